@@ -21,6 +21,7 @@ import (
 	"github.com/looplj/axonhub/internal/log"
 	"github.com/looplj/axonhub/internal/metrics"
 	"github.com/looplj/axonhub/internal/server"
+	"github.com/looplj/axonhub/internal/server/persist"
 )
 
 func main() {
@@ -69,7 +70,7 @@ func startServer() {
 		fx.Provide(metrics.NewProvider),
 		fx.Provide(dumper.New),
 		fx.Invoke(dumper.SetGlobal),
-		fx.Invoke(func(lc fx.Lifecycle, server *server.Server, provider *sdk.MeterProvider, ent *ent.Client) {
+		fx.Invoke(func(lc fx.Lifecycle, server *server.Server, provider *sdk.MeterProvider, ent *ent.Client, writer *persist.Writer) {
 			lc.Append(fx.Hook{
 				OnStart: func(ctx context.Context) error {
 					return metrics.SetupMetrics(provider, server.Config.Name)
@@ -80,6 +81,11 @@ func startServer() {
 			})
 			lc.Append(fx.Hook{
 				OnStart: func(ctx context.Context) error {
+					err := writer.Start(ctx)
+					if err != nil {
+						return err
+					}
+
 					go func() {
 						err := server.Run()
 						if err != nil {
@@ -94,6 +100,11 @@ func startServer() {
 					if err != nil {
 						log.Error(context.Background(), "server shutdown error:", log.Cause(err))
 					}
+					// Flush the queued writes after no more requests are served and before the database is closed.
+					err = writer.Stop(ctx)
+					if err != nil {
+						log.Error(context.Background(), "persistence writer stop error:", log.Cause(err))
+					}
 					err = ent.Close()
 					if err != nil {
 						log.Error(context.Background(), "ent close error:", log.Cause(err))
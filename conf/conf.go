@@ -15,11 +15,16 @@ import (
 	"go.uber.org/zap/zapcore"
 
 	"github.com/looplj/axonhub/internal/dumper"
+	"github.com/looplj/axonhub/internal/llm/decorator/policy"
+	"github.com/looplj/axonhub/internal/llm/tokenizer"
 	"github.com/looplj/axonhub/internal/log"
 	"github.com/looplj/axonhub/internal/metrics"
 	"github.com/looplj/axonhub/internal/server"
+	"github.com/looplj/axonhub/internal/server/affinity"
+	"github.com/looplj/axonhub/internal/server/cache"
 	"github.com/looplj/axonhub/internal/server/db"
 	"github.com/looplj/axonhub/internal/server/gc"
+	"github.com/looplj/axonhub/internal/server/persist"
 )
 
 type Config struct {
@@ -31,6 +36,12 @@ type Config struct {
 	Metrics   metrics.Config `conf:"metrics" yaml:"metrics" json:"metrics"`
 	Dumper    dumper.Config  `conf:"dumper" yaml:"dumper" json:"dumper"`
 	GC        gc.Config      `conf:"gc" yaml:"gc" json:"gc"`
+	Persist   persist.Config `conf:"persist" yaml:"persist" json:"persist"`
+
+	Tokenizer       tokenizer.Config `conf:"tokenizer" yaml:"tokenizer" json:"tokenizer"`
+	ResponseCache   cache.Config     `conf:"response_cache" yaml:"response_cache" json:"response_cache"`
+	PolicyWebhook   policy.Config    `conf:"policy_webhook" yaml:"policy_webhook" json:"policy_webhook"`
+	SessionAffinity affinity.Config  `conf:"session_affinity" yaml:"session_affinity" json:"session_affinity"`
 }
 
 // Load loads configuration from YAML file and environment variables.
@@ -162,6 +173,34 @@ func setDefaults(v *viper.Viper) {
 
 	// GC defaults
 	v.SetDefault("gc.cron", "0 2 * * *") // Daily at 2:00 AM
+	v.SetDefault("gc.deleted_retention_days", 30)
+
+	// Persist defaults
+	v.SetDefault("persist.async", true)
+	v.SetDefault("persist.queue_size", 4096)
+	v.SetDefault("persist.workers", 4)
+	v.SetDefault("persist.batch_size", 64)
+	v.SetDefault("persist.enqueue_timeout", "100ms")
+
+	// Tokenizer defaults
+	v.SetDefault("tokenizer.encodings_dir", "")
+
+	// Response cache defaults
+	v.SetDefault("response_cache.store", "memory")
+	v.SetDefault("response_cache.max_entries", 1000)
+	v.SetDefault("response_cache.ttl", "1h")
+
+	// Policy webhook defaults
+	v.SetDefault("policy_webhook.url", "")
+	v.SetDefault("policy_webhook.timeout", "5s")
+	v.SetDefault("policy_webhook.fail_mode", "closed")
+	v.SetDefault("policy_webhook.check_response", false)
+
+	// Session affinity defaults
+	v.SetDefault("session_affinity.enabled", false)
+	v.SetDefault("session_affinity.header", "AH-Session-Id")
+	v.SetDefault("session_affinity.ttl", "30m")
+	v.SetDefault("session_affinity.max_sessions", 10000)
 }
 
 // parseLogLevel converts a string log level to zapcore.Level.
@@ -26,7 +26,7 @@ import { CreateApiKeyInput, createApiKeyInputSchema } from '../data/schema'
 
 export function ApiKeysCreateDialog() {
   const { t } = useTranslation()
-  const { isDialogOpen, closeDialog } = useApiKeysContext()
+  const { isDialogOpen, openDialog, closeDialog } = useApiKeysContext()
   const createApiKey = useCreateApiKey()
   const [isSubmitting, setIsSubmitting] = useState(false)
 
@@ -40,9 +40,11 @@ export function ApiKeysCreateDialog() {
   const onSubmit = async (data: CreateApiKeyInput) => {
     setIsSubmitting(true)
     try {
-      await createApiKey.mutateAsync(data)
+      const result = await createApiKey.mutateAsync(data)
       form.reset()
       closeDialog('create')
+      // The full key is only returned once on creation.
+      openDialog('view', { ...result.createAPIKey.apiKey, key: result.createAPIKey.plainKey })
     } catch (error) {
       // Error is handled by the mutation
     } finally {
@@ -17,6 +17,7 @@ import { Form, FormControl, FormField, FormItem, FormLabel, FormMessage } from '
 import { Input } from '@/components/ui/input'
 import { Select, SelectContent, SelectItem, SelectTrigger, SelectValue } from '@/components/ui/select'
 import { Separator } from '@/components/ui/separator'
+import { Textarea } from '@/components/ui/textarea'
 import { AutoComplete } from '@/components/auto-complete'
 import { defaultModels } from '../../channels/components/channels-action-dialog'
 import { useApiKeysContext } from '../context/apikeys-context'
@@ -90,7 +91,21 @@ export function ApiKeyProfilesDialog({
   }, [open, initialData, form])
 
   const handleSubmit = (data: UpdateApiKeyProfilesInput) => {
-    onSubmit(data)
+    // The comma separated inputs keep the empty entries while typing, drop them on submit.
+    onSubmit({
+      ...data,
+      profiles: data.profiles.map((profile) => ({
+        ...profile,
+        overrides: profile.overrides && {
+          ...profile.overrides,
+          stripParams: profile.overrides.stripParams?.filter((param) => param !== ''),
+        },
+        modelFallbacks: profile.modelFallbacks?.map((fallback) => ({
+          ...fallback,
+          fallbacks: fallback.fallbacks.filter((model) => model !== ''),
+        })),
+      })),
+    })
   }
 
   const addProfile = () => {
@@ -314,11 +329,280 @@ function ProfileCard({ profileIndex, form, onRemove, canRemove, t }: ProfileCard
             />
           ))}
         </div>
+
+        <Separator />
+
+        <RoutingStrategySection profileIndex={profileIndex} form={form} t={t} />
+
+        <Separator />
+
+        <FallbacksSection profileIndex={profileIndex} form={form} t={t} />
+
+        <Separator />
+
+        <OverridesSection profileIndex={profileIndex} form={form} t={t} />
       </CardContent>
     </Card>
   )
 }
 
+interface RoutingStrategySectionProps {
+  profileIndex: number
+  form: any
+  t: (key: string) => string
+}
+
+// The select does not accept the empty value, the default strategy keeps the channel order.
+const defaultRoutingStrategy = 'default'
+
+function RoutingStrategySection({ profileIndex, form, t }: RoutingStrategySectionProps) {
+  return (
+    <FormField
+      control={form.control}
+      name={`profiles.${profileIndex}.routingStrategy`}
+      render={({ field }) => (
+        <FormItem className='flex items-center justify-between gap-3'>
+          <div>
+            <FormLabel className='text-sm font-medium'>{t('apikeys.profiles.routingStrategy.title')}</FormLabel>
+            <p className='text-muted-foreground text-xs'>{t('apikeys.profiles.routingStrategy.description')}</p>
+          </div>
+          <Select
+            onValueChange={(value) => field.onChange(value === defaultRoutingStrategy ? null : value)}
+            value={field.value || defaultRoutingStrategy}
+          >
+            <FormControl>
+              <SelectTrigger className='w-48'>
+                <SelectValue />
+              </SelectTrigger>
+            </FormControl>
+            <SelectContent>
+              <SelectItem value={defaultRoutingStrategy}>{t('apikeys.profiles.routingStrategy.default')}</SelectItem>
+              <SelectItem value='cheapest'>{t('apikeys.profiles.routingStrategy.cheapest')}</SelectItem>
+              <SelectItem value='fastest'>{t('apikeys.profiles.routingStrategy.fastest')}</SelectItem>
+            </SelectContent>
+          </Select>
+        </FormItem>
+      )}
+    />
+  )
+}
+
+interface FallbacksSectionProps {
+  profileIndex: number
+  form: any
+  t: (key: string) => string
+}
+
+function FallbacksSection({ profileIndex, form, t }: FallbacksSectionProps) {
+  const {
+    fields: fallbackFields,
+    append: appendFallback,
+    remove: removeFallback,
+  } = useFieldArray({
+    control: form.control,
+    name: `profiles.${profileIndex}.modelFallbacks`,
+  })
+
+  return (
+    <div className='space-y-3'>
+      <div className='flex items-center justify-between'>
+        <div>
+          <h4 className='text-sm font-medium'>{t('apikeys.profiles.fallbacks.title')}</h4>
+          <p className='text-muted-foreground text-xs'>{t('apikeys.profiles.fallbacks.description')}</p>
+        </div>
+        <Button
+          type='button'
+          variant='outline'
+          size='sm'
+          onClick={() => appendFallback({ model: '', fallbacks: [] })}
+          className='flex items-center gap-2'
+        >
+          <IconPlus className='h-4 w-4' />
+          {t('apikeys.profiles.fallbacks.add')}
+        </Button>
+      </div>
+
+      {fallbackFields.map((fallback, fallbackIndex) => (
+        <div key={fallback.id} className='flex items-start gap-3'>
+          <FormField
+            control={form.control}
+            name={`profiles.${profileIndex}.modelFallbacks.${fallbackIndex}.model`}
+            render={({ field }) => (
+              <FormItem className='flex-1'>
+                <FormControl>
+                  <Input {...field} placeholder={t('apikeys.profiles.fallbacks.model')} />
+                </FormControl>
+                <FormMessage />
+              </FormItem>
+            )}
+          />
+          <span className='text-muted-foreground'>→</span>
+          <FormField
+            control={form.control}
+            name={`profiles.${profileIndex}.modelFallbacks.${fallbackIndex}.fallbacks`}
+            render={({ field }) => (
+              <FormItem className='flex-[2]'>
+                <FormControl>
+                  <Input
+                    placeholder={t('apikeys.profiles.fallbacks.fallbacks')}
+                    value={(field.value ?? []).join(', ')}
+                    onChange={(e) =>
+                      field.onChange(
+                        e.target.value
+                          .split(',')
+                          .map((model) => model.trim())
+                      )
+                    }
+                  />
+                </FormControl>
+                <FormMessage />
+              </FormItem>
+            )}
+          />
+          <Button
+            type='button'
+            variant='ghost'
+            size='sm'
+            onClick={() => removeFallback(fallbackIndex)}
+            className='text-destructive hover:text-destructive'
+          >
+            <IconTrash className='h-4 w-4' />
+          </Button>
+        </div>
+      ))}
+    </div>
+  )
+}
+
+interface OverridesSectionProps {
+  profileIndex: number
+  form: any
+  t: (key: string) => string
+}
+
+// Empty inputs are saved as null, so the overrides are not applied.
+const toNumber = (value: string) => (value === '' ? null : Number(value))
+
+function OverridesSection({ profileIndex, form, t }: OverridesSectionProps) {
+  const prefix = `profiles.${profileIndex}.overrides`
+
+  return (
+    <div className='space-y-3'>
+      <div>
+        <h4 className='text-sm font-medium'>{t('apikeys.profiles.overrides.title')}</h4>
+        <p className='text-muted-foreground text-xs'>{t('apikeys.profiles.overrides.description')}</p>
+      </div>
+      <div className='grid grid-cols-2 gap-3'>
+        <FormField
+          control={form.control}
+          name={`${prefix}.systemPromptPrepend`}
+          render={({ field }) => (
+            <FormItem>
+              <FormLabel>{t('apikeys.profiles.overrides.systemPromptPrepend')}</FormLabel>
+              <FormControl>
+                <Textarea {...field} value={field.value ?? ''} rows={2} />
+              </FormControl>
+              <FormMessage />
+            </FormItem>
+          )}
+        />
+        <FormField
+          control={form.control}
+          name={`${prefix}.systemPromptAppend`}
+          render={({ field }) => (
+            <FormItem>
+              <FormLabel>{t('apikeys.profiles.overrides.systemPromptAppend')}</FormLabel>
+              <FormControl>
+                <Textarea {...field} value={field.value ?? ''} rows={2} />
+              </FormControl>
+              <FormMessage />
+            </FormItem>
+          )}
+        />
+        <FormField
+          control={form.control}
+          name={`${prefix}.maxTokens`}
+          render={({ field }) => (
+            <FormItem>
+              <FormLabel>{t('apikeys.profiles.overrides.maxTokens')}</FormLabel>
+              <FormControl>
+                <Input
+                  type='number'
+                  min={0}
+                  value={field.value ?? ''}
+                  onChange={(e) => field.onChange(toNumber(e.target.value))}
+                />
+              </FormControl>
+              <FormMessage />
+            </FormItem>
+          )}
+        />
+        <FormField
+          control={form.control}
+          name={`${prefix}.maxTemperature`}
+          render={({ field }) => (
+            <FormItem>
+              <FormLabel>{t('apikeys.profiles.overrides.maxTemperature')}</FormLabel>
+              <FormControl>
+                <Input
+                  type='number'
+                  min={0}
+                  step={0.1}
+                  value={field.value ?? ''}
+                  onChange={(e) => field.onChange(toNumber(e.target.value))}
+                />
+              </FormControl>
+              <FormMessage />
+            </FormItem>
+          )}
+        />
+        <FormField
+          control={form.control}
+          name={`${prefix}.forcedParams.temperature`}
+          render={({ field }) => (
+            <FormItem>
+              <FormLabel>{t('apikeys.profiles.overrides.forcedTemperature')}</FormLabel>
+              <FormControl>
+                <Input
+                  type='number'
+                  min={0}
+                  step={0.1}
+                  value={field.value ?? ''}
+                  onChange={(e) => field.onChange(toNumber(e.target.value))}
+                />
+              </FormControl>
+              <FormMessage />
+            </FormItem>
+          )}
+        />
+        <FormField
+          control={form.control}
+          name={`${prefix}.stripParams`}
+          render={({ field }) => (
+            <FormItem>
+              <FormLabel>{t('apikeys.profiles.overrides.stripParams')}</FormLabel>
+              <FormControl>
+                <Input
+                  placeholder='logit_bias, seed'
+                  value={(field.value ?? []).join(', ')}
+                  onChange={(e) =>
+                    field.onChange(
+                      e.target.value
+                        .split(',')
+                        .map((param) => param.trim())
+                    )
+                  }
+                />
+              </FormControl>
+              <FormMessage />
+            </FormItem>
+          )}
+        />
+      </div>
+    </div>
+  )
+}
+
 interface MappingRowProps {
   profileIndex: number
   mappingIndex: number
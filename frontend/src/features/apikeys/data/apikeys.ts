@@ -65,6 +65,17 @@ function buildApiKeyQuery(permissions: { canViewUsers: boolean }) {
           profiles {
             name
             modelMappings { from to }
+            overrides {
+              defaultParams { temperature topP presencePenalty frequencyPenalty seed reasoningEffort }
+              forcedParams { temperature topP presencePenalty frequencyPenalty seed reasoningEffort }
+              maxTemperature
+              maxTokens
+              systemPromptPrepend
+              systemPromptAppend
+              stripParams
+            }
+            modelFallbacks { model fallbacks }
+            routingStrategy
           }
         }
       }
@@ -84,12 +95,15 @@ function buildCreateApiKeyMutation(permissions: { canViewUsers: boolean }) {
   return `
     mutation CreateAPIKey($input: CreateAPIKeyInput!) {
       createAPIKey(input: $input) {
-        id
-        createdAt
-        updatedAt${userFields}
-        key
-        name
-        status
+        apiKey {
+          id
+          createdAt
+          updatedAt${userFields}
+          key
+          name
+          status
+        }
+        plainKey
       }
     }
   `
@@ -199,7 +213,7 @@ export function useCreateApiKey() {
   return useMutation({
     mutationFn: (input: CreateApiKeyInput) => {
       const mutation = buildCreateApiKeyMutation(permissions)
-      return graphqlRequest<{ createAPIKey: ApiKey }>(mutation, { input })
+      return graphqlRequest<{ createAPIKey: { apiKey: ApiKey; plainKey: string } }>(mutation, { input })
     },
     onSuccess: () => {
       queryClient.invalidateQueries({ queryKey: ['apiKeys'] })
@@ -1,10 +1,18 @@
 import { z } from 'zod'
+import { requestOverridesSchema } from '@/features/channels/data/schema'
 import { userSchema } from '@/features/users/data/schema'
 
 // API Key Status
 export const apiKeyStatusSchema = z.enum(['enabled', 'disabled'])
 export type ApiKeyStatus = z.infer<typeof apiKeyStatusSchema>
 
+// Model fallback chain, the fallback models are tried in order when the model fails
+export const modelFallbackSchema = z.object({
+  model: z.string(),
+  fallbacks: z.array(z.string()),
+})
+export type ModelFallback = z.infer<typeof modelFallbackSchema>
+
 // API Key schema based on GraphQL schema
 export const apiKeySchema = z.object({
   id: z.string(),
@@ -27,6 +35,9 @@ export const apiKeySchema = z.object({
               to: z.string(),
             })
           ),
+          overrides: requestOverridesSchema.optional().nullable(),
+          modelFallbacks: z.array(modelFallbackSchema).optional().nullable(),
+          routingStrategy: z.string().optional().nullable(),
         })
       ),
     })
@@ -89,6 +100,9 @@ export type ModelMapping = z.infer<typeof modelMappingSchema>
 export const apiKeyProfileSchema = z.object({
   name: z.string(),
   modelMappings: z.array(modelMappingSchema),
+  overrides: requestOverridesSchema.optional().nullable(),
+  modelFallbacks: z.array(modelFallbackSchema).optional().nullable(),
+  routingStrategy: z.string().optional().nullable(),
 })
 export type ApiKeyProfile = z.infer<typeof apiKeyProfileSchema>
 
@@ -108,6 +122,9 @@ export const updateApiKeyProfilesInputSchema = z.object({
       from: z.string().min(1, 'Source model is required'),
       to: z.string().min(1, 'Target model is required'),
     })),
+    overrides: requestOverridesSchema.optional().nullable(),
+    modelFallbacks: z.array(modelFallbackSchema).optional().nullable(),
+    routingStrategy: z.string().optional().nullable(),
   })),
 })
 export type UpdateApiKeyProfilesInput = z.infer<typeof updateApiKeyProfilesInputSchema>
@@ -121,6 +138,9 @@ export const updateApiKeyProfilesInputSchemaFactory = (t: (key: string) => strin
       from: z.string().min(1, t('apikeys.validation.sourceModelRequired')),
       to: z.string().min(1, t('apikeys.validation.targetModelRequired')),
     })),
+    overrides: requestOverridesSchema.optional().nullable(),
+    modelFallbacks: z.array(modelFallbackSchema).optional().nullable(),
+    routingStrategy: z.string().optional().nullable(),
   })).min(1, t('apikeys.validation.atLeastOneProfile')),
 }).refine(
   (data) => data.profiles.some(profile => profile.name === data.activeProfile),
@@ -283,10 +283,26 @@ export const createColumns = (
               return t('channels.status.disabled')
           }
         }
+        // The enabled channels are skipped while they are out of their schedule or in maintenance.
+        const maintenance = row.original.activeMaintenanceWindow
         return (
-          <Badge variant={getBadgeVariant()}>
-            {getStatusText()}
-          </Badge>
+          <div className='flex items-center gap-1'>
+            <Badge variant={getBadgeVariant()}>
+              {getStatusText()}
+            </Badge>
+            {status === 'enabled' && row.original.available === false && (
+              <Badge
+                variant='outline'
+                title={
+                  maintenance
+                    ? `${format(maintenance.startAt, 'yyyy-MM-dd HH:mm')} - ${format(maintenance.endAt, 'yyyy-MM-dd HH:mm')}${maintenance.reason ? ` ${maintenance.reason}` : ''}`
+                    : undefined
+                }
+              >
+                {maintenance ? t('channels.status.maintenance') : t('channels.status.offSchedule')}
+              </Badge>
+            )}
+          </div>
         )
       },
       enableSorting: true,
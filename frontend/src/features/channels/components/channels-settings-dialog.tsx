@@ -1,4 +1,5 @@
 import { useCallback, useState } from 'react'
+import { format } from 'date-fns'
 import { z } from 'zod'
 import { useForm } from 'react-hook-form'
 import { zodResolver } from '@hookform/resolvers/zod'
@@ -17,9 +18,22 @@ import {
   DialogTitle,
 } from '@/components/ui/dialog'
 import { Input } from '@/components/ui/input'
+import { Label } from '@/components/ui/label'
+import { Switch } from '@/components/ui/switch'
+import { Textarea } from '@/components/ui/textarea'
 import { Select, SelectContent, SelectItem, SelectTrigger, SelectValue } from '@/components/ui/select'
 import { useUpdateChannel } from '../data/channels'
-import { Channel, ModelMapping } from '../data/schema'
+import {
+  BodyPatch,
+  Channel,
+  ChannelSchedule,
+  ExtraHeader,
+  MaintenanceWindow,
+  ModelMapping,
+  ModelPrice,
+  TimeWindow,
+  TransportSettings,
+} from '../data/schema'
 
 interface Props {
   open: boolean
@@ -27,6 +41,30 @@ interface Props {
   currentRow: Channel
 }
 
+const splitModels = (value: string) =>
+  value
+    .split(',')
+    .map((model) => model.trim())
+    .filter((model) => model !== '')
+
+const TRANSPORT_NUMBER_FIELDS = [
+  'connectTimeoutSeconds',
+  'responseHeaderTimeoutSeconds',
+  'idleConnTimeoutSeconds',
+  'maxIdleConns',
+  'maxIdleConnsPerHost',
+] as const
+
+// The days of the week of the schedule windows, 0 is Sunday.
+const WEEKDAYS = [1, 2, 3, 4, 5, 6, 0]
+
+// The datetime-local inputs use the local time of the browser.
+const toDateTimeLocal = (date: Date) => format(date, "yyyy-MM-dd'T'HH:mm")
+
+// The shared HTTP client is used when no transport setting is set.
+const hasTransportSettings = (transport: TransportSettings) =>
+  Object.values(transport).some((value) => value !== null && value !== undefined && value !== '' && value !== false)
+
 // 扩展 schema 以包含模型映射的校验规则
 const createChannelSettingsFormSchema = (supportedModels: string[]) =>
   z.object({
@@ -63,6 +101,14 @@ export function ChannelsSettingsDialog({ open, onOpenChange, currentRow }: Props
   const updateChannel = useUpdateChannel()
   const [modelMappings, setModelMappings] = useState<ModelMapping[]>(currentRow.settings?.modelMappings || [])
   const [newMapping, setNewMapping] = useState({ from: '', to: '' })
+  const [extraHeaders, setExtraHeaders] = useState<ExtraHeader[]>(currentRow.settings?.extraHeaders || [])
+  const [bodyPatches, setBodyPatches] = useState<BodyPatch[]>(currentRow.settings?.bodyPatches || [])
+  const [transport, setTransport] = useState<TransportSettings>(currentRow.settings?.transport || {})
+  const [modelPrices, setModelPrices] = useState<ModelPrice[]>(currentRow.settings?.modelPrices || [])
+  const [schedule, setSchedule] = useState<ChannelSchedule | null>(currentRow.settings?.schedule || null)
+  const [maintenanceWindows, setMaintenanceWindows] = useState<MaintenanceWindow[]>(
+    currentRow.settings?.maintenanceWindows || []
+  )
 
   const channelSettingsFormSchema = createChannelSettingsFormSchema(currentRow.supportedModels)
 
@@ -86,6 +132,15 @@ export function ChannelsSettingsDialog({ open, onOpenChange, currentRow }: Props
         input: {
           settings: {
             modelMappings: values.modelMappings,
+            promptCaching: currentRow.settings?.promptCaching,
+            imageFetch: currentRow.settings?.imageFetch,
+            overrides: currentRow.settings?.overrides,
+            extraHeaders: extraHeaders.filter((header) => header.key.trim()),
+            bodyPatches: bodyPatches.filter((patch) => patch.path.trim()),
+            transport: hasTransportSettings(transport) ? transport : null,
+            modelPrices: modelPrices.filter((price) => price.model.trim()),
+            schedule: schedule && schedule.windows.length > 0 ? schedule : null,
+            maintenanceWindows,
           },
         },
       })
@@ -121,6 +176,35 @@ export function ChannelsSettingsDialog({ open, onOpenChange, currentRow }: Props
     [form, modelMappings, setModelMappings]
   )
 
+  const updateExtraHeader = (index: number, changes: Partial<ExtraHeader>) => {
+    setExtraHeaders(extraHeaders.map((header, i) => (i === index ? { ...header, ...changes } : header)))
+  }
+
+  const updateBodyPatch = (index: number, changes: Partial<BodyPatch>) => {
+    setBodyPatches(bodyPatches.map((patch, i) => (i === index ? { ...patch, ...changes } : patch)))
+  }
+
+  const updateModelPrice = (index: number, changes: Partial<ModelPrice>) => {
+    setModelPrices(modelPrices.map((price, i) => (i === index ? { ...price, ...changes } : price)))
+  }
+
+  const updateScheduleWindow = (index: number, changes: Partial<TimeWindow>) => {
+    if (!schedule) return
+    setSchedule({
+      ...schedule,
+      windows: schedule.windows.map((item, i) => (i === index ? { ...item, ...changes } : item)),
+    })
+  }
+
+  const toggleScheduleDay = (index: number, day: number) => {
+    const days = schedule?.windows[index].days ?? []
+    updateScheduleWindow(index, { days: days.includes(day) ? days.filter((d) => d !== day) : [...days, day] })
+  }
+
+  const updateMaintenanceWindow = (index: number, changes: Partial<MaintenanceWindow>) => {
+    setMaintenanceWindows(maintenanceWindows.map((item, i) => (i === index ? { ...item, ...changes } : item)))
+  }
+
   return (
     <Dialog
       open={open}
@@ -128,11 +212,17 @@ export function ChannelsSettingsDialog({ open, onOpenChange, currentRow }: Props
         if (!state) {
           setModelMappings(currentRow.settings?.modelMappings || [])
           setNewMapping({ from: '', to: '' })
+          setExtraHeaders(currentRow.settings?.extraHeaders || [])
+          setBodyPatches(currentRow.settings?.bodyPatches || [])
+          setTransport(currentRow.settings?.transport || {})
+          setModelPrices(currentRow.settings?.modelPrices || [])
+          setSchedule(currentRow.settings?.schedule || null)
+          setMaintenanceWindows(currentRow.settings?.maintenanceWindows || [])
         }
         onOpenChange(state)
       }}
     >
-      <DialogContent className='sm:max-w-2xl'>
+      <DialogContent className='max-h-[90vh] overflow-y-auto sm:max-w-2xl'>
         <DialogHeader className='text-left'>
           <DialogTitle>{t('channels.dialogs.settings.title')}</DialogTitle>
           <DialogDescription>{t('channels.dialogs.settings.description', { name: currentRow.name })}</DialogDescription>
@@ -250,6 +340,349 @@ export function ChannelsSettingsDialog({ open, onOpenChange, currentRow }: Props
               </div>
             </CardContent>
           </Card>
+
+          <Card>
+            <CardHeader>
+              <CardTitle className='text-lg'>{t('channels.dialogs.settings.requestPatches.title')}</CardTitle>
+              <CardDescription>{t('channels.dialogs.settings.requestPatches.description')}</CardDescription>
+            </CardHeader>
+            <CardContent className='space-y-4'>
+              <div className='flex items-center justify-between'>
+                <h4 className='text-sm font-medium'>{t('channels.dialogs.settings.requestPatches.headers')}</h4>
+                <Button
+                  type='button'
+                  variant='outline'
+                  size='sm'
+                  onClick={() => setExtraHeaders([...extraHeaders, { key: '', value: '' }])}
+                >
+                  <Plus size={16} />
+                </Button>
+              </div>
+              {extraHeaders.map((header, index) => (
+                <div key={index} className='flex items-center gap-2'>
+                  <Input
+                    placeholder={t('channels.dialogs.settings.requestPatches.headerKey')}
+                    value={header.key}
+                    onChange={(e) => updateExtraHeader(index, { key: e.target.value })}
+                  />
+                  <Input
+                    placeholder={t('channels.dialogs.settings.requestPatches.headerValue')}
+                    value={header.value}
+                    onChange={(e) => updateExtraHeader(index, { value: e.target.value })}
+                  />
+                  <Input
+                    placeholder={t('channels.dialogs.settings.requestPatches.models')}
+                    value={(header.models ?? []).join(', ')}
+                    onChange={(e) => updateExtraHeader(index, { models: splitModels(e.target.value) })}
+                  />
+                  <Button
+                    type='button'
+                    variant='ghost'
+                    size='sm'
+                    onClick={() => setExtraHeaders(extraHeaders.filter((_, i) => i !== index))}
+                    className='text-destructive hover:text-destructive'
+                  >
+                    <X size={16} />
+                  </Button>
+                </div>
+              ))}
+
+              <div className='flex items-center justify-between'>
+                <h4 className='text-sm font-medium'>{t('channels.dialogs.settings.requestPatches.bodyPatches')}</h4>
+                <Button
+                  type='button'
+                  variant='outline'
+                  size='sm'
+                  onClick={() => setBodyPatches([...bodyPatches, { path: '', value: '' }])}
+                >
+                  <Plus size={16} />
+                </Button>
+              </div>
+              {bodyPatches.map((patch, index) => (
+                <div key={index} className='flex items-center gap-2'>
+                  <Input
+                    placeholder={t('channels.dialogs.settings.requestPatches.path')}
+                    value={patch.path}
+                    onChange={(e) => updateBodyPatch(index, { path: e.target.value })}
+                  />
+                  <Input
+                    placeholder={t('channels.dialogs.settings.requestPatches.value')}
+                    value={patch.value ?? ''}
+                    disabled={!!patch.delete}
+                    onChange={(e) => updateBodyPatch(index, { value: e.target.value })}
+                  />
+                  <Input
+                    placeholder={t('channels.dialogs.settings.requestPatches.models')}
+                    value={(patch.models ?? []).join(', ')}
+                    onChange={(e) => updateBodyPatch(index, { models: splitModels(e.target.value) })}
+                  />
+                  <div className='flex items-center gap-1'>
+                    <Switch
+                      checked={!!patch.delete}
+                      onCheckedChange={(checked) => updateBodyPatch(index, { delete: checked })}
+                    />
+                    <span className='text-muted-foreground text-xs'>
+                      {t('channels.dialogs.settings.requestPatches.delete')}
+                    </span>
+                  </div>
+                  <Button
+                    type='button'
+                    variant='ghost'
+                    size='sm'
+                    onClick={() => setBodyPatches(bodyPatches.filter((_, i) => i !== index))}
+                    className='text-destructive hover:text-destructive'
+                  >
+                    <X size={16} />
+                  </Button>
+                </div>
+              ))}
+            </CardContent>
+          </Card>
+
+          <Card>
+            <CardHeader>
+              <CardTitle className='text-lg'>{t('channels.dialogs.settings.transport.title')}</CardTitle>
+              <CardDescription>{t('channels.dialogs.settings.transport.description')}</CardDescription>
+            </CardHeader>
+            <CardContent className='space-y-4'>
+              <div className='space-y-1'>
+                <Label htmlFor='transport-proxy-url'>{t('channels.dialogs.settings.transport.proxyURL.label')}</Label>
+                <Input
+                  id='transport-proxy-url'
+                  placeholder='socks5://127.0.0.1:1080'
+                  value={transport.proxyURL ?? ''}
+                  onChange={(e) => setTransport({ ...transport, proxyURL: e.target.value })}
+                />
+                <p className='text-muted-foreground text-xs'>
+                  {t('channels.dialogs.settings.transport.proxyURL.description')}
+                </p>
+              </div>
+              <div className='space-y-1'>
+                <Label htmlFor='transport-ca-cert'>{t('channels.dialogs.settings.transport.caCert')}</Label>
+                <Textarea
+                  id='transport-ca-cert'
+                  placeholder='-----BEGIN CERTIFICATE-----'
+                  rows={3}
+                  value={transport.caCert ?? ''}
+                  onChange={(e) => setTransport({ ...transport, caCert: e.target.value })}
+                />
+              </div>
+              <div className='flex items-center justify-between'>
+                <Label htmlFor='transport-insecure'>{t('channels.dialogs.settings.transport.insecureSkipVerify')}</Label>
+                <Switch
+                  id='transport-insecure'
+                  checked={!!transport.insecureSkipVerify}
+                  onCheckedChange={(checked) => setTransport({ ...transport, insecureSkipVerify: checked })}
+                />
+              </div>
+              <div className='grid grid-cols-2 gap-4'>
+                {TRANSPORT_NUMBER_FIELDS.map((field) => (
+                  <div key={field} className='space-y-1'>
+                    <Label htmlFor={`transport-${field}`}>{t(`channels.dialogs.settings.transport.${field}`)}</Label>
+                    <Input
+                      id={`transport-${field}`}
+                      type='number'
+                      min={0}
+                      value={transport[field] ?? ''}
+                      onChange={(e) =>
+                        setTransport({ ...transport, [field]: e.target.value === '' ? null : Number(e.target.value) })
+                      }
+                    />
+                  </div>
+                ))}
+              </div>
+            </CardContent>
+          </Card>
+          <Card>
+            <CardHeader>
+              <div className='flex items-center justify-between'>
+                <div>
+                  <CardTitle className='text-lg'>{t('channels.dialogs.settings.modelPrices.title')}</CardTitle>
+                  <CardDescription>{t('channels.dialogs.settings.modelPrices.description')}</CardDescription>
+                </div>
+                <Button
+                  type='button'
+                  variant='outline'
+                  size='sm'
+                  onClick={() => setModelPrices([...modelPrices, { model: '', inputPrice: 0, outputPrice: 0 }])}
+                >
+                  <Plus size={16} />
+                </Button>
+              </div>
+            </CardHeader>
+            <CardContent className='space-y-2'>
+              {modelPrices.map((price, index) => (
+                <div key={index} className='flex items-center gap-2'>
+                  <Input
+                    placeholder={t('channels.dialogs.settings.modelPrices.model')}
+                    value={price.model}
+                    onChange={(e) => updateModelPrice(index, { model: e.target.value })}
+                  />
+                  <Input
+                    type='number'
+                    min={0}
+                    step='any'
+                    placeholder={t('channels.dialogs.settings.modelPrices.inputPrice')}
+                    value={price.inputPrice}
+                    onChange={(e) => updateModelPrice(index, { inputPrice: Number(e.target.value) })}
+                  />
+                  <Input
+                    type='number'
+                    min={0}
+                    step='any'
+                    placeholder={t('channels.dialogs.settings.modelPrices.outputPrice')}
+                    value={price.outputPrice}
+                    onChange={(e) => updateModelPrice(index, { outputPrice: Number(e.target.value) })}
+                  />
+                  <Button
+                    type='button'
+                    variant='ghost'
+                    size='sm'
+                    onClick={() => setModelPrices(modelPrices.filter((_, i) => i !== index))}
+                    className='text-destructive hover:text-destructive'
+                  >
+                    <X size={16} />
+                  </Button>
+                </div>
+              ))}
+            </CardContent>
+          </Card>
+          <Card>
+            <CardHeader>
+              <div className='flex items-center justify-between'>
+                <div>
+                  <CardTitle className='text-lg'>{t('channels.dialogs.settings.schedule.title')}</CardTitle>
+                  <CardDescription>{t('channels.dialogs.settings.schedule.description')}</CardDescription>
+                </div>
+                <Button
+                  type='button'
+                  variant='outline'
+                  size='sm'
+                  onClick={() =>
+                    setSchedule({
+                      timezone: schedule?.timezone ?? Intl.DateTimeFormat().resolvedOptions().timeZone,
+                      windows: [...(schedule?.windows ?? []), { days: [], start: '00:00', end: '08:00' }],
+                    })
+                  }
+                >
+                  <Plus size={16} />
+                </Button>
+              </div>
+            </CardHeader>
+            {schedule && schedule.windows.length > 0 && (
+              <CardContent className='space-y-3'>
+                <div className='space-y-1'>
+                  <Label htmlFor='schedule-timezone'>{t('channels.dialogs.settings.schedule.timezone')}</Label>
+                  <Input
+                    id='schedule-timezone'
+                    placeholder='UTC'
+                    value={schedule.timezone ?? ''}
+                    onChange={(e) => setSchedule({ ...schedule, timezone: e.target.value })}
+                  />
+                </div>
+                {schedule.windows.map((item, index) => (
+                  <div key={index} className='flex flex-wrap items-center gap-2'>
+                    <div className='flex gap-1'>
+                      {WEEKDAYS.map((day) => (
+                        <Button
+                          key={day}
+                          type='button'
+                          size='sm'
+                          variant={item.days?.includes(day) ? 'default' : 'outline'}
+                          className='h-8 w-10 px-0'
+                          onClick={() => toggleScheduleDay(index, day)}
+                        >
+                          {t(`channels.dialogs.settings.schedule.weekdays.${day}`)}
+                        </Button>
+                      ))}
+                    </div>
+                    <Input
+                      type='time'
+                      className='w-28'
+                      value={item.start}
+                      onChange={(e) => updateScheduleWindow(index, { start: e.target.value })}
+                    />
+                    <span className='text-muted-foreground'>-</span>
+                    <Input
+                      type='time'
+                      className='w-28'
+                      value={item.end}
+                      onChange={(e) => updateScheduleWindow(index, { end: e.target.value })}
+                    />
+                    <Button
+                      type='button'
+                      variant='ghost'
+                      size='sm'
+                      onClick={() => setSchedule({ ...schedule, windows: schedule.windows.filter((_, i) => i !== index) })}
+                      className='text-destructive hover:text-destructive'
+                    >
+                      <X size={16} />
+                    </Button>
+                  </div>
+                ))}
+                <p className='text-muted-foreground text-xs'>{t('channels.dialogs.settings.schedule.hint')}</p>
+              </CardContent>
+            )}
+          </Card>
+
+          <Card>
+            <CardHeader>
+              <div className='flex items-center justify-between'>
+                <div>
+                  <CardTitle className='text-lg'>{t('channels.dialogs.settings.maintenance.title')}</CardTitle>
+                  <CardDescription>{t('channels.dialogs.settings.maintenance.description')}</CardDescription>
+                </div>
+                <Button
+                  type='button'
+                  variant='outline'
+                  size='sm'
+                  onClick={() => {
+                    const startAt = new Date()
+                    setMaintenanceWindows([
+                      ...maintenanceWindows,
+                      { startAt, endAt: new Date(startAt.getTime() + 60 * 60 * 1000), reason: '' },
+                    ])
+                  }}
+                >
+                  <Plus size={16} />
+                </Button>
+              </div>
+            </CardHeader>
+            <CardContent className='space-y-2'>
+              {maintenanceWindows.map((item, index) => (
+                <div key={index} className='flex items-center gap-2'>
+                  <Input
+                    type='datetime-local'
+                    value={toDateTimeLocal(item.startAt)}
+                    onChange={(e) => e.target.value && updateMaintenanceWindow(index, { startAt: new Date(e.target.value) })}
+                  />
+                  <span className='text-muted-foreground'>-</span>
+                  <Input
+                    type='datetime-local'
+                    value={toDateTimeLocal(item.endAt)}
+                    onChange={(e) => e.target.value && updateMaintenanceWindow(index, { endAt: new Date(e.target.value) })}
+                  />
+                  <Input
+                    placeholder={t('channels.dialogs.settings.maintenance.reason')}
+                    value={item.reason ?? ''}
+                    onChange={(e) => updateMaintenanceWindow(index, { reason: e.target.value })}
+                  />
+                  {item.endAt < new Date() && (
+                    <Badge variant='secondary'>{t('channels.dialogs.settings.maintenance.ended')}</Badge>
+                  )}
+                  <Button
+                    type='button'
+                    variant='ghost'
+                    size='sm'
+                    onClick={() => setMaintenanceWindows(maintenanceWindows.filter((_, i) => i !== index))}
+                    className='text-destructive hover:text-destructive'
+                  >
+                    <X size={16} />
+                  </Button>
+                </div>
+              ))}
+            </CardContent>
+          </Card>
         </div>
 
         <DialogFooter>
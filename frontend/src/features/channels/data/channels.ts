@@ -37,6 +37,12 @@ const CHANNELS_QUERY = `
           baseURL
           name
           status
+          available
+          activeMaintenanceWindow {
+            startAt
+            endAt
+            reason
+          }
           supportedModels
           defaultTestModel
           settings {
@@ -44,6 +50,55 @@ const CHANNELS_QUERY = `
               from
               to
             }
+            promptCaching {
+              auto
+              ttl
+            }
+            imageFetch {
+              enabled
+              maxBytes
+              timeoutSeconds
+              maxDimension
+            }
+            overrides {
+              defaultParams { temperature topP presencePenalty frequencyPenalty seed reasoningEffort }
+              forcedParams { temperature topP presencePenalty frequencyPenalty seed reasoningEffort }
+              maxTemperature
+              maxTokens
+              systemPromptPrepend
+              systemPromptAppend
+              stripParams
+            }
+            extraHeaders { key value models }
+            bodyPatches { path value delete models }
+            transport {
+              proxyURL
+              caCert
+              insecureSkipVerify
+              maxIdleConns
+              maxIdleConnsPerHost
+              idleConnTimeoutSeconds
+              connectTimeoutSeconds
+              responseHeaderTimeoutSeconds
+            }
+            modelPrices {
+              model
+              inputPrice
+              outputPrice
+            }
+            schedule {
+              timezone
+              windows {
+                days
+                start
+                end
+              }
+            }
+            maintenanceWindows {
+              startAt
+              endAt
+              reason
+            }
           }
           orderingWeight
 
@@ -79,6 +134,55 @@ const CREATE_CHANNEL_MUTATION = `
           from
           to
         }
+        promptCaching {
+          auto
+          ttl
+        }
+        imageFetch {
+          enabled
+          maxBytes
+          timeoutSeconds
+          maxDimension
+        }
+        overrides {
+          defaultParams { temperature topP presencePenalty frequencyPenalty seed reasoningEffort }
+          forcedParams { temperature topP presencePenalty frequencyPenalty seed reasoningEffort }
+          maxTemperature
+          maxTokens
+          systemPromptPrepend
+          systemPromptAppend
+          stripParams
+        }
+        extraHeaders { key value models }
+        bodyPatches { path value delete models }
+        transport {
+          proxyURL
+          caCert
+          insecureSkipVerify
+          maxIdleConns
+          maxIdleConnsPerHost
+          idleConnTimeoutSeconds
+          connectTimeoutSeconds
+          responseHeaderTimeoutSeconds
+        }
+        modelPrices {
+          model
+          inputPrice
+          outputPrice
+        }
+        schedule {
+          timezone
+          windows {
+            days
+            start
+            end
+          }
+        }
+        maintenanceWindows {
+          startAt
+          endAt
+          reason
+        }
       }
       orderingWeight
     }
@@ -102,6 +206,55 @@ const UPDATE_CHANNEL_MUTATION = `
           from
           to
         }
+        promptCaching {
+          auto
+          ttl
+        }
+        imageFetch {
+          enabled
+          maxBytes
+          timeoutSeconds
+          maxDimension
+        }
+        overrides {
+          defaultParams { temperature topP presencePenalty frequencyPenalty seed reasoningEffort }
+          forcedParams { temperature topP presencePenalty frequencyPenalty seed reasoningEffort }
+          maxTemperature
+          maxTokens
+          systemPromptPrepend
+          systemPromptAppend
+          stripParams
+        }
+        extraHeaders { key value models }
+        bodyPatches { path value delete models }
+        transport {
+          proxyURL
+          caCert
+          insecureSkipVerify
+          maxIdleConns
+          maxIdleConnsPerHost
+          idleConnTimeoutSeconds
+          connectTimeoutSeconds
+          responseHeaderTimeoutSeconds
+        }
+        modelPrices {
+          model
+          inputPrice
+          outputPrice
+        }
+        schedule {
+          timezone
+          windows {
+            days
+            start
+            end
+          }
+        }
+        maintenanceWindows {
+          startAt
+          endAt
+          reason
+        }
       }
       orderingWeight
     }
@@ -150,6 +303,55 @@ const BULK_IMPORT_CHANNELS_MUTATION = `
             from
             to
           }
+          promptCaching {
+            auto
+            ttl
+          }
+          imageFetch {
+            enabled
+            maxBytes
+            timeoutSeconds
+            maxDimension
+          }
+          overrides {
+            defaultParams { temperature topP presencePenalty frequencyPenalty seed reasoningEffort }
+            forcedParams { temperature topP presencePenalty frequencyPenalty seed reasoningEffort }
+            maxTemperature
+            maxTokens
+            systemPromptPrepend
+            systemPromptAppend
+            stripParams
+          }
+          extraHeaders { key value models }
+          bodyPatches { path value delete models }
+          transport {
+            proxyURL
+            caCert
+            insecureSkipVerify
+            maxIdleConns
+            maxIdleConnsPerHost
+            idleConnTimeoutSeconds
+            connectTimeoutSeconds
+            responseHeaderTimeoutSeconds
+          }
+          modelPrices {
+            model
+            inputPrice
+            outputPrice
+          }
+          schedule {
+            timezone
+            windows {
+              days
+              start
+              end
+            }
+          }
+          maintenanceWindows {
+            startAt
+            endAt
+            reason
+          }
         }
       }
     }
@@ -177,6 +379,55 @@ const BULK_UPDATE_CHANNEL_ORDERING_MUTATION = `
             from
             to
           }
+          promptCaching {
+            auto
+            ttl
+          }
+          imageFetch {
+            enabled
+            maxBytes
+            timeoutSeconds
+            maxDimension
+          }
+          overrides {
+            defaultParams { temperature topP presencePenalty frequencyPenalty seed reasoningEffort }
+            forcedParams { temperature topP presencePenalty frequencyPenalty seed reasoningEffort }
+            maxTemperature
+            maxTokens
+            systemPromptPrepend
+            systemPromptAppend
+            stripParams
+          }
+          extraHeaders { key value models }
+          bodyPatches { path value delete models }
+          transport {
+            proxyURL
+            caCert
+            insecureSkipVerify
+            maxIdleConns
+            maxIdleConnsPerHost
+            idleConnTimeoutSeconds
+            connectTimeoutSeconds
+            responseHeaderTimeoutSeconds
+          }
+          modelPrices {
+            model
+            inputPrice
+            outputPrice
+          }
+          schedule {
+            timezone
+            windows {
+              days
+              start
+              end
+            }
+          }
+          maintenanceWindows {
+            startAt
+            endAt
+            reason
+          }
         }
       }
     }
@@ -33,9 +33,115 @@ export const modelMappingSchema = z.object({
 })
 export type ModelMapping = z.infer<typeof modelMappingSchema>
 
+// Prompt Caching Settings
+export const promptCachingSettingsSchema = z.object({
+  auto: z.boolean(),
+  ttl: z.string().optional().nullable(),
+})
+export type PromptCachingSettings = z.infer<typeof promptCachingSettingsSchema>
+
+// Image Fetch Settings
+export const imageFetchSettingsSchema = z.object({
+  enabled: z.boolean(),
+  maxBytes: z.number().optional().nullable(),
+  timeoutSeconds: z.number().optional().nullable(),
+  maxDimension: z.number().optional().nullable(),
+})
+export type ImageFetchSettings = z.infer<typeof imageFetchSettingsSchema>
+
+// Request Overrides
+export const requestParamsSchema = z.object({
+  temperature: z.number().optional().nullable(),
+  topP: z.number().optional().nullable(),
+  presencePenalty: z.number().optional().nullable(),
+  frequencyPenalty: z.number().optional().nullable(),
+  seed: z.number().optional().nullable(),
+  reasoningEffort: z.string().optional().nullable(),
+})
+export type RequestParams = z.infer<typeof requestParamsSchema>
+
+export const requestOverridesSchema = z.object({
+  defaultParams: requestParamsSchema.optional().nullable(),
+  forcedParams: requestParamsSchema.optional().nullable(),
+  maxTemperature: z.number().optional().nullable(),
+  maxTokens: z.number().optional().nullable(),
+  systemPromptPrepend: z.string().optional().nullable(),
+  systemPromptAppend: z.string().optional().nullable(),
+  stripParams: z.array(z.string()).optional().nullable(),
+})
+export type RequestOverrides = z.infer<typeof requestOverridesSchema>
+
+// Extra Headers and Body Patches
+export const extraHeaderSchema = z.object({
+  key: z.string(),
+  value: z.string(),
+  models: z.array(z.string()).optional().nullable(),
+})
+export type ExtraHeader = z.infer<typeof extraHeaderSchema>
+
+export const bodyPatchSchema = z.object({
+  path: z.string(),
+  value: z.string().optional().nullable(),
+  delete: z.boolean().optional().nullable(),
+  models: z.array(z.string()).optional().nullable(),
+})
+export type BodyPatch = z.infer<typeof bodyPatchSchema>
+
+// Transport Settings
+export const transportSettingsSchema = z.object({
+  proxyURL: z.string().optional().nullable(),
+  caCert: z.string().optional().nullable(),
+  insecureSkipVerify: z.boolean().optional().nullable(),
+  maxIdleConns: z.number().optional().nullable(),
+  maxIdleConnsPerHost: z.number().optional().nullable(),
+  idleConnTimeoutSeconds: z.number().optional().nullable(),
+  connectTimeoutSeconds: z.number().optional().nullable(),
+  responseHeaderTimeoutSeconds: z.number().optional().nullable(),
+})
+export type TransportSettings = z.infer<typeof transportSettingsSchema>
+
+// Model Price
+export const modelPriceSchema = z.object({
+  model: z.string(),
+  inputPrice: z.number(),
+  outputPrice: z.number(),
+})
+export type ModelPrice = z.infer<typeof modelPriceSchema>
+
+// Channel Schedule
+export const timeWindowSchema = z.object({
+  days: z.array(z.number()).optional().nullable(),
+  start: z.string(),
+  end: z.string(),
+})
+export type TimeWindow = z.infer<typeof timeWindowSchema>
+
+export const channelScheduleSchema = z.object({
+  timezone: z.string().optional().nullable(),
+  windows: z.array(timeWindowSchema),
+})
+export type ChannelSchedule = z.infer<typeof channelScheduleSchema>
+
+// Maintenance Window
+export const maintenanceWindowSchema = z.object({
+  startAt: z.coerce.date(),
+  endAt: z.coerce.date(),
+  reason: z.string().optional().nullable(),
+})
+export type MaintenanceWindow = z.infer<typeof maintenanceWindowSchema>
+
 // Channel Settings
 export const channelSettingsSchema = z.object({
   modelMappings: z.array(modelMappingSchema),
+  promptCaching: promptCachingSettingsSchema.optional().nullable(),
+  imageFetch: imageFetchSettingsSchema.optional().nullable(),
+  overrides: requestOverridesSchema.optional().nullable(),
+  extraHeaders: z.array(extraHeaderSchema).optional().nullable(),
+  bodyPatches: z.array(bodyPatchSchema).optional().nullable(),
+  transport: transportSettingsSchema.optional().nullable(),
+  modelPrices: z.array(modelPriceSchema).optional().nullable(),
+  schedule: channelScheduleSchema.optional().nullable(),
+  maintenanceWindows: z.array(maintenanceWindowSchema).optional().nullable(),
 })
 export type ChannelSettings = z.infer<typeof channelSettingsSchema>
 
@@ -52,6 +158,8 @@ export const channelSchema = z.object({
   defaultTestModel: z.string(),
   settings: channelSettingsSchema.optional().nullable(),
   orderingWeight: z.number().default(0),
+  available: z.boolean().optional(),
+  activeMaintenanceWindow: maintenanceWindowSchema.optional().nullable(),
 })
 export type Channel = z.infer<typeof channelSchema>
 
@@ -1,7 +1,7 @@
 import { format } from 'date-fns'
 import { useParams, useNavigate } from '@tanstack/react-router'
 import { zhCN, enUS } from 'date-fns/locale'
-import { Copy, Clock, User, Key, Database, ArrowLeft, FileText } from 'lucide-react'
+import { Copy, Clock, User, Key, Database, ArrowLeft, FileText, ShieldAlert, GitBranch } from 'lucide-react'
 import { useTranslation } from 'react-i18next'
 import { toast } from 'sonner'
 import { extractNumberID } from '@/lib/utils'
@@ -151,9 +151,12 @@ export default function RequestDetailPage() {
                   </div>
                   <span className='text-xl'>{t('requests.detail.overview')}</span>
                 </div>
-                <Badge className={getStatusColor(request.status)} variant='secondary'>
-                  {t(`requests.status.${request.status}`)}
-                </Badge>
+                <div className='flex items-center gap-2'>
+                  {request.cached && <Badge variant='outline'>{t('requests.cached')}</Badge>}
+                  <Badge className={getStatusColor(request.status)} variant='secondary'>
+                    {t(`requests.status.${request.status}`)}
+                  </Badge>
+                </div>
               </CardTitle>
             </CardHeader>
             <CardContent>
@@ -201,6 +204,39 @@ export default function RequestDetailPage() {
                   </p>
                 </div>
               </div>
+
+              {request.guardrailViolations && request.guardrailViolations.length > 0 && (
+                <div className='bg-muted/30 mt-4 space-y-3 rounded-lg border p-4'>
+                  <div className='flex items-center gap-2'>
+                    <ShieldAlert className='text-primary h-4 w-4' />
+                    <span className='text-sm font-medium'>{t('requests.guardrailViolations')}</span>
+                  </div>
+                  <div className='flex flex-wrap gap-2'>
+                    {request.guardrailViolations.map((violation, index) => (
+                      <Badge key={index} variant={violation.action === 'reject' ? 'destructive' : 'outline'}>
+                        {violation.rule} · {t(`system.guardrail.targets.${violation.target}`)} ·{' '}
+                        {t(`system.guardrail.actions.${violation.action}`)} × {violation.count}
+                      </Badge>
+                    ))}
+                  </div>
+                </div>
+              )}
+
+              {request.fallbackHops && request.fallbackHops.length > 0 && (
+                <div className='bg-muted/30 mt-4 space-y-3 rounded-lg border p-4'>
+                  <div className='flex items-center gap-2'>
+                    <GitBranch className='text-primary h-4 w-4' />
+                    <span className='text-sm font-medium'>{t('requests.fallbackHops')}</span>
+                  </div>
+                  <div className='flex flex-wrap gap-2'>
+                    {request.fallbackHops.map((hop, index) => (
+                      <Badge key={index} variant='outline' className='font-mono'>
+                        {hop.from} → {hop.to}
+                      </Badge>
+                    ))}
+                  </div>
+                </div>
+              )}
             </CardContent>
           </Card>
 
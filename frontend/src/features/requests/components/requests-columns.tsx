@@ -47,7 +47,12 @@ export function useRequestsColumns(): ColumnDef<Request>[] {
       enableSorting: false,
       cell: ({ row }) => {
         const status = row.getValue('status') as string
-        return <Badge className={getStatusColor(status)}>{t(`requests.status.${status}`)}</Badge>
+        return (
+          <div className='flex items-center gap-1'>
+            <Badge className={getStatusColor(status)}>{t(`requests.status.${status}`)}</Badge>
+            {row.original.cached && <Badge variant='outline'>{t('requests.cached')}</Badge>}
+          </div>
+        )
       },
       filterFn: (row, id, value) => {
         return value.includes(row.getValue(id))
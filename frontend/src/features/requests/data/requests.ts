@@ -50,6 +50,7 @@ function buildRequestsQuery(permissions: { canViewUsers: boolean; canViewApiKeys
             requestBody
             responseBody
             status
+            cached
             executions(first: 10, orderBy: { field: CREATED_AT, direction: DESC }) {
               edges {
                 node {
@@ -126,6 +127,18 @@ function buildRequestDetailQuery(permissions: { canViewUsers: boolean; canViewAp
           requestBody
           responseBody
           status
+          cached
+          guardrailViolations {
+            rule
+            type
+            action
+            target
+            count
+          }
+          fallbackHops {
+            from
+            to
+          }
           executions(first: 100, orderBy: { field: CREATED_AT, direction: DESC }) {
             edges {
               node {
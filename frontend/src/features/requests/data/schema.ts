@@ -68,6 +68,28 @@ export const requestSchema = z.object({
   requestBody: z.any(), // JSONRawMessage
   responseBody: z.any().nullable(), // JSONRawMessage
   status: requestStatusSchema,
+  cached: z.boolean().optional(),
+  guardrailViolations: z
+    .array(
+      z.object({
+        rule: z.string(),
+        type: z.string(),
+        action: z.string(),
+        target: z.string(),
+        count: z.number(),
+      })
+    )
+    .nullable()
+    .optional(),
+  fallbackHops: z
+    .array(
+      z.object({
+        from: z.string(),
+        to: z.string(),
+      })
+    )
+    .nullable()
+    .optional(),
   executions: z
     .object({
       edges: z.array(
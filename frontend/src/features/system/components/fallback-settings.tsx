@@ -0,0 +1,144 @@
+'use client'
+
+import React, { useState } from 'react'
+import { Loader2, Plus, Save, Trash2 } from 'lucide-react'
+import { useTranslation } from 'react-i18next'
+import { Button } from '@/components/ui/button'
+import {
+  Card,
+  CardContent,
+  CardDescription,
+  CardHeader,
+  CardTitle,
+} from '@/components/ui/card'
+import { Input } from '@/components/ui/input'
+import { useSystemContext } from '../context/system-context'
+import { useModelFallbacks, useUpdateModelFallbacks, ModelFallback } from '../data/system'
+
+export function FallbackSettings() {
+  const { t } = useTranslation()
+  const { data: modelFallbacks, isLoading: isLoadingModelFallbacks } = useModelFallbacks()
+  const updateModelFallbacks = useUpdateModelFallbacks()
+  const { isLoading, setIsLoading } = useSystemContext()
+
+  const [chains, setChains] = useState<ModelFallback[]>(modelFallbacks ?? [])
+
+  // Update local state when model fallbacks are loaded
+  React.useEffect(() => {
+    if (modelFallbacks) {
+      setChains(modelFallbacks)
+    }
+  }, [modelFallbacks])
+
+  const handleSave = async () => {
+    setIsLoading(true)
+    try {
+      await updateModelFallbacks.mutateAsync(
+        chains.map((chain) => ({
+          model: chain.model.trim(),
+          fallbacks: chain.fallbacks.filter((model) => model !== ''),
+        }))
+      )
+    } finally {
+      setIsLoading(false)
+    }
+  }
+
+  const handleChainChange = (index: number, changes: Partial<ModelFallback>) => {
+    const updated = [...chains]
+    updated[index] = { ...updated[index], ...changes }
+    setChains(updated)
+  }
+
+  const hasChanges = modelFallbacks && JSON.stringify(modelFallbacks) !== JSON.stringify(chains)
+
+  if (isLoadingModelFallbacks) {
+    return (
+      <div className='flex h-32 items-center justify-center'>
+        <Loader2 className='h-6 w-6 animate-spin' />
+        <span className='text-muted-foreground ml-2'>
+          {t('loading')}
+        </span>
+      </div>
+    )
+  }
+
+  return (
+    <div className='space-y-6'>
+      <Card>
+        <CardHeader>
+          <CardTitle>{t('system.fallback.title')}</CardTitle>
+          <CardDescription>{t('system.fallback.description')}</CardDescription>
+        </CardHeader>
+        <CardContent className='space-y-4'>
+          <div className='flex justify-end'>
+            <Button
+              variant='outline'
+              size='sm'
+              onClick={() => setChains([...chains, { model: '', fallbacks: [] }])}
+              disabled={isLoading}
+            >
+              <Plus className='mr-2 h-4 w-4' />
+              {t('system.fallback.addChain')}
+            </Button>
+          </div>
+          {chains.length === 0 && (
+            <div className='text-muted-foreground text-sm'>{t('system.fallback.noChains')}</div>
+          )}
+          {chains.map((chain, index) => (
+            <div key={index} className='flex items-center gap-2'>
+              <Input
+                className='w-64'
+                placeholder={t('system.fallback.model')}
+                value={chain.model}
+                onChange={(e) => handleChainChange(index, { model: e.target.value })}
+                disabled={isLoading}
+              />
+              <span className='text-muted-foreground'>→</span>
+              <Input
+                placeholder={t('system.fallback.fallbacks')}
+                value={chain.fallbacks.join(', ')}
+                onChange={(e) =>
+                  handleChainChange(index, {
+                    fallbacks: e.target.value.split(',').map((model) => model.trim()),
+                  })
+                }
+                disabled={isLoading}
+              />
+              <Button
+                variant='ghost'
+                size='icon'
+                onClick={() => setChains(chains.filter((_, i) => i !== index))}
+                disabled={isLoading}
+              >
+                <Trash2 className='h-4 w-4' />
+              </Button>
+            </div>
+          ))}
+        </CardContent>
+      </Card>
+
+      {hasChanges && (
+        <div className='flex justify-end'>
+          <Button
+            onClick={handleSave}
+            disabled={isLoading || updateModelFallbacks.isPending}
+            className='min-w-[100px]'
+          >
+            {isLoading || updateModelFallbacks.isPending ? (
+              <>
+                <Loader2 className='mr-2 h-4 w-4 animate-spin' />
+                {t('system.buttons.saving')}
+              </>
+            ) : (
+              <>
+                <Save className='mr-2 h-4 w-4' />
+                {t('system.buttons.save')}
+              </>
+            )}
+          </Button>
+        </div>
+      )}
+    </div>
+  )
+}
@@ -0,0 +1,240 @@
+'use client'
+
+import React, { useState } from 'react'
+import { Loader2, Plus, Save, Trash2 } from 'lucide-react'
+import { useTranslation } from 'react-i18next'
+import { Button } from '@/components/ui/button'
+import {
+  Card,
+  CardContent,
+  CardDescription,
+  CardHeader,
+  CardTitle,
+} from '@/components/ui/card'
+import { Input } from '@/components/ui/input'
+import { Label } from '@/components/ui/label'
+import { Select, SelectContent, SelectItem, SelectTrigger, SelectValue } from '@/components/ui/select'
+import { Switch } from '@/components/ui/switch'
+import { useSystemContext } from '../context/system-context'
+import {
+  useGuardrailPolicy,
+  useUpdateGuardrailPolicy,
+  GuardrailPolicy,
+  GuardrailRule,
+} from '../data/system'
+
+const RULE_TYPES = ['keyword', 'regex', 'email', 'phone', 'credit_card', 'id_number'] as const
+const ACTIONS = ['mask', 'reject'] as const
+const TARGETS = ['both', 'request', 'response'] as const
+
+const hasPatterns = (rule: GuardrailRule) => rule.type === 'keyword' || rule.type === 'regex'
+
+export function GuardrailSettings() {
+  const { t } = useTranslation()
+  const { data: guardrailPolicy, isLoading: isLoadingGuardrailPolicy } = useGuardrailPolicy()
+  const updateGuardrailPolicy = useUpdateGuardrailPolicy()
+  const { isLoading, setIsLoading } = useSystemContext()
+
+  const [policyState, setPolicyState] = useState<GuardrailPolicy>({
+    enabled: guardrailPolicy?.enabled ?? false,
+    rules: guardrailPolicy?.rules ?? [],
+  })
+
+  // Update local state when guardrail policy is loaded
+  React.useEffect(() => {
+    if (guardrailPolicy) {
+      setPolicyState(guardrailPolicy)
+    }
+  }, [guardrailPolicy])
+
+  const handleSave = async () => {
+    setIsLoading(true)
+    try {
+      await updateGuardrailPolicy.mutateAsync({
+        enabled: policyState.enabled,
+        rules: policyState.rules.map((rule) => ({
+          name: rule.name || undefined,
+          type: rule.type,
+          patterns: hasPatterns(rule) ? rule.patterns?.filter((pattern) => pattern !== '') : undefined,
+          action: rule.action || 'mask',
+          target: rule.target || 'both',
+        })),
+      })
+    } finally {
+      setIsLoading(false)
+    }
+  }
+
+  const handleRuleChange = (index: number, changes: Partial<GuardrailRule>) => {
+    const rules = [...policyState.rules]
+    rules[index] = { ...rules[index], ...changes }
+    setPolicyState({ ...policyState, rules })
+  }
+
+  const handleAddRule = () => {
+    setPolicyState({
+      ...policyState,
+      rules: [...policyState.rules, { type: 'email', action: 'mask', target: 'both' }],
+    })
+  }
+
+  const handleRemoveRule = (index: number) => {
+    setPolicyState({
+      ...policyState,
+      rules: policyState.rules.filter((_, i) => i !== index),
+    })
+  }
+
+  const hasChanges = guardrailPolicy && JSON.stringify(guardrailPolicy) !== JSON.stringify(policyState)
+
+  if (isLoadingGuardrailPolicy) {
+    return (
+      <div className='flex h-32 items-center justify-center'>
+        <Loader2 className='h-6 w-6 animate-spin' />
+        <span className='text-muted-foreground ml-2'>
+          {t('loading')}
+        </span>
+      </div>
+    )
+  }
+
+  return (
+    <div className='space-y-6'>
+      <Card>
+        <CardHeader>
+          <CardTitle>{t('system.guardrail.title')}</CardTitle>
+          <CardDescription>{t('system.guardrail.description')}</CardDescription>
+        </CardHeader>
+        <CardContent className='space-y-6'>
+          <div className='flex items-center justify-between'>
+            <div className='space-y-0.5'>
+              <Label htmlFor='guardrail-enabled'>{t('system.guardrail.enabled.label')}</Label>
+              <div className='text-muted-foreground text-sm'>
+                {t('system.guardrail.enabled.description')}
+              </div>
+            </div>
+            <Switch
+              id='guardrail-enabled'
+              checked={policyState.enabled}
+              onCheckedChange={(checked) => setPolicyState({ ...policyState, enabled: checked })}
+              disabled={isLoading}
+            />
+          </div>
+
+          <div className='space-y-4'>
+            <div className='flex items-center justify-between'>
+              <div className='text-lg font-medium'>{t('system.guardrail.rules')}</div>
+              <Button variant='outline' size='sm' onClick={handleAddRule} disabled={isLoading}>
+                <Plus className='mr-2 h-4 w-4' />
+                {t('system.guardrail.addRule')}
+              </Button>
+            </div>
+            {policyState.rules.length === 0 && (
+              <div className='text-muted-foreground text-sm'>{t('system.guardrail.noRules')}</div>
+            )}
+            {policyState.rules.map((rule, index) => (
+              <div key={index} className='flex flex-col gap-4 rounded-lg border p-4'>
+                <div className='flex items-center gap-2'>
+                  <Input
+                    placeholder={t('system.guardrail.name')}
+                    value={rule.name ?? ''}
+                    onChange={(e) => handleRuleChange(index, { name: e.target.value })}
+                    disabled={isLoading}
+                  />
+                  <Select
+                    value={rule.type}
+                    onValueChange={(value) => handleRuleChange(index, { type: value as GuardrailRule['type'] })}
+                    disabled={isLoading}
+                  >
+                    <SelectTrigger className='w-48'>
+                      <SelectValue />
+                    </SelectTrigger>
+                    <SelectContent>
+                      {RULE_TYPES.map((type) => (
+                        <SelectItem key={type} value={type}>
+                          {t(`system.guardrail.types.${type}`)}
+                        </SelectItem>
+                      ))}
+                    </SelectContent>
+                  </Select>
+                  <Select
+                    value={rule.action || 'mask'}
+                    onValueChange={(value) => handleRuleChange(index, { action: value as GuardrailRule['action'] })}
+                    disabled={isLoading}
+                  >
+                    <SelectTrigger className='w-32'>
+                      <SelectValue />
+                    </SelectTrigger>
+                    <SelectContent>
+                      {ACTIONS.map((action) => (
+                        <SelectItem key={action} value={action}>
+                          {t(`system.guardrail.actions.${action}`)}
+                        </SelectItem>
+                      ))}
+                    </SelectContent>
+                  </Select>
+                  <Select
+                    value={rule.target || 'both'}
+                    onValueChange={(value) => handleRuleChange(index, { target: value as GuardrailRule['target'] })}
+                    disabled={isLoading}
+                  >
+                    <SelectTrigger className='w-36'>
+                      <SelectValue />
+                    </SelectTrigger>
+                    <SelectContent>
+                      {TARGETS.map((target) => (
+                        <SelectItem key={target} value={target}>
+                          {t(`system.guardrail.targets.${target}`)}
+                        </SelectItem>
+                      ))}
+                    </SelectContent>
+                  </Select>
+                  <Button variant='ghost' size='icon' onClick={() => handleRemoveRule(index)} disabled={isLoading}>
+                    <Trash2 className='h-4 w-4' />
+                  </Button>
+                </div>
+                {hasPatterns(rule) && (
+                  <div className='space-y-1'>
+                    <Input
+                      placeholder={t(`system.guardrail.patterns.${rule.type}`)}
+                      value={(rule.patterns ?? []).join(', ')}
+                      onChange={(e) =>
+                        handleRuleChange(index, {
+                          patterns: e.target.value.split(',').map((pattern) => pattern.trim()),
+                        })
+                      }
+                      disabled={isLoading}
+                    />
+                    <div className='text-muted-foreground text-xs'>{t('system.guardrail.patterns.description')}</div>
+                  </div>
+                )}
+              </div>
+            ))}
+          </div>
+        </CardContent>
+      </Card>
+
+      {hasChanges && (
+        <div className='flex justify-end'>
+          <Button
+            onClick={handleSave}
+            disabled={isLoading || updateGuardrailPolicy.isPending}
+            className='min-w-[100px]'
+          >
+            {isLoading || updateGuardrailPolicy.isPending ? (
+              <>
+                <Loader2 className='mr-2 h-4 w-4 animate-spin' />
+                {t('system.buttons.saving')}
+              </>
+            ) : (
+              <>
+                <Save className='mr-2 h-4 w-4' />
+                {t('system.buttons.save')}
+              </>
+            )}
+          </Button>
+        </div>
+      )}
+    </div>
+  )
+}
@@ -0,0 +1,125 @@
+'use client'
+
+import React, { useState } from 'react'
+import { Loader2, Save } from 'lucide-react'
+import { useTranslation } from 'react-i18next'
+import { Button } from '@/components/ui/button'
+import {
+  Card,
+  CardContent,
+  CardDescription,
+  CardHeader,
+  CardTitle,
+} from '@/components/ui/card'
+import { Input } from '@/components/ui/input'
+import { Label } from '@/components/ui/label'
+import { Switch } from '@/components/ui/switch'
+import { useSystemContext } from '../context/system-context'
+import { useHedgingPolicy, useUpdateHedgingPolicy, HedgingPolicy } from '../data/system'
+
+export function HedgingSettings() {
+  const { t } = useTranslation()
+  const { data: hedgingPolicy, isLoading: isLoadingHedgingPolicy } = useHedgingPolicy()
+  const updateHedgingPolicy = useUpdateHedgingPolicy()
+  const { isLoading, setIsLoading } = useSystemContext()
+
+  const [policyState, setPolicyState] = useState<HedgingPolicy>({
+    enabled: hedgingPolicy?.enabled ?? false,
+    delayMs: hedgingPolicy?.delayMs ?? 0,
+  })
+
+  // Update local state when hedging policy is loaded
+  React.useEffect(() => {
+    if (hedgingPolicy) {
+      setPolicyState(hedgingPolicy)
+    }
+  }, [hedgingPolicy])
+
+  const handleSave = async () => {
+    setIsLoading(true)
+    try {
+      await updateHedgingPolicy.mutateAsync(policyState)
+    } finally {
+      setIsLoading(false)
+    }
+  }
+
+  const hasChanges =
+    hedgingPolicy &&
+    (hedgingPolicy.enabled !== policyState.enabled || hedgingPolicy.delayMs !== policyState.delayMs)
+
+  if (isLoadingHedgingPolicy) {
+    return (
+      <div className='flex h-32 items-center justify-center'>
+        <Loader2 className='h-6 w-6 animate-spin' />
+        <span className='text-muted-foreground ml-2'>
+          {t('loading')}
+        </span>
+      </div>
+    )
+  }
+
+  return (
+    <div className='space-y-6'>
+      <Card>
+        <CardHeader>
+          <CardTitle>{t('system.hedging.title')}</CardTitle>
+          <CardDescription>{t('system.hedging.description')}</CardDescription>
+        </CardHeader>
+        <CardContent className='space-y-6'>
+          <div className='flex items-center justify-between'>
+            <div className='space-y-0.5'>
+              <Label htmlFor='hedging-enabled'>{t('system.hedging.enabled.label')}</Label>
+              <div className='text-muted-foreground text-sm'>
+                {t('system.hedging.enabled.description')}
+              </div>
+            </div>
+            <Switch
+              id='hedging-enabled'
+              checked={policyState.enabled}
+              onCheckedChange={(checked) => setPolicyState({ ...policyState, enabled: checked })}
+              disabled={isLoading}
+            />
+          </div>
+
+          <div className='space-y-2'>
+            <Label htmlFor='hedging-delay'>{t('system.hedging.delayMs')}</Label>
+            <Input
+              id='hedging-delay'
+              type='number'
+              min={0}
+              className='w-48'
+              value={policyState.delayMs}
+              onChange={(e) =>
+                setPolicyState({ ...policyState, delayMs: parseInt(e.target.value, 10) || 0 })
+              }
+              disabled={isLoading}
+            />
+          </div>
+        </CardContent>
+      </Card>
+
+      {hasChanges && (
+        <div className='flex justify-end'>
+          <Button
+            onClick={handleSave}
+            disabled={isLoading || updateHedgingPolicy.isPending}
+            className='min-w-[100px]'
+          >
+            {isLoading || updateHedgingPolicy.isPending ? (
+              <>
+                <Loader2 className='mr-2 h-4 w-4 animate-spin' />
+                {t('system.buttons.saving')}
+              </>
+            ) : (
+              <>
+                <Save className='mr-2 h-4 w-4' />
+                {t('system.buttons.save')}
+              </>
+            )}
+          </Button>
+        </div>
+      )}
+    </div>
+  )
+}
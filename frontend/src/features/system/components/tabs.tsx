@@ -4,6 +4,9 @@ import React, { useState } from 'react'
 import { useTranslation } from 'react-i18next'
 import { Tabs, TabsList, TabsTrigger, TabsContent } from '@/components/ui/tabs'
 import { BrandSettings } from './brand-settings'
+import { FallbackSettings } from './fallback-settings'
+import { GuardrailSettings } from './guardrail-settings'
+import { HedgingSettings } from './hedging-settings'
 import { StorageSettings } from './storage-settings'
 
 export function SystemSettingsTabs() {
@@ -12,9 +15,12 @@ export function SystemSettingsTabs() {
 
   return (
     <Tabs value={activeTab} onValueChange={setActiveTab} className="w-full">
-      <TabsList className="grid w-full grid-cols-2">
+      <TabsList className="grid w-full grid-cols-5">
         <TabsTrigger value="brand">{t('system.tabs.brand')}</TabsTrigger>
         <TabsTrigger value="storage">{t('system.tabs.storage')}</TabsTrigger>
+        <TabsTrigger value="guardrail">{t('system.tabs.guardrail')}</TabsTrigger>
+        <TabsTrigger value="fallback">{t('system.tabs.fallback')}</TabsTrigger>
+        <TabsTrigger value="hedging">{t('system.tabs.hedging')}</TabsTrigger>
       </TabsList>
       <TabsContent value="brand" className="mt-6">
         <BrandSettings />
@@ -22,6 +28,15 @@ export function SystemSettingsTabs() {
       <TabsContent value="storage" className="mt-6">
         <StorageSettings />
       </TabsContent>
+      <TabsContent value="guardrail" className="mt-6">
+        <GuardrailSettings />
+      </TabsContent>
+      <TabsContent value="fallback" className="mt-6">
+        <FallbackSettings />
+      </TabsContent>
+      <TabsContent value="hedging" className="mt-6">
+        <HedgingSettings />
+      </TabsContent>
     </Tabs>
   )
 }
\ No newline at end of file
@@ -29,6 +29,39 @@ const STORAGE_POLICY_QUERY = `
   }
 `
 
+const GUARDRAIL_POLICY_QUERY = `
+  query GuardrailPolicy {
+    guardrailPolicy {
+      enabled
+      rules {
+        name
+        type
+        patterns
+        action
+        target
+      }
+    }
+  }
+`
+
+const MODEL_FALLBACKS_QUERY = `
+  query ModelFallbacks {
+    modelFallbacks {
+      model
+      fallbacks
+    }
+  }
+`
+
+const HEDGING_POLICY_QUERY = `
+  query HedgingPolicy {
+    hedgingPolicy {
+      enabled
+      delayMs
+    }
+  }
+`
+
 const UPDATE_BRAND_SETTINGS_MUTATION = `
   mutation UpdateBrandSettings($input: UpdateBrandSettingsInput!) {
     updateBrandSettings(input: $input)
@@ -41,6 +74,24 @@ const UPDATE_STORAGE_POLICY_MUTATION = `
   }
 `
 
+const UPDATE_GUARDRAIL_POLICY_MUTATION = `
+  mutation UpdateGuardrailPolicy($input: GuardrailPolicyInput!) {
+    updateGuardrailPolicy(input: $input)
+  }
+`
+
+const UPDATE_MODEL_FALLBACKS_MUTATION = `
+  mutation UpdateModelFallbacks($input: [ModelFallbackInput!]!) {
+    updateModelFallbacks(input: $input)
+  }
+`
+
+const UPDATE_HEDGING_POLICY_MUTATION = `
+  mutation UpdateHedgingPolicy($input: HedgingPolicyInput!) {
+    updateHedgingPolicy(input: $input)
+  }
+`
+
 // Types
 export interface BrandSettings {
   brandName?: string
@@ -60,6 +111,31 @@ export interface CleanupOption {
   cleanupDays: number
 }
 
+export type GuardrailRuleType = 'keyword' | 'regex' | 'email' | 'phone' | 'credit_card' | 'id_number'
+
+export interface GuardrailRule {
+  name?: string
+  type: GuardrailRuleType
+  patterns?: string[]
+  action?: 'mask' | 'reject'
+  target?: 'request' | 'response' | 'both'
+}
+
+export interface GuardrailPolicy {
+  enabled: boolean
+  rules: GuardrailRule[]
+}
+
+export interface ModelFallback {
+  model: string
+  fallbacks: string[]
+}
+
+export interface HedgingPolicy {
+  enabled: boolean
+  delayMs: number
+}
+
 export interface UpdateBrandSettingsInput {
   brandName?: string
   brandLogo?: string
@@ -117,6 +193,63 @@ export function useStoragePolicy() {
   })
 }
 
+export function useGuardrailPolicy() {
+  const { handleError } = useErrorHandler()
+
+  return useQuery({
+    queryKey: ['guardrailPolicy'],
+    queryFn: async () => {
+      try {
+        const data = await graphqlRequest<{ guardrailPolicy: GuardrailPolicy }>(
+          GUARDRAIL_POLICY_QUERY
+        )
+        return { ...data.guardrailPolicy, rules: data.guardrailPolicy.rules ?? [] }
+      } catch (error) {
+        handleError(error, '获取防护策略')
+        throw error
+      }
+    },
+  })
+}
+
+export function useModelFallbacks() {
+  const { handleError } = useErrorHandler()
+
+  return useQuery({
+    queryKey: ['modelFallbacks'],
+    queryFn: async () => {
+      try {
+        const data = await graphqlRequest<{ modelFallbacks: ModelFallback[] }>(
+          MODEL_FALLBACKS_QUERY
+        )
+        return data.modelFallbacks
+      } catch (error) {
+        handleError(error, '获取模型降级')
+        throw error
+      }
+    },
+  })
+}
+
+export function useHedgingPolicy() {
+  const { handleError } = useErrorHandler()
+
+  return useQuery({
+    queryKey: ['hedgingPolicy'],
+    queryFn: async () => {
+      try {
+        const data = await graphqlRequest<{ hedgingPolicy: HedgingPolicy }>(
+          HEDGING_POLICY_QUERY
+        )
+        return data.hedgingPolicy
+      } catch (error) {
+        handleError(error, '获取对冲请求策略')
+        throw error
+      }
+    },
+  })
+}
+
 export function useUpdateBrandSettings() {
   const queryClient = useQueryClient()
   
@@ -157,4 +290,67 @@ export function useUpdateStoragePolicy() {
       toast.error(i18n.t('common.errors.systemUpdateFailed'))
     },
   })
-}
\ No newline at end of file
+}
+
+export function useUpdateGuardrailPolicy() {
+  const queryClient = useQueryClient()
+
+  return useMutation({
+    mutationFn: async (input: GuardrailPolicy) => {
+      const data = await graphqlRequest<{ updateGuardrailPolicy: boolean }>(
+        UPDATE_GUARDRAIL_POLICY_MUTATION,
+        { input }
+      )
+      return data.updateGuardrailPolicy
+    },
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: ['guardrailPolicy'] })
+      toast.success(i18n.t('common.success.systemUpdated'))
+    },
+    onError: (error: any) => {
+      toast.error(error?.message || i18n.t('common.errors.systemUpdateFailed'))
+    },
+  })
+}
+
+export function useUpdateModelFallbacks() {
+  const queryClient = useQueryClient()
+
+  return useMutation({
+    mutationFn: async (input: ModelFallback[]) => {
+      const data = await graphqlRequest<{ updateModelFallbacks: boolean }>(
+        UPDATE_MODEL_FALLBACKS_MUTATION,
+        { input }
+      )
+      return data.updateModelFallbacks
+    },
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: ['modelFallbacks'] })
+      toast.success(i18n.t('common.success.systemUpdated'))
+    },
+    onError: (error: any) => {
+      toast.error(error?.message || i18n.t('common.errors.systemUpdateFailed'))
+    },
+  })
+}
+
+export function useUpdateHedgingPolicy() {
+  const queryClient = useQueryClient()
+
+  return useMutation({
+    mutationFn: async (input: HedgingPolicy) => {
+      const data = await graphqlRequest<{ updateHedgingPolicy: boolean }>(
+        UPDATE_HEDGING_POLICY_MUTATION,
+        { input }
+      )
+      return data.updateHedgingPolicy
+    },
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: ['hedgingPolicy'] })
+      toast.success(i18n.t('common.success.systemUpdated'))
+    },
+    onError: (error: any) => {
+      toast.error(error?.message || i18n.t('common.errors.systemUpdateFailed'))
+    },
+  })
+}
@@ -163,7 +163,12 @@ export function UsageDetailDialog({
             <TabsContent value='tokens' className='space-y-4'>
               <div className='grid grid-cols-1 gap-6'>
                 <div className='space-y-4'>
-                  <h4 className='text-sm font-medium'>{t('usageLogs.dialogs.usageDetail.tokenDetails.basic')}</h4>
+                  <div className='flex items-center gap-2'>
+                    <h4 className='text-sm font-medium'>{t('usageLogs.dialogs.usageDetail.tokenDetails.basic')}</h4>
+                    {usageLog.estimated && (
+                      <Badge variant='outline'>{t('usageLogs.dialogs.usageDetail.tokenDetails.estimated')}</Badge>
+                    )}
+                  </div>
                   <div className='grid grid-cols-3 gap-4'>
                     <div className='text-center p-4 border rounded-lg'>
                       <div className='text-2xl font-bold text-blue-600 dark:text-blue-400'>
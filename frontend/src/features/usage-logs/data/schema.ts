@@ -28,6 +28,7 @@ export const usageLogSchema = z.object({
   completionReasoningTokens: z.number().nullable().optional(),
   completionAcceptedPredictionTokens: z.number().nullable().optional(),
   completionRejectedPredictionTokens: z.number().nullable().optional(),
+  estimated: z.boolean().optional(),
   source: usageLogSourceSchema,
   format: z.string(),
 })
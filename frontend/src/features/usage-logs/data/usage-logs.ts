@@ -46,6 +46,7 @@ function buildUsageLogsQuery(permissions: { canViewUsers: boolean; canViewChanne
             completionReasoningTokens
             completionAcceptedPredictionTokens
             completionRejectedPredictionTokens
+            estimated
             source
             format
           }
@@ -97,6 +98,7 @@ function buildUsageLogDetailQuery(permissions: { canViewUsers: boolean; canViewC
           completionReasoningTokens
           completionAcceptedPredictionTokens
           completionRejectedPredictionTokens
+          estimated
           source
           format
         }
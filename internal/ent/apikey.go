@@ -28,14 +28,38 @@ type APIKey struct {
 	DeletedAt int `json:"deleted_at,omitempty"`
 	// UserID holds the value of the "user_id" field.
 	UserID int `json:"user_id,omitempty"`
-	// Key holds the value of the "key" field.
-	Key string `json:"key,omitempty"`
+	// The salted hash of the API key, the plain key is only returned once on creation.
+	Key string `json:"-"`
+	// The visible prefix of the API key for display.
+	KeyPrefix string `json:"key_prefix,omitempty"`
+	// The last time the current key was used.
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	// The salted hash of the key before the last rotation, it is valid until the grace period ends.
+	PreviousKey string `json:"-"`
+	// The visible prefix of the key before the last rotation.
+	PreviousKeyPrefix string `json:"previous_key_prefix,omitempty"`
+	// The end of the grace period of the key before the last rotation.
+	PreviousKeyExpiresAt *time.Time `json:"previous_key_expires_at,omitempty"`
+	// The last time the key before the last rotation was used.
+	PreviousKeyLastUsedAt *time.Time `json:"previous_key_last_used_at,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
 	// Status holds the value of the "status" field.
 	Status apikey.Status `json:"status,omitempty"`
 	// API Key specific scopes: read_channels, write_requests, etc.
 	Scopes []string `json:"-"`
+	// The API key can not be used after the expiration time, never expires if not set.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// The CIDRs or IPs the API key can be used from, no restriction if empty.
+	AllowedCidrs []string `json:"allowed_cidrs,omitempty"`
+	// The model patterns the API key can request, supports wildcard and regex, no restriction if empty.
+	AllowedModels []string `json:"allowed_models,omitempty"`
+	// The response cache mode, opt_in caches the requests with the `AH-Cache: true` header, always caches the requests unless the `AH-Cache: false` header is present.
+	CacheMode apikey.CacheMode `json:"cache_mode,omitempty"`
+	// The API keys with the same cache scope share the cached responses, default to the API key itself.
+	CacheScope string `json:"cache_scope,omitempty"`
+	// The guardrail rules applied to the requests of the API key, in addition to the global guardrail policy.
+	GuardrailPolicy *objects.GuardrailPolicy `json:"guardrail_policy,omitempty"`
 	// Profiles holds the value of the "profiles" field.
 	Profiles *objects.APIKeyProfiles `json:"profiles,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -84,13 +108,13 @@ func (*APIKey) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case apikey.FieldScopes, apikey.FieldProfiles:
+		case apikey.FieldScopes, apikey.FieldAllowedCidrs, apikey.FieldAllowedModels, apikey.FieldGuardrailPolicy, apikey.FieldProfiles:
 			values[i] = new([]byte)
 		case apikey.FieldID, apikey.FieldDeletedAt, apikey.FieldUserID:
 			values[i] = new(sql.NullInt64)
-		case apikey.FieldKey, apikey.FieldName, apikey.FieldStatus:
+		case apikey.FieldKey, apikey.FieldKeyPrefix, apikey.FieldPreviousKey, apikey.FieldPreviousKeyPrefix, apikey.FieldName, apikey.FieldStatus, apikey.FieldCacheMode, apikey.FieldCacheScope:
 			values[i] = new(sql.NullString)
-		case apikey.FieldCreatedAt, apikey.FieldUpdatedAt:
+		case apikey.FieldCreatedAt, apikey.FieldUpdatedAt, apikey.FieldLastUsedAt, apikey.FieldPreviousKeyExpiresAt, apikey.FieldPreviousKeyLastUsedAt, apikey.FieldExpiresAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -143,6 +167,45 @@ func (ak *APIKey) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				ak.Key = value.String
 			}
+		case apikey.FieldKeyPrefix:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key_prefix", values[i])
+			} else if value.Valid {
+				ak.KeyPrefix = value.String
+			}
+		case apikey.FieldLastUsedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_used_at", values[i])
+			} else if value.Valid {
+				ak.LastUsedAt = new(time.Time)
+				*ak.LastUsedAt = value.Time
+			}
+		case apikey.FieldPreviousKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field previous_key", values[i])
+			} else if value.Valid {
+				ak.PreviousKey = value.String
+			}
+		case apikey.FieldPreviousKeyPrefix:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field previous_key_prefix", values[i])
+			} else if value.Valid {
+				ak.PreviousKeyPrefix = value.String
+			}
+		case apikey.FieldPreviousKeyExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field previous_key_expires_at", values[i])
+			} else if value.Valid {
+				ak.PreviousKeyExpiresAt = new(time.Time)
+				*ak.PreviousKeyExpiresAt = value.Time
+			}
+		case apikey.FieldPreviousKeyLastUsedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field previous_key_last_used_at", values[i])
+			} else if value.Valid {
+				ak.PreviousKeyLastUsedAt = new(time.Time)
+				*ak.PreviousKeyLastUsedAt = value.Time
+			}
 		case apikey.FieldName:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field name", values[i])
@@ -163,6 +226,49 @@ func (ak *APIKey) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field scopes: %w", err)
 				}
 			}
+		case apikey.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				ak.ExpiresAt = new(time.Time)
+				*ak.ExpiresAt = value.Time
+			}
+		case apikey.FieldAllowedCidrs:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field allowed_cidrs", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ak.AllowedCidrs); err != nil {
+					return fmt.Errorf("unmarshal field allowed_cidrs: %w", err)
+				}
+			}
+		case apikey.FieldAllowedModels:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field allowed_models", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ak.AllowedModels); err != nil {
+					return fmt.Errorf("unmarshal field allowed_models: %w", err)
+				}
+			}
+		case apikey.FieldCacheMode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cache_mode", values[i])
+			} else if value.Valid {
+				ak.CacheMode = apikey.CacheMode(value.String)
+			}
+		case apikey.FieldCacheScope:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cache_scope", values[i])
+			} else if value.Valid {
+				ak.CacheScope = value.String
+			}
+		case apikey.FieldGuardrailPolicy:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field guardrail_policy", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ak.GuardrailPolicy); err != nil {
+					return fmt.Errorf("unmarshal field guardrail_policy: %w", err)
+				}
+			}
 		case apikey.FieldProfiles:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field profiles", values[i])
@@ -229,8 +335,30 @@ func (ak *APIKey) String() string {
 	builder.WriteString("user_id=")
 	builder.WriteString(fmt.Sprintf("%v", ak.UserID))
 	builder.WriteString(", ")
-	builder.WriteString("key=")
-	builder.WriteString(ak.Key)
+	builder.WriteString("key=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("key_prefix=")
+	builder.WriteString(ak.KeyPrefix)
+	builder.WriteString(", ")
+	if v := ak.LastUsedAt; v != nil {
+		builder.WriteString("last_used_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("previous_key=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("previous_key_prefix=")
+	builder.WriteString(ak.PreviousKeyPrefix)
+	builder.WriteString(", ")
+	if v := ak.PreviousKeyExpiresAt; v != nil {
+		builder.WriteString("previous_key_expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := ak.PreviousKeyLastUsedAt; v != nil {
+		builder.WriteString("previous_key_last_used_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteString(", ")
 	builder.WriteString("name=")
 	builder.WriteString(ak.Name)
@@ -240,6 +368,26 @@ func (ak *APIKey) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("scopes=<sensitive>")
 	builder.WriteString(", ")
+	if v := ak.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("allowed_cidrs=")
+	builder.WriteString(fmt.Sprintf("%v", ak.AllowedCidrs))
+	builder.WriteString(", ")
+	builder.WriteString("allowed_models=")
+	builder.WriteString(fmt.Sprintf("%v", ak.AllowedModels))
+	builder.WriteString(", ")
+	builder.WriteString("cache_mode=")
+	builder.WriteString(fmt.Sprintf("%v", ak.CacheMode))
+	builder.WriteString(", ")
+	builder.WriteString("cache_scope=")
+	builder.WriteString(ak.CacheScope)
+	builder.WriteString(", ")
+	builder.WriteString("guardrail_policy=")
+	builder.WriteString(fmt.Sprintf("%v", ak.GuardrailPolicy))
+	builder.WriteString(", ")
 	builder.WriteString("profiles=")
 	builder.WriteString(fmt.Sprintf("%v", ak.Profiles))
 	builder.WriteByte(')')
@@ -29,12 +29,36 @@ const (
 	FieldUserID = "user_id"
 	// FieldKey holds the string denoting the key field in the database.
 	FieldKey = "key"
+	// FieldKeyPrefix holds the string denoting the key_prefix field in the database.
+	FieldKeyPrefix = "key_prefix"
+	// FieldLastUsedAt holds the string denoting the last_used_at field in the database.
+	FieldLastUsedAt = "last_used_at"
+	// FieldPreviousKey holds the string denoting the previous_key field in the database.
+	FieldPreviousKey = "previous_key"
+	// FieldPreviousKeyPrefix holds the string denoting the previous_key_prefix field in the database.
+	FieldPreviousKeyPrefix = "previous_key_prefix"
+	// FieldPreviousKeyExpiresAt holds the string denoting the previous_key_expires_at field in the database.
+	FieldPreviousKeyExpiresAt = "previous_key_expires_at"
+	// FieldPreviousKeyLastUsedAt holds the string denoting the previous_key_last_used_at field in the database.
+	FieldPreviousKeyLastUsedAt = "previous_key_last_used_at"
 	// FieldName holds the string denoting the name field in the database.
 	FieldName = "name"
 	// FieldStatus holds the string denoting the status field in the database.
 	FieldStatus = "status"
 	// FieldScopes holds the string denoting the scopes field in the database.
 	FieldScopes = "scopes"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// FieldAllowedCidrs holds the string denoting the allowed_cidrs field in the database.
+	FieldAllowedCidrs = "allowed_cidrs"
+	// FieldAllowedModels holds the string denoting the allowed_models field in the database.
+	FieldAllowedModels = "allowed_models"
+	// FieldCacheMode holds the string denoting the cache_mode field in the database.
+	FieldCacheMode = "cache_mode"
+	// FieldCacheScope holds the string denoting the cache_scope field in the database.
+	FieldCacheScope = "cache_scope"
+	// FieldGuardrailPolicy holds the string denoting the guardrail_policy field in the database.
+	FieldGuardrailPolicy = "guardrail_policy"
 	// FieldProfiles holds the string denoting the profiles field in the database.
 	FieldProfiles = "profiles"
 	// EdgeUser holds the string denoting the user edge name in mutations.
@@ -67,9 +91,21 @@ var Columns = []string{
 	FieldDeletedAt,
 	FieldUserID,
 	FieldKey,
+	FieldKeyPrefix,
+	FieldLastUsedAt,
+	FieldPreviousKey,
+	FieldPreviousKeyPrefix,
+	FieldPreviousKeyExpiresAt,
+	FieldPreviousKeyLastUsedAt,
 	FieldName,
 	FieldStatus,
 	FieldScopes,
+	FieldExpiresAt,
+	FieldAllowedCidrs,
+	FieldAllowedModels,
+	FieldCacheMode,
+	FieldCacheScope,
+	FieldGuardrailPolicy,
 	FieldProfiles,
 }
 
@@ -100,6 +136,8 @@ var (
 	UpdateDefaultUpdatedAt func() time.Time
 	// DefaultDeletedAt holds the default value on creation for the "deleted_at" field.
 	DefaultDeletedAt int
+	// DefaultKeyPrefix holds the default value on creation for the "key_prefix" field.
+	DefaultKeyPrefix string
 	// DefaultScopes holds the default value on creation for the "scopes" field.
 	DefaultScopes []string
 	// DefaultProfiles holds the default value on creation for the "profiles" field.
@@ -132,6 +170,33 @@ func StatusValidator(s Status) error {
 	}
 }
 
+// CacheMode defines the type for the "cache_mode" enum field.
+type CacheMode string
+
+// CacheModeDisabled is the default value of the CacheMode enum.
+const DefaultCacheMode = CacheModeDisabled
+
+// CacheMode values.
+const (
+	CacheModeDisabled CacheMode = "disabled"
+	CacheModeOptIn    CacheMode = "opt_in"
+	CacheModeAlways   CacheMode = "always"
+)
+
+func (cm CacheMode) String() string {
+	return string(cm)
+}
+
+// CacheModeValidator is a validator for the "cache_mode" field enum values. It is called by the builders before save.
+func CacheModeValidator(cm CacheMode) error {
+	switch cm {
+	case CacheModeDisabled, CacheModeOptIn, CacheModeAlways:
+		return nil
+	default:
+		return fmt.Errorf("apikey: invalid enum value for cache_mode field: %q", cm)
+	}
+}
+
 // OrderOption defines the ordering options for the APIKey queries.
 type OrderOption func(*sql.Selector)
 
@@ -165,6 +230,36 @@ func ByKey(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldKey, opts...).ToFunc()
 }
 
+// ByKeyPrefix orders the results by the key_prefix field.
+func ByKeyPrefix(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKeyPrefix, opts...).ToFunc()
+}
+
+// ByLastUsedAt orders the results by the last_used_at field.
+func ByLastUsedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastUsedAt, opts...).ToFunc()
+}
+
+// ByPreviousKey orders the results by the previous_key field.
+func ByPreviousKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPreviousKey, opts...).ToFunc()
+}
+
+// ByPreviousKeyPrefix orders the results by the previous_key_prefix field.
+func ByPreviousKeyPrefix(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPreviousKeyPrefix, opts...).ToFunc()
+}
+
+// ByPreviousKeyExpiresAt orders the results by the previous_key_expires_at field.
+func ByPreviousKeyExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPreviousKeyExpiresAt, opts...).ToFunc()
+}
+
+// ByPreviousKeyLastUsedAt orders the results by the previous_key_last_used_at field.
+func ByPreviousKeyLastUsedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPreviousKeyLastUsedAt, opts...).ToFunc()
+}
+
 // ByName orders the results by the name field.
 func ByName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldName, opts...).ToFunc()
@@ -175,6 +270,21 @@ func ByStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatus, opts...).ToFunc()
 }
 
+// ByExpiresAt orders the results by the expires_at field.
+func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
+}
+
+// ByCacheMode orders the results by the cache_mode field.
+func ByCacheMode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCacheMode, opts...).ToFunc()
+}
+
+// ByCacheScope orders the results by the cache_scope field.
+func ByCacheScope(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCacheScope, opts...).ToFunc()
+}
+
 // ByUserField orders the results by user field.
 func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -227,3 +337,21 @@ func (e *Status) UnmarshalGQL(val interface{}) error {
 	}
 	return nil
 }
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e CacheMode) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *CacheMode) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = CacheMode(str)
+	if err := CacheModeValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid CacheMode", str)
+	}
+	return nil
+}
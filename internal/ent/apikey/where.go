@@ -80,11 +80,51 @@ func Key(v string) predicate.APIKey {
 	return predicate.APIKey(sql.FieldEQ(FieldKey, v))
 }
 
+// KeyPrefix applies equality check predicate on the "key_prefix" field. It's identical to KeyPrefixEQ.
+func KeyPrefix(v string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldEQ(FieldKeyPrefix, v))
+}
+
+// LastUsedAt applies equality check predicate on the "last_used_at" field. It's identical to LastUsedAtEQ.
+func LastUsedAt(v time.Time) predicate.APIKey {
+	return predicate.APIKey(sql.FieldEQ(FieldLastUsedAt, v))
+}
+
+// PreviousKey applies equality check predicate on the "previous_key" field. It's identical to PreviousKeyEQ.
+func PreviousKey(v string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldEQ(FieldPreviousKey, v))
+}
+
+// PreviousKeyPrefix applies equality check predicate on the "previous_key_prefix" field. It's identical to PreviousKeyPrefixEQ.
+func PreviousKeyPrefix(v string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldEQ(FieldPreviousKeyPrefix, v))
+}
+
+// PreviousKeyExpiresAt applies equality check predicate on the "previous_key_expires_at" field. It's identical to PreviousKeyExpiresAtEQ.
+func PreviousKeyExpiresAt(v time.Time) predicate.APIKey {
+	return predicate.APIKey(sql.FieldEQ(FieldPreviousKeyExpiresAt, v))
+}
+
+// PreviousKeyLastUsedAt applies equality check predicate on the "previous_key_last_used_at" field. It's identical to PreviousKeyLastUsedAtEQ.
+func PreviousKeyLastUsedAt(v time.Time) predicate.APIKey {
+	return predicate.APIKey(sql.FieldEQ(FieldPreviousKeyLastUsedAt, v))
+}
+
 // Name applies equality check predicate on the "name" field. It's identical to NameEQ.
 func Name(v string) predicate.APIKey {
 	return predicate.APIKey(sql.FieldEQ(FieldName, v))
 }
 
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.APIKey {
+	return predicate.APIKey(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// CacheScope applies equality check predicate on the "cache_scope" field. It's identical to CacheScopeEQ.
+func CacheScope(v string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldEQ(FieldCacheScope, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.APIKey {
 	return predicate.APIKey(sql.FieldEQ(FieldCreatedAt, v))
@@ -290,6 +330,371 @@ func KeyContainsFold(v string) predicate.APIKey {
 	return predicate.APIKey(sql.FieldContainsFold(FieldKey, v))
 }
 
+// KeyPrefixEQ applies the EQ predicate on the "key_prefix" field.
+func KeyPrefixEQ(v string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldEQ(FieldKeyPrefix, v))
+}
+
+// KeyPrefixNEQ applies the NEQ predicate on the "key_prefix" field.
+func KeyPrefixNEQ(v string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldNEQ(FieldKeyPrefix, v))
+}
+
+// KeyPrefixIn applies the In predicate on the "key_prefix" field.
+func KeyPrefixIn(vs ...string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldIn(FieldKeyPrefix, vs...))
+}
+
+// KeyPrefixNotIn applies the NotIn predicate on the "key_prefix" field.
+func KeyPrefixNotIn(vs ...string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldNotIn(FieldKeyPrefix, vs...))
+}
+
+// KeyPrefixGT applies the GT predicate on the "key_prefix" field.
+func KeyPrefixGT(v string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldGT(FieldKeyPrefix, v))
+}
+
+// KeyPrefixGTE applies the GTE predicate on the "key_prefix" field.
+func KeyPrefixGTE(v string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldGTE(FieldKeyPrefix, v))
+}
+
+// KeyPrefixLT applies the LT predicate on the "key_prefix" field.
+func KeyPrefixLT(v string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldLT(FieldKeyPrefix, v))
+}
+
+// KeyPrefixLTE applies the LTE predicate on the "key_prefix" field.
+func KeyPrefixLTE(v string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldLTE(FieldKeyPrefix, v))
+}
+
+// KeyPrefixContains applies the Contains predicate on the "key_prefix" field.
+func KeyPrefixContains(v string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldContains(FieldKeyPrefix, v))
+}
+
+// KeyPrefixHasPrefix applies the HasPrefix predicate on the "key_prefix" field.
+func KeyPrefixHasPrefix(v string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldHasPrefix(FieldKeyPrefix, v))
+}
+
+// KeyPrefixHasSuffix applies the HasSuffix predicate on the "key_prefix" field.
+func KeyPrefixHasSuffix(v string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldHasSuffix(FieldKeyPrefix, v))
+}
+
+// KeyPrefixEqualFold applies the EqualFold predicate on the "key_prefix" field.
+func KeyPrefixEqualFold(v string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldEqualFold(FieldKeyPrefix, v))
+}
+
+// KeyPrefixContainsFold applies the ContainsFold predicate on the "key_prefix" field.
+func KeyPrefixContainsFold(v string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldContainsFold(FieldKeyPrefix, v))
+}
+
+// LastUsedAtEQ applies the EQ predicate on the "last_used_at" field.
+func LastUsedAtEQ(v time.Time) predicate.APIKey {
+	return predicate.APIKey(sql.FieldEQ(FieldLastUsedAt, v))
+}
+
+// LastUsedAtNEQ applies the NEQ predicate on the "last_used_at" field.
+func LastUsedAtNEQ(v time.Time) predicate.APIKey {
+	return predicate.APIKey(sql.FieldNEQ(FieldLastUsedAt, v))
+}
+
+// LastUsedAtIn applies the In predicate on the "last_used_at" field.
+func LastUsedAtIn(vs ...time.Time) predicate.APIKey {
+	return predicate.APIKey(sql.FieldIn(FieldLastUsedAt, vs...))
+}
+
+// LastUsedAtNotIn applies the NotIn predicate on the "last_used_at" field.
+func LastUsedAtNotIn(vs ...time.Time) predicate.APIKey {
+	return predicate.APIKey(sql.FieldNotIn(FieldLastUsedAt, vs...))
+}
+
+// LastUsedAtGT applies the GT predicate on the "last_used_at" field.
+func LastUsedAtGT(v time.Time) predicate.APIKey {
+	return predicate.APIKey(sql.FieldGT(FieldLastUsedAt, v))
+}
+
+// LastUsedAtGTE applies the GTE predicate on the "last_used_at" field.
+func LastUsedAtGTE(v time.Time) predicate.APIKey {
+	return predicate.APIKey(sql.FieldGTE(FieldLastUsedAt, v))
+}
+
+// LastUsedAtLT applies the LT predicate on the "last_used_at" field.
+func LastUsedAtLT(v time.Time) predicate.APIKey {
+	return predicate.APIKey(sql.FieldLT(FieldLastUsedAt, v))
+}
+
+// LastUsedAtLTE applies the LTE predicate on the "last_used_at" field.
+func LastUsedAtLTE(v time.Time) predicate.APIKey {
+	return predicate.APIKey(sql.FieldLTE(FieldLastUsedAt, v))
+}
+
+// LastUsedAtIsNil applies the IsNil predicate on the "last_used_at" field.
+func LastUsedAtIsNil() predicate.APIKey {
+	return predicate.APIKey(sql.FieldIsNull(FieldLastUsedAt))
+}
+
+// LastUsedAtNotNil applies the NotNil predicate on the "last_used_at" field.
+func LastUsedAtNotNil() predicate.APIKey {
+	return predicate.APIKey(sql.FieldNotNull(FieldLastUsedAt))
+}
+
+// PreviousKeyEQ applies the EQ predicate on the "previous_key" field.
+func PreviousKeyEQ(v string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldEQ(FieldPreviousKey, v))
+}
+
+// PreviousKeyNEQ applies the NEQ predicate on the "previous_key" field.
+func PreviousKeyNEQ(v string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldNEQ(FieldPreviousKey, v))
+}
+
+// PreviousKeyIn applies the In predicate on the "previous_key" field.
+func PreviousKeyIn(vs ...string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldIn(FieldPreviousKey, vs...))
+}
+
+// PreviousKeyNotIn applies the NotIn predicate on the "previous_key" field.
+func PreviousKeyNotIn(vs ...string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldNotIn(FieldPreviousKey, vs...))
+}
+
+// PreviousKeyGT applies the GT predicate on the "previous_key" field.
+func PreviousKeyGT(v string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldGT(FieldPreviousKey, v))
+}
+
+// PreviousKeyGTE applies the GTE predicate on the "previous_key" field.
+func PreviousKeyGTE(v string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldGTE(FieldPreviousKey, v))
+}
+
+// PreviousKeyLT applies the LT predicate on the "previous_key" field.
+func PreviousKeyLT(v string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldLT(FieldPreviousKey, v))
+}
+
+// PreviousKeyLTE applies the LTE predicate on the "previous_key" field.
+func PreviousKeyLTE(v string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldLTE(FieldPreviousKey, v))
+}
+
+// PreviousKeyContains applies the Contains predicate on the "previous_key" field.
+func PreviousKeyContains(v string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldContains(FieldPreviousKey, v))
+}
+
+// PreviousKeyHasPrefix applies the HasPrefix predicate on the "previous_key" field.
+func PreviousKeyHasPrefix(v string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldHasPrefix(FieldPreviousKey, v))
+}
+
+// PreviousKeyHasSuffix applies the HasSuffix predicate on the "previous_key" field.
+func PreviousKeyHasSuffix(v string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldHasSuffix(FieldPreviousKey, v))
+}
+
+// PreviousKeyIsNil applies the IsNil predicate on the "previous_key" field.
+func PreviousKeyIsNil() predicate.APIKey {
+	return predicate.APIKey(sql.FieldIsNull(FieldPreviousKey))
+}
+
+// PreviousKeyNotNil applies the NotNil predicate on the "previous_key" field.
+func PreviousKeyNotNil() predicate.APIKey {
+	return predicate.APIKey(sql.FieldNotNull(FieldPreviousKey))
+}
+
+// PreviousKeyEqualFold applies the EqualFold predicate on the "previous_key" field.
+func PreviousKeyEqualFold(v string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldEqualFold(FieldPreviousKey, v))
+}
+
+// PreviousKeyContainsFold applies the ContainsFold predicate on the "previous_key" field.
+func PreviousKeyContainsFold(v string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldContainsFold(FieldPreviousKey, v))
+}
+
+// PreviousKeyPrefixEQ applies the EQ predicate on the "previous_key_prefix" field.
+func PreviousKeyPrefixEQ(v string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldEQ(FieldPreviousKeyPrefix, v))
+}
+
+// PreviousKeyPrefixNEQ applies the NEQ predicate on the "previous_key_prefix" field.
+func PreviousKeyPrefixNEQ(v string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldNEQ(FieldPreviousKeyPrefix, v))
+}
+
+// PreviousKeyPrefixIn applies the In predicate on the "previous_key_prefix" field.
+func PreviousKeyPrefixIn(vs ...string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldIn(FieldPreviousKeyPrefix, vs...))
+}
+
+// PreviousKeyPrefixNotIn applies the NotIn predicate on the "previous_key_prefix" field.
+func PreviousKeyPrefixNotIn(vs ...string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldNotIn(FieldPreviousKeyPrefix, vs...))
+}
+
+// PreviousKeyPrefixGT applies the GT predicate on the "previous_key_prefix" field.
+func PreviousKeyPrefixGT(v string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldGT(FieldPreviousKeyPrefix, v))
+}
+
+// PreviousKeyPrefixGTE applies the GTE predicate on the "previous_key_prefix" field.
+func PreviousKeyPrefixGTE(v string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldGTE(FieldPreviousKeyPrefix, v))
+}
+
+// PreviousKeyPrefixLT applies the LT predicate on the "previous_key_prefix" field.
+func PreviousKeyPrefixLT(v string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldLT(FieldPreviousKeyPrefix, v))
+}
+
+// PreviousKeyPrefixLTE applies the LTE predicate on the "previous_key_prefix" field.
+func PreviousKeyPrefixLTE(v string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldLTE(FieldPreviousKeyPrefix, v))
+}
+
+// PreviousKeyPrefixContains applies the Contains predicate on the "previous_key_prefix" field.
+func PreviousKeyPrefixContains(v string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldContains(FieldPreviousKeyPrefix, v))
+}
+
+// PreviousKeyPrefixHasPrefix applies the HasPrefix predicate on the "previous_key_prefix" field.
+func PreviousKeyPrefixHasPrefix(v string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldHasPrefix(FieldPreviousKeyPrefix, v))
+}
+
+// PreviousKeyPrefixHasSuffix applies the HasSuffix predicate on the "previous_key_prefix" field.
+func PreviousKeyPrefixHasSuffix(v string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldHasSuffix(FieldPreviousKeyPrefix, v))
+}
+
+// PreviousKeyPrefixIsNil applies the IsNil predicate on the "previous_key_prefix" field.
+func PreviousKeyPrefixIsNil() predicate.APIKey {
+	return predicate.APIKey(sql.FieldIsNull(FieldPreviousKeyPrefix))
+}
+
+// PreviousKeyPrefixNotNil applies the NotNil predicate on the "previous_key_prefix" field.
+func PreviousKeyPrefixNotNil() predicate.APIKey {
+	return predicate.APIKey(sql.FieldNotNull(FieldPreviousKeyPrefix))
+}
+
+// PreviousKeyPrefixEqualFold applies the EqualFold predicate on the "previous_key_prefix" field.
+func PreviousKeyPrefixEqualFold(v string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldEqualFold(FieldPreviousKeyPrefix, v))
+}
+
+// PreviousKeyPrefixContainsFold applies the ContainsFold predicate on the "previous_key_prefix" field.
+func PreviousKeyPrefixContainsFold(v string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldContainsFold(FieldPreviousKeyPrefix, v))
+}
+
+// PreviousKeyExpiresAtEQ applies the EQ predicate on the "previous_key_expires_at" field.
+func PreviousKeyExpiresAtEQ(v time.Time) predicate.APIKey {
+	return predicate.APIKey(sql.FieldEQ(FieldPreviousKeyExpiresAt, v))
+}
+
+// PreviousKeyExpiresAtNEQ applies the NEQ predicate on the "previous_key_expires_at" field.
+func PreviousKeyExpiresAtNEQ(v time.Time) predicate.APIKey {
+	return predicate.APIKey(sql.FieldNEQ(FieldPreviousKeyExpiresAt, v))
+}
+
+// PreviousKeyExpiresAtIn applies the In predicate on the "previous_key_expires_at" field.
+func PreviousKeyExpiresAtIn(vs ...time.Time) predicate.APIKey {
+	return predicate.APIKey(sql.FieldIn(FieldPreviousKeyExpiresAt, vs...))
+}
+
+// PreviousKeyExpiresAtNotIn applies the NotIn predicate on the "previous_key_expires_at" field.
+func PreviousKeyExpiresAtNotIn(vs ...time.Time) predicate.APIKey {
+	return predicate.APIKey(sql.FieldNotIn(FieldPreviousKeyExpiresAt, vs...))
+}
+
+// PreviousKeyExpiresAtGT applies the GT predicate on the "previous_key_expires_at" field.
+func PreviousKeyExpiresAtGT(v time.Time) predicate.APIKey {
+	return predicate.APIKey(sql.FieldGT(FieldPreviousKeyExpiresAt, v))
+}
+
+// PreviousKeyExpiresAtGTE applies the GTE predicate on the "previous_key_expires_at" field.
+func PreviousKeyExpiresAtGTE(v time.Time) predicate.APIKey {
+	return predicate.APIKey(sql.FieldGTE(FieldPreviousKeyExpiresAt, v))
+}
+
+// PreviousKeyExpiresAtLT applies the LT predicate on the "previous_key_expires_at" field.
+func PreviousKeyExpiresAtLT(v time.Time) predicate.APIKey {
+	return predicate.APIKey(sql.FieldLT(FieldPreviousKeyExpiresAt, v))
+}
+
+// PreviousKeyExpiresAtLTE applies the LTE predicate on the "previous_key_expires_at" field.
+func PreviousKeyExpiresAtLTE(v time.Time) predicate.APIKey {
+	return predicate.APIKey(sql.FieldLTE(FieldPreviousKeyExpiresAt, v))
+}
+
+// PreviousKeyExpiresAtIsNil applies the IsNil predicate on the "previous_key_expires_at" field.
+func PreviousKeyExpiresAtIsNil() predicate.APIKey {
+	return predicate.APIKey(sql.FieldIsNull(FieldPreviousKeyExpiresAt))
+}
+
+// PreviousKeyExpiresAtNotNil applies the NotNil predicate on the "previous_key_expires_at" field.
+func PreviousKeyExpiresAtNotNil() predicate.APIKey {
+	return predicate.APIKey(sql.FieldNotNull(FieldPreviousKeyExpiresAt))
+}
+
+// PreviousKeyLastUsedAtEQ applies the EQ predicate on the "previous_key_last_used_at" field.
+func PreviousKeyLastUsedAtEQ(v time.Time) predicate.APIKey {
+	return predicate.APIKey(sql.FieldEQ(FieldPreviousKeyLastUsedAt, v))
+}
+
+// PreviousKeyLastUsedAtNEQ applies the NEQ predicate on the "previous_key_last_used_at" field.
+func PreviousKeyLastUsedAtNEQ(v time.Time) predicate.APIKey {
+	return predicate.APIKey(sql.FieldNEQ(FieldPreviousKeyLastUsedAt, v))
+}
+
+// PreviousKeyLastUsedAtIn applies the In predicate on the "previous_key_last_used_at" field.
+func PreviousKeyLastUsedAtIn(vs ...time.Time) predicate.APIKey {
+	return predicate.APIKey(sql.FieldIn(FieldPreviousKeyLastUsedAt, vs...))
+}
+
+// PreviousKeyLastUsedAtNotIn applies the NotIn predicate on the "previous_key_last_used_at" field.
+func PreviousKeyLastUsedAtNotIn(vs ...time.Time) predicate.APIKey {
+	return predicate.APIKey(sql.FieldNotIn(FieldPreviousKeyLastUsedAt, vs...))
+}
+
+// PreviousKeyLastUsedAtGT applies the GT predicate on the "previous_key_last_used_at" field.
+func PreviousKeyLastUsedAtGT(v time.Time) predicate.APIKey {
+	return predicate.APIKey(sql.FieldGT(FieldPreviousKeyLastUsedAt, v))
+}
+
+// PreviousKeyLastUsedAtGTE applies the GTE predicate on the "previous_key_last_used_at" field.
+func PreviousKeyLastUsedAtGTE(v time.Time) predicate.APIKey {
+	return predicate.APIKey(sql.FieldGTE(FieldPreviousKeyLastUsedAt, v))
+}
+
+// PreviousKeyLastUsedAtLT applies the LT predicate on the "previous_key_last_used_at" field.
+func PreviousKeyLastUsedAtLT(v time.Time) predicate.APIKey {
+	return predicate.APIKey(sql.FieldLT(FieldPreviousKeyLastUsedAt, v))
+}
+
+// PreviousKeyLastUsedAtLTE applies the LTE predicate on the "previous_key_last_used_at" field.
+func PreviousKeyLastUsedAtLTE(v time.Time) predicate.APIKey {
+	return predicate.APIKey(sql.FieldLTE(FieldPreviousKeyLastUsedAt, v))
+}
+
+// PreviousKeyLastUsedAtIsNil applies the IsNil predicate on the "previous_key_last_used_at" field.
+func PreviousKeyLastUsedAtIsNil() predicate.APIKey {
+	return predicate.APIKey(sql.FieldIsNull(FieldPreviousKeyLastUsedAt))
+}
+
+// PreviousKeyLastUsedAtNotNil applies the NotNil predicate on the "previous_key_last_used_at" field.
+func PreviousKeyLastUsedAtNotNil() predicate.APIKey {
+	return predicate.APIKey(sql.FieldNotNull(FieldPreviousKeyLastUsedAt))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.APIKey {
 	return predicate.APIKey(sql.FieldEQ(FieldName, v))
@@ -385,6 +790,181 @@ func ScopesNotNil() predicate.APIKey {
 	return predicate.APIKey(sql.FieldNotNull(FieldScopes))
 }
 
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.APIKey {
+	return predicate.APIKey(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.APIKey {
+	return predicate.APIKey(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.APIKey {
+	return predicate.APIKey(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.APIKey {
+	return predicate.APIKey(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.APIKey {
+	return predicate.APIKey(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.APIKey {
+	return predicate.APIKey(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.APIKey {
+	return predicate.APIKey(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.APIKey {
+	return predicate.APIKey(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtIsNil applies the IsNil predicate on the "expires_at" field.
+func ExpiresAtIsNil() predicate.APIKey {
+	return predicate.APIKey(sql.FieldIsNull(FieldExpiresAt))
+}
+
+// ExpiresAtNotNil applies the NotNil predicate on the "expires_at" field.
+func ExpiresAtNotNil() predicate.APIKey {
+	return predicate.APIKey(sql.FieldNotNull(FieldExpiresAt))
+}
+
+// AllowedCidrsIsNil applies the IsNil predicate on the "allowed_cidrs" field.
+func AllowedCidrsIsNil() predicate.APIKey {
+	return predicate.APIKey(sql.FieldIsNull(FieldAllowedCidrs))
+}
+
+// AllowedCidrsNotNil applies the NotNil predicate on the "allowed_cidrs" field.
+func AllowedCidrsNotNil() predicate.APIKey {
+	return predicate.APIKey(sql.FieldNotNull(FieldAllowedCidrs))
+}
+
+// AllowedModelsIsNil applies the IsNil predicate on the "allowed_models" field.
+func AllowedModelsIsNil() predicate.APIKey {
+	return predicate.APIKey(sql.FieldIsNull(FieldAllowedModels))
+}
+
+// AllowedModelsNotNil applies the NotNil predicate on the "allowed_models" field.
+func AllowedModelsNotNil() predicate.APIKey {
+	return predicate.APIKey(sql.FieldNotNull(FieldAllowedModels))
+}
+
+// CacheModeEQ applies the EQ predicate on the "cache_mode" field.
+func CacheModeEQ(v CacheMode) predicate.APIKey {
+	return predicate.APIKey(sql.FieldEQ(FieldCacheMode, v))
+}
+
+// CacheModeNEQ applies the NEQ predicate on the "cache_mode" field.
+func CacheModeNEQ(v CacheMode) predicate.APIKey {
+	return predicate.APIKey(sql.FieldNEQ(FieldCacheMode, v))
+}
+
+// CacheModeIn applies the In predicate on the "cache_mode" field.
+func CacheModeIn(vs ...CacheMode) predicate.APIKey {
+	return predicate.APIKey(sql.FieldIn(FieldCacheMode, vs...))
+}
+
+// CacheModeNotIn applies the NotIn predicate on the "cache_mode" field.
+func CacheModeNotIn(vs ...CacheMode) predicate.APIKey {
+	return predicate.APIKey(sql.FieldNotIn(FieldCacheMode, vs...))
+}
+
+// CacheScopeEQ applies the EQ predicate on the "cache_scope" field.
+func CacheScopeEQ(v string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldEQ(FieldCacheScope, v))
+}
+
+// CacheScopeNEQ applies the NEQ predicate on the "cache_scope" field.
+func CacheScopeNEQ(v string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldNEQ(FieldCacheScope, v))
+}
+
+// CacheScopeIn applies the In predicate on the "cache_scope" field.
+func CacheScopeIn(vs ...string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldIn(FieldCacheScope, vs...))
+}
+
+// CacheScopeNotIn applies the NotIn predicate on the "cache_scope" field.
+func CacheScopeNotIn(vs ...string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldNotIn(FieldCacheScope, vs...))
+}
+
+// CacheScopeGT applies the GT predicate on the "cache_scope" field.
+func CacheScopeGT(v string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldGT(FieldCacheScope, v))
+}
+
+// CacheScopeGTE applies the GTE predicate on the "cache_scope" field.
+func CacheScopeGTE(v string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldGTE(FieldCacheScope, v))
+}
+
+// CacheScopeLT applies the LT predicate on the "cache_scope" field.
+func CacheScopeLT(v string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldLT(FieldCacheScope, v))
+}
+
+// CacheScopeLTE applies the LTE predicate on the "cache_scope" field.
+func CacheScopeLTE(v string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldLTE(FieldCacheScope, v))
+}
+
+// CacheScopeContains applies the Contains predicate on the "cache_scope" field.
+func CacheScopeContains(v string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldContains(FieldCacheScope, v))
+}
+
+// CacheScopeHasPrefix applies the HasPrefix predicate on the "cache_scope" field.
+func CacheScopeHasPrefix(v string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldHasPrefix(FieldCacheScope, v))
+}
+
+// CacheScopeHasSuffix applies the HasSuffix predicate on the "cache_scope" field.
+func CacheScopeHasSuffix(v string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldHasSuffix(FieldCacheScope, v))
+}
+
+// CacheScopeIsNil applies the IsNil predicate on the "cache_scope" field.
+func CacheScopeIsNil() predicate.APIKey {
+	return predicate.APIKey(sql.FieldIsNull(FieldCacheScope))
+}
+
+// CacheScopeNotNil applies the NotNil predicate on the "cache_scope" field.
+func CacheScopeNotNil() predicate.APIKey {
+	return predicate.APIKey(sql.FieldNotNull(FieldCacheScope))
+}
+
+// CacheScopeEqualFold applies the EqualFold predicate on the "cache_scope" field.
+func CacheScopeEqualFold(v string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldEqualFold(FieldCacheScope, v))
+}
+
+// CacheScopeContainsFold applies the ContainsFold predicate on the "cache_scope" field.
+func CacheScopeContainsFold(v string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldContainsFold(FieldCacheScope, v))
+}
+
+// GuardrailPolicyIsNil applies the IsNil predicate on the "guardrail_policy" field.
+func GuardrailPolicyIsNil() predicate.APIKey {
+	return predicate.APIKey(sql.FieldIsNull(FieldGuardrailPolicy))
+}
+
+// GuardrailPolicyNotNil applies the NotNil predicate on the "guardrail_policy" field.
+func GuardrailPolicyNotNil() predicate.APIKey {
+	return predicate.APIKey(sql.FieldNotNull(FieldGuardrailPolicy))
+}
+
 // ProfilesIsNil applies the IsNil predicate on the "profiles" field.
 func ProfilesIsNil() predicate.APIKey {
 	return predicate.APIKey(sql.FieldIsNull(FieldProfiles))
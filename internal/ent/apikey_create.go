@@ -79,6 +79,90 @@ func (akc *APIKeyCreate) SetKey(s string) *APIKeyCreate {
 	return akc
 }
 
+// SetKeyPrefix sets the "key_prefix" field.
+func (akc *APIKeyCreate) SetKeyPrefix(s string) *APIKeyCreate {
+	akc.mutation.SetKeyPrefix(s)
+	return akc
+}
+
+// SetNillableKeyPrefix sets the "key_prefix" field if the given value is not nil.
+func (akc *APIKeyCreate) SetNillableKeyPrefix(s *string) *APIKeyCreate {
+	if s != nil {
+		akc.SetKeyPrefix(*s)
+	}
+	return akc
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (akc *APIKeyCreate) SetLastUsedAt(t time.Time) *APIKeyCreate {
+	akc.mutation.SetLastUsedAt(t)
+	return akc
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (akc *APIKeyCreate) SetNillableLastUsedAt(t *time.Time) *APIKeyCreate {
+	if t != nil {
+		akc.SetLastUsedAt(*t)
+	}
+	return akc
+}
+
+// SetPreviousKey sets the "previous_key" field.
+func (akc *APIKeyCreate) SetPreviousKey(s string) *APIKeyCreate {
+	akc.mutation.SetPreviousKey(s)
+	return akc
+}
+
+// SetNillablePreviousKey sets the "previous_key" field if the given value is not nil.
+func (akc *APIKeyCreate) SetNillablePreviousKey(s *string) *APIKeyCreate {
+	if s != nil {
+		akc.SetPreviousKey(*s)
+	}
+	return akc
+}
+
+// SetPreviousKeyPrefix sets the "previous_key_prefix" field.
+func (akc *APIKeyCreate) SetPreviousKeyPrefix(s string) *APIKeyCreate {
+	akc.mutation.SetPreviousKeyPrefix(s)
+	return akc
+}
+
+// SetNillablePreviousKeyPrefix sets the "previous_key_prefix" field if the given value is not nil.
+func (akc *APIKeyCreate) SetNillablePreviousKeyPrefix(s *string) *APIKeyCreate {
+	if s != nil {
+		akc.SetPreviousKeyPrefix(*s)
+	}
+	return akc
+}
+
+// SetPreviousKeyExpiresAt sets the "previous_key_expires_at" field.
+func (akc *APIKeyCreate) SetPreviousKeyExpiresAt(t time.Time) *APIKeyCreate {
+	akc.mutation.SetPreviousKeyExpiresAt(t)
+	return akc
+}
+
+// SetNillablePreviousKeyExpiresAt sets the "previous_key_expires_at" field if the given value is not nil.
+func (akc *APIKeyCreate) SetNillablePreviousKeyExpiresAt(t *time.Time) *APIKeyCreate {
+	if t != nil {
+		akc.SetPreviousKeyExpiresAt(*t)
+	}
+	return akc
+}
+
+// SetPreviousKeyLastUsedAt sets the "previous_key_last_used_at" field.
+func (akc *APIKeyCreate) SetPreviousKeyLastUsedAt(t time.Time) *APIKeyCreate {
+	akc.mutation.SetPreviousKeyLastUsedAt(t)
+	return akc
+}
+
+// SetNillablePreviousKeyLastUsedAt sets the "previous_key_last_used_at" field if the given value is not nil.
+func (akc *APIKeyCreate) SetNillablePreviousKeyLastUsedAt(t *time.Time) *APIKeyCreate {
+	if t != nil {
+		akc.SetPreviousKeyLastUsedAt(*t)
+	}
+	return akc
+}
+
 // SetName sets the "name" field.
 func (akc *APIKeyCreate) SetName(s string) *APIKeyCreate {
 	akc.mutation.SetName(s)
@@ -105,6 +189,66 @@ func (akc *APIKeyCreate) SetScopes(s []string) *APIKeyCreate {
 	return akc
 }
 
+// SetExpiresAt sets the "expires_at" field.
+func (akc *APIKeyCreate) SetExpiresAt(t time.Time) *APIKeyCreate {
+	akc.mutation.SetExpiresAt(t)
+	return akc
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (akc *APIKeyCreate) SetNillableExpiresAt(t *time.Time) *APIKeyCreate {
+	if t != nil {
+		akc.SetExpiresAt(*t)
+	}
+	return akc
+}
+
+// SetAllowedCidrs sets the "allowed_cidrs" field.
+func (akc *APIKeyCreate) SetAllowedCidrs(s []string) *APIKeyCreate {
+	akc.mutation.SetAllowedCidrs(s)
+	return akc
+}
+
+// SetAllowedModels sets the "allowed_models" field.
+func (akc *APIKeyCreate) SetAllowedModels(s []string) *APIKeyCreate {
+	akc.mutation.SetAllowedModels(s)
+	return akc
+}
+
+// SetCacheMode sets the "cache_mode" field.
+func (akc *APIKeyCreate) SetCacheMode(am apikey.CacheMode) *APIKeyCreate {
+	akc.mutation.SetCacheMode(am)
+	return akc
+}
+
+// SetNillableCacheMode sets the "cache_mode" field if the given value is not nil.
+func (akc *APIKeyCreate) SetNillableCacheMode(am *apikey.CacheMode) *APIKeyCreate {
+	if am != nil {
+		akc.SetCacheMode(*am)
+	}
+	return akc
+}
+
+// SetCacheScope sets the "cache_scope" field.
+func (akc *APIKeyCreate) SetCacheScope(s string) *APIKeyCreate {
+	akc.mutation.SetCacheScope(s)
+	return akc
+}
+
+// SetNillableCacheScope sets the "cache_scope" field if the given value is not nil.
+func (akc *APIKeyCreate) SetNillableCacheScope(s *string) *APIKeyCreate {
+	if s != nil {
+		akc.SetCacheScope(*s)
+	}
+	return akc
+}
+
+// SetGuardrailPolicy sets the "guardrail_policy" field.
+func (akc *APIKeyCreate) SetGuardrailPolicy(op *objects.GuardrailPolicy) *APIKeyCreate {
+	akc.mutation.SetGuardrailPolicy(op)
+	return akc
+}
+
 // SetProfiles sets the "profiles" field.
 func (akc *APIKeyCreate) SetProfiles(okp *objects.APIKeyProfiles) *APIKeyCreate {
 	akc.mutation.SetProfiles(okp)
@@ -186,6 +330,10 @@ func (akc *APIKeyCreate) defaults() error {
 		v := apikey.DefaultDeletedAt
 		akc.mutation.SetDeletedAt(v)
 	}
+	if _, ok := akc.mutation.KeyPrefix(); !ok {
+		v := apikey.DefaultKeyPrefix
+		akc.mutation.SetKeyPrefix(v)
+	}
 	if _, ok := akc.mutation.Status(); !ok {
 		v := apikey.DefaultStatus
 		akc.mutation.SetStatus(v)
@@ -194,6 +342,10 @@ func (akc *APIKeyCreate) defaults() error {
 		v := apikey.DefaultScopes
 		akc.mutation.SetScopes(v)
 	}
+	if _, ok := akc.mutation.CacheMode(); !ok {
+		v := apikey.DefaultCacheMode
+		akc.mutation.SetCacheMode(v)
+	}
 	if _, ok := akc.mutation.Profiles(); !ok {
 		v := apikey.DefaultProfiles
 		akc.mutation.SetProfiles(v)
@@ -218,6 +370,9 @@ func (akc *APIKeyCreate) check() error {
 	if _, ok := akc.mutation.Key(); !ok {
 		return &ValidationError{Name: "key", err: errors.New(`ent: missing required field "APIKey.key"`)}
 	}
+	if _, ok := akc.mutation.KeyPrefix(); !ok {
+		return &ValidationError{Name: "key_prefix", err: errors.New(`ent: missing required field "APIKey.key_prefix"`)}
+	}
 	if _, ok := akc.mutation.Name(); !ok {
 		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "APIKey.name"`)}
 	}
@@ -229,6 +384,14 @@ func (akc *APIKeyCreate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "APIKey.status": %w`, err)}
 		}
 	}
+	if _, ok := akc.mutation.CacheMode(); !ok {
+		return &ValidationError{Name: "cache_mode", err: errors.New(`ent: missing required field "APIKey.cache_mode"`)}
+	}
+	if v, ok := akc.mutation.CacheMode(); ok {
+		if err := apikey.CacheModeValidator(v); err != nil {
+			return &ValidationError{Name: "cache_mode", err: fmt.Errorf(`ent: validator failed for field "APIKey.cache_mode": %w`, err)}
+		}
+	}
 	if len(akc.mutation.UserIDs()) == 0 {
 		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "APIKey.user"`)}
 	}
@@ -275,6 +438,30 @@ func (akc *APIKeyCreate) createSpec() (*APIKey, *sqlgraph.CreateSpec) {
 		_spec.SetField(apikey.FieldKey, field.TypeString, value)
 		_node.Key = value
 	}
+	if value, ok := akc.mutation.KeyPrefix(); ok {
+		_spec.SetField(apikey.FieldKeyPrefix, field.TypeString, value)
+		_node.KeyPrefix = value
+	}
+	if value, ok := akc.mutation.LastUsedAt(); ok {
+		_spec.SetField(apikey.FieldLastUsedAt, field.TypeTime, value)
+		_node.LastUsedAt = &value
+	}
+	if value, ok := akc.mutation.PreviousKey(); ok {
+		_spec.SetField(apikey.FieldPreviousKey, field.TypeString, value)
+		_node.PreviousKey = value
+	}
+	if value, ok := akc.mutation.PreviousKeyPrefix(); ok {
+		_spec.SetField(apikey.FieldPreviousKeyPrefix, field.TypeString, value)
+		_node.PreviousKeyPrefix = value
+	}
+	if value, ok := akc.mutation.PreviousKeyExpiresAt(); ok {
+		_spec.SetField(apikey.FieldPreviousKeyExpiresAt, field.TypeTime, value)
+		_node.PreviousKeyExpiresAt = &value
+	}
+	if value, ok := akc.mutation.PreviousKeyLastUsedAt(); ok {
+		_spec.SetField(apikey.FieldPreviousKeyLastUsedAt, field.TypeTime, value)
+		_node.PreviousKeyLastUsedAt = &value
+	}
 	if value, ok := akc.mutation.Name(); ok {
 		_spec.SetField(apikey.FieldName, field.TypeString, value)
 		_node.Name = value
@@ -287,6 +474,30 @@ func (akc *APIKeyCreate) createSpec() (*APIKey, *sqlgraph.CreateSpec) {
 		_spec.SetField(apikey.FieldScopes, field.TypeJSON, value)
 		_node.Scopes = value
 	}
+	if value, ok := akc.mutation.ExpiresAt(); ok {
+		_spec.SetField(apikey.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := akc.mutation.AllowedCidrs(); ok {
+		_spec.SetField(apikey.FieldAllowedCidrs, field.TypeJSON, value)
+		_node.AllowedCidrs = value
+	}
+	if value, ok := akc.mutation.AllowedModels(); ok {
+		_spec.SetField(apikey.FieldAllowedModels, field.TypeJSON, value)
+		_node.AllowedModels = value
+	}
+	if value, ok := akc.mutation.CacheMode(); ok {
+		_spec.SetField(apikey.FieldCacheMode, field.TypeEnum, value)
+		_node.CacheMode = value
+	}
+	if value, ok := akc.mutation.CacheScope(); ok {
+		_spec.SetField(apikey.FieldCacheScope, field.TypeString, value)
+		_node.CacheScope = value
+	}
+	if value, ok := akc.mutation.GuardrailPolicy(); ok {
+		_spec.SetField(apikey.FieldGuardrailPolicy, field.TypeJSON, value)
+		_node.GuardrailPolicy = value
+	}
 	if value, ok := akc.mutation.Profiles(); ok {
 		_spec.SetField(apikey.FieldProfiles, field.TypeJSON, value)
 		_node.Profiles = value
@@ -406,6 +617,120 @@ func (u *APIKeyUpsert) AddDeletedAt(v int) *APIKeyUpsert {
 	return u
 }
 
+// SetKey sets the "key" field.
+func (u *APIKeyUpsert) SetKey(v string) *APIKeyUpsert {
+	u.Set(apikey.FieldKey, v)
+	return u
+}
+
+// UpdateKey sets the "key" field to the value that was provided on create.
+func (u *APIKeyUpsert) UpdateKey() *APIKeyUpsert {
+	u.SetExcluded(apikey.FieldKey)
+	return u
+}
+
+// SetKeyPrefix sets the "key_prefix" field.
+func (u *APIKeyUpsert) SetKeyPrefix(v string) *APIKeyUpsert {
+	u.Set(apikey.FieldKeyPrefix, v)
+	return u
+}
+
+// UpdateKeyPrefix sets the "key_prefix" field to the value that was provided on create.
+func (u *APIKeyUpsert) UpdateKeyPrefix() *APIKeyUpsert {
+	u.SetExcluded(apikey.FieldKeyPrefix)
+	return u
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (u *APIKeyUpsert) SetLastUsedAt(v time.Time) *APIKeyUpsert {
+	u.Set(apikey.FieldLastUsedAt, v)
+	return u
+}
+
+// UpdateLastUsedAt sets the "last_used_at" field to the value that was provided on create.
+func (u *APIKeyUpsert) UpdateLastUsedAt() *APIKeyUpsert {
+	u.SetExcluded(apikey.FieldLastUsedAt)
+	return u
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (u *APIKeyUpsert) ClearLastUsedAt() *APIKeyUpsert {
+	u.SetNull(apikey.FieldLastUsedAt)
+	return u
+}
+
+// SetPreviousKey sets the "previous_key" field.
+func (u *APIKeyUpsert) SetPreviousKey(v string) *APIKeyUpsert {
+	u.Set(apikey.FieldPreviousKey, v)
+	return u
+}
+
+// UpdatePreviousKey sets the "previous_key" field to the value that was provided on create.
+func (u *APIKeyUpsert) UpdatePreviousKey() *APIKeyUpsert {
+	u.SetExcluded(apikey.FieldPreviousKey)
+	return u
+}
+
+// ClearPreviousKey clears the value of the "previous_key" field.
+func (u *APIKeyUpsert) ClearPreviousKey() *APIKeyUpsert {
+	u.SetNull(apikey.FieldPreviousKey)
+	return u
+}
+
+// SetPreviousKeyPrefix sets the "previous_key_prefix" field.
+func (u *APIKeyUpsert) SetPreviousKeyPrefix(v string) *APIKeyUpsert {
+	u.Set(apikey.FieldPreviousKeyPrefix, v)
+	return u
+}
+
+// UpdatePreviousKeyPrefix sets the "previous_key_prefix" field to the value that was provided on create.
+func (u *APIKeyUpsert) UpdatePreviousKeyPrefix() *APIKeyUpsert {
+	u.SetExcluded(apikey.FieldPreviousKeyPrefix)
+	return u
+}
+
+// ClearPreviousKeyPrefix clears the value of the "previous_key_prefix" field.
+func (u *APIKeyUpsert) ClearPreviousKeyPrefix() *APIKeyUpsert {
+	u.SetNull(apikey.FieldPreviousKeyPrefix)
+	return u
+}
+
+// SetPreviousKeyExpiresAt sets the "previous_key_expires_at" field.
+func (u *APIKeyUpsert) SetPreviousKeyExpiresAt(v time.Time) *APIKeyUpsert {
+	u.Set(apikey.FieldPreviousKeyExpiresAt, v)
+	return u
+}
+
+// UpdatePreviousKeyExpiresAt sets the "previous_key_expires_at" field to the value that was provided on create.
+func (u *APIKeyUpsert) UpdatePreviousKeyExpiresAt() *APIKeyUpsert {
+	u.SetExcluded(apikey.FieldPreviousKeyExpiresAt)
+	return u
+}
+
+// ClearPreviousKeyExpiresAt clears the value of the "previous_key_expires_at" field.
+func (u *APIKeyUpsert) ClearPreviousKeyExpiresAt() *APIKeyUpsert {
+	u.SetNull(apikey.FieldPreviousKeyExpiresAt)
+	return u
+}
+
+// SetPreviousKeyLastUsedAt sets the "previous_key_last_used_at" field.
+func (u *APIKeyUpsert) SetPreviousKeyLastUsedAt(v time.Time) *APIKeyUpsert {
+	u.Set(apikey.FieldPreviousKeyLastUsedAt, v)
+	return u
+}
+
+// UpdatePreviousKeyLastUsedAt sets the "previous_key_last_used_at" field to the value that was provided on create.
+func (u *APIKeyUpsert) UpdatePreviousKeyLastUsedAt() *APIKeyUpsert {
+	u.SetExcluded(apikey.FieldPreviousKeyLastUsedAt)
+	return u
+}
+
+// ClearPreviousKeyLastUsedAt clears the value of the "previous_key_last_used_at" field.
+func (u *APIKeyUpsert) ClearPreviousKeyLastUsedAt() *APIKeyUpsert {
+	u.SetNull(apikey.FieldPreviousKeyLastUsedAt)
+	return u
+}
+
 // SetName sets the "name" field.
 func (u *APIKeyUpsert) SetName(v string) *APIKeyUpsert {
 	u.Set(apikey.FieldName, v)
@@ -448,6 +773,108 @@ func (u *APIKeyUpsert) ClearScopes() *APIKeyUpsert {
 	return u
 }
 
+// SetExpiresAt sets the "expires_at" field.
+func (u *APIKeyUpsert) SetExpiresAt(v time.Time) *APIKeyUpsert {
+	u.Set(apikey.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *APIKeyUpsert) UpdateExpiresAt() *APIKeyUpsert {
+	u.SetExcluded(apikey.FieldExpiresAt)
+	return u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *APIKeyUpsert) ClearExpiresAt() *APIKeyUpsert {
+	u.SetNull(apikey.FieldExpiresAt)
+	return u
+}
+
+// SetAllowedCidrs sets the "allowed_cidrs" field.
+func (u *APIKeyUpsert) SetAllowedCidrs(v []string) *APIKeyUpsert {
+	u.Set(apikey.FieldAllowedCidrs, v)
+	return u
+}
+
+// UpdateAllowedCidrs sets the "allowed_cidrs" field to the value that was provided on create.
+func (u *APIKeyUpsert) UpdateAllowedCidrs() *APIKeyUpsert {
+	u.SetExcluded(apikey.FieldAllowedCidrs)
+	return u
+}
+
+// ClearAllowedCidrs clears the value of the "allowed_cidrs" field.
+func (u *APIKeyUpsert) ClearAllowedCidrs() *APIKeyUpsert {
+	u.SetNull(apikey.FieldAllowedCidrs)
+	return u
+}
+
+// SetAllowedModels sets the "allowed_models" field.
+func (u *APIKeyUpsert) SetAllowedModels(v []string) *APIKeyUpsert {
+	u.Set(apikey.FieldAllowedModels, v)
+	return u
+}
+
+// UpdateAllowedModels sets the "allowed_models" field to the value that was provided on create.
+func (u *APIKeyUpsert) UpdateAllowedModels() *APIKeyUpsert {
+	u.SetExcluded(apikey.FieldAllowedModels)
+	return u
+}
+
+// ClearAllowedModels clears the value of the "allowed_models" field.
+func (u *APIKeyUpsert) ClearAllowedModels() *APIKeyUpsert {
+	u.SetNull(apikey.FieldAllowedModels)
+	return u
+}
+
+// SetCacheMode sets the "cache_mode" field.
+func (u *APIKeyUpsert) SetCacheMode(v apikey.CacheMode) *APIKeyUpsert {
+	u.Set(apikey.FieldCacheMode, v)
+	return u
+}
+
+// UpdateCacheMode sets the "cache_mode" field to the value that was provided on create.
+func (u *APIKeyUpsert) UpdateCacheMode() *APIKeyUpsert {
+	u.SetExcluded(apikey.FieldCacheMode)
+	return u
+}
+
+// SetCacheScope sets the "cache_scope" field.
+func (u *APIKeyUpsert) SetCacheScope(v string) *APIKeyUpsert {
+	u.Set(apikey.FieldCacheScope, v)
+	return u
+}
+
+// UpdateCacheScope sets the "cache_scope" field to the value that was provided on create.
+func (u *APIKeyUpsert) UpdateCacheScope() *APIKeyUpsert {
+	u.SetExcluded(apikey.FieldCacheScope)
+	return u
+}
+
+// ClearCacheScope clears the value of the "cache_scope" field.
+func (u *APIKeyUpsert) ClearCacheScope() *APIKeyUpsert {
+	u.SetNull(apikey.FieldCacheScope)
+	return u
+}
+
+// SetGuardrailPolicy sets the "guardrail_policy" field.
+func (u *APIKeyUpsert) SetGuardrailPolicy(v *objects.GuardrailPolicy) *APIKeyUpsert {
+	u.Set(apikey.FieldGuardrailPolicy, v)
+	return u
+}
+
+// UpdateGuardrailPolicy sets the "guardrail_policy" field to the value that was provided on create.
+func (u *APIKeyUpsert) UpdateGuardrailPolicy() *APIKeyUpsert {
+	u.SetExcluded(apikey.FieldGuardrailPolicy)
+	return u
+}
+
+// ClearGuardrailPolicy clears the value of the "guardrail_policy" field.
+func (u *APIKeyUpsert) ClearGuardrailPolicy() *APIKeyUpsert {
+	u.SetNull(apikey.FieldGuardrailPolicy)
+	return u
+}
+
 // SetProfiles sets the "profiles" field.
 func (u *APIKeyUpsert) SetProfiles(v *objects.APIKeyProfiles) *APIKeyUpsert {
 	u.Set(apikey.FieldProfiles, v)
@@ -483,9 +910,6 @@ func (u *APIKeyUpsertOne) UpdateNewValues() *APIKeyUpsertOne {
 		if _, exists := u.create.mutation.UserID(); exists {
 			s.SetIgnore(apikey.FieldUserID)
 		}
-		if _, exists := u.create.mutation.Key(); exists {
-			s.SetIgnore(apikey.FieldKey)
-		}
 	}))
 	return u
 }
@@ -552,6 +976,139 @@ func (u *APIKeyUpsertOne) UpdateDeletedAt() *APIKeyUpsertOne {
 	})
 }
 
+// SetKey sets the "key" field.
+func (u *APIKeyUpsertOne) SetKey(v string) *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetKey(v)
+	})
+}
+
+// UpdateKey sets the "key" field to the value that was provided on create.
+func (u *APIKeyUpsertOne) UpdateKey() *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdateKey()
+	})
+}
+
+// SetKeyPrefix sets the "key_prefix" field.
+func (u *APIKeyUpsertOne) SetKeyPrefix(v string) *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetKeyPrefix(v)
+	})
+}
+
+// UpdateKeyPrefix sets the "key_prefix" field to the value that was provided on create.
+func (u *APIKeyUpsertOne) UpdateKeyPrefix() *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdateKeyPrefix()
+	})
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (u *APIKeyUpsertOne) SetLastUsedAt(v time.Time) *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetLastUsedAt(v)
+	})
+}
+
+// UpdateLastUsedAt sets the "last_used_at" field to the value that was provided on create.
+func (u *APIKeyUpsertOne) UpdateLastUsedAt() *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdateLastUsedAt()
+	})
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (u *APIKeyUpsertOne) ClearLastUsedAt() *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.ClearLastUsedAt()
+	})
+}
+
+// SetPreviousKey sets the "previous_key" field.
+func (u *APIKeyUpsertOne) SetPreviousKey(v string) *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetPreviousKey(v)
+	})
+}
+
+// UpdatePreviousKey sets the "previous_key" field to the value that was provided on create.
+func (u *APIKeyUpsertOne) UpdatePreviousKey() *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdatePreviousKey()
+	})
+}
+
+// ClearPreviousKey clears the value of the "previous_key" field.
+func (u *APIKeyUpsertOne) ClearPreviousKey() *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.ClearPreviousKey()
+	})
+}
+
+// SetPreviousKeyPrefix sets the "previous_key_prefix" field.
+func (u *APIKeyUpsertOne) SetPreviousKeyPrefix(v string) *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetPreviousKeyPrefix(v)
+	})
+}
+
+// UpdatePreviousKeyPrefix sets the "previous_key_prefix" field to the value that was provided on create.
+func (u *APIKeyUpsertOne) UpdatePreviousKeyPrefix() *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdatePreviousKeyPrefix()
+	})
+}
+
+// ClearPreviousKeyPrefix clears the value of the "previous_key_prefix" field.
+func (u *APIKeyUpsertOne) ClearPreviousKeyPrefix() *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.ClearPreviousKeyPrefix()
+	})
+}
+
+// SetPreviousKeyExpiresAt sets the "previous_key_expires_at" field.
+func (u *APIKeyUpsertOne) SetPreviousKeyExpiresAt(v time.Time) *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetPreviousKeyExpiresAt(v)
+	})
+}
+
+// UpdatePreviousKeyExpiresAt sets the "previous_key_expires_at" field to the value that was provided on create.
+func (u *APIKeyUpsertOne) UpdatePreviousKeyExpiresAt() *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdatePreviousKeyExpiresAt()
+	})
+}
+
+// ClearPreviousKeyExpiresAt clears the value of the "previous_key_expires_at" field.
+func (u *APIKeyUpsertOne) ClearPreviousKeyExpiresAt() *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.ClearPreviousKeyExpiresAt()
+	})
+}
+
+// SetPreviousKeyLastUsedAt sets the "previous_key_last_used_at" field.
+func (u *APIKeyUpsertOne) SetPreviousKeyLastUsedAt(v time.Time) *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetPreviousKeyLastUsedAt(v)
+	})
+}
+
+// UpdatePreviousKeyLastUsedAt sets the "previous_key_last_used_at" field to the value that was provided on create.
+func (u *APIKeyUpsertOne) UpdatePreviousKeyLastUsedAt() *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdatePreviousKeyLastUsedAt()
+	})
+}
+
+// ClearPreviousKeyLastUsedAt clears the value of the "previous_key_last_used_at" field.
+func (u *APIKeyUpsertOne) ClearPreviousKeyLastUsedAt() *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.ClearPreviousKeyLastUsedAt()
+	})
+}
+
 // SetName sets the "name" field.
 func (u *APIKeyUpsertOne) SetName(v string) *APIKeyUpsertOne {
 	return u.Update(func(s *APIKeyUpsert) {
@@ -601,6 +1158,125 @@ func (u *APIKeyUpsertOne) ClearScopes() *APIKeyUpsertOne {
 	})
 }
 
+// SetExpiresAt sets the "expires_at" field.
+func (u *APIKeyUpsertOne) SetExpiresAt(v time.Time) *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *APIKeyUpsertOne) UpdateExpiresAt() *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *APIKeyUpsertOne) ClearExpiresAt() *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetAllowedCidrs sets the "allowed_cidrs" field.
+func (u *APIKeyUpsertOne) SetAllowedCidrs(v []string) *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetAllowedCidrs(v)
+	})
+}
+
+// UpdateAllowedCidrs sets the "allowed_cidrs" field to the value that was provided on create.
+func (u *APIKeyUpsertOne) UpdateAllowedCidrs() *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdateAllowedCidrs()
+	})
+}
+
+// ClearAllowedCidrs clears the value of the "allowed_cidrs" field.
+func (u *APIKeyUpsertOne) ClearAllowedCidrs() *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.ClearAllowedCidrs()
+	})
+}
+
+// SetAllowedModels sets the "allowed_models" field.
+func (u *APIKeyUpsertOne) SetAllowedModels(v []string) *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetAllowedModels(v)
+	})
+}
+
+// UpdateAllowedModels sets the "allowed_models" field to the value that was provided on create.
+func (u *APIKeyUpsertOne) UpdateAllowedModels() *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdateAllowedModels()
+	})
+}
+
+// ClearAllowedModels clears the value of the "allowed_models" field.
+func (u *APIKeyUpsertOne) ClearAllowedModels() *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.ClearAllowedModels()
+	})
+}
+
+// SetCacheMode sets the "cache_mode" field.
+func (u *APIKeyUpsertOne) SetCacheMode(v apikey.CacheMode) *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetCacheMode(v)
+	})
+}
+
+// UpdateCacheMode sets the "cache_mode" field to the value that was provided on create.
+func (u *APIKeyUpsertOne) UpdateCacheMode() *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdateCacheMode()
+	})
+}
+
+// SetCacheScope sets the "cache_scope" field.
+func (u *APIKeyUpsertOne) SetCacheScope(v string) *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetCacheScope(v)
+	})
+}
+
+// UpdateCacheScope sets the "cache_scope" field to the value that was provided on create.
+func (u *APIKeyUpsertOne) UpdateCacheScope() *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdateCacheScope()
+	})
+}
+
+// ClearCacheScope clears the value of the "cache_scope" field.
+func (u *APIKeyUpsertOne) ClearCacheScope() *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.ClearCacheScope()
+	})
+}
+
+// SetGuardrailPolicy sets the "guardrail_policy" field.
+func (u *APIKeyUpsertOne) SetGuardrailPolicy(v *objects.GuardrailPolicy) *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetGuardrailPolicy(v)
+	})
+}
+
+// UpdateGuardrailPolicy sets the "guardrail_policy" field to the value that was provided on create.
+func (u *APIKeyUpsertOne) UpdateGuardrailPolicy() *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdateGuardrailPolicy()
+	})
+}
+
+// ClearGuardrailPolicy clears the value of the "guardrail_policy" field.
+func (u *APIKeyUpsertOne) ClearGuardrailPolicy() *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.ClearGuardrailPolicy()
+	})
+}
+
 // SetProfiles sets the "profiles" field.
 func (u *APIKeyUpsertOne) SetProfiles(v *objects.APIKeyProfiles) *APIKeyUpsertOne {
 	return u.Update(func(s *APIKeyUpsert) {
@@ -804,9 +1480,6 @@ func (u *APIKeyUpsertBulk) UpdateNewValues() *APIKeyUpsertBulk {
 			if _, exists := b.mutation.UserID(); exists {
 				s.SetIgnore(apikey.FieldUserID)
 			}
-			if _, exists := b.mutation.Key(); exists {
-				s.SetIgnore(apikey.FieldKey)
-			}
 		}
 	}))
 	return u
@@ -874,6 +1547,139 @@ func (u *APIKeyUpsertBulk) UpdateDeletedAt() *APIKeyUpsertBulk {
 	})
 }
 
+// SetKey sets the "key" field.
+func (u *APIKeyUpsertBulk) SetKey(v string) *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetKey(v)
+	})
+}
+
+// UpdateKey sets the "key" field to the value that was provided on create.
+func (u *APIKeyUpsertBulk) UpdateKey() *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdateKey()
+	})
+}
+
+// SetKeyPrefix sets the "key_prefix" field.
+func (u *APIKeyUpsertBulk) SetKeyPrefix(v string) *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetKeyPrefix(v)
+	})
+}
+
+// UpdateKeyPrefix sets the "key_prefix" field to the value that was provided on create.
+func (u *APIKeyUpsertBulk) UpdateKeyPrefix() *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdateKeyPrefix()
+	})
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (u *APIKeyUpsertBulk) SetLastUsedAt(v time.Time) *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetLastUsedAt(v)
+	})
+}
+
+// UpdateLastUsedAt sets the "last_used_at" field to the value that was provided on create.
+func (u *APIKeyUpsertBulk) UpdateLastUsedAt() *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdateLastUsedAt()
+	})
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (u *APIKeyUpsertBulk) ClearLastUsedAt() *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.ClearLastUsedAt()
+	})
+}
+
+// SetPreviousKey sets the "previous_key" field.
+func (u *APIKeyUpsertBulk) SetPreviousKey(v string) *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetPreviousKey(v)
+	})
+}
+
+// UpdatePreviousKey sets the "previous_key" field to the value that was provided on create.
+func (u *APIKeyUpsertBulk) UpdatePreviousKey() *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdatePreviousKey()
+	})
+}
+
+// ClearPreviousKey clears the value of the "previous_key" field.
+func (u *APIKeyUpsertBulk) ClearPreviousKey() *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.ClearPreviousKey()
+	})
+}
+
+// SetPreviousKeyPrefix sets the "previous_key_prefix" field.
+func (u *APIKeyUpsertBulk) SetPreviousKeyPrefix(v string) *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetPreviousKeyPrefix(v)
+	})
+}
+
+// UpdatePreviousKeyPrefix sets the "previous_key_prefix" field to the value that was provided on create.
+func (u *APIKeyUpsertBulk) UpdatePreviousKeyPrefix() *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdatePreviousKeyPrefix()
+	})
+}
+
+// ClearPreviousKeyPrefix clears the value of the "previous_key_prefix" field.
+func (u *APIKeyUpsertBulk) ClearPreviousKeyPrefix() *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.ClearPreviousKeyPrefix()
+	})
+}
+
+// SetPreviousKeyExpiresAt sets the "previous_key_expires_at" field.
+func (u *APIKeyUpsertBulk) SetPreviousKeyExpiresAt(v time.Time) *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetPreviousKeyExpiresAt(v)
+	})
+}
+
+// UpdatePreviousKeyExpiresAt sets the "previous_key_expires_at" field to the value that was provided on create.
+func (u *APIKeyUpsertBulk) UpdatePreviousKeyExpiresAt() *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdatePreviousKeyExpiresAt()
+	})
+}
+
+// ClearPreviousKeyExpiresAt clears the value of the "previous_key_expires_at" field.
+func (u *APIKeyUpsertBulk) ClearPreviousKeyExpiresAt() *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.ClearPreviousKeyExpiresAt()
+	})
+}
+
+// SetPreviousKeyLastUsedAt sets the "previous_key_last_used_at" field.
+func (u *APIKeyUpsertBulk) SetPreviousKeyLastUsedAt(v time.Time) *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetPreviousKeyLastUsedAt(v)
+	})
+}
+
+// UpdatePreviousKeyLastUsedAt sets the "previous_key_last_used_at" field to the value that was provided on create.
+func (u *APIKeyUpsertBulk) UpdatePreviousKeyLastUsedAt() *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdatePreviousKeyLastUsedAt()
+	})
+}
+
+// ClearPreviousKeyLastUsedAt clears the value of the "previous_key_last_used_at" field.
+func (u *APIKeyUpsertBulk) ClearPreviousKeyLastUsedAt() *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.ClearPreviousKeyLastUsedAt()
+	})
+}
+
 // SetName sets the "name" field.
 func (u *APIKeyUpsertBulk) SetName(v string) *APIKeyUpsertBulk {
 	return u.Update(func(s *APIKeyUpsert) {
@@ -923,6 +1729,125 @@ func (u *APIKeyUpsertBulk) ClearScopes() *APIKeyUpsertBulk {
 	})
 }
 
+// SetExpiresAt sets the "expires_at" field.
+func (u *APIKeyUpsertBulk) SetExpiresAt(v time.Time) *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *APIKeyUpsertBulk) UpdateExpiresAt() *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *APIKeyUpsertBulk) ClearExpiresAt() *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetAllowedCidrs sets the "allowed_cidrs" field.
+func (u *APIKeyUpsertBulk) SetAllowedCidrs(v []string) *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetAllowedCidrs(v)
+	})
+}
+
+// UpdateAllowedCidrs sets the "allowed_cidrs" field to the value that was provided on create.
+func (u *APIKeyUpsertBulk) UpdateAllowedCidrs() *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdateAllowedCidrs()
+	})
+}
+
+// ClearAllowedCidrs clears the value of the "allowed_cidrs" field.
+func (u *APIKeyUpsertBulk) ClearAllowedCidrs() *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.ClearAllowedCidrs()
+	})
+}
+
+// SetAllowedModels sets the "allowed_models" field.
+func (u *APIKeyUpsertBulk) SetAllowedModels(v []string) *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetAllowedModels(v)
+	})
+}
+
+// UpdateAllowedModels sets the "allowed_models" field to the value that was provided on create.
+func (u *APIKeyUpsertBulk) UpdateAllowedModels() *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdateAllowedModels()
+	})
+}
+
+// ClearAllowedModels clears the value of the "allowed_models" field.
+func (u *APIKeyUpsertBulk) ClearAllowedModels() *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.ClearAllowedModels()
+	})
+}
+
+// SetCacheMode sets the "cache_mode" field.
+func (u *APIKeyUpsertBulk) SetCacheMode(v apikey.CacheMode) *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetCacheMode(v)
+	})
+}
+
+// UpdateCacheMode sets the "cache_mode" field to the value that was provided on create.
+func (u *APIKeyUpsertBulk) UpdateCacheMode() *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdateCacheMode()
+	})
+}
+
+// SetCacheScope sets the "cache_scope" field.
+func (u *APIKeyUpsertBulk) SetCacheScope(v string) *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetCacheScope(v)
+	})
+}
+
+// UpdateCacheScope sets the "cache_scope" field to the value that was provided on create.
+func (u *APIKeyUpsertBulk) UpdateCacheScope() *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdateCacheScope()
+	})
+}
+
+// ClearCacheScope clears the value of the "cache_scope" field.
+func (u *APIKeyUpsertBulk) ClearCacheScope() *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.ClearCacheScope()
+	})
+}
+
+// SetGuardrailPolicy sets the "guardrail_policy" field.
+func (u *APIKeyUpsertBulk) SetGuardrailPolicy(v *objects.GuardrailPolicy) *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetGuardrailPolicy(v)
+	})
+}
+
+// UpdateGuardrailPolicy sets the "guardrail_policy" field to the value that was provided on create.
+func (u *APIKeyUpsertBulk) UpdateGuardrailPolicy() *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdateGuardrailPolicy()
+	})
+}
+
+// ClearGuardrailPolicy clears the value of the "guardrail_policy" field.
+func (u *APIKeyUpsertBulk) ClearGuardrailPolicy() *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.ClearGuardrailPolicy()
+	})
+}
+
 // SetProfiles sets the "profiles" field.
 func (u *APIKeyUpsertBulk) SetProfiles(v *objects.APIKeyProfiles) *APIKeyUpsertBulk {
 	return u.Update(func(s *APIKeyUpsert) {
@@ -59,6 +59,134 @@ func (aku *APIKeyUpdate) AddDeletedAt(i int) *APIKeyUpdate {
 	return aku
 }
 
+// SetKey sets the "key" field.
+func (aku *APIKeyUpdate) SetKey(s string) *APIKeyUpdate {
+	aku.mutation.SetKey(s)
+	return aku
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (aku *APIKeyUpdate) SetNillableKey(s *string) *APIKeyUpdate {
+	if s != nil {
+		aku.SetKey(*s)
+	}
+	return aku
+}
+
+// SetKeyPrefix sets the "key_prefix" field.
+func (aku *APIKeyUpdate) SetKeyPrefix(s string) *APIKeyUpdate {
+	aku.mutation.SetKeyPrefix(s)
+	return aku
+}
+
+// SetNillableKeyPrefix sets the "key_prefix" field if the given value is not nil.
+func (aku *APIKeyUpdate) SetNillableKeyPrefix(s *string) *APIKeyUpdate {
+	if s != nil {
+		aku.SetKeyPrefix(*s)
+	}
+	return aku
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (aku *APIKeyUpdate) SetLastUsedAt(t time.Time) *APIKeyUpdate {
+	aku.mutation.SetLastUsedAt(t)
+	return aku
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (aku *APIKeyUpdate) SetNillableLastUsedAt(t *time.Time) *APIKeyUpdate {
+	if t != nil {
+		aku.SetLastUsedAt(*t)
+	}
+	return aku
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (aku *APIKeyUpdate) ClearLastUsedAt() *APIKeyUpdate {
+	aku.mutation.ClearLastUsedAt()
+	return aku
+}
+
+// SetPreviousKey sets the "previous_key" field.
+func (aku *APIKeyUpdate) SetPreviousKey(s string) *APIKeyUpdate {
+	aku.mutation.SetPreviousKey(s)
+	return aku
+}
+
+// SetNillablePreviousKey sets the "previous_key" field if the given value is not nil.
+func (aku *APIKeyUpdate) SetNillablePreviousKey(s *string) *APIKeyUpdate {
+	if s != nil {
+		aku.SetPreviousKey(*s)
+	}
+	return aku
+}
+
+// ClearPreviousKey clears the value of the "previous_key" field.
+func (aku *APIKeyUpdate) ClearPreviousKey() *APIKeyUpdate {
+	aku.mutation.ClearPreviousKey()
+	return aku
+}
+
+// SetPreviousKeyPrefix sets the "previous_key_prefix" field.
+func (aku *APIKeyUpdate) SetPreviousKeyPrefix(s string) *APIKeyUpdate {
+	aku.mutation.SetPreviousKeyPrefix(s)
+	return aku
+}
+
+// SetNillablePreviousKeyPrefix sets the "previous_key_prefix" field if the given value is not nil.
+func (aku *APIKeyUpdate) SetNillablePreviousKeyPrefix(s *string) *APIKeyUpdate {
+	if s != nil {
+		aku.SetPreviousKeyPrefix(*s)
+	}
+	return aku
+}
+
+// ClearPreviousKeyPrefix clears the value of the "previous_key_prefix" field.
+func (aku *APIKeyUpdate) ClearPreviousKeyPrefix() *APIKeyUpdate {
+	aku.mutation.ClearPreviousKeyPrefix()
+	return aku
+}
+
+// SetPreviousKeyExpiresAt sets the "previous_key_expires_at" field.
+func (aku *APIKeyUpdate) SetPreviousKeyExpiresAt(t time.Time) *APIKeyUpdate {
+	aku.mutation.SetPreviousKeyExpiresAt(t)
+	return aku
+}
+
+// SetNillablePreviousKeyExpiresAt sets the "previous_key_expires_at" field if the given value is not nil.
+func (aku *APIKeyUpdate) SetNillablePreviousKeyExpiresAt(t *time.Time) *APIKeyUpdate {
+	if t != nil {
+		aku.SetPreviousKeyExpiresAt(*t)
+	}
+	return aku
+}
+
+// ClearPreviousKeyExpiresAt clears the value of the "previous_key_expires_at" field.
+func (aku *APIKeyUpdate) ClearPreviousKeyExpiresAt() *APIKeyUpdate {
+	aku.mutation.ClearPreviousKeyExpiresAt()
+	return aku
+}
+
+// SetPreviousKeyLastUsedAt sets the "previous_key_last_used_at" field.
+func (aku *APIKeyUpdate) SetPreviousKeyLastUsedAt(t time.Time) *APIKeyUpdate {
+	aku.mutation.SetPreviousKeyLastUsedAt(t)
+	return aku
+}
+
+// SetNillablePreviousKeyLastUsedAt sets the "previous_key_last_used_at" field if the given value is not nil.
+func (aku *APIKeyUpdate) SetNillablePreviousKeyLastUsedAt(t *time.Time) *APIKeyUpdate {
+	if t != nil {
+		aku.SetPreviousKeyLastUsedAt(*t)
+	}
+	return aku
+}
+
+// ClearPreviousKeyLastUsedAt clears the value of the "previous_key_last_used_at" field.
+func (aku *APIKeyUpdate) ClearPreviousKeyLastUsedAt() *APIKeyUpdate {
+	aku.mutation.ClearPreviousKeyLastUsedAt()
+	return aku
+}
+
 // SetName sets the "name" field.
 func (aku *APIKeyUpdate) SetName(s string) *APIKeyUpdate {
 	aku.mutation.SetName(s)
@@ -105,6 +233,108 @@ func (aku *APIKeyUpdate) ClearScopes() *APIKeyUpdate {
 	return aku
 }
 
+// SetExpiresAt sets the "expires_at" field.
+func (aku *APIKeyUpdate) SetExpiresAt(t time.Time) *APIKeyUpdate {
+	aku.mutation.SetExpiresAt(t)
+	return aku
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (aku *APIKeyUpdate) SetNillableExpiresAt(t *time.Time) *APIKeyUpdate {
+	if t != nil {
+		aku.SetExpiresAt(*t)
+	}
+	return aku
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (aku *APIKeyUpdate) ClearExpiresAt() *APIKeyUpdate {
+	aku.mutation.ClearExpiresAt()
+	return aku
+}
+
+// SetAllowedCidrs sets the "allowed_cidrs" field.
+func (aku *APIKeyUpdate) SetAllowedCidrs(s []string) *APIKeyUpdate {
+	aku.mutation.SetAllowedCidrs(s)
+	return aku
+}
+
+// AppendAllowedCidrs appends s to the "allowed_cidrs" field.
+func (aku *APIKeyUpdate) AppendAllowedCidrs(s []string) *APIKeyUpdate {
+	aku.mutation.AppendAllowedCidrs(s)
+	return aku
+}
+
+// ClearAllowedCidrs clears the value of the "allowed_cidrs" field.
+func (aku *APIKeyUpdate) ClearAllowedCidrs() *APIKeyUpdate {
+	aku.mutation.ClearAllowedCidrs()
+	return aku
+}
+
+// SetAllowedModels sets the "allowed_models" field.
+func (aku *APIKeyUpdate) SetAllowedModels(s []string) *APIKeyUpdate {
+	aku.mutation.SetAllowedModels(s)
+	return aku
+}
+
+// AppendAllowedModels appends s to the "allowed_models" field.
+func (aku *APIKeyUpdate) AppendAllowedModels(s []string) *APIKeyUpdate {
+	aku.mutation.AppendAllowedModels(s)
+	return aku
+}
+
+// ClearAllowedModels clears the value of the "allowed_models" field.
+func (aku *APIKeyUpdate) ClearAllowedModels() *APIKeyUpdate {
+	aku.mutation.ClearAllowedModels()
+	return aku
+}
+
+// SetCacheMode sets the "cache_mode" field.
+func (aku *APIKeyUpdate) SetCacheMode(am apikey.CacheMode) *APIKeyUpdate {
+	aku.mutation.SetCacheMode(am)
+	return aku
+}
+
+// SetNillableCacheMode sets the "cache_mode" field if the given value is not nil.
+func (aku *APIKeyUpdate) SetNillableCacheMode(am *apikey.CacheMode) *APIKeyUpdate {
+	if am != nil {
+		aku.SetCacheMode(*am)
+	}
+	return aku
+}
+
+// SetCacheScope sets the "cache_scope" field.
+func (aku *APIKeyUpdate) SetCacheScope(s string) *APIKeyUpdate {
+	aku.mutation.SetCacheScope(s)
+	return aku
+}
+
+// SetNillableCacheScope sets the "cache_scope" field if the given value is not nil.
+func (aku *APIKeyUpdate) SetNillableCacheScope(s *string) *APIKeyUpdate {
+	if s != nil {
+		aku.SetCacheScope(*s)
+	}
+	return aku
+}
+
+// ClearCacheScope clears the value of the "cache_scope" field.
+func (aku *APIKeyUpdate) ClearCacheScope() *APIKeyUpdate {
+	aku.mutation.ClearCacheScope()
+	return aku
+}
+
+// SetGuardrailPolicy sets the "guardrail_policy" field.
+func (aku *APIKeyUpdate) SetGuardrailPolicy(op *objects.GuardrailPolicy) *APIKeyUpdate {
+	aku.mutation.SetGuardrailPolicy(op)
+	return aku
+}
+
+// ClearGuardrailPolicy clears the value of the "guardrail_policy" field.
+func (aku *APIKeyUpdate) ClearGuardrailPolicy() *APIKeyUpdate {
+	aku.mutation.ClearGuardrailPolicy()
+	return aku
+}
+
 // SetProfiles sets the "profiles" field.
 func (aku *APIKeyUpdate) SetProfiles(okp *objects.APIKeyProfiles) *APIKeyUpdate {
 	aku.mutation.SetProfiles(okp)
@@ -207,6 +437,11 @@ func (aku *APIKeyUpdate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "APIKey.status": %w`, err)}
 		}
 	}
+	if v, ok := aku.mutation.CacheMode(); ok {
+		if err := apikey.CacheModeValidator(v); err != nil {
+			return &ValidationError{Name: "cache_mode", err: fmt.Errorf(`ent: validator failed for field "APIKey.cache_mode": %w`, err)}
+		}
+	}
 	if aku.mutation.UserCleared() && len(aku.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "APIKey.user"`)
 	}
@@ -240,6 +475,42 @@ func (aku *APIKeyUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := aku.mutation.AddedDeletedAt(); ok {
 		_spec.AddField(apikey.FieldDeletedAt, field.TypeInt, value)
 	}
+	if value, ok := aku.mutation.Key(); ok {
+		_spec.SetField(apikey.FieldKey, field.TypeString, value)
+	}
+	if value, ok := aku.mutation.KeyPrefix(); ok {
+		_spec.SetField(apikey.FieldKeyPrefix, field.TypeString, value)
+	}
+	if value, ok := aku.mutation.LastUsedAt(); ok {
+		_spec.SetField(apikey.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if aku.mutation.LastUsedAtCleared() {
+		_spec.ClearField(apikey.FieldLastUsedAt, field.TypeTime)
+	}
+	if value, ok := aku.mutation.PreviousKey(); ok {
+		_spec.SetField(apikey.FieldPreviousKey, field.TypeString, value)
+	}
+	if aku.mutation.PreviousKeyCleared() {
+		_spec.ClearField(apikey.FieldPreviousKey, field.TypeString)
+	}
+	if value, ok := aku.mutation.PreviousKeyPrefix(); ok {
+		_spec.SetField(apikey.FieldPreviousKeyPrefix, field.TypeString, value)
+	}
+	if aku.mutation.PreviousKeyPrefixCleared() {
+		_spec.ClearField(apikey.FieldPreviousKeyPrefix, field.TypeString)
+	}
+	if value, ok := aku.mutation.PreviousKeyExpiresAt(); ok {
+		_spec.SetField(apikey.FieldPreviousKeyExpiresAt, field.TypeTime, value)
+	}
+	if aku.mutation.PreviousKeyExpiresAtCleared() {
+		_spec.ClearField(apikey.FieldPreviousKeyExpiresAt, field.TypeTime)
+	}
+	if value, ok := aku.mutation.PreviousKeyLastUsedAt(); ok {
+		_spec.SetField(apikey.FieldPreviousKeyLastUsedAt, field.TypeTime, value)
+	}
+	if aku.mutation.PreviousKeyLastUsedAtCleared() {
+		_spec.ClearField(apikey.FieldPreviousKeyLastUsedAt, field.TypeTime)
+	}
 	if value, ok := aku.mutation.Name(); ok {
 		_spec.SetField(apikey.FieldName, field.TypeString, value)
 	}
@@ -257,6 +528,49 @@ func (aku *APIKeyUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if aku.mutation.ScopesCleared() {
 		_spec.ClearField(apikey.FieldScopes, field.TypeJSON)
 	}
+	if value, ok := aku.mutation.ExpiresAt(); ok {
+		_spec.SetField(apikey.FieldExpiresAt, field.TypeTime, value)
+	}
+	if aku.mutation.ExpiresAtCleared() {
+		_spec.ClearField(apikey.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := aku.mutation.AllowedCidrs(); ok {
+		_spec.SetField(apikey.FieldAllowedCidrs, field.TypeJSON, value)
+	}
+	if value, ok := aku.mutation.AppendedAllowedCidrs(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, apikey.FieldAllowedCidrs, value)
+		})
+	}
+	if aku.mutation.AllowedCidrsCleared() {
+		_spec.ClearField(apikey.FieldAllowedCidrs, field.TypeJSON)
+	}
+	if value, ok := aku.mutation.AllowedModels(); ok {
+		_spec.SetField(apikey.FieldAllowedModels, field.TypeJSON, value)
+	}
+	if value, ok := aku.mutation.AppendedAllowedModels(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, apikey.FieldAllowedModels, value)
+		})
+	}
+	if aku.mutation.AllowedModelsCleared() {
+		_spec.ClearField(apikey.FieldAllowedModels, field.TypeJSON)
+	}
+	if value, ok := aku.mutation.CacheMode(); ok {
+		_spec.SetField(apikey.FieldCacheMode, field.TypeEnum, value)
+	}
+	if value, ok := aku.mutation.CacheScope(); ok {
+		_spec.SetField(apikey.FieldCacheScope, field.TypeString, value)
+	}
+	if aku.mutation.CacheScopeCleared() {
+		_spec.ClearField(apikey.FieldCacheScope, field.TypeString)
+	}
+	if value, ok := aku.mutation.GuardrailPolicy(); ok {
+		_spec.SetField(apikey.FieldGuardrailPolicy, field.TypeJSON, value)
+	}
+	if aku.mutation.GuardrailPolicyCleared() {
+		_spec.ClearField(apikey.FieldGuardrailPolicy, field.TypeJSON)
+	}
 	if value, ok := aku.mutation.Profiles(); ok {
 		_spec.SetField(apikey.FieldProfiles, field.TypeJSON, value)
 	}
@@ -357,6 +671,134 @@ func (akuo *APIKeyUpdateOne) AddDeletedAt(i int) *APIKeyUpdateOne {
 	return akuo
 }
 
+// SetKey sets the "key" field.
+func (akuo *APIKeyUpdateOne) SetKey(s string) *APIKeyUpdateOne {
+	akuo.mutation.SetKey(s)
+	return akuo
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (akuo *APIKeyUpdateOne) SetNillableKey(s *string) *APIKeyUpdateOne {
+	if s != nil {
+		akuo.SetKey(*s)
+	}
+	return akuo
+}
+
+// SetKeyPrefix sets the "key_prefix" field.
+func (akuo *APIKeyUpdateOne) SetKeyPrefix(s string) *APIKeyUpdateOne {
+	akuo.mutation.SetKeyPrefix(s)
+	return akuo
+}
+
+// SetNillableKeyPrefix sets the "key_prefix" field if the given value is not nil.
+func (akuo *APIKeyUpdateOne) SetNillableKeyPrefix(s *string) *APIKeyUpdateOne {
+	if s != nil {
+		akuo.SetKeyPrefix(*s)
+	}
+	return akuo
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (akuo *APIKeyUpdateOne) SetLastUsedAt(t time.Time) *APIKeyUpdateOne {
+	akuo.mutation.SetLastUsedAt(t)
+	return akuo
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (akuo *APIKeyUpdateOne) SetNillableLastUsedAt(t *time.Time) *APIKeyUpdateOne {
+	if t != nil {
+		akuo.SetLastUsedAt(*t)
+	}
+	return akuo
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (akuo *APIKeyUpdateOne) ClearLastUsedAt() *APIKeyUpdateOne {
+	akuo.mutation.ClearLastUsedAt()
+	return akuo
+}
+
+// SetPreviousKey sets the "previous_key" field.
+func (akuo *APIKeyUpdateOne) SetPreviousKey(s string) *APIKeyUpdateOne {
+	akuo.mutation.SetPreviousKey(s)
+	return akuo
+}
+
+// SetNillablePreviousKey sets the "previous_key" field if the given value is not nil.
+func (akuo *APIKeyUpdateOne) SetNillablePreviousKey(s *string) *APIKeyUpdateOne {
+	if s != nil {
+		akuo.SetPreviousKey(*s)
+	}
+	return akuo
+}
+
+// ClearPreviousKey clears the value of the "previous_key" field.
+func (akuo *APIKeyUpdateOne) ClearPreviousKey() *APIKeyUpdateOne {
+	akuo.mutation.ClearPreviousKey()
+	return akuo
+}
+
+// SetPreviousKeyPrefix sets the "previous_key_prefix" field.
+func (akuo *APIKeyUpdateOne) SetPreviousKeyPrefix(s string) *APIKeyUpdateOne {
+	akuo.mutation.SetPreviousKeyPrefix(s)
+	return akuo
+}
+
+// SetNillablePreviousKeyPrefix sets the "previous_key_prefix" field if the given value is not nil.
+func (akuo *APIKeyUpdateOne) SetNillablePreviousKeyPrefix(s *string) *APIKeyUpdateOne {
+	if s != nil {
+		akuo.SetPreviousKeyPrefix(*s)
+	}
+	return akuo
+}
+
+// ClearPreviousKeyPrefix clears the value of the "previous_key_prefix" field.
+func (akuo *APIKeyUpdateOne) ClearPreviousKeyPrefix() *APIKeyUpdateOne {
+	akuo.mutation.ClearPreviousKeyPrefix()
+	return akuo
+}
+
+// SetPreviousKeyExpiresAt sets the "previous_key_expires_at" field.
+func (akuo *APIKeyUpdateOne) SetPreviousKeyExpiresAt(t time.Time) *APIKeyUpdateOne {
+	akuo.mutation.SetPreviousKeyExpiresAt(t)
+	return akuo
+}
+
+// SetNillablePreviousKeyExpiresAt sets the "previous_key_expires_at" field if the given value is not nil.
+func (akuo *APIKeyUpdateOne) SetNillablePreviousKeyExpiresAt(t *time.Time) *APIKeyUpdateOne {
+	if t != nil {
+		akuo.SetPreviousKeyExpiresAt(*t)
+	}
+	return akuo
+}
+
+// ClearPreviousKeyExpiresAt clears the value of the "previous_key_expires_at" field.
+func (akuo *APIKeyUpdateOne) ClearPreviousKeyExpiresAt() *APIKeyUpdateOne {
+	akuo.mutation.ClearPreviousKeyExpiresAt()
+	return akuo
+}
+
+// SetPreviousKeyLastUsedAt sets the "previous_key_last_used_at" field.
+func (akuo *APIKeyUpdateOne) SetPreviousKeyLastUsedAt(t time.Time) *APIKeyUpdateOne {
+	akuo.mutation.SetPreviousKeyLastUsedAt(t)
+	return akuo
+}
+
+// SetNillablePreviousKeyLastUsedAt sets the "previous_key_last_used_at" field if the given value is not nil.
+func (akuo *APIKeyUpdateOne) SetNillablePreviousKeyLastUsedAt(t *time.Time) *APIKeyUpdateOne {
+	if t != nil {
+		akuo.SetPreviousKeyLastUsedAt(*t)
+	}
+	return akuo
+}
+
+// ClearPreviousKeyLastUsedAt clears the value of the "previous_key_last_used_at" field.
+func (akuo *APIKeyUpdateOne) ClearPreviousKeyLastUsedAt() *APIKeyUpdateOne {
+	akuo.mutation.ClearPreviousKeyLastUsedAt()
+	return akuo
+}
+
 // SetName sets the "name" field.
 func (akuo *APIKeyUpdateOne) SetName(s string) *APIKeyUpdateOne {
 	akuo.mutation.SetName(s)
@@ -403,6 +845,108 @@ func (akuo *APIKeyUpdateOne) ClearScopes() *APIKeyUpdateOne {
 	return akuo
 }
 
+// SetExpiresAt sets the "expires_at" field.
+func (akuo *APIKeyUpdateOne) SetExpiresAt(t time.Time) *APIKeyUpdateOne {
+	akuo.mutation.SetExpiresAt(t)
+	return akuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (akuo *APIKeyUpdateOne) SetNillableExpiresAt(t *time.Time) *APIKeyUpdateOne {
+	if t != nil {
+		akuo.SetExpiresAt(*t)
+	}
+	return akuo
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (akuo *APIKeyUpdateOne) ClearExpiresAt() *APIKeyUpdateOne {
+	akuo.mutation.ClearExpiresAt()
+	return akuo
+}
+
+// SetAllowedCidrs sets the "allowed_cidrs" field.
+func (akuo *APIKeyUpdateOne) SetAllowedCidrs(s []string) *APIKeyUpdateOne {
+	akuo.mutation.SetAllowedCidrs(s)
+	return akuo
+}
+
+// AppendAllowedCidrs appends s to the "allowed_cidrs" field.
+func (akuo *APIKeyUpdateOne) AppendAllowedCidrs(s []string) *APIKeyUpdateOne {
+	akuo.mutation.AppendAllowedCidrs(s)
+	return akuo
+}
+
+// ClearAllowedCidrs clears the value of the "allowed_cidrs" field.
+func (akuo *APIKeyUpdateOne) ClearAllowedCidrs() *APIKeyUpdateOne {
+	akuo.mutation.ClearAllowedCidrs()
+	return akuo
+}
+
+// SetAllowedModels sets the "allowed_models" field.
+func (akuo *APIKeyUpdateOne) SetAllowedModels(s []string) *APIKeyUpdateOne {
+	akuo.mutation.SetAllowedModels(s)
+	return akuo
+}
+
+// AppendAllowedModels appends s to the "allowed_models" field.
+func (akuo *APIKeyUpdateOne) AppendAllowedModels(s []string) *APIKeyUpdateOne {
+	akuo.mutation.AppendAllowedModels(s)
+	return akuo
+}
+
+// ClearAllowedModels clears the value of the "allowed_models" field.
+func (akuo *APIKeyUpdateOne) ClearAllowedModels() *APIKeyUpdateOne {
+	akuo.mutation.ClearAllowedModels()
+	return akuo
+}
+
+// SetCacheMode sets the "cache_mode" field.
+func (akuo *APIKeyUpdateOne) SetCacheMode(am apikey.CacheMode) *APIKeyUpdateOne {
+	akuo.mutation.SetCacheMode(am)
+	return akuo
+}
+
+// SetNillableCacheMode sets the "cache_mode" field if the given value is not nil.
+func (akuo *APIKeyUpdateOne) SetNillableCacheMode(am *apikey.CacheMode) *APIKeyUpdateOne {
+	if am != nil {
+		akuo.SetCacheMode(*am)
+	}
+	return akuo
+}
+
+// SetCacheScope sets the "cache_scope" field.
+func (akuo *APIKeyUpdateOne) SetCacheScope(s string) *APIKeyUpdateOne {
+	akuo.mutation.SetCacheScope(s)
+	return akuo
+}
+
+// SetNillableCacheScope sets the "cache_scope" field if the given value is not nil.
+func (akuo *APIKeyUpdateOne) SetNillableCacheScope(s *string) *APIKeyUpdateOne {
+	if s != nil {
+		akuo.SetCacheScope(*s)
+	}
+	return akuo
+}
+
+// ClearCacheScope clears the value of the "cache_scope" field.
+func (akuo *APIKeyUpdateOne) ClearCacheScope() *APIKeyUpdateOne {
+	akuo.mutation.ClearCacheScope()
+	return akuo
+}
+
+// SetGuardrailPolicy sets the "guardrail_policy" field.
+func (akuo *APIKeyUpdateOne) SetGuardrailPolicy(op *objects.GuardrailPolicy) *APIKeyUpdateOne {
+	akuo.mutation.SetGuardrailPolicy(op)
+	return akuo
+}
+
+// ClearGuardrailPolicy clears the value of the "guardrail_policy" field.
+func (akuo *APIKeyUpdateOne) ClearGuardrailPolicy() *APIKeyUpdateOne {
+	akuo.mutation.ClearGuardrailPolicy()
+	return akuo
+}
+
 // SetProfiles sets the "profiles" field.
 func (akuo *APIKeyUpdateOne) SetProfiles(okp *objects.APIKeyProfiles) *APIKeyUpdateOne {
 	akuo.mutation.SetProfiles(okp)
@@ -518,6 +1062,11 @@ func (akuo *APIKeyUpdateOne) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "APIKey.status": %w`, err)}
 		}
 	}
+	if v, ok := akuo.mutation.CacheMode(); ok {
+		if err := apikey.CacheModeValidator(v); err != nil {
+			return &ValidationError{Name: "cache_mode", err: fmt.Errorf(`ent: validator failed for field "APIKey.cache_mode": %w`, err)}
+		}
+	}
 	if akuo.mutation.UserCleared() && len(akuo.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "APIKey.user"`)
 	}
@@ -568,6 +1117,42 @@ func (akuo *APIKeyUpdateOne) sqlSave(ctx context.Context) (_node *APIKey, err er
 	if value, ok := akuo.mutation.AddedDeletedAt(); ok {
 		_spec.AddField(apikey.FieldDeletedAt, field.TypeInt, value)
 	}
+	if value, ok := akuo.mutation.Key(); ok {
+		_spec.SetField(apikey.FieldKey, field.TypeString, value)
+	}
+	if value, ok := akuo.mutation.KeyPrefix(); ok {
+		_spec.SetField(apikey.FieldKeyPrefix, field.TypeString, value)
+	}
+	if value, ok := akuo.mutation.LastUsedAt(); ok {
+		_spec.SetField(apikey.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if akuo.mutation.LastUsedAtCleared() {
+		_spec.ClearField(apikey.FieldLastUsedAt, field.TypeTime)
+	}
+	if value, ok := akuo.mutation.PreviousKey(); ok {
+		_spec.SetField(apikey.FieldPreviousKey, field.TypeString, value)
+	}
+	if akuo.mutation.PreviousKeyCleared() {
+		_spec.ClearField(apikey.FieldPreviousKey, field.TypeString)
+	}
+	if value, ok := akuo.mutation.PreviousKeyPrefix(); ok {
+		_spec.SetField(apikey.FieldPreviousKeyPrefix, field.TypeString, value)
+	}
+	if akuo.mutation.PreviousKeyPrefixCleared() {
+		_spec.ClearField(apikey.FieldPreviousKeyPrefix, field.TypeString)
+	}
+	if value, ok := akuo.mutation.PreviousKeyExpiresAt(); ok {
+		_spec.SetField(apikey.FieldPreviousKeyExpiresAt, field.TypeTime, value)
+	}
+	if akuo.mutation.PreviousKeyExpiresAtCleared() {
+		_spec.ClearField(apikey.FieldPreviousKeyExpiresAt, field.TypeTime)
+	}
+	if value, ok := akuo.mutation.PreviousKeyLastUsedAt(); ok {
+		_spec.SetField(apikey.FieldPreviousKeyLastUsedAt, field.TypeTime, value)
+	}
+	if akuo.mutation.PreviousKeyLastUsedAtCleared() {
+		_spec.ClearField(apikey.FieldPreviousKeyLastUsedAt, field.TypeTime)
+	}
 	if value, ok := akuo.mutation.Name(); ok {
 		_spec.SetField(apikey.FieldName, field.TypeString, value)
 	}
@@ -585,6 +1170,49 @@ func (akuo *APIKeyUpdateOne) sqlSave(ctx context.Context) (_node *APIKey, err er
 	if akuo.mutation.ScopesCleared() {
 		_spec.ClearField(apikey.FieldScopes, field.TypeJSON)
 	}
+	if value, ok := akuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(apikey.FieldExpiresAt, field.TypeTime, value)
+	}
+	if akuo.mutation.ExpiresAtCleared() {
+		_spec.ClearField(apikey.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := akuo.mutation.AllowedCidrs(); ok {
+		_spec.SetField(apikey.FieldAllowedCidrs, field.TypeJSON, value)
+	}
+	if value, ok := akuo.mutation.AppendedAllowedCidrs(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, apikey.FieldAllowedCidrs, value)
+		})
+	}
+	if akuo.mutation.AllowedCidrsCleared() {
+		_spec.ClearField(apikey.FieldAllowedCidrs, field.TypeJSON)
+	}
+	if value, ok := akuo.mutation.AllowedModels(); ok {
+		_spec.SetField(apikey.FieldAllowedModels, field.TypeJSON, value)
+	}
+	if value, ok := akuo.mutation.AppendedAllowedModels(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, apikey.FieldAllowedModels, value)
+		})
+	}
+	if akuo.mutation.AllowedModelsCleared() {
+		_spec.ClearField(apikey.FieldAllowedModels, field.TypeJSON)
+	}
+	if value, ok := akuo.mutation.CacheMode(); ok {
+		_spec.SetField(apikey.FieldCacheMode, field.TypeEnum, value)
+	}
+	if value, ok := akuo.mutation.CacheScope(); ok {
+		_spec.SetField(apikey.FieldCacheScope, field.TypeString, value)
+	}
+	if akuo.mutation.CacheScopeCleared() {
+		_spec.ClearField(apikey.FieldCacheScope, field.TypeString)
+	}
+	if value, ok := akuo.mutation.GuardrailPolicy(); ok {
+		_spec.SetField(apikey.FieldGuardrailPolicy, field.TypeJSON, value)
+	}
+	if akuo.mutation.GuardrailPolicyCleared() {
+		_spec.ClearField(apikey.FieldGuardrailPolicy, field.TypeJSON)
+	}
 	if value, ok := akuo.mutation.Profiles(); ok {
 		_spec.SetField(apikey.FieldProfiles, field.TypeJSON, value)
 	}
@@ -57,15 +57,18 @@ type ChannelEdges struct {
 	Executions []*RequestExecution `json:"executions,omitempty"`
 	// UsageLogs holds the value of the usage_logs edge.
 	UsageLogs []*UsageLog `json:"usage_logs,omitempty"`
+	// RoutingRules holds the value of the routing_rules edge.
+	RoutingRules []*RoutingRule `json:"routing_rules,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [4]bool
 	// totalCount holds the count of the edges above.
-	totalCount [3]map[string]int
+	totalCount [4]map[string]int
 
-	namedRequests   map[string][]*Request
-	namedExecutions map[string][]*RequestExecution
-	namedUsageLogs  map[string][]*UsageLog
+	namedRequests     map[string][]*Request
+	namedExecutions   map[string][]*RequestExecution
+	namedUsageLogs    map[string][]*UsageLog
+	namedRoutingRules map[string][]*RoutingRule
 }
 
 // RequestsOrErr returns the Requests value or an error if the edge
@@ -95,6 +98,15 @@ func (e ChannelEdges) UsageLogsOrErr() ([]*UsageLog, error) {
 	return nil, &NotLoadedError{edge: "usage_logs"}
 }
 
+// RoutingRulesOrErr returns the RoutingRules value or an error if the edge
+// was not loaded in eager-loading.
+func (e ChannelEdges) RoutingRulesOrErr() ([]*RoutingRule, error) {
+	if e.loadedTypes[3] {
+		return e.RoutingRules, nil
+	}
+	return nil, &NotLoadedError{edge: "routing_rules"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Channel) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -235,6 +247,11 @@ func (c *Channel) QueryUsageLogs() *UsageLogQuery {
 	return NewChannelClient(c.config).QueryUsageLogs(c)
 }
 
+// QueryRoutingRules queries the "routing_rules" edge of the Channel entity.
+func (c *Channel) QueryRoutingRules() *RoutingRuleQuery {
+	return NewChannelClient(c.config).QueryRoutingRules(c)
+}
+
 // Update returns a builder for updating this Channel.
 // Note that you need to call Channel.Unwrap() before calling this method if this Channel
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -368,5 +385,29 @@ func (c *Channel) appendNamedUsageLogs(name string, edges ...*UsageLog) {
 	}
 }
 
+// NamedRoutingRules returns the RoutingRules named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (c *Channel) NamedRoutingRules(name string) ([]*RoutingRule, error) {
+	if c.Edges.namedRoutingRules == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := c.Edges.namedRoutingRules[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (c *Channel) appendNamedRoutingRules(name string, edges ...*RoutingRule) {
+	if c.Edges.namedRoutingRules == nil {
+		c.Edges.namedRoutingRules = make(map[string][]*RoutingRule)
+	}
+	if len(edges) == 0 {
+		c.Edges.namedRoutingRules[name] = []*RoutingRule{}
+	} else {
+		c.Edges.namedRoutingRules[name] = append(c.Edges.namedRoutingRules[name], edges...)
+	}
+}
+
 // Channels is a parsable slice of Channel.
 type Channels []*Channel
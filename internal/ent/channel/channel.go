@@ -49,6 +49,8 @@ const (
 	EdgeExecutions = "executions"
 	// EdgeUsageLogs holds the string denoting the usage_logs edge name in mutations.
 	EdgeUsageLogs = "usage_logs"
+	// EdgeRoutingRules holds the string denoting the routing_rules edge name in mutations.
+	EdgeRoutingRules = "routing_rules"
 	// Table holds the table name of the channel in the database.
 	Table = "channels"
 	// RequestsTable is the table that holds the requests relation/edge.
@@ -72,6 +74,11 @@ const (
 	UsageLogsInverseTable = "usage_logs"
 	// UsageLogsColumn is the table column denoting the usage_logs relation/edge.
 	UsageLogsColumn = "channel_id"
+	// RoutingRulesTable is the table that holds the routing_rules relation/edge. The primary key declared below.
+	RoutingRulesTable = "routing_rule_channels"
+	// RoutingRulesInverseTable is the table name for the RoutingRule entity.
+	// It exists in this package in order to avoid circular dependency with the "routingrule" package.
+	RoutingRulesInverseTable = "routing_rules"
 )
 
 // Columns holds all SQL columns for channel fields.
@@ -91,6 +98,12 @@ var Columns = []string{
 	FieldOrderingWeight,
 }
 
+var (
+	// RoutingRulesPrimaryKey and RoutingRulesColumn2 are the table columns denoting the
+	// primary key for the routing_rules relation (M2M).
+	RoutingRulesPrimaryKey = []string{"routing_rule_id", "channel_id"}
+)
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -285,6 +298,20 @@ func ByUsageLogs(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newUsageLogsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByRoutingRulesCount orders the results by routing_rules count.
+func ByRoutingRulesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRoutingRulesStep(), opts...)
+	}
+}
+
+// ByRoutingRules orders the results by routing_rules terms.
+func ByRoutingRules(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRoutingRulesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newRequestsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -306,6 +333,13 @@ func newUsageLogsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, UsageLogsTable, UsageLogsColumn),
 	)
 }
+func newRoutingRulesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RoutingRulesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, RoutingRulesTable, RoutingRulesPrimaryKey...),
+	)
+}
 
 // MarshalGQL implements graphql.Marshaler interface.
 func (e Type) MarshalGQL(w io.Writer) {
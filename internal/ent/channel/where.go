@@ -574,6 +574,29 @@ func HasUsageLogsWith(preds ...predicate.UsageLog) predicate.Channel {
 	})
 }
 
+// HasRoutingRules applies the HasEdge predicate on the "routing_rules" edge.
+func HasRoutingRules() predicate.Channel {
+	return predicate.Channel(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, RoutingRulesTable, RoutingRulesPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRoutingRulesWith applies the HasEdge predicate on the "routing_rules" edge with a given conditions (other predicates).
+func HasRoutingRulesWith(preds ...predicate.RoutingRule) predicate.Channel {
+	return predicate.Channel(func(s *sql.Selector) {
+		step := newRoutingRulesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Channel) predicate.Channel {
 	return predicate.Channel(sql.AndPredicates(predicates...))
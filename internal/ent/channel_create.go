@@ -14,6 +14,7 @@ import (
 	"github.com/looplj/axonhub/internal/ent/channel"
 	"github.com/looplj/axonhub/internal/ent/request"
 	"github.com/looplj/axonhub/internal/ent/requestexecution"
+	"github.com/looplj/axonhub/internal/ent/routingrule"
 	"github.com/looplj/axonhub/internal/ent/usagelog"
 	"github.com/looplj/axonhub/internal/objects"
 )
@@ -191,6 +192,21 @@ func (cc *ChannelCreate) AddUsageLogs(u ...*UsageLog) *ChannelCreate {
 	return cc.AddUsageLogIDs(ids...)
 }
 
+// AddRoutingRuleIDs adds the "routing_rules" edge to the RoutingRule entity by IDs.
+func (cc *ChannelCreate) AddRoutingRuleIDs(ids ...int) *ChannelCreate {
+	cc.mutation.AddRoutingRuleIDs(ids...)
+	return cc
+}
+
+// AddRoutingRules adds the "routing_rules" edges to the RoutingRule entity.
+func (cc *ChannelCreate) AddRoutingRules(r ...*RoutingRule) *ChannelCreate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return cc.AddRoutingRuleIDs(ids...)
+}
+
 // Mutation returns the ChannelMutation object of the builder.
 func (cc *ChannelCreate) Mutation() *ChannelMutation {
 	return cc.mutation
@@ -430,6 +446,22 @@ func (cc *ChannelCreate) createSpec() (*Channel, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := cc.mutation.RoutingRulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   channel.RoutingRulesTable,
+			Columns: channel.RoutingRulesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(routingrule.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
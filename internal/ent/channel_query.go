@@ -17,24 +17,27 @@ import (
 	"github.com/looplj/axonhub/internal/ent/predicate"
 	"github.com/looplj/axonhub/internal/ent/request"
 	"github.com/looplj/axonhub/internal/ent/requestexecution"
+	"github.com/looplj/axonhub/internal/ent/routingrule"
 	"github.com/looplj/axonhub/internal/ent/usagelog"
 )
 
 // ChannelQuery is the builder for querying Channel entities.
 type ChannelQuery struct {
 	config
-	ctx                 *QueryContext
-	order               []channel.OrderOption
-	inters              []Interceptor
-	predicates          []predicate.Channel
-	withRequests        *RequestQuery
-	withExecutions      *RequestExecutionQuery
-	withUsageLogs       *UsageLogQuery
-	loadTotal           []func(context.Context, []*Channel) error
-	modifiers           []func(*sql.Selector)
-	withNamedRequests   map[string]*RequestQuery
-	withNamedExecutions map[string]*RequestExecutionQuery
-	withNamedUsageLogs  map[string]*UsageLogQuery
+	ctx                   *QueryContext
+	order                 []channel.OrderOption
+	inters                []Interceptor
+	predicates            []predicate.Channel
+	withRequests          *RequestQuery
+	withExecutions        *RequestExecutionQuery
+	withUsageLogs         *UsageLogQuery
+	withRoutingRules      *RoutingRuleQuery
+	loadTotal             []func(context.Context, []*Channel) error
+	modifiers             []func(*sql.Selector)
+	withNamedRequests     map[string]*RequestQuery
+	withNamedExecutions   map[string]*RequestExecutionQuery
+	withNamedUsageLogs    map[string]*UsageLogQuery
+	withNamedRoutingRules map[string]*RoutingRuleQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -137,6 +140,28 @@ func (cq *ChannelQuery) QueryUsageLogs() *UsageLogQuery {
 	return query
 }
 
+// QueryRoutingRules chains the current query on the "routing_rules" edge.
+func (cq *ChannelQuery) QueryRoutingRules() *RoutingRuleQuery {
+	query := (&RoutingRuleClient{config: cq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(channel.Table, channel.FieldID, selector),
+			sqlgraph.To(routingrule.Table, routingrule.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, channel.RoutingRulesTable, channel.RoutingRulesPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Channel entity from the query.
 // Returns a *NotFoundError when no Channel was found.
 func (cq *ChannelQuery) First(ctx context.Context) (*Channel, error) {
@@ -324,14 +349,15 @@ func (cq *ChannelQuery) Clone() *ChannelQuery {
 		return nil
 	}
 	return &ChannelQuery{
-		config:         cq.config,
-		ctx:            cq.ctx.Clone(),
-		order:          append([]channel.OrderOption{}, cq.order...),
-		inters:         append([]Interceptor{}, cq.inters...),
-		predicates:     append([]predicate.Channel{}, cq.predicates...),
-		withRequests:   cq.withRequests.Clone(),
-		withExecutions: cq.withExecutions.Clone(),
-		withUsageLogs:  cq.withUsageLogs.Clone(),
+		config:           cq.config,
+		ctx:              cq.ctx.Clone(),
+		order:            append([]channel.OrderOption{}, cq.order...),
+		inters:           append([]Interceptor{}, cq.inters...),
+		predicates:       append([]predicate.Channel{}, cq.predicates...),
+		withRequests:     cq.withRequests.Clone(),
+		withExecutions:   cq.withExecutions.Clone(),
+		withUsageLogs:    cq.withUsageLogs.Clone(),
+		withRoutingRules: cq.withRoutingRules.Clone(),
 		// clone intermediate query.
 		sql:       cq.sql.Clone(),
 		path:      cq.path,
@@ -372,6 +398,17 @@ func (cq *ChannelQuery) WithUsageLogs(opts ...func(*UsageLogQuery)) *ChannelQuer
 	return cq
 }
 
+// WithRoutingRules tells the query-builder to eager-load the nodes that are connected to
+// the "routing_rules" edge. The optional arguments are used to configure the query builder of the edge.
+func (cq *ChannelQuery) WithRoutingRules(opts ...func(*RoutingRuleQuery)) *ChannelQuery {
+	query := (&RoutingRuleClient{config: cq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withRoutingRules = query
+	return cq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -456,10 +493,11 @@ func (cq *ChannelQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Chan
 	var (
 		nodes       = []*Channel{}
 		_spec       = cq.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [4]bool{
 			cq.withRequests != nil,
 			cq.withExecutions != nil,
 			cq.withUsageLogs != nil,
+			cq.withRoutingRules != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -504,6 +542,13 @@ func (cq *ChannelQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Chan
 			return nil, err
 		}
 	}
+	if query := cq.withRoutingRules; query != nil {
+		if err := cq.loadRoutingRules(ctx, query, nodes,
+			func(n *Channel) { n.Edges.RoutingRules = []*RoutingRule{} },
+			func(n *Channel, e *RoutingRule) { n.Edges.RoutingRules = append(n.Edges.RoutingRules, e) }); err != nil {
+			return nil, err
+		}
+	}
 	for name, query := range cq.withNamedRequests {
 		if err := cq.loadRequests(ctx, query, nodes,
 			func(n *Channel) { n.appendNamedRequests(name) },
@@ -525,6 +570,13 @@ func (cq *ChannelQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Chan
 			return nil, err
 		}
 	}
+	for name, query := range cq.withNamedRoutingRules {
+		if err := cq.loadRoutingRules(ctx, query, nodes,
+			func(n *Channel) { n.appendNamedRoutingRules(name) },
+			func(n *Channel, e *RoutingRule) { n.appendNamedRoutingRules(name, e) }); err != nil {
+			return nil, err
+		}
+	}
 	for i := range cq.loadTotal {
 		if err := cq.loadTotal[i](ctx, nodes); err != nil {
 			return nil, err
@@ -623,6 +675,67 @@ func (cq *ChannelQuery) loadUsageLogs(ctx context.Context, query *UsageLogQuery,
 	}
 	return nil
 }
+func (cq *ChannelQuery) loadRoutingRules(ctx context.Context, query *RoutingRuleQuery, nodes []*Channel, init func(*Channel), assign func(*Channel, *RoutingRule)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Channel)
+	nids := make(map[int]map[*Channel]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(channel.RoutingRulesTable)
+		s.Join(joinT).On(s.C(routingrule.FieldID), joinT.C(channel.RoutingRulesPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(channel.RoutingRulesPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(channel.RoutingRulesPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Channel]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*RoutingRule](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "routing_rules" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
 
 func (cq *ChannelQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := cq.querySpec()
@@ -759,6 +872,20 @@ func (cq *ChannelQuery) WithNamedUsageLogs(name string, opts ...func(*UsageLogQu
 	return cq
 }
 
+// WithNamedRoutingRules tells the query-builder to eager-load the nodes that are connected to the "routing_rules"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (cq *ChannelQuery) WithNamedRoutingRules(name string, opts ...func(*RoutingRuleQuery)) *ChannelQuery {
+	query := (&RoutingRuleClient{config: cq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if cq.withNamedRoutingRules == nil {
+		cq.withNamedRoutingRules = make(map[string]*RoutingRuleQuery)
+	}
+	cq.withNamedRoutingRules[name] = query
+	return cq
+}
+
 // ChannelGroupBy is the group-by builder for Channel entities.
 type ChannelGroupBy struct {
 	selector
@@ -16,6 +16,7 @@ import (
 	"github.com/looplj/axonhub/internal/ent/predicate"
 	"github.com/looplj/axonhub/internal/ent/request"
 	"github.com/looplj/axonhub/internal/ent/requestexecution"
+	"github.com/looplj/axonhub/internal/ent/routingrule"
 	"github.com/looplj/axonhub/internal/ent/usagelog"
 	"github.com/looplj/axonhub/internal/objects"
 )
@@ -219,6 +220,21 @@ func (cu *ChannelUpdate) AddUsageLogs(u ...*UsageLog) *ChannelUpdate {
 	return cu.AddUsageLogIDs(ids...)
 }
 
+// AddRoutingRuleIDs adds the "routing_rules" edge to the RoutingRule entity by IDs.
+func (cu *ChannelUpdate) AddRoutingRuleIDs(ids ...int) *ChannelUpdate {
+	cu.mutation.AddRoutingRuleIDs(ids...)
+	return cu
+}
+
+// AddRoutingRules adds the "routing_rules" edges to the RoutingRule entity.
+func (cu *ChannelUpdate) AddRoutingRules(r ...*RoutingRule) *ChannelUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return cu.AddRoutingRuleIDs(ids...)
+}
+
 // Mutation returns the ChannelMutation object of the builder.
 func (cu *ChannelUpdate) Mutation() *ChannelMutation {
 	return cu.mutation
@@ -287,6 +303,27 @@ func (cu *ChannelUpdate) RemoveUsageLogs(u ...*UsageLog) *ChannelUpdate {
 	return cu.RemoveUsageLogIDs(ids...)
 }
 
+// ClearRoutingRules clears all "routing_rules" edges to the RoutingRule entity.
+func (cu *ChannelUpdate) ClearRoutingRules() *ChannelUpdate {
+	cu.mutation.ClearRoutingRules()
+	return cu
+}
+
+// RemoveRoutingRuleIDs removes the "routing_rules" edge to RoutingRule entities by IDs.
+func (cu *ChannelUpdate) RemoveRoutingRuleIDs(ids ...int) *ChannelUpdate {
+	cu.mutation.RemoveRoutingRuleIDs(ids...)
+	return cu
+}
+
+// RemoveRoutingRules removes "routing_rules" edges to RoutingRule entities.
+func (cu *ChannelUpdate) RemoveRoutingRules(r ...*RoutingRule) *ChannelUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return cu.RemoveRoutingRuleIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (cu *ChannelUpdate) Save(ctx context.Context) (int, error) {
 	if err := cu.defaults(); err != nil {
@@ -539,6 +576,51 @@ func (cu *ChannelUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if cu.mutation.RoutingRulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   channel.RoutingRulesTable,
+			Columns: channel.RoutingRulesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(routingrule.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedRoutingRulesIDs(); len(nodes) > 0 && !cu.mutation.RoutingRulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   channel.RoutingRulesTable,
+			Columns: channel.RoutingRulesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(routingrule.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RoutingRulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   channel.RoutingRulesTable,
+			Columns: channel.RoutingRulesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(routingrule.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(cu.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -746,6 +828,21 @@ func (cuo *ChannelUpdateOne) AddUsageLogs(u ...*UsageLog) *ChannelUpdateOne {
 	return cuo.AddUsageLogIDs(ids...)
 }
 
+// AddRoutingRuleIDs adds the "routing_rules" edge to the RoutingRule entity by IDs.
+func (cuo *ChannelUpdateOne) AddRoutingRuleIDs(ids ...int) *ChannelUpdateOne {
+	cuo.mutation.AddRoutingRuleIDs(ids...)
+	return cuo
+}
+
+// AddRoutingRules adds the "routing_rules" edges to the RoutingRule entity.
+func (cuo *ChannelUpdateOne) AddRoutingRules(r ...*RoutingRule) *ChannelUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return cuo.AddRoutingRuleIDs(ids...)
+}
+
 // Mutation returns the ChannelMutation object of the builder.
 func (cuo *ChannelUpdateOne) Mutation() *ChannelMutation {
 	return cuo.mutation
@@ -814,6 +911,27 @@ func (cuo *ChannelUpdateOne) RemoveUsageLogs(u ...*UsageLog) *ChannelUpdateOne {
 	return cuo.RemoveUsageLogIDs(ids...)
 }
 
+// ClearRoutingRules clears all "routing_rules" edges to the RoutingRule entity.
+func (cuo *ChannelUpdateOne) ClearRoutingRules() *ChannelUpdateOne {
+	cuo.mutation.ClearRoutingRules()
+	return cuo
+}
+
+// RemoveRoutingRuleIDs removes the "routing_rules" edge to RoutingRule entities by IDs.
+func (cuo *ChannelUpdateOne) RemoveRoutingRuleIDs(ids ...int) *ChannelUpdateOne {
+	cuo.mutation.RemoveRoutingRuleIDs(ids...)
+	return cuo
+}
+
+// RemoveRoutingRules removes "routing_rules" edges to RoutingRule entities.
+func (cuo *ChannelUpdateOne) RemoveRoutingRules(r ...*RoutingRule) *ChannelUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return cuo.RemoveRoutingRuleIDs(ids...)
+}
+
 // Where appends a list predicates to the ChannelUpdate builder.
 func (cuo *ChannelUpdateOne) Where(ps ...predicate.Channel) *ChannelUpdateOne {
 	cuo.mutation.Where(ps...)
@@ -1096,6 +1214,51 @@ func (cuo *ChannelUpdateOne) sqlSave(ctx context.Context) (_node *Channel, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if cuo.mutation.RoutingRulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   channel.RoutingRulesTable,
+			Columns: channel.RoutingRulesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(routingrule.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedRoutingRulesIDs(); len(nodes) > 0 && !cuo.mutation.RoutingRulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   channel.RoutingRulesTable,
+			Columns: channel.RoutingRulesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(routingrule.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RoutingRulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   channel.RoutingRulesTable,
+			Columns: channel.RoutingRulesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(routingrule.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(cuo.modifiers...)
 	_node = &Channel{config: cuo.config}
 	_spec.Assign = _node.assignValues
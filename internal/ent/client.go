@@ -19,10 +19,13 @@ import (
 	"github.com/looplj/axonhub/internal/ent/channel"
 	"github.com/looplj/axonhub/internal/ent/request"
 	"github.com/looplj/axonhub/internal/ent/requestexecution"
+	"github.com/looplj/axonhub/internal/ent/responsecache"
 	"github.com/looplj/axonhub/internal/ent/role"
+	"github.com/looplj/axonhub/internal/ent/routingrule"
 	"github.com/looplj/axonhub/internal/ent/system"
 	"github.com/looplj/axonhub/internal/ent/usagelog"
 	"github.com/looplj/axonhub/internal/ent/user"
+	"github.com/looplj/axonhub/internal/ent/virtualmodel"
 )
 
 // Client is the client that holds all ent builders.
@@ -38,14 +41,20 @@ type Client struct {
 	Request *RequestClient
 	// RequestExecution is the client for interacting with the RequestExecution builders.
 	RequestExecution *RequestExecutionClient
+	// ResponseCache is the client for interacting with the ResponseCache builders.
+	ResponseCache *ResponseCacheClient
 	// Role is the client for interacting with the Role builders.
 	Role *RoleClient
+	// RoutingRule is the client for interacting with the RoutingRule builders.
+	RoutingRule *RoutingRuleClient
 	// System is the client for interacting with the System builders.
 	System *SystemClient
 	// UsageLog is the client for interacting with the UsageLog builders.
 	UsageLog *UsageLogClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// VirtualModel is the client for interacting with the VirtualModel builders.
+	VirtualModel *VirtualModelClient
 	// additional fields for node api
 	tables tables
 }
@@ -63,10 +72,13 @@ func (c *Client) init() {
 	c.Channel = NewChannelClient(c.config)
 	c.Request = NewRequestClient(c.config)
 	c.RequestExecution = NewRequestExecutionClient(c.config)
+	c.ResponseCache = NewResponseCacheClient(c.config)
 	c.Role = NewRoleClient(c.config)
+	c.RoutingRule = NewRoutingRuleClient(c.config)
 	c.System = NewSystemClient(c.config)
 	c.UsageLog = NewUsageLogClient(c.config)
 	c.User = NewUserClient(c.config)
+	c.VirtualModel = NewVirtualModelClient(c.config)
 }
 
 type (
@@ -163,10 +175,13 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		Channel:          NewChannelClient(cfg),
 		Request:          NewRequestClient(cfg),
 		RequestExecution: NewRequestExecutionClient(cfg),
+		ResponseCache:    NewResponseCacheClient(cfg),
 		Role:             NewRoleClient(cfg),
+		RoutingRule:      NewRoutingRuleClient(cfg),
 		System:           NewSystemClient(cfg),
 		UsageLog:         NewUsageLogClient(cfg),
 		User:             NewUserClient(cfg),
+		VirtualModel:     NewVirtualModelClient(cfg),
 	}, nil
 }
 
@@ -190,10 +205,13 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		Channel:          NewChannelClient(cfg),
 		Request:          NewRequestClient(cfg),
 		RequestExecution: NewRequestExecutionClient(cfg),
+		ResponseCache:    NewResponseCacheClient(cfg),
 		Role:             NewRoleClient(cfg),
+		RoutingRule:      NewRoutingRuleClient(cfg),
 		System:           NewSystemClient(cfg),
 		UsageLog:         NewUsageLogClient(cfg),
 		User:             NewUserClient(cfg),
+		VirtualModel:     NewVirtualModelClient(cfg),
 	}, nil
 }
 
@@ -223,8 +241,8 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.APIKey, c.Channel, c.Request, c.RequestExecution, c.Role, c.System,
-		c.UsageLog, c.User,
+		c.APIKey, c.Channel, c.Request, c.RequestExecution, c.ResponseCache, c.Role,
+		c.RoutingRule, c.System, c.UsageLog, c.User, c.VirtualModel,
 	} {
 		n.Use(hooks...)
 	}
@@ -234,8 +252,8 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.APIKey, c.Channel, c.Request, c.RequestExecution, c.Role, c.System,
-		c.UsageLog, c.User,
+		c.APIKey, c.Channel, c.Request, c.RequestExecution, c.ResponseCache, c.Role,
+		c.RoutingRule, c.System, c.UsageLog, c.User, c.VirtualModel,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -252,14 +270,20 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Request.mutate(ctx, m)
 	case *RequestExecutionMutation:
 		return c.RequestExecution.mutate(ctx, m)
+	case *ResponseCacheMutation:
+		return c.ResponseCache.mutate(ctx, m)
 	case *RoleMutation:
 		return c.Role.mutate(ctx, m)
+	case *RoutingRuleMutation:
+		return c.RoutingRule.mutate(ctx, m)
 	case *SystemMutation:
 		return c.System.mutate(ctx, m)
 	case *UsageLogMutation:
 		return c.UsageLog.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
+	case *VirtualModelMutation:
+		return c.VirtualModel.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
@@ -588,6 +612,22 @@ func (c *ChannelClient) QueryUsageLogs(ch *Channel) *UsageLogQuery {
 	return query
 }
 
+// QueryRoutingRules queries the routing_rules edge of a Channel.
+func (c *ChannelClient) QueryRoutingRules(ch *Channel) *RoutingRuleQuery {
+	query := (&RoutingRuleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ch.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(channel.Table, channel.FieldID, id),
+			sqlgraph.To(routingrule.Table, routingrule.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, channel.RoutingRulesTable, channel.RoutingRulesPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(ch.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *ChannelClient) Hooks() []Hook {
 	hooks := c.hooks.Channel
@@ -995,6 +1035,139 @@ func (c *RequestExecutionClient) mutate(ctx context.Context, m *RequestExecution
 	}
 }
 
+// ResponseCacheClient is a client for the ResponseCache schema.
+type ResponseCacheClient struct {
+	config
+}
+
+// NewResponseCacheClient returns a client for the ResponseCache from the given config.
+func NewResponseCacheClient(c config) *ResponseCacheClient {
+	return &ResponseCacheClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `responsecache.Hooks(f(g(h())))`.
+func (c *ResponseCacheClient) Use(hooks ...Hook) {
+	c.hooks.ResponseCache = append(c.hooks.ResponseCache, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `responsecache.Intercept(f(g(h())))`.
+func (c *ResponseCacheClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ResponseCache = append(c.inters.ResponseCache, interceptors...)
+}
+
+// Create returns a builder for creating a ResponseCache entity.
+func (c *ResponseCacheClient) Create() *ResponseCacheCreate {
+	mutation := newResponseCacheMutation(c.config, OpCreate)
+	return &ResponseCacheCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ResponseCache entities.
+func (c *ResponseCacheClient) CreateBulk(builders ...*ResponseCacheCreate) *ResponseCacheCreateBulk {
+	return &ResponseCacheCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ResponseCacheClient) MapCreateBulk(slice any, setFunc func(*ResponseCacheCreate, int)) *ResponseCacheCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ResponseCacheCreateBulk{err: fmt.Errorf("calling to ResponseCacheClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ResponseCacheCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ResponseCacheCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ResponseCache.
+func (c *ResponseCacheClient) Update() *ResponseCacheUpdate {
+	mutation := newResponseCacheMutation(c.config, OpUpdate)
+	return &ResponseCacheUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ResponseCacheClient) UpdateOne(rc *ResponseCache) *ResponseCacheUpdateOne {
+	mutation := newResponseCacheMutation(c.config, OpUpdateOne, withResponseCache(rc))
+	return &ResponseCacheUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ResponseCacheClient) UpdateOneID(id int) *ResponseCacheUpdateOne {
+	mutation := newResponseCacheMutation(c.config, OpUpdateOne, withResponseCacheID(id))
+	return &ResponseCacheUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ResponseCache.
+func (c *ResponseCacheClient) Delete() *ResponseCacheDelete {
+	mutation := newResponseCacheMutation(c.config, OpDelete)
+	return &ResponseCacheDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ResponseCacheClient) DeleteOne(rc *ResponseCache) *ResponseCacheDeleteOne {
+	return c.DeleteOneID(rc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ResponseCacheClient) DeleteOneID(id int) *ResponseCacheDeleteOne {
+	builder := c.Delete().Where(responsecache.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ResponseCacheDeleteOne{builder}
+}
+
+// Query returns a query builder for ResponseCache.
+func (c *ResponseCacheClient) Query() *ResponseCacheQuery {
+	return &ResponseCacheQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeResponseCache},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ResponseCache entity by its id.
+func (c *ResponseCacheClient) Get(ctx context.Context, id int) (*ResponseCache, error) {
+	return c.Query().Where(responsecache.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ResponseCacheClient) GetX(ctx context.Context, id int) *ResponseCache {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ResponseCacheClient) Hooks() []Hook {
+	return c.hooks.ResponseCache
+}
+
+// Interceptors returns the client interceptors.
+func (c *ResponseCacheClient) Interceptors() []Interceptor {
+	return c.inters.ResponseCache
+}
+
+func (c *ResponseCacheClient) mutate(ctx context.Context, m *ResponseCacheMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ResponseCacheCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ResponseCacheUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ResponseCacheUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ResponseCacheDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ResponseCache mutation op: %q", m.Op())
+	}
+}
+
 // RoleClient is a client for the Role schema.
 type RoleClient struct {
 	config
@@ -1146,6 +1319,157 @@ func (c *RoleClient) mutate(ctx context.Context, m *RoleMutation) (Value, error)
 	}
 }
 
+// RoutingRuleClient is a client for the RoutingRule schema.
+type RoutingRuleClient struct {
+	config
+}
+
+// NewRoutingRuleClient returns a client for the RoutingRule from the given config.
+func NewRoutingRuleClient(c config) *RoutingRuleClient {
+	return &RoutingRuleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `routingrule.Hooks(f(g(h())))`.
+func (c *RoutingRuleClient) Use(hooks ...Hook) {
+	c.hooks.RoutingRule = append(c.hooks.RoutingRule, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `routingrule.Intercept(f(g(h())))`.
+func (c *RoutingRuleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RoutingRule = append(c.inters.RoutingRule, interceptors...)
+}
+
+// Create returns a builder for creating a RoutingRule entity.
+func (c *RoutingRuleClient) Create() *RoutingRuleCreate {
+	mutation := newRoutingRuleMutation(c.config, OpCreate)
+	return &RoutingRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RoutingRule entities.
+func (c *RoutingRuleClient) CreateBulk(builders ...*RoutingRuleCreate) *RoutingRuleCreateBulk {
+	return &RoutingRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RoutingRuleClient) MapCreateBulk(slice any, setFunc func(*RoutingRuleCreate, int)) *RoutingRuleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RoutingRuleCreateBulk{err: fmt.Errorf("calling to RoutingRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RoutingRuleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RoutingRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RoutingRule.
+func (c *RoutingRuleClient) Update() *RoutingRuleUpdate {
+	mutation := newRoutingRuleMutation(c.config, OpUpdate)
+	return &RoutingRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RoutingRuleClient) UpdateOne(rr *RoutingRule) *RoutingRuleUpdateOne {
+	mutation := newRoutingRuleMutation(c.config, OpUpdateOne, withRoutingRule(rr))
+	return &RoutingRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RoutingRuleClient) UpdateOneID(id int) *RoutingRuleUpdateOne {
+	mutation := newRoutingRuleMutation(c.config, OpUpdateOne, withRoutingRuleID(id))
+	return &RoutingRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RoutingRule.
+func (c *RoutingRuleClient) Delete() *RoutingRuleDelete {
+	mutation := newRoutingRuleMutation(c.config, OpDelete)
+	return &RoutingRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RoutingRuleClient) DeleteOne(rr *RoutingRule) *RoutingRuleDeleteOne {
+	return c.DeleteOneID(rr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RoutingRuleClient) DeleteOneID(id int) *RoutingRuleDeleteOne {
+	builder := c.Delete().Where(routingrule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RoutingRuleDeleteOne{builder}
+}
+
+// Query returns a query builder for RoutingRule.
+func (c *RoutingRuleClient) Query() *RoutingRuleQuery {
+	return &RoutingRuleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRoutingRule},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RoutingRule entity by its id.
+func (c *RoutingRuleClient) Get(ctx context.Context, id int) (*RoutingRule, error) {
+	return c.Query().Where(routingrule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RoutingRuleClient) GetX(ctx context.Context, id int) *RoutingRule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryChannels queries the channels edge of a RoutingRule.
+func (c *RoutingRuleClient) QueryChannels(rr *RoutingRule) *ChannelQuery {
+	query := (&ChannelClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := rr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(routingrule.Table, routingrule.FieldID, id),
+			sqlgraph.To(channel.Table, channel.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, routingrule.ChannelsTable, routingrule.ChannelsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(rr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RoutingRuleClient) Hooks() []Hook {
+	hooks := c.hooks.RoutingRule
+	return append(hooks[:len(hooks):len(hooks)], routingrule.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *RoutingRuleClient) Interceptors() []Interceptor {
+	inters := c.inters.RoutingRule
+	return append(inters[:len(inters):len(inters)], routingrule.Interceptors[:]...)
+}
+
+func (c *RoutingRuleClient) mutate(ctx context.Context, m *RoutingRuleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RoutingRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RoutingRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RoutingRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RoutingRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RoutingRule mutation op: %q", m.Op())
+	}
+}
+
 // SystemClient is a client for the System schema.
 type SystemClient struct {
 	config
@@ -1663,14 +1987,149 @@ func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error)
 	}
 }
 
+// VirtualModelClient is a client for the VirtualModel schema.
+type VirtualModelClient struct {
+	config
+}
+
+// NewVirtualModelClient returns a client for the VirtualModel from the given config.
+func NewVirtualModelClient(c config) *VirtualModelClient {
+	return &VirtualModelClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `virtualmodel.Hooks(f(g(h())))`.
+func (c *VirtualModelClient) Use(hooks ...Hook) {
+	c.hooks.VirtualModel = append(c.hooks.VirtualModel, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `virtualmodel.Intercept(f(g(h())))`.
+func (c *VirtualModelClient) Intercept(interceptors ...Interceptor) {
+	c.inters.VirtualModel = append(c.inters.VirtualModel, interceptors...)
+}
+
+// Create returns a builder for creating a VirtualModel entity.
+func (c *VirtualModelClient) Create() *VirtualModelCreate {
+	mutation := newVirtualModelMutation(c.config, OpCreate)
+	return &VirtualModelCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of VirtualModel entities.
+func (c *VirtualModelClient) CreateBulk(builders ...*VirtualModelCreate) *VirtualModelCreateBulk {
+	return &VirtualModelCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *VirtualModelClient) MapCreateBulk(slice any, setFunc func(*VirtualModelCreate, int)) *VirtualModelCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &VirtualModelCreateBulk{err: fmt.Errorf("calling to VirtualModelClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*VirtualModelCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &VirtualModelCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for VirtualModel.
+func (c *VirtualModelClient) Update() *VirtualModelUpdate {
+	mutation := newVirtualModelMutation(c.config, OpUpdate)
+	return &VirtualModelUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *VirtualModelClient) UpdateOne(vm *VirtualModel) *VirtualModelUpdateOne {
+	mutation := newVirtualModelMutation(c.config, OpUpdateOne, withVirtualModel(vm))
+	return &VirtualModelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *VirtualModelClient) UpdateOneID(id int) *VirtualModelUpdateOne {
+	mutation := newVirtualModelMutation(c.config, OpUpdateOne, withVirtualModelID(id))
+	return &VirtualModelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for VirtualModel.
+func (c *VirtualModelClient) Delete() *VirtualModelDelete {
+	mutation := newVirtualModelMutation(c.config, OpDelete)
+	return &VirtualModelDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *VirtualModelClient) DeleteOne(vm *VirtualModel) *VirtualModelDeleteOne {
+	return c.DeleteOneID(vm.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *VirtualModelClient) DeleteOneID(id int) *VirtualModelDeleteOne {
+	builder := c.Delete().Where(virtualmodel.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &VirtualModelDeleteOne{builder}
+}
+
+// Query returns a query builder for VirtualModel.
+func (c *VirtualModelClient) Query() *VirtualModelQuery {
+	return &VirtualModelQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeVirtualModel},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a VirtualModel entity by its id.
+func (c *VirtualModelClient) Get(ctx context.Context, id int) (*VirtualModel, error) {
+	return c.Query().Where(virtualmodel.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *VirtualModelClient) GetX(ctx context.Context, id int) *VirtualModel {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *VirtualModelClient) Hooks() []Hook {
+	hooks := c.hooks.VirtualModel
+	return append(hooks[:len(hooks):len(hooks)], virtualmodel.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *VirtualModelClient) Interceptors() []Interceptor {
+	inters := c.inters.VirtualModel
+	return append(inters[:len(inters):len(inters)], virtualmodel.Interceptors[:]...)
+}
+
+func (c *VirtualModelClient) mutate(ctx context.Context, m *VirtualModelMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&VirtualModelCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&VirtualModelUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&VirtualModelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&VirtualModelDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown VirtualModel mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		APIKey, Channel, Request, RequestExecution, Role, System, UsageLog,
-		User []ent.Hook
+		APIKey, Channel, Request, RequestExecution, ResponseCache, Role, RoutingRule,
+		System, UsageLog, User, VirtualModel []ent.Hook
 	}
 	inters struct {
-		APIKey, Channel, Request, RequestExecution, Role, System, UsageLog,
-		User []ent.Interceptor
+		APIKey, Channel, Request, RequestExecution, ResponseCache, Role, RoutingRule,
+		System, UsageLog, User, VirtualModel []ent.Interceptor
 	}
 )
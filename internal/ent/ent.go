@@ -16,10 +16,13 @@ import (
 	"github.com/looplj/axonhub/internal/ent/channel"
 	"github.com/looplj/axonhub/internal/ent/request"
 	"github.com/looplj/axonhub/internal/ent/requestexecution"
+	"github.com/looplj/axonhub/internal/ent/responsecache"
 	"github.com/looplj/axonhub/internal/ent/role"
+	"github.com/looplj/axonhub/internal/ent/routingrule"
 	"github.com/looplj/axonhub/internal/ent/system"
 	"github.com/looplj/axonhub/internal/ent/usagelog"
 	"github.com/looplj/axonhub/internal/ent/user"
+	"github.com/looplj/axonhub/internal/ent/virtualmodel"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -84,10 +87,13 @@ func checkColumn(table, column string) error {
 			channel.Table:          channel.ValidColumn,
 			request.Table:          request.ValidColumn,
 			requestexecution.Table: requestexecution.ValidColumn,
+			responsecache.Table:    responsecache.ValidColumn,
 			role.Table:             role.ValidColumn,
+			routingrule.Table:      routingrule.ValidColumn,
 			system.Table:           system.ValidColumn,
 			usagelog.Table:         usagelog.ValidColumn,
 			user.Table:             user.ValidColumn,
+			virtualmodel.Table:     virtualmodel.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -8,10 +8,13 @@ import (
 	"github.com/looplj/axonhub/internal/ent/predicate"
 	"github.com/looplj/axonhub/internal/ent/request"
 	"github.com/looplj/axonhub/internal/ent/requestexecution"
+	"github.com/looplj/axonhub/internal/ent/responsecache"
 	"github.com/looplj/axonhub/internal/ent/role"
+	"github.com/looplj/axonhub/internal/ent/routingrule"
 	"github.com/looplj/axonhub/internal/ent/system"
 	"github.com/looplj/axonhub/internal/ent/usagelog"
 	"github.com/looplj/axonhub/internal/ent/user"
+	"github.com/looplj/axonhub/internal/ent/virtualmodel"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -21,7 +24,7 @@ import (
 
 // schemaGraph holds a representation of ent/schema at runtime.
 var schemaGraph = func() *sqlgraph.Schema {
-	graph := &sqlgraph.Schema{Nodes: make([]*sqlgraph.Node, 8)}
+	graph := &sqlgraph.Schema{Nodes: make([]*sqlgraph.Node, 11)}
 	graph.Nodes[0] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   apikey.Table,
@@ -33,15 +36,27 @@ var schemaGraph = func() *sqlgraph.Schema {
 		},
 		Type: "APIKey",
 		Fields: map[string]*sqlgraph.FieldSpec{
-			apikey.FieldCreatedAt: {Type: field.TypeTime, Column: apikey.FieldCreatedAt},
-			apikey.FieldUpdatedAt: {Type: field.TypeTime, Column: apikey.FieldUpdatedAt},
-			apikey.FieldDeletedAt: {Type: field.TypeInt, Column: apikey.FieldDeletedAt},
-			apikey.FieldUserID:    {Type: field.TypeInt, Column: apikey.FieldUserID},
-			apikey.FieldKey:       {Type: field.TypeString, Column: apikey.FieldKey},
-			apikey.FieldName:      {Type: field.TypeString, Column: apikey.FieldName},
-			apikey.FieldStatus:    {Type: field.TypeEnum, Column: apikey.FieldStatus},
-			apikey.FieldScopes:    {Type: field.TypeJSON, Column: apikey.FieldScopes},
-			apikey.FieldProfiles:  {Type: field.TypeJSON, Column: apikey.FieldProfiles},
+			apikey.FieldCreatedAt:             {Type: field.TypeTime, Column: apikey.FieldCreatedAt},
+			apikey.FieldUpdatedAt:             {Type: field.TypeTime, Column: apikey.FieldUpdatedAt},
+			apikey.FieldDeletedAt:             {Type: field.TypeInt, Column: apikey.FieldDeletedAt},
+			apikey.FieldUserID:                {Type: field.TypeInt, Column: apikey.FieldUserID},
+			apikey.FieldKey:                   {Type: field.TypeString, Column: apikey.FieldKey},
+			apikey.FieldKeyPrefix:             {Type: field.TypeString, Column: apikey.FieldKeyPrefix},
+			apikey.FieldLastUsedAt:            {Type: field.TypeTime, Column: apikey.FieldLastUsedAt},
+			apikey.FieldPreviousKey:           {Type: field.TypeString, Column: apikey.FieldPreviousKey},
+			apikey.FieldPreviousKeyPrefix:     {Type: field.TypeString, Column: apikey.FieldPreviousKeyPrefix},
+			apikey.FieldPreviousKeyExpiresAt:  {Type: field.TypeTime, Column: apikey.FieldPreviousKeyExpiresAt},
+			apikey.FieldPreviousKeyLastUsedAt: {Type: field.TypeTime, Column: apikey.FieldPreviousKeyLastUsedAt},
+			apikey.FieldName:                  {Type: field.TypeString, Column: apikey.FieldName},
+			apikey.FieldStatus:                {Type: field.TypeEnum, Column: apikey.FieldStatus},
+			apikey.FieldScopes:                {Type: field.TypeJSON, Column: apikey.FieldScopes},
+			apikey.FieldExpiresAt:             {Type: field.TypeTime, Column: apikey.FieldExpiresAt},
+			apikey.FieldAllowedCidrs:          {Type: field.TypeJSON, Column: apikey.FieldAllowedCidrs},
+			apikey.FieldAllowedModels:         {Type: field.TypeJSON, Column: apikey.FieldAllowedModels},
+			apikey.FieldCacheMode:             {Type: field.TypeEnum, Column: apikey.FieldCacheMode},
+			apikey.FieldCacheScope:            {Type: field.TypeString, Column: apikey.FieldCacheScope},
+			apikey.FieldGuardrailPolicy:       {Type: field.TypeJSON, Column: apikey.FieldGuardrailPolicy},
+			apikey.FieldProfiles:              {Type: field.TypeJSON, Column: apikey.FieldProfiles},
 		},
 	}
 	graph.Nodes[1] = &sqlgraph.Node{
@@ -80,20 +95,23 @@ var schemaGraph = func() *sqlgraph.Schema {
 		},
 		Type: "Request",
 		Fields: map[string]*sqlgraph.FieldSpec{
-			request.FieldCreatedAt:      {Type: field.TypeTime, Column: request.FieldCreatedAt},
-			request.FieldUpdatedAt:      {Type: field.TypeTime, Column: request.FieldUpdatedAt},
-			request.FieldDeletedAt:      {Type: field.TypeInt, Column: request.FieldDeletedAt},
-			request.FieldUserID:         {Type: field.TypeInt, Column: request.FieldUserID},
-			request.FieldAPIKeyID:       {Type: field.TypeInt, Column: request.FieldAPIKeyID},
-			request.FieldSource:         {Type: field.TypeEnum, Column: request.FieldSource},
-			request.FieldModelID:        {Type: field.TypeString, Column: request.FieldModelID},
-			request.FieldFormat:         {Type: field.TypeString, Column: request.FieldFormat},
-			request.FieldRequestBody:    {Type: field.TypeJSON, Column: request.FieldRequestBody},
-			request.FieldResponseBody:   {Type: field.TypeJSON, Column: request.FieldResponseBody},
-			request.FieldResponseChunks: {Type: field.TypeJSON, Column: request.FieldResponseChunks},
-			request.FieldChannelID:      {Type: field.TypeInt, Column: request.FieldChannelID},
-			request.FieldExternalID:     {Type: field.TypeString, Column: request.FieldExternalID},
-			request.FieldStatus:         {Type: field.TypeEnum, Column: request.FieldStatus},
+			request.FieldCreatedAt:           {Type: field.TypeTime, Column: request.FieldCreatedAt},
+			request.FieldUpdatedAt:           {Type: field.TypeTime, Column: request.FieldUpdatedAt},
+			request.FieldDeletedAt:           {Type: field.TypeInt, Column: request.FieldDeletedAt},
+			request.FieldUserID:              {Type: field.TypeInt, Column: request.FieldUserID},
+			request.FieldAPIKeyID:            {Type: field.TypeInt, Column: request.FieldAPIKeyID},
+			request.FieldSource:              {Type: field.TypeEnum, Column: request.FieldSource},
+			request.FieldModelID:             {Type: field.TypeString, Column: request.FieldModelID},
+			request.FieldFormat:              {Type: field.TypeString, Column: request.FieldFormat},
+			request.FieldRequestBody:         {Type: field.TypeJSON, Column: request.FieldRequestBody},
+			request.FieldResponseBody:        {Type: field.TypeJSON, Column: request.FieldResponseBody},
+			request.FieldResponseChunks:      {Type: field.TypeJSON, Column: request.FieldResponseChunks},
+			request.FieldChannelID:           {Type: field.TypeInt, Column: request.FieldChannelID},
+			request.FieldExternalID:          {Type: field.TypeString, Column: request.FieldExternalID},
+			request.FieldCached:              {Type: field.TypeBool, Column: request.FieldCached},
+			request.FieldGuardrailViolations: {Type: field.TypeJSON, Column: request.FieldGuardrailViolations},
+			request.FieldFallbackHops:        {Type: field.TypeJSON, Column: request.FieldFallbackHops},
+			request.FieldStatus:              {Type: field.TypeEnum, Column: request.FieldStatus},
 		},
 	}
 	graph.Nodes[3] = &sqlgraph.Node{
@@ -123,6 +141,24 @@ var schemaGraph = func() *sqlgraph.Schema {
 		},
 	}
 	graph.Nodes[4] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   responsecache.Table,
+			Columns: responsecache.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: responsecache.FieldID,
+			},
+		},
+		Type: "ResponseCache",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			responsecache.FieldCreatedAt: {Type: field.TypeTime, Column: responsecache.FieldCreatedAt},
+			responsecache.FieldUpdatedAt: {Type: field.TypeTime, Column: responsecache.FieldUpdatedAt},
+			responsecache.FieldKey:       {Type: field.TypeString, Column: responsecache.FieldKey},
+			responsecache.FieldResponse:  {Type: field.TypeJSON, Column: responsecache.FieldResponse},
+			responsecache.FieldExpiresAt: {Type: field.TypeTime, Column: responsecache.FieldExpiresAt},
+		},
+	}
+	graph.Nodes[5] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   role.Table,
 			Columns: role.Columns,
@@ -141,7 +177,29 @@ var schemaGraph = func() *sqlgraph.Schema {
 			role.FieldScopes:    {Type: field.TypeJSON, Column: role.FieldScopes},
 		},
 	}
-	graph.Nodes[5] = &sqlgraph.Node{
+	graph.Nodes[6] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   routingrule.Table,
+			Columns: routingrule.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: routingrule.FieldID,
+			},
+		},
+		Type: "RoutingRule",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			routingrule.FieldCreatedAt:   {Type: field.TypeTime, Column: routingrule.FieldCreatedAt},
+			routingrule.FieldUpdatedAt:   {Type: field.TypeTime, Column: routingrule.FieldUpdatedAt},
+			routingrule.FieldDeletedAt:   {Type: field.TypeInt, Column: routingrule.FieldDeletedAt},
+			routingrule.FieldName:        {Type: field.TypeString, Column: routingrule.FieldName},
+			routingrule.FieldDescription: {Type: field.TypeString, Column: routingrule.FieldDescription},
+			routingrule.FieldStatus:      {Type: field.TypeEnum, Column: routingrule.FieldStatus},
+			routingrule.FieldPriority:    {Type: field.TypeInt, Column: routingrule.FieldPriority},
+			routingrule.FieldConditions:  {Type: field.TypeJSON, Column: routingrule.FieldConditions},
+			routingrule.FieldAction:      {Type: field.TypeEnum, Column: routingrule.FieldAction},
+		},
+	}
+	graph.Nodes[7] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   system.Table,
 			Columns: system.Columns,
@@ -159,7 +217,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			system.FieldValue:     {Type: field.TypeString, Column: system.FieldValue},
 		},
 	}
-	graph.Nodes[6] = &sqlgraph.Node{
+	graph.Nodes[8] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   usagelog.Table,
 			Columns: usagelog.Columns,
@@ -182,15 +240,17 @@ var schemaGraph = func() *sqlgraph.Schema {
 			usagelog.FieldTotalTokens:                        {Type: field.TypeInt, Column: usagelog.FieldTotalTokens},
 			usagelog.FieldPromptAudioTokens:                  {Type: field.TypeInt, Column: usagelog.FieldPromptAudioTokens},
 			usagelog.FieldPromptCachedTokens:                 {Type: field.TypeInt, Column: usagelog.FieldPromptCachedTokens},
+			usagelog.FieldPromptCacheCreationTokens:          {Type: field.TypeInt, Column: usagelog.FieldPromptCacheCreationTokens},
 			usagelog.FieldCompletionAudioTokens:              {Type: field.TypeInt, Column: usagelog.FieldCompletionAudioTokens},
 			usagelog.FieldCompletionReasoningTokens:          {Type: field.TypeInt, Column: usagelog.FieldCompletionReasoningTokens},
 			usagelog.FieldCompletionAcceptedPredictionTokens: {Type: field.TypeInt, Column: usagelog.FieldCompletionAcceptedPredictionTokens},
 			usagelog.FieldCompletionRejectedPredictionTokens: {Type: field.TypeInt, Column: usagelog.FieldCompletionRejectedPredictionTokens},
+			usagelog.FieldEstimated:                          {Type: field.TypeBool, Column: usagelog.FieldEstimated},
 			usagelog.FieldSource:                             {Type: field.TypeEnum, Column: usagelog.FieldSource},
 			usagelog.FieldFormat:                             {Type: field.TypeString, Column: usagelog.FieldFormat},
 		},
 	}
-	graph.Nodes[7] = &sqlgraph.Node{
+	graph.Nodes[9] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   user.Table,
 			Columns: user.Columns,
@@ -215,6 +275,26 @@ var schemaGraph = func() *sqlgraph.Schema {
 			user.FieldScopes:         {Type: field.TypeJSON, Column: user.FieldScopes},
 		},
 	}
+	graph.Nodes[10] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   virtualmodel.Table,
+			Columns: virtualmodel.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: virtualmodel.FieldID,
+			},
+		},
+		Type: "VirtualModel",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			virtualmodel.FieldCreatedAt:   {Type: field.TypeTime, Column: virtualmodel.FieldCreatedAt},
+			virtualmodel.FieldUpdatedAt:   {Type: field.TypeTime, Column: virtualmodel.FieldUpdatedAt},
+			virtualmodel.FieldDeletedAt:   {Type: field.TypeInt, Column: virtualmodel.FieldDeletedAt},
+			virtualmodel.FieldName:        {Type: field.TypeString, Column: virtualmodel.FieldName},
+			virtualmodel.FieldDescription: {Type: field.TypeString, Column: virtualmodel.FieldDescription},
+			virtualmodel.FieldStatus:      {Type: field.TypeEnum, Column: virtualmodel.FieldStatus},
+			virtualmodel.FieldTargets:     {Type: field.TypeJSON, Column: virtualmodel.FieldTargets},
+		},
+	}
 	graph.MustAddE(
 		"user",
 		&sqlgraph.EdgeSpec{
@@ -275,6 +355,18 @@ var schemaGraph = func() *sqlgraph.Schema {
 		"Channel",
 		"UsageLog",
 	)
+	graph.MustAddE(
+		"routing_rules",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   channel.RoutingRulesTable,
+			Columns: channel.RoutingRulesPrimaryKey,
+			Bidi:    false,
+		},
+		"Channel",
+		"RoutingRule",
+	)
 	graph.MustAddE(
 		"user",
 		&sqlgraph.EdgeSpec{
@@ -371,6 +463,18 @@ var schemaGraph = func() *sqlgraph.Schema {
 		"Role",
 		"User",
 	)
+	graph.MustAddE(
+		"channels",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   routingrule.ChannelsTable,
+			Columns: routingrule.ChannelsPrimaryKey,
+			Bidi:    false,
+		},
+		"RoutingRule",
+		"Channel",
+	)
 	graph.MustAddE(
 		"user",
 		&sqlgraph.EdgeSpec{
@@ -529,6 +633,36 @@ func (f *APIKeyFilter) WhereKey(p entql.StringP) {
 	f.Where(p.Field(apikey.FieldKey))
 }
 
+// WhereKeyPrefix applies the entql string predicate on the key_prefix field.
+func (f *APIKeyFilter) WhereKeyPrefix(p entql.StringP) {
+	f.Where(p.Field(apikey.FieldKeyPrefix))
+}
+
+// WhereLastUsedAt applies the entql time.Time predicate on the last_used_at field.
+func (f *APIKeyFilter) WhereLastUsedAt(p entql.TimeP) {
+	f.Where(p.Field(apikey.FieldLastUsedAt))
+}
+
+// WherePreviousKey applies the entql string predicate on the previous_key field.
+func (f *APIKeyFilter) WherePreviousKey(p entql.StringP) {
+	f.Where(p.Field(apikey.FieldPreviousKey))
+}
+
+// WherePreviousKeyPrefix applies the entql string predicate on the previous_key_prefix field.
+func (f *APIKeyFilter) WherePreviousKeyPrefix(p entql.StringP) {
+	f.Where(p.Field(apikey.FieldPreviousKeyPrefix))
+}
+
+// WherePreviousKeyExpiresAt applies the entql time.Time predicate on the previous_key_expires_at field.
+func (f *APIKeyFilter) WherePreviousKeyExpiresAt(p entql.TimeP) {
+	f.Where(p.Field(apikey.FieldPreviousKeyExpiresAt))
+}
+
+// WherePreviousKeyLastUsedAt applies the entql time.Time predicate on the previous_key_last_used_at field.
+func (f *APIKeyFilter) WherePreviousKeyLastUsedAt(p entql.TimeP) {
+	f.Where(p.Field(apikey.FieldPreviousKeyLastUsedAt))
+}
+
 // WhereName applies the entql string predicate on the name field.
 func (f *APIKeyFilter) WhereName(p entql.StringP) {
 	f.Where(p.Field(apikey.FieldName))
@@ -544,6 +678,36 @@ func (f *APIKeyFilter) WhereScopes(p entql.BytesP) {
 	f.Where(p.Field(apikey.FieldScopes))
 }
 
+// WhereExpiresAt applies the entql time.Time predicate on the expires_at field.
+func (f *APIKeyFilter) WhereExpiresAt(p entql.TimeP) {
+	f.Where(p.Field(apikey.FieldExpiresAt))
+}
+
+// WhereAllowedCidrs applies the entql json.RawMessage predicate on the allowed_cidrs field.
+func (f *APIKeyFilter) WhereAllowedCidrs(p entql.BytesP) {
+	f.Where(p.Field(apikey.FieldAllowedCidrs))
+}
+
+// WhereAllowedModels applies the entql json.RawMessage predicate on the allowed_models field.
+func (f *APIKeyFilter) WhereAllowedModels(p entql.BytesP) {
+	f.Where(p.Field(apikey.FieldAllowedModels))
+}
+
+// WhereCacheMode applies the entql string predicate on the cache_mode field.
+func (f *APIKeyFilter) WhereCacheMode(p entql.StringP) {
+	f.Where(p.Field(apikey.FieldCacheMode))
+}
+
+// WhereCacheScope applies the entql string predicate on the cache_scope field.
+func (f *APIKeyFilter) WhereCacheScope(p entql.StringP) {
+	f.Where(p.Field(apikey.FieldCacheScope))
+}
+
+// WhereGuardrailPolicy applies the entql json.RawMessage predicate on the guardrail_policy field.
+func (f *APIKeyFilter) WhereGuardrailPolicy(p entql.BytesP) {
+	f.Where(p.Field(apikey.FieldGuardrailPolicy))
+}
+
 // WhereProfiles applies the entql json.RawMessage predicate on the profiles field.
 func (f *APIKeyFilter) WhereProfiles(p entql.BytesP) {
 	f.Where(p.Field(apikey.FieldProfiles))
@@ -719,6 +883,20 @@ func (f *ChannelFilter) WhereHasUsageLogsWith(preds ...predicate.UsageLog) {
 	})))
 }
 
+// WhereHasRoutingRules applies a predicate to check if query has an edge routing_rules.
+func (f *ChannelFilter) WhereHasRoutingRules() {
+	f.Where(entql.HasEdge("routing_rules"))
+}
+
+// WhereHasRoutingRulesWith applies a predicate to check if query has an edge routing_rules with a given conditions (other predicates).
+func (f *ChannelFilter) WhereHasRoutingRulesWith(preds ...predicate.RoutingRule) {
+	f.Where(entql.HasEdgeWith("routing_rules", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (rq *RequestQuery) addPredicate(pred func(s *sql.Selector)) {
 	rq.predicates = append(rq.predicates, pred)
@@ -824,6 +1002,21 @@ func (f *RequestFilter) WhereExternalID(p entql.StringP) {
 	f.Where(p.Field(request.FieldExternalID))
 }
 
+// WhereCached applies the entql bool predicate on the cached field.
+func (f *RequestFilter) WhereCached(p entql.BoolP) {
+	f.Where(p.Field(request.FieldCached))
+}
+
+// WhereGuardrailViolations applies the entql json.RawMessage predicate on the guardrail_violations field.
+func (f *RequestFilter) WhereGuardrailViolations(p entql.BytesP) {
+	f.Where(p.Field(request.FieldGuardrailViolations))
+}
+
+// WhereFallbackHops applies the entql json.RawMessage predicate on the fallback_hops field.
+func (f *RequestFilter) WhereFallbackHops(p entql.BytesP) {
+	f.Where(p.Field(request.FieldFallbackHops))
+}
+
 // WhereStatus applies the entql string predicate on the status field.
 func (f *RequestFilter) WhereStatus(p entql.StringP) {
 	f.Where(p.Field(request.FieldStatus))
@@ -1032,6 +1225,71 @@ func (f *RequestExecutionFilter) WhereHasChannelWith(preds ...predicate.Channel)
 	})))
 }
 
+// addPredicate implements the predicateAdder interface.
+func (rcq *ResponseCacheQuery) addPredicate(pred func(s *sql.Selector)) {
+	rcq.predicates = append(rcq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the ResponseCacheQuery builder.
+func (rcq *ResponseCacheQuery) Filter() *ResponseCacheFilter {
+	return &ResponseCacheFilter{config: rcq.config, predicateAdder: rcq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *ResponseCacheMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the ResponseCacheMutation builder.
+func (m *ResponseCacheMutation) Filter() *ResponseCacheFilter {
+	return &ResponseCacheFilter{config: m.config, predicateAdder: m}
+}
+
+// ResponseCacheFilter provides a generic filtering capability at runtime for ResponseCacheQuery.
+type ResponseCacheFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *ResponseCacheFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[4].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *ResponseCacheFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(responsecache.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *ResponseCacheFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(responsecache.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *ResponseCacheFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(responsecache.FieldUpdatedAt))
+}
+
+// WhereKey applies the entql string predicate on the key field.
+func (f *ResponseCacheFilter) WhereKey(p entql.StringP) {
+	f.Where(p.Field(responsecache.FieldKey))
+}
+
+// WhereResponse applies the entql json.RawMessage predicate on the response field.
+func (f *ResponseCacheFilter) WhereResponse(p entql.BytesP) {
+	f.Where(p.Field(responsecache.FieldResponse))
+}
+
+// WhereExpiresAt applies the entql time.Time predicate on the expires_at field.
+func (f *ResponseCacheFilter) WhereExpiresAt(p entql.TimeP) {
+	f.Where(p.Field(responsecache.FieldExpiresAt))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (rq *RoleQuery) addPredicate(pred func(s *sql.Selector)) {
 	rq.predicates = append(rq.predicates, pred)
@@ -1061,7 +1319,7 @@ type RoleFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *RoleFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[4].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[5].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -1116,6 +1374,105 @@ func (f *RoleFilter) WhereHasUsersWith(preds ...predicate.User) {
 	})))
 }
 
+// addPredicate implements the predicateAdder interface.
+func (rrq *RoutingRuleQuery) addPredicate(pred func(s *sql.Selector)) {
+	rrq.predicates = append(rrq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the RoutingRuleQuery builder.
+func (rrq *RoutingRuleQuery) Filter() *RoutingRuleFilter {
+	return &RoutingRuleFilter{config: rrq.config, predicateAdder: rrq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *RoutingRuleMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the RoutingRuleMutation builder.
+func (m *RoutingRuleMutation) Filter() *RoutingRuleFilter {
+	return &RoutingRuleFilter{config: m.config, predicateAdder: m}
+}
+
+// RoutingRuleFilter provides a generic filtering capability at runtime for RoutingRuleQuery.
+type RoutingRuleFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *RoutingRuleFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[6].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *RoutingRuleFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(routingrule.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *RoutingRuleFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(routingrule.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *RoutingRuleFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(routingrule.FieldUpdatedAt))
+}
+
+// WhereDeletedAt applies the entql int predicate on the deleted_at field.
+func (f *RoutingRuleFilter) WhereDeletedAt(p entql.IntP) {
+	f.Where(p.Field(routingrule.FieldDeletedAt))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *RoutingRuleFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(routingrule.FieldName))
+}
+
+// WhereDescription applies the entql string predicate on the description field.
+func (f *RoutingRuleFilter) WhereDescription(p entql.StringP) {
+	f.Where(p.Field(routingrule.FieldDescription))
+}
+
+// WhereStatus applies the entql string predicate on the status field.
+func (f *RoutingRuleFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(routingrule.FieldStatus))
+}
+
+// WherePriority applies the entql int predicate on the priority field.
+func (f *RoutingRuleFilter) WherePriority(p entql.IntP) {
+	f.Where(p.Field(routingrule.FieldPriority))
+}
+
+// WhereConditions applies the entql json.RawMessage predicate on the conditions field.
+func (f *RoutingRuleFilter) WhereConditions(p entql.BytesP) {
+	f.Where(p.Field(routingrule.FieldConditions))
+}
+
+// WhereAction applies the entql string predicate on the action field.
+func (f *RoutingRuleFilter) WhereAction(p entql.StringP) {
+	f.Where(p.Field(routingrule.FieldAction))
+}
+
+// WhereHasChannels applies a predicate to check if query has an edge channels.
+func (f *RoutingRuleFilter) WhereHasChannels() {
+	f.Where(entql.HasEdge("channels"))
+}
+
+// WhereHasChannelsWith applies a predicate to check if query has an edge channels with a given conditions (other predicates).
+func (f *RoutingRuleFilter) WhereHasChannelsWith(preds ...predicate.Channel) {
+	f.Where(entql.HasEdgeWith("channels", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (sq *SystemQuery) addPredicate(pred func(s *sql.Selector)) {
 	sq.predicates = append(sq.predicates, pred)
@@ -1145,7 +1502,7 @@ type SystemFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *SystemFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[5].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[7].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -1210,7 +1567,7 @@ type UsageLogFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *UsageLogFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[6].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[8].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -1281,6 +1638,11 @@ func (f *UsageLogFilter) WherePromptCachedTokens(p entql.IntP) {
 	f.Where(p.Field(usagelog.FieldPromptCachedTokens))
 }
 
+// WherePromptCacheCreationTokens applies the entql int predicate on the prompt_cache_creation_tokens field.
+func (f *UsageLogFilter) WherePromptCacheCreationTokens(p entql.IntP) {
+	f.Where(p.Field(usagelog.FieldPromptCacheCreationTokens))
+}
+
 // WhereCompletionAudioTokens applies the entql int predicate on the completion_audio_tokens field.
 func (f *UsageLogFilter) WhereCompletionAudioTokens(p entql.IntP) {
 	f.Where(p.Field(usagelog.FieldCompletionAudioTokens))
@@ -1301,6 +1663,11 @@ func (f *UsageLogFilter) WhereCompletionRejectedPredictionTokens(p entql.IntP) {
 	f.Where(p.Field(usagelog.FieldCompletionRejectedPredictionTokens))
 }
 
+// WhereEstimated applies the entql bool predicate on the estimated field.
+func (f *UsageLogFilter) WhereEstimated(p entql.BoolP) {
+	f.Where(p.Field(usagelog.FieldEstimated))
+}
+
 // WhereSource applies the entql string predicate on the source field.
 func (f *UsageLogFilter) WhereSource(p entql.StringP) {
 	f.Where(p.Field(usagelog.FieldSource))
@@ -1382,7 +1749,7 @@ type UserFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *UserFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[7].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[9].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -1508,3 +1875,78 @@ func (f *UserFilter) WhereHasUsageLogsWith(preds ...predicate.UsageLog) {
 		}
 	})))
 }
+
+// addPredicate implements the predicateAdder interface.
+func (vmq *VirtualModelQuery) addPredicate(pred func(s *sql.Selector)) {
+	vmq.predicates = append(vmq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the VirtualModelQuery builder.
+func (vmq *VirtualModelQuery) Filter() *VirtualModelFilter {
+	return &VirtualModelFilter{config: vmq.config, predicateAdder: vmq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *VirtualModelMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the VirtualModelMutation builder.
+func (m *VirtualModelMutation) Filter() *VirtualModelFilter {
+	return &VirtualModelFilter{config: m.config, predicateAdder: m}
+}
+
+// VirtualModelFilter provides a generic filtering capability at runtime for VirtualModelQuery.
+type VirtualModelFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *VirtualModelFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[10].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *VirtualModelFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(virtualmodel.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *VirtualModelFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(virtualmodel.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *VirtualModelFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(virtualmodel.FieldUpdatedAt))
+}
+
+// WhereDeletedAt applies the entql int predicate on the deleted_at field.
+func (f *VirtualModelFilter) WhereDeletedAt(p entql.IntP) {
+	f.Where(p.Field(virtualmodel.FieldDeletedAt))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *VirtualModelFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(virtualmodel.FieldName))
+}
+
+// WhereDescription applies the entql string predicate on the description field.
+func (f *VirtualModelFilter) WhereDescription(p entql.StringP) {
+	f.Where(p.Field(virtualmodel.FieldDescription))
+}
+
+// WhereStatus applies the entql string predicate on the status field.
+func (f *VirtualModelFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(virtualmodel.FieldStatus))
+}
+
+// WhereTargets applies the entql json.RawMessage predicate on the targets field.
+func (f *VirtualModelFilter) WhereTargets(p entql.BytesP) {
+	f.Where(p.Field(virtualmodel.FieldTargets))
+}
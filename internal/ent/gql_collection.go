@@ -15,9 +15,11 @@ import (
 	"github.com/looplj/axonhub/internal/ent/request"
 	"github.com/looplj/axonhub/internal/ent/requestexecution"
 	"github.com/looplj/axonhub/internal/ent/role"
+	"github.com/looplj/axonhub/internal/ent/routingrule"
 	"github.com/looplj/axonhub/internal/ent/system"
 	"github.com/looplj/axonhub/internal/ent/usagelog"
 	"github.com/looplj/axonhub/internal/ent/user"
+	"github.com/looplj/axonhub/internal/ent/virtualmodel"
 )
 
 // CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
@@ -165,10 +167,30 @@ func (akq *APIKeyQuery) collectField(ctx context.Context, oneNode bool, opCtx *g
 				selectedFields = append(selectedFields, apikey.FieldUserID)
 				fieldSeen[apikey.FieldUserID] = struct{}{}
 			}
-		case "key":
-			if _, ok := fieldSeen[apikey.FieldKey]; !ok {
-				selectedFields = append(selectedFields, apikey.FieldKey)
-				fieldSeen[apikey.FieldKey] = struct{}{}
+		case "keyPrefix":
+			if _, ok := fieldSeen[apikey.FieldKeyPrefix]; !ok {
+				selectedFields = append(selectedFields, apikey.FieldKeyPrefix)
+				fieldSeen[apikey.FieldKeyPrefix] = struct{}{}
+			}
+		case "lastUsedAt":
+			if _, ok := fieldSeen[apikey.FieldLastUsedAt]; !ok {
+				selectedFields = append(selectedFields, apikey.FieldLastUsedAt)
+				fieldSeen[apikey.FieldLastUsedAt] = struct{}{}
+			}
+		case "previousKeyPrefix":
+			if _, ok := fieldSeen[apikey.FieldPreviousKeyPrefix]; !ok {
+				selectedFields = append(selectedFields, apikey.FieldPreviousKeyPrefix)
+				fieldSeen[apikey.FieldPreviousKeyPrefix] = struct{}{}
+			}
+		case "previousKeyExpiresAt":
+			if _, ok := fieldSeen[apikey.FieldPreviousKeyExpiresAt]; !ok {
+				selectedFields = append(selectedFields, apikey.FieldPreviousKeyExpiresAt)
+				fieldSeen[apikey.FieldPreviousKeyExpiresAt] = struct{}{}
+			}
+		case "previousKeyLastUsedAt":
+			if _, ok := fieldSeen[apikey.FieldPreviousKeyLastUsedAt]; !ok {
+				selectedFields = append(selectedFields, apikey.FieldPreviousKeyLastUsedAt)
+				fieldSeen[apikey.FieldPreviousKeyLastUsedAt] = struct{}{}
 			}
 		case "name":
 			if _, ok := fieldSeen[apikey.FieldName]; !ok {
@@ -180,6 +202,36 @@ func (akq *APIKeyQuery) collectField(ctx context.Context, oneNode bool, opCtx *g
 				selectedFields = append(selectedFields, apikey.FieldStatus)
 				fieldSeen[apikey.FieldStatus] = struct{}{}
 			}
+		case "expiresAt":
+			if _, ok := fieldSeen[apikey.FieldExpiresAt]; !ok {
+				selectedFields = append(selectedFields, apikey.FieldExpiresAt)
+				fieldSeen[apikey.FieldExpiresAt] = struct{}{}
+			}
+		case "allowedCidrs":
+			if _, ok := fieldSeen[apikey.FieldAllowedCidrs]; !ok {
+				selectedFields = append(selectedFields, apikey.FieldAllowedCidrs)
+				fieldSeen[apikey.FieldAllowedCidrs] = struct{}{}
+			}
+		case "allowedModels":
+			if _, ok := fieldSeen[apikey.FieldAllowedModels]; !ok {
+				selectedFields = append(selectedFields, apikey.FieldAllowedModels)
+				fieldSeen[apikey.FieldAllowedModels] = struct{}{}
+			}
+		case "cacheMode":
+			if _, ok := fieldSeen[apikey.FieldCacheMode]; !ok {
+				selectedFields = append(selectedFields, apikey.FieldCacheMode)
+				fieldSeen[apikey.FieldCacheMode] = struct{}{}
+			}
+		case "cacheScope":
+			if _, ok := fieldSeen[apikey.FieldCacheScope]; !ok {
+				selectedFields = append(selectedFields, apikey.FieldCacheScope)
+				fieldSeen[apikey.FieldCacheScope] = struct{}{}
+			}
+		case "guardrailPolicy":
+			if _, ok := fieldSeen[apikey.FieldGuardrailPolicy]; !ok {
+				selectedFields = append(selectedFields, apikey.FieldGuardrailPolicy)
+				fieldSeen[apikey.FieldGuardrailPolicy] = struct{}{}
+			}
 		case "profiles":
 			if _, ok := fieldSeen[apikey.FieldProfiles]; !ok {
 				selectedFields = append(selectedFields, apikey.FieldProfiles)
@@ -536,6 +588,99 @@ func (cq *ChannelQuery) collectField(ctx context.Context, oneNode bool, opCtx *g
 			cq.WithNamedUsageLogs(alias, func(wq *UsageLogQuery) {
 				*wq = *query
 			})
+
+		case "routingRules":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&RoutingRuleClient{config: cq.config}).Query()
+			)
+			args := newRoutingRulePaginateArgs(fieldArgs(ctx, new(RoutingRuleWhereInput), path...))
+			if err := validateFirstLast(args.first, args.last); err != nil {
+				return fmt.Errorf("validate first and last in path %q: %w", path, err)
+			}
+			pager, err := newRoutingRulePager(args.opts, args.last != nil)
+			if err != nil {
+				return fmt.Errorf("create new pager in path %q: %w", path, err)
+			}
+			if query, err = pager.applyFilter(query); err != nil {
+				return err
+			}
+			ignoredEdges := !hasCollectedField(ctx, append(path, edgesField)...)
+			if hasCollectedField(ctx, append(path, totalCountField)...) || hasCollectedField(ctx, append(path, pageInfoField)...) {
+				hasPagination := args.after != nil || args.first != nil || args.before != nil || args.last != nil
+				if hasPagination || ignoredEdges {
+					query := query.Clone()
+					cq.loadTotal = append(cq.loadTotal, func(ctx context.Context, nodes []*Channel) error {
+						ids := make([]driver.Value, len(nodes))
+						for i := range nodes {
+							ids[i] = nodes[i].ID
+						}
+						var v []struct {
+							NodeID int `sql:"channel_id"`
+							Count  int `sql:"count"`
+						}
+						query.Where(func(s *sql.Selector) {
+							joinT := sql.Table(channel.RoutingRulesTable)
+							s.Join(joinT).On(s.C(routingrule.FieldID), joinT.C(channel.RoutingRulesPrimaryKey[0]))
+							s.Where(sql.InValues(joinT.C(channel.RoutingRulesPrimaryKey[1]), ids...))
+							s.Select(joinT.C(channel.RoutingRulesPrimaryKey[1]), sql.Count("*"))
+							s.GroupBy(joinT.C(channel.RoutingRulesPrimaryKey[1]))
+						})
+						if err := query.Select().Scan(ctx, &v); err != nil {
+							return err
+						}
+						m := make(map[int]int, len(v))
+						for i := range v {
+							m[v[i].NodeID] = v[i].Count
+						}
+						for i := range nodes {
+							n := m[nodes[i].ID]
+							if nodes[i].Edges.totalCount[3] == nil {
+								nodes[i].Edges.totalCount[3] = make(map[string]int)
+							}
+							nodes[i].Edges.totalCount[3][alias] = n
+						}
+						return nil
+					})
+				} else {
+					cq.loadTotal = append(cq.loadTotal, func(_ context.Context, nodes []*Channel) error {
+						for i := range nodes {
+							n := len(nodes[i].Edges.RoutingRules)
+							if nodes[i].Edges.totalCount[3] == nil {
+								nodes[i].Edges.totalCount[3] = make(map[string]int)
+							}
+							nodes[i].Edges.totalCount[3][alias] = n
+						}
+						return nil
+					})
+				}
+			}
+			if ignoredEdges || (args.first != nil && *args.first == 0) || (args.last != nil && *args.last == 0) {
+				continue
+			}
+			if query, err = pager.applyCursors(query, args.after, args.before); err != nil {
+				return err
+			}
+			path = append(path, edgesField, nodeField)
+			if field := collectedField(ctx, path...); field != nil {
+				if err := query.collectField(ctx, false, opCtx, *field, path, mayAddCondition(satisfies, routingruleImplementors)...); err != nil {
+					return err
+				}
+			}
+			if limit := paginateLimit(args.first, args.last); limit > 0 {
+				if oneNode {
+					pager.applyOrder(query.Limit(limit))
+				} else {
+					modify := entgql.LimitPerRow(channel.RoutingRulesPrimaryKey[1], limit, pager.orderExpr(query))
+					query.modifiers = append(query.modifiers, modify)
+				}
+			} else {
+				query = pager.applyOrder(query)
+			}
+			cq.WithNamedRoutingRules(alias, func(wq *RoutingRuleQuery) {
+				*wq = *query
+			})
 		case "createdAt":
 			if _, ok := fieldSeen[channel.FieldCreatedAt]; !ok {
 				selectedFields = append(selectedFields, channel.FieldCreatedAt)
@@ -963,6 +1108,21 @@ func (rq *RequestQuery) collectField(ctx context.Context, oneNode bool, opCtx *g
 				selectedFields = append(selectedFields, request.FieldExternalID)
 				fieldSeen[request.FieldExternalID] = struct{}{}
 			}
+		case "cached":
+			if _, ok := fieldSeen[request.FieldCached]; !ok {
+				selectedFields = append(selectedFields, request.FieldCached)
+				fieldSeen[request.FieldCached] = struct{}{}
+			}
+		case "guardrailViolations":
+			if _, ok := fieldSeen[request.FieldGuardrailViolations]; !ok {
+				selectedFields = append(selectedFields, request.FieldGuardrailViolations)
+				fieldSeen[request.FieldGuardrailViolations] = struct{}{}
+			}
+		case "fallbackHops":
+			if _, ok := fieldSeen[request.FieldFallbackHops]; !ok {
+				selectedFields = append(selectedFields, request.FieldFallbackHops)
+				fieldSeen[request.FieldFallbackHops] = struct{}{}
+			}
 		case "status":
 			if _, ok := fieldSeen[request.FieldStatus]; !ok {
 				selectedFields = append(selectedFields, request.FieldStatus)
@@ -1417,6 +1577,228 @@ func newRolePaginateArgs(rv map[string]any) *rolePaginateArgs {
 	return args
 }
 
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (rrq *RoutingRuleQuery) CollectFields(ctx context.Context, satisfies ...string) (*RoutingRuleQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return rrq, nil
+	}
+	if err := rrq.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return rrq, nil
+}
+
+func (rrq *RoutingRuleQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(routingrule.Columns))
+		selectedFields = []string{routingrule.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "channels":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&ChannelClient{config: rrq.config}).Query()
+			)
+			args := newChannelPaginateArgs(fieldArgs(ctx, new(ChannelWhereInput), path...))
+			if err := validateFirstLast(args.first, args.last); err != nil {
+				return fmt.Errorf("validate first and last in path %q: %w", path, err)
+			}
+			pager, err := newChannelPager(args.opts, args.last != nil)
+			if err != nil {
+				return fmt.Errorf("create new pager in path %q: %w", path, err)
+			}
+			if query, err = pager.applyFilter(query); err != nil {
+				return err
+			}
+			ignoredEdges := !hasCollectedField(ctx, append(path, edgesField)...)
+			if hasCollectedField(ctx, append(path, totalCountField)...) || hasCollectedField(ctx, append(path, pageInfoField)...) {
+				hasPagination := args.after != nil || args.first != nil || args.before != nil || args.last != nil
+				if hasPagination || ignoredEdges {
+					query := query.Clone()
+					rrq.loadTotal = append(rrq.loadTotal, func(ctx context.Context, nodes []*RoutingRule) error {
+						ids := make([]driver.Value, len(nodes))
+						for i := range nodes {
+							ids[i] = nodes[i].ID
+						}
+						var v []struct {
+							NodeID int `sql:"routing_rule_id"`
+							Count  int `sql:"count"`
+						}
+						query.Where(func(s *sql.Selector) {
+							joinT := sql.Table(routingrule.ChannelsTable)
+							s.Join(joinT).On(s.C(channel.FieldID), joinT.C(routingrule.ChannelsPrimaryKey[1]))
+							s.Where(sql.InValues(joinT.C(routingrule.ChannelsPrimaryKey[0]), ids...))
+							s.Select(joinT.C(routingrule.ChannelsPrimaryKey[0]), sql.Count("*"))
+							s.GroupBy(joinT.C(routingrule.ChannelsPrimaryKey[0]))
+						})
+						if err := query.Select().Scan(ctx, &v); err != nil {
+							return err
+						}
+						m := make(map[int]int, len(v))
+						for i := range v {
+							m[v[i].NodeID] = v[i].Count
+						}
+						for i := range nodes {
+							n := m[nodes[i].ID]
+							if nodes[i].Edges.totalCount[0] == nil {
+								nodes[i].Edges.totalCount[0] = make(map[string]int)
+							}
+							nodes[i].Edges.totalCount[0][alias] = n
+						}
+						return nil
+					})
+				} else {
+					rrq.loadTotal = append(rrq.loadTotal, func(_ context.Context, nodes []*RoutingRule) error {
+						for i := range nodes {
+							n := len(nodes[i].Edges.Channels)
+							if nodes[i].Edges.totalCount[0] == nil {
+								nodes[i].Edges.totalCount[0] = make(map[string]int)
+							}
+							nodes[i].Edges.totalCount[0][alias] = n
+						}
+						return nil
+					})
+				}
+			}
+			if ignoredEdges || (args.first != nil && *args.first == 0) || (args.last != nil && *args.last == 0) {
+				continue
+			}
+			if query, err = pager.applyCursors(query, args.after, args.before); err != nil {
+				return err
+			}
+			path = append(path, edgesField, nodeField)
+			if field := collectedField(ctx, path...); field != nil {
+				if err := query.collectField(ctx, false, opCtx, *field, path, mayAddCondition(satisfies, channelImplementors)...); err != nil {
+					return err
+				}
+			}
+			if limit := paginateLimit(args.first, args.last); limit > 0 {
+				if oneNode {
+					pager.applyOrder(query.Limit(limit))
+				} else {
+					modify := entgql.LimitPerRow(routingrule.ChannelsPrimaryKey[0], limit, pager.orderExpr(query))
+					query.modifiers = append(query.modifiers, modify)
+				}
+			} else {
+				query = pager.applyOrder(query)
+			}
+			rrq.WithNamedChannels(alias, func(wq *ChannelQuery) {
+				*wq = *query
+			})
+		case "createdAt":
+			if _, ok := fieldSeen[routingrule.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, routingrule.FieldCreatedAt)
+				fieldSeen[routingrule.FieldCreatedAt] = struct{}{}
+			}
+		case "updatedAt":
+			if _, ok := fieldSeen[routingrule.FieldUpdatedAt]; !ok {
+				selectedFields = append(selectedFields, routingrule.FieldUpdatedAt)
+				fieldSeen[routingrule.FieldUpdatedAt] = struct{}{}
+			}
+		case "deletedAt":
+			if _, ok := fieldSeen[routingrule.FieldDeletedAt]; !ok {
+				selectedFields = append(selectedFields, routingrule.FieldDeletedAt)
+				fieldSeen[routingrule.FieldDeletedAt] = struct{}{}
+			}
+		case "name":
+			if _, ok := fieldSeen[routingrule.FieldName]; !ok {
+				selectedFields = append(selectedFields, routingrule.FieldName)
+				fieldSeen[routingrule.FieldName] = struct{}{}
+			}
+		case "description":
+			if _, ok := fieldSeen[routingrule.FieldDescription]; !ok {
+				selectedFields = append(selectedFields, routingrule.FieldDescription)
+				fieldSeen[routingrule.FieldDescription] = struct{}{}
+			}
+		case "status":
+			if _, ok := fieldSeen[routingrule.FieldStatus]; !ok {
+				selectedFields = append(selectedFields, routingrule.FieldStatus)
+				fieldSeen[routingrule.FieldStatus] = struct{}{}
+			}
+		case "priority":
+			if _, ok := fieldSeen[routingrule.FieldPriority]; !ok {
+				selectedFields = append(selectedFields, routingrule.FieldPriority)
+				fieldSeen[routingrule.FieldPriority] = struct{}{}
+			}
+		case "conditions":
+			if _, ok := fieldSeen[routingrule.FieldConditions]; !ok {
+				selectedFields = append(selectedFields, routingrule.FieldConditions)
+				fieldSeen[routingrule.FieldConditions] = struct{}{}
+			}
+		case "action":
+			if _, ok := fieldSeen[routingrule.FieldAction]; !ok {
+				selectedFields = append(selectedFields, routingrule.FieldAction)
+				fieldSeen[routingrule.FieldAction] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		rrq.Select(selectedFields...)
+	}
+	return nil
+}
+
+type routingrulePaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []RoutingRulePaginateOption
+}
+
+func newRoutingRulePaginateArgs(rv map[string]any) *routingrulePaginateArgs {
+	args := &routingrulePaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[orderByField]; ok {
+		switch v := v.(type) {
+		case map[string]any:
+			var (
+				err1, err2 error
+				order      = &RoutingRuleOrder{Field: &RoutingRuleOrderField{}, Direction: entgql.OrderDirectionAsc}
+			)
+			if d, ok := v[directionField]; ok {
+				err1 = order.Direction.UnmarshalGQL(d)
+			}
+			if f, ok := v[fieldField]; ok {
+				err2 = order.Field.UnmarshalGQL(f)
+			}
+			if err1 == nil && err2 == nil {
+				args.opts = append(args.opts, WithRoutingRuleOrder(order))
+			}
+		case *RoutingRuleOrder:
+			if v != nil {
+				args.opts = append(args.opts, WithRoutingRuleOrder(v))
+			}
+		}
+	}
+	if v, ok := rv[whereField].(*RoutingRuleWhereInput); ok {
+		args.opts = append(args.opts, WithRoutingRuleFilter(v.Filter))
+	}
+	return args
+}
+
 // CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
 func (sq *SystemQuery) CollectFields(ctx context.Context, satisfies ...string) (*SystemQuery, error) {
 	fc := graphql.GetFieldContext(ctx)
@@ -1652,6 +2034,11 @@ func (ulq *UsageLogQuery) collectField(ctx context.Context, oneNode bool, opCtx
 				selectedFields = append(selectedFields, usagelog.FieldPromptCachedTokens)
 				fieldSeen[usagelog.FieldPromptCachedTokens] = struct{}{}
 			}
+		case "promptCacheCreationTokens":
+			if _, ok := fieldSeen[usagelog.FieldPromptCacheCreationTokens]; !ok {
+				selectedFields = append(selectedFields, usagelog.FieldPromptCacheCreationTokens)
+				fieldSeen[usagelog.FieldPromptCacheCreationTokens] = struct{}{}
+			}
 		case "completionAudioTokens":
 			if _, ok := fieldSeen[usagelog.FieldCompletionAudioTokens]; !ok {
 				selectedFields = append(selectedFields, usagelog.FieldCompletionAudioTokens)
@@ -1672,6 +2059,11 @@ func (ulq *UsageLogQuery) collectField(ctx context.Context, oneNode bool, opCtx
 				selectedFields = append(selectedFields, usagelog.FieldCompletionRejectedPredictionTokens)
 				fieldSeen[usagelog.FieldCompletionRejectedPredictionTokens] = struct{}{}
 			}
+		case "estimated":
+			if _, ok := fieldSeen[usagelog.FieldEstimated]; !ok {
+				selectedFields = append(selectedFields, usagelog.FieldEstimated)
+				fieldSeen[usagelog.FieldEstimated] = struct{}{}
+			}
 		case "source":
 			if _, ok := fieldSeen[usagelog.FieldSource]; !ok {
 				selectedFields = append(selectedFields, usagelog.FieldSource)
@@ -2244,6 +2636,125 @@ func newUserPaginateArgs(rv map[string]any) *userPaginateArgs {
 	return args
 }
 
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (vmq *VirtualModelQuery) CollectFields(ctx context.Context, satisfies ...string) (*VirtualModelQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return vmq, nil
+	}
+	if err := vmq.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return vmq, nil
+}
+
+func (vmq *VirtualModelQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(virtualmodel.Columns))
+		selectedFields = []string{virtualmodel.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+		case "createdAt":
+			if _, ok := fieldSeen[virtualmodel.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, virtualmodel.FieldCreatedAt)
+				fieldSeen[virtualmodel.FieldCreatedAt] = struct{}{}
+			}
+		case "updatedAt":
+			if _, ok := fieldSeen[virtualmodel.FieldUpdatedAt]; !ok {
+				selectedFields = append(selectedFields, virtualmodel.FieldUpdatedAt)
+				fieldSeen[virtualmodel.FieldUpdatedAt] = struct{}{}
+			}
+		case "deletedAt":
+			if _, ok := fieldSeen[virtualmodel.FieldDeletedAt]; !ok {
+				selectedFields = append(selectedFields, virtualmodel.FieldDeletedAt)
+				fieldSeen[virtualmodel.FieldDeletedAt] = struct{}{}
+			}
+		case "name":
+			if _, ok := fieldSeen[virtualmodel.FieldName]; !ok {
+				selectedFields = append(selectedFields, virtualmodel.FieldName)
+				fieldSeen[virtualmodel.FieldName] = struct{}{}
+			}
+		case "description":
+			if _, ok := fieldSeen[virtualmodel.FieldDescription]; !ok {
+				selectedFields = append(selectedFields, virtualmodel.FieldDescription)
+				fieldSeen[virtualmodel.FieldDescription] = struct{}{}
+			}
+		case "status":
+			if _, ok := fieldSeen[virtualmodel.FieldStatus]; !ok {
+				selectedFields = append(selectedFields, virtualmodel.FieldStatus)
+				fieldSeen[virtualmodel.FieldStatus] = struct{}{}
+			}
+		case "targets":
+			if _, ok := fieldSeen[virtualmodel.FieldTargets]; !ok {
+				selectedFields = append(selectedFields, virtualmodel.FieldTargets)
+				fieldSeen[virtualmodel.FieldTargets] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		vmq.Select(selectedFields...)
+	}
+	return nil
+}
+
+type virtualmodelPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []VirtualModelPaginateOption
+}
+
+func newVirtualModelPaginateArgs(rv map[string]any) *virtualmodelPaginateArgs {
+	args := &virtualmodelPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[orderByField]; ok {
+		switch v := v.(type) {
+		case map[string]any:
+			var (
+				err1, err2 error
+				order      = &VirtualModelOrder{Field: &VirtualModelOrderField{}, Direction: entgql.OrderDirectionAsc}
+			)
+			if d, ok := v[directionField]; ok {
+				err1 = order.Direction.UnmarshalGQL(d)
+			}
+			if f, ok := v[fieldField]; ok {
+				err2 = order.Field.UnmarshalGQL(f)
+			}
+			if err1 == nil && err2 == nil {
+				args.opts = append(args.opts, WithVirtualModelOrder(order))
+			}
+		case *VirtualModelOrder:
+			if v != nil {
+				args.opts = append(args.opts, WithVirtualModelOrder(v))
+			}
+		}
+	}
+	if v, ok := rv[whereField].(*VirtualModelWhereInput); ok {
+		args.opts = append(args.opts, WithVirtualModelFilter(v.Filter))
+	}
+	return args
+}
+
 const (
 	afterField     = "after"
 	firstField     = "first"
@@ -100,6 +100,27 @@ func (c *Channel) UsageLogs(
 	return c.QueryUsageLogs().Paginate(ctx, after, first, before, last, opts...)
 }
 
+func (c *Channel) RoutingRules(
+	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *RoutingRuleOrder, where *RoutingRuleWhereInput,
+) (*RoutingRuleConnection, error) {
+	opts := []RoutingRulePaginateOption{
+		WithRoutingRuleOrder(orderBy),
+		WithRoutingRuleFilter(where.Filter),
+	}
+	alias := graphql.GetFieldContext(ctx).Field.Alias
+	totalCount, hasTotalCount := c.Edges.totalCount[3][alias]
+	if nodes, err := c.NamedRoutingRules(alias); err == nil || hasTotalCount {
+		pager, err := newRoutingRulePager(opts, last != nil)
+		if err != nil {
+			return nil, err
+		}
+		conn := &RoutingRuleConnection{Edges: []*RoutingRuleEdge{}, TotalCount: totalCount}
+		conn.build(nodes, pager, after, first, before, last)
+		return conn, nil
+	}
+	return c.QueryRoutingRules().Paginate(ctx, after, first, before, last, opts...)
+}
+
 func (r *Request) User(ctx context.Context) (*User, error) {
 	result, err := r.Edges.UserOrErr()
 	if IsNotLoaded(err) {
@@ -203,6 +224,27 @@ func (r *Role) Users(
 	return r.QueryUsers().Paginate(ctx, after, first, before, last, opts...)
 }
 
+func (rr *RoutingRule) Channels(
+	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *ChannelOrder, where *ChannelWhereInput,
+) (*ChannelConnection, error) {
+	opts := []ChannelPaginateOption{
+		WithChannelOrder(orderBy),
+		WithChannelFilter(where.Filter),
+	}
+	alias := graphql.GetFieldContext(ctx).Field.Alias
+	totalCount, hasTotalCount := rr.Edges.totalCount[0][alias]
+	if nodes, err := rr.NamedChannels(alias); err == nil || hasTotalCount {
+		pager, err := newChannelPager(opts, last != nil)
+		if err != nil {
+			return nil, err
+		}
+		conn := &ChannelConnection{Edges: []*ChannelEdge{}, TotalCount: totalCount}
+		conn.build(nodes, pager, after, first, before, last)
+		return conn, nil
+	}
+	return rr.QueryChannels().Paginate(ctx, after, first, before, last, opts...)
+}
+
 func (ul *UsageLog) User(ctx context.Context) (*User, error) {
 	result, err := ul.Edges.UserOrErr()
 	if IsNotLoaded(err) {
@@ -5,18 +5,26 @@ package ent
 import (
 	"time"
 
+	"github.com/looplj/axonhub/internal/ent/apikey"
 	"github.com/looplj/axonhub/internal/ent/channel"
 	"github.com/looplj/axonhub/internal/ent/request"
+	"github.com/looplj/axonhub/internal/ent/routingrule"
 	"github.com/looplj/axonhub/internal/ent/usagelog"
 	"github.com/looplj/axonhub/internal/ent/user"
+	"github.com/looplj/axonhub/internal/ent/virtualmodel"
 	"github.com/looplj/axonhub/internal/objects"
 )
 
 // CreateAPIKeyInput represents a mutation input for creating apikeys.
 type CreateAPIKeyInput struct {
-	CreatedAt *time.Time
-	UpdatedAt *time.Time
-	Name      string
+	CreatedAt     *time.Time
+	UpdatedAt     *time.Time
+	Name          string
+	ExpiresAt     *time.Time
+	AllowedCidrs  []string
+	AllowedModels []string
+	CacheMode     *apikey.CacheMode
+	CacheScope    *string
 }
 
 // Mutate applies the CreateAPIKeyInput on the APIKeyMutation builder.
@@ -28,6 +36,21 @@ func (i *CreateAPIKeyInput) Mutate(m *APIKeyMutation) {
 		m.SetUpdatedAt(*v)
 	}
 	m.SetName(i.Name)
+	if v := i.ExpiresAt; v != nil {
+		m.SetExpiresAt(*v)
+	}
+	if v := i.AllowedCidrs; v != nil {
+		m.SetAllowedCidrs(v)
+	}
+	if v := i.AllowedModels; v != nil {
+		m.SetAllowedModels(v)
+	}
+	if v := i.CacheMode; v != nil {
+		m.SetCacheMode(*v)
+	}
+	if v := i.CacheScope; v != nil {
+		m.SetCacheScope(*v)
+	}
 }
 
 // SetInput applies the change-set in the CreateAPIKeyInput on the APIKeyCreate builder.
@@ -38,8 +61,19 @@ func (c *APIKeyCreate) SetInput(i CreateAPIKeyInput) *APIKeyCreate {
 
 // UpdateAPIKeyInput represents a mutation input for updating apikeys.
 type UpdateAPIKeyInput struct {
-	UpdatedAt *time.Time
-	Name      *string
+	UpdatedAt           *time.Time
+	Name                *string
+	ClearExpiresAt      bool
+	ExpiresAt           *time.Time
+	ClearAllowedCidrs   bool
+	AllowedCidrs        []string
+	AppendAllowedCidrs  []string
+	ClearAllowedModels  bool
+	AllowedModels       []string
+	AppendAllowedModels []string
+	CacheMode           *apikey.CacheMode
+	ClearCacheScope     bool
+	CacheScope          *string
 }
 
 // Mutate applies the UpdateAPIKeyInput on the APIKeyMutation builder.
@@ -50,6 +84,39 @@ func (i *UpdateAPIKeyInput) Mutate(m *APIKeyMutation) {
 	if v := i.Name; v != nil {
 		m.SetName(*v)
 	}
+	if i.ClearExpiresAt {
+		m.ClearExpiresAt()
+	}
+	if v := i.ExpiresAt; v != nil {
+		m.SetExpiresAt(*v)
+	}
+	if i.ClearAllowedCidrs {
+		m.ClearAllowedCidrs()
+	}
+	if v := i.AllowedCidrs; v != nil {
+		m.SetAllowedCidrs(v)
+	}
+	if i.AppendAllowedCidrs != nil {
+		m.AppendAllowedCidrs(i.AllowedCidrs)
+	}
+	if i.ClearAllowedModels {
+		m.ClearAllowedModels()
+	}
+	if v := i.AllowedModels; v != nil {
+		m.SetAllowedModels(v)
+	}
+	if i.AppendAllowedModels != nil {
+		m.AppendAllowedModels(i.AllowedModels)
+	}
+	if v := i.CacheMode; v != nil {
+		m.SetCacheMode(*v)
+	}
+	if i.ClearCacheScope {
+		m.ClearCacheScope()
+	}
+	if v := i.CacheScope; v != nil {
+		m.SetCacheScope(*v)
+	}
 }
 
 // SetInput applies the change-set in the UpdateAPIKeyInput on the APIKeyUpdate builder.
@@ -398,6 +465,118 @@ func (c *RoleUpdateOne) SetInput(i UpdateRoleInput) *RoleUpdateOne {
 	return c
 }
 
+// CreateRoutingRuleInput represents a mutation input for creating routingrules.
+type CreateRoutingRuleInput struct {
+	CreatedAt   *time.Time
+	UpdatedAt   *time.Time
+	Name        string
+	Description *string
+	Status      *routingrule.Status
+	Priority    *int
+	Conditions  *objects.RoutingConditions
+	Action      *routingrule.Action
+	ChannelIDs  []int
+}
+
+// Mutate applies the CreateRoutingRuleInput on the RoutingRuleMutation builder.
+func (i *CreateRoutingRuleInput) Mutate(m *RoutingRuleMutation) {
+	if v := i.CreatedAt; v != nil {
+		m.SetCreatedAt(*v)
+	}
+	if v := i.UpdatedAt; v != nil {
+		m.SetUpdatedAt(*v)
+	}
+	m.SetName(i.Name)
+	if v := i.Description; v != nil {
+		m.SetDescription(*v)
+	}
+	if v := i.Status; v != nil {
+		m.SetStatus(*v)
+	}
+	if v := i.Priority; v != nil {
+		m.SetPriority(*v)
+	}
+	if v := i.Conditions; v != nil {
+		m.SetConditions(v)
+	}
+	if v := i.Action; v != nil {
+		m.SetAction(*v)
+	}
+	if v := i.ChannelIDs; len(v) > 0 {
+		m.AddChannelIDs(v...)
+	}
+}
+
+// SetInput applies the change-set in the CreateRoutingRuleInput on the RoutingRuleCreate builder.
+func (c *RoutingRuleCreate) SetInput(i CreateRoutingRuleInput) *RoutingRuleCreate {
+	i.Mutate(c.Mutation())
+	return c
+}
+
+// UpdateRoutingRuleInput represents a mutation input for updating routingrules.
+type UpdateRoutingRuleInput struct {
+	UpdatedAt        *time.Time
+	Name             *string
+	ClearDescription bool
+	Description      *string
+	Status           *routingrule.Status
+	Priority         *int
+	Conditions       *objects.RoutingConditions
+	Action           *routingrule.Action
+	ClearChannels    bool
+	AddChannelIDs    []int
+	RemoveChannelIDs []int
+}
+
+// Mutate applies the UpdateRoutingRuleInput on the RoutingRuleMutation builder.
+func (i *UpdateRoutingRuleInput) Mutate(m *RoutingRuleMutation) {
+	if v := i.UpdatedAt; v != nil {
+		m.SetUpdatedAt(*v)
+	}
+	if v := i.Name; v != nil {
+		m.SetName(*v)
+	}
+	if i.ClearDescription {
+		m.ClearDescription()
+	}
+	if v := i.Description; v != nil {
+		m.SetDescription(*v)
+	}
+	if v := i.Status; v != nil {
+		m.SetStatus(*v)
+	}
+	if v := i.Priority; v != nil {
+		m.SetPriority(*v)
+	}
+	if v := i.Conditions; v != nil {
+		m.SetConditions(v)
+	}
+	if v := i.Action; v != nil {
+		m.SetAction(*v)
+	}
+	if i.ClearChannels {
+		m.ClearChannels()
+	}
+	if v := i.AddChannelIDs; len(v) > 0 {
+		m.AddChannelIDs(v...)
+	}
+	if v := i.RemoveChannelIDs; len(v) > 0 {
+		m.RemoveChannelIDs(v...)
+	}
+}
+
+// SetInput applies the change-set in the UpdateRoutingRuleInput on the RoutingRuleUpdate builder.
+func (c *RoutingRuleUpdate) SetInput(i UpdateRoutingRuleInput) *RoutingRuleUpdate {
+	i.Mutate(c.Mutation())
+	return c
+}
+
+// SetInput applies the change-set in the UpdateRoutingRuleInput on the RoutingRuleUpdateOne builder.
+func (c *RoutingRuleUpdateOne) SetInput(i UpdateRoutingRuleInput) *RoutingRuleUpdateOne {
+	i.Mutate(c.Mutation())
+	return c
+}
+
 // CreateSystemInput represents a mutation input for creating systems.
 type CreateSystemInput struct {
 	CreatedAt *time.Time
@@ -466,10 +645,12 @@ type CreateUsageLogInput struct {
 	TotalTokens                        *int
 	PromptAudioTokens                  *int
 	PromptCachedTokens                 *int
+	PromptCacheCreationTokens          *int
 	CompletionAudioTokens              *int
 	CompletionReasoningTokens          *int
 	CompletionAcceptedPredictionTokens *int
 	CompletionRejectedPredictionTokens *int
+	Estimated                          *bool
 	Source                             *usagelog.Source
 	Format                             *string
 	UserID                             int
@@ -501,6 +682,9 @@ func (i *CreateUsageLogInput) Mutate(m *UsageLogMutation) {
 	if v := i.PromptCachedTokens; v != nil {
 		m.SetPromptCachedTokens(*v)
 	}
+	if v := i.PromptCacheCreationTokens; v != nil {
+		m.SetPromptCacheCreationTokens(*v)
+	}
 	if v := i.CompletionAudioTokens; v != nil {
 		m.SetCompletionAudioTokens(*v)
 	}
@@ -513,6 +697,9 @@ func (i *CreateUsageLogInput) Mutate(m *UsageLogMutation) {
 	if v := i.CompletionRejectedPredictionTokens; v != nil {
 		m.SetCompletionRejectedPredictionTokens(*v)
 	}
+	if v := i.Estimated; v != nil {
+		m.SetEstimated(*v)
+	}
 	if v := i.Source; v != nil {
 		m.SetSource(*v)
 	}
@@ -542,6 +729,8 @@ type UpdateUsageLogInput struct {
 	PromptAudioTokens                       *int
 	ClearPromptCachedTokens                 bool
 	PromptCachedTokens                      *int
+	ClearPromptCacheCreationTokens          bool
+	PromptCacheCreationTokens               *int
 	ClearCompletionAudioTokens              bool
 	CompletionAudioTokens                   *int
 	ClearCompletionReasoningTokens          bool
@@ -550,6 +739,7 @@ type UpdateUsageLogInput struct {
 	CompletionAcceptedPredictionTokens      *int
 	ClearCompletionRejectedPredictionTokens bool
 	CompletionRejectedPredictionTokens      *int
+	Estimated                               *bool
 	ClearChannel                            bool
 	ChannelID                               *int
 }
@@ -580,6 +770,12 @@ func (i *UpdateUsageLogInput) Mutate(m *UsageLogMutation) {
 	if v := i.PromptCachedTokens; v != nil {
 		m.SetPromptCachedTokens(*v)
 	}
+	if i.ClearPromptCacheCreationTokens {
+		m.ClearPromptCacheCreationTokens()
+	}
+	if v := i.PromptCacheCreationTokens; v != nil {
+		m.SetPromptCacheCreationTokens(*v)
+	}
 	if i.ClearCompletionAudioTokens {
 		m.ClearCompletionAudioTokens()
 	}
@@ -604,6 +800,9 @@ func (i *UpdateUsageLogInput) Mutate(m *UsageLogMutation) {
 	if v := i.CompletionRejectedPredictionTokens; v != nil {
 		m.SetCompletionRejectedPredictionTokens(*v)
 	}
+	if v := i.Estimated; v != nil {
+		m.SetEstimated(*v)
+	}
 	if i.ClearChannel {
 		m.ClearChannel()
 	}
@@ -765,3 +964,87 @@ func (c *UserUpdateOne) SetInput(i UpdateUserInput) *UserUpdateOne {
 	i.Mutate(c.Mutation())
 	return c
 }
+
+// CreateVirtualModelInput represents a mutation input for creating virtualmodels.
+type CreateVirtualModelInput struct {
+	CreatedAt   *time.Time
+	UpdatedAt   *time.Time
+	Name        string
+	Description *string
+	Status      *virtualmodel.Status
+	Targets     []objects.VirtualModelTarget
+}
+
+// Mutate applies the CreateVirtualModelInput on the VirtualModelMutation builder.
+func (i *CreateVirtualModelInput) Mutate(m *VirtualModelMutation) {
+	if v := i.CreatedAt; v != nil {
+		m.SetCreatedAt(*v)
+	}
+	if v := i.UpdatedAt; v != nil {
+		m.SetUpdatedAt(*v)
+	}
+	m.SetName(i.Name)
+	if v := i.Description; v != nil {
+		m.SetDescription(*v)
+	}
+	if v := i.Status; v != nil {
+		m.SetStatus(*v)
+	}
+	if v := i.Targets; v != nil {
+		m.SetTargets(v)
+	}
+}
+
+// SetInput applies the change-set in the CreateVirtualModelInput on the VirtualModelCreate builder.
+func (c *VirtualModelCreate) SetInput(i CreateVirtualModelInput) *VirtualModelCreate {
+	i.Mutate(c.Mutation())
+	return c
+}
+
+// UpdateVirtualModelInput represents a mutation input for updating virtualmodels.
+type UpdateVirtualModelInput struct {
+	UpdatedAt        *time.Time
+	Name             *string
+	ClearDescription bool
+	Description      *string
+	Status           *virtualmodel.Status
+	Targets          []objects.VirtualModelTarget
+	AppendTargets    []objects.VirtualModelTarget
+}
+
+// Mutate applies the UpdateVirtualModelInput on the VirtualModelMutation builder.
+func (i *UpdateVirtualModelInput) Mutate(m *VirtualModelMutation) {
+	if v := i.UpdatedAt; v != nil {
+		m.SetUpdatedAt(*v)
+	}
+	if v := i.Name; v != nil {
+		m.SetName(*v)
+	}
+	if i.ClearDescription {
+		m.ClearDescription()
+	}
+	if v := i.Description; v != nil {
+		m.SetDescription(*v)
+	}
+	if v := i.Status; v != nil {
+		m.SetStatus(*v)
+	}
+	if v := i.Targets; v != nil {
+		m.SetTargets(v)
+	}
+	if i.AppendTargets != nil {
+		m.AppendTargets(i.Targets)
+	}
+}
+
+// SetInput applies the change-set in the UpdateVirtualModelInput on the VirtualModelUpdate builder.
+func (c *VirtualModelUpdate) SetInput(i UpdateVirtualModelInput) *VirtualModelUpdate {
+	i.Mutate(c.Mutation())
+	return c
+}
+
+// SetInput applies the change-set in the UpdateVirtualModelInput on the VirtualModelUpdateOne builder.
+func (c *VirtualModelUpdateOne) SetInput(i UpdateVirtualModelInput) *VirtualModelUpdateOne {
+	i.Mutate(c.Mutation())
+	return c
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/looplj/axonhub/internal/ent/request"
 	"github.com/looplj/axonhub/internal/ent/requestexecution"
 	"github.com/looplj/axonhub/internal/ent/role"
+	"github.com/looplj/axonhub/internal/ent/routingrule"
 	"github.com/looplj/axonhub/internal/ent/usagelog"
 	"github.com/looplj/axonhub/internal/ent/user"
 )
@@ -42,7 +43,7 @@ func (ak *APIKey) Node(ctx context.Context) (node *Node, err error) {
 	node = &Node{
 		ID:     ak.ID,
 		Type:   "APIKey",
-		Fields: make([]*Field, 9),
+		Fields: make([]*Field, 21),
 		Edges:  make([]*Edge, 2),
 	}
 	var buf []byte
@@ -86,10 +87,58 @@ func (ak *APIKey) Node(ctx context.Context) (node *Node, err error) {
 		Name:  "key",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(ak.Name); err != nil {
+	if buf, err = json.Marshal(ak.KeyPrefix); err != nil {
 		return nil, err
 	}
 	node.Fields[5] = &Field{
+		Type:  "string",
+		Name:  "key_prefix",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(ak.LastUsedAt); err != nil {
+		return nil, err
+	}
+	node.Fields[6] = &Field{
+		Type:  "time.Time",
+		Name:  "last_used_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(ak.PreviousKey); err != nil {
+		return nil, err
+	}
+	node.Fields[7] = &Field{
+		Type:  "string",
+		Name:  "previous_key",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(ak.PreviousKeyPrefix); err != nil {
+		return nil, err
+	}
+	node.Fields[8] = &Field{
+		Type:  "string",
+		Name:  "previous_key_prefix",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(ak.PreviousKeyExpiresAt); err != nil {
+		return nil, err
+	}
+	node.Fields[9] = &Field{
+		Type:  "time.Time",
+		Name:  "previous_key_expires_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(ak.PreviousKeyLastUsedAt); err != nil {
+		return nil, err
+	}
+	node.Fields[10] = &Field{
+		Type:  "time.Time",
+		Name:  "previous_key_last_used_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(ak.Name); err != nil {
+		return nil, err
+	}
+	node.Fields[11] = &Field{
 		Type:  "string",
 		Name:  "name",
 		Value: string(buf),
@@ -97,7 +146,7 @@ func (ak *APIKey) Node(ctx context.Context) (node *Node, err error) {
 	if buf, err = json.Marshal(ak.Status); err != nil {
 		return nil, err
 	}
-	node.Fields[6] = &Field{
+	node.Fields[12] = &Field{
 		Type:  "apikey.Status",
 		Name:  "status",
 		Value: string(buf),
@@ -105,15 +154,63 @@ func (ak *APIKey) Node(ctx context.Context) (node *Node, err error) {
 	if buf, err = json.Marshal(ak.Scopes); err != nil {
 		return nil, err
 	}
-	node.Fields[7] = &Field{
+	node.Fields[13] = &Field{
 		Type:  "[]string",
 		Name:  "scopes",
 		Value: string(buf),
 	}
+	if buf, err = json.Marshal(ak.ExpiresAt); err != nil {
+		return nil, err
+	}
+	node.Fields[14] = &Field{
+		Type:  "time.Time",
+		Name:  "expires_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(ak.AllowedCidrs); err != nil {
+		return nil, err
+	}
+	node.Fields[15] = &Field{
+		Type:  "[]string",
+		Name:  "allowed_cidrs",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(ak.AllowedModels); err != nil {
+		return nil, err
+	}
+	node.Fields[16] = &Field{
+		Type:  "[]string",
+		Name:  "allowed_models",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(ak.CacheMode); err != nil {
+		return nil, err
+	}
+	node.Fields[17] = &Field{
+		Type:  "apikey.CacheMode",
+		Name:  "cache_mode",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(ak.CacheScope); err != nil {
+		return nil, err
+	}
+	node.Fields[18] = &Field{
+		Type:  "string",
+		Name:  "cache_scope",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(ak.GuardrailPolicy); err != nil {
+		return nil, err
+	}
+	node.Fields[19] = &Field{
+		Type:  "*objects.GuardrailPolicy",
+		Name:  "guardrail_policy",
+		Value: string(buf),
+	}
 	if buf, err = json.Marshal(ak.Profiles); err != nil {
 		return nil, err
 	}
-	node.Fields[8] = &Field{
+	node.Fields[20] = &Field{
 		Type:  "*objects.APIKeyProfiles",
 		Name:  "profiles",
 		Value: string(buf),
@@ -147,7 +244,7 @@ func (c *Channel) Node(ctx context.Context) (node *Node, err error) {
 		ID:     c.ID,
 		Type:   "Channel",
 		Fields: make([]*Field, 12),
-		Edges:  make([]*Edge, 3),
+		Edges:  make([]*Edge, 4),
 	}
 	var buf []byte
 	if buf, err = json.Marshal(c.CreatedAt); err != nil {
@@ -276,6 +373,16 @@ func (c *Channel) Node(ctx context.Context) (node *Node, err error) {
 	if err != nil {
 		return nil, err
 	}
+	node.Edges[3] = &Edge{
+		Type: "RoutingRule",
+		Name: "routing_rules",
+	}
+	err = c.QueryRoutingRules().
+		Select(routingrule.FieldID).
+		Scan(ctx, &node.Edges[3].IDs)
+	if err != nil {
+		return nil, err
+	}
 	return node, nil
 }
 
@@ -284,7 +391,7 @@ func (r *Request) Node(ctx context.Context) (node *Node, err error) {
 	node = &Node{
 		ID:     r.ID,
 		Type:   "Request",
-		Fields: make([]*Field, 14),
+		Fields: make([]*Field, 17),
 		Edges:  make([]*Edge, 5),
 	}
 	var buf []byte
@@ -392,10 +499,34 @@ func (r *Request) Node(ctx context.Context) (node *Node, err error) {
 		Name:  "external_id",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(r.Status); err != nil {
+	if buf, err = json.Marshal(r.Cached); err != nil {
 		return nil, err
 	}
 	node.Fields[13] = &Field{
+		Type:  "bool",
+		Name:  "cached",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(r.GuardrailViolations); err != nil {
+		return nil, err
+	}
+	node.Fields[14] = &Field{
+		Type:  "[]objects.GuardrailViolation",
+		Name:  "guardrail_violations",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(r.FallbackHops); err != nil {
+		return nil, err
+	}
+	node.Fields[15] = &Field{
+		Type:  "[]objects.FallbackHop",
+		Name:  "fallback_hops",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(r.Status); err != nil {
+		return nil, err
+	}
+	node.Fields[16] = &Field{
 		Type:  "request.Status",
 		Name:  "status",
 		Value: string(buf),
@@ -659,6 +790,100 @@ func (r *Role) Node(ctx context.Context) (node *Node, err error) {
 	return node, nil
 }
 
+// Node implements Noder interface
+func (rr *RoutingRule) Node(ctx context.Context) (node *Node, err error) {
+	node = &Node{
+		ID:     rr.ID,
+		Type:   "RoutingRule",
+		Fields: make([]*Field, 9),
+		Edges:  make([]*Edge, 1),
+	}
+	var buf []byte
+	if buf, err = json.Marshal(rr.CreatedAt); err != nil {
+		return nil, err
+	}
+	node.Fields[0] = &Field{
+		Type:  "time.Time",
+		Name:  "created_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(rr.UpdatedAt); err != nil {
+		return nil, err
+	}
+	node.Fields[1] = &Field{
+		Type:  "time.Time",
+		Name:  "updated_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(rr.DeletedAt); err != nil {
+		return nil, err
+	}
+	node.Fields[2] = &Field{
+		Type:  "int",
+		Name:  "deleted_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(rr.Name); err != nil {
+		return nil, err
+	}
+	node.Fields[3] = &Field{
+		Type:  "string",
+		Name:  "name",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(rr.Description); err != nil {
+		return nil, err
+	}
+	node.Fields[4] = &Field{
+		Type:  "string",
+		Name:  "description",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(rr.Status); err != nil {
+		return nil, err
+	}
+	node.Fields[5] = &Field{
+		Type:  "routingrule.Status",
+		Name:  "status",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(rr.Priority); err != nil {
+		return nil, err
+	}
+	node.Fields[6] = &Field{
+		Type:  "int",
+		Name:  "priority",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(rr.Conditions); err != nil {
+		return nil, err
+	}
+	node.Fields[7] = &Field{
+		Type:  "*objects.RoutingConditions",
+		Name:  "conditions",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(rr.Action); err != nil {
+		return nil, err
+	}
+	node.Fields[8] = &Field{
+		Type:  "routingrule.Action",
+		Name:  "action",
+		Value: string(buf),
+	}
+	node.Edges[0] = &Edge{
+		Type: "Channel",
+		Name: "channels",
+	}
+	err = rr.QueryChannels().
+		Select(channel.FieldID).
+		Scan(ctx, &node.Edges[0].IDs)
+	if err != nil {
+		return nil, err
+	}
+	return node, nil
+}
+
 // Node implements Noder interface
 func (s *System) Node(ctx context.Context) (node *Node, err error) {
 	node = &Node{
@@ -716,7 +941,7 @@ func (ul *UsageLog) Node(ctx context.Context) (node *Node, err error) {
 	node = &Node{
 		ID:     ul.ID,
 		Type:   "UsageLog",
-		Fields: make([]*Field, 18),
+		Fields: make([]*Field, 20),
 		Edges:  make([]*Edge, 3),
 	}
 	var buf []byte
@@ -816,10 +1041,18 @@ func (ul *UsageLog) Node(ctx context.Context) (node *Node, err error) {
 		Name:  "prompt_cached_tokens",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(ul.CompletionAudioTokens); err != nil {
+	if buf, err = json.Marshal(ul.PromptCacheCreationTokens); err != nil {
 		return nil, err
 	}
 	node.Fields[12] = &Field{
+		Type:  "int",
+		Name:  "prompt_cache_creation_tokens",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(ul.CompletionAudioTokens); err != nil {
+		return nil, err
+	}
+	node.Fields[13] = &Field{
 		Type:  "int",
 		Name:  "completion_audio_tokens",
 		Value: string(buf),
@@ -827,7 +1060,7 @@ func (ul *UsageLog) Node(ctx context.Context) (node *Node, err error) {
 	if buf, err = json.Marshal(ul.CompletionReasoningTokens); err != nil {
 		return nil, err
 	}
-	node.Fields[13] = &Field{
+	node.Fields[14] = &Field{
 		Type:  "int",
 		Name:  "completion_reasoning_tokens",
 		Value: string(buf),
@@ -835,7 +1068,7 @@ func (ul *UsageLog) Node(ctx context.Context) (node *Node, err error) {
 	if buf, err = json.Marshal(ul.CompletionAcceptedPredictionTokens); err != nil {
 		return nil, err
 	}
-	node.Fields[14] = &Field{
+	node.Fields[15] = &Field{
 		Type:  "int",
 		Name:  "completion_accepted_prediction_tokens",
 		Value: string(buf),
@@ -843,15 +1076,23 @@ func (ul *UsageLog) Node(ctx context.Context) (node *Node, err error) {
 	if buf, err = json.Marshal(ul.CompletionRejectedPredictionTokens); err != nil {
 		return nil, err
 	}
-	node.Fields[15] = &Field{
+	node.Fields[16] = &Field{
 		Type:  "int",
 		Name:  "completion_rejected_prediction_tokens",
 		Value: string(buf),
 	}
+	if buf, err = json.Marshal(ul.Estimated); err != nil {
+		return nil, err
+	}
+	node.Fields[17] = &Field{
+		Type:  "bool",
+		Name:  "estimated",
+		Value: string(buf),
+	}
 	if buf, err = json.Marshal(ul.Source); err != nil {
 		return nil, err
 	}
-	node.Fields[16] = &Field{
+	node.Fields[18] = &Field{
 		Type:  "usagelog.Source",
 		Name:  "source",
 		Value: string(buf),
@@ -859,7 +1100,7 @@ func (ul *UsageLog) Node(ctx context.Context) (node *Node, err error) {
 	if buf, err = json.Marshal(ul.Format); err != nil {
 		return nil, err
 	}
-	node.Fields[17] = &Field{
+	node.Fields[19] = &Field{
 		Type:  "string",
 		Name:  "format",
 		Value: string(buf),
@@ -1045,6 +1286,74 @@ func (u *User) Node(ctx context.Context) (node *Node, err error) {
 	return node, nil
 }
 
+// Node implements Noder interface
+func (vm *VirtualModel) Node(ctx context.Context) (node *Node, err error) {
+	node = &Node{
+		ID:     vm.ID,
+		Type:   "VirtualModel",
+		Fields: make([]*Field, 7),
+		Edges:  make([]*Edge, 0),
+	}
+	var buf []byte
+	if buf, err = json.Marshal(vm.CreatedAt); err != nil {
+		return nil, err
+	}
+	node.Fields[0] = &Field{
+		Type:  "time.Time",
+		Name:  "created_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(vm.UpdatedAt); err != nil {
+		return nil, err
+	}
+	node.Fields[1] = &Field{
+		Type:  "time.Time",
+		Name:  "updated_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(vm.DeletedAt); err != nil {
+		return nil, err
+	}
+	node.Fields[2] = &Field{
+		Type:  "int",
+		Name:  "deleted_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(vm.Name); err != nil {
+		return nil, err
+	}
+	node.Fields[3] = &Field{
+		Type:  "string",
+		Name:  "name",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(vm.Description); err != nil {
+		return nil, err
+	}
+	node.Fields[4] = &Field{
+		Type:  "string",
+		Name:  "description",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(vm.Status); err != nil {
+		return nil, err
+	}
+	node.Fields[5] = &Field{
+		Type:  "virtualmodel.Status",
+		Name:  "status",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(vm.Targets); err != nil {
+		return nil, err
+	}
+	node.Fields[6] = &Field{
+		Type:  "[]objects.VirtualModelTarget",
+		Name:  "targets",
+		Value: string(buf),
+	}
+	return node, nil
+}
+
 // Node returns the node with given global ID.
 //
 // This API helpful in case you want to build
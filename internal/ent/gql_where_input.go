@@ -13,9 +13,11 @@ import (
 	"github.com/looplj/axonhub/internal/ent/request"
 	"github.com/looplj/axonhub/internal/ent/requestexecution"
 	"github.com/looplj/axonhub/internal/ent/role"
+	"github.com/looplj/axonhub/internal/ent/routingrule"
 	"github.com/looplj/axonhub/internal/ent/system"
 	"github.com/looplj/axonhub/internal/ent/usagelog"
 	"github.com/looplj/axonhub/internal/ent/user"
+	"github.com/looplj/axonhub/internal/ent/virtualmodel"
 )
 
 // APIKeyWhereInput represents a where input for filtering APIKey queries.
@@ -86,6 +88,91 @@ type APIKeyWhereInput struct {
 	KeyEqualFold    *string  `json:"keyEqualFold,omitempty"`
 	KeyContainsFold *string  `json:"keyContainsFold,omitempty"`
 
+	// "key_prefix" field predicates.
+	KeyPrefix             *string  `json:"keyPrefix,omitempty"`
+	KeyPrefixNEQ          *string  `json:"keyPrefixNEQ,omitempty"`
+	KeyPrefixIn           []string `json:"keyPrefixIn,omitempty"`
+	KeyPrefixNotIn        []string `json:"keyPrefixNotIn,omitempty"`
+	KeyPrefixGT           *string  `json:"keyPrefixGT,omitempty"`
+	KeyPrefixGTE          *string  `json:"keyPrefixGTE,omitempty"`
+	KeyPrefixLT           *string  `json:"keyPrefixLT,omitempty"`
+	KeyPrefixLTE          *string  `json:"keyPrefixLTE,omitempty"`
+	KeyPrefixContains     *string  `json:"keyPrefixContains,omitempty"`
+	KeyPrefixHasPrefix    *string  `json:"keyPrefixHasPrefix,omitempty"`
+	KeyPrefixHasSuffix    *string  `json:"keyPrefixHasSuffix,omitempty"`
+	KeyPrefixEqualFold    *string  `json:"keyPrefixEqualFold,omitempty"`
+	KeyPrefixContainsFold *string  `json:"keyPrefixContainsFold,omitempty"`
+
+	// "last_used_at" field predicates.
+	LastUsedAt       *time.Time  `json:"lastUsedAt,omitempty"`
+	LastUsedAtNEQ    *time.Time  `json:"lastUsedAtNEQ,omitempty"`
+	LastUsedAtIn     []time.Time `json:"lastUsedAtIn,omitempty"`
+	LastUsedAtNotIn  []time.Time `json:"lastUsedAtNotIn,omitempty"`
+	LastUsedAtGT     *time.Time  `json:"lastUsedAtGT,omitempty"`
+	LastUsedAtGTE    *time.Time  `json:"lastUsedAtGTE,omitempty"`
+	LastUsedAtLT     *time.Time  `json:"lastUsedAtLT,omitempty"`
+	LastUsedAtLTE    *time.Time  `json:"lastUsedAtLTE,omitempty"`
+	LastUsedAtIsNil  bool        `json:"lastUsedAtIsNil,omitempty"`
+	LastUsedAtNotNil bool        `json:"lastUsedAtNotNil,omitempty"`
+
+	// "previous_key" field predicates.
+	PreviousKey             *string  `json:"previousKey,omitempty"`
+	PreviousKeyNEQ          *string  `json:"previousKeyNEQ,omitempty"`
+	PreviousKeyIn           []string `json:"previousKeyIn,omitempty"`
+	PreviousKeyNotIn        []string `json:"previousKeyNotIn,omitempty"`
+	PreviousKeyGT           *string  `json:"previousKeyGT,omitempty"`
+	PreviousKeyGTE          *string  `json:"previousKeyGTE,omitempty"`
+	PreviousKeyLT           *string  `json:"previousKeyLT,omitempty"`
+	PreviousKeyLTE          *string  `json:"previousKeyLTE,omitempty"`
+	PreviousKeyContains     *string  `json:"previousKeyContains,omitempty"`
+	PreviousKeyHasPrefix    *string  `json:"previousKeyHasPrefix,omitempty"`
+	PreviousKeyHasSuffix    *string  `json:"previousKeyHasSuffix,omitempty"`
+	PreviousKeyIsNil        bool     `json:"previousKeyIsNil,omitempty"`
+	PreviousKeyNotNil       bool     `json:"previousKeyNotNil,omitempty"`
+	PreviousKeyEqualFold    *string  `json:"previousKeyEqualFold,omitempty"`
+	PreviousKeyContainsFold *string  `json:"previousKeyContainsFold,omitempty"`
+
+	// "previous_key_prefix" field predicates.
+	PreviousKeyPrefix             *string  `json:"previousKeyPrefix,omitempty"`
+	PreviousKeyPrefixNEQ          *string  `json:"previousKeyPrefixNEQ,omitempty"`
+	PreviousKeyPrefixIn           []string `json:"previousKeyPrefixIn,omitempty"`
+	PreviousKeyPrefixNotIn        []string `json:"previousKeyPrefixNotIn,omitempty"`
+	PreviousKeyPrefixGT           *string  `json:"previousKeyPrefixGT,omitempty"`
+	PreviousKeyPrefixGTE          *string  `json:"previousKeyPrefixGTE,omitempty"`
+	PreviousKeyPrefixLT           *string  `json:"previousKeyPrefixLT,omitempty"`
+	PreviousKeyPrefixLTE          *string  `json:"previousKeyPrefixLTE,omitempty"`
+	PreviousKeyPrefixContains     *string  `json:"previousKeyPrefixContains,omitempty"`
+	PreviousKeyPrefixHasPrefix    *string  `json:"previousKeyPrefixHasPrefix,omitempty"`
+	PreviousKeyPrefixHasSuffix    *string  `json:"previousKeyPrefixHasSuffix,omitempty"`
+	PreviousKeyPrefixIsNil        bool     `json:"previousKeyPrefixIsNil,omitempty"`
+	PreviousKeyPrefixNotNil       bool     `json:"previousKeyPrefixNotNil,omitempty"`
+	PreviousKeyPrefixEqualFold    *string  `json:"previousKeyPrefixEqualFold,omitempty"`
+	PreviousKeyPrefixContainsFold *string  `json:"previousKeyPrefixContainsFold,omitempty"`
+
+	// "previous_key_expires_at" field predicates.
+	PreviousKeyExpiresAt       *time.Time  `json:"previousKeyExpiresAt,omitempty"`
+	PreviousKeyExpiresAtNEQ    *time.Time  `json:"previousKeyExpiresAtNEQ,omitempty"`
+	PreviousKeyExpiresAtIn     []time.Time `json:"previousKeyExpiresAtIn,omitempty"`
+	PreviousKeyExpiresAtNotIn  []time.Time `json:"previousKeyExpiresAtNotIn,omitempty"`
+	PreviousKeyExpiresAtGT     *time.Time  `json:"previousKeyExpiresAtGT,omitempty"`
+	PreviousKeyExpiresAtGTE    *time.Time  `json:"previousKeyExpiresAtGTE,omitempty"`
+	PreviousKeyExpiresAtLT     *time.Time  `json:"previousKeyExpiresAtLT,omitempty"`
+	PreviousKeyExpiresAtLTE    *time.Time  `json:"previousKeyExpiresAtLTE,omitempty"`
+	PreviousKeyExpiresAtIsNil  bool        `json:"previousKeyExpiresAtIsNil,omitempty"`
+	PreviousKeyExpiresAtNotNil bool        `json:"previousKeyExpiresAtNotNil,omitempty"`
+
+	// "previous_key_last_used_at" field predicates.
+	PreviousKeyLastUsedAt       *time.Time  `json:"previousKeyLastUsedAt,omitempty"`
+	PreviousKeyLastUsedAtNEQ    *time.Time  `json:"previousKeyLastUsedAtNEQ,omitempty"`
+	PreviousKeyLastUsedAtIn     []time.Time `json:"previousKeyLastUsedAtIn,omitempty"`
+	PreviousKeyLastUsedAtNotIn  []time.Time `json:"previousKeyLastUsedAtNotIn,omitempty"`
+	PreviousKeyLastUsedAtGT     *time.Time  `json:"previousKeyLastUsedAtGT,omitempty"`
+	PreviousKeyLastUsedAtGTE    *time.Time  `json:"previousKeyLastUsedAtGTE,omitempty"`
+	PreviousKeyLastUsedAtLT     *time.Time  `json:"previousKeyLastUsedAtLT,omitempty"`
+	PreviousKeyLastUsedAtLTE    *time.Time  `json:"previousKeyLastUsedAtLTE,omitempty"`
+	PreviousKeyLastUsedAtIsNil  bool        `json:"previousKeyLastUsedAtIsNil,omitempty"`
+	PreviousKeyLastUsedAtNotNil bool        `json:"previousKeyLastUsedAtNotNil,omitempty"`
+
 	// "name" field predicates.
 	Name             *string  `json:"name,omitempty"`
 	NameNEQ          *string  `json:"nameNEQ,omitempty"`
@@ -107,6 +194,41 @@ type APIKeyWhereInput struct {
 	StatusIn    []apikey.Status `json:"statusIn,omitempty"`
 	StatusNotIn []apikey.Status `json:"statusNotIn,omitempty"`
 
+	// "expires_at" field predicates.
+	ExpiresAt       *time.Time  `json:"expiresAt,omitempty"`
+	ExpiresAtNEQ    *time.Time  `json:"expiresAtNEQ,omitempty"`
+	ExpiresAtIn     []time.Time `json:"expiresAtIn,omitempty"`
+	ExpiresAtNotIn  []time.Time `json:"expiresAtNotIn,omitempty"`
+	ExpiresAtGT     *time.Time  `json:"expiresAtGT,omitempty"`
+	ExpiresAtGTE    *time.Time  `json:"expiresAtGTE,omitempty"`
+	ExpiresAtLT     *time.Time  `json:"expiresAtLT,omitempty"`
+	ExpiresAtLTE    *time.Time  `json:"expiresAtLTE,omitempty"`
+	ExpiresAtIsNil  bool        `json:"expiresAtIsNil,omitempty"`
+	ExpiresAtNotNil bool        `json:"expiresAtNotNil,omitempty"`
+
+	// "cache_mode" field predicates.
+	CacheMode      *apikey.CacheMode  `json:"cacheMode,omitempty"`
+	CacheModeNEQ   *apikey.CacheMode  `json:"cacheModeNEQ,omitempty"`
+	CacheModeIn    []apikey.CacheMode `json:"cacheModeIn,omitempty"`
+	CacheModeNotIn []apikey.CacheMode `json:"cacheModeNotIn,omitempty"`
+
+	// "cache_scope" field predicates.
+	CacheScope             *string  `json:"cacheScope,omitempty"`
+	CacheScopeNEQ          *string  `json:"cacheScopeNEQ,omitempty"`
+	CacheScopeIn           []string `json:"cacheScopeIn,omitempty"`
+	CacheScopeNotIn        []string `json:"cacheScopeNotIn,omitempty"`
+	CacheScopeGT           *string  `json:"cacheScopeGT,omitempty"`
+	CacheScopeGTE          *string  `json:"cacheScopeGTE,omitempty"`
+	CacheScopeLT           *string  `json:"cacheScopeLT,omitempty"`
+	CacheScopeLTE          *string  `json:"cacheScopeLTE,omitempty"`
+	CacheScopeContains     *string  `json:"cacheScopeContains,omitempty"`
+	CacheScopeHasPrefix    *string  `json:"cacheScopeHasPrefix,omitempty"`
+	CacheScopeHasSuffix    *string  `json:"cacheScopeHasSuffix,omitempty"`
+	CacheScopeIsNil        bool     `json:"cacheScopeIsNil,omitempty"`
+	CacheScopeNotNil       bool     `json:"cacheScopeNotNil,omitempty"`
+	CacheScopeEqualFold    *string  `json:"cacheScopeEqualFold,omitempty"`
+	CacheScopeContainsFold *string  `json:"cacheScopeContainsFold,omitempty"`
+
 	// "user" edge predicates.
 	HasUser     *bool             `json:"hasUser,omitempty"`
 	HasUserWith []*UserWhereInput `json:"hasUserWith,omitempty"`
@@ -334,6 +456,225 @@ func (i *APIKeyWhereInput) P() (predicate.APIKey, error) {
 	if i.KeyContainsFold != nil {
 		predicates = append(predicates, apikey.KeyContainsFold(*i.KeyContainsFold))
 	}
+	if i.KeyPrefix != nil {
+		predicates = append(predicates, apikey.KeyPrefixEQ(*i.KeyPrefix))
+	}
+	if i.KeyPrefixNEQ != nil {
+		predicates = append(predicates, apikey.KeyPrefixNEQ(*i.KeyPrefixNEQ))
+	}
+	if len(i.KeyPrefixIn) > 0 {
+		predicates = append(predicates, apikey.KeyPrefixIn(i.KeyPrefixIn...))
+	}
+	if len(i.KeyPrefixNotIn) > 0 {
+		predicates = append(predicates, apikey.KeyPrefixNotIn(i.KeyPrefixNotIn...))
+	}
+	if i.KeyPrefixGT != nil {
+		predicates = append(predicates, apikey.KeyPrefixGT(*i.KeyPrefixGT))
+	}
+	if i.KeyPrefixGTE != nil {
+		predicates = append(predicates, apikey.KeyPrefixGTE(*i.KeyPrefixGTE))
+	}
+	if i.KeyPrefixLT != nil {
+		predicates = append(predicates, apikey.KeyPrefixLT(*i.KeyPrefixLT))
+	}
+	if i.KeyPrefixLTE != nil {
+		predicates = append(predicates, apikey.KeyPrefixLTE(*i.KeyPrefixLTE))
+	}
+	if i.KeyPrefixContains != nil {
+		predicates = append(predicates, apikey.KeyPrefixContains(*i.KeyPrefixContains))
+	}
+	if i.KeyPrefixHasPrefix != nil {
+		predicates = append(predicates, apikey.KeyPrefixHasPrefix(*i.KeyPrefixHasPrefix))
+	}
+	if i.KeyPrefixHasSuffix != nil {
+		predicates = append(predicates, apikey.KeyPrefixHasSuffix(*i.KeyPrefixHasSuffix))
+	}
+	if i.KeyPrefixEqualFold != nil {
+		predicates = append(predicates, apikey.KeyPrefixEqualFold(*i.KeyPrefixEqualFold))
+	}
+	if i.KeyPrefixContainsFold != nil {
+		predicates = append(predicates, apikey.KeyPrefixContainsFold(*i.KeyPrefixContainsFold))
+	}
+	if i.LastUsedAt != nil {
+		predicates = append(predicates, apikey.LastUsedAtEQ(*i.LastUsedAt))
+	}
+	if i.LastUsedAtNEQ != nil {
+		predicates = append(predicates, apikey.LastUsedAtNEQ(*i.LastUsedAtNEQ))
+	}
+	if len(i.LastUsedAtIn) > 0 {
+		predicates = append(predicates, apikey.LastUsedAtIn(i.LastUsedAtIn...))
+	}
+	if len(i.LastUsedAtNotIn) > 0 {
+		predicates = append(predicates, apikey.LastUsedAtNotIn(i.LastUsedAtNotIn...))
+	}
+	if i.LastUsedAtGT != nil {
+		predicates = append(predicates, apikey.LastUsedAtGT(*i.LastUsedAtGT))
+	}
+	if i.LastUsedAtGTE != nil {
+		predicates = append(predicates, apikey.LastUsedAtGTE(*i.LastUsedAtGTE))
+	}
+	if i.LastUsedAtLT != nil {
+		predicates = append(predicates, apikey.LastUsedAtLT(*i.LastUsedAtLT))
+	}
+	if i.LastUsedAtLTE != nil {
+		predicates = append(predicates, apikey.LastUsedAtLTE(*i.LastUsedAtLTE))
+	}
+	if i.LastUsedAtIsNil {
+		predicates = append(predicates, apikey.LastUsedAtIsNil())
+	}
+	if i.LastUsedAtNotNil {
+		predicates = append(predicates, apikey.LastUsedAtNotNil())
+	}
+	if i.PreviousKey != nil {
+		predicates = append(predicates, apikey.PreviousKeyEQ(*i.PreviousKey))
+	}
+	if i.PreviousKeyNEQ != nil {
+		predicates = append(predicates, apikey.PreviousKeyNEQ(*i.PreviousKeyNEQ))
+	}
+	if len(i.PreviousKeyIn) > 0 {
+		predicates = append(predicates, apikey.PreviousKeyIn(i.PreviousKeyIn...))
+	}
+	if len(i.PreviousKeyNotIn) > 0 {
+		predicates = append(predicates, apikey.PreviousKeyNotIn(i.PreviousKeyNotIn...))
+	}
+	if i.PreviousKeyGT != nil {
+		predicates = append(predicates, apikey.PreviousKeyGT(*i.PreviousKeyGT))
+	}
+	if i.PreviousKeyGTE != nil {
+		predicates = append(predicates, apikey.PreviousKeyGTE(*i.PreviousKeyGTE))
+	}
+	if i.PreviousKeyLT != nil {
+		predicates = append(predicates, apikey.PreviousKeyLT(*i.PreviousKeyLT))
+	}
+	if i.PreviousKeyLTE != nil {
+		predicates = append(predicates, apikey.PreviousKeyLTE(*i.PreviousKeyLTE))
+	}
+	if i.PreviousKeyContains != nil {
+		predicates = append(predicates, apikey.PreviousKeyContains(*i.PreviousKeyContains))
+	}
+	if i.PreviousKeyHasPrefix != nil {
+		predicates = append(predicates, apikey.PreviousKeyHasPrefix(*i.PreviousKeyHasPrefix))
+	}
+	if i.PreviousKeyHasSuffix != nil {
+		predicates = append(predicates, apikey.PreviousKeyHasSuffix(*i.PreviousKeyHasSuffix))
+	}
+	if i.PreviousKeyIsNil {
+		predicates = append(predicates, apikey.PreviousKeyIsNil())
+	}
+	if i.PreviousKeyNotNil {
+		predicates = append(predicates, apikey.PreviousKeyNotNil())
+	}
+	if i.PreviousKeyEqualFold != nil {
+		predicates = append(predicates, apikey.PreviousKeyEqualFold(*i.PreviousKeyEqualFold))
+	}
+	if i.PreviousKeyContainsFold != nil {
+		predicates = append(predicates, apikey.PreviousKeyContainsFold(*i.PreviousKeyContainsFold))
+	}
+	if i.PreviousKeyPrefix != nil {
+		predicates = append(predicates, apikey.PreviousKeyPrefixEQ(*i.PreviousKeyPrefix))
+	}
+	if i.PreviousKeyPrefixNEQ != nil {
+		predicates = append(predicates, apikey.PreviousKeyPrefixNEQ(*i.PreviousKeyPrefixNEQ))
+	}
+	if len(i.PreviousKeyPrefixIn) > 0 {
+		predicates = append(predicates, apikey.PreviousKeyPrefixIn(i.PreviousKeyPrefixIn...))
+	}
+	if len(i.PreviousKeyPrefixNotIn) > 0 {
+		predicates = append(predicates, apikey.PreviousKeyPrefixNotIn(i.PreviousKeyPrefixNotIn...))
+	}
+	if i.PreviousKeyPrefixGT != nil {
+		predicates = append(predicates, apikey.PreviousKeyPrefixGT(*i.PreviousKeyPrefixGT))
+	}
+	if i.PreviousKeyPrefixGTE != nil {
+		predicates = append(predicates, apikey.PreviousKeyPrefixGTE(*i.PreviousKeyPrefixGTE))
+	}
+	if i.PreviousKeyPrefixLT != nil {
+		predicates = append(predicates, apikey.PreviousKeyPrefixLT(*i.PreviousKeyPrefixLT))
+	}
+	if i.PreviousKeyPrefixLTE != nil {
+		predicates = append(predicates, apikey.PreviousKeyPrefixLTE(*i.PreviousKeyPrefixLTE))
+	}
+	if i.PreviousKeyPrefixContains != nil {
+		predicates = append(predicates, apikey.PreviousKeyPrefixContains(*i.PreviousKeyPrefixContains))
+	}
+	if i.PreviousKeyPrefixHasPrefix != nil {
+		predicates = append(predicates, apikey.PreviousKeyPrefixHasPrefix(*i.PreviousKeyPrefixHasPrefix))
+	}
+	if i.PreviousKeyPrefixHasSuffix != nil {
+		predicates = append(predicates, apikey.PreviousKeyPrefixHasSuffix(*i.PreviousKeyPrefixHasSuffix))
+	}
+	if i.PreviousKeyPrefixIsNil {
+		predicates = append(predicates, apikey.PreviousKeyPrefixIsNil())
+	}
+	if i.PreviousKeyPrefixNotNil {
+		predicates = append(predicates, apikey.PreviousKeyPrefixNotNil())
+	}
+	if i.PreviousKeyPrefixEqualFold != nil {
+		predicates = append(predicates, apikey.PreviousKeyPrefixEqualFold(*i.PreviousKeyPrefixEqualFold))
+	}
+	if i.PreviousKeyPrefixContainsFold != nil {
+		predicates = append(predicates, apikey.PreviousKeyPrefixContainsFold(*i.PreviousKeyPrefixContainsFold))
+	}
+	if i.PreviousKeyExpiresAt != nil {
+		predicates = append(predicates, apikey.PreviousKeyExpiresAtEQ(*i.PreviousKeyExpiresAt))
+	}
+	if i.PreviousKeyExpiresAtNEQ != nil {
+		predicates = append(predicates, apikey.PreviousKeyExpiresAtNEQ(*i.PreviousKeyExpiresAtNEQ))
+	}
+	if len(i.PreviousKeyExpiresAtIn) > 0 {
+		predicates = append(predicates, apikey.PreviousKeyExpiresAtIn(i.PreviousKeyExpiresAtIn...))
+	}
+	if len(i.PreviousKeyExpiresAtNotIn) > 0 {
+		predicates = append(predicates, apikey.PreviousKeyExpiresAtNotIn(i.PreviousKeyExpiresAtNotIn...))
+	}
+	if i.PreviousKeyExpiresAtGT != nil {
+		predicates = append(predicates, apikey.PreviousKeyExpiresAtGT(*i.PreviousKeyExpiresAtGT))
+	}
+	if i.PreviousKeyExpiresAtGTE != nil {
+		predicates = append(predicates, apikey.PreviousKeyExpiresAtGTE(*i.PreviousKeyExpiresAtGTE))
+	}
+	if i.PreviousKeyExpiresAtLT != nil {
+		predicates = append(predicates, apikey.PreviousKeyExpiresAtLT(*i.PreviousKeyExpiresAtLT))
+	}
+	if i.PreviousKeyExpiresAtLTE != nil {
+		predicates = append(predicates, apikey.PreviousKeyExpiresAtLTE(*i.PreviousKeyExpiresAtLTE))
+	}
+	if i.PreviousKeyExpiresAtIsNil {
+		predicates = append(predicates, apikey.PreviousKeyExpiresAtIsNil())
+	}
+	if i.PreviousKeyExpiresAtNotNil {
+		predicates = append(predicates, apikey.PreviousKeyExpiresAtNotNil())
+	}
+	if i.PreviousKeyLastUsedAt != nil {
+		predicates = append(predicates, apikey.PreviousKeyLastUsedAtEQ(*i.PreviousKeyLastUsedAt))
+	}
+	if i.PreviousKeyLastUsedAtNEQ != nil {
+		predicates = append(predicates, apikey.PreviousKeyLastUsedAtNEQ(*i.PreviousKeyLastUsedAtNEQ))
+	}
+	if len(i.PreviousKeyLastUsedAtIn) > 0 {
+		predicates = append(predicates, apikey.PreviousKeyLastUsedAtIn(i.PreviousKeyLastUsedAtIn...))
+	}
+	if len(i.PreviousKeyLastUsedAtNotIn) > 0 {
+		predicates = append(predicates, apikey.PreviousKeyLastUsedAtNotIn(i.PreviousKeyLastUsedAtNotIn...))
+	}
+	if i.PreviousKeyLastUsedAtGT != nil {
+		predicates = append(predicates, apikey.PreviousKeyLastUsedAtGT(*i.PreviousKeyLastUsedAtGT))
+	}
+	if i.PreviousKeyLastUsedAtGTE != nil {
+		predicates = append(predicates, apikey.PreviousKeyLastUsedAtGTE(*i.PreviousKeyLastUsedAtGTE))
+	}
+	if i.PreviousKeyLastUsedAtLT != nil {
+		predicates = append(predicates, apikey.PreviousKeyLastUsedAtLT(*i.PreviousKeyLastUsedAtLT))
+	}
+	if i.PreviousKeyLastUsedAtLTE != nil {
+		predicates = append(predicates, apikey.PreviousKeyLastUsedAtLTE(*i.PreviousKeyLastUsedAtLTE))
+	}
+	if i.PreviousKeyLastUsedAtIsNil {
+		predicates = append(predicates, apikey.PreviousKeyLastUsedAtIsNil())
+	}
+	if i.PreviousKeyLastUsedAtNotNil {
+		predicates = append(predicates, apikey.PreviousKeyLastUsedAtNotNil())
+	}
 	if i.Name != nil {
 		predicates = append(predicates, apikey.NameEQ(*i.Name))
 	}
@@ -385,6 +726,93 @@ func (i *APIKeyWhereInput) P() (predicate.APIKey, error) {
 	if len(i.StatusNotIn) > 0 {
 		predicates = append(predicates, apikey.StatusNotIn(i.StatusNotIn...))
 	}
+	if i.ExpiresAt != nil {
+		predicates = append(predicates, apikey.ExpiresAtEQ(*i.ExpiresAt))
+	}
+	if i.ExpiresAtNEQ != nil {
+		predicates = append(predicates, apikey.ExpiresAtNEQ(*i.ExpiresAtNEQ))
+	}
+	if len(i.ExpiresAtIn) > 0 {
+		predicates = append(predicates, apikey.ExpiresAtIn(i.ExpiresAtIn...))
+	}
+	if len(i.ExpiresAtNotIn) > 0 {
+		predicates = append(predicates, apikey.ExpiresAtNotIn(i.ExpiresAtNotIn...))
+	}
+	if i.ExpiresAtGT != nil {
+		predicates = append(predicates, apikey.ExpiresAtGT(*i.ExpiresAtGT))
+	}
+	if i.ExpiresAtGTE != nil {
+		predicates = append(predicates, apikey.ExpiresAtGTE(*i.ExpiresAtGTE))
+	}
+	if i.ExpiresAtLT != nil {
+		predicates = append(predicates, apikey.ExpiresAtLT(*i.ExpiresAtLT))
+	}
+	if i.ExpiresAtLTE != nil {
+		predicates = append(predicates, apikey.ExpiresAtLTE(*i.ExpiresAtLTE))
+	}
+	if i.ExpiresAtIsNil {
+		predicates = append(predicates, apikey.ExpiresAtIsNil())
+	}
+	if i.ExpiresAtNotNil {
+		predicates = append(predicates, apikey.ExpiresAtNotNil())
+	}
+	if i.CacheMode != nil {
+		predicates = append(predicates, apikey.CacheModeEQ(*i.CacheMode))
+	}
+	if i.CacheModeNEQ != nil {
+		predicates = append(predicates, apikey.CacheModeNEQ(*i.CacheModeNEQ))
+	}
+	if len(i.CacheModeIn) > 0 {
+		predicates = append(predicates, apikey.CacheModeIn(i.CacheModeIn...))
+	}
+	if len(i.CacheModeNotIn) > 0 {
+		predicates = append(predicates, apikey.CacheModeNotIn(i.CacheModeNotIn...))
+	}
+	if i.CacheScope != nil {
+		predicates = append(predicates, apikey.CacheScopeEQ(*i.CacheScope))
+	}
+	if i.CacheScopeNEQ != nil {
+		predicates = append(predicates, apikey.CacheScopeNEQ(*i.CacheScopeNEQ))
+	}
+	if len(i.CacheScopeIn) > 0 {
+		predicates = append(predicates, apikey.CacheScopeIn(i.CacheScopeIn...))
+	}
+	if len(i.CacheScopeNotIn) > 0 {
+		predicates = append(predicates, apikey.CacheScopeNotIn(i.CacheScopeNotIn...))
+	}
+	if i.CacheScopeGT != nil {
+		predicates = append(predicates, apikey.CacheScopeGT(*i.CacheScopeGT))
+	}
+	if i.CacheScopeGTE != nil {
+		predicates = append(predicates, apikey.CacheScopeGTE(*i.CacheScopeGTE))
+	}
+	if i.CacheScopeLT != nil {
+		predicates = append(predicates, apikey.CacheScopeLT(*i.CacheScopeLT))
+	}
+	if i.CacheScopeLTE != nil {
+		predicates = append(predicates, apikey.CacheScopeLTE(*i.CacheScopeLTE))
+	}
+	if i.CacheScopeContains != nil {
+		predicates = append(predicates, apikey.CacheScopeContains(*i.CacheScopeContains))
+	}
+	if i.CacheScopeHasPrefix != nil {
+		predicates = append(predicates, apikey.CacheScopeHasPrefix(*i.CacheScopeHasPrefix))
+	}
+	if i.CacheScopeHasSuffix != nil {
+		predicates = append(predicates, apikey.CacheScopeHasSuffix(*i.CacheScopeHasSuffix))
+	}
+	if i.CacheScopeIsNil {
+		predicates = append(predicates, apikey.CacheScopeIsNil())
+	}
+	if i.CacheScopeNotNil {
+		predicates = append(predicates, apikey.CacheScopeNotNil())
+	}
+	if i.CacheScopeEqualFold != nil {
+		predicates = append(predicates, apikey.CacheScopeEqualFold(*i.CacheScopeEqualFold))
+	}
+	if i.CacheScopeContainsFold != nil {
+		predicates = append(predicates, apikey.CacheScopeContainsFold(*i.CacheScopeContainsFold))
+	}
 
 	if i.HasUser != nil {
 		p := apikey.HasUser()
@@ -559,6 +987,10 @@ type ChannelWhereInput struct {
 	// "usage_logs" edge predicates.
 	HasUsageLogs     *bool                 `json:"hasUsageLogs,omitempty"`
 	HasUsageLogsWith []*UsageLogWhereInput `json:"hasUsageLogsWith,omitempty"`
+
+	// "routing_rules" edge predicates.
+	HasRoutingRules     *bool                    `json:"hasRoutingRules,omitempty"`
+	HasRoutingRulesWith []*RoutingRuleWhereInput `json:"hasRoutingRulesWith,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
@@ -954,6 +1386,24 @@ func (i *ChannelWhereInput) P() (predicate.Channel, error) {
 		}
 		predicates = append(predicates, channel.HasUsageLogsWith(with...))
 	}
+	if i.HasRoutingRules != nil {
+		p := channel.HasRoutingRules()
+		if !*i.HasRoutingRules {
+			p = channel.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasRoutingRulesWith) > 0 {
+		with := make([]predicate.RoutingRule, 0, len(i.HasRoutingRulesWith))
+		for _, w := range i.HasRoutingRulesWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasRoutingRulesWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, channel.HasRoutingRulesWith(with...))
+	}
 	switch len(predicates) {
 	case 0:
 		return nil, ErrEmptyChannelWhereInput
@@ -1086,6 +1536,10 @@ type RequestWhereInput struct {
 	ExternalIDEqualFold    *string  `json:"externalIDEqualFold,omitempty"`
 	ExternalIDContainsFold *string  `json:"externalIDContainsFold,omitempty"`
 
+	// "cached" field predicates.
+	Cached    *bool `json:"cached,omitempty"`
+	CachedNEQ *bool `json:"cachedNEQ,omitempty"`
+
 	// "status" field predicates.
 	Status      *request.Status  `json:"status,omitempty"`
 	StatusNEQ   *request.Status  `json:"statusNEQ,omitempty"`
@@ -1463,6 +1917,12 @@ func (i *RequestWhereInput) P() (predicate.Request, error) {
 	if i.ExternalIDContainsFold != nil {
 		predicates = append(predicates, request.ExternalIDContainsFold(*i.ExternalIDContainsFold))
 	}
+	if i.Cached != nil {
+		predicates = append(predicates, request.CachedEQ(*i.Cached))
+	}
+	if i.CachedNEQ != nil {
+		predicates = append(predicates, request.CachedNEQ(*i.CachedNEQ))
+	}
 	if i.Status != nil {
 		predicates = append(predicates, request.StatusEQ(*i.Status))
 	}
@@ -2488,12 +2948,12 @@ func (i *RoleWhereInput) P() (predicate.Role, error) {
 	}
 }
 
-// SystemWhereInput represents a where input for filtering System queries.
-type SystemWhereInput struct {
-	Predicates []predicate.System  `json:"-"`
-	Not        *SystemWhereInput   `json:"not,omitempty"`
-	Or         []*SystemWhereInput `json:"or,omitempty"`
-	And        []*SystemWhereInput `json:"and,omitempty"`
+// RoutingRuleWhereInput represents a where input for filtering RoutingRule queries.
+type RoutingRuleWhereInput struct {
+	Predicates []predicate.RoutingRule  `json:"-"`
+	Not        *RoutingRuleWhereInput   `json:"not,omitempty"`
+	Or         []*RoutingRuleWhereInput `json:"or,omitempty"`
+	And        []*RoutingRuleWhereInput `json:"and,omitempty"`
 
 	// "id" field predicates.
 	ID      *int  `json:"id,omitempty"`
@@ -2535,50 +2995,78 @@ type SystemWhereInput struct {
 	DeletedAtLT    *int  `json:"deletedAtLT,omitempty"`
 	DeletedAtLTE   *int  `json:"deletedAtLTE,omitempty"`
 
-	// "key" field predicates.
-	Key             *string  `json:"key,omitempty"`
-	KeyNEQ          *string  `json:"keyNEQ,omitempty"`
-	KeyIn           []string `json:"keyIn,omitempty"`
-	KeyNotIn        []string `json:"keyNotIn,omitempty"`
-	KeyGT           *string  `json:"keyGT,omitempty"`
-	KeyGTE          *string  `json:"keyGTE,omitempty"`
-	KeyLT           *string  `json:"keyLT,omitempty"`
-	KeyLTE          *string  `json:"keyLTE,omitempty"`
-	KeyContains     *string  `json:"keyContains,omitempty"`
-	KeyHasPrefix    *string  `json:"keyHasPrefix,omitempty"`
-	KeyHasSuffix    *string  `json:"keyHasSuffix,omitempty"`
-	KeyEqualFold    *string  `json:"keyEqualFold,omitempty"`
-	KeyContainsFold *string  `json:"keyContainsFold,omitempty"`
+	// "name" field predicates.
+	Name             *string  `json:"name,omitempty"`
+	NameNEQ          *string  `json:"nameNEQ,omitempty"`
+	NameIn           []string `json:"nameIn,omitempty"`
+	NameNotIn        []string `json:"nameNotIn,omitempty"`
+	NameGT           *string  `json:"nameGT,omitempty"`
+	NameGTE          *string  `json:"nameGTE,omitempty"`
+	NameLT           *string  `json:"nameLT,omitempty"`
+	NameLTE          *string  `json:"nameLTE,omitempty"`
+	NameContains     *string  `json:"nameContains,omitempty"`
+	NameHasPrefix    *string  `json:"nameHasPrefix,omitempty"`
+	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
+	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
+	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
 
-	// "value" field predicates.
-	Value             *string  `json:"value,omitempty"`
-	ValueNEQ          *string  `json:"valueNEQ,omitempty"`
-	ValueIn           []string `json:"valueIn,omitempty"`
-	ValueNotIn        []string `json:"valueNotIn,omitempty"`
-	ValueGT           *string  `json:"valueGT,omitempty"`
-	ValueGTE          *string  `json:"valueGTE,omitempty"`
-	ValueLT           *string  `json:"valueLT,omitempty"`
-	ValueLTE          *string  `json:"valueLTE,omitempty"`
-	ValueContains     *string  `json:"valueContains,omitempty"`
-	ValueHasPrefix    *string  `json:"valueHasPrefix,omitempty"`
-	ValueHasSuffix    *string  `json:"valueHasSuffix,omitempty"`
-	ValueEqualFold    *string  `json:"valueEqualFold,omitempty"`
-	ValueContainsFold *string  `json:"valueContainsFold,omitempty"`
+	// "description" field predicates.
+	Description             *string  `json:"description,omitempty"`
+	DescriptionNEQ          *string  `json:"descriptionNEQ,omitempty"`
+	DescriptionIn           []string `json:"descriptionIn,omitempty"`
+	DescriptionNotIn        []string `json:"descriptionNotIn,omitempty"`
+	DescriptionGT           *string  `json:"descriptionGT,omitempty"`
+	DescriptionGTE          *string  `json:"descriptionGTE,omitempty"`
+	DescriptionLT           *string  `json:"descriptionLT,omitempty"`
+	DescriptionLTE          *string  `json:"descriptionLTE,omitempty"`
+	DescriptionContains     *string  `json:"descriptionContains,omitempty"`
+	DescriptionHasPrefix    *string  `json:"descriptionHasPrefix,omitempty"`
+	DescriptionHasSuffix    *string  `json:"descriptionHasSuffix,omitempty"`
+	DescriptionIsNil        bool     `json:"descriptionIsNil,omitempty"`
+	DescriptionNotNil       bool     `json:"descriptionNotNil,omitempty"`
+	DescriptionEqualFold    *string  `json:"descriptionEqualFold,omitempty"`
+	DescriptionContainsFold *string  `json:"descriptionContainsFold,omitempty"`
+
+	// "status" field predicates.
+	Status      *routingrule.Status  `json:"status,omitempty"`
+	StatusNEQ   *routingrule.Status  `json:"statusNEQ,omitempty"`
+	StatusIn    []routingrule.Status `json:"statusIn,omitempty"`
+	StatusNotIn []routingrule.Status `json:"statusNotIn,omitempty"`
+
+	// "priority" field predicates.
+	Priority      *int  `json:"priority,omitempty"`
+	PriorityNEQ   *int  `json:"priorityNEQ,omitempty"`
+	PriorityIn    []int `json:"priorityIn,omitempty"`
+	PriorityNotIn []int `json:"priorityNotIn,omitempty"`
+	PriorityGT    *int  `json:"priorityGT,omitempty"`
+	PriorityGTE   *int  `json:"priorityGTE,omitempty"`
+	PriorityLT    *int  `json:"priorityLT,omitempty"`
+	PriorityLTE   *int  `json:"priorityLTE,omitempty"`
+
+	// "action" field predicates.
+	Action      *routingrule.Action  `json:"action,omitempty"`
+	ActionNEQ   *routingrule.Action  `json:"actionNEQ,omitempty"`
+	ActionIn    []routingrule.Action `json:"actionIn,omitempty"`
+	ActionNotIn []routingrule.Action `json:"actionNotIn,omitempty"`
+
+	// "channels" edge predicates.
+	HasChannels     *bool                `json:"hasChannels,omitempty"`
+	HasChannelsWith []*ChannelWhereInput `json:"hasChannelsWith,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
-func (i *SystemWhereInput) AddPredicates(predicates ...predicate.System) {
+func (i *RoutingRuleWhereInput) AddPredicates(predicates ...predicate.RoutingRule) {
 	i.Predicates = append(i.Predicates, predicates...)
 }
 
-// Filter applies the SystemWhereInput filter on the SystemQuery builder.
-func (i *SystemWhereInput) Filter(q *SystemQuery) (*SystemQuery, error) {
+// Filter applies the RoutingRuleWhereInput filter on the RoutingRuleQuery builder.
+func (i *RoutingRuleWhereInput) Filter(q *RoutingRuleQuery) (*RoutingRuleQuery, error) {
 	if i == nil {
 		return q, nil
 	}
 	p, err := i.P()
 	if err != nil {
-		if err == ErrEmptySystemWhereInput {
+		if err == ErrEmptyRoutingRuleWhereInput {
 			return q, nil
 		}
 		return nil, err
@@ -2586,19 +3074,19 @@ func (i *SystemWhereInput) Filter(q *SystemQuery) (*SystemQuery, error) {
 	return q.Where(p), nil
 }
 
-// ErrEmptySystemWhereInput is returned in case the SystemWhereInput is empty.
-var ErrEmptySystemWhereInput = errors.New("ent: empty predicate SystemWhereInput")
+// ErrEmptyRoutingRuleWhereInput is returned in case the RoutingRuleWhereInput is empty.
+var ErrEmptyRoutingRuleWhereInput = errors.New("ent: empty predicate RoutingRuleWhereInput")
 
-// P returns a predicate for filtering systems.
+// P returns a predicate for filtering routingrules.
 // An error is returned if the input is empty or invalid.
-func (i *SystemWhereInput) P() (predicate.System, error) {
-	var predicates []predicate.System
+func (i *RoutingRuleWhereInput) P() (predicate.RoutingRule, error) {
+	var predicates []predicate.RoutingRule
 	if i.Not != nil {
 		p, err := i.Not.P()
 		if err != nil {
 			return nil, fmt.Errorf("%w: field 'not'", err)
 		}
-		predicates = append(predicates, system.Not(p))
+		predicates = append(predicates, routingrule.Not(p))
 	}
 	switch n := len(i.Or); {
 	case n == 1:
@@ -2608,7 +3096,7 @@ func (i *SystemWhereInput) P() (predicate.System, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		or := make([]predicate.System, 0, n)
+		or := make([]predicate.RoutingRule, 0, n)
 		for _, w := range i.Or {
 			p, err := w.P()
 			if err != nil {
@@ -2616,7 +3104,7 @@ func (i *SystemWhereInput) P() (predicate.System, error) {
 			}
 			or = append(or, p)
 		}
-		predicates = append(predicates, system.Or(or...))
+		predicates = append(predicates, routingrule.Or(or...))
 	}
 	switch n := len(i.And); {
 	case n == 1:
@@ -2626,7 +3114,7 @@ func (i *SystemWhereInput) P() (predicate.System, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		and := make([]predicate.System, 0, n)
+		and := make([]predicate.RoutingRule, 0, n)
 		for _, w := range i.And {
 			p, err := w.P()
 			if err != nil {
@@ -2634,148 +3122,554 @@ func (i *SystemWhereInput) P() (predicate.System, error) {
 			}
 			and = append(and, p)
 		}
-		predicates = append(predicates, system.And(and...))
+		predicates = append(predicates, routingrule.And(and...))
 	}
 	predicates = append(predicates, i.Predicates...)
 	if i.ID != nil {
-		predicates = append(predicates, system.IDEQ(*i.ID))
+		predicates = append(predicates, routingrule.IDEQ(*i.ID))
 	}
 	if i.IDNEQ != nil {
-		predicates = append(predicates, system.IDNEQ(*i.IDNEQ))
+		predicates = append(predicates, routingrule.IDNEQ(*i.IDNEQ))
 	}
 	if len(i.IDIn) > 0 {
-		predicates = append(predicates, system.IDIn(i.IDIn...))
+		predicates = append(predicates, routingrule.IDIn(i.IDIn...))
 	}
 	if len(i.IDNotIn) > 0 {
-		predicates = append(predicates, system.IDNotIn(i.IDNotIn...))
+		predicates = append(predicates, routingrule.IDNotIn(i.IDNotIn...))
 	}
 	if i.IDGT != nil {
-		predicates = append(predicates, system.IDGT(*i.IDGT))
+		predicates = append(predicates, routingrule.IDGT(*i.IDGT))
 	}
 	if i.IDGTE != nil {
-		predicates = append(predicates, system.IDGTE(*i.IDGTE))
+		predicates = append(predicates, routingrule.IDGTE(*i.IDGTE))
 	}
 	if i.IDLT != nil {
-		predicates = append(predicates, system.IDLT(*i.IDLT))
+		predicates = append(predicates, routingrule.IDLT(*i.IDLT))
 	}
 	if i.IDLTE != nil {
-		predicates = append(predicates, system.IDLTE(*i.IDLTE))
+		predicates = append(predicates, routingrule.IDLTE(*i.IDLTE))
 	}
 	if i.CreatedAt != nil {
-		predicates = append(predicates, system.CreatedAtEQ(*i.CreatedAt))
+		predicates = append(predicates, routingrule.CreatedAtEQ(*i.CreatedAt))
 	}
 	if i.CreatedAtNEQ != nil {
-		predicates = append(predicates, system.CreatedAtNEQ(*i.CreatedAtNEQ))
+		predicates = append(predicates, routingrule.CreatedAtNEQ(*i.CreatedAtNEQ))
 	}
 	if len(i.CreatedAtIn) > 0 {
-		predicates = append(predicates, system.CreatedAtIn(i.CreatedAtIn...))
+		predicates = append(predicates, routingrule.CreatedAtIn(i.CreatedAtIn...))
 	}
 	if len(i.CreatedAtNotIn) > 0 {
-		predicates = append(predicates, system.CreatedAtNotIn(i.CreatedAtNotIn...))
+		predicates = append(predicates, routingrule.CreatedAtNotIn(i.CreatedAtNotIn...))
 	}
 	if i.CreatedAtGT != nil {
-		predicates = append(predicates, system.CreatedAtGT(*i.CreatedAtGT))
+		predicates = append(predicates, routingrule.CreatedAtGT(*i.CreatedAtGT))
 	}
 	if i.CreatedAtGTE != nil {
-		predicates = append(predicates, system.CreatedAtGTE(*i.CreatedAtGTE))
+		predicates = append(predicates, routingrule.CreatedAtGTE(*i.CreatedAtGTE))
 	}
 	if i.CreatedAtLT != nil {
-		predicates = append(predicates, system.CreatedAtLT(*i.CreatedAtLT))
+		predicates = append(predicates, routingrule.CreatedAtLT(*i.CreatedAtLT))
 	}
 	if i.CreatedAtLTE != nil {
-		predicates = append(predicates, system.CreatedAtLTE(*i.CreatedAtLTE))
+		predicates = append(predicates, routingrule.CreatedAtLTE(*i.CreatedAtLTE))
 	}
 	if i.UpdatedAt != nil {
-		predicates = append(predicates, system.UpdatedAtEQ(*i.UpdatedAt))
+		predicates = append(predicates, routingrule.UpdatedAtEQ(*i.UpdatedAt))
 	}
 	if i.UpdatedAtNEQ != nil {
-		predicates = append(predicates, system.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+		predicates = append(predicates, routingrule.UpdatedAtNEQ(*i.UpdatedAtNEQ))
 	}
 	if len(i.UpdatedAtIn) > 0 {
-		predicates = append(predicates, system.UpdatedAtIn(i.UpdatedAtIn...))
+		predicates = append(predicates, routingrule.UpdatedAtIn(i.UpdatedAtIn...))
 	}
 	if len(i.UpdatedAtNotIn) > 0 {
-		predicates = append(predicates, system.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+		predicates = append(predicates, routingrule.UpdatedAtNotIn(i.UpdatedAtNotIn...))
 	}
 	if i.UpdatedAtGT != nil {
-		predicates = append(predicates, system.UpdatedAtGT(*i.UpdatedAtGT))
+		predicates = append(predicates, routingrule.UpdatedAtGT(*i.UpdatedAtGT))
 	}
 	if i.UpdatedAtGTE != nil {
-		predicates = append(predicates, system.UpdatedAtGTE(*i.UpdatedAtGTE))
+		predicates = append(predicates, routingrule.UpdatedAtGTE(*i.UpdatedAtGTE))
 	}
 	if i.UpdatedAtLT != nil {
-		predicates = append(predicates, system.UpdatedAtLT(*i.UpdatedAtLT))
+		predicates = append(predicates, routingrule.UpdatedAtLT(*i.UpdatedAtLT))
 	}
 	if i.UpdatedAtLTE != nil {
-		predicates = append(predicates, system.UpdatedAtLTE(*i.UpdatedAtLTE))
+		predicates = append(predicates, routingrule.UpdatedAtLTE(*i.UpdatedAtLTE))
 	}
 	if i.DeletedAt != nil {
-		predicates = append(predicates, system.DeletedAtEQ(*i.DeletedAt))
+		predicates = append(predicates, routingrule.DeletedAtEQ(*i.DeletedAt))
 	}
 	if i.DeletedAtNEQ != nil {
-		predicates = append(predicates, system.DeletedAtNEQ(*i.DeletedAtNEQ))
+		predicates = append(predicates, routingrule.DeletedAtNEQ(*i.DeletedAtNEQ))
 	}
 	if len(i.DeletedAtIn) > 0 {
-		predicates = append(predicates, system.DeletedAtIn(i.DeletedAtIn...))
+		predicates = append(predicates, routingrule.DeletedAtIn(i.DeletedAtIn...))
 	}
 	if len(i.DeletedAtNotIn) > 0 {
-		predicates = append(predicates, system.DeletedAtNotIn(i.DeletedAtNotIn...))
+		predicates = append(predicates, routingrule.DeletedAtNotIn(i.DeletedAtNotIn...))
 	}
 	if i.DeletedAtGT != nil {
-		predicates = append(predicates, system.DeletedAtGT(*i.DeletedAtGT))
+		predicates = append(predicates, routingrule.DeletedAtGT(*i.DeletedAtGT))
 	}
 	if i.DeletedAtGTE != nil {
-		predicates = append(predicates, system.DeletedAtGTE(*i.DeletedAtGTE))
+		predicates = append(predicates, routingrule.DeletedAtGTE(*i.DeletedAtGTE))
 	}
 	if i.DeletedAtLT != nil {
-		predicates = append(predicates, system.DeletedAtLT(*i.DeletedAtLT))
+		predicates = append(predicates, routingrule.DeletedAtLT(*i.DeletedAtLT))
 	}
 	if i.DeletedAtLTE != nil {
-		predicates = append(predicates, system.DeletedAtLTE(*i.DeletedAtLTE))
+		predicates = append(predicates, routingrule.DeletedAtLTE(*i.DeletedAtLTE))
 	}
-	if i.Key != nil {
-		predicates = append(predicates, system.KeyEQ(*i.Key))
+	if i.Name != nil {
+		predicates = append(predicates, routingrule.NameEQ(*i.Name))
 	}
-	if i.KeyNEQ != nil {
-		predicates = append(predicates, system.KeyNEQ(*i.KeyNEQ))
+	if i.NameNEQ != nil {
+		predicates = append(predicates, routingrule.NameNEQ(*i.NameNEQ))
 	}
-	if len(i.KeyIn) > 0 {
-		predicates = append(predicates, system.KeyIn(i.KeyIn...))
+	if len(i.NameIn) > 0 {
+		predicates = append(predicates, routingrule.NameIn(i.NameIn...))
 	}
-	if len(i.KeyNotIn) > 0 {
-		predicates = append(predicates, system.KeyNotIn(i.KeyNotIn...))
+	if len(i.NameNotIn) > 0 {
+		predicates = append(predicates, routingrule.NameNotIn(i.NameNotIn...))
 	}
-	if i.KeyGT != nil {
-		predicates = append(predicates, system.KeyGT(*i.KeyGT))
+	if i.NameGT != nil {
+		predicates = append(predicates, routingrule.NameGT(*i.NameGT))
 	}
-	if i.KeyGTE != nil {
-		predicates = append(predicates, system.KeyGTE(*i.KeyGTE))
+	if i.NameGTE != nil {
+		predicates = append(predicates, routingrule.NameGTE(*i.NameGTE))
 	}
-	if i.KeyLT != nil {
-		predicates = append(predicates, system.KeyLT(*i.KeyLT))
+	if i.NameLT != nil {
+		predicates = append(predicates, routingrule.NameLT(*i.NameLT))
 	}
-	if i.KeyLTE != nil {
-		predicates = append(predicates, system.KeyLTE(*i.KeyLTE))
+	if i.NameLTE != nil {
+		predicates = append(predicates, routingrule.NameLTE(*i.NameLTE))
 	}
-	if i.KeyContains != nil {
-		predicates = append(predicates, system.KeyContains(*i.KeyContains))
+	if i.NameContains != nil {
+		predicates = append(predicates, routingrule.NameContains(*i.NameContains))
 	}
-	if i.KeyHasPrefix != nil {
-		predicates = append(predicates, system.KeyHasPrefix(*i.KeyHasPrefix))
+	if i.NameHasPrefix != nil {
+		predicates = append(predicates, routingrule.NameHasPrefix(*i.NameHasPrefix))
 	}
-	if i.KeyHasSuffix != nil {
-		predicates = append(predicates, system.KeyHasSuffix(*i.KeyHasSuffix))
+	if i.NameHasSuffix != nil {
+		predicates = append(predicates, routingrule.NameHasSuffix(*i.NameHasSuffix))
 	}
-	if i.KeyEqualFold != nil {
-		predicates = append(predicates, system.KeyEqualFold(*i.KeyEqualFold))
+	if i.NameEqualFold != nil {
+		predicates = append(predicates, routingrule.NameEqualFold(*i.NameEqualFold))
 	}
-	if i.KeyContainsFold != nil {
-		predicates = append(predicates, system.KeyContainsFold(*i.KeyContainsFold))
+	if i.NameContainsFold != nil {
+		predicates = append(predicates, routingrule.NameContainsFold(*i.NameContainsFold))
 	}
-	if i.Value != nil {
-		predicates = append(predicates, system.ValueEQ(*i.Value))
+	if i.Description != nil {
+		predicates = append(predicates, routingrule.DescriptionEQ(*i.Description))
 	}
-	if i.ValueNEQ != nil {
+	if i.DescriptionNEQ != nil {
+		predicates = append(predicates, routingrule.DescriptionNEQ(*i.DescriptionNEQ))
+	}
+	if len(i.DescriptionIn) > 0 {
+		predicates = append(predicates, routingrule.DescriptionIn(i.DescriptionIn...))
+	}
+	if len(i.DescriptionNotIn) > 0 {
+		predicates = append(predicates, routingrule.DescriptionNotIn(i.DescriptionNotIn...))
+	}
+	if i.DescriptionGT != nil {
+		predicates = append(predicates, routingrule.DescriptionGT(*i.DescriptionGT))
+	}
+	if i.DescriptionGTE != nil {
+		predicates = append(predicates, routingrule.DescriptionGTE(*i.DescriptionGTE))
+	}
+	if i.DescriptionLT != nil {
+		predicates = append(predicates, routingrule.DescriptionLT(*i.DescriptionLT))
+	}
+	if i.DescriptionLTE != nil {
+		predicates = append(predicates, routingrule.DescriptionLTE(*i.DescriptionLTE))
+	}
+	if i.DescriptionContains != nil {
+		predicates = append(predicates, routingrule.DescriptionContains(*i.DescriptionContains))
+	}
+	if i.DescriptionHasPrefix != nil {
+		predicates = append(predicates, routingrule.DescriptionHasPrefix(*i.DescriptionHasPrefix))
+	}
+	if i.DescriptionHasSuffix != nil {
+		predicates = append(predicates, routingrule.DescriptionHasSuffix(*i.DescriptionHasSuffix))
+	}
+	if i.DescriptionIsNil {
+		predicates = append(predicates, routingrule.DescriptionIsNil())
+	}
+	if i.DescriptionNotNil {
+		predicates = append(predicates, routingrule.DescriptionNotNil())
+	}
+	if i.DescriptionEqualFold != nil {
+		predicates = append(predicates, routingrule.DescriptionEqualFold(*i.DescriptionEqualFold))
+	}
+	if i.DescriptionContainsFold != nil {
+		predicates = append(predicates, routingrule.DescriptionContainsFold(*i.DescriptionContainsFold))
+	}
+	if i.Status != nil {
+		predicates = append(predicates, routingrule.StatusEQ(*i.Status))
+	}
+	if i.StatusNEQ != nil {
+		predicates = append(predicates, routingrule.StatusNEQ(*i.StatusNEQ))
+	}
+	if len(i.StatusIn) > 0 {
+		predicates = append(predicates, routingrule.StatusIn(i.StatusIn...))
+	}
+	if len(i.StatusNotIn) > 0 {
+		predicates = append(predicates, routingrule.StatusNotIn(i.StatusNotIn...))
+	}
+	if i.Priority != nil {
+		predicates = append(predicates, routingrule.PriorityEQ(*i.Priority))
+	}
+	if i.PriorityNEQ != nil {
+		predicates = append(predicates, routingrule.PriorityNEQ(*i.PriorityNEQ))
+	}
+	if len(i.PriorityIn) > 0 {
+		predicates = append(predicates, routingrule.PriorityIn(i.PriorityIn...))
+	}
+	if len(i.PriorityNotIn) > 0 {
+		predicates = append(predicates, routingrule.PriorityNotIn(i.PriorityNotIn...))
+	}
+	if i.PriorityGT != nil {
+		predicates = append(predicates, routingrule.PriorityGT(*i.PriorityGT))
+	}
+	if i.PriorityGTE != nil {
+		predicates = append(predicates, routingrule.PriorityGTE(*i.PriorityGTE))
+	}
+	if i.PriorityLT != nil {
+		predicates = append(predicates, routingrule.PriorityLT(*i.PriorityLT))
+	}
+	if i.PriorityLTE != nil {
+		predicates = append(predicates, routingrule.PriorityLTE(*i.PriorityLTE))
+	}
+	if i.Action != nil {
+		predicates = append(predicates, routingrule.ActionEQ(*i.Action))
+	}
+	if i.ActionNEQ != nil {
+		predicates = append(predicates, routingrule.ActionNEQ(*i.ActionNEQ))
+	}
+	if len(i.ActionIn) > 0 {
+		predicates = append(predicates, routingrule.ActionIn(i.ActionIn...))
+	}
+	if len(i.ActionNotIn) > 0 {
+		predicates = append(predicates, routingrule.ActionNotIn(i.ActionNotIn...))
+	}
+
+	if i.HasChannels != nil {
+		p := routingrule.HasChannels()
+		if !*i.HasChannels {
+			p = routingrule.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasChannelsWith) > 0 {
+		with := make([]predicate.Channel, 0, len(i.HasChannelsWith))
+		for _, w := range i.HasChannelsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasChannelsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, routingrule.HasChannelsWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyRoutingRuleWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return routingrule.And(predicates...), nil
+	}
+}
+
+// SystemWhereInput represents a where input for filtering System queries.
+type SystemWhereInput struct {
+	Predicates []predicate.System  `json:"-"`
+	Not        *SystemWhereInput   `json:"not,omitempty"`
+	Or         []*SystemWhereInput `json:"or,omitempty"`
+	And        []*SystemWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *int  `json:"id,omitempty"`
+	IDNEQ   *int  `json:"idNEQ,omitempty"`
+	IDIn    []int `json:"idIn,omitempty"`
+	IDNotIn []int `json:"idNotIn,omitempty"`
+	IDGT    *int  `json:"idGT,omitempty"`
+	IDGTE   *int  `json:"idGTE,omitempty"`
+	IDLT    *int  `json:"idLT,omitempty"`
+	IDLTE   *int  `json:"idLTE,omitempty"`
+
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
+
+	// "updated_at" field predicates.
+	UpdatedAt      *time.Time  `json:"updatedAt,omitempty"`
+	UpdatedAtNEQ   *time.Time  `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn    []time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn []time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGT    *time.Time  `json:"updatedAtGT,omitempty"`
+	UpdatedAtGTE   *time.Time  `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
+	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
+
+	// "deleted_at" field predicates.
+	DeletedAt      *int  `json:"deletedAt,omitempty"`
+	DeletedAtNEQ   *int  `json:"deletedAtNEQ,omitempty"`
+	DeletedAtIn    []int `json:"deletedAtIn,omitempty"`
+	DeletedAtNotIn []int `json:"deletedAtNotIn,omitempty"`
+	DeletedAtGT    *int  `json:"deletedAtGT,omitempty"`
+	DeletedAtGTE   *int  `json:"deletedAtGTE,omitempty"`
+	DeletedAtLT    *int  `json:"deletedAtLT,omitempty"`
+	DeletedAtLTE   *int  `json:"deletedAtLTE,omitempty"`
+
+	// "key" field predicates.
+	Key             *string  `json:"key,omitempty"`
+	KeyNEQ          *string  `json:"keyNEQ,omitempty"`
+	KeyIn           []string `json:"keyIn,omitempty"`
+	KeyNotIn        []string `json:"keyNotIn,omitempty"`
+	KeyGT           *string  `json:"keyGT,omitempty"`
+	KeyGTE          *string  `json:"keyGTE,omitempty"`
+	KeyLT           *string  `json:"keyLT,omitempty"`
+	KeyLTE          *string  `json:"keyLTE,omitempty"`
+	KeyContains     *string  `json:"keyContains,omitempty"`
+	KeyHasPrefix    *string  `json:"keyHasPrefix,omitempty"`
+	KeyHasSuffix    *string  `json:"keyHasSuffix,omitempty"`
+	KeyEqualFold    *string  `json:"keyEqualFold,omitempty"`
+	KeyContainsFold *string  `json:"keyContainsFold,omitempty"`
+
+	// "value" field predicates.
+	Value             *string  `json:"value,omitempty"`
+	ValueNEQ          *string  `json:"valueNEQ,omitempty"`
+	ValueIn           []string `json:"valueIn,omitempty"`
+	ValueNotIn        []string `json:"valueNotIn,omitempty"`
+	ValueGT           *string  `json:"valueGT,omitempty"`
+	ValueGTE          *string  `json:"valueGTE,omitempty"`
+	ValueLT           *string  `json:"valueLT,omitempty"`
+	ValueLTE          *string  `json:"valueLTE,omitempty"`
+	ValueContains     *string  `json:"valueContains,omitempty"`
+	ValueHasPrefix    *string  `json:"valueHasPrefix,omitempty"`
+	ValueHasSuffix    *string  `json:"valueHasSuffix,omitempty"`
+	ValueEqualFold    *string  `json:"valueEqualFold,omitempty"`
+	ValueContainsFold *string  `json:"valueContainsFold,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *SystemWhereInput) AddPredicates(predicates ...predicate.System) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the SystemWhereInput filter on the SystemQuery builder.
+func (i *SystemWhereInput) Filter(q *SystemQuery) (*SystemQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptySystemWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptySystemWhereInput is returned in case the SystemWhereInput is empty.
+var ErrEmptySystemWhereInput = errors.New("ent: empty predicate SystemWhereInput")
+
+// P returns a predicate for filtering systems.
+// An error is returned if the input is empty or invalid.
+func (i *SystemWhereInput) P() (predicate.System, error) {
+	var predicates []predicate.System
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, system.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.System, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, system.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.System, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, system.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, system.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, system.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, system.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, system.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, system.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, system.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, system.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, system.IDLTE(*i.IDLTE))
+	}
+	if i.CreatedAt != nil {
+		predicates = append(predicates, system.CreatedAtEQ(*i.CreatedAt))
+	}
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, system.CreatedAtNEQ(*i.CreatedAtNEQ))
+	}
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, system.CreatedAtIn(i.CreatedAtIn...))
+	}
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, system.CreatedAtNotIn(i.CreatedAtNotIn...))
+	}
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, system.CreatedAtGT(*i.CreatedAtGT))
+	}
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, system.CreatedAtGTE(*i.CreatedAtGTE))
+	}
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, system.CreatedAtLT(*i.CreatedAtLT))
+	}
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, system.CreatedAtLTE(*i.CreatedAtLTE))
+	}
+	if i.UpdatedAt != nil {
+		predicates = append(predicates, system.UpdatedAtEQ(*i.UpdatedAt))
+	}
+	if i.UpdatedAtNEQ != nil {
+		predicates = append(predicates, system.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+	}
+	if len(i.UpdatedAtIn) > 0 {
+		predicates = append(predicates, system.UpdatedAtIn(i.UpdatedAtIn...))
+	}
+	if len(i.UpdatedAtNotIn) > 0 {
+		predicates = append(predicates, system.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+	}
+	if i.UpdatedAtGT != nil {
+		predicates = append(predicates, system.UpdatedAtGT(*i.UpdatedAtGT))
+	}
+	if i.UpdatedAtGTE != nil {
+		predicates = append(predicates, system.UpdatedAtGTE(*i.UpdatedAtGTE))
+	}
+	if i.UpdatedAtLT != nil {
+		predicates = append(predicates, system.UpdatedAtLT(*i.UpdatedAtLT))
+	}
+	if i.UpdatedAtLTE != nil {
+		predicates = append(predicates, system.UpdatedAtLTE(*i.UpdatedAtLTE))
+	}
+	if i.DeletedAt != nil {
+		predicates = append(predicates, system.DeletedAtEQ(*i.DeletedAt))
+	}
+	if i.DeletedAtNEQ != nil {
+		predicates = append(predicates, system.DeletedAtNEQ(*i.DeletedAtNEQ))
+	}
+	if len(i.DeletedAtIn) > 0 {
+		predicates = append(predicates, system.DeletedAtIn(i.DeletedAtIn...))
+	}
+	if len(i.DeletedAtNotIn) > 0 {
+		predicates = append(predicates, system.DeletedAtNotIn(i.DeletedAtNotIn...))
+	}
+	if i.DeletedAtGT != nil {
+		predicates = append(predicates, system.DeletedAtGT(*i.DeletedAtGT))
+	}
+	if i.DeletedAtGTE != nil {
+		predicates = append(predicates, system.DeletedAtGTE(*i.DeletedAtGTE))
+	}
+	if i.DeletedAtLT != nil {
+		predicates = append(predicates, system.DeletedAtLT(*i.DeletedAtLT))
+	}
+	if i.DeletedAtLTE != nil {
+		predicates = append(predicates, system.DeletedAtLTE(*i.DeletedAtLTE))
+	}
+	if i.Key != nil {
+		predicates = append(predicates, system.KeyEQ(*i.Key))
+	}
+	if i.KeyNEQ != nil {
+		predicates = append(predicates, system.KeyNEQ(*i.KeyNEQ))
+	}
+	if len(i.KeyIn) > 0 {
+		predicates = append(predicates, system.KeyIn(i.KeyIn...))
+	}
+	if len(i.KeyNotIn) > 0 {
+		predicates = append(predicates, system.KeyNotIn(i.KeyNotIn...))
+	}
+	if i.KeyGT != nil {
+		predicates = append(predicates, system.KeyGT(*i.KeyGT))
+	}
+	if i.KeyGTE != nil {
+		predicates = append(predicates, system.KeyGTE(*i.KeyGTE))
+	}
+	if i.KeyLT != nil {
+		predicates = append(predicates, system.KeyLT(*i.KeyLT))
+	}
+	if i.KeyLTE != nil {
+		predicates = append(predicates, system.KeyLTE(*i.KeyLTE))
+	}
+	if i.KeyContains != nil {
+		predicates = append(predicates, system.KeyContains(*i.KeyContains))
+	}
+	if i.KeyHasPrefix != nil {
+		predicates = append(predicates, system.KeyHasPrefix(*i.KeyHasPrefix))
+	}
+	if i.KeyHasSuffix != nil {
+		predicates = append(predicates, system.KeyHasSuffix(*i.KeyHasSuffix))
+	}
+	if i.KeyEqualFold != nil {
+		predicates = append(predicates, system.KeyEqualFold(*i.KeyEqualFold))
+	}
+	if i.KeyContainsFold != nil {
+		predicates = append(predicates, system.KeyContainsFold(*i.KeyContainsFold))
+	}
+	if i.Value != nil {
+		predicates = append(predicates, system.ValueEQ(*i.Value))
+	}
+	if i.ValueNEQ != nil {
 		predicates = append(predicates, system.ValueNEQ(*i.ValueNEQ))
 	}
 	if len(i.ValueIn) > 0 {
@@ -2958,6 +3852,18 @@ type UsageLogWhereInput struct {
 	PromptCachedTokensIsNil  bool  `json:"promptCachedTokensIsNil,omitempty"`
 	PromptCachedTokensNotNil bool  `json:"promptCachedTokensNotNil,omitempty"`
 
+	// "prompt_cache_creation_tokens" field predicates.
+	PromptCacheCreationTokens       *int  `json:"promptCacheCreationTokens,omitempty"`
+	PromptCacheCreationTokensNEQ    *int  `json:"promptCacheCreationTokensNEQ,omitempty"`
+	PromptCacheCreationTokensIn     []int `json:"promptCacheCreationTokensIn,omitempty"`
+	PromptCacheCreationTokensNotIn  []int `json:"promptCacheCreationTokensNotIn,omitempty"`
+	PromptCacheCreationTokensGT     *int  `json:"promptCacheCreationTokensGT,omitempty"`
+	PromptCacheCreationTokensGTE    *int  `json:"promptCacheCreationTokensGTE,omitempty"`
+	PromptCacheCreationTokensLT     *int  `json:"promptCacheCreationTokensLT,omitempty"`
+	PromptCacheCreationTokensLTE    *int  `json:"promptCacheCreationTokensLTE,omitempty"`
+	PromptCacheCreationTokensIsNil  bool  `json:"promptCacheCreationTokensIsNil,omitempty"`
+	PromptCacheCreationTokensNotNil bool  `json:"promptCacheCreationTokensNotNil,omitempty"`
+
 	// "completion_audio_tokens" field predicates.
 	CompletionAudioTokens       *int  `json:"completionAudioTokens,omitempty"`
 	CompletionAudioTokensNEQ    *int  `json:"completionAudioTokensNEQ,omitempty"`
@@ -3006,6 +3912,10 @@ type UsageLogWhereInput struct {
 	CompletionRejectedPredictionTokensIsNil  bool  `json:"completionRejectedPredictionTokensIsNil,omitempty"`
 	CompletionRejectedPredictionTokensNotNil bool  `json:"completionRejectedPredictionTokensNotNil,omitempty"`
 
+	// "estimated" field predicates.
+	Estimated    *bool `json:"estimated,omitempty"`
+	EstimatedNEQ *bool `json:"estimatedNEQ,omitempty"`
+
 	// "source" field predicates.
 	Source      *usagelog.Source  `json:"source,omitempty"`
 	SourceNEQ   *usagelog.Source  `json:"sourceNEQ,omitempty"`
@@ -3420,6 +4330,36 @@ func (i *UsageLogWhereInput) P() (predicate.UsageLog, error) {
 	if i.PromptCachedTokensNotNil {
 		predicates = append(predicates, usagelog.PromptCachedTokensNotNil())
 	}
+	if i.PromptCacheCreationTokens != nil {
+		predicates = append(predicates, usagelog.PromptCacheCreationTokensEQ(*i.PromptCacheCreationTokens))
+	}
+	if i.PromptCacheCreationTokensNEQ != nil {
+		predicates = append(predicates, usagelog.PromptCacheCreationTokensNEQ(*i.PromptCacheCreationTokensNEQ))
+	}
+	if len(i.PromptCacheCreationTokensIn) > 0 {
+		predicates = append(predicates, usagelog.PromptCacheCreationTokensIn(i.PromptCacheCreationTokensIn...))
+	}
+	if len(i.PromptCacheCreationTokensNotIn) > 0 {
+		predicates = append(predicates, usagelog.PromptCacheCreationTokensNotIn(i.PromptCacheCreationTokensNotIn...))
+	}
+	if i.PromptCacheCreationTokensGT != nil {
+		predicates = append(predicates, usagelog.PromptCacheCreationTokensGT(*i.PromptCacheCreationTokensGT))
+	}
+	if i.PromptCacheCreationTokensGTE != nil {
+		predicates = append(predicates, usagelog.PromptCacheCreationTokensGTE(*i.PromptCacheCreationTokensGTE))
+	}
+	if i.PromptCacheCreationTokensLT != nil {
+		predicates = append(predicates, usagelog.PromptCacheCreationTokensLT(*i.PromptCacheCreationTokensLT))
+	}
+	if i.PromptCacheCreationTokensLTE != nil {
+		predicates = append(predicates, usagelog.PromptCacheCreationTokensLTE(*i.PromptCacheCreationTokensLTE))
+	}
+	if i.PromptCacheCreationTokensIsNil {
+		predicates = append(predicates, usagelog.PromptCacheCreationTokensIsNil())
+	}
+	if i.PromptCacheCreationTokensNotNil {
+		predicates = append(predicates, usagelog.PromptCacheCreationTokensNotNil())
+	}
 	if i.CompletionAudioTokens != nil {
 		predicates = append(predicates, usagelog.CompletionAudioTokensEQ(*i.CompletionAudioTokens))
 	}
@@ -3540,6 +4480,12 @@ func (i *UsageLogWhereInput) P() (predicate.UsageLog, error) {
 	if i.CompletionRejectedPredictionTokensNotNil {
 		predicates = append(predicates, usagelog.CompletionRejectedPredictionTokensNotNil())
 	}
+	if i.Estimated != nil {
+		predicates = append(predicates, usagelog.EstimatedEQ(*i.Estimated))
+	}
+	if i.EstimatedNEQ != nil {
+		predicates = append(predicates, usagelog.EstimatedNEQ(*i.EstimatedNEQ))
+	}
 	if i.Source != nil {
 		predicates = append(predicates, usagelog.SourceEQ(*i.Source))
 	}
@@ -4329,3 +5275,363 @@ func (i *UserWhereInput) P() (predicate.User, error) {
 		return user.And(predicates...), nil
 	}
 }
+
+// VirtualModelWhereInput represents a where input for filtering VirtualModel queries.
+type VirtualModelWhereInput struct {
+	Predicates []predicate.VirtualModel  `json:"-"`
+	Not        *VirtualModelWhereInput   `json:"not,omitempty"`
+	Or         []*VirtualModelWhereInput `json:"or,omitempty"`
+	And        []*VirtualModelWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *int  `json:"id,omitempty"`
+	IDNEQ   *int  `json:"idNEQ,omitempty"`
+	IDIn    []int `json:"idIn,omitempty"`
+	IDNotIn []int `json:"idNotIn,omitempty"`
+	IDGT    *int  `json:"idGT,omitempty"`
+	IDGTE   *int  `json:"idGTE,omitempty"`
+	IDLT    *int  `json:"idLT,omitempty"`
+	IDLTE   *int  `json:"idLTE,omitempty"`
+
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
+
+	// "updated_at" field predicates.
+	UpdatedAt      *time.Time  `json:"updatedAt,omitempty"`
+	UpdatedAtNEQ   *time.Time  `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn    []time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn []time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGT    *time.Time  `json:"updatedAtGT,omitempty"`
+	UpdatedAtGTE   *time.Time  `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
+	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
+
+	// "deleted_at" field predicates.
+	DeletedAt      *int  `json:"deletedAt,omitempty"`
+	DeletedAtNEQ   *int  `json:"deletedAtNEQ,omitempty"`
+	DeletedAtIn    []int `json:"deletedAtIn,omitempty"`
+	DeletedAtNotIn []int `json:"deletedAtNotIn,omitempty"`
+	DeletedAtGT    *int  `json:"deletedAtGT,omitempty"`
+	DeletedAtGTE   *int  `json:"deletedAtGTE,omitempty"`
+	DeletedAtLT    *int  `json:"deletedAtLT,omitempty"`
+	DeletedAtLTE   *int  `json:"deletedAtLTE,omitempty"`
+
+	// "name" field predicates.
+	Name             *string  `json:"name,omitempty"`
+	NameNEQ          *string  `json:"nameNEQ,omitempty"`
+	NameIn           []string `json:"nameIn,omitempty"`
+	NameNotIn        []string `json:"nameNotIn,omitempty"`
+	NameGT           *string  `json:"nameGT,omitempty"`
+	NameGTE          *string  `json:"nameGTE,omitempty"`
+	NameLT           *string  `json:"nameLT,omitempty"`
+	NameLTE          *string  `json:"nameLTE,omitempty"`
+	NameContains     *string  `json:"nameContains,omitempty"`
+	NameHasPrefix    *string  `json:"nameHasPrefix,omitempty"`
+	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
+	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
+	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
+
+	// "description" field predicates.
+	Description             *string  `json:"description,omitempty"`
+	DescriptionNEQ          *string  `json:"descriptionNEQ,omitempty"`
+	DescriptionIn           []string `json:"descriptionIn,omitempty"`
+	DescriptionNotIn        []string `json:"descriptionNotIn,omitempty"`
+	DescriptionGT           *string  `json:"descriptionGT,omitempty"`
+	DescriptionGTE          *string  `json:"descriptionGTE,omitempty"`
+	DescriptionLT           *string  `json:"descriptionLT,omitempty"`
+	DescriptionLTE          *string  `json:"descriptionLTE,omitempty"`
+	DescriptionContains     *string  `json:"descriptionContains,omitempty"`
+	DescriptionHasPrefix    *string  `json:"descriptionHasPrefix,omitempty"`
+	DescriptionHasSuffix    *string  `json:"descriptionHasSuffix,omitempty"`
+	DescriptionIsNil        bool     `json:"descriptionIsNil,omitempty"`
+	DescriptionNotNil       bool     `json:"descriptionNotNil,omitempty"`
+	DescriptionEqualFold    *string  `json:"descriptionEqualFold,omitempty"`
+	DescriptionContainsFold *string  `json:"descriptionContainsFold,omitempty"`
+
+	// "status" field predicates.
+	Status      *virtualmodel.Status  `json:"status,omitempty"`
+	StatusNEQ   *virtualmodel.Status  `json:"statusNEQ,omitempty"`
+	StatusIn    []virtualmodel.Status `json:"statusIn,omitempty"`
+	StatusNotIn []virtualmodel.Status `json:"statusNotIn,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *VirtualModelWhereInput) AddPredicates(predicates ...predicate.VirtualModel) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the VirtualModelWhereInput filter on the VirtualModelQuery builder.
+func (i *VirtualModelWhereInput) Filter(q *VirtualModelQuery) (*VirtualModelQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyVirtualModelWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyVirtualModelWhereInput is returned in case the VirtualModelWhereInput is empty.
+var ErrEmptyVirtualModelWhereInput = errors.New("ent: empty predicate VirtualModelWhereInput")
+
+// P returns a predicate for filtering virtualmodels.
+// An error is returned if the input is empty or invalid.
+func (i *VirtualModelWhereInput) P() (predicate.VirtualModel, error) {
+	var predicates []predicate.VirtualModel
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, virtualmodel.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.VirtualModel, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, virtualmodel.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.VirtualModel, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, virtualmodel.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, virtualmodel.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, virtualmodel.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, virtualmodel.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, virtualmodel.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, virtualmodel.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, virtualmodel.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, virtualmodel.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, virtualmodel.IDLTE(*i.IDLTE))
+	}
+	if i.CreatedAt != nil {
+		predicates = append(predicates, virtualmodel.CreatedAtEQ(*i.CreatedAt))
+	}
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, virtualmodel.CreatedAtNEQ(*i.CreatedAtNEQ))
+	}
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, virtualmodel.CreatedAtIn(i.CreatedAtIn...))
+	}
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, virtualmodel.CreatedAtNotIn(i.CreatedAtNotIn...))
+	}
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, virtualmodel.CreatedAtGT(*i.CreatedAtGT))
+	}
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, virtualmodel.CreatedAtGTE(*i.CreatedAtGTE))
+	}
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, virtualmodel.CreatedAtLT(*i.CreatedAtLT))
+	}
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, virtualmodel.CreatedAtLTE(*i.CreatedAtLTE))
+	}
+	if i.UpdatedAt != nil {
+		predicates = append(predicates, virtualmodel.UpdatedAtEQ(*i.UpdatedAt))
+	}
+	if i.UpdatedAtNEQ != nil {
+		predicates = append(predicates, virtualmodel.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+	}
+	if len(i.UpdatedAtIn) > 0 {
+		predicates = append(predicates, virtualmodel.UpdatedAtIn(i.UpdatedAtIn...))
+	}
+	if len(i.UpdatedAtNotIn) > 0 {
+		predicates = append(predicates, virtualmodel.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+	}
+	if i.UpdatedAtGT != nil {
+		predicates = append(predicates, virtualmodel.UpdatedAtGT(*i.UpdatedAtGT))
+	}
+	if i.UpdatedAtGTE != nil {
+		predicates = append(predicates, virtualmodel.UpdatedAtGTE(*i.UpdatedAtGTE))
+	}
+	if i.UpdatedAtLT != nil {
+		predicates = append(predicates, virtualmodel.UpdatedAtLT(*i.UpdatedAtLT))
+	}
+	if i.UpdatedAtLTE != nil {
+		predicates = append(predicates, virtualmodel.UpdatedAtLTE(*i.UpdatedAtLTE))
+	}
+	if i.DeletedAt != nil {
+		predicates = append(predicates, virtualmodel.DeletedAtEQ(*i.DeletedAt))
+	}
+	if i.DeletedAtNEQ != nil {
+		predicates = append(predicates, virtualmodel.DeletedAtNEQ(*i.DeletedAtNEQ))
+	}
+	if len(i.DeletedAtIn) > 0 {
+		predicates = append(predicates, virtualmodel.DeletedAtIn(i.DeletedAtIn...))
+	}
+	if len(i.DeletedAtNotIn) > 0 {
+		predicates = append(predicates, virtualmodel.DeletedAtNotIn(i.DeletedAtNotIn...))
+	}
+	if i.DeletedAtGT != nil {
+		predicates = append(predicates, virtualmodel.DeletedAtGT(*i.DeletedAtGT))
+	}
+	if i.DeletedAtGTE != nil {
+		predicates = append(predicates, virtualmodel.DeletedAtGTE(*i.DeletedAtGTE))
+	}
+	if i.DeletedAtLT != nil {
+		predicates = append(predicates, virtualmodel.DeletedAtLT(*i.DeletedAtLT))
+	}
+	if i.DeletedAtLTE != nil {
+		predicates = append(predicates, virtualmodel.DeletedAtLTE(*i.DeletedAtLTE))
+	}
+	if i.Name != nil {
+		predicates = append(predicates, virtualmodel.NameEQ(*i.Name))
+	}
+	if i.NameNEQ != nil {
+		predicates = append(predicates, virtualmodel.NameNEQ(*i.NameNEQ))
+	}
+	if len(i.NameIn) > 0 {
+		predicates = append(predicates, virtualmodel.NameIn(i.NameIn...))
+	}
+	if len(i.NameNotIn) > 0 {
+		predicates = append(predicates, virtualmodel.NameNotIn(i.NameNotIn...))
+	}
+	if i.NameGT != nil {
+		predicates = append(predicates, virtualmodel.NameGT(*i.NameGT))
+	}
+	if i.NameGTE != nil {
+		predicates = append(predicates, virtualmodel.NameGTE(*i.NameGTE))
+	}
+	if i.NameLT != nil {
+		predicates = append(predicates, virtualmodel.NameLT(*i.NameLT))
+	}
+	if i.NameLTE != nil {
+		predicates = append(predicates, virtualmodel.NameLTE(*i.NameLTE))
+	}
+	if i.NameContains != nil {
+		predicates = append(predicates, virtualmodel.NameContains(*i.NameContains))
+	}
+	if i.NameHasPrefix != nil {
+		predicates = append(predicates, virtualmodel.NameHasPrefix(*i.NameHasPrefix))
+	}
+	if i.NameHasSuffix != nil {
+		predicates = append(predicates, virtualmodel.NameHasSuffix(*i.NameHasSuffix))
+	}
+	if i.NameEqualFold != nil {
+		predicates = append(predicates, virtualmodel.NameEqualFold(*i.NameEqualFold))
+	}
+	if i.NameContainsFold != nil {
+		predicates = append(predicates, virtualmodel.NameContainsFold(*i.NameContainsFold))
+	}
+	if i.Description != nil {
+		predicates = append(predicates, virtualmodel.DescriptionEQ(*i.Description))
+	}
+	if i.DescriptionNEQ != nil {
+		predicates = append(predicates, virtualmodel.DescriptionNEQ(*i.DescriptionNEQ))
+	}
+	if len(i.DescriptionIn) > 0 {
+		predicates = append(predicates, virtualmodel.DescriptionIn(i.DescriptionIn...))
+	}
+	if len(i.DescriptionNotIn) > 0 {
+		predicates = append(predicates, virtualmodel.DescriptionNotIn(i.DescriptionNotIn...))
+	}
+	if i.DescriptionGT != nil {
+		predicates = append(predicates, virtualmodel.DescriptionGT(*i.DescriptionGT))
+	}
+	if i.DescriptionGTE != nil {
+		predicates = append(predicates, virtualmodel.DescriptionGTE(*i.DescriptionGTE))
+	}
+	if i.DescriptionLT != nil {
+		predicates = append(predicates, virtualmodel.DescriptionLT(*i.DescriptionLT))
+	}
+	if i.DescriptionLTE != nil {
+		predicates = append(predicates, virtualmodel.DescriptionLTE(*i.DescriptionLTE))
+	}
+	if i.DescriptionContains != nil {
+		predicates = append(predicates, virtualmodel.DescriptionContains(*i.DescriptionContains))
+	}
+	if i.DescriptionHasPrefix != nil {
+		predicates = append(predicates, virtualmodel.DescriptionHasPrefix(*i.DescriptionHasPrefix))
+	}
+	if i.DescriptionHasSuffix != nil {
+		predicates = append(predicates, virtualmodel.DescriptionHasSuffix(*i.DescriptionHasSuffix))
+	}
+	if i.DescriptionIsNil {
+		predicates = append(predicates, virtualmodel.DescriptionIsNil())
+	}
+	if i.DescriptionNotNil {
+		predicates = append(predicates, virtualmodel.DescriptionNotNil())
+	}
+	if i.DescriptionEqualFold != nil {
+		predicates = append(predicates, virtualmodel.DescriptionEqualFold(*i.DescriptionEqualFold))
+	}
+	if i.DescriptionContainsFold != nil {
+		predicates = append(predicates, virtualmodel.DescriptionContainsFold(*i.DescriptionContainsFold))
+	}
+	if i.Status != nil {
+		predicates = append(predicates, virtualmodel.StatusEQ(*i.Status))
+	}
+	if i.StatusNEQ != nil {
+		predicates = append(predicates, virtualmodel.StatusNEQ(*i.StatusNEQ))
+	}
+	if len(i.StatusIn) > 0 {
+		predicates = append(predicates, virtualmodel.StatusIn(i.StatusIn...))
+	}
+	if len(i.StatusNotIn) > 0 {
+		predicates = append(predicates, virtualmodel.StatusNotIn(i.StatusNotIn...))
+	}
+
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyVirtualModelWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return virtualmodel.And(predicates...), nil
+	}
+}
@@ -15,9 +15,21 @@ var (
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "deleted_at", Type: field.TypeInt, Default: 0},
 		{Name: "key", Type: field.TypeString},
+		{Name: "key_prefix", Type: field.TypeString, Default: ""},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "previous_key", Type: field.TypeString, Nullable: true},
+		{Name: "previous_key_prefix", Type: field.TypeString, Nullable: true},
+		{Name: "previous_key_expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "previous_key_last_used_at", Type: field.TypeTime, Nullable: true},
 		{Name: "name", Type: field.TypeString},
 		{Name: "status", Type: field.TypeEnum, Enums: []string{"enabled", "disabled"}, Default: "enabled"},
 		{Name: "scopes", Type: field.TypeJSON, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "allowed_cidrs", Type: field.TypeJSON, Nullable: true},
+		{Name: "allowed_models", Type: field.TypeJSON, Nullable: true},
+		{Name: "cache_mode", Type: field.TypeEnum, Enums: []string{"disabled", "opt_in", "always"}, Default: "disabled"},
+		{Name: "cache_scope", Type: field.TypeString, Nullable: true},
+		{Name: "guardrail_policy", Type: field.TypeJSON, Nullable: true},
 		{Name: "profiles", Type: field.TypeJSON, Nullable: true},
 		{Name: "user_id", Type: field.TypeInt},
 	}
@@ -29,7 +41,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "api_keys_users_api_keys",
-				Columns:    []*schema.Column{APIKeysColumns[9]},
+				Columns:    []*schema.Column{APIKeysColumns[21]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -38,13 +50,23 @@ var (
 			{
 				Name:    "api_keys_by_user_id",
 				Unique:  false,
-				Columns: []*schema.Column{APIKeysColumns[9]},
+				Columns: []*schema.Column{APIKeysColumns[21]},
 			},
 			{
 				Name:    "api_keys_by_key",
 				Unique:  true,
 				Columns: []*schema.Column{APIKeysColumns[4]},
 			},
+			{
+				Name:    "api_keys_by_key_prefix",
+				Unique:  false,
+				Columns: []*schema.Column{APIKeysColumns[5]},
+			},
+			{
+				Name:    "api_keys_by_previous_key_prefix",
+				Unique:  false,
+				Columns: []*schema.Column{APIKeysColumns[8]},
+			},
 		},
 	}
 	// ChannelsColumns holds the columns for the "channels" table.
@@ -70,9 +92,9 @@ var (
 		PrimaryKey: []*schema.Column{ChannelsColumns[0]},
 		Indexes: []*schema.Index{
 			{
-				Name:    "channels_by_name",
+				Name:    "channels_by_name_deleted_at",
 				Unique:  true,
-				Columns: []*schema.Column{ChannelsColumns[6]},
+				Columns: []*schema.Column{ChannelsColumns[6], ChannelsColumns[3]},
 			},
 		},
 	}
@@ -89,6 +111,9 @@ var (
 		{Name: "response_body", Type: field.TypeJSON, Nullable: true},
 		{Name: "response_chunks", Type: field.TypeJSON, Nullable: true},
 		{Name: "external_id", Type: field.TypeString, Nullable: true},
+		{Name: "cached", Type: field.TypeBool, Default: false},
+		{Name: "guardrail_violations", Type: field.TypeJSON, Nullable: true},
+		{Name: "fallback_hops", Type: field.TypeJSON, Nullable: true},
 		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "processing", "completed", "failed", "canceled"}},
 		{Name: "api_key_id", Type: field.TypeInt, Nullable: true},
 		{Name: "channel_id", Type: field.TypeInt, Nullable: true},
@@ -102,19 +127,19 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "requests_api_keys_requests",
-				Columns:    []*schema.Column{RequestsColumns[12]},
+				Columns:    []*schema.Column{RequestsColumns[15]},
 				RefColumns: []*schema.Column{APIKeysColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "requests_channels_requests",
-				Columns:    []*schema.Column{RequestsColumns[13]},
+				Columns:    []*schema.Column{RequestsColumns[16]},
 				RefColumns: []*schema.Column{ChannelsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "requests_users_requests",
-				Columns:    []*schema.Column{RequestsColumns[14]},
+				Columns:    []*schema.Column{RequestsColumns[17]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -123,17 +148,17 @@ var (
 			{
 				Name:    "requests_by_user_id",
 				Unique:  false,
-				Columns: []*schema.Column{RequestsColumns[14]},
+				Columns: []*schema.Column{RequestsColumns[17]},
 			},
 			{
 				Name:    "requests_by_api_key_id",
 				Unique:  false,
-				Columns: []*schema.Column{RequestsColumns[12]},
+				Columns: []*schema.Column{RequestsColumns[15]},
 			},
 			{
 				Name:    "requests_by_channel_id",
 				Unique:  false,
-				Columns: []*schema.Column{RequestsColumns[13]},
+				Columns: []*schema.Column{RequestsColumns[16]},
 			},
 			{
 				Name:    "requests_by_created_at",
@@ -143,7 +168,7 @@ var (
 			{
 				Name:    "requests_by_status",
 				Unique:  false,
-				Columns: []*schema.Column{RequestsColumns[11]},
+				Columns: []*schema.Column{RequestsColumns[14]},
 			},
 		},
 	}
@@ -196,6 +221,28 @@ var (
 			},
 		},
 	}
+	// ResponseCachesColumns holds the columns for the "response_caches" table.
+	ResponseCachesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "key", Type: field.TypeString, Unique: true},
+		{Name: "response", Type: field.TypeJSON},
+		{Name: "expires_at", Type: field.TypeTime},
+	}
+	// ResponseCachesTable holds the schema information for the "response_caches" table.
+	ResponseCachesTable = &schema.Table{
+		Name:       "response_caches",
+		Columns:    ResponseCachesColumns,
+		PrimaryKey: []*schema.Column{ResponseCachesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "response_caches_by_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{ResponseCachesColumns[5]},
+			},
+		},
+	}
 	// RolesColumns holds the columns for the "roles" table.
 	RolesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -219,6 +266,25 @@ var (
 			},
 		},
 	}
+	// RoutingRulesColumns holds the columns for the "routing_rules" table.
+	RoutingRulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeInt, Default: 0},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"enabled", "disabled"}, Default: "enabled"},
+		{Name: "priority", Type: field.TypeInt, Default: 0},
+		{Name: "conditions", Type: field.TypeJSON},
+		{Name: "action", Type: field.TypeEnum, Enums: []string{"restrict", "prefer"}, Default: "restrict"},
+	}
+	// RoutingRulesTable holds the schema information for the "routing_rules" table.
+	RoutingRulesTable = &schema.Table{
+		Name:       "routing_rules",
+		Columns:    RoutingRulesColumns,
+		PrimaryKey: []*schema.Column{RoutingRulesColumns[0]},
+	}
 	// SystemsColumns holds the columns for the "systems" table.
 	SystemsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -246,10 +312,12 @@ var (
 		{Name: "total_tokens", Type: field.TypeInt, Default: 0},
 		{Name: "prompt_audio_tokens", Type: field.TypeInt, Nullable: true, Default: 0},
 		{Name: "prompt_cached_tokens", Type: field.TypeInt, Nullable: true, Default: 0},
+		{Name: "prompt_cache_creation_tokens", Type: field.TypeInt, Nullable: true, Default: 0},
 		{Name: "completion_audio_tokens", Type: field.TypeInt, Nullable: true, Default: 0},
 		{Name: "completion_reasoning_tokens", Type: field.TypeInt, Nullable: true, Default: 0},
 		{Name: "completion_accepted_prediction_tokens", Type: field.TypeInt, Nullable: true, Default: 0},
 		{Name: "completion_rejected_prediction_tokens", Type: field.TypeInt, Nullable: true, Default: 0},
+		{Name: "estimated", Type: field.TypeBool, Default: false},
 		{Name: "source", Type: field.TypeEnum, Enums: []string{"api", "playground", "test"}, Default: "api"},
 		{Name: "format", Type: field.TypeString, Default: "openai/chat_completions"},
 		{Name: "channel_id", Type: field.TypeInt, Nullable: true},
@@ -264,19 +332,19 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "usage_logs_channels_usage_logs",
-				Columns:    []*schema.Column{UsageLogsColumns[16]},
+				Columns:    []*schema.Column{UsageLogsColumns[18]},
 				RefColumns: []*schema.Column{ChannelsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "usage_logs_requests_usage_logs",
-				Columns:    []*schema.Column{UsageLogsColumns[17]},
+				Columns:    []*schema.Column{UsageLogsColumns[19]},
 				RefColumns: []*schema.Column{RequestsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "usage_logs_users_usage_logs",
-				Columns:    []*schema.Column{UsageLogsColumns[18]},
+				Columns:    []*schema.Column{UsageLogsColumns[20]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -285,17 +353,17 @@ var (
 			{
 				Name:    "usage_logs_by_user_id",
 				Unique:  false,
-				Columns: []*schema.Column{UsageLogsColumns[18]},
+				Columns: []*schema.Column{UsageLogsColumns[20]},
 			},
 			{
 				Name:    "usage_logs_by_request_id",
 				Unique:  false,
-				Columns: []*schema.Column{UsageLogsColumns[17]},
+				Columns: []*schema.Column{UsageLogsColumns[19]},
 			},
 			{
 				Name:    "usage_logs_by_channel_id",
 				Unique:  false,
-				Columns: []*schema.Column{UsageLogsColumns[16]},
+				Columns: []*schema.Column{UsageLogsColumns[18]},
 			},
 			{
 				Name:    "usage_logs_by_created_at",
@@ -310,12 +378,12 @@ var (
 			{
 				Name:    "usage_logs_by_user_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{UsageLogsColumns[18], UsageLogsColumns[1]},
+				Columns: []*schema.Column{UsageLogsColumns[20], UsageLogsColumns[1]},
 			},
 			{
 				Name:    "usage_logs_by_channel_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{UsageLogsColumns[16], UsageLogsColumns[1]},
+				Columns: []*schema.Column{UsageLogsColumns[18], UsageLogsColumns[1]},
 			},
 		},
 	}
@@ -341,6 +409,55 @@ var (
 		Columns:    UsersColumns,
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
 	}
+	// VirtualModelsColumns holds the columns for the "virtual_models" table.
+	VirtualModelsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeInt, Default: 0},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"enabled", "disabled"}, Default: "enabled"},
+		{Name: "targets", Type: field.TypeJSON},
+	}
+	// VirtualModelsTable holds the schema information for the "virtual_models" table.
+	VirtualModelsTable = &schema.Table{
+		Name:       "virtual_models",
+		Columns:    VirtualModelsColumns,
+		PrimaryKey: []*schema.Column{VirtualModelsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "virtual_models_by_name_deleted_at",
+				Unique:  true,
+				Columns: []*schema.Column{VirtualModelsColumns[4], VirtualModelsColumns[3]},
+			},
+		},
+	}
+	// RoutingRuleChannelsColumns holds the columns for the "routing_rule_channels" table.
+	RoutingRuleChannelsColumns = []*schema.Column{
+		{Name: "routing_rule_id", Type: field.TypeInt},
+		{Name: "channel_id", Type: field.TypeInt},
+	}
+	// RoutingRuleChannelsTable holds the schema information for the "routing_rule_channels" table.
+	RoutingRuleChannelsTable = &schema.Table{
+		Name:       "routing_rule_channels",
+		Columns:    RoutingRuleChannelsColumns,
+		PrimaryKey: []*schema.Column{RoutingRuleChannelsColumns[0], RoutingRuleChannelsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "routing_rule_channels_routing_rule_id",
+				Columns:    []*schema.Column{RoutingRuleChannelsColumns[0]},
+				RefColumns: []*schema.Column{RoutingRulesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "routing_rule_channels_channel_id",
+				Columns:    []*schema.Column{RoutingRuleChannelsColumns[1]},
+				RefColumns: []*schema.Column{ChannelsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// UserRolesColumns holds the columns for the "user_roles" table.
 	UserRolesColumns = []*schema.Column{
 		{Name: "user_id", Type: field.TypeInt},
@@ -372,10 +489,14 @@ var (
 		ChannelsTable,
 		RequestsTable,
 		RequestExecutionsTable,
+		ResponseCachesTable,
 		RolesTable,
+		RoutingRulesTable,
 		SystemsTable,
 		UsageLogsTable,
 		UsersTable,
+		VirtualModelsTable,
+		RoutingRuleChannelsTable,
 		UserRolesTable,
 	}
 )
@@ -390,6 +511,8 @@ func init() {
 	UsageLogsTable.ForeignKeys[0].RefTable = ChannelsTable
 	UsageLogsTable.ForeignKeys[1].RefTable = RequestsTable
 	UsageLogsTable.ForeignKeys[2].RefTable = UsersTable
+	RoutingRuleChannelsTable.ForeignKeys[0].RefTable = RoutingRulesTable
+	RoutingRuleChannelsTable.ForeignKeys[1].RefTable = ChannelsTable
 	UserRolesTable.ForeignKeys[0].RefTable = UsersTable
 	UserRolesTable.ForeignKeys[1].RefTable = RolesTable
 }
@@ -16,10 +16,13 @@ import (
 	"github.com/looplj/axonhub/internal/ent/predicate"
 	"github.com/looplj/axonhub/internal/ent/request"
 	"github.com/looplj/axonhub/internal/ent/requestexecution"
+	"github.com/looplj/axonhub/internal/ent/responsecache"
 	"github.com/looplj/axonhub/internal/ent/role"
+	"github.com/looplj/axonhub/internal/ent/routingrule"
 	"github.com/looplj/axonhub/internal/ent/system"
 	"github.com/looplj/axonhub/internal/ent/usagelog"
 	"github.com/looplj/axonhub/internal/ent/user"
+	"github.com/looplj/axonhub/internal/ent/virtualmodel"
 	"github.com/looplj/axonhub/internal/objects"
 )
 
@@ -36,37 +39,54 @@ const (
 	TypeChannel          = "Channel"
 	TypeRequest          = "Request"
 	TypeRequestExecution = "RequestExecution"
+	TypeResponseCache    = "ResponseCache"
 	TypeRole             = "Role"
+	TypeRoutingRule      = "RoutingRule"
 	TypeSystem           = "System"
 	TypeUsageLog         = "UsageLog"
 	TypeUser             = "User"
+	TypeVirtualModel     = "VirtualModel"
 )
 
 // APIKeyMutation represents an operation that mutates the APIKey nodes in the graph.
 type APIKeyMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *int
-	created_at      *time.Time
-	updated_at      *time.Time
-	deleted_at      *int
-	adddeleted_at   *int
-	key             *string
-	name            *string
-	status          *apikey.Status
-	scopes          *[]string
-	appendscopes    []string
-	profiles        **objects.APIKeyProfiles
-	clearedFields   map[string]struct{}
-	user            *int
-	cleareduser     bool
-	requests        map[int]struct{}
-	removedrequests map[int]struct{}
-	clearedrequests bool
-	done            bool
-	oldValue        func(context.Context) (*APIKey, error)
-	predicates      []predicate.APIKey
+	op                        Op
+	typ                       string
+	id                        *int
+	created_at                *time.Time
+	updated_at                *time.Time
+	deleted_at                *int
+	adddeleted_at             *int
+	key                       *string
+	key_prefix                *string
+	last_used_at              *time.Time
+	previous_key              *string
+	previous_key_prefix       *string
+	previous_key_expires_at   *time.Time
+	previous_key_last_used_at *time.Time
+	name                      *string
+	status                    *apikey.Status
+	scopes                    *[]string
+	appendscopes              []string
+	expires_at                *time.Time
+	allowed_cidrs             *[]string
+	appendallowed_cidrs       []string
+	allowed_models            *[]string
+	appendallowed_models      []string
+	cache_mode                *apikey.CacheMode
+	cache_scope               *string
+	guardrail_policy          **objects.GuardrailPolicy
+	profiles                  **objects.APIKeyProfiles
+	clearedFields             map[string]struct{}
+	user                      *int
+	cleareduser               bool
+	requests                  map[int]struct{}
+	removedrequests           map[int]struct{}
+	clearedrequests           bool
+	done                      bool
+	oldValue                  func(context.Context) (*APIKey, error)
+	predicates                []predicate.APIKey
 }
 
 var _ ent.Mutation = (*APIKeyMutation)(nil)
@@ -367,6 +387,287 @@ func (m *APIKeyMutation) ResetKey() {
 	m.key = nil
 }
 
+// SetKeyPrefix sets the "key_prefix" field.
+func (m *APIKeyMutation) SetKeyPrefix(s string) {
+	m.key_prefix = &s
+}
+
+// KeyPrefix returns the value of the "key_prefix" field in the mutation.
+func (m *APIKeyMutation) KeyPrefix() (r string, exists bool) {
+	v := m.key_prefix
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKeyPrefix returns the old "key_prefix" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIKeyMutation) OldKeyPrefix(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeyPrefix is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeyPrefix requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeyPrefix: %w", err)
+	}
+	return oldValue.KeyPrefix, nil
+}
+
+// ResetKeyPrefix resets all changes to the "key_prefix" field.
+func (m *APIKeyMutation) ResetKeyPrefix() {
+	m.key_prefix = nil
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (m *APIKeyMutation) SetLastUsedAt(t time.Time) {
+	m.last_used_at = &t
+}
+
+// LastUsedAt returns the value of the "last_used_at" field in the mutation.
+func (m *APIKeyMutation) LastUsedAt() (r time.Time, exists bool) {
+	v := m.last_used_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastUsedAt returns the old "last_used_at" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIKeyMutation) OldLastUsedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastUsedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastUsedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastUsedAt: %w", err)
+	}
+	return oldValue.LastUsedAt, nil
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (m *APIKeyMutation) ClearLastUsedAt() {
+	m.last_used_at = nil
+	m.clearedFields[apikey.FieldLastUsedAt] = struct{}{}
+}
+
+// LastUsedAtCleared returns if the "last_used_at" field was cleared in this mutation.
+func (m *APIKeyMutation) LastUsedAtCleared() bool {
+	_, ok := m.clearedFields[apikey.FieldLastUsedAt]
+	return ok
+}
+
+// ResetLastUsedAt resets all changes to the "last_used_at" field.
+func (m *APIKeyMutation) ResetLastUsedAt() {
+	m.last_used_at = nil
+	delete(m.clearedFields, apikey.FieldLastUsedAt)
+}
+
+// SetPreviousKey sets the "previous_key" field.
+func (m *APIKeyMutation) SetPreviousKey(s string) {
+	m.previous_key = &s
+}
+
+// PreviousKey returns the value of the "previous_key" field in the mutation.
+func (m *APIKeyMutation) PreviousKey() (r string, exists bool) {
+	v := m.previous_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPreviousKey returns the old "previous_key" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIKeyMutation) OldPreviousKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPreviousKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPreviousKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPreviousKey: %w", err)
+	}
+	return oldValue.PreviousKey, nil
+}
+
+// ClearPreviousKey clears the value of the "previous_key" field.
+func (m *APIKeyMutation) ClearPreviousKey() {
+	m.previous_key = nil
+	m.clearedFields[apikey.FieldPreviousKey] = struct{}{}
+}
+
+// PreviousKeyCleared returns if the "previous_key" field was cleared in this mutation.
+func (m *APIKeyMutation) PreviousKeyCleared() bool {
+	_, ok := m.clearedFields[apikey.FieldPreviousKey]
+	return ok
+}
+
+// ResetPreviousKey resets all changes to the "previous_key" field.
+func (m *APIKeyMutation) ResetPreviousKey() {
+	m.previous_key = nil
+	delete(m.clearedFields, apikey.FieldPreviousKey)
+}
+
+// SetPreviousKeyPrefix sets the "previous_key_prefix" field.
+func (m *APIKeyMutation) SetPreviousKeyPrefix(s string) {
+	m.previous_key_prefix = &s
+}
+
+// PreviousKeyPrefix returns the value of the "previous_key_prefix" field in the mutation.
+func (m *APIKeyMutation) PreviousKeyPrefix() (r string, exists bool) {
+	v := m.previous_key_prefix
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPreviousKeyPrefix returns the old "previous_key_prefix" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIKeyMutation) OldPreviousKeyPrefix(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPreviousKeyPrefix is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPreviousKeyPrefix requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPreviousKeyPrefix: %w", err)
+	}
+	return oldValue.PreviousKeyPrefix, nil
+}
+
+// ClearPreviousKeyPrefix clears the value of the "previous_key_prefix" field.
+func (m *APIKeyMutation) ClearPreviousKeyPrefix() {
+	m.previous_key_prefix = nil
+	m.clearedFields[apikey.FieldPreviousKeyPrefix] = struct{}{}
+}
+
+// PreviousKeyPrefixCleared returns if the "previous_key_prefix" field was cleared in this mutation.
+func (m *APIKeyMutation) PreviousKeyPrefixCleared() bool {
+	_, ok := m.clearedFields[apikey.FieldPreviousKeyPrefix]
+	return ok
+}
+
+// ResetPreviousKeyPrefix resets all changes to the "previous_key_prefix" field.
+func (m *APIKeyMutation) ResetPreviousKeyPrefix() {
+	m.previous_key_prefix = nil
+	delete(m.clearedFields, apikey.FieldPreviousKeyPrefix)
+}
+
+// SetPreviousKeyExpiresAt sets the "previous_key_expires_at" field.
+func (m *APIKeyMutation) SetPreviousKeyExpiresAt(t time.Time) {
+	m.previous_key_expires_at = &t
+}
+
+// PreviousKeyExpiresAt returns the value of the "previous_key_expires_at" field in the mutation.
+func (m *APIKeyMutation) PreviousKeyExpiresAt() (r time.Time, exists bool) {
+	v := m.previous_key_expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPreviousKeyExpiresAt returns the old "previous_key_expires_at" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIKeyMutation) OldPreviousKeyExpiresAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPreviousKeyExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPreviousKeyExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPreviousKeyExpiresAt: %w", err)
+	}
+	return oldValue.PreviousKeyExpiresAt, nil
+}
+
+// ClearPreviousKeyExpiresAt clears the value of the "previous_key_expires_at" field.
+func (m *APIKeyMutation) ClearPreviousKeyExpiresAt() {
+	m.previous_key_expires_at = nil
+	m.clearedFields[apikey.FieldPreviousKeyExpiresAt] = struct{}{}
+}
+
+// PreviousKeyExpiresAtCleared returns if the "previous_key_expires_at" field was cleared in this mutation.
+func (m *APIKeyMutation) PreviousKeyExpiresAtCleared() bool {
+	_, ok := m.clearedFields[apikey.FieldPreviousKeyExpiresAt]
+	return ok
+}
+
+// ResetPreviousKeyExpiresAt resets all changes to the "previous_key_expires_at" field.
+func (m *APIKeyMutation) ResetPreviousKeyExpiresAt() {
+	m.previous_key_expires_at = nil
+	delete(m.clearedFields, apikey.FieldPreviousKeyExpiresAt)
+}
+
+// SetPreviousKeyLastUsedAt sets the "previous_key_last_used_at" field.
+func (m *APIKeyMutation) SetPreviousKeyLastUsedAt(t time.Time) {
+	m.previous_key_last_used_at = &t
+}
+
+// PreviousKeyLastUsedAt returns the value of the "previous_key_last_used_at" field in the mutation.
+func (m *APIKeyMutation) PreviousKeyLastUsedAt() (r time.Time, exists bool) {
+	v := m.previous_key_last_used_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPreviousKeyLastUsedAt returns the old "previous_key_last_used_at" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIKeyMutation) OldPreviousKeyLastUsedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPreviousKeyLastUsedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPreviousKeyLastUsedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPreviousKeyLastUsedAt: %w", err)
+	}
+	return oldValue.PreviousKeyLastUsedAt, nil
+}
+
+// ClearPreviousKeyLastUsedAt clears the value of the "previous_key_last_used_at" field.
+func (m *APIKeyMutation) ClearPreviousKeyLastUsedAt() {
+	m.previous_key_last_used_at = nil
+	m.clearedFields[apikey.FieldPreviousKeyLastUsedAt] = struct{}{}
+}
+
+// PreviousKeyLastUsedAtCleared returns if the "previous_key_last_used_at" field was cleared in this mutation.
+func (m *APIKeyMutation) PreviousKeyLastUsedAtCleared() bool {
+	_, ok := m.clearedFields[apikey.FieldPreviousKeyLastUsedAt]
+	return ok
+}
+
+// ResetPreviousKeyLastUsedAt resets all changes to the "previous_key_last_used_at" field.
+func (m *APIKeyMutation) ResetPreviousKeyLastUsedAt() {
+	m.previous_key_last_used_at = nil
+	delete(m.clearedFields, apikey.FieldPreviousKeyLastUsedAt)
+}
+
 // SetName sets the "name" field.
 func (m *APIKeyMutation) SetName(s string) {
 	m.name = &s
@@ -504,133 +805,446 @@ func (m *APIKeyMutation) ResetScopes() {
 	delete(m.clearedFields, apikey.FieldScopes)
 }
 
-// SetProfiles sets the "profiles" field.
-func (m *APIKeyMutation) SetProfiles(okp *objects.APIKeyProfiles) {
-	m.profiles = &okp
+// SetExpiresAt sets the "expires_at" field.
+func (m *APIKeyMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
 }
 
-// Profiles returns the value of the "profiles" field in the mutation.
-func (m *APIKeyMutation) Profiles() (r *objects.APIKeyProfiles, exists bool) {
-	v := m.profiles
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *APIKeyMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProfiles returns the old "profiles" field's value of the APIKey entity.
+// OldExpiresAt returns the old "expires_at" field's value of the APIKey entity.
 // If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *APIKeyMutation) OldProfiles(ctx context.Context) (v *objects.APIKeyProfiles, err error) {
+func (m *APIKeyMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProfiles is only allowed on UpdateOne operations")
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProfiles requires an ID field in the mutation")
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProfiles: %w", err)
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
 	}
-	return oldValue.Profiles, nil
+	return oldValue.ExpiresAt, nil
 }
 
-// ClearProfiles clears the value of the "profiles" field.
-func (m *APIKeyMutation) ClearProfiles() {
-	m.profiles = nil
-	m.clearedFields[apikey.FieldProfiles] = struct{}{}
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *APIKeyMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[apikey.FieldExpiresAt] = struct{}{}
 }
 
-// ProfilesCleared returns if the "profiles" field was cleared in this mutation.
-func (m *APIKeyMutation) ProfilesCleared() bool {
-	_, ok := m.clearedFields[apikey.FieldProfiles]
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *APIKeyMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[apikey.FieldExpiresAt]
 	return ok
 }
 
-// ResetProfiles resets all changes to the "profiles" field.
-func (m *APIKeyMutation) ResetProfiles() {
-	m.profiles = nil
-	delete(m.clearedFields, apikey.FieldProfiles)
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *APIKeyMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, apikey.FieldExpiresAt)
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *APIKeyMutation) ClearUser() {
-	m.cleareduser = true
-	m.clearedFields[apikey.FieldUserID] = struct{}{}
+// SetAllowedCidrs sets the "allowed_cidrs" field.
+func (m *APIKeyMutation) SetAllowedCidrs(s []string) {
+	m.allowed_cidrs = &s
+	m.appendallowed_cidrs = nil
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *APIKeyMutation) UserCleared() bool {
-	return m.cleareduser
+// AllowedCidrs returns the value of the "allowed_cidrs" field in the mutation.
+func (m *APIKeyMutation) AllowedCidrs() (r []string, exists bool) {
+	v := m.allowed_cidrs
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *APIKeyMutation) UserIDs() (ids []int) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
+// OldAllowedCidrs returns the old "allowed_cidrs" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIKeyMutation) OldAllowedCidrs(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAllowedCidrs is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAllowedCidrs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAllowedCidrs: %w", err)
+	}
+	return oldValue.AllowedCidrs, nil
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *APIKeyMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// AppendAllowedCidrs adds s to the "allowed_cidrs" field.
+func (m *APIKeyMutation) AppendAllowedCidrs(s []string) {
+	m.appendallowed_cidrs = append(m.appendallowed_cidrs, s...)
 }
 
-// AddRequestIDs adds the "requests" edge to the Request entity by ids.
-func (m *APIKeyMutation) AddRequestIDs(ids ...int) {
-	if m.requests == nil {
-		m.requests = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.requests[ids[i]] = struct{}{}
+// AppendedAllowedCidrs returns the list of values that were appended to the "allowed_cidrs" field in this mutation.
+func (m *APIKeyMutation) AppendedAllowedCidrs() ([]string, bool) {
+	if len(m.appendallowed_cidrs) == 0 {
+		return nil, false
 	}
+	return m.appendallowed_cidrs, true
 }
 
-// ClearRequests clears the "requests" edge to the Request entity.
-func (m *APIKeyMutation) ClearRequests() {
-	m.clearedrequests = true
+// ClearAllowedCidrs clears the value of the "allowed_cidrs" field.
+func (m *APIKeyMutation) ClearAllowedCidrs() {
+	m.allowed_cidrs = nil
+	m.appendallowed_cidrs = nil
+	m.clearedFields[apikey.FieldAllowedCidrs] = struct{}{}
 }
 
-// RequestsCleared reports if the "requests" edge to the Request entity was cleared.
-func (m *APIKeyMutation) RequestsCleared() bool {
-	return m.clearedrequests
+// AllowedCidrsCleared returns if the "allowed_cidrs" field was cleared in this mutation.
+func (m *APIKeyMutation) AllowedCidrsCleared() bool {
+	_, ok := m.clearedFields[apikey.FieldAllowedCidrs]
+	return ok
 }
 
-// RemoveRequestIDs removes the "requests" edge to the Request entity by IDs.
-func (m *APIKeyMutation) RemoveRequestIDs(ids ...int) {
-	if m.removedrequests == nil {
-		m.removedrequests = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.requests, ids[i])
-		m.removedrequests[ids[i]] = struct{}{}
-	}
+// ResetAllowedCidrs resets all changes to the "allowed_cidrs" field.
+func (m *APIKeyMutation) ResetAllowedCidrs() {
+	m.allowed_cidrs = nil
+	m.appendallowed_cidrs = nil
+	delete(m.clearedFields, apikey.FieldAllowedCidrs)
 }
 
-// RemovedRequests returns the removed IDs of the "requests" edge to the Request entity.
-func (m *APIKeyMutation) RemovedRequestsIDs() (ids []int) {
-	for id := range m.removedrequests {
-		ids = append(ids, id)
-	}
-	return
+// SetAllowedModels sets the "allowed_models" field.
+func (m *APIKeyMutation) SetAllowedModels(s []string) {
+	m.allowed_models = &s
+	m.appendallowed_models = nil
 }
 
-// RequestsIDs returns the "requests" edge IDs in the mutation.
-func (m *APIKeyMutation) RequestsIDs() (ids []int) {
-	for id := range m.requests {
-		ids = append(ids, id)
+// AllowedModels returns the value of the "allowed_models" field in the mutation.
+func (m *APIKeyMutation) AllowedModels() (r []string, exists bool) {
+	v := m.allowed_models
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetRequests resets all changes to the "requests" edge.
-func (m *APIKeyMutation) ResetRequests() {
-	m.requests = nil
-	m.clearedrequests = false
+// OldAllowedModels returns the old "allowed_models" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIKeyMutation) OldAllowedModels(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAllowedModels is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAllowedModels requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAllowedModels: %w", err)
+	}
+	return oldValue.AllowedModels, nil
+}
+
+// AppendAllowedModels adds s to the "allowed_models" field.
+func (m *APIKeyMutation) AppendAllowedModels(s []string) {
+	m.appendallowed_models = append(m.appendallowed_models, s...)
+}
+
+// AppendedAllowedModels returns the list of values that were appended to the "allowed_models" field in this mutation.
+func (m *APIKeyMutation) AppendedAllowedModels() ([]string, bool) {
+	if len(m.appendallowed_models) == 0 {
+		return nil, false
+	}
+	return m.appendallowed_models, true
+}
+
+// ClearAllowedModels clears the value of the "allowed_models" field.
+func (m *APIKeyMutation) ClearAllowedModels() {
+	m.allowed_models = nil
+	m.appendallowed_models = nil
+	m.clearedFields[apikey.FieldAllowedModels] = struct{}{}
+}
+
+// AllowedModelsCleared returns if the "allowed_models" field was cleared in this mutation.
+func (m *APIKeyMutation) AllowedModelsCleared() bool {
+	_, ok := m.clearedFields[apikey.FieldAllowedModels]
+	return ok
+}
+
+// ResetAllowedModels resets all changes to the "allowed_models" field.
+func (m *APIKeyMutation) ResetAllowedModels() {
+	m.allowed_models = nil
+	m.appendallowed_models = nil
+	delete(m.clearedFields, apikey.FieldAllowedModels)
+}
+
+// SetCacheMode sets the "cache_mode" field.
+func (m *APIKeyMutation) SetCacheMode(am apikey.CacheMode) {
+	m.cache_mode = &am
+}
+
+// CacheMode returns the value of the "cache_mode" field in the mutation.
+func (m *APIKeyMutation) CacheMode() (r apikey.CacheMode, exists bool) {
+	v := m.cache_mode
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCacheMode returns the old "cache_mode" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIKeyMutation) OldCacheMode(ctx context.Context) (v apikey.CacheMode, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCacheMode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCacheMode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCacheMode: %w", err)
+	}
+	return oldValue.CacheMode, nil
+}
+
+// ResetCacheMode resets all changes to the "cache_mode" field.
+func (m *APIKeyMutation) ResetCacheMode() {
+	m.cache_mode = nil
+}
+
+// SetCacheScope sets the "cache_scope" field.
+func (m *APIKeyMutation) SetCacheScope(s string) {
+	m.cache_scope = &s
+}
+
+// CacheScope returns the value of the "cache_scope" field in the mutation.
+func (m *APIKeyMutation) CacheScope() (r string, exists bool) {
+	v := m.cache_scope
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCacheScope returns the old "cache_scope" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIKeyMutation) OldCacheScope(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCacheScope is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCacheScope requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCacheScope: %w", err)
+	}
+	return oldValue.CacheScope, nil
+}
+
+// ClearCacheScope clears the value of the "cache_scope" field.
+func (m *APIKeyMutation) ClearCacheScope() {
+	m.cache_scope = nil
+	m.clearedFields[apikey.FieldCacheScope] = struct{}{}
+}
+
+// CacheScopeCleared returns if the "cache_scope" field was cleared in this mutation.
+func (m *APIKeyMutation) CacheScopeCleared() bool {
+	_, ok := m.clearedFields[apikey.FieldCacheScope]
+	return ok
+}
+
+// ResetCacheScope resets all changes to the "cache_scope" field.
+func (m *APIKeyMutation) ResetCacheScope() {
+	m.cache_scope = nil
+	delete(m.clearedFields, apikey.FieldCacheScope)
+}
+
+// SetGuardrailPolicy sets the "guardrail_policy" field.
+func (m *APIKeyMutation) SetGuardrailPolicy(op *objects.GuardrailPolicy) {
+	m.guardrail_policy = &op
+}
+
+// GuardrailPolicy returns the value of the "guardrail_policy" field in the mutation.
+func (m *APIKeyMutation) GuardrailPolicy() (r *objects.GuardrailPolicy, exists bool) {
+	v := m.guardrail_policy
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGuardrailPolicy returns the old "guardrail_policy" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIKeyMutation) OldGuardrailPolicy(ctx context.Context) (v *objects.GuardrailPolicy, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGuardrailPolicy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGuardrailPolicy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGuardrailPolicy: %w", err)
+	}
+	return oldValue.GuardrailPolicy, nil
+}
+
+// ClearGuardrailPolicy clears the value of the "guardrail_policy" field.
+func (m *APIKeyMutation) ClearGuardrailPolicy() {
+	m.guardrail_policy = nil
+	m.clearedFields[apikey.FieldGuardrailPolicy] = struct{}{}
+}
+
+// GuardrailPolicyCleared returns if the "guardrail_policy" field was cleared in this mutation.
+func (m *APIKeyMutation) GuardrailPolicyCleared() bool {
+	_, ok := m.clearedFields[apikey.FieldGuardrailPolicy]
+	return ok
+}
+
+// ResetGuardrailPolicy resets all changes to the "guardrail_policy" field.
+func (m *APIKeyMutation) ResetGuardrailPolicy() {
+	m.guardrail_policy = nil
+	delete(m.clearedFields, apikey.FieldGuardrailPolicy)
+}
+
+// SetProfiles sets the "profiles" field.
+func (m *APIKeyMutation) SetProfiles(okp *objects.APIKeyProfiles) {
+	m.profiles = &okp
+}
+
+// Profiles returns the value of the "profiles" field in the mutation.
+func (m *APIKeyMutation) Profiles() (r *objects.APIKeyProfiles, exists bool) {
+	v := m.profiles
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProfiles returns the old "profiles" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIKeyMutation) OldProfiles(ctx context.Context) (v *objects.APIKeyProfiles, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProfiles is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProfiles requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProfiles: %w", err)
+	}
+	return oldValue.Profiles, nil
+}
+
+// ClearProfiles clears the value of the "profiles" field.
+func (m *APIKeyMutation) ClearProfiles() {
+	m.profiles = nil
+	m.clearedFields[apikey.FieldProfiles] = struct{}{}
+}
+
+// ProfilesCleared returns if the "profiles" field was cleared in this mutation.
+func (m *APIKeyMutation) ProfilesCleared() bool {
+	_, ok := m.clearedFields[apikey.FieldProfiles]
+	return ok
+}
+
+// ResetProfiles resets all changes to the "profiles" field.
+func (m *APIKeyMutation) ResetProfiles() {
+	m.profiles = nil
+	delete(m.clearedFields, apikey.FieldProfiles)
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *APIKeyMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[apikey.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *APIKeyMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *APIKeyMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *APIKeyMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// AddRequestIDs adds the "requests" edge to the Request entity by ids.
+func (m *APIKeyMutation) AddRequestIDs(ids ...int) {
+	if m.requests == nil {
+		m.requests = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.requests[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRequests clears the "requests" edge to the Request entity.
+func (m *APIKeyMutation) ClearRequests() {
+	m.clearedrequests = true
+}
+
+// RequestsCleared reports if the "requests" edge to the Request entity was cleared.
+func (m *APIKeyMutation) RequestsCleared() bool {
+	return m.clearedrequests
+}
+
+// RemoveRequestIDs removes the "requests" edge to the Request entity by IDs.
+func (m *APIKeyMutation) RemoveRequestIDs(ids ...int) {
+	if m.removedrequests == nil {
+		m.removedrequests = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.requests, ids[i])
+		m.removedrequests[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRequests returns the removed IDs of the "requests" edge to the Request entity.
+func (m *APIKeyMutation) RemovedRequestsIDs() (ids []int) {
+	for id := range m.removedrequests {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RequestsIDs returns the "requests" edge IDs in the mutation.
+func (m *APIKeyMutation) RequestsIDs() (ids []int) {
+	for id := range m.requests {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRequests resets all changes to the "requests" edge.
+func (m *APIKeyMutation) ResetRequests() {
+	m.requests = nil
+	m.clearedrequests = false
 	m.removedrequests = nil
 }
 
@@ -668,7 +1282,7 @@ func (m *APIKeyMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *APIKeyMutation) Fields() []string {
-	fields := make([]string, 0, 9)
+	fields := make([]string, 0, 21)
 	if m.created_at != nil {
 		fields = append(fields, apikey.FieldCreatedAt)
 	}
@@ -684,6 +1298,24 @@ func (m *APIKeyMutation) Fields() []string {
 	if m.key != nil {
 		fields = append(fields, apikey.FieldKey)
 	}
+	if m.key_prefix != nil {
+		fields = append(fields, apikey.FieldKeyPrefix)
+	}
+	if m.last_used_at != nil {
+		fields = append(fields, apikey.FieldLastUsedAt)
+	}
+	if m.previous_key != nil {
+		fields = append(fields, apikey.FieldPreviousKey)
+	}
+	if m.previous_key_prefix != nil {
+		fields = append(fields, apikey.FieldPreviousKeyPrefix)
+	}
+	if m.previous_key_expires_at != nil {
+		fields = append(fields, apikey.FieldPreviousKeyExpiresAt)
+	}
+	if m.previous_key_last_used_at != nil {
+		fields = append(fields, apikey.FieldPreviousKeyLastUsedAt)
+	}
 	if m.name != nil {
 		fields = append(fields, apikey.FieldName)
 	}
@@ -693,6 +1325,24 @@ func (m *APIKeyMutation) Fields() []string {
 	if m.scopes != nil {
 		fields = append(fields, apikey.FieldScopes)
 	}
+	if m.expires_at != nil {
+		fields = append(fields, apikey.FieldExpiresAt)
+	}
+	if m.allowed_cidrs != nil {
+		fields = append(fields, apikey.FieldAllowedCidrs)
+	}
+	if m.allowed_models != nil {
+		fields = append(fields, apikey.FieldAllowedModels)
+	}
+	if m.cache_mode != nil {
+		fields = append(fields, apikey.FieldCacheMode)
+	}
+	if m.cache_scope != nil {
+		fields = append(fields, apikey.FieldCacheScope)
+	}
+	if m.guardrail_policy != nil {
+		fields = append(fields, apikey.FieldGuardrailPolicy)
+	}
 	if m.profiles != nil {
 		fields = append(fields, apikey.FieldProfiles)
 	}
@@ -714,12 +1364,36 @@ func (m *APIKeyMutation) Field(name string) (ent.Value, bool) {
 		return m.UserID()
 	case apikey.FieldKey:
 		return m.Key()
+	case apikey.FieldKeyPrefix:
+		return m.KeyPrefix()
+	case apikey.FieldLastUsedAt:
+		return m.LastUsedAt()
+	case apikey.FieldPreviousKey:
+		return m.PreviousKey()
+	case apikey.FieldPreviousKeyPrefix:
+		return m.PreviousKeyPrefix()
+	case apikey.FieldPreviousKeyExpiresAt:
+		return m.PreviousKeyExpiresAt()
+	case apikey.FieldPreviousKeyLastUsedAt:
+		return m.PreviousKeyLastUsedAt()
 	case apikey.FieldName:
 		return m.Name()
 	case apikey.FieldStatus:
 		return m.Status()
 	case apikey.FieldScopes:
 		return m.Scopes()
+	case apikey.FieldExpiresAt:
+		return m.ExpiresAt()
+	case apikey.FieldAllowedCidrs:
+		return m.AllowedCidrs()
+	case apikey.FieldAllowedModels:
+		return m.AllowedModels()
+	case apikey.FieldCacheMode:
+		return m.CacheMode()
+	case apikey.FieldCacheScope:
+		return m.CacheScope()
+	case apikey.FieldGuardrailPolicy:
+		return m.GuardrailPolicy()
 	case apikey.FieldProfiles:
 		return m.Profiles()
 	}
@@ -741,12 +1415,36 @@ func (m *APIKeyMutation) OldField(ctx context.Context, name string) (ent.Value,
 		return m.OldUserID(ctx)
 	case apikey.FieldKey:
 		return m.OldKey(ctx)
+	case apikey.FieldKeyPrefix:
+		return m.OldKeyPrefix(ctx)
+	case apikey.FieldLastUsedAt:
+		return m.OldLastUsedAt(ctx)
+	case apikey.FieldPreviousKey:
+		return m.OldPreviousKey(ctx)
+	case apikey.FieldPreviousKeyPrefix:
+		return m.OldPreviousKeyPrefix(ctx)
+	case apikey.FieldPreviousKeyExpiresAt:
+		return m.OldPreviousKeyExpiresAt(ctx)
+	case apikey.FieldPreviousKeyLastUsedAt:
+		return m.OldPreviousKeyLastUsedAt(ctx)
 	case apikey.FieldName:
 		return m.OldName(ctx)
 	case apikey.FieldStatus:
 		return m.OldStatus(ctx)
 	case apikey.FieldScopes:
 		return m.OldScopes(ctx)
+	case apikey.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case apikey.FieldAllowedCidrs:
+		return m.OldAllowedCidrs(ctx)
+	case apikey.FieldAllowedModels:
+		return m.OldAllowedModels(ctx)
+	case apikey.FieldCacheMode:
+		return m.OldCacheMode(ctx)
+	case apikey.FieldCacheScope:
+		return m.OldCacheScope(ctx)
+	case apikey.FieldGuardrailPolicy:
+		return m.OldGuardrailPolicy(ctx)
 	case apikey.FieldProfiles:
 		return m.OldProfiles(ctx)
 	}
@@ -793,39 +1491,123 @@ func (m *APIKeyMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetKey(v)
 		return nil
-	case apikey.FieldName:
+	case apikey.FieldKeyPrefix:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetKeyPrefix(v)
 		return nil
-	case apikey.FieldStatus:
-		v, ok := value.(apikey.Status)
+	case apikey.FieldLastUsedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetLastUsedAt(v)
 		return nil
-	case apikey.FieldScopes:
-		v, ok := value.([]string)
+	case apikey.FieldPreviousKey:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetScopes(v)
+		m.SetPreviousKey(v)
 		return nil
-	case apikey.FieldProfiles:
-		v, ok := value.(*objects.APIKeyProfiles)
+	case apikey.FieldPreviousKeyPrefix:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetProfiles(v)
+		m.SetPreviousKeyPrefix(v)
 		return nil
-	}
-	return fmt.Errorf("unknown APIKey field %s", name)
-}
-
-// AddedFields returns all numeric fields that were incremented/decremented during
+	case apikey.FieldPreviousKeyExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPreviousKeyExpiresAt(v)
+		return nil
+	case apikey.FieldPreviousKeyLastUsedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPreviousKeyLastUsedAt(v)
+		return nil
+	case apikey.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case apikey.FieldStatus:
+		v, ok := value.(apikey.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case apikey.FieldScopes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScopes(v)
+		return nil
+	case apikey.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case apikey.FieldAllowedCidrs:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAllowedCidrs(v)
+		return nil
+	case apikey.FieldAllowedModels:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAllowedModels(v)
+		return nil
+	case apikey.FieldCacheMode:
+		v, ok := value.(apikey.CacheMode)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCacheMode(v)
+		return nil
+	case apikey.FieldCacheScope:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCacheScope(v)
+		return nil
+	case apikey.FieldGuardrailPolicy:
+		v, ok := value.(*objects.GuardrailPolicy)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGuardrailPolicy(v)
+		return nil
+	case apikey.FieldProfiles:
+		v, ok := value.(*objects.APIKeyProfiles)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProfiles(v)
+		return nil
+	}
+	return fmt.Errorf("unknown APIKey field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
 func (m *APIKeyMutation) AddedFields() []string {
 	var fields []string
@@ -866,9 +1648,39 @@ func (m *APIKeyMutation) AddField(name string, value ent.Value) error {
 // mutation.
 func (m *APIKeyMutation) ClearedFields() []string {
 	var fields []string
+	if m.FieldCleared(apikey.FieldLastUsedAt) {
+		fields = append(fields, apikey.FieldLastUsedAt)
+	}
+	if m.FieldCleared(apikey.FieldPreviousKey) {
+		fields = append(fields, apikey.FieldPreviousKey)
+	}
+	if m.FieldCleared(apikey.FieldPreviousKeyPrefix) {
+		fields = append(fields, apikey.FieldPreviousKeyPrefix)
+	}
+	if m.FieldCleared(apikey.FieldPreviousKeyExpiresAt) {
+		fields = append(fields, apikey.FieldPreviousKeyExpiresAt)
+	}
+	if m.FieldCleared(apikey.FieldPreviousKeyLastUsedAt) {
+		fields = append(fields, apikey.FieldPreviousKeyLastUsedAt)
+	}
 	if m.FieldCleared(apikey.FieldScopes) {
 		fields = append(fields, apikey.FieldScopes)
 	}
+	if m.FieldCleared(apikey.FieldExpiresAt) {
+		fields = append(fields, apikey.FieldExpiresAt)
+	}
+	if m.FieldCleared(apikey.FieldAllowedCidrs) {
+		fields = append(fields, apikey.FieldAllowedCidrs)
+	}
+	if m.FieldCleared(apikey.FieldAllowedModels) {
+		fields = append(fields, apikey.FieldAllowedModels)
+	}
+	if m.FieldCleared(apikey.FieldCacheScope) {
+		fields = append(fields, apikey.FieldCacheScope)
+	}
+	if m.FieldCleared(apikey.FieldGuardrailPolicy) {
+		fields = append(fields, apikey.FieldGuardrailPolicy)
+	}
 	if m.FieldCleared(apikey.FieldProfiles) {
 		fields = append(fields, apikey.FieldProfiles)
 	}
@@ -886,9 +1698,39 @@ func (m *APIKeyMutation) FieldCleared(name string) bool {
 // error if the field is not defined in the schema.
 func (m *APIKeyMutation) ClearField(name string) error {
 	switch name {
+	case apikey.FieldLastUsedAt:
+		m.ClearLastUsedAt()
+		return nil
+	case apikey.FieldPreviousKey:
+		m.ClearPreviousKey()
+		return nil
+	case apikey.FieldPreviousKeyPrefix:
+		m.ClearPreviousKeyPrefix()
+		return nil
+	case apikey.FieldPreviousKeyExpiresAt:
+		m.ClearPreviousKeyExpiresAt()
+		return nil
+	case apikey.FieldPreviousKeyLastUsedAt:
+		m.ClearPreviousKeyLastUsedAt()
+		return nil
 	case apikey.FieldScopes:
 		m.ClearScopes()
 		return nil
+	case apikey.FieldExpiresAt:
+		m.ClearExpiresAt()
+		return nil
+	case apikey.FieldAllowedCidrs:
+		m.ClearAllowedCidrs()
+		return nil
+	case apikey.FieldAllowedModels:
+		m.ClearAllowedModels()
+		return nil
+	case apikey.FieldCacheScope:
+		m.ClearCacheScope()
+		return nil
+	case apikey.FieldGuardrailPolicy:
+		m.ClearGuardrailPolicy()
+		return nil
 	case apikey.FieldProfiles:
 		m.ClearProfiles()
 		return nil
@@ -915,6 +1757,24 @@ func (m *APIKeyMutation) ResetField(name string) error {
 	case apikey.FieldKey:
 		m.ResetKey()
 		return nil
+	case apikey.FieldKeyPrefix:
+		m.ResetKeyPrefix()
+		return nil
+	case apikey.FieldLastUsedAt:
+		m.ResetLastUsedAt()
+		return nil
+	case apikey.FieldPreviousKey:
+		m.ResetPreviousKey()
+		return nil
+	case apikey.FieldPreviousKeyPrefix:
+		m.ResetPreviousKeyPrefix()
+		return nil
+	case apikey.FieldPreviousKeyExpiresAt:
+		m.ResetPreviousKeyExpiresAt()
+		return nil
+	case apikey.FieldPreviousKeyLastUsedAt:
+		m.ResetPreviousKeyLastUsedAt()
+		return nil
 	case apikey.FieldName:
 		m.ResetName()
 		return nil
@@ -924,6 +1784,24 @@ func (m *APIKeyMutation) ResetField(name string) error {
 	case apikey.FieldScopes:
 		m.ResetScopes()
 		return nil
+	case apikey.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case apikey.FieldAllowedCidrs:
+		m.ResetAllowedCidrs()
+		return nil
+	case apikey.FieldAllowedModels:
+		m.ResetAllowedModels()
+		return nil
+	case apikey.FieldCacheMode:
+		m.ResetCacheMode()
+		return nil
+	case apikey.FieldCacheScope:
+		m.ResetCacheScope()
+		return nil
+	case apikey.FieldGuardrailPolicy:
+		m.ResetGuardrailPolicy()
+		return nil
 	case apikey.FieldProfiles:
 		m.ResetProfiles()
 		return nil
@@ -1064,6 +1942,9 @@ type ChannelMutation struct {
 	usage_logs             map[int]struct{}
 	removedusage_logs      map[int]struct{}
 	clearedusage_logs      bool
+	routing_rules          map[int]struct{}
+	removedrouting_rules   map[int]struct{}
+	clearedrouting_rules   bool
 	done                   bool
 	oldValue               func(context.Context) (*Channel, error)
 	predicates             []predicate.Channel
@@ -1842,6 +2723,60 @@ func (m *ChannelMutation) ResetUsageLogs() {
 	m.removedusage_logs = nil
 }
 
+// AddRoutingRuleIDs adds the "routing_rules" edge to the RoutingRule entity by ids.
+func (m *ChannelMutation) AddRoutingRuleIDs(ids ...int) {
+	if m.routing_rules == nil {
+		m.routing_rules = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.routing_rules[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRoutingRules clears the "routing_rules" edge to the RoutingRule entity.
+func (m *ChannelMutation) ClearRoutingRules() {
+	m.clearedrouting_rules = true
+}
+
+// RoutingRulesCleared reports if the "routing_rules" edge to the RoutingRule entity was cleared.
+func (m *ChannelMutation) RoutingRulesCleared() bool {
+	return m.clearedrouting_rules
+}
+
+// RemoveRoutingRuleIDs removes the "routing_rules" edge to the RoutingRule entity by IDs.
+func (m *ChannelMutation) RemoveRoutingRuleIDs(ids ...int) {
+	if m.removedrouting_rules == nil {
+		m.removedrouting_rules = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.routing_rules, ids[i])
+		m.removedrouting_rules[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRoutingRules returns the removed IDs of the "routing_rules" edge to the RoutingRule entity.
+func (m *ChannelMutation) RemovedRoutingRulesIDs() (ids []int) {
+	for id := range m.removedrouting_rules {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RoutingRulesIDs returns the "routing_rules" edge IDs in the mutation.
+func (m *ChannelMutation) RoutingRulesIDs() (ids []int) {
+	for id := range m.routing_rules {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRoutingRules resets all changes to the "routing_rules" edge.
+func (m *ChannelMutation) ResetRoutingRules() {
+	m.routing_rules = nil
+	m.clearedrouting_rules = false
+	m.removedrouting_rules = nil
+}
+
 // Where appends a list predicates to the ChannelMutation builder.
 func (m *ChannelMutation) Where(ps ...predicate.Channel) {
 	m.predicates = append(m.predicates, ps...)
@@ -2204,7 +3139,7 @@ func (m *ChannelMutation) ResetField(name string) error {
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *ChannelMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
+	edges := make([]string, 0, 4)
 	if m.requests != nil {
 		edges = append(edges, channel.EdgeRequests)
 	}
@@ -2214,6 +3149,9 @@ func (m *ChannelMutation) AddedEdges() []string {
 	if m.usage_logs != nil {
 		edges = append(edges, channel.EdgeUsageLogs)
 	}
+	if m.routing_rules != nil {
+		edges = append(edges, channel.EdgeRoutingRules)
+	}
 	return edges
 }
 
@@ -2239,13 +3177,19 @@ func (m *ChannelMutation) AddedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case channel.EdgeRoutingRules:
+		ids := make([]ent.Value, 0, len(m.routing_rules))
+		for id := range m.routing_rules {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *ChannelMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
+	edges := make([]string, 0, 4)
 	if m.removedrequests != nil {
 		edges = append(edges, channel.EdgeRequests)
 	}
@@ -2255,6 +3199,9 @@ func (m *ChannelMutation) RemovedEdges() []string {
 	if m.removedusage_logs != nil {
 		edges = append(edges, channel.EdgeUsageLogs)
 	}
+	if m.removedrouting_rules != nil {
+		edges = append(edges, channel.EdgeRoutingRules)
+	}
 	return edges
 }
 
@@ -2280,13 +3227,19 @@ func (m *ChannelMutation) RemovedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case channel.EdgeRoutingRules:
+		ids := make([]ent.Value, 0, len(m.removedrouting_rules))
+		for id := range m.removedrouting_rules {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *ChannelMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
+	edges := make([]string, 0, 4)
 	if m.clearedrequests {
 		edges = append(edges, channel.EdgeRequests)
 	}
@@ -2296,6 +3249,9 @@ func (m *ChannelMutation) ClearedEdges() []string {
 	if m.clearedusage_logs {
 		edges = append(edges, channel.EdgeUsageLogs)
 	}
+	if m.clearedrouting_rules {
+		edges = append(edges, channel.EdgeRoutingRules)
+	}
 	return edges
 }
 
@@ -2309,6 +3265,8 @@ func (m *ChannelMutation) EdgeCleared(name string) bool {
 		return m.clearedexecutions
 	case channel.EdgeUsageLogs:
 		return m.clearedusage_logs
+	case channel.EdgeRoutingRules:
+		return m.clearedrouting_rules
 	}
 	return false
 }
@@ -2334,6 +3292,9 @@ func (m *ChannelMutation) ResetEdge(name string) error {
 	case channel.EdgeUsageLogs:
 		m.ResetUsageLogs()
 		return nil
+	case channel.EdgeRoutingRules:
+		m.ResetRoutingRules()
+		return nil
 	}
 	return fmt.Errorf("unknown Channel edge %s", name)
 }
@@ -2341,40 +3302,45 @@ func (m *ChannelMutation) ResetEdge(name string) error {
 // RequestMutation represents an operation that mutates the Request nodes in the graph.
 type RequestMutation struct {
 	config
-	op                    Op
-	typ                   string
-	id                    *int
-	created_at            *time.Time
-	updated_at            *time.Time
-	deleted_at            *int
-	adddeleted_at         *int
-	source                *request.Source
-	model_id              *string
-	format                *string
-	request_body          *objects.JSONRawMessage
-	appendrequest_body    objects.JSONRawMessage
-	response_body         *objects.JSONRawMessage
-	appendresponse_body   objects.JSONRawMessage
-	response_chunks       *[]objects.JSONRawMessage
-	appendresponse_chunks []objects.JSONRawMessage
-	external_id           *string
-	status                *request.Status
-	clearedFields         map[string]struct{}
-	user                  *int
-	cleareduser           bool
-	api_key               *int
-	clearedapi_key        bool
-	executions            map[int]struct{}
-	removedexecutions     map[int]struct{}
-	clearedexecutions     bool
-	channel               *int
-	clearedchannel        bool
-	usage_logs            map[int]struct{}
-	removedusage_logs     map[int]struct{}
-	clearedusage_logs     bool
-	done                  bool
-	oldValue              func(context.Context) (*Request, error)
-	predicates            []predicate.Request
+	op                         Op
+	typ                        string
+	id                         *int
+	created_at                 *time.Time
+	updated_at                 *time.Time
+	deleted_at                 *int
+	adddeleted_at              *int
+	source                     *request.Source
+	model_id                   *string
+	format                     *string
+	request_body               *objects.JSONRawMessage
+	appendrequest_body         objects.JSONRawMessage
+	response_body              *objects.JSONRawMessage
+	appendresponse_body        objects.JSONRawMessage
+	response_chunks            *[]objects.JSONRawMessage
+	appendresponse_chunks      []objects.JSONRawMessage
+	external_id                *string
+	cached                     *bool
+	guardrail_violations       *[]objects.GuardrailViolation
+	appendguardrail_violations []objects.GuardrailViolation
+	fallback_hops              *[]objects.FallbackHop
+	appendfallback_hops        []objects.FallbackHop
+	status                     *request.Status
+	clearedFields              map[string]struct{}
+	user                       *int
+	cleareduser                bool
+	api_key                    *int
+	clearedapi_key             bool
+	executions                 map[int]struct{}
+	removedexecutions          map[int]struct{}
+	clearedexecutions          bool
+	channel                    *int
+	clearedchannel             bool
+	usage_logs                 map[int]struct{}
+	removedusage_logs          map[int]struct{}
+	clearedusage_logs          bool
+	done                       bool
+	oldValue                   func(context.Context) (*Request, error)
+	predicates                 []predicate.Request
 }
 
 var _ ent.Mutation = (*RequestMutation)(nil)
@@ -3075,6 +4041,172 @@ func (m *RequestMutation) ResetExternalID() {
 	delete(m.clearedFields, request.FieldExternalID)
 }
 
+// SetCached sets the "cached" field.
+func (m *RequestMutation) SetCached(b bool) {
+	m.cached = &b
+}
+
+// Cached returns the value of the "cached" field in the mutation.
+func (m *RequestMutation) Cached() (r bool, exists bool) {
+	v := m.cached
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCached returns the old "cached" field's value of the Request entity.
+// If the Request object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RequestMutation) OldCached(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCached is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCached requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCached: %w", err)
+	}
+	return oldValue.Cached, nil
+}
+
+// ResetCached resets all changes to the "cached" field.
+func (m *RequestMutation) ResetCached() {
+	m.cached = nil
+}
+
+// SetGuardrailViolations sets the "guardrail_violations" field.
+func (m *RequestMutation) SetGuardrailViolations(ov []objects.GuardrailViolation) {
+	m.guardrail_violations = &ov
+	m.appendguardrail_violations = nil
+}
+
+// GuardrailViolations returns the value of the "guardrail_violations" field in the mutation.
+func (m *RequestMutation) GuardrailViolations() (r []objects.GuardrailViolation, exists bool) {
+	v := m.guardrail_violations
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGuardrailViolations returns the old "guardrail_violations" field's value of the Request entity.
+// If the Request object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RequestMutation) OldGuardrailViolations(ctx context.Context) (v []objects.GuardrailViolation, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGuardrailViolations is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGuardrailViolations requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGuardrailViolations: %w", err)
+	}
+	return oldValue.GuardrailViolations, nil
+}
+
+// AppendGuardrailViolations adds ov to the "guardrail_violations" field.
+func (m *RequestMutation) AppendGuardrailViolations(ov []objects.GuardrailViolation) {
+	m.appendguardrail_violations = append(m.appendguardrail_violations, ov...)
+}
+
+// AppendedGuardrailViolations returns the list of values that were appended to the "guardrail_violations" field in this mutation.
+func (m *RequestMutation) AppendedGuardrailViolations() ([]objects.GuardrailViolation, bool) {
+	if len(m.appendguardrail_violations) == 0 {
+		return nil, false
+	}
+	return m.appendguardrail_violations, true
+}
+
+// ClearGuardrailViolations clears the value of the "guardrail_violations" field.
+func (m *RequestMutation) ClearGuardrailViolations() {
+	m.guardrail_violations = nil
+	m.appendguardrail_violations = nil
+	m.clearedFields[request.FieldGuardrailViolations] = struct{}{}
+}
+
+// GuardrailViolationsCleared returns if the "guardrail_violations" field was cleared in this mutation.
+func (m *RequestMutation) GuardrailViolationsCleared() bool {
+	_, ok := m.clearedFields[request.FieldGuardrailViolations]
+	return ok
+}
+
+// ResetGuardrailViolations resets all changes to the "guardrail_violations" field.
+func (m *RequestMutation) ResetGuardrailViolations() {
+	m.guardrail_violations = nil
+	m.appendguardrail_violations = nil
+	delete(m.clearedFields, request.FieldGuardrailViolations)
+}
+
+// SetFallbackHops sets the "fallback_hops" field.
+func (m *RequestMutation) SetFallbackHops(oh []objects.FallbackHop) {
+	m.fallback_hops = &oh
+	m.appendfallback_hops = nil
+}
+
+// FallbackHops returns the value of the "fallback_hops" field in the mutation.
+func (m *RequestMutation) FallbackHops() (r []objects.FallbackHop, exists bool) {
+	v := m.fallback_hops
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFallbackHops returns the old "fallback_hops" field's value of the Request entity.
+// If the Request object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RequestMutation) OldFallbackHops(ctx context.Context) (v []objects.FallbackHop, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFallbackHops is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFallbackHops requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFallbackHops: %w", err)
+	}
+	return oldValue.FallbackHops, nil
+}
+
+// AppendFallbackHops adds oh to the "fallback_hops" field.
+func (m *RequestMutation) AppendFallbackHops(oh []objects.FallbackHop) {
+	m.appendfallback_hops = append(m.appendfallback_hops, oh...)
+}
+
+// AppendedFallbackHops returns the list of values that were appended to the "fallback_hops" field in this mutation.
+func (m *RequestMutation) AppendedFallbackHops() ([]objects.FallbackHop, bool) {
+	if len(m.appendfallback_hops) == 0 {
+		return nil, false
+	}
+	return m.appendfallback_hops, true
+}
+
+// ClearFallbackHops clears the value of the "fallback_hops" field.
+func (m *RequestMutation) ClearFallbackHops() {
+	m.fallback_hops = nil
+	m.appendfallback_hops = nil
+	m.clearedFields[request.FieldFallbackHops] = struct{}{}
+}
+
+// FallbackHopsCleared returns if the "fallback_hops" field was cleared in this mutation.
+func (m *RequestMutation) FallbackHopsCleared() bool {
+	_, ok := m.clearedFields[request.FieldFallbackHops]
+	return ok
+}
+
+// ResetFallbackHops resets all changes to the "fallback_hops" field.
+func (m *RequestMutation) ResetFallbackHops() {
+	m.fallback_hops = nil
+	m.appendfallback_hops = nil
+	delete(m.clearedFields, request.FieldFallbackHops)
+}
+
 // SetStatus sets the "status" field.
 func (m *RequestMutation) SetStatus(r request.Status) {
 	m.status = &r
@@ -3334,7 +4466,7 @@ func (m *RequestMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *RequestMutation) Fields() []string {
-	fields := make([]string, 0, 14)
+	fields := make([]string, 0, 17)
 	if m.created_at != nil {
 		fields = append(fields, request.FieldCreatedAt)
 	}
@@ -3374,6 +4506,15 @@ func (m *RequestMutation) Fields() []string {
 	if m.external_id != nil {
 		fields = append(fields, request.FieldExternalID)
 	}
+	if m.cached != nil {
+		fields = append(fields, request.FieldCached)
+	}
+	if m.guardrail_violations != nil {
+		fields = append(fields, request.FieldGuardrailViolations)
+	}
+	if m.fallback_hops != nil {
+		fields = append(fields, request.FieldFallbackHops)
+	}
 	if m.status != nil {
 		fields = append(fields, request.FieldStatus)
 	}
@@ -3411,6 +4552,12 @@ func (m *RequestMutation) Field(name string) (ent.Value, bool) {
 		return m.ChannelID()
 	case request.FieldExternalID:
 		return m.ExternalID()
+	case request.FieldCached:
+		return m.Cached()
+	case request.FieldGuardrailViolations:
+		return m.GuardrailViolations()
+	case request.FieldFallbackHops:
+		return m.FallbackHops()
 	case request.FieldStatus:
 		return m.Status()
 	}
@@ -3448,6 +4595,12 @@ func (m *RequestMutation) OldField(ctx context.Context, name string) (ent.Value,
 		return m.OldChannelID(ctx)
 	case request.FieldExternalID:
 		return m.OldExternalID(ctx)
+	case request.FieldCached:
+		return m.OldCached(ctx)
+	case request.FieldGuardrailViolations:
+		return m.OldGuardrailViolations(ctx)
+	case request.FieldFallbackHops:
+		return m.OldFallbackHops(ctx)
 	case request.FieldStatus:
 		return m.OldStatus(ctx)
 	}
@@ -3550,14 +4703,35 @@ func (m *RequestMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetExternalID(v)
 		return nil
-	case request.FieldStatus:
-		v, ok := value.(request.Status)
+	case request.FieldCached:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetCached(v)
 		return nil
-	}
+	case request.FieldGuardrailViolations:
+		v, ok := value.([]objects.GuardrailViolation)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGuardrailViolations(v)
+		return nil
+	case request.FieldFallbackHops:
+		v, ok := value.([]objects.FallbackHop)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFallbackHops(v)
+		return nil
+	case request.FieldStatus:
+		v, ok := value.(request.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	}
 	return fmt.Errorf("unknown Request field %s", name)
 }
 
@@ -3617,6 +4791,12 @@ func (m *RequestMutation) ClearedFields() []string {
 	if m.FieldCleared(request.FieldExternalID) {
 		fields = append(fields, request.FieldExternalID)
 	}
+	if m.FieldCleared(request.FieldGuardrailViolations) {
+		fields = append(fields, request.FieldGuardrailViolations)
+	}
+	if m.FieldCleared(request.FieldFallbackHops) {
+		fields = append(fields, request.FieldFallbackHops)
+	}
 	return fields
 }
 
@@ -3646,6 +4826,12 @@ func (m *RequestMutation) ClearField(name string) error {
 	case request.FieldExternalID:
 		m.ClearExternalID()
 		return nil
+	case request.FieldGuardrailViolations:
+		m.ClearGuardrailViolations()
+		return nil
+	case request.FieldFallbackHops:
+		m.ClearFallbackHops()
+		return nil
 	}
 	return fmt.Errorf("unknown Request nullable field %s", name)
 }
@@ -3693,6 +4879,15 @@ func (m *RequestMutation) ResetField(name string) error {
 	case request.FieldExternalID:
 		m.ResetExternalID()
 		return nil
+	case request.FieldCached:
+		m.ResetCached()
+		return nil
+	case request.FieldGuardrailViolations:
+		m.ResetGuardrailViolations()
+		return nil
+	case request.FieldFallbackHops:
+		m.ResetFallbackHops()
+		return nil
 	case request.FieldStatus:
 		m.ResetStatus()
 		return nil
@@ -5103,40 +6298,35 @@ func (m *RequestExecutionMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown RequestExecution edge %s", name)
 }
 
-// RoleMutation represents an operation that mutates the Role nodes in the graph.
-type RoleMutation struct {
+// ResponseCacheMutation represents an operation that mutates the ResponseCache nodes in the graph.
+type ResponseCacheMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	created_at    *time.Time
-	updated_at    *time.Time
-	deleted_at    *int
-	adddeleted_at *int
-	code          *string
-	name          *string
-	scopes        *[]string
-	appendscopes  []string
-	clearedFields map[string]struct{}
-	users         map[int]struct{}
-	removedusers  map[int]struct{}
-	clearedusers  bool
-	done          bool
-	oldValue      func(context.Context) (*Role, error)
-	predicates    []predicate.Role
-}
-
-var _ ent.Mutation = (*RoleMutation)(nil)
-
-// roleOption allows management of the mutation configuration using functional options.
-type roleOption func(*RoleMutation)
-
-// newRoleMutation creates new mutation for the Role entity.
-func newRoleMutation(c config, op Op, opts ...roleOption) *RoleMutation {
-	m := &RoleMutation{
+	op             Op
+	typ            string
+	id             *int
+	created_at     *time.Time
+	updated_at     *time.Time
+	key            *string
+	response       *objects.JSONRawMessage
+	appendresponse objects.JSONRawMessage
+	expires_at     *time.Time
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*ResponseCache, error)
+	predicates     []predicate.ResponseCache
+}
+
+var _ ent.Mutation = (*ResponseCacheMutation)(nil)
+
+// responsecacheOption allows management of the mutation configuration using functional options.
+type responsecacheOption func(*ResponseCacheMutation)
+
+// newResponseCacheMutation creates new mutation for the ResponseCache entity.
+func newResponseCacheMutation(c config, op Op, opts ...responsecacheOption) *ResponseCacheMutation {
+	m := &ResponseCacheMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeRole,
+		typ:           TypeResponseCache,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -5145,20 +6335,20 @@ func newRoleMutation(c config, op Op, opts ...roleOption) *RoleMutation {
 	return m
 }
 
-// withRoleID sets the ID field of the mutation.
-func withRoleID(id int) roleOption {
-	return func(m *RoleMutation) {
+// withResponseCacheID sets the ID field of the mutation.
+func withResponseCacheID(id int) responsecacheOption {
+	return func(m *ResponseCacheMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Role
+			value *ResponseCache
 		)
-		m.oldValue = func(ctx context.Context) (*Role, error) {
+		m.oldValue = func(ctx context.Context) (*ResponseCache, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Role.Get(ctx, id)
+					value, err = m.Client().ResponseCache.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -5167,10 +6357,10 @@ func withRoleID(id int) roleOption {
 	}
 }
 
-// withRole sets the old Role of the mutation.
-func withRole(node *Role) roleOption {
-	return func(m *RoleMutation) {
-		m.oldValue = func(context.Context) (*Role, error) {
+// withResponseCache sets the old ResponseCache of the mutation.
+func withResponseCache(node *ResponseCache) responsecacheOption {
+	return func(m *ResponseCacheMutation) {
+		m.oldValue = func(context.Context) (*ResponseCache, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -5179,7 +6369,7 @@ func withRole(node *Role) roleOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m RoleMutation) Client() *Client {
+func (m ResponseCacheMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -5187,7 +6377,7 @@ func (m RoleMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m RoleMutation) Tx() (*Tx, error) {
+func (m ResponseCacheMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -5198,7 +6388,7 @@ func (m RoleMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *RoleMutation) ID() (id int, exists bool) {
+func (m *ResponseCacheMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -5209,7 +6399,7 @@ func (m *RoleMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *RoleMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *ResponseCacheMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -5218,19 +6408,19 @@ func (m *RoleMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Role.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ResponseCache.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *RoleMutation) SetCreatedAt(t time.Time) {
+func (m *ResponseCacheMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *RoleMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *ResponseCacheMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -5238,10 +6428,10 @@ func (m *RoleMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Role entity.
-// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the ResponseCache entity.
+// If the ResponseCache object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoleMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ResponseCacheMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -5256,17 +6446,17 @@ func (m *RoleMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *RoleMutation) ResetCreatedAt() {
+func (m *ResponseCacheMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *RoleMutation) SetUpdatedAt(t time.Time) {
+func (m *ResponseCacheMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *RoleMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *ResponseCacheMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -5274,10 +6464,10 @@ func (m *RoleMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Role entity.
-// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the ResponseCache entity.
+// If the ResponseCache object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoleMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ResponseCacheMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -5292,583 +6482,382 @@ func (m *RoleMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *RoleMutation) ResetUpdatedAt() {
+func (m *ResponseCacheMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (m *RoleMutation) SetDeletedAt(i int) {
-	m.deleted_at = &i
-	m.adddeleted_at = nil
+// SetKey sets the "key" field.
+func (m *ResponseCacheMutation) SetKey(s string) {
+	m.key = &s
 }
 
-// DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *RoleMutation) DeletedAt() (r int, exists bool) {
-	v := m.deleted_at
+// Key returns the value of the "key" field in the mutation.
+func (m *ResponseCacheMutation) Key() (r string, exists bool) {
+	v := m.key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the Role entity.
-// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// OldKey returns the old "key" field's value of the ResponseCache entity.
+// If the ResponseCache object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoleMutation) OldDeletedAt(ctx context.Context) (v int, err error) {
+func (m *ResponseCacheMutation) OldKey(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+		return v, errors.New("OldKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
-	}
-	return oldValue.DeletedAt, nil
-}
-
-// AddDeletedAt adds i to the "deleted_at" field.
-func (m *RoleMutation) AddDeletedAt(i int) {
-	if m.adddeleted_at != nil {
-		*m.adddeleted_at += i
-	} else {
-		m.adddeleted_at = &i
-	}
-}
-
-// AddedDeletedAt returns the value that was added to the "deleted_at" field in this mutation.
-func (m *RoleMutation) AddedDeletedAt() (r int, exists bool) {
-	v := m.adddeleted_at
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
 	}
-	return *v, true
+	return oldValue.Key, nil
 }
 
-// ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *RoleMutation) ResetDeletedAt() {
-	m.deleted_at = nil
-	m.adddeleted_at = nil
+// ResetKey resets all changes to the "key" field.
+func (m *ResponseCacheMutation) ResetKey() {
+	m.key = nil
 }
 
-// SetCode sets the "code" field.
-func (m *RoleMutation) SetCode(s string) {
-	m.code = &s
+// SetResponse sets the "response" field.
+func (m *ResponseCacheMutation) SetResponse(orm objects.JSONRawMessage) {
+	m.response = &orm
+	m.appendresponse = nil
 }
 
-// Code returns the value of the "code" field in the mutation.
-func (m *RoleMutation) Code() (r string, exists bool) {
-	v := m.code
+// Response returns the value of the "response" field in the mutation.
+func (m *ResponseCacheMutation) Response() (r objects.JSONRawMessage, exists bool) {
+	v := m.response
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCode returns the old "code" field's value of the Role entity.
-// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// OldResponse returns the old "response" field's value of the ResponseCache entity.
+// If the ResponseCache object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoleMutation) OldCode(ctx context.Context) (v string, err error) {
+func (m *ResponseCacheMutation) OldResponse(ctx context.Context) (v objects.JSONRawMessage, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCode is only allowed on UpdateOne operations")
+		return v, errors.New("OldResponse is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCode requires an ID field in the mutation")
+		return v, errors.New("OldResponse requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCode: %w", err)
+		return v, fmt.Errorf("querying old value for OldResponse: %w", err)
 	}
-	return oldValue.Code, nil
-}
-
-// ResetCode resets all changes to the "code" field.
-func (m *RoleMutation) ResetCode() {
-	m.code = nil
-}
-
-// SetName sets the "name" field.
-func (m *RoleMutation) SetName(s string) {
-	m.name = &s
+	return oldValue.Response, nil
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *RoleMutation) Name() (r string, exists bool) {
-	v := m.name
-	if v == nil {
-		return
-	}
-	return *v, true
+// AppendResponse adds orm to the "response" field.
+func (m *ResponseCacheMutation) AppendResponse(orm objects.JSONRawMessage) {
+	m.appendresponse = append(m.appendresponse, orm...)
 }
 
-// OldName returns the old "name" field's value of the Role entity.
-// If the Role object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoleMutation) OldName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+// AppendedResponse returns the list of values that were appended to the "response" field in this mutation.
+func (m *ResponseCacheMutation) AppendedResponse() (objects.JSONRawMessage, bool) {
+	if len(m.appendresponse) == 0 {
+		return nil, false
 	}
-	return oldValue.Name, nil
+	return m.appendresponse, true
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *RoleMutation) ResetName() {
-	m.name = nil
+// ResetResponse resets all changes to the "response" field.
+func (m *ResponseCacheMutation) ResetResponse() {
+	m.response = nil
+	m.appendresponse = nil
 }
 
-// SetScopes sets the "scopes" field.
-func (m *RoleMutation) SetScopes(s []string) {
-	m.scopes = &s
-	m.appendscopes = nil
+// SetExpiresAt sets the "expires_at" field.
+func (m *ResponseCacheMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
 }
 
-// Scopes returns the value of the "scopes" field in the mutation.
-func (m *RoleMutation) Scopes() (r []string, exists bool) {
-	v := m.scopes
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *ResponseCacheMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldScopes returns the old "scopes" field's value of the Role entity.
-// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// OldExpiresAt returns the old "expires_at" field's value of the ResponseCache entity.
+// If the ResponseCache object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoleMutation) OldScopes(ctx context.Context) (v []string, err error) {
+func (m *ResponseCacheMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldScopes is only allowed on UpdateOne operations")
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldScopes requires an ID field in the mutation")
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldScopes: %w", err)
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
 	}
-	return oldValue.Scopes, nil
+	return oldValue.ExpiresAt, nil
 }
 
-// AppendScopes adds s to the "scopes" field.
-func (m *RoleMutation) AppendScopes(s []string) {
-	m.appendscopes = append(m.appendscopes, s...)
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *ResponseCacheMutation) ResetExpiresAt() {
+	m.expires_at = nil
 }
 
-// AppendedScopes returns the list of values that were appended to the "scopes" field in this mutation.
-func (m *RoleMutation) AppendedScopes() ([]string, bool) {
-	if len(m.appendscopes) == 0 {
-		return nil, false
+// Where appends a list predicates to the ResponseCacheMutation builder.
+func (m *ResponseCacheMutation) Where(ps ...predicate.ResponseCache) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ResponseCacheMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ResponseCacheMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ResponseCache, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return m.appendscopes, true
+	m.Where(p...)
 }
 
-// ClearScopes clears the value of the "scopes" field.
-func (m *RoleMutation) ClearScopes() {
-	m.scopes = nil
-	m.appendscopes = nil
-	m.clearedFields[role.FieldScopes] = struct{}{}
+// Op returns the operation name.
+func (m *ResponseCacheMutation) Op() Op {
+	return m.op
 }
 
-// ScopesCleared returns if the "scopes" field was cleared in this mutation.
-func (m *RoleMutation) ScopesCleared() bool {
-	_, ok := m.clearedFields[role.FieldScopes]
-	return ok
+// SetOp allows setting the mutation operation.
+func (m *ResponseCacheMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// ResetScopes resets all changes to the "scopes" field.
-func (m *RoleMutation) ResetScopes() {
-	m.scopes = nil
-	m.appendscopes = nil
-	delete(m.clearedFields, role.FieldScopes)
+// Type returns the node type of this mutation (ResponseCache).
+func (m *ResponseCacheMutation) Type() string {
+	return m.typ
 }
 
-// AddUserIDs adds the "users" edge to the User entity by ids.
-func (m *RoleMutation) AddUserIDs(ids ...int) {
-	if m.users == nil {
-		m.users = make(map[int]struct{})
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ResponseCacheMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.created_at != nil {
+		fields = append(fields, responsecache.FieldCreatedAt)
 	}
-	for i := range ids {
-		m.users[ids[i]] = struct{}{}
+	if m.updated_at != nil {
+		fields = append(fields, responsecache.FieldUpdatedAt)
+	}
+	if m.key != nil {
+		fields = append(fields, responsecache.FieldKey)
+	}
+	if m.response != nil {
+		fields = append(fields, responsecache.FieldResponse)
 	}
+	if m.expires_at != nil {
+		fields = append(fields, responsecache.FieldExpiresAt)
+	}
+	return fields
 }
 
-// ClearUsers clears the "users" edge to the User entity.
-func (m *RoleMutation) ClearUsers() {
-	m.clearedusers = true
-}
-
-// UsersCleared reports if the "users" edge to the User entity was cleared.
-func (m *RoleMutation) UsersCleared() bool {
-	return m.clearedusers
-}
-
-// RemoveUserIDs removes the "users" edge to the User entity by IDs.
-func (m *RoleMutation) RemoveUserIDs(ids ...int) {
-	if m.removedusers == nil {
-		m.removedusers = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.users, ids[i])
-		m.removedusers[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedUsers returns the removed IDs of the "users" edge to the User entity.
-func (m *RoleMutation) RemovedUsersIDs() (ids []int) {
-	for id := range m.removedusers {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// UsersIDs returns the "users" edge IDs in the mutation.
-func (m *RoleMutation) UsersIDs() (ids []int) {
-	for id := range m.users {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetUsers resets all changes to the "users" edge.
-func (m *RoleMutation) ResetUsers() {
-	m.users = nil
-	m.clearedusers = false
-	m.removedusers = nil
-}
-
-// Where appends a list predicates to the RoleMutation builder.
-func (m *RoleMutation) Where(ps ...predicate.Role) {
-	m.predicates = append(m.predicates, ps...)
-}
-
-// WhereP appends storage-level predicates to the RoleMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *RoleMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Role, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
-}
-
-// Op returns the operation name.
-func (m *RoleMutation) Op() Op {
-	return m.op
-}
-
-// SetOp allows setting the mutation operation.
-func (m *RoleMutation) SetOp(op Op) {
-	m.op = op
-}
-
-// Type returns the node type of this mutation (Role).
-func (m *RoleMutation) Type() string {
-	return m.typ
-}
-
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *RoleMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.created_at != nil {
-		fields = append(fields, role.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, role.FieldUpdatedAt)
-	}
-	if m.deleted_at != nil {
-		fields = append(fields, role.FieldDeletedAt)
-	}
-	if m.code != nil {
-		fields = append(fields, role.FieldCode)
-	}
-	if m.name != nil {
-		fields = append(fields, role.FieldName)
-	}
-	if m.scopes != nil {
-		fields = append(fields, role.FieldScopes)
-	}
-	return fields
-}
-
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *RoleMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case role.FieldCreatedAt:
-		return m.CreatedAt()
-	case role.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case role.FieldDeletedAt:
-		return m.DeletedAt()
-	case role.FieldCode:
-		return m.Code()
-	case role.FieldName:
-		return m.Name()
-	case role.FieldScopes:
-		return m.Scopes()
-	}
-	return nil, false
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ResponseCacheMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case responsecache.FieldCreatedAt:
+		return m.CreatedAt()
+	case responsecache.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case responsecache.FieldKey:
+		return m.Key()
+	case responsecache.FieldResponse:
+		return m.Response()
+	case responsecache.FieldExpiresAt:
+		return m.ExpiresAt()
+	}
+	return nil, false
 }
 
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *RoleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ResponseCacheMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case role.FieldCreatedAt:
+	case responsecache.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case role.FieldUpdatedAt:
+	case responsecache.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case role.FieldDeletedAt:
-		return m.OldDeletedAt(ctx)
-	case role.FieldCode:
-		return m.OldCode(ctx)
-	case role.FieldName:
-		return m.OldName(ctx)
-	case role.FieldScopes:
-		return m.OldScopes(ctx)
+	case responsecache.FieldKey:
+		return m.OldKey(ctx)
+	case responsecache.FieldResponse:
+		return m.OldResponse(ctx)
+	case responsecache.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Role field %s", name)
+	return nil, fmt.Errorf("unknown ResponseCache field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RoleMutation) SetField(name string, value ent.Value) error {
+func (m *ResponseCacheMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case role.FieldCreatedAt:
+	case responsecache.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case role.FieldUpdatedAt:
+	case responsecache.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case role.FieldDeletedAt:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDeletedAt(v)
-		return nil
-	case role.FieldCode:
+	case responsecache.FieldKey:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCode(v)
+		m.SetKey(v)
 		return nil
-	case role.FieldName:
-		v, ok := value.(string)
+	case responsecache.FieldResponse:
+		v, ok := value.(objects.JSONRawMessage)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetResponse(v)
 		return nil
-	case role.FieldScopes:
-		v, ok := value.([]string)
+	case responsecache.FieldExpiresAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetScopes(v)
+		m.SetExpiresAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Role field %s", name)
+	return fmt.Errorf("unknown ResponseCache field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *RoleMutation) AddedFields() []string {
-	var fields []string
-	if m.adddeleted_at != nil {
-		fields = append(fields, role.FieldDeletedAt)
-	}
-	return fields
+func (m *ResponseCacheMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *RoleMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case role.FieldDeletedAt:
-		return m.AddedDeletedAt()
-	}
+func (m *ResponseCacheMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RoleMutation) AddField(name string, value ent.Value) error {
+func (m *ResponseCacheMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case role.FieldDeletedAt:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddDeletedAt(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Role numeric field %s", name)
+	return fmt.Errorf("unknown ResponseCache numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *RoleMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(role.FieldScopes) {
-		fields = append(fields, role.FieldScopes)
-	}
-	return fields
+func (m *ResponseCacheMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *RoleMutation) FieldCleared(name string) bool {
+func (m *ResponseCacheMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *RoleMutation) ClearField(name string) error {
-	switch name {
-	case role.FieldScopes:
-		m.ClearScopes()
-		return nil
-	}
-	return fmt.Errorf("unknown Role nullable field %s", name)
+func (m *ResponseCacheMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ResponseCache nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *RoleMutation) ResetField(name string) error {
+func (m *ResponseCacheMutation) ResetField(name string) error {
 	switch name {
-	case role.FieldCreatedAt:
+	case responsecache.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case role.FieldUpdatedAt:
+	case responsecache.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case role.FieldDeletedAt:
-		m.ResetDeletedAt()
-		return nil
-	case role.FieldCode:
-		m.ResetCode()
+	case responsecache.FieldKey:
+		m.ResetKey()
 		return nil
-	case role.FieldName:
-		m.ResetName()
+	case responsecache.FieldResponse:
+		m.ResetResponse()
 		return nil
-	case role.FieldScopes:
-		m.ResetScopes()
+	case responsecache.FieldExpiresAt:
+		m.ResetExpiresAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Role field %s", name)
+	return fmt.Errorf("unknown ResponseCache field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *RoleMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.users != nil {
-		edges = append(edges, role.EdgeUsers)
-	}
+func (m *ResponseCacheMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *RoleMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case role.EdgeUsers:
-		ids := make([]ent.Value, 0, len(m.users))
-		for id := range m.users {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *ResponseCacheMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *RoleMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedusers != nil {
-		edges = append(edges, role.EdgeUsers)
-	}
+func (m *ResponseCacheMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *RoleMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case role.EdgeUsers:
-		ids := make([]ent.Value, 0, len(m.removedusers))
-		for id := range m.removedusers {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *ResponseCacheMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *RoleMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedusers {
-		edges = append(edges, role.EdgeUsers)
-	}
+func (m *ResponseCacheMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *RoleMutation) EdgeCleared(name string) bool {
-	switch name {
-	case role.EdgeUsers:
-		return m.clearedusers
-	}
+func (m *ResponseCacheMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *RoleMutation) ClearEdge(name string) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown Role unique edge %s", name)
+func (m *ResponseCacheMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ResponseCache unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *RoleMutation) ResetEdge(name string) error {
-	switch name {
-	case role.EdgeUsers:
-		m.ResetUsers()
-		return nil
-	}
-	return fmt.Errorf("unknown Role edge %s", name)
+func (m *ResponseCacheMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ResponseCache edge %s", name)
 }
 
-// SystemMutation represents an operation that mutates the System nodes in the graph.
-type SystemMutation struct {
+// RoleMutation represents an operation that mutates the Role nodes in the graph.
+type RoleMutation struct {
 	config
 	op            Op
 	typ           string
@@ -5877,25 +6866,30 @@ type SystemMutation struct {
 	updated_at    *time.Time
 	deleted_at    *int
 	adddeleted_at *int
-	key           *string
-	value         *string
-	clearedFields map[string]struct{}
+	code          *string
+	name          *string
+	scopes        *[]string
+	appendscopes  []string
+	clearedFields map[string]struct{}
+	users         map[int]struct{}
+	removedusers  map[int]struct{}
+	clearedusers  bool
 	done          bool
-	oldValue      func(context.Context) (*System, error)
-	predicates    []predicate.System
+	oldValue      func(context.Context) (*Role, error)
+	predicates    []predicate.Role
 }
 
-var _ ent.Mutation = (*SystemMutation)(nil)
+var _ ent.Mutation = (*RoleMutation)(nil)
 
-// systemOption allows management of the mutation configuration using functional options.
-type systemOption func(*SystemMutation)
+// roleOption allows management of the mutation configuration using functional options.
+type roleOption func(*RoleMutation)
 
-// newSystemMutation creates new mutation for the System entity.
-func newSystemMutation(c config, op Op, opts ...systemOption) *SystemMutation {
-	m := &SystemMutation{
+// newRoleMutation creates new mutation for the Role entity.
+func newRoleMutation(c config, op Op, opts ...roleOption) *RoleMutation {
+	m := &RoleMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeSystem,
+		typ:           TypeRole,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -5904,20 +6898,20 @@ func newSystemMutation(c config, op Op, opts ...systemOption) *SystemMutation {
 	return m
 }
 
-// withSystemID sets the ID field of the mutation.
-func withSystemID(id int) systemOption {
-	return func(m *SystemMutation) {
+// withRoleID sets the ID field of the mutation.
+func withRoleID(id int) roleOption {
+	return func(m *RoleMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *System
+			value *Role
 		)
-		m.oldValue = func(ctx context.Context) (*System, error) {
+		m.oldValue = func(ctx context.Context) (*Role, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().System.Get(ctx, id)
+					value, err = m.Client().Role.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -5926,10 +6920,10 @@ func withSystemID(id int) systemOption {
 	}
 }
 
-// withSystem sets the old System of the mutation.
-func withSystem(node *System) systemOption {
-	return func(m *SystemMutation) {
-		m.oldValue = func(context.Context) (*System, error) {
+// withRole sets the old Role of the mutation.
+func withRole(node *Role) roleOption {
+	return func(m *RoleMutation) {
+		m.oldValue = func(context.Context) (*Role, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -5938,7 +6932,7 @@ func withSystem(node *System) systemOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SystemMutation) Client() *Client {
+func (m RoleMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -5946,7 +6940,7 @@ func (m SystemMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m SystemMutation) Tx() (*Tx, error) {
+func (m RoleMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -5957,7 +6951,7 @@ func (m SystemMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *SystemMutation) ID() (id int, exists bool) {
+func (m *RoleMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -5968,7 +6962,7 @@ func (m *SystemMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *SystemMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *RoleMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -5977,19 +6971,19 @@ func (m *SystemMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().System.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Role.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *SystemMutation) SetCreatedAt(t time.Time) {
+func (m *RoleMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *SystemMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *RoleMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -5997,10 +6991,10 @@ func (m *SystemMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the System entity.
-// If the System object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SystemMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *RoleMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -6015,17 +7009,17 @@ func (m *SystemMutation) OldCreatedAt(ctx context.Context) (v time.Time, err err
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *SystemMutation) ResetCreatedAt() {
+func (m *RoleMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *SystemMutation) SetUpdatedAt(t time.Time) {
+func (m *RoleMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *SystemMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *RoleMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -6033,10 +7027,10 @@ func (m *SystemMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the System entity.
-// If the System object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SystemMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *RoleMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -6051,18 +7045,18 @@ func (m *SystemMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err err
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *SystemMutation) ResetUpdatedAt() {
+func (m *RoleMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
 // SetDeletedAt sets the "deleted_at" field.
-func (m *SystemMutation) SetDeletedAt(i int) {
+func (m *RoleMutation) SetDeletedAt(i int) {
 	m.deleted_at = &i
 	m.adddeleted_at = nil
 }
 
 // DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *SystemMutation) DeletedAt() (r int, exists bool) {
+func (m *RoleMutation) DeletedAt() (r int, exists bool) {
 	v := m.deleted_at
 	if v == nil {
 		return
@@ -6070,10 +7064,10 @@ func (m *SystemMutation) DeletedAt() (r int, exists bool) {
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the System entity.
-// If the System object wasn't provided to the builder, the object is fetched from the database.
+// OldDeletedAt returns the old "deleted_at" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SystemMutation) OldDeletedAt(ctx context.Context) (v int, err error) {
+func (m *RoleMutation) OldDeletedAt(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
@@ -6088,7 +7082,7 @@ func (m *SystemMutation) OldDeletedAt(ctx context.Context) (v int, err error) {
 }
 
 // AddDeletedAt adds i to the "deleted_at" field.
-func (m *SystemMutation) AddDeletedAt(i int) {
+func (m *RoleMutation) AddDeletedAt(i int) {
 	if m.adddeleted_at != nil {
 		*m.adddeleted_at += i
 	} else {
@@ -6097,7 +7091,7 @@ func (m *SystemMutation) AddDeletedAt(i int) {
 }
 
 // AddedDeletedAt returns the value that was added to the "deleted_at" field in this mutation.
-func (m *SystemMutation) AddedDeletedAt() (r int, exists bool) {
+func (m *RoleMutation) AddedDeletedAt() (r int, exists bool) {
 	v := m.adddeleted_at
 	if v == nil {
 		return
@@ -6106,224 +7100,357 @@ func (m *SystemMutation) AddedDeletedAt() (r int, exists bool) {
 }
 
 // ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *SystemMutation) ResetDeletedAt() {
+func (m *RoleMutation) ResetDeletedAt() {
 	m.deleted_at = nil
 	m.adddeleted_at = nil
 }
 
-// SetKey sets the "key" field.
-func (m *SystemMutation) SetKey(s string) {
-	m.key = &s
+// SetCode sets the "code" field.
+func (m *RoleMutation) SetCode(s string) {
+	m.code = &s
 }
 
-// Key returns the value of the "key" field in the mutation.
-func (m *SystemMutation) Key() (r string, exists bool) {
-	v := m.key
+// Code returns the value of the "code" field in the mutation.
+func (m *RoleMutation) Code() (r string, exists bool) {
+	v := m.code
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldKey returns the old "key" field's value of the System entity.
-// If the System object wasn't provided to the builder, the object is fetched from the database.
+// OldCode returns the old "code" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SystemMutation) OldKey(ctx context.Context) (v string, err error) {
+func (m *RoleMutation) OldCode(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+		return v, errors.New("OldCode is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldKey requires an ID field in the mutation")
+		return v, errors.New("OldCode requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+		return v, fmt.Errorf("querying old value for OldCode: %w", err)
 	}
-	return oldValue.Key, nil
+	return oldValue.Code, nil
 }
 
-// ResetKey resets all changes to the "key" field.
-func (m *SystemMutation) ResetKey() {
-	m.key = nil
+// ResetCode resets all changes to the "code" field.
+func (m *RoleMutation) ResetCode() {
+	m.code = nil
 }
 
-// SetValue sets the "value" field.
-func (m *SystemMutation) SetValue(s string) {
-	m.value = &s
+// SetName sets the "name" field.
+func (m *RoleMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Value returns the value of the "value" field in the mutation.
-func (m *SystemMutation) Value() (r string, exists bool) {
-	v := m.value
+// Name returns the value of the "name" field in the mutation.
+func (m *RoleMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldValue returns the old "value" field's value of the System entity.
-// If the System object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SystemMutation) OldValue(ctx context.Context) (v string, err error) {
+func (m *RoleMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldValue requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Value, nil
+	return oldValue.Name, nil
 }
 
-// ResetValue resets all changes to the "value" field.
-func (m *SystemMutation) ResetValue() {
-	m.value = nil
+// ResetName resets all changes to the "name" field.
+func (m *RoleMutation) ResetName() {
+	m.name = nil
 }
 
-// Where appends a list predicates to the SystemMutation builder.
-func (m *SystemMutation) Where(ps ...predicate.System) {
-	m.predicates = append(m.predicates, ps...)
+// SetScopes sets the "scopes" field.
+func (m *RoleMutation) SetScopes(s []string) {
+	m.scopes = &s
+	m.appendscopes = nil
 }
 
-// WhereP appends storage-level predicates to the SystemMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SystemMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.System, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// Scopes returns the value of the "scopes" field in the mutation.
+func (m *RoleMutation) Scopes() (r []string, exists bool) {
+	v := m.scopes
+	if v == nil {
+		return
 	}
-	m.Where(p...)
-}
-
-// Op returns the operation name.
-func (m *SystemMutation) Op() Op {
-	return m.op
+	return *v, true
 }
 
-// SetOp allows setting the mutation operation.
-func (m *SystemMutation) SetOp(op Op) {
-	m.op = op
+// OldScopes returns the old "scopes" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleMutation) OldScopes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScopes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScopes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScopes: %w", err)
+	}
+	return oldValue.Scopes, nil
 }
 
-// Type returns the node type of this mutation (System).
-func (m *SystemMutation) Type() string {
-	return m.typ
+// AppendScopes adds s to the "scopes" field.
+func (m *RoleMutation) AppendScopes(s []string) {
+	m.appendscopes = append(m.appendscopes, s...)
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *SystemMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.created_at != nil {
-		fields = append(fields, system.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, system.FieldUpdatedAt)
-	}
-	if m.deleted_at != nil {
-		fields = append(fields, system.FieldDeletedAt)
-	}
-	if m.key != nil {
-		fields = append(fields, system.FieldKey)
-	}
-	if m.value != nil {
-		fields = append(fields, system.FieldValue)
+// AppendedScopes returns the list of values that were appended to the "scopes" field in this mutation.
+func (m *RoleMutation) AppendedScopes() ([]string, bool) {
+	if len(m.appendscopes) == 0 {
+		return nil, false
 	}
-	return fields
+	return m.appendscopes, true
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *SystemMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case system.FieldCreatedAt:
-		return m.CreatedAt()
-	case system.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case system.FieldDeletedAt:
-		return m.DeletedAt()
-	case system.FieldKey:
-		return m.Key()
-	case system.FieldValue:
-		return m.Value()
-	}
-	return nil, false
+// ClearScopes clears the value of the "scopes" field.
+func (m *RoleMutation) ClearScopes() {
+	m.scopes = nil
+	m.appendscopes = nil
+	m.clearedFields[role.FieldScopes] = struct{}{}
+}
+
+// ScopesCleared returns if the "scopes" field was cleared in this mutation.
+func (m *RoleMutation) ScopesCleared() bool {
+	_, ok := m.clearedFields[role.FieldScopes]
+	return ok
+}
+
+// ResetScopes resets all changes to the "scopes" field.
+func (m *RoleMutation) ResetScopes() {
+	m.scopes = nil
+	m.appendscopes = nil
+	delete(m.clearedFields, role.FieldScopes)
+}
+
+// AddUserIDs adds the "users" edge to the User entity by ids.
+func (m *RoleMutation) AddUserIDs(ids ...int) {
+	if m.users == nil {
+		m.users = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.users[ids[i]] = struct{}{}
+	}
+}
+
+// ClearUsers clears the "users" edge to the User entity.
+func (m *RoleMutation) ClearUsers() {
+	m.clearedusers = true
+}
+
+// UsersCleared reports if the "users" edge to the User entity was cleared.
+func (m *RoleMutation) UsersCleared() bool {
+	return m.clearedusers
+}
+
+// RemoveUserIDs removes the "users" edge to the User entity by IDs.
+func (m *RoleMutation) RemoveUserIDs(ids ...int) {
+	if m.removedusers == nil {
+		m.removedusers = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.users, ids[i])
+		m.removedusers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedUsers returns the removed IDs of the "users" edge to the User entity.
+func (m *RoleMutation) RemovedUsersIDs() (ids []int) {
+	for id := range m.removedusers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// UsersIDs returns the "users" edge IDs in the mutation.
+func (m *RoleMutation) UsersIDs() (ids []int) {
+	for id := range m.users {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetUsers resets all changes to the "users" edge.
+func (m *RoleMutation) ResetUsers() {
+	m.users = nil
+	m.clearedusers = false
+	m.removedusers = nil
+}
+
+// Where appends a list predicates to the RoleMutation builder.
+func (m *RoleMutation) Where(ps ...predicate.Role) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RoleMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RoleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Role, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RoleMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RoleMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Role).
+func (m *RoleMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RoleMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.created_at != nil {
+		fields = append(fields, role.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, role.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, role.FieldDeletedAt)
+	}
+	if m.code != nil {
+		fields = append(fields, role.FieldCode)
+	}
+	if m.name != nil {
+		fields = append(fields, role.FieldName)
+	}
+	if m.scopes != nil {
+		fields = append(fields, role.FieldScopes)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RoleMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case role.FieldCreatedAt:
+		return m.CreatedAt()
+	case role.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case role.FieldDeletedAt:
+		return m.DeletedAt()
+	case role.FieldCode:
+		return m.Code()
+	case role.FieldName:
+		return m.Name()
+	case role.FieldScopes:
+		return m.Scopes()
+	}
+	return nil, false
 }
 
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SystemMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *RoleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case system.FieldCreatedAt:
+	case role.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case system.FieldUpdatedAt:
+	case role.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case system.FieldDeletedAt:
+	case role.FieldDeletedAt:
 		return m.OldDeletedAt(ctx)
-	case system.FieldKey:
-		return m.OldKey(ctx)
-	case system.FieldValue:
-		return m.OldValue(ctx)
+	case role.FieldCode:
+		return m.OldCode(ctx)
+	case role.FieldName:
+		return m.OldName(ctx)
+	case role.FieldScopes:
+		return m.OldScopes(ctx)
 	}
-	return nil, fmt.Errorf("unknown System field %s", name)
+	return nil, fmt.Errorf("unknown Role field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SystemMutation) SetField(name string, value ent.Value) error {
+func (m *RoleMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case system.FieldCreatedAt:
+	case role.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case system.FieldUpdatedAt:
+	case role.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case system.FieldDeletedAt:
+	case role.FieldDeletedAt:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetDeletedAt(v)
 		return nil
-	case system.FieldKey:
+	case role.FieldCode:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetKey(v)
+		m.SetCode(v)
 		return nil
-	case system.FieldValue:
+	case role.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetValue(v)
+		m.SetName(v)
+		return nil
+	case role.FieldScopes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScopes(v)
 		return nil
 	}
-	return fmt.Errorf("unknown System field %s", name)
+	return fmt.Errorf("unknown Role field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SystemMutation) AddedFields() []string {
+func (m *RoleMutation) AddedFields() []string {
 	var fields []string
 	if m.adddeleted_at != nil {
-		fields = append(fields, system.FieldDeletedAt)
+		fields = append(fields, role.FieldDeletedAt)
 	}
 	return fields
 }
@@ -6331,9 +7458,9 @@ func (m *SystemMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SystemMutation) AddedField(name string) (ent.Value, bool) {
+func (m *RoleMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case system.FieldDeletedAt:
+	case role.FieldDeletedAt:
 		return m.AddedDeletedAt()
 	}
 	return nil, false
@@ -6342,9 +7469,9 @@ func (m *SystemMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SystemMutation) AddField(name string, value ent.Value) error {
+func (m *RoleMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case system.FieldDeletedAt:
+	case role.FieldDeletedAt:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -6352,153 +7479,184 @@ func (m *SystemMutation) AddField(name string, value ent.Value) error {
 		m.AddDeletedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown System numeric field %s", name)
+	return fmt.Errorf("unknown Role numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SystemMutation) ClearedFields() []string {
-	return nil
+func (m *RoleMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(role.FieldScopes) {
+		fields = append(fields, role.FieldScopes)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SystemMutation) FieldCleared(name string) bool {
+func (m *RoleMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SystemMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown System nullable field %s", name)
+func (m *RoleMutation) ClearField(name string) error {
+	switch name {
+	case role.FieldScopes:
+		m.ClearScopes()
+		return nil
+	}
+	return fmt.Errorf("unknown Role nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SystemMutation) ResetField(name string) error {
+func (m *RoleMutation) ResetField(name string) error {
 	switch name {
-	case system.FieldCreatedAt:
+	case role.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case system.FieldUpdatedAt:
+	case role.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case system.FieldDeletedAt:
+	case role.FieldDeletedAt:
 		m.ResetDeletedAt()
 		return nil
-	case system.FieldKey:
-		m.ResetKey()
+	case role.FieldCode:
+		m.ResetCode()
 		return nil
-	case system.FieldValue:
-		m.ResetValue()
+	case role.FieldName:
+		m.ResetName()
+		return nil
+	case role.FieldScopes:
+		m.ResetScopes()
 		return nil
 	}
-	return fmt.Errorf("unknown System field %s", name)
+	return fmt.Errorf("unknown Role field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SystemMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *RoleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.users != nil {
+		edges = append(edges, role.EdgeUsers)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SystemMutation) AddedIDs(name string) []ent.Value {
-	return nil
-}
-
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SystemMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *RoleMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case role.EdgeUsers:
+		ids := make([]ent.Value, 0, len(m.users))
+		for id := range m.users {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RoleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedusers != nil {
+		edges = append(edges, role.EdgeUsers)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SystemMutation) RemovedIDs(name string) []ent.Value {
+func (m *RoleMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case role.EdgeUsers:
+		ids := make([]ent.Value, 0, len(m.removedusers))
+		for id := range m.removedusers {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SystemMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *RoleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedusers {
+		edges = append(edges, role.EdgeUsers)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SystemMutation) EdgeCleared(name string) bool {
+func (m *RoleMutation) EdgeCleared(name string) bool {
+	switch name {
+	case role.EdgeUsers:
+		return m.clearedusers
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SystemMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown System unique edge %s", name)
+func (m *RoleMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Role unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SystemMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown System edge %s", name)
+func (m *RoleMutation) ResetEdge(name string) error {
+	switch name {
+	case role.EdgeUsers:
+		m.ResetUsers()
+		return nil
+	}
+	return fmt.Errorf("unknown Role edge %s", name)
 }
 
-// UsageLogMutation represents an operation that mutates the UsageLog nodes in the graph.
-type UsageLogMutation struct {
+// RoutingRuleMutation represents an operation that mutates the RoutingRule nodes in the graph.
+type RoutingRuleMutation struct {
 	config
-	op                                       Op
-	typ                                      string
-	id                                       *int
-	created_at                               *time.Time
-	updated_at                               *time.Time
-	deleted_at                               *int
-	adddeleted_at                            *int
-	model_id                                 *string
-	prompt_tokens                            *int
-	addprompt_tokens                         *int
-	completion_tokens                        *int
-	addcompletion_tokens                     *int
-	total_tokens                             *int
-	addtotal_tokens                          *int
-	prompt_audio_tokens                      *int
-	addprompt_audio_tokens                   *int
-	prompt_cached_tokens                     *int
-	addprompt_cached_tokens                  *int
-	completion_audio_tokens                  *int
-	addcompletion_audio_tokens               *int
-	completion_reasoning_tokens              *int
-	addcompletion_reasoning_tokens           *int
-	completion_accepted_prediction_tokens    *int
-	addcompletion_accepted_prediction_tokens *int
-	completion_rejected_prediction_tokens    *int
-	addcompletion_rejected_prediction_tokens *int
-	source                                   *usagelog.Source
-	format                                   *string
-	clearedFields                            map[string]struct{}
-	user                                     *int
-	cleareduser                              bool
-	request                                  *int
-	clearedrequest                           bool
-	channel                                  *int
-	clearedchannel                           bool
-	done                                     bool
-	oldValue                                 func(context.Context) (*UsageLog, error)
-	predicates                               []predicate.UsageLog
+	op              Op
+	typ             string
+	id              *int
+	created_at      *time.Time
+	updated_at      *time.Time
+	deleted_at      *int
+	adddeleted_at   *int
+	name            *string
+	description     *string
+	status          *routingrule.Status
+	priority        *int
+	addpriority     *int
+	conditions      **objects.RoutingConditions
+	action          *routingrule.Action
+	clearedFields   map[string]struct{}
+	channels        map[int]struct{}
+	removedchannels map[int]struct{}
+	clearedchannels bool
+	done            bool
+	oldValue        func(context.Context) (*RoutingRule, error)
+	predicates      []predicate.RoutingRule
 }
 
-var _ ent.Mutation = (*UsageLogMutation)(nil)
+var _ ent.Mutation = (*RoutingRuleMutation)(nil)
 
-// usagelogOption allows management of the mutation configuration using functional options.
-type usagelogOption func(*UsageLogMutation)
+// routingruleOption allows management of the mutation configuration using functional options.
+type routingruleOption func(*RoutingRuleMutation)
 
-// newUsageLogMutation creates new mutation for the UsageLog entity.
-func newUsageLogMutation(c config, op Op, opts ...usagelogOption) *UsageLogMutation {
-	m := &UsageLogMutation{
+// newRoutingRuleMutation creates new mutation for the RoutingRule entity.
+func newRoutingRuleMutation(c config, op Op, opts ...routingruleOption) *RoutingRuleMutation {
+	m := &RoutingRuleMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeUsageLog,
+		typ:           TypeRoutingRule,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -6507,20 +7665,20 @@ func newUsageLogMutation(c config, op Op, opts ...usagelogOption) *UsageLogMutat
 	return m
 }
 
-// withUsageLogID sets the ID field of the mutation.
-func withUsageLogID(id int) usagelogOption {
-	return func(m *UsageLogMutation) {
+// withRoutingRuleID sets the ID field of the mutation.
+func withRoutingRuleID(id int) routingruleOption {
+	return func(m *RoutingRuleMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *UsageLog
+			value *RoutingRule
 		)
-		m.oldValue = func(ctx context.Context) (*UsageLog, error) {
+		m.oldValue = func(ctx context.Context) (*RoutingRule, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().UsageLog.Get(ctx, id)
+					value, err = m.Client().RoutingRule.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -6529,10 +7687,10 @@ func withUsageLogID(id int) usagelogOption {
 	}
 }
 
-// withUsageLog sets the old UsageLog of the mutation.
-func withUsageLog(node *UsageLog) usagelogOption {
-	return func(m *UsageLogMutation) {
-		m.oldValue = func(context.Context) (*UsageLog, error) {
+// withRoutingRule sets the old RoutingRule of the mutation.
+func withRoutingRule(node *RoutingRule) routingruleOption {
+	return func(m *RoutingRuleMutation) {
+		m.oldValue = func(context.Context) (*RoutingRule, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -6541,7 +7699,7 @@ func withUsageLog(node *UsageLog) usagelogOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UsageLogMutation) Client() *Client {
+func (m RoutingRuleMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -6549,7 +7707,7 @@ func (m UsageLogMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m UsageLogMutation) Tx() (*Tx, error) {
+func (m RoutingRuleMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -6560,7 +7718,7 @@ func (m UsageLogMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *UsageLogMutation) ID() (id int, exists bool) {
+func (m *RoutingRuleMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -6571,7 +7729,7 @@ func (m *UsageLogMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *UsageLogMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *RoutingRuleMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -6580,19 +7738,19 @@ func (m *UsageLogMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().UsageLog.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().RoutingRule.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *UsageLogMutation) SetCreatedAt(t time.Time) {
+func (m *RoutingRuleMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *UsageLogMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *RoutingRuleMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -6600,10 +7758,10 @@ func (m *UsageLogMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the UsageLog entity.
-// If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the RoutingRule entity.
+// If the RoutingRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsageLogMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *RoutingRuleMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -6618,17 +7776,17 @@ func (m *UsageLogMutation) OldCreatedAt(ctx context.Context) (v time.Time, err e
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *UsageLogMutation) ResetCreatedAt() {
+func (m *RoutingRuleMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *UsageLogMutation) SetUpdatedAt(t time.Time) {
+func (m *RoutingRuleMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *UsageLogMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *RoutingRuleMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -6636,10 +7794,10 @@ func (m *UsageLogMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the UsageLog entity.
-// If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the RoutingRule entity.
+// If the RoutingRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsageLogMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *RoutingRuleMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -6654,18 +7812,18 @@ func (m *UsageLogMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err e
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *UsageLogMutation) ResetUpdatedAt() {
+func (m *RoutingRuleMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
 // SetDeletedAt sets the "deleted_at" field.
-func (m *UsageLogMutation) SetDeletedAt(i int) {
+func (m *RoutingRuleMutation) SetDeletedAt(i int) {
 	m.deleted_at = &i
 	m.adddeleted_at = nil
 }
 
 // DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *UsageLogMutation) DeletedAt() (r int, exists bool) {
+func (m *RoutingRuleMutation) DeletedAt() (r int, exists bool) {
 	v := m.deleted_at
 	if v == nil {
 		return
@@ -6673,10 +7831,10 @@ func (m *UsageLogMutation) DeletedAt() (r int, exists bool) {
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the UsageLog entity.
-// If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
+// OldDeletedAt returns the old "deleted_at" field's value of the RoutingRule entity.
+// If the RoutingRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsageLogMutation) OldDeletedAt(ctx context.Context) (v int, err error) {
+func (m *RoutingRuleMutation) OldDeletedAt(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
@@ -6691,7 +7849,7 @@ func (m *UsageLogMutation) OldDeletedAt(ctx context.Context) (v int, err error)
 }
 
 // AddDeletedAt adds i to the "deleted_at" field.
-func (m *UsageLogMutation) AddDeletedAt(i int) {
+func (m *RoutingRuleMutation) AddDeletedAt(i int) {
 	if m.adddeleted_at != nil {
 		*m.adddeleted_at += i
 	} else {
@@ -6700,7 +7858,7 @@ func (m *UsageLogMutation) AddDeletedAt(i int) {
 }
 
 // AddedDeletedAt returns the value that was added to the "deleted_at" field in this mutation.
-func (m *UsageLogMutation) AddedDeletedAt() (r int, exists bool) {
+func (m *RoutingRuleMutation) AddedDeletedAt() (r int, exists bool) {
 	v := m.adddeleted_at
 	if v == nil {
 		return
@@ -6709,918 +7867,1026 @@ func (m *UsageLogMutation) AddedDeletedAt() (r int, exists bool) {
 }
 
 // ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *UsageLogMutation) ResetDeletedAt() {
+func (m *RoutingRuleMutation) ResetDeletedAt() {
 	m.deleted_at = nil
 	m.adddeleted_at = nil
 }
 
-// SetUserID sets the "user_id" field.
-func (m *UsageLogMutation) SetUserID(i int) {
-	m.user = &i
+// SetName sets the "name" field.
+func (m *RoutingRuleMutation) SetName(s string) {
+	m.name = &s
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *UsageLogMutation) UserID() (r int, exists bool) {
-	v := m.user
+// Name returns the value of the "name" field in the mutation.
+func (m *RoutingRuleMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the UsageLog entity.
-// If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the RoutingRule entity.
+// If the RoutingRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsageLogMutation) OldUserID(ctx context.Context) (v int, err error) {
+func (m *RoutingRuleMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.UserID, nil
+	return oldValue.Name, nil
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *UsageLogMutation) ResetUserID() {
-	m.user = nil
+// ResetName resets all changes to the "name" field.
+func (m *RoutingRuleMutation) ResetName() {
+	m.name = nil
 }
 
-// SetRequestID sets the "request_id" field.
-func (m *UsageLogMutation) SetRequestID(i int) {
-	m.request = &i
+// SetDescription sets the "description" field.
+func (m *RoutingRuleMutation) SetDescription(s string) {
+	m.description = &s
 }
 
-// RequestID returns the value of the "request_id" field in the mutation.
-func (m *UsageLogMutation) RequestID() (r int, exists bool) {
-	v := m.request
+// Description returns the value of the "description" field in the mutation.
+func (m *RoutingRuleMutation) Description() (r string, exists bool) {
+	v := m.description
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRequestID returns the old "request_id" field's value of the UsageLog entity.
-// If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
+// OldDescription returns the old "description" field's value of the RoutingRule entity.
+// If the RoutingRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsageLogMutation) OldRequestID(ctx context.Context) (v int, err error) {
+func (m *RoutingRuleMutation) OldDescription(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRequestID is only allowed on UpdateOne operations")
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRequestID requires an ID field in the mutation")
+		return v, errors.New("OldDescription requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRequestID: %w", err)
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
 	}
-	return oldValue.RequestID, nil
+	return oldValue.Description, nil
 }
 
-// ResetRequestID resets all changes to the "request_id" field.
-func (m *UsageLogMutation) ResetRequestID() {
-	m.request = nil
+// ClearDescription clears the value of the "description" field.
+func (m *RoutingRuleMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[routingrule.FieldDescription] = struct{}{}
 }
 
-// SetChannelID sets the "channel_id" field.
-func (m *UsageLogMutation) SetChannelID(i int) {
-	m.channel = &i
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *RoutingRuleMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[routingrule.FieldDescription]
+	return ok
 }
 
-// ChannelID returns the value of the "channel_id" field in the mutation.
-func (m *UsageLogMutation) ChannelID() (r int, exists bool) {
-	v := m.channel
+// ResetDescription resets all changes to the "description" field.
+func (m *RoutingRuleMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, routingrule.FieldDescription)
+}
+
+// SetStatus sets the "status" field.
+func (m *RoutingRuleMutation) SetStatus(r routingrule.Status) {
+	m.status = &r
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *RoutingRuleMutation) Status() (r routingrule.Status, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldChannelID returns the old "channel_id" field's value of the UsageLog entity.
-// If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the RoutingRule entity.
+// If the RoutingRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsageLogMutation) OldChannelID(ctx context.Context) (v int, err error) {
+func (m *RoutingRuleMutation) OldStatus(ctx context.Context) (v routingrule.Status, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldChannelID is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldChannelID requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldChannelID: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.ChannelID, nil
-}
-
-// ClearChannelID clears the value of the "channel_id" field.
-func (m *UsageLogMutation) ClearChannelID() {
-	m.channel = nil
-	m.clearedFields[usagelog.FieldChannelID] = struct{}{}
-}
-
-// ChannelIDCleared returns if the "channel_id" field was cleared in this mutation.
-func (m *UsageLogMutation) ChannelIDCleared() bool {
-	_, ok := m.clearedFields[usagelog.FieldChannelID]
-	return ok
+	return oldValue.Status, nil
 }
 
-// ResetChannelID resets all changes to the "channel_id" field.
-func (m *UsageLogMutation) ResetChannelID() {
-	m.channel = nil
-	delete(m.clearedFields, usagelog.FieldChannelID)
+// ResetStatus resets all changes to the "status" field.
+func (m *RoutingRuleMutation) ResetStatus() {
+	m.status = nil
 }
 
-// SetModelID sets the "model_id" field.
-func (m *UsageLogMutation) SetModelID(s string) {
-	m.model_id = &s
+// SetPriority sets the "priority" field.
+func (m *RoutingRuleMutation) SetPriority(i int) {
+	m.priority = &i
+	m.addpriority = nil
 }
 
-// ModelID returns the value of the "model_id" field in the mutation.
-func (m *UsageLogMutation) ModelID() (r string, exists bool) {
-	v := m.model_id
+// Priority returns the value of the "priority" field in the mutation.
+func (m *RoutingRuleMutation) Priority() (r int, exists bool) {
+	v := m.priority
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldModelID returns the old "model_id" field's value of the UsageLog entity.
-// If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
+// OldPriority returns the old "priority" field's value of the RoutingRule entity.
+// If the RoutingRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsageLogMutation) OldModelID(ctx context.Context) (v string, err error) {
+func (m *RoutingRuleMutation) OldPriority(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldModelID is only allowed on UpdateOne operations")
+		return v, errors.New("OldPriority is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldModelID requires an ID field in the mutation")
+		return v, errors.New("OldPriority requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldModelID: %w", err)
+		return v, fmt.Errorf("querying old value for OldPriority: %w", err)
 	}
-	return oldValue.ModelID, nil
+	return oldValue.Priority, nil
 }
 
-// ResetModelID resets all changes to the "model_id" field.
-func (m *UsageLogMutation) ResetModelID() {
-	m.model_id = nil
+// AddPriority adds i to the "priority" field.
+func (m *RoutingRuleMutation) AddPriority(i int) {
+	if m.addpriority != nil {
+		*m.addpriority += i
+	} else {
+		m.addpriority = &i
+	}
 }
 
-// SetPromptTokens sets the "prompt_tokens" field.
-func (m *UsageLogMutation) SetPromptTokens(i int) {
-	m.prompt_tokens = &i
-	m.addprompt_tokens = nil
-}
-
-// PromptTokens returns the value of the "prompt_tokens" field in the mutation.
-func (m *UsageLogMutation) PromptTokens() (r int, exists bool) {
-	v := m.prompt_tokens
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldPromptTokens returns the old "prompt_tokens" field's value of the UsageLog entity.
-// If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsageLogMutation) OldPromptTokens(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPromptTokens is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPromptTokens requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPromptTokens: %w", err)
-	}
-	return oldValue.PromptTokens, nil
-}
-
-// AddPromptTokens adds i to the "prompt_tokens" field.
-func (m *UsageLogMutation) AddPromptTokens(i int) {
-	if m.addprompt_tokens != nil {
-		*m.addprompt_tokens += i
-	} else {
-		m.addprompt_tokens = &i
-	}
-}
-
-// AddedPromptTokens returns the value that was added to the "prompt_tokens" field in this mutation.
-func (m *UsageLogMutation) AddedPromptTokens() (r int, exists bool) {
-	v := m.addprompt_tokens
+// AddedPriority returns the value that was added to the "priority" field in this mutation.
+func (m *RoutingRuleMutation) AddedPriority() (r int, exists bool) {
+	v := m.addpriority
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetPromptTokens resets all changes to the "prompt_tokens" field.
-func (m *UsageLogMutation) ResetPromptTokens() {
-	m.prompt_tokens = nil
-	m.addprompt_tokens = nil
+// ResetPriority resets all changes to the "priority" field.
+func (m *RoutingRuleMutation) ResetPriority() {
+	m.priority = nil
+	m.addpriority = nil
 }
 
-// SetCompletionTokens sets the "completion_tokens" field.
-func (m *UsageLogMutation) SetCompletionTokens(i int) {
-	m.completion_tokens = &i
-	m.addcompletion_tokens = nil
+// SetConditions sets the "conditions" field.
+func (m *RoutingRuleMutation) SetConditions(oc *objects.RoutingConditions) {
+	m.conditions = &oc
 }
 
-// CompletionTokens returns the value of the "completion_tokens" field in the mutation.
-func (m *UsageLogMutation) CompletionTokens() (r int, exists bool) {
-	v := m.completion_tokens
+// Conditions returns the value of the "conditions" field in the mutation.
+func (m *RoutingRuleMutation) Conditions() (r *objects.RoutingConditions, exists bool) {
+	v := m.conditions
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCompletionTokens returns the old "completion_tokens" field's value of the UsageLog entity.
-// If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
+// OldConditions returns the old "conditions" field's value of the RoutingRule entity.
+// If the RoutingRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsageLogMutation) OldCompletionTokens(ctx context.Context) (v int, err error) {
+func (m *RoutingRuleMutation) OldConditions(ctx context.Context) (v *objects.RoutingConditions, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCompletionTokens is only allowed on UpdateOne operations")
+		return v, errors.New("OldConditions is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCompletionTokens requires an ID field in the mutation")
+		return v, errors.New("OldConditions requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCompletionTokens: %w", err)
-	}
-	return oldValue.CompletionTokens, nil
-}
-
-// AddCompletionTokens adds i to the "completion_tokens" field.
-func (m *UsageLogMutation) AddCompletionTokens(i int) {
-	if m.addcompletion_tokens != nil {
-		*m.addcompletion_tokens += i
-	} else {
-		m.addcompletion_tokens = &i
-	}
-}
-
-// AddedCompletionTokens returns the value that was added to the "completion_tokens" field in this mutation.
-func (m *UsageLogMutation) AddedCompletionTokens() (r int, exists bool) {
-	v := m.addcompletion_tokens
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldConditions: %w", err)
 	}
-	return *v, true
+	return oldValue.Conditions, nil
 }
 
-// ResetCompletionTokens resets all changes to the "completion_tokens" field.
-func (m *UsageLogMutation) ResetCompletionTokens() {
-	m.completion_tokens = nil
-	m.addcompletion_tokens = nil
+// ResetConditions resets all changes to the "conditions" field.
+func (m *RoutingRuleMutation) ResetConditions() {
+	m.conditions = nil
 }
 
-// SetTotalTokens sets the "total_tokens" field.
-func (m *UsageLogMutation) SetTotalTokens(i int) {
-	m.total_tokens = &i
-	m.addtotal_tokens = nil
+// SetAction sets the "action" field.
+func (m *RoutingRuleMutation) SetAction(r routingrule.Action) {
+	m.action = &r
 }
 
-// TotalTokens returns the value of the "total_tokens" field in the mutation.
-func (m *UsageLogMutation) TotalTokens() (r int, exists bool) {
-	v := m.total_tokens
+// Action returns the value of the "action" field in the mutation.
+func (m *RoutingRuleMutation) Action() (r routingrule.Action, exists bool) {
+	v := m.action
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTotalTokens returns the old "total_tokens" field's value of the UsageLog entity.
-// If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
+// OldAction returns the old "action" field's value of the RoutingRule entity.
+// If the RoutingRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsageLogMutation) OldTotalTokens(ctx context.Context) (v int, err error) {
+func (m *RoutingRuleMutation) OldAction(ctx context.Context) (v routingrule.Action, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTotalTokens is only allowed on UpdateOne operations")
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTotalTokens requires an ID field in the mutation")
+		return v, errors.New("OldAction requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTotalTokens: %w", err)
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
 	}
-	return oldValue.TotalTokens, nil
+	return oldValue.Action, nil
 }
 
-// AddTotalTokens adds i to the "total_tokens" field.
-func (m *UsageLogMutation) AddTotalTokens(i int) {
-	if m.addtotal_tokens != nil {
-		*m.addtotal_tokens += i
-	} else {
-		m.addtotal_tokens = &i
-	}
+// ResetAction resets all changes to the "action" field.
+func (m *RoutingRuleMutation) ResetAction() {
+	m.action = nil
 }
 
-// AddedTotalTokens returns the value that was added to the "total_tokens" field in this mutation.
-func (m *UsageLogMutation) AddedTotalTokens() (r int, exists bool) {
-	v := m.addtotal_tokens
-	if v == nil {
-		return
+// AddChannelIDs adds the "channels" edge to the Channel entity by ids.
+func (m *RoutingRuleMutation) AddChannelIDs(ids ...int) {
+	if m.channels == nil {
+		m.channels = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.channels[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// ResetTotalTokens resets all changes to the "total_tokens" field.
-func (m *UsageLogMutation) ResetTotalTokens() {
-	m.total_tokens = nil
-	m.addtotal_tokens = nil
+// ClearChannels clears the "channels" edge to the Channel entity.
+func (m *RoutingRuleMutation) ClearChannels() {
+	m.clearedchannels = true
 }
 
-// SetPromptAudioTokens sets the "prompt_audio_tokens" field.
-func (m *UsageLogMutation) SetPromptAudioTokens(i int) {
-	m.prompt_audio_tokens = &i
-	m.addprompt_audio_tokens = nil
+// ChannelsCleared reports if the "channels" edge to the Channel entity was cleared.
+func (m *RoutingRuleMutation) ChannelsCleared() bool {
+	return m.clearedchannels
 }
 
-// PromptAudioTokens returns the value of the "prompt_audio_tokens" field in the mutation.
-func (m *UsageLogMutation) PromptAudioTokens() (r int, exists bool) {
-	v := m.prompt_audio_tokens
-	if v == nil {
-		return
+// RemoveChannelIDs removes the "channels" edge to the Channel entity by IDs.
+func (m *RoutingRuleMutation) RemoveChannelIDs(ids ...int) {
+	if m.removedchannels == nil {
+		m.removedchannels = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.channels, ids[i])
+		m.removedchannels[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldPromptAudioTokens returns the old "prompt_audio_tokens" field's value of the UsageLog entity.
-// If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsageLogMutation) OldPromptAudioTokens(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPromptAudioTokens is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPromptAudioTokens requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPromptAudioTokens: %w", err)
+// RemovedChannels returns the removed IDs of the "channels" edge to the Channel entity.
+func (m *RoutingRuleMutation) RemovedChannelsIDs() (ids []int) {
+	for id := range m.removedchannels {
+		ids = append(ids, id)
 	}
-	return oldValue.PromptAudioTokens, nil
+	return
 }
 
-// AddPromptAudioTokens adds i to the "prompt_audio_tokens" field.
-func (m *UsageLogMutation) AddPromptAudioTokens(i int) {
-	if m.addprompt_audio_tokens != nil {
-		*m.addprompt_audio_tokens += i
-	} else {
-		m.addprompt_audio_tokens = &i
+// ChannelsIDs returns the "channels" edge IDs in the mutation.
+func (m *RoutingRuleMutation) ChannelsIDs() (ids []int) {
+	for id := range m.channels {
+		ids = append(ids, id)
 	}
+	return
 }
 
-// AddedPromptAudioTokens returns the value that was added to the "prompt_audio_tokens" field in this mutation.
-func (m *UsageLogMutation) AddedPromptAudioTokens() (r int, exists bool) {
-	v := m.addprompt_audio_tokens
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetChannels resets all changes to the "channels" edge.
+func (m *RoutingRuleMutation) ResetChannels() {
+	m.channels = nil
+	m.clearedchannels = false
+	m.removedchannels = nil
 }
 
-// ClearPromptAudioTokens clears the value of the "prompt_audio_tokens" field.
-func (m *UsageLogMutation) ClearPromptAudioTokens() {
-	m.prompt_audio_tokens = nil
-	m.addprompt_audio_tokens = nil
-	m.clearedFields[usagelog.FieldPromptAudioTokens] = struct{}{}
+// Where appends a list predicates to the RoutingRuleMutation builder.
+func (m *RoutingRuleMutation) Where(ps ...predicate.RoutingRule) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// PromptAudioTokensCleared returns if the "prompt_audio_tokens" field was cleared in this mutation.
-func (m *UsageLogMutation) PromptAudioTokensCleared() bool {
-	_, ok := m.clearedFields[usagelog.FieldPromptAudioTokens]
-	return ok
+// WhereP appends storage-level predicates to the RoutingRuleMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RoutingRuleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RoutingRule, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
 }
 
-// ResetPromptAudioTokens resets all changes to the "prompt_audio_tokens" field.
-func (m *UsageLogMutation) ResetPromptAudioTokens() {
-	m.prompt_audio_tokens = nil
-	m.addprompt_audio_tokens = nil
-	delete(m.clearedFields, usagelog.FieldPromptAudioTokens)
+// Op returns the operation name.
+func (m *RoutingRuleMutation) Op() Op {
+	return m.op
 }
 
-// SetPromptCachedTokens sets the "prompt_cached_tokens" field.
-func (m *UsageLogMutation) SetPromptCachedTokens(i int) {
-	m.prompt_cached_tokens = &i
-	m.addprompt_cached_tokens = nil
+// SetOp allows setting the mutation operation.
+func (m *RoutingRuleMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// PromptCachedTokens returns the value of the "prompt_cached_tokens" field in the mutation.
-func (m *UsageLogMutation) PromptCachedTokens() (r int, exists bool) {
-	v := m.prompt_cached_tokens
-	if v == nil {
-		return
-	}
-	return *v, true
+// Type returns the node type of this mutation (RoutingRule).
+func (m *RoutingRuleMutation) Type() string {
+	return m.typ
 }
 
-// OldPromptCachedTokens returns the old "prompt_cached_tokens" field's value of the UsageLog entity.
-// If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsageLogMutation) OldPromptCachedTokens(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPromptCachedTokens is only allowed on UpdateOne operations")
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RoutingRuleMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.created_at != nil {
+		fields = append(fields, routingrule.FieldCreatedAt)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPromptCachedTokens requires an ID field in the mutation")
+	if m.updated_at != nil {
+		fields = append(fields, routingrule.FieldUpdatedAt)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPromptCachedTokens: %w", err)
+	if m.deleted_at != nil {
+		fields = append(fields, routingrule.FieldDeletedAt)
 	}
-	return oldValue.PromptCachedTokens, nil
+	if m.name != nil {
+		fields = append(fields, routingrule.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, routingrule.FieldDescription)
+	}
+	if m.status != nil {
+		fields = append(fields, routingrule.FieldStatus)
+	}
+	if m.priority != nil {
+		fields = append(fields, routingrule.FieldPriority)
+	}
+	if m.conditions != nil {
+		fields = append(fields, routingrule.FieldConditions)
+	}
+	if m.action != nil {
+		fields = append(fields, routingrule.FieldAction)
+	}
+	return fields
 }
 
-// AddPromptCachedTokens adds i to the "prompt_cached_tokens" field.
-func (m *UsageLogMutation) AddPromptCachedTokens(i int) {
-	if m.addprompt_cached_tokens != nil {
-		*m.addprompt_cached_tokens += i
-	} else {
-		m.addprompt_cached_tokens = &i
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RoutingRuleMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case routingrule.FieldCreatedAt:
+		return m.CreatedAt()
+	case routingrule.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case routingrule.FieldDeletedAt:
+		return m.DeletedAt()
+	case routingrule.FieldName:
+		return m.Name()
+	case routingrule.FieldDescription:
+		return m.Description()
+	case routingrule.FieldStatus:
+		return m.Status()
+	case routingrule.FieldPriority:
+		return m.Priority()
+	case routingrule.FieldConditions:
+		return m.Conditions()
+	case routingrule.FieldAction:
+		return m.Action()
 	}
+	return nil, false
 }
 
-// AddedPromptCachedTokens returns the value that was added to the "prompt_cached_tokens" field in this mutation.
-func (m *UsageLogMutation) AddedPromptCachedTokens() (r int, exists bool) {
-	v := m.addprompt_cached_tokens
-	if v == nil {
-		return
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RoutingRuleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case routingrule.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case routingrule.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case routingrule.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case routingrule.FieldName:
+		return m.OldName(ctx)
+	case routingrule.FieldDescription:
+		return m.OldDescription(ctx)
+	case routingrule.FieldStatus:
+		return m.OldStatus(ctx)
+	case routingrule.FieldPriority:
+		return m.OldPriority(ctx)
+	case routingrule.FieldConditions:
+		return m.OldConditions(ctx)
+	case routingrule.FieldAction:
+		return m.OldAction(ctx)
 	}
-	return *v, true
+	return nil, fmt.Errorf("unknown RoutingRule field %s", name)
 }
 
-// ClearPromptCachedTokens clears the value of the "prompt_cached_tokens" field.
-func (m *UsageLogMutation) ClearPromptCachedTokens() {
-	m.prompt_cached_tokens = nil
-	m.addprompt_cached_tokens = nil
-	m.clearedFields[usagelog.FieldPromptCachedTokens] = struct{}{}
-}
-
-// PromptCachedTokensCleared returns if the "prompt_cached_tokens" field was cleared in this mutation.
-func (m *UsageLogMutation) PromptCachedTokensCleared() bool {
-	_, ok := m.clearedFields[usagelog.FieldPromptCachedTokens]
-	return ok
-}
-
-// ResetPromptCachedTokens resets all changes to the "prompt_cached_tokens" field.
-func (m *UsageLogMutation) ResetPromptCachedTokens() {
-	m.prompt_cached_tokens = nil
-	m.addprompt_cached_tokens = nil
-	delete(m.clearedFields, usagelog.FieldPromptCachedTokens)
-}
-
-// SetCompletionAudioTokens sets the "completion_audio_tokens" field.
-func (m *UsageLogMutation) SetCompletionAudioTokens(i int) {
-	m.completion_audio_tokens = &i
-	m.addcompletion_audio_tokens = nil
-}
-
-// CompletionAudioTokens returns the value of the "completion_audio_tokens" field in the mutation.
-func (m *UsageLogMutation) CompletionAudioTokens() (r int, exists bool) {
-	v := m.completion_audio_tokens
-	if v == nil {
-		return
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RoutingRuleMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case routingrule.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case routingrule.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case routingrule.FieldDeletedAt:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case routingrule.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case routingrule.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case routingrule.FieldStatus:
+		v, ok := value.(routingrule.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case routingrule.FieldPriority:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPriority(v)
+		return nil
+	case routingrule.FieldConditions:
+		v, ok := value.(*objects.RoutingConditions)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConditions(v)
+		return nil
+	case routingrule.FieldAction:
+		v, ok := value.(routingrule.Action)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown RoutingRule field %s", name)
 }
 
-// OldCompletionAudioTokens returns the old "completion_audio_tokens" field's value of the UsageLog entity.
-// If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsageLogMutation) OldCompletionAudioTokens(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCompletionAudioTokens is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCompletionAudioTokens requires an ID field in the mutation")
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RoutingRuleMutation) AddedFields() []string {
+	var fields []string
+	if m.adddeleted_at != nil {
+		fields = append(fields, routingrule.FieldDeletedAt)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCompletionAudioTokens: %w", err)
+	if m.addpriority != nil {
+		fields = append(fields, routingrule.FieldPriority)
 	}
-	return oldValue.CompletionAudioTokens, nil
+	return fields
 }
 
-// AddCompletionAudioTokens adds i to the "completion_audio_tokens" field.
-func (m *UsageLogMutation) AddCompletionAudioTokens(i int) {
-	if m.addcompletion_audio_tokens != nil {
-		*m.addcompletion_audio_tokens += i
-	} else {
-		m.addcompletion_audio_tokens = &i
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RoutingRuleMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case routingrule.FieldDeletedAt:
+		return m.AddedDeletedAt()
+	case routingrule.FieldPriority:
+		return m.AddedPriority()
 	}
+	return nil, false
 }
 
-// AddedCompletionAudioTokens returns the value that was added to the "completion_audio_tokens" field in this mutation.
-func (m *UsageLogMutation) AddedCompletionAudioTokens() (r int, exists bool) {
-	v := m.addcompletion_audio_tokens
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RoutingRuleMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case routingrule.FieldDeletedAt:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDeletedAt(v)
+		return nil
+	case routingrule.FieldPriority:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPriority(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown RoutingRule numeric field %s", name)
 }
 
-// ClearCompletionAudioTokens clears the value of the "completion_audio_tokens" field.
-func (m *UsageLogMutation) ClearCompletionAudioTokens() {
-	m.completion_audio_tokens = nil
-	m.addcompletion_audio_tokens = nil
-	m.clearedFields[usagelog.FieldCompletionAudioTokens] = struct{}{}
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RoutingRuleMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(routingrule.FieldDescription) {
+		fields = append(fields, routingrule.FieldDescription)
+	}
+	return fields
 }
 
-// CompletionAudioTokensCleared returns if the "completion_audio_tokens" field was cleared in this mutation.
-func (m *UsageLogMutation) CompletionAudioTokensCleared() bool {
-	_, ok := m.clearedFields[usagelog.FieldCompletionAudioTokens]
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RoutingRuleMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
 	return ok
 }
 
-// ResetCompletionAudioTokens resets all changes to the "completion_audio_tokens" field.
-func (m *UsageLogMutation) ResetCompletionAudioTokens() {
-	m.completion_audio_tokens = nil
-	m.addcompletion_audio_tokens = nil
-	delete(m.clearedFields, usagelog.FieldCompletionAudioTokens)
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RoutingRuleMutation) ClearField(name string) error {
+	switch name {
+	case routingrule.FieldDescription:
+		m.ClearDescription()
+		return nil
+	}
+	return fmt.Errorf("unknown RoutingRule nullable field %s", name)
 }
 
-// SetCompletionReasoningTokens sets the "completion_reasoning_tokens" field.
-func (m *UsageLogMutation) SetCompletionReasoningTokens(i int) {
-	m.completion_reasoning_tokens = &i
-	m.addcompletion_reasoning_tokens = nil
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RoutingRuleMutation) ResetField(name string) error {
+	switch name {
+	case routingrule.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case routingrule.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case routingrule.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case routingrule.FieldName:
+		m.ResetName()
+		return nil
+	case routingrule.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case routingrule.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case routingrule.FieldPriority:
+		m.ResetPriority()
+		return nil
+	case routingrule.FieldConditions:
+		m.ResetConditions()
+		return nil
+	case routingrule.FieldAction:
+		m.ResetAction()
+		return nil
+	}
+	return fmt.Errorf("unknown RoutingRule field %s", name)
 }
 
-// CompletionReasoningTokens returns the value of the "completion_reasoning_tokens" field in the mutation.
-func (m *UsageLogMutation) CompletionReasoningTokens() (r int, exists bool) {
-	v := m.completion_reasoning_tokens
-	if v == nil {
-		return
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RoutingRuleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.channels != nil {
+		edges = append(edges, routingrule.EdgeChannels)
 	}
-	return *v, true
+	return edges
 }
 
-// OldCompletionReasoningTokens returns the old "completion_reasoning_tokens" field's value of the UsageLog entity.
-// If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsageLogMutation) OldCompletionReasoningTokens(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCompletionReasoningTokens is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCompletionReasoningTokens requires an ID field in the mutation")
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RoutingRuleMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case routingrule.EdgeChannels:
+		ids := make([]ent.Value, 0, len(m.channels))
+		for id := range m.channels {
+			ids = append(ids, id)
+		}
+		return ids
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCompletionReasoningTokens: %w", err)
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RoutingRuleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedchannels != nil {
+		edges = append(edges, routingrule.EdgeChannels)
 	}
-	return oldValue.CompletionReasoningTokens, nil
+	return edges
 }
 
-// AddCompletionReasoningTokens adds i to the "completion_reasoning_tokens" field.
-func (m *UsageLogMutation) AddCompletionReasoningTokens(i int) {
-	if m.addcompletion_reasoning_tokens != nil {
-		*m.addcompletion_reasoning_tokens += i
-	} else {
-		m.addcompletion_reasoning_tokens = &i
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RoutingRuleMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case routingrule.EdgeChannels:
+		ids := make([]ent.Value, 0, len(m.removedchannels))
+		for id := range m.removedchannels {
+			ids = append(ids, id)
+		}
+		return ids
 	}
+	return nil
 }
 
-// AddedCompletionReasoningTokens returns the value that was added to the "completion_reasoning_tokens" field in this mutation.
-func (m *UsageLogMutation) AddedCompletionReasoningTokens() (r int, exists bool) {
-	v := m.addcompletion_reasoning_tokens
-	if v == nil {
-		return
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RoutingRuleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedchannels {
+		edges = append(edges, routingrule.EdgeChannels)
 	}
-	return *v, true
+	return edges
 }
 
-// ClearCompletionReasoningTokens clears the value of the "completion_reasoning_tokens" field.
-func (m *UsageLogMutation) ClearCompletionReasoningTokens() {
-	m.completion_reasoning_tokens = nil
-	m.addcompletion_reasoning_tokens = nil
-	m.clearedFields[usagelog.FieldCompletionReasoningTokens] = struct{}{}
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RoutingRuleMutation) EdgeCleared(name string) bool {
+	switch name {
+	case routingrule.EdgeChannels:
+		return m.clearedchannels
+	}
+	return false
 }
 
-// CompletionReasoningTokensCleared returns if the "completion_reasoning_tokens" field was cleared in this mutation.
-func (m *UsageLogMutation) CompletionReasoningTokensCleared() bool {
-	_, ok := m.clearedFields[usagelog.FieldCompletionReasoningTokens]
-	return ok
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RoutingRuleMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown RoutingRule unique edge %s", name)
 }
 
-// ResetCompletionReasoningTokens resets all changes to the "completion_reasoning_tokens" field.
-func (m *UsageLogMutation) ResetCompletionReasoningTokens() {
-	m.completion_reasoning_tokens = nil
-	m.addcompletion_reasoning_tokens = nil
-	delete(m.clearedFields, usagelog.FieldCompletionReasoningTokens)
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RoutingRuleMutation) ResetEdge(name string) error {
+	switch name {
+	case routingrule.EdgeChannels:
+		m.ResetChannels()
+		return nil
+	}
+	return fmt.Errorf("unknown RoutingRule edge %s", name)
 }
 
-// SetCompletionAcceptedPredictionTokens sets the "completion_accepted_prediction_tokens" field.
-func (m *UsageLogMutation) SetCompletionAcceptedPredictionTokens(i int) {
-	m.completion_accepted_prediction_tokens = &i
-	m.addcompletion_accepted_prediction_tokens = nil
+// SystemMutation represents an operation that mutates the System nodes in the graph.
+type SystemMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	created_at    *time.Time
+	updated_at    *time.Time
+	deleted_at    *int
+	adddeleted_at *int
+	key           *string
+	value         *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*System, error)
+	predicates    []predicate.System
 }
 
-// CompletionAcceptedPredictionTokens returns the value of the "completion_accepted_prediction_tokens" field in the mutation.
-func (m *UsageLogMutation) CompletionAcceptedPredictionTokens() (r int, exists bool) {
-	v := m.completion_accepted_prediction_tokens
-	if v == nil {
-		return
-	}
-	return *v, true
-}
+var _ ent.Mutation = (*SystemMutation)(nil)
 
-// OldCompletionAcceptedPredictionTokens returns the old "completion_accepted_prediction_tokens" field's value of the UsageLog entity.
-// If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsageLogMutation) OldCompletionAcceptedPredictionTokens(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCompletionAcceptedPredictionTokens is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCompletionAcceptedPredictionTokens requires an ID field in the mutation")
+// systemOption allows management of the mutation configuration using functional options.
+type systemOption func(*SystemMutation)
+
+// newSystemMutation creates new mutation for the System entity.
+func newSystemMutation(c config, op Op, opts ...systemOption) *SystemMutation {
+	m := &SystemMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSystem,
+		clearedFields: make(map[string]struct{}),
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCompletionAcceptedPredictionTokens: %w", err)
+	for _, opt := range opts {
+		opt(m)
 	}
-	return oldValue.CompletionAcceptedPredictionTokens, nil
+	return m
 }
 
-// AddCompletionAcceptedPredictionTokens adds i to the "completion_accepted_prediction_tokens" field.
-func (m *UsageLogMutation) AddCompletionAcceptedPredictionTokens(i int) {
-	if m.addcompletion_accepted_prediction_tokens != nil {
-		*m.addcompletion_accepted_prediction_tokens += i
-	} else {
-		m.addcompletion_accepted_prediction_tokens = &i
+// withSystemID sets the ID field of the mutation.
+func withSystemID(id int) systemOption {
+	return func(m *SystemMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *System
+		)
+		m.oldValue = func(ctx context.Context) (*System, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().System.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
 }
 
-// AddedCompletionAcceptedPredictionTokens returns the value that was added to the "completion_accepted_prediction_tokens" field in this mutation.
-func (m *UsageLogMutation) AddedCompletionAcceptedPredictionTokens() (r int, exists bool) {
-	v := m.addcompletion_accepted_prediction_tokens
-	if v == nil {
-		return
+// withSystem sets the old System of the mutation.
+func withSystem(node *System) systemOption {
+	return func(m *SystemMutation) {
+		m.oldValue = func(context.Context) (*System, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return *v, true
 }
 
-// ClearCompletionAcceptedPredictionTokens clears the value of the "completion_accepted_prediction_tokens" field.
-func (m *UsageLogMutation) ClearCompletionAcceptedPredictionTokens() {
-	m.completion_accepted_prediction_tokens = nil
-	m.addcompletion_accepted_prediction_tokens = nil
-	m.clearedFields[usagelog.FieldCompletionAcceptedPredictionTokens] = struct{}{}
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SystemMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// CompletionAcceptedPredictionTokensCleared returns if the "completion_accepted_prediction_tokens" field was cleared in this mutation.
-func (m *UsageLogMutation) CompletionAcceptedPredictionTokensCleared() bool {
-	_, ok := m.clearedFields[usagelog.FieldCompletionAcceptedPredictionTokens]
-	return ok
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SystemMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ResetCompletionAcceptedPredictionTokens resets all changes to the "completion_accepted_prediction_tokens" field.
-func (m *UsageLogMutation) ResetCompletionAcceptedPredictionTokens() {
-	m.completion_accepted_prediction_tokens = nil
-	m.addcompletion_accepted_prediction_tokens = nil
-	delete(m.clearedFields, usagelog.FieldCompletionAcceptedPredictionTokens)
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SystemMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// SetCompletionRejectedPredictionTokens sets the "completion_rejected_prediction_tokens" field.
-func (m *UsageLogMutation) SetCompletionRejectedPredictionTokens(i int) {
-	m.completion_rejected_prediction_tokens = &i
-	m.addcompletion_rejected_prediction_tokens = nil
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SystemMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().System.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// CompletionRejectedPredictionTokens returns the value of the "completion_rejected_prediction_tokens" field in the mutation.
-func (m *UsageLogMutation) CompletionRejectedPredictionTokens() (r int, exists bool) {
-	v := m.completion_rejected_prediction_tokens
+// SetCreatedAt sets the "created_at" field.
+func (m *SystemMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SystemMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCompletionRejectedPredictionTokens returns the old "completion_rejected_prediction_tokens" field's value of the UsageLog entity.
-// If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the System entity.
+// If the System object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsageLogMutation) OldCompletionRejectedPredictionTokens(ctx context.Context) (v int, err error) {
+func (m *SystemMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCompletionRejectedPredictionTokens is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCompletionRejectedPredictionTokens requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCompletionRejectedPredictionTokens: %w", err)
-	}
-	return oldValue.CompletionRejectedPredictionTokens, nil
-}
-
-// AddCompletionRejectedPredictionTokens adds i to the "completion_rejected_prediction_tokens" field.
-func (m *UsageLogMutation) AddCompletionRejectedPredictionTokens(i int) {
-	if m.addcompletion_rejected_prediction_tokens != nil {
-		*m.addcompletion_rejected_prediction_tokens += i
-	} else {
-		m.addcompletion_rejected_prediction_tokens = &i
-	}
-}
-
-// AddedCompletionRejectedPredictionTokens returns the value that was added to the "completion_rejected_prediction_tokens" field in this mutation.
-func (m *UsageLogMutation) AddedCompletionRejectedPredictionTokens() (r int, exists bool) {
-	v := m.addcompletion_rejected_prediction_tokens
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return *v, true
-}
-
-// ClearCompletionRejectedPredictionTokens clears the value of the "completion_rejected_prediction_tokens" field.
-func (m *UsageLogMutation) ClearCompletionRejectedPredictionTokens() {
-	m.completion_rejected_prediction_tokens = nil
-	m.addcompletion_rejected_prediction_tokens = nil
-	m.clearedFields[usagelog.FieldCompletionRejectedPredictionTokens] = struct{}{}
-}
-
-// CompletionRejectedPredictionTokensCleared returns if the "completion_rejected_prediction_tokens" field was cleared in this mutation.
-func (m *UsageLogMutation) CompletionRejectedPredictionTokensCleared() bool {
-	_, ok := m.clearedFields[usagelog.FieldCompletionRejectedPredictionTokens]
-	return ok
+	return oldValue.CreatedAt, nil
 }
 
-// ResetCompletionRejectedPredictionTokens resets all changes to the "completion_rejected_prediction_tokens" field.
-func (m *UsageLogMutation) ResetCompletionRejectedPredictionTokens() {
-	m.completion_rejected_prediction_tokens = nil
-	m.addcompletion_rejected_prediction_tokens = nil
-	delete(m.clearedFields, usagelog.FieldCompletionRejectedPredictionTokens)
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SystemMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetSource sets the "source" field.
-func (m *UsageLogMutation) SetSource(u usagelog.Source) {
-	m.source = &u
+// SetUpdatedAt sets the "updated_at" field.
+func (m *SystemMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// Source returns the value of the "source" field in the mutation.
-func (m *UsageLogMutation) Source() (r usagelog.Source, exists bool) {
-	v := m.source
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *SystemMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSource returns the old "source" field's value of the UsageLog entity.
-// If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the System entity.
+// If the System object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsageLogMutation) OldSource(ctx context.Context) (v usagelog.Source, err error) {
+func (m *SystemMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSource is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSource requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.Source, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetSource resets all changes to the "source" field.
-func (m *UsageLogMutation) ResetSource() {
-	m.source = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *SystemMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// SetFormat sets the "format" field.
-func (m *UsageLogMutation) SetFormat(s string) {
-	m.format = &s
-}
+// SetDeletedAt sets the "deleted_at" field.
+func (m *SystemMutation) SetDeletedAt(i int) {
+	m.deleted_at = &i
+	m.adddeleted_at = nil
+}
 
-// Format returns the value of the "format" field in the mutation.
-func (m *UsageLogMutation) Format() (r string, exists bool) {
-	v := m.format
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *SystemMutation) DeletedAt() (r int, exists bool) {
+	v := m.deleted_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFormat returns the old "format" field's value of the UsageLog entity.
-// If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
+// OldDeletedAt returns the old "deleted_at" field's value of the System entity.
+// If the System object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsageLogMutation) OldFormat(ctx context.Context) (v string, err error) {
+func (m *SystemMutation) OldDeletedAt(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFormat is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFormat requires an ID field in the mutation")
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFormat: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
 	}
-	return oldValue.Format, nil
-}
-
-// ResetFormat resets all changes to the "format" field.
-func (m *UsageLogMutation) ResetFormat() {
-	m.format = nil
-}
-
-// ClearUser clears the "user" edge to the User entity.
-func (m *UsageLogMutation) ClearUser() {
-	m.cleareduser = true
-	m.clearedFields[usagelog.FieldUserID] = struct{}{}
+	return oldValue.DeletedAt, nil
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *UsageLogMutation) UserCleared() bool {
-	return m.cleareduser
+// AddDeletedAt adds i to the "deleted_at" field.
+func (m *SystemMutation) AddDeletedAt(i int) {
+	if m.adddeleted_at != nil {
+		*m.adddeleted_at += i
+	} else {
+		m.adddeleted_at = &i
+	}
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *UsageLogMutation) UserIDs() (ids []int) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
+// AddedDeletedAt returns the value that was added to the "deleted_at" field in this mutation.
+func (m *SystemMutation) AddedDeletedAt() (r int, exists bool) {
+	v := m.adddeleted_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *UsageLogMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *SystemMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	m.adddeleted_at = nil
 }
 
-// ClearRequest clears the "request" edge to the Request entity.
-func (m *UsageLogMutation) ClearRequest() {
-	m.clearedrequest = true
-	m.clearedFields[usagelog.FieldRequestID] = struct{}{}
+// SetKey sets the "key" field.
+func (m *SystemMutation) SetKey(s string) {
+	m.key = &s
 }
 
-// RequestCleared reports if the "request" edge to the Request entity was cleared.
-func (m *UsageLogMutation) RequestCleared() bool {
-	return m.clearedrequest
+// Key returns the value of the "key" field in the mutation.
+func (m *SystemMutation) Key() (r string, exists bool) {
+	v := m.key
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RequestIDs returns the "request" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// RequestID instead. It exists only for internal usage by the builders.
-func (m *UsageLogMutation) RequestIDs() (ids []int) {
-	if id := m.request; id != nil {
-		ids = append(ids, *id)
+// OldKey returns the old "key" field's value of the System entity.
+// If the System object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemMutation) OldKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+	}
+	return oldValue.Key, nil
 }
 
-// ResetRequest resets all changes to the "request" edge.
-func (m *UsageLogMutation) ResetRequest() {
-	m.request = nil
-	m.clearedrequest = false
+// ResetKey resets all changes to the "key" field.
+func (m *SystemMutation) ResetKey() {
+	m.key = nil
 }
 
-// ClearChannel clears the "channel" edge to the Channel entity.
-func (m *UsageLogMutation) ClearChannel() {
-	m.clearedchannel = true
-	m.clearedFields[usagelog.FieldChannelID] = struct{}{}
+// SetValue sets the "value" field.
+func (m *SystemMutation) SetValue(s string) {
+	m.value = &s
 }
 
-// ChannelCleared reports if the "channel" edge to the Channel entity was cleared.
-func (m *UsageLogMutation) ChannelCleared() bool {
-	return m.ChannelIDCleared() || m.clearedchannel
+// Value returns the value of the "value" field in the mutation.
+func (m *SystemMutation) Value() (r string, exists bool) {
+	v := m.value
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ChannelIDs returns the "channel" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ChannelID instead. It exists only for internal usage by the builders.
-func (m *UsageLogMutation) ChannelIDs() (ids []int) {
-	if id := m.channel; id != nil {
-		ids = append(ids, *id)
+// OldValue returns the old "value" field's value of the System entity.
+// If the System object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemMutation) OldValue(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+	}
+	return oldValue.Value, nil
 }
 
-// ResetChannel resets all changes to the "channel" edge.
-func (m *UsageLogMutation) ResetChannel() {
-	m.channel = nil
-	m.clearedchannel = false
+// ResetValue resets all changes to the "value" field.
+func (m *SystemMutation) ResetValue() {
+	m.value = nil
 }
 
-// Where appends a list predicates to the UsageLogMutation builder.
-func (m *UsageLogMutation) Where(ps ...predicate.UsageLog) {
+// Where appends a list predicates to the SystemMutation builder.
+func (m *SystemMutation) Where(ps ...predicate.System) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the UsageLogMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SystemMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *UsageLogMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.UsageLog, len(ps))
+func (m *SystemMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.System, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -7628,78 +8894,39 @@ func (m *UsageLogMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *UsageLogMutation) Op() Op {
+func (m *SystemMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *UsageLogMutation) SetOp(op Op) {
+func (m *SystemMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (UsageLog).
-func (m *UsageLogMutation) Type() string {
+// Type returns the node type of this mutation (System).
+func (m *SystemMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *UsageLogMutation) Fields() []string {
-	fields := make([]string, 0, 18)
+func (m *SystemMutation) Fields() []string {
+	fields := make([]string, 0, 5)
 	if m.created_at != nil {
-		fields = append(fields, usagelog.FieldCreatedAt)
+		fields = append(fields, system.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, usagelog.FieldUpdatedAt)
+		fields = append(fields, system.FieldUpdatedAt)
 	}
 	if m.deleted_at != nil {
-		fields = append(fields, usagelog.FieldDeletedAt)
-	}
-	if m.user != nil {
-		fields = append(fields, usagelog.FieldUserID)
-	}
-	if m.request != nil {
-		fields = append(fields, usagelog.FieldRequestID)
-	}
-	if m.channel != nil {
-		fields = append(fields, usagelog.FieldChannelID)
-	}
-	if m.model_id != nil {
-		fields = append(fields, usagelog.FieldModelID)
-	}
-	if m.prompt_tokens != nil {
-		fields = append(fields, usagelog.FieldPromptTokens)
-	}
-	if m.completion_tokens != nil {
-		fields = append(fields, usagelog.FieldCompletionTokens)
-	}
-	if m.total_tokens != nil {
-		fields = append(fields, usagelog.FieldTotalTokens)
-	}
-	if m.prompt_audio_tokens != nil {
-		fields = append(fields, usagelog.FieldPromptAudioTokens)
-	}
-	if m.prompt_cached_tokens != nil {
-		fields = append(fields, usagelog.FieldPromptCachedTokens)
-	}
-	if m.completion_audio_tokens != nil {
-		fields = append(fields, usagelog.FieldCompletionAudioTokens)
-	}
-	if m.completion_reasoning_tokens != nil {
-		fields = append(fields, usagelog.FieldCompletionReasoningTokens)
-	}
-	if m.completion_accepted_prediction_tokens != nil {
-		fields = append(fields, usagelog.FieldCompletionAcceptedPredictionTokens)
-	}
-	if m.completion_rejected_prediction_tokens != nil {
-		fields = append(fields, usagelog.FieldCompletionRejectedPredictionTokens)
+		fields = append(fields, system.FieldDeletedAt)
 	}
-	if m.source != nil {
-		fields = append(fields, usagelog.FieldSource)
+	if m.key != nil {
+		fields = append(fields, system.FieldKey)
 	}
-	if m.format != nil {
-		fields = append(fields, usagelog.FieldFormat)
+	if m.value != nil {
+		fields = append(fields, system.FieldValue)
 	}
 	return fields
 }
@@ -7707,44 +8934,18 @@ func (m *UsageLogMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *UsageLogMutation) Field(name string) (ent.Value, bool) {
+func (m *SystemMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case usagelog.FieldCreatedAt:
+	case system.FieldCreatedAt:
 		return m.CreatedAt()
-	case usagelog.FieldUpdatedAt:
+	case system.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case usagelog.FieldDeletedAt:
+	case system.FieldDeletedAt:
 		return m.DeletedAt()
-	case usagelog.FieldUserID:
-		return m.UserID()
-	case usagelog.FieldRequestID:
-		return m.RequestID()
-	case usagelog.FieldChannelID:
-		return m.ChannelID()
-	case usagelog.FieldModelID:
-		return m.ModelID()
-	case usagelog.FieldPromptTokens:
-		return m.PromptTokens()
-	case usagelog.FieldCompletionTokens:
-		return m.CompletionTokens()
-	case usagelog.FieldTotalTokens:
-		return m.TotalTokens()
-	case usagelog.FieldPromptAudioTokens:
-		return m.PromptAudioTokens()
-	case usagelog.FieldPromptCachedTokens:
-		return m.PromptCachedTokens()
-	case usagelog.FieldCompletionAudioTokens:
-		return m.CompletionAudioTokens()
-	case usagelog.FieldCompletionReasoningTokens:
-		return m.CompletionReasoningTokens()
-	case usagelog.FieldCompletionAcceptedPredictionTokens:
-		return m.CompletionAcceptedPredictionTokens()
-	case usagelog.FieldCompletionRejectedPredictionTokens:
-		return m.CompletionRejectedPredictionTokens()
-	case usagelog.FieldSource:
-		return m.Source()
-	case usagelog.FieldFormat:
-		return m.Format()
+	case system.FieldKey:
+		return m.Key()
+	case system.FieldValue:
+		return m.Value()
 	}
 	return nil, false
 }
@@ -7752,216 +8953,72 @@ func (m *UsageLogMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *UsageLogMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SystemMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case usagelog.FieldCreatedAt:
+	case system.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case usagelog.FieldUpdatedAt:
+	case system.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case usagelog.FieldDeletedAt:
+	case system.FieldDeletedAt:
 		return m.OldDeletedAt(ctx)
-	case usagelog.FieldUserID:
-		return m.OldUserID(ctx)
-	case usagelog.FieldRequestID:
-		return m.OldRequestID(ctx)
-	case usagelog.FieldChannelID:
-		return m.OldChannelID(ctx)
-	case usagelog.FieldModelID:
-		return m.OldModelID(ctx)
-	case usagelog.FieldPromptTokens:
-		return m.OldPromptTokens(ctx)
-	case usagelog.FieldCompletionTokens:
-		return m.OldCompletionTokens(ctx)
-	case usagelog.FieldTotalTokens:
-		return m.OldTotalTokens(ctx)
-	case usagelog.FieldPromptAudioTokens:
-		return m.OldPromptAudioTokens(ctx)
-	case usagelog.FieldPromptCachedTokens:
-		return m.OldPromptCachedTokens(ctx)
-	case usagelog.FieldCompletionAudioTokens:
-		return m.OldCompletionAudioTokens(ctx)
-	case usagelog.FieldCompletionReasoningTokens:
-		return m.OldCompletionReasoningTokens(ctx)
-	case usagelog.FieldCompletionAcceptedPredictionTokens:
-		return m.OldCompletionAcceptedPredictionTokens(ctx)
-	case usagelog.FieldCompletionRejectedPredictionTokens:
-		return m.OldCompletionRejectedPredictionTokens(ctx)
-	case usagelog.FieldSource:
-		return m.OldSource(ctx)
-	case usagelog.FieldFormat:
-		return m.OldFormat(ctx)
+	case system.FieldKey:
+		return m.OldKey(ctx)
+	case system.FieldValue:
+		return m.OldValue(ctx)
 	}
-	return nil, fmt.Errorf("unknown UsageLog field %s", name)
+	return nil, fmt.Errorf("unknown System field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UsageLogMutation) SetField(name string, value ent.Value) error {
+func (m *SystemMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case usagelog.FieldCreatedAt:
+	case system.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case usagelog.FieldUpdatedAt:
+	case system.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case usagelog.FieldDeletedAt:
+	case system.FieldDeletedAt:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetDeletedAt(v)
 		return nil
-	case usagelog.FieldUserID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUserID(v)
-		return nil
-	case usagelog.FieldRequestID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRequestID(v)
-		return nil
-	case usagelog.FieldChannelID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetChannelID(v)
-		return nil
-	case usagelog.FieldModelID:
+	case system.FieldKey:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetModelID(v)
-		return nil
-	case usagelog.FieldPromptTokens:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPromptTokens(v)
-		return nil
-	case usagelog.FieldCompletionTokens:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCompletionTokens(v)
-		return nil
-	case usagelog.FieldTotalTokens:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTotalTokens(v)
-		return nil
-	case usagelog.FieldPromptAudioTokens:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPromptAudioTokens(v)
-		return nil
-	case usagelog.FieldPromptCachedTokens:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPromptCachedTokens(v)
-		return nil
-	case usagelog.FieldCompletionAudioTokens:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCompletionAudioTokens(v)
-		return nil
-	case usagelog.FieldCompletionReasoningTokens:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCompletionReasoningTokens(v)
-		return nil
-	case usagelog.FieldCompletionAcceptedPredictionTokens:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCompletionAcceptedPredictionTokens(v)
-		return nil
-	case usagelog.FieldCompletionRejectedPredictionTokens:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCompletionRejectedPredictionTokens(v)
-		return nil
-	case usagelog.FieldSource:
-		v, ok := value.(usagelog.Source)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSource(v)
+		m.SetKey(v)
 		return nil
-	case usagelog.FieldFormat:
+	case system.FieldValue:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFormat(v)
+		m.SetValue(v)
 		return nil
 	}
-	return fmt.Errorf("unknown UsageLog field %s", name)
+	return fmt.Errorf("unknown System field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *UsageLogMutation) AddedFields() []string {
+func (m *SystemMutation) AddedFields() []string {
 	var fields []string
 	if m.adddeleted_at != nil {
-		fields = append(fields, usagelog.FieldDeletedAt)
-	}
-	if m.addprompt_tokens != nil {
-		fields = append(fields, usagelog.FieldPromptTokens)
-	}
-	if m.addcompletion_tokens != nil {
-		fields = append(fields, usagelog.FieldCompletionTokens)
-	}
-	if m.addtotal_tokens != nil {
-		fields = append(fields, usagelog.FieldTotalTokens)
-	}
-	if m.addprompt_audio_tokens != nil {
-		fields = append(fields, usagelog.FieldPromptAudioTokens)
-	}
-	if m.addprompt_cached_tokens != nil {
-		fields = append(fields, usagelog.FieldPromptCachedTokens)
-	}
-	if m.addcompletion_audio_tokens != nil {
-		fields = append(fields, usagelog.FieldCompletionAudioTokens)
-	}
-	if m.addcompletion_reasoning_tokens != nil {
-		fields = append(fields, usagelog.FieldCompletionReasoningTokens)
-	}
-	if m.addcompletion_accepted_prediction_tokens != nil {
-		fields = append(fields, usagelog.FieldCompletionAcceptedPredictionTokens)
-	}
-	if m.addcompletion_rejected_prediction_tokens != nil {
-		fields = append(fields, usagelog.FieldCompletionRejectedPredictionTokens)
+		fields = append(fields, system.FieldDeletedAt)
 	}
 	return fields
 }
@@ -7969,28 +9026,10 @@ func (m *UsageLogMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *UsageLogMutation) AddedField(name string) (ent.Value, bool) {
+func (m *SystemMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case usagelog.FieldDeletedAt:
+	case system.FieldDeletedAt:
 		return m.AddedDeletedAt()
-	case usagelog.FieldPromptTokens:
-		return m.AddedPromptTokens()
-	case usagelog.FieldCompletionTokens:
-		return m.AddedCompletionTokens()
-	case usagelog.FieldTotalTokens:
-		return m.AddedTotalTokens()
-	case usagelog.FieldPromptAudioTokens:
-		return m.AddedPromptAudioTokens()
-	case usagelog.FieldPromptCachedTokens:
-		return m.AddedPromptCachedTokens()
-	case usagelog.FieldCompletionAudioTokens:
-		return m.AddedCompletionAudioTokens()
-	case usagelog.FieldCompletionReasoningTokens:
-		return m.AddedCompletionReasoningTokens()
-	case usagelog.FieldCompletionAcceptedPredictionTokens:
-		return m.AddedCompletionAcceptedPredictionTokens()
-	case usagelog.FieldCompletionRejectedPredictionTokens:
-		return m.AddedCompletionRejectedPredictionTokens()
 	}
 	return nil, false
 }
@@ -7998,367 +9037,166 @@ func (m *UsageLogMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UsageLogMutation) AddField(name string, value ent.Value) error {
+func (m *SystemMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case usagelog.FieldDeletedAt:
+	case system.FieldDeletedAt:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.AddDeletedAt(v)
 		return nil
-	case usagelog.FieldPromptTokens:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddPromptTokens(v)
-		return nil
-	case usagelog.FieldCompletionTokens:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddCompletionTokens(v)
-		return nil
-	case usagelog.FieldTotalTokens:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddTotalTokens(v)
-		return nil
-	case usagelog.FieldPromptAudioTokens:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddPromptAudioTokens(v)
-		return nil
-	case usagelog.FieldPromptCachedTokens:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddPromptCachedTokens(v)
-		return nil
-	case usagelog.FieldCompletionAudioTokens:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddCompletionAudioTokens(v)
-		return nil
-	case usagelog.FieldCompletionReasoningTokens:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddCompletionReasoningTokens(v)
-		return nil
-	case usagelog.FieldCompletionAcceptedPredictionTokens:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddCompletionAcceptedPredictionTokens(v)
-		return nil
-	case usagelog.FieldCompletionRejectedPredictionTokens:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddCompletionRejectedPredictionTokens(v)
-		return nil
 	}
-	return fmt.Errorf("unknown UsageLog numeric field %s", name)
+	return fmt.Errorf("unknown System numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *UsageLogMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(usagelog.FieldChannelID) {
-		fields = append(fields, usagelog.FieldChannelID)
-	}
-	if m.FieldCleared(usagelog.FieldPromptAudioTokens) {
-		fields = append(fields, usagelog.FieldPromptAudioTokens)
-	}
-	if m.FieldCleared(usagelog.FieldPromptCachedTokens) {
-		fields = append(fields, usagelog.FieldPromptCachedTokens)
-	}
-	if m.FieldCleared(usagelog.FieldCompletionAudioTokens) {
-		fields = append(fields, usagelog.FieldCompletionAudioTokens)
-	}
-	if m.FieldCleared(usagelog.FieldCompletionReasoningTokens) {
-		fields = append(fields, usagelog.FieldCompletionReasoningTokens)
-	}
-	if m.FieldCleared(usagelog.FieldCompletionAcceptedPredictionTokens) {
-		fields = append(fields, usagelog.FieldCompletionAcceptedPredictionTokens)
-	}
-	if m.FieldCleared(usagelog.FieldCompletionRejectedPredictionTokens) {
-		fields = append(fields, usagelog.FieldCompletionRejectedPredictionTokens)
-	}
-	return fields
+func (m *SystemMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *UsageLogMutation) FieldCleared(name string) bool {
+func (m *SystemMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *UsageLogMutation) ClearField(name string) error {
-	switch name {
-	case usagelog.FieldChannelID:
-		m.ClearChannelID()
-		return nil
-	case usagelog.FieldPromptAudioTokens:
-		m.ClearPromptAudioTokens()
-		return nil
-	case usagelog.FieldPromptCachedTokens:
-		m.ClearPromptCachedTokens()
-		return nil
-	case usagelog.FieldCompletionAudioTokens:
-		m.ClearCompletionAudioTokens()
-		return nil
-	case usagelog.FieldCompletionReasoningTokens:
-		m.ClearCompletionReasoningTokens()
-		return nil
-	case usagelog.FieldCompletionAcceptedPredictionTokens:
-		m.ClearCompletionAcceptedPredictionTokens()
-		return nil
-	case usagelog.FieldCompletionRejectedPredictionTokens:
-		m.ClearCompletionRejectedPredictionTokens()
-		return nil
-	}
-	return fmt.Errorf("unknown UsageLog nullable field %s", name)
+func (m *SystemMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown System nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *UsageLogMutation) ResetField(name string) error {
+func (m *SystemMutation) ResetField(name string) error {
 	switch name {
-	case usagelog.FieldCreatedAt:
+	case system.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case usagelog.FieldUpdatedAt:
+	case system.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case usagelog.FieldDeletedAt:
+	case system.FieldDeletedAt:
 		m.ResetDeletedAt()
 		return nil
-	case usagelog.FieldUserID:
-		m.ResetUserID()
-		return nil
-	case usagelog.FieldRequestID:
-		m.ResetRequestID()
-		return nil
-	case usagelog.FieldChannelID:
-		m.ResetChannelID()
-		return nil
-	case usagelog.FieldModelID:
-		m.ResetModelID()
-		return nil
-	case usagelog.FieldPromptTokens:
-		m.ResetPromptTokens()
-		return nil
-	case usagelog.FieldCompletionTokens:
-		m.ResetCompletionTokens()
-		return nil
-	case usagelog.FieldTotalTokens:
-		m.ResetTotalTokens()
-		return nil
-	case usagelog.FieldPromptAudioTokens:
-		m.ResetPromptAudioTokens()
-		return nil
-	case usagelog.FieldPromptCachedTokens:
-		m.ResetPromptCachedTokens()
-		return nil
-	case usagelog.FieldCompletionAudioTokens:
-		m.ResetCompletionAudioTokens()
-		return nil
-	case usagelog.FieldCompletionReasoningTokens:
-		m.ResetCompletionReasoningTokens()
-		return nil
-	case usagelog.FieldCompletionAcceptedPredictionTokens:
-		m.ResetCompletionAcceptedPredictionTokens()
-		return nil
-	case usagelog.FieldCompletionRejectedPredictionTokens:
-		m.ResetCompletionRejectedPredictionTokens()
-		return nil
-	case usagelog.FieldSource:
-		m.ResetSource()
+	case system.FieldKey:
+		m.ResetKey()
 		return nil
-	case usagelog.FieldFormat:
-		m.ResetFormat()
+	case system.FieldValue:
+		m.ResetValue()
 		return nil
 	}
-	return fmt.Errorf("unknown UsageLog field %s", name)
+	return fmt.Errorf("unknown System field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *UsageLogMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.user != nil {
-		edges = append(edges, usagelog.EdgeUser)
-	}
-	if m.request != nil {
-		edges = append(edges, usagelog.EdgeRequest)
-	}
-	if m.channel != nil {
-		edges = append(edges, usagelog.EdgeChannel)
-	}
+func (m *SystemMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *UsageLogMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case usagelog.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
-		}
-	case usagelog.EdgeRequest:
-		if id := m.request; id != nil {
-			return []ent.Value{*id}
-		}
-	case usagelog.EdgeChannel:
-		if id := m.channel; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *SystemMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *UsageLogMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
+func (m *SystemMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *UsageLogMutation) RemovedIDs(name string) []ent.Value {
+func (m *SystemMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *UsageLogMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.cleareduser {
-		edges = append(edges, usagelog.EdgeUser)
-	}
-	if m.clearedrequest {
-		edges = append(edges, usagelog.EdgeRequest)
-	}
-	if m.clearedchannel {
-		edges = append(edges, usagelog.EdgeChannel)
-	}
+func (m *SystemMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *UsageLogMutation) EdgeCleared(name string) bool {
-	switch name {
-	case usagelog.EdgeUser:
-		return m.cleareduser
-	case usagelog.EdgeRequest:
-		return m.clearedrequest
-	case usagelog.EdgeChannel:
-		return m.clearedchannel
-	}
+func (m *SystemMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *UsageLogMutation) ClearEdge(name string) error {
-	switch name {
-	case usagelog.EdgeUser:
-		m.ClearUser()
-		return nil
-	case usagelog.EdgeRequest:
-		m.ClearRequest()
-		return nil
-	case usagelog.EdgeChannel:
-		m.ClearChannel()
-		return nil
-	}
-	return fmt.Errorf("unknown UsageLog unique edge %s", name)
+func (m *SystemMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown System unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *UsageLogMutation) ResetEdge(name string) error {
-	switch name {
-	case usagelog.EdgeUser:
-		m.ResetUser()
-		return nil
-	case usagelog.EdgeRequest:
-		m.ResetRequest()
-		return nil
-	case usagelog.EdgeChannel:
-		m.ResetChannel()
-		return nil
-	}
-	return fmt.Errorf("unknown UsageLog edge %s", name)
+func (m *SystemMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown System edge %s", name)
 }
 
-// UserMutation represents an operation that mutates the User nodes in the graph.
-type UserMutation struct {
+// UsageLogMutation represents an operation that mutates the UsageLog nodes in the graph.
+type UsageLogMutation struct {
 	config
-	op                Op
-	typ               string
-	id                *int
-	created_at        *time.Time
-	updated_at        *time.Time
-	deleted_at        *int
-	adddeleted_at     *int
-	email             *string
-	status            *user.Status
-	prefer_language   *string
-	password          *string
-	first_name        *string
-	last_name         *string
-	avatar            *string
-	is_owner          *bool
-	scopes            *[]string
-	appendscopes      []string
-	clearedFields     map[string]struct{}
-	requests          map[int]struct{}
-	removedrequests   map[int]struct{}
-	clearedrequests   bool
-	api_keys          map[int]struct{}
-	removedapi_keys   map[int]struct{}
-	clearedapi_keys   bool
-	roles             map[int]struct{}
-	removedroles      map[int]struct{}
-	clearedroles      bool
-	usage_logs        map[int]struct{}
-	removedusage_logs map[int]struct{}
-	clearedusage_logs bool
-	done              bool
-	oldValue          func(context.Context) (*User, error)
-	predicates        []predicate.User
+	op                                       Op
+	typ                                      string
+	id                                       *int
+	created_at                               *time.Time
+	updated_at                               *time.Time
+	deleted_at                               *int
+	adddeleted_at                            *int
+	model_id                                 *string
+	prompt_tokens                            *int
+	addprompt_tokens                         *int
+	completion_tokens                        *int
+	addcompletion_tokens                     *int
+	total_tokens                             *int
+	addtotal_tokens                          *int
+	prompt_audio_tokens                      *int
+	addprompt_audio_tokens                   *int
+	prompt_cached_tokens                     *int
+	addprompt_cached_tokens                  *int
+	prompt_cache_creation_tokens             *int
+	addprompt_cache_creation_tokens          *int
+	completion_audio_tokens                  *int
+	addcompletion_audio_tokens               *int
+	completion_reasoning_tokens              *int
+	addcompletion_reasoning_tokens           *int
+	completion_accepted_prediction_tokens    *int
+	addcompletion_accepted_prediction_tokens *int
+	completion_rejected_prediction_tokens    *int
+	addcompletion_rejected_prediction_tokens *int
+	estimated                                *bool
+	source                                   *usagelog.Source
+	format                                   *string
+	clearedFields                            map[string]struct{}
+	user                                     *int
+	cleareduser                              bool
+	request                                  *int
+	clearedrequest                           bool
+	channel                                  *int
+	clearedchannel                           bool
+	done                                     bool
+	oldValue                                 func(context.Context) (*UsageLog, error)
+	predicates                               []predicate.UsageLog
 }
 
-var _ ent.Mutation = (*UserMutation)(nil)
+var _ ent.Mutation = (*UsageLogMutation)(nil)
 
-// userOption allows management of the mutation configuration using functional options.
-type userOption func(*UserMutation)
+// usagelogOption allows management of the mutation configuration using functional options.
+type usagelogOption func(*UsageLogMutation)
 
-// newUserMutation creates new mutation for the User entity.
-func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
-	m := &UserMutation{
+// newUsageLogMutation creates new mutation for the UsageLog entity.
+func newUsageLogMutation(c config, op Op, opts ...usagelogOption) *UsageLogMutation {
+	m := &UsageLogMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeUser,
+		typ:           TypeUsageLog,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -8367,20 +9205,20 @@ func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
 	return m
 }
 
-// withUserID sets the ID field of the mutation.
-func withUserID(id int) userOption {
-	return func(m *UserMutation) {
+// withUsageLogID sets the ID field of the mutation.
+func withUsageLogID(id int) usagelogOption {
+	return func(m *UsageLogMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *User
+			value *UsageLog
 		)
-		m.oldValue = func(ctx context.Context) (*User, error) {
+		m.oldValue = func(ctx context.Context) (*UsageLog, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().User.Get(ctx, id)
+					value, err = m.Client().UsageLog.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -8389,10 +9227,10 @@ func withUserID(id int) userOption {
 	}
 }
 
-// withUser sets the old User of the mutation.
-func withUser(node *User) userOption {
-	return func(m *UserMutation) {
-		m.oldValue = func(context.Context) (*User, error) {
+// withUsageLog sets the old UsageLog of the mutation.
+func withUsageLog(node *UsageLog) usagelogOption {
+	return func(m *UsageLogMutation) {
+		m.oldValue = func(context.Context) (*UsageLog, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -8401,7 +9239,7 @@ func withUser(node *User) userOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserMutation) Client() *Client {
+func (m UsageLogMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -8409,7 +9247,7 @@ func (m UserMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m UserMutation) Tx() (*Tx, error) {
+func (m UsageLogMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -8420,7 +9258,7 @@ func (m UserMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *UserMutation) ID() (id int, exists bool) {
+func (m *UsageLogMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -8431,7 +9269,7 @@ func (m *UserMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *UsageLogMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -8440,19 +9278,19 @@ func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().UsageLog.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *UserMutation) SetCreatedAt(t time.Time) {
+func (m *UsageLogMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *UsageLogMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -8460,10 +9298,10 @@ func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the UsageLog entity.
+// If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UsageLogMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -8478,17 +9316,17 @@ func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *UserMutation) ResetCreatedAt() {
+func (m *UsageLogMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *UserMutation) SetUpdatedAt(t time.Time) {
+func (m *UsageLogMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *UsageLogMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -8496,10 +9334,10 @@ func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the UsageLog entity.
+// If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UsageLogMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -8514,18 +9352,18 @@ func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *UserMutation) ResetUpdatedAt() {
+func (m *UsageLogMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
 // SetDeletedAt sets the "deleted_at" field.
-func (m *UserMutation) SetDeletedAt(i int) {
+func (m *UsageLogMutation) SetDeletedAt(i int) {
 	m.deleted_at = &i
 	m.adddeleted_at = nil
 }
 
 // DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *UserMutation) DeletedAt() (r int, exists bool) {
+func (m *UsageLogMutation) DeletedAt() (r int, exists bool) {
 	v := m.deleted_at
 	if v == nil {
 		return
@@ -8533,10 +9371,10 @@ func (m *UserMutation) DeletedAt() (r int, exists bool) {
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldDeletedAt returns the old "deleted_at" field's value of the UsageLog entity.
+// If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldDeletedAt(ctx context.Context) (v int, err error) {
+func (m *UsageLogMutation) OldDeletedAt(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
@@ -8551,7 +9389,7 @@ func (m *UserMutation) OldDeletedAt(ctx context.Context) (v int, err error) {
 }
 
 // AddDeletedAt adds i to the "deleted_at" field.
-func (m *UserMutation) AddDeletedAt(i int) {
+func (m *UsageLogMutation) AddDeletedAt(i int) {
 	if m.adddeleted_at != nil {
 		*m.adddeleted_at += i
 	} else {
@@ -8560,7 +9398,7 @@ func (m *UserMutation) AddDeletedAt(i int) {
 }
 
 // AddedDeletedAt returns the value that was added to the "deleted_at" field in this mutation.
-func (m *UserMutation) AddedDeletedAt() (r int, exists bool) {
+func (m *UsageLogMutation) AddedDeletedAt() (r int, exists bool) {
 	v := m.adddeleted_at
 	if v == nil {
 		return
@@ -8569,602 +9407,3549 @@ func (m *UserMutation) AddedDeletedAt() (r int, exists bool) {
 }
 
 // ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *UserMutation) ResetDeletedAt() {
+func (m *UsageLogMutation) ResetDeletedAt() {
 	m.deleted_at = nil
 	m.adddeleted_at = nil
 }
 
-// SetEmail sets the "email" field.
-func (m *UserMutation) SetEmail(s string) {
-	m.email = &s
+// SetUserID sets the "user_id" field.
+func (m *UsageLogMutation) SetUserID(i int) {
+	m.user = &i
 }
 
-// Email returns the value of the "email" field in the mutation.
-func (m *UserMutation) Email() (r string, exists bool) {
-	v := m.email
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *UsageLogMutation) UserID() (r int, exists bool) {
+	v := m.user
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEmail returns the old "email" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the UsageLog entity.
+// If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
+func (m *UsageLogMutation) OldUserID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmail requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.Email, nil
+	return oldValue.UserID, nil
 }
 
-// ResetEmail resets all changes to the "email" field.
-func (m *UserMutation) ResetEmail() {
-	m.email = nil
+// ResetUserID resets all changes to the "user_id" field.
+func (m *UsageLogMutation) ResetUserID() {
+	m.user = nil
 }
 
-// SetStatus sets the "status" field.
-func (m *UserMutation) SetStatus(u user.Status) {
-	m.status = &u
+// SetRequestID sets the "request_id" field.
+func (m *UsageLogMutation) SetRequestID(i int) {
+	m.request = &i
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *UserMutation) Status() (r user.Status, exists bool) {
-	v := m.status
+// RequestID returns the value of the "request_id" field in the mutation.
+func (m *UsageLogMutation) RequestID() (r int, exists bool) {
+	v := m.request
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldRequestID returns the old "request_id" field's value of the UsageLog entity.
+// If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldStatus(ctx context.Context) (v user.Status, err error) {
+func (m *UsageLogMutation) OldRequestID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldRequestID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldRequestID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldRequestID: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.RequestID, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *UserMutation) ResetStatus() {
-	m.status = nil
+// ResetRequestID resets all changes to the "request_id" field.
+func (m *UsageLogMutation) ResetRequestID() {
+	m.request = nil
 }
 
-// SetPreferLanguage sets the "prefer_language" field.
-func (m *UserMutation) SetPreferLanguage(s string) {
-	m.prefer_language = &s
+// SetChannelID sets the "channel_id" field.
+func (m *UsageLogMutation) SetChannelID(i int) {
+	m.channel = &i
 }
 
-// PreferLanguage returns the value of the "prefer_language" field in the mutation.
-func (m *UserMutation) PreferLanguage() (r string, exists bool) {
-	v := m.prefer_language
+// ChannelID returns the value of the "channel_id" field in the mutation.
+func (m *UsageLogMutation) ChannelID() (r int, exists bool) {
+	v := m.channel
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPreferLanguage returns the old "prefer_language" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldChannelID returns the old "channel_id" field's value of the UsageLog entity.
+// If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldPreferLanguage(ctx context.Context) (v string, err error) {
+func (m *UsageLogMutation) OldChannelID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPreferLanguage is only allowed on UpdateOne operations")
+		return v, errors.New("OldChannelID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPreferLanguage requires an ID field in the mutation")
+		return v, errors.New("OldChannelID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPreferLanguage: %w", err)
+		return v, fmt.Errorf("querying old value for OldChannelID: %w", err)
 	}
-	return oldValue.PreferLanguage, nil
+	return oldValue.ChannelID, nil
 }
 
-// ResetPreferLanguage resets all changes to the "prefer_language" field.
-func (m *UserMutation) ResetPreferLanguage() {
-	m.prefer_language = nil
+// ClearChannelID clears the value of the "channel_id" field.
+func (m *UsageLogMutation) ClearChannelID() {
+	m.channel = nil
+	m.clearedFields[usagelog.FieldChannelID] = struct{}{}
 }
 
-// SetPassword sets the "password" field.
-func (m *UserMutation) SetPassword(s string) {
-	m.password = &s
+// ChannelIDCleared returns if the "channel_id" field was cleared in this mutation.
+func (m *UsageLogMutation) ChannelIDCleared() bool {
+	_, ok := m.clearedFields[usagelog.FieldChannelID]
+	return ok
 }
 
-// Password returns the value of the "password" field in the mutation.
-func (m *UserMutation) Password() (r string, exists bool) {
-	v := m.password
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetChannelID resets all changes to the "channel_id" field.
+func (m *UsageLogMutation) ResetChannelID() {
+	m.channel = nil
+	delete(m.clearedFields, usagelog.FieldChannelID)
 }
 
-// OldPassword returns the old "password" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// SetModelID sets the "model_id" field.
+func (m *UsageLogMutation) SetModelID(s string) {
+	m.model_id = &s
+}
+
+// ModelID returns the value of the "model_id" field in the mutation.
+func (m *UsageLogMutation) ModelID() (r string, exists bool) {
+	v := m.model_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModelID returns the old "model_id" field's value of the UsageLog entity.
+// If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldPassword(ctx context.Context) (v string, err error) {
+func (m *UsageLogMutation) OldModelID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
+		return v, errors.New("OldModelID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPassword requires an ID field in the mutation")
+		return v, errors.New("OldModelID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
+		return v, fmt.Errorf("querying old value for OldModelID: %w", err)
 	}
-	return oldValue.Password, nil
+	return oldValue.ModelID, nil
 }
 
-// ResetPassword resets all changes to the "password" field.
-func (m *UserMutation) ResetPassword() {
-	m.password = nil
+// ResetModelID resets all changes to the "model_id" field.
+func (m *UsageLogMutation) ResetModelID() {
+	m.model_id = nil
 }
 
-// SetFirstName sets the "first_name" field.
-func (m *UserMutation) SetFirstName(s string) {
-	m.first_name = &s
+// SetPromptTokens sets the "prompt_tokens" field.
+func (m *UsageLogMutation) SetPromptTokens(i int) {
+	m.prompt_tokens = &i
+	m.addprompt_tokens = nil
 }
 
-// FirstName returns the value of the "first_name" field in the mutation.
-func (m *UserMutation) FirstName() (r string, exists bool) {
-	v := m.first_name
+// PromptTokens returns the value of the "prompt_tokens" field in the mutation.
+func (m *UsageLogMutation) PromptTokens() (r int, exists bool) {
+	v := m.prompt_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPromptTokens returns the old "prompt_tokens" field's value of the UsageLog entity.
+// If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsageLogMutation) OldPromptTokens(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPromptTokens is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPromptTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPromptTokens: %w", err)
+	}
+	return oldValue.PromptTokens, nil
+}
+
+// AddPromptTokens adds i to the "prompt_tokens" field.
+func (m *UsageLogMutation) AddPromptTokens(i int) {
+	if m.addprompt_tokens != nil {
+		*m.addprompt_tokens += i
+	} else {
+		m.addprompt_tokens = &i
+	}
+}
+
+// AddedPromptTokens returns the value that was added to the "prompt_tokens" field in this mutation.
+func (m *UsageLogMutation) AddedPromptTokens() (r int, exists bool) {
+	v := m.addprompt_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPromptTokens resets all changes to the "prompt_tokens" field.
+func (m *UsageLogMutation) ResetPromptTokens() {
+	m.prompt_tokens = nil
+	m.addprompt_tokens = nil
+}
+
+// SetCompletionTokens sets the "completion_tokens" field.
+func (m *UsageLogMutation) SetCompletionTokens(i int) {
+	m.completion_tokens = &i
+	m.addcompletion_tokens = nil
+}
+
+// CompletionTokens returns the value of the "completion_tokens" field in the mutation.
+func (m *UsageLogMutation) CompletionTokens() (r int, exists bool) {
+	v := m.completion_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompletionTokens returns the old "completion_tokens" field's value of the UsageLog entity.
+// If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsageLogMutation) OldCompletionTokens(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompletionTokens is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompletionTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompletionTokens: %w", err)
+	}
+	return oldValue.CompletionTokens, nil
+}
+
+// AddCompletionTokens adds i to the "completion_tokens" field.
+func (m *UsageLogMutation) AddCompletionTokens(i int) {
+	if m.addcompletion_tokens != nil {
+		*m.addcompletion_tokens += i
+	} else {
+		m.addcompletion_tokens = &i
+	}
+}
+
+// AddedCompletionTokens returns the value that was added to the "completion_tokens" field in this mutation.
+func (m *UsageLogMutation) AddedCompletionTokens() (r int, exists bool) {
+	v := m.addcompletion_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCompletionTokens resets all changes to the "completion_tokens" field.
+func (m *UsageLogMutation) ResetCompletionTokens() {
+	m.completion_tokens = nil
+	m.addcompletion_tokens = nil
+}
+
+// SetTotalTokens sets the "total_tokens" field.
+func (m *UsageLogMutation) SetTotalTokens(i int) {
+	m.total_tokens = &i
+	m.addtotal_tokens = nil
+}
+
+// TotalTokens returns the value of the "total_tokens" field in the mutation.
+func (m *UsageLogMutation) TotalTokens() (r int, exists bool) {
+	v := m.total_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotalTokens returns the old "total_tokens" field's value of the UsageLog entity.
+// If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsageLogMutation) OldTotalTokens(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotalTokens is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotalTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalTokens: %w", err)
+	}
+	return oldValue.TotalTokens, nil
+}
+
+// AddTotalTokens adds i to the "total_tokens" field.
+func (m *UsageLogMutation) AddTotalTokens(i int) {
+	if m.addtotal_tokens != nil {
+		*m.addtotal_tokens += i
+	} else {
+		m.addtotal_tokens = &i
+	}
+}
+
+// AddedTotalTokens returns the value that was added to the "total_tokens" field in this mutation.
+func (m *UsageLogMutation) AddedTotalTokens() (r int, exists bool) {
+	v := m.addtotal_tokens
 	if v == nil {
 		return
 	}
-	return *v, true
+	return *v, true
+}
+
+// ResetTotalTokens resets all changes to the "total_tokens" field.
+func (m *UsageLogMutation) ResetTotalTokens() {
+	m.total_tokens = nil
+	m.addtotal_tokens = nil
+}
+
+// SetPromptAudioTokens sets the "prompt_audio_tokens" field.
+func (m *UsageLogMutation) SetPromptAudioTokens(i int) {
+	m.prompt_audio_tokens = &i
+	m.addprompt_audio_tokens = nil
+}
+
+// PromptAudioTokens returns the value of the "prompt_audio_tokens" field in the mutation.
+func (m *UsageLogMutation) PromptAudioTokens() (r int, exists bool) {
+	v := m.prompt_audio_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPromptAudioTokens returns the old "prompt_audio_tokens" field's value of the UsageLog entity.
+// If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsageLogMutation) OldPromptAudioTokens(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPromptAudioTokens is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPromptAudioTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPromptAudioTokens: %w", err)
+	}
+	return oldValue.PromptAudioTokens, nil
+}
+
+// AddPromptAudioTokens adds i to the "prompt_audio_tokens" field.
+func (m *UsageLogMutation) AddPromptAudioTokens(i int) {
+	if m.addprompt_audio_tokens != nil {
+		*m.addprompt_audio_tokens += i
+	} else {
+		m.addprompt_audio_tokens = &i
+	}
+}
+
+// AddedPromptAudioTokens returns the value that was added to the "prompt_audio_tokens" field in this mutation.
+func (m *UsageLogMutation) AddedPromptAudioTokens() (r int, exists bool) {
+	v := m.addprompt_audio_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearPromptAudioTokens clears the value of the "prompt_audio_tokens" field.
+func (m *UsageLogMutation) ClearPromptAudioTokens() {
+	m.prompt_audio_tokens = nil
+	m.addprompt_audio_tokens = nil
+	m.clearedFields[usagelog.FieldPromptAudioTokens] = struct{}{}
+}
+
+// PromptAudioTokensCleared returns if the "prompt_audio_tokens" field was cleared in this mutation.
+func (m *UsageLogMutation) PromptAudioTokensCleared() bool {
+	_, ok := m.clearedFields[usagelog.FieldPromptAudioTokens]
+	return ok
+}
+
+// ResetPromptAudioTokens resets all changes to the "prompt_audio_tokens" field.
+func (m *UsageLogMutation) ResetPromptAudioTokens() {
+	m.prompt_audio_tokens = nil
+	m.addprompt_audio_tokens = nil
+	delete(m.clearedFields, usagelog.FieldPromptAudioTokens)
+}
+
+// SetPromptCachedTokens sets the "prompt_cached_tokens" field.
+func (m *UsageLogMutation) SetPromptCachedTokens(i int) {
+	m.prompt_cached_tokens = &i
+	m.addprompt_cached_tokens = nil
+}
+
+// PromptCachedTokens returns the value of the "prompt_cached_tokens" field in the mutation.
+func (m *UsageLogMutation) PromptCachedTokens() (r int, exists bool) {
+	v := m.prompt_cached_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPromptCachedTokens returns the old "prompt_cached_tokens" field's value of the UsageLog entity.
+// If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsageLogMutation) OldPromptCachedTokens(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPromptCachedTokens is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPromptCachedTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPromptCachedTokens: %w", err)
+	}
+	return oldValue.PromptCachedTokens, nil
+}
+
+// AddPromptCachedTokens adds i to the "prompt_cached_tokens" field.
+func (m *UsageLogMutation) AddPromptCachedTokens(i int) {
+	if m.addprompt_cached_tokens != nil {
+		*m.addprompt_cached_tokens += i
+	} else {
+		m.addprompt_cached_tokens = &i
+	}
+}
+
+// AddedPromptCachedTokens returns the value that was added to the "prompt_cached_tokens" field in this mutation.
+func (m *UsageLogMutation) AddedPromptCachedTokens() (r int, exists bool) {
+	v := m.addprompt_cached_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearPromptCachedTokens clears the value of the "prompt_cached_tokens" field.
+func (m *UsageLogMutation) ClearPromptCachedTokens() {
+	m.prompt_cached_tokens = nil
+	m.addprompt_cached_tokens = nil
+	m.clearedFields[usagelog.FieldPromptCachedTokens] = struct{}{}
+}
+
+// PromptCachedTokensCleared returns if the "prompt_cached_tokens" field was cleared in this mutation.
+func (m *UsageLogMutation) PromptCachedTokensCleared() bool {
+	_, ok := m.clearedFields[usagelog.FieldPromptCachedTokens]
+	return ok
+}
+
+// ResetPromptCachedTokens resets all changes to the "prompt_cached_tokens" field.
+func (m *UsageLogMutation) ResetPromptCachedTokens() {
+	m.prompt_cached_tokens = nil
+	m.addprompt_cached_tokens = nil
+	delete(m.clearedFields, usagelog.FieldPromptCachedTokens)
+}
+
+// SetPromptCacheCreationTokens sets the "prompt_cache_creation_tokens" field.
+func (m *UsageLogMutation) SetPromptCacheCreationTokens(i int) {
+	m.prompt_cache_creation_tokens = &i
+	m.addprompt_cache_creation_tokens = nil
+}
+
+// PromptCacheCreationTokens returns the value of the "prompt_cache_creation_tokens" field in the mutation.
+func (m *UsageLogMutation) PromptCacheCreationTokens() (r int, exists bool) {
+	v := m.prompt_cache_creation_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPromptCacheCreationTokens returns the old "prompt_cache_creation_tokens" field's value of the UsageLog entity.
+// If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsageLogMutation) OldPromptCacheCreationTokens(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPromptCacheCreationTokens is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPromptCacheCreationTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPromptCacheCreationTokens: %w", err)
+	}
+	return oldValue.PromptCacheCreationTokens, nil
+}
+
+// AddPromptCacheCreationTokens adds i to the "prompt_cache_creation_tokens" field.
+func (m *UsageLogMutation) AddPromptCacheCreationTokens(i int) {
+	if m.addprompt_cache_creation_tokens != nil {
+		*m.addprompt_cache_creation_tokens += i
+	} else {
+		m.addprompt_cache_creation_tokens = &i
+	}
+}
+
+// AddedPromptCacheCreationTokens returns the value that was added to the "prompt_cache_creation_tokens" field in this mutation.
+func (m *UsageLogMutation) AddedPromptCacheCreationTokens() (r int, exists bool) {
+	v := m.addprompt_cache_creation_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearPromptCacheCreationTokens clears the value of the "prompt_cache_creation_tokens" field.
+func (m *UsageLogMutation) ClearPromptCacheCreationTokens() {
+	m.prompt_cache_creation_tokens = nil
+	m.addprompt_cache_creation_tokens = nil
+	m.clearedFields[usagelog.FieldPromptCacheCreationTokens] = struct{}{}
+}
+
+// PromptCacheCreationTokensCleared returns if the "prompt_cache_creation_tokens" field was cleared in this mutation.
+func (m *UsageLogMutation) PromptCacheCreationTokensCleared() bool {
+	_, ok := m.clearedFields[usagelog.FieldPromptCacheCreationTokens]
+	return ok
+}
+
+// ResetPromptCacheCreationTokens resets all changes to the "prompt_cache_creation_tokens" field.
+func (m *UsageLogMutation) ResetPromptCacheCreationTokens() {
+	m.prompt_cache_creation_tokens = nil
+	m.addprompt_cache_creation_tokens = nil
+	delete(m.clearedFields, usagelog.FieldPromptCacheCreationTokens)
+}
+
+// SetCompletionAudioTokens sets the "completion_audio_tokens" field.
+func (m *UsageLogMutation) SetCompletionAudioTokens(i int) {
+	m.completion_audio_tokens = &i
+	m.addcompletion_audio_tokens = nil
+}
+
+// CompletionAudioTokens returns the value of the "completion_audio_tokens" field in the mutation.
+func (m *UsageLogMutation) CompletionAudioTokens() (r int, exists bool) {
+	v := m.completion_audio_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompletionAudioTokens returns the old "completion_audio_tokens" field's value of the UsageLog entity.
+// If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsageLogMutation) OldCompletionAudioTokens(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompletionAudioTokens is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompletionAudioTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompletionAudioTokens: %w", err)
+	}
+	return oldValue.CompletionAudioTokens, nil
+}
+
+// AddCompletionAudioTokens adds i to the "completion_audio_tokens" field.
+func (m *UsageLogMutation) AddCompletionAudioTokens(i int) {
+	if m.addcompletion_audio_tokens != nil {
+		*m.addcompletion_audio_tokens += i
+	} else {
+		m.addcompletion_audio_tokens = &i
+	}
+}
+
+// AddedCompletionAudioTokens returns the value that was added to the "completion_audio_tokens" field in this mutation.
+func (m *UsageLogMutation) AddedCompletionAudioTokens() (r int, exists bool) {
+	v := m.addcompletion_audio_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCompletionAudioTokens clears the value of the "completion_audio_tokens" field.
+func (m *UsageLogMutation) ClearCompletionAudioTokens() {
+	m.completion_audio_tokens = nil
+	m.addcompletion_audio_tokens = nil
+	m.clearedFields[usagelog.FieldCompletionAudioTokens] = struct{}{}
+}
+
+// CompletionAudioTokensCleared returns if the "completion_audio_tokens" field was cleared in this mutation.
+func (m *UsageLogMutation) CompletionAudioTokensCleared() bool {
+	_, ok := m.clearedFields[usagelog.FieldCompletionAudioTokens]
+	return ok
+}
+
+// ResetCompletionAudioTokens resets all changes to the "completion_audio_tokens" field.
+func (m *UsageLogMutation) ResetCompletionAudioTokens() {
+	m.completion_audio_tokens = nil
+	m.addcompletion_audio_tokens = nil
+	delete(m.clearedFields, usagelog.FieldCompletionAudioTokens)
+}
+
+// SetCompletionReasoningTokens sets the "completion_reasoning_tokens" field.
+func (m *UsageLogMutation) SetCompletionReasoningTokens(i int) {
+	m.completion_reasoning_tokens = &i
+	m.addcompletion_reasoning_tokens = nil
+}
+
+// CompletionReasoningTokens returns the value of the "completion_reasoning_tokens" field in the mutation.
+func (m *UsageLogMutation) CompletionReasoningTokens() (r int, exists bool) {
+	v := m.completion_reasoning_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompletionReasoningTokens returns the old "completion_reasoning_tokens" field's value of the UsageLog entity.
+// If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsageLogMutation) OldCompletionReasoningTokens(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompletionReasoningTokens is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompletionReasoningTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompletionReasoningTokens: %w", err)
+	}
+	return oldValue.CompletionReasoningTokens, nil
+}
+
+// AddCompletionReasoningTokens adds i to the "completion_reasoning_tokens" field.
+func (m *UsageLogMutation) AddCompletionReasoningTokens(i int) {
+	if m.addcompletion_reasoning_tokens != nil {
+		*m.addcompletion_reasoning_tokens += i
+	} else {
+		m.addcompletion_reasoning_tokens = &i
+	}
+}
+
+// AddedCompletionReasoningTokens returns the value that was added to the "completion_reasoning_tokens" field in this mutation.
+func (m *UsageLogMutation) AddedCompletionReasoningTokens() (r int, exists bool) {
+	v := m.addcompletion_reasoning_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCompletionReasoningTokens clears the value of the "completion_reasoning_tokens" field.
+func (m *UsageLogMutation) ClearCompletionReasoningTokens() {
+	m.completion_reasoning_tokens = nil
+	m.addcompletion_reasoning_tokens = nil
+	m.clearedFields[usagelog.FieldCompletionReasoningTokens] = struct{}{}
+}
+
+// CompletionReasoningTokensCleared returns if the "completion_reasoning_tokens" field was cleared in this mutation.
+func (m *UsageLogMutation) CompletionReasoningTokensCleared() bool {
+	_, ok := m.clearedFields[usagelog.FieldCompletionReasoningTokens]
+	return ok
+}
+
+// ResetCompletionReasoningTokens resets all changes to the "completion_reasoning_tokens" field.
+func (m *UsageLogMutation) ResetCompletionReasoningTokens() {
+	m.completion_reasoning_tokens = nil
+	m.addcompletion_reasoning_tokens = nil
+	delete(m.clearedFields, usagelog.FieldCompletionReasoningTokens)
+}
+
+// SetCompletionAcceptedPredictionTokens sets the "completion_accepted_prediction_tokens" field.
+func (m *UsageLogMutation) SetCompletionAcceptedPredictionTokens(i int) {
+	m.completion_accepted_prediction_tokens = &i
+	m.addcompletion_accepted_prediction_tokens = nil
+}
+
+// CompletionAcceptedPredictionTokens returns the value of the "completion_accepted_prediction_tokens" field in the mutation.
+func (m *UsageLogMutation) CompletionAcceptedPredictionTokens() (r int, exists bool) {
+	v := m.completion_accepted_prediction_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompletionAcceptedPredictionTokens returns the old "completion_accepted_prediction_tokens" field's value of the UsageLog entity.
+// If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsageLogMutation) OldCompletionAcceptedPredictionTokens(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompletionAcceptedPredictionTokens is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompletionAcceptedPredictionTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompletionAcceptedPredictionTokens: %w", err)
+	}
+	return oldValue.CompletionAcceptedPredictionTokens, nil
+}
+
+// AddCompletionAcceptedPredictionTokens adds i to the "completion_accepted_prediction_tokens" field.
+func (m *UsageLogMutation) AddCompletionAcceptedPredictionTokens(i int) {
+	if m.addcompletion_accepted_prediction_tokens != nil {
+		*m.addcompletion_accepted_prediction_tokens += i
+	} else {
+		m.addcompletion_accepted_prediction_tokens = &i
+	}
+}
+
+// AddedCompletionAcceptedPredictionTokens returns the value that was added to the "completion_accepted_prediction_tokens" field in this mutation.
+func (m *UsageLogMutation) AddedCompletionAcceptedPredictionTokens() (r int, exists bool) {
+	v := m.addcompletion_accepted_prediction_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCompletionAcceptedPredictionTokens clears the value of the "completion_accepted_prediction_tokens" field.
+func (m *UsageLogMutation) ClearCompletionAcceptedPredictionTokens() {
+	m.completion_accepted_prediction_tokens = nil
+	m.addcompletion_accepted_prediction_tokens = nil
+	m.clearedFields[usagelog.FieldCompletionAcceptedPredictionTokens] = struct{}{}
+}
+
+// CompletionAcceptedPredictionTokensCleared returns if the "completion_accepted_prediction_tokens" field was cleared in this mutation.
+func (m *UsageLogMutation) CompletionAcceptedPredictionTokensCleared() bool {
+	_, ok := m.clearedFields[usagelog.FieldCompletionAcceptedPredictionTokens]
+	return ok
+}
+
+// ResetCompletionAcceptedPredictionTokens resets all changes to the "completion_accepted_prediction_tokens" field.
+func (m *UsageLogMutation) ResetCompletionAcceptedPredictionTokens() {
+	m.completion_accepted_prediction_tokens = nil
+	m.addcompletion_accepted_prediction_tokens = nil
+	delete(m.clearedFields, usagelog.FieldCompletionAcceptedPredictionTokens)
+}
+
+// SetCompletionRejectedPredictionTokens sets the "completion_rejected_prediction_tokens" field.
+func (m *UsageLogMutation) SetCompletionRejectedPredictionTokens(i int) {
+	m.completion_rejected_prediction_tokens = &i
+	m.addcompletion_rejected_prediction_tokens = nil
+}
+
+// CompletionRejectedPredictionTokens returns the value of the "completion_rejected_prediction_tokens" field in the mutation.
+func (m *UsageLogMutation) CompletionRejectedPredictionTokens() (r int, exists bool) {
+	v := m.completion_rejected_prediction_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompletionRejectedPredictionTokens returns the old "completion_rejected_prediction_tokens" field's value of the UsageLog entity.
+// If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsageLogMutation) OldCompletionRejectedPredictionTokens(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompletionRejectedPredictionTokens is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompletionRejectedPredictionTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompletionRejectedPredictionTokens: %w", err)
+	}
+	return oldValue.CompletionRejectedPredictionTokens, nil
+}
+
+// AddCompletionRejectedPredictionTokens adds i to the "completion_rejected_prediction_tokens" field.
+func (m *UsageLogMutation) AddCompletionRejectedPredictionTokens(i int) {
+	if m.addcompletion_rejected_prediction_tokens != nil {
+		*m.addcompletion_rejected_prediction_tokens += i
+	} else {
+		m.addcompletion_rejected_prediction_tokens = &i
+	}
+}
+
+// AddedCompletionRejectedPredictionTokens returns the value that was added to the "completion_rejected_prediction_tokens" field in this mutation.
+func (m *UsageLogMutation) AddedCompletionRejectedPredictionTokens() (r int, exists bool) {
+	v := m.addcompletion_rejected_prediction_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCompletionRejectedPredictionTokens clears the value of the "completion_rejected_prediction_tokens" field.
+func (m *UsageLogMutation) ClearCompletionRejectedPredictionTokens() {
+	m.completion_rejected_prediction_tokens = nil
+	m.addcompletion_rejected_prediction_tokens = nil
+	m.clearedFields[usagelog.FieldCompletionRejectedPredictionTokens] = struct{}{}
+}
+
+// CompletionRejectedPredictionTokensCleared returns if the "completion_rejected_prediction_tokens" field was cleared in this mutation.
+func (m *UsageLogMutation) CompletionRejectedPredictionTokensCleared() bool {
+	_, ok := m.clearedFields[usagelog.FieldCompletionRejectedPredictionTokens]
+	return ok
+}
+
+// ResetCompletionRejectedPredictionTokens resets all changes to the "completion_rejected_prediction_tokens" field.
+func (m *UsageLogMutation) ResetCompletionRejectedPredictionTokens() {
+	m.completion_rejected_prediction_tokens = nil
+	m.addcompletion_rejected_prediction_tokens = nil
+	delete(m.clearedFields, usagelog.FieldCompletionRejectedPredictionTokens)
+}
+
+// SetEstimated sets the "estimated" field.
+func (m *UsageLogMutation) SetEstimated(b bool) {
+	m.estimated = &b
+}
+
+// Estimated returns the value of the "estimated" field in the mutation.
+func (m *UsageLogMutation) Estimated() (r bool, exists bool) {
+	v := m.estimated
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEstimated returns the old "estimated" field's value of the UsageLog entity.
+// If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsageLogMutation) OldEstimated(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEstimated is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEstimated requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEstimated: %w", err)
+	}
+	return oldValue.Estimated, nil
+}
+
+// ResetEstimated resets all changes to the "estimated" field.
+func (m *UsageLogMutation) ResetEstimated() {
+	m.estimated = nil
+}
+
+// SetSource sets the "source" field.
+func (m *UsageLogMutation) SetSource(u usagelog.Source) {
+	m.source = &u
+}
+
+// Source returns the value of the "source" field in the mutation.
+func (m *UsageLogMutation) Source() (r usagelog.Source, exists bool) {
+	v := m.source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSource returns the old "source" field's value of the UsageLog entity.
+// If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsageLogMutation) OldSource(ctx context.Context) (v usagelog.Source, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+	}
+	return oldValue.Source, nil
+}
+
+// ResetSource resets all changes to the "source" field.
+func (m *UsageLogMutation) ResetSource() {
+	m.source = nil
+}
+
+// SetFormat sets the "format" field.
+func (m *UsageLogMutation) SetFormat(s string) {
+	m.format = &s
+}
+
+// Format returns the value of the "format" field in the mutation.
+func (m *UsageLogMutation) Format() (r string, exists bool) {
+	v := m.format
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFormat returns the old "format" field's value of the UsageLog entity.
+// If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsageLogMutation) OldFormat(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFormat is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFormat requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFormat: %w", err)
+	}
+	return oldValue.Format, nil
+}
+
+// ResetFormat resets all changes to the "format" field.
+func (m *UsageLogMutation) ResetFormat() {
+	m.format = nil
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *UsageLogMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[usagelog.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *UsageLogMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *UsageLogMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *UsageLogMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// ClearRequest clears the "request" edge to the Request entity.
+func (m *UsageLogMutation) ClearRequest() {
+	m.clearedrequest = true
+	m.clearedFields[usagelog.FieldRequestID] = struct{}{}
+}
+
+// RequestCleared reports if the "request" edge to the Request entity was cleared.
+func (m *UsageLogMutation) RequestCleared() bool {
+	return m.clearedrequest
+}
+
+// RequestIDs returns the "request" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RequestID instead. It exists only for internal usage by the builders.
+func (m *UsageLogMutation) RequestIDs() (ids []int) {
+	if id := m.request; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRequest resets all changes to the "request" edge.
+func (m *UsageLogMutation) ResetRequest() {
+	m.request = nil
+	m.clearedrequest = false
+}
+
+// ClearChannel clears the "channel" edge to the Channel entity.
+func (m *UsageLogMutation) ClearChannel() {
+	m.clearedchannel = true
+	m.clearedFields[usagelog.FieldChannelID] = struct{}{}
+}
+
+// ChannelCleared reports if the "channel" edge to the Channel entity was cleared.
+func (m *UsageLogMutation) ChannelCleared() bool {
+	return m.ChannelIDCleared() || m.clearedchannel
+}
+
+// ChannelIDs returns the "channel" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ChannelID instead. It exists only for internal usage by the builders.
+func (m *UsageLogMutation) ChannelIDs() (ids []int) {
+	if id := m.channel; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetChannel resets all changes to the "channel" edge.
+func (m *UsageLogMutation) ResetChannel() {
+	m.channel = nil
+	m.clearedchannel = false
+}
+
+// Where appends a list predicates to the UsageLogMutation builder.
+func (m *UsageLogMutation) Where(ps ...predicate.UsageLog) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the UsageLogMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *UsageLogMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.UsageLog, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *UsageLogMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *UsageLogMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (UsageLog).
+func (m *UsageLogMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *UsageLogMutation) Fields() []string {
+	fields := make([]string, 0, 20)
+	if m.created_at != nil {
+		fields = append(fields, usagelog.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, usagelog.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, usagelog.FieldDeletedAt)
+	}
+	if m.user != nil {
+		fields = append(fields, usagelog.FieldUserID)
+	}
+	if m.request != nil {
+		fields = append(fields, usagelog.FieldRequestID)
+	}
+	if m.channel != nil {
+		fields = append(fields, usagelog.FieldChannelID)
+	}
+	if m.model_id != nil {
+		fields = append(fields, usagelog.FieldModelID)
+	}
+	if m.prompt_tokens != nil {
+		fields = append(fields, usagelog.FieldPromptTokens)
+	}
+	if m.completion_tokens != nil {
+		fields = append(fields, usagelog.FieldCompletionTokens)
+	}
+	if m.total_tokens != nil {
+		fields = append(fields, usagelog.FieldTotalTokens)
+	}
+	if m.prompt_audio_tokens != nil {
+		fields = append(fields, usagelog.FieldPromptAudioTokens)
+	}
+	if m.prompt_cached_tokens != nil {
+		fields = append(fields, usagelog.FieldPromptCachedTokens)
+	}
+	if m.prompt_cache_creation_tokens != nil {
+		fields = append(fields, usagelog.FieldPromptCacheCreationTokens)
+	}
+	if m.completion_audio_tokens != nil {
+		fields = append(fields, usagelog.FieldCompletionAudioTokens)
+	}
+	if m.completion_reasoning_tokens != nil {
+		fields = append(fields, usagelog.FieldCompletionReasoningTokens)
+	}
+	if m.completion_accepted_prediction_tokens != nil {
+		fields = append(fields, usagelog.FieldCompletionAcceptedPredictionTokens)
+	}
+	if m.completion_rejected_prediction_tokens != nil {
+		fields = append(fields, usagelog.FieldCompletionRejectedPredictionTokens)
+	}
+	if m.estimated != nil {
+		fields = append(fields, usagelog.FieldEstimated)
+	}
+	if m.source != nil {
+		fields = append(fields, usagelog.FieldSource)
+	}
+	if m.format != nil {
+		fields = append(fields, usagelog.FieldFormat)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *UsageLogMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case usagelog.FieldCreatedAt:
+		return m.CreatedAt()
+	case usagelog.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case usagelog.FieldDeletedAt:
+		return m.DeletedAt()
+	case usagelog.FieldUserID:
+		return m.UserID()
+	case usagelog.FieldRequestID:
+		return m.RequestID()
+	case usagelog.FieldChannelID:
+		return m.ChannelID()
+	case usagelog.FieldModelID:
+		return m.ModelID()
+	case usagelog.FieldPromptTokens:
+		return m.PromptTokens()
+	case usagelog.FieldCompletionTokens:
+		return m.CompletionTokens()
+	case usagelog.FieldTotalTokens:
+		return m.TotalTokens()
+	case usagelog.FieldPromptAudioTokens:
+		return m.PromptAudioTokens()
+	case usagelog.FieldPromptCachedTokens:
+		return m.PromptCachedTokens()
+	case usagelog.FieldPromptCacheCreationTokens:
+		return m.PromptCacheCreationTokens()
+	case usagelog.FieldCompletionAudioTokens:
+		return m.CompletionAudioTokens()
+	case usagelog.FieldCompletionReasoningTokens:
+		return m.CompletionReasoningTokens()
+	case usagelog.FieldCompletionAcceptedPredictionTokens:
+		return m.CompletionAcceptedPredictionTokens()
+	case usagelog.FieldCompletionRejectedPredictionTokens:
+		return m.CompletionRejectedPredictionTokens()
+	case usagelog.FieldEstimated:
+		return m.Estimated()
+	case usagelog.FieldSource:
+		return m.Source()
+	case usagelog.FieldFormat:
+		return m.Format()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *UsageLogMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case usagelog.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case usagelog.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case usagelog.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case usagelog.FieldUserID:
+		return m.OldUserID(ctx)
+	case usagelog.FieldRequestID:
+		return m.OldRequestID(ctx)
+	case usagelog.FieldChannelID:
+		return m.OldChannelID(ctx)
+	case usagelog.FieldModelID:
+		return m.OldModelID(ctx)
+	case usagelog.FieldPromptTokens:
+		return m.OldPromptTokens(ctx)
+	case usagelog.FieldCompletionTokens:
+		return m.OldCompletionTokens(ctx)
+	case usagelog.FieldTotalTokens:
+		return m.OldTotalTokens(ctx)
+	case usagelog.FieldPromptAudioTokens:
+		return m.OldPromptAudioTokens(ctx)
+	case usagelog.FieldPromptCachedTokens:
+		return m.OldPromptCachedTokens(ctx)
+	case usagelog.FieldPromptCacheCreationTokens:
+		return m.OldPromptCacheCreationTokens(ctx)
+	case usagelog.FieldCompletionAudioTokens:
+		return m.OldCompletionAudioTokens(ctx)
+	case usagelog.FieldCompletionReasoningTokens:
+		return m.OldCompletionReasoningTokens(ctx)
+	case usagelog.FieldCompletionAcceptedPredictionTokens:
+		return m.OldCompletionAcceptedPredictionTokens(ctx)
+	case usagelog.FieldCompletionRejectedPredictionTokens:
+		return m.OldCompletionRejectedPredictionTokens(ctx)
+	case usagelog.FieldEstimated:
+		return m.OldEstimated(ctx)
+	case usagelog.FieldSource:
+		return m.OldSource(ctx)
+	case usagelog.FieldFormat:
+		return m.OldFormat(ctx)
+	}
+	return nil, fmt.Errorf("unknown UsageLog field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UsageLogMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case usagelog.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case usagelog.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case usagelog.FieldDeletedAt:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case usagelog.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case usagelog.FieldRequestID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequestID(v)
+		return nil
+	case usagelog.FieldChannelID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChannelID(v)
+		return nil
+	case usagelog.FieldModelID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModelID(v)
+		return nil
+	case usagelog.FieldPromptTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPromptTokens(v)
+		return nil
+	case usagelog.FieldCompletionTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompletionTokens(v)
+		return nil
+	case usagelog.FieldTotalTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalTokens(v)
+		return nil
+	case usagelog.FieldPromptAudioTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPromptAudioTokens(v)
+		return nil
+	case usagelog.FieldPromptCachedTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPromptCachedTokens(v)
+		return nil
+	case usagelog.FieldPromptCacheCreationTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPromptCacheCreationTokens(v)
+		return nil
+	case usagelog.FieldCompletionAudioTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompletionAudioTokens(v)
+		return nil
+	case usagelog.FieldCompletionReasoningTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompletionReasoningTokens(v)
+		return nil
+	case usagelog.FieldCompletionAcceptedPredictionTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompletionAcceptedPredictionTokens(v)
+		return nil
+	case usagelog.FieldCompletionRejectedPredictionTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompletionRejectedPredictionTokens(v)
+		return nil
+	case usagelog.FieldEstimated:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEstimated(v)
+		return nil
+	case usagelog.FieldSource:
+		v, ok := value.(usagelog.Source)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSource(v)
+		return nil
+	case usagelog.FieldFormat:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFormat(v)
+		return nil
+	}
+	return fmt.Errorf("unknown UsageLog field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *UsageLogMutation) AddedFields() []string {
+	var fields []string
+	if m.adddeleted_at != nil {
+		fields = append(fields, usagelog.FieldDeletedAt)
+	}
+	if m.addprompt_tokens != nil {
+		fields = append(fields, usagelog.FieldPromptTokens)
+	}
+	if m.addcompletion_tokens != nil {
+		fields = append(fields, usagelog.FieldCompletionTokens)
+	}
+	if m.addtotal_tokens != nil {
+		fields = append(fields, usagelog.FieldTotalTokens)
+	}
+	if m.addprompt_audio_tokens != nil {
+		fields = append(fields, usagelog.FieldPromptAudioTokens)
+	}
+	if m.addprompt_cached_tokens != nil {
+		fields = append(fields, usagelog.FieldPromptCachedTokens)
+	}
+	if m.addprompt_cache_creation_tokens != nil {
+		fields = append(fields, usagelog.FieldPromptCacheCreationTokens)
+	}
+	if m.addcompletion_audio_tokens != nil {
+		fields = append(fields, usagelog.FieldCompletionAudioTokens)
+	}
+	if m.addcompletion_reasoning_tokens != nil {
+		fields = append(fields, usagelog.FieldCompletionReasoningTokens)
+	}
+	if m.addcompletion_accepted_prediction_tokens != nil {
+		fields = append(fields, usagelog.FieldCompletionAcceptedPredictionTokens)
+	}
+	if m.addcompletion_rejected_prediction_tokens != nil {
+		fields = append(fields, usagelog.FieldCompletionRejectedPredictionTokens)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *UsageLogMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case usagelog.FieldDeletedAt:
+		return m.AddedDeletedAt()
+	case usagelog.FieldPromptTokens:
+		return m.AddedPromptTokens()
+	case usagelog.FieldCompletionTokens:
+		return m.AddedCompletionTokens()
+	case usagelog.FieldTotalTokens:
+		return m.AddedTotalTokens()
+	case usagelog.FieldPromptAudioTokens:
+		return m.AddedPromptAudioTokens()
+	case usagelog.FieldPromptCachedTokens:
+		return m.AddedPromptCachedTokens()
+	case usagelog.FieldPromptCacheCreationTokens:
+		return m.AddedPromptCacheCreationTokens()
+	case usagelog.FieldCompletionAudioTokens:
+		return m.AddedCompletionAudioTokens()
+	case usagelog.FieldCompletionReasoningTokens:
+		return m.AddedCompletionReasoningTokens()
+	case usagelog.FieldCompletionAcceptedPredictionTokens:
+		return m.AddedCompletionAcceptedPredictionTokens()
+	case usagelog.FieldCompletionRejectedPredictionTokens:
+		return m.AddedCompletionRejectedPredictionTokens()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UsageLogMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case usagelog.FieldDeletedAt:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDeletedAt(v)
+		return nil
+	case usagelog.FieldPromptTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPromptTokens(v)
+		return nil
+	case usagelog.FieldCompletionTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCompletionTokens(v)
+		return nil
+	case usagelog.FieldTotalTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalTokens(v)
+		return nil
+	case usagelog.FieldPromptAudioTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPromptAudioTokens(v)
+		return nil
+	case usagelog.FieldPromptCachedTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPromptCachedTokens(v)
+		return nil
+	case usagelog.FieldPromptCacheCreationTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPromptCacheCreationTokens(v)
+		return nil
+	case usagelog.FieldCompletionAudioTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCompletionAudioTokens(v)
+		return nil
+	case usagelog.FieldCompletionReasoningTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCompletionReasoningTokens(v)
+		return nil
+	case usagelog.FieldCompletionAcceptedPredictionTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCompletionAcceptedPredictionTokens(v)
+		return nil
+	case usagelog.FieldCompletionRejectedPredictionTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCompletionRejectedPredictionTokens(v)
+		return nil
+	}
+	return fmt.Errorf("unknown UsageLog numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *UsageLogMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(usagelog.FieldChannelID) {
+		fields = append(fields, usagelog.FieldChannelID)
+	}
+	if m.FieldCleared(usagelog.FieldPromptAudioTokens) {
+		fields = append(fields, usagelog.FieldPromptAudioTokens)
+	}
+	if m.FieldCleared(usagelog.FieldPromptCachedTokens) {
+		fields = append(fields, usagelog.FieldPromptCachedTokens)
+	}
+	if m.FieldCleared(usagelog.FieldPromptCacheCreationTokens) {
+		fields = append(fields, usagelog.FieldPromptCacheCreationTokens)
+	}
+	if m.FieldCleared(usagelog.FieldCompletionAudioTokens) {
+		fields = append(fields, usagelog.FieldCompletionAudioTokens)
+	}
+	if m.FieldCleared(usagelog.FieldCompletionReasoningTokens) {
+		fields = append(fields, usagelog.FieldCompletionReasoningTokens)
+	}
+	if m.FieldCleared(usagelog.FieldCompletionAcceptedPredictionTokens) {
+		fields = append(fields, usagelog.FieldCompletionAcceptedPredictionTokens)
+	}
+	if m.FieldCleared(usagelog.FieldCompletionRejectedPredictionTokens) {
+		fields = append(fields, usagelog.FieldCompletionRejectedPredictionTokens)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *UsageLogMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *UsageLogMutation) ClearField(name string) error {
+	switch name {
+	case usagelog.FieldChannelID:
+		m.ClearChannelID()
+		return nil
+	case usagelog.FieldPromptAudioTokens:
+		m.ClearPromptAudioTokens()
+		return nil
+	case usagelog.FieldPromptCachedTokens:
+		m.ClearPromptCachedTokens()
+		return nil
+	case usagelog.FieldPromptCacheCreationTokens:
+		m.ClearPromptCacheCreationTokens()
+		return nil
+	case usagelog.FieldCompletionAudioTokens:
+		m.ClearCompletionAudioTokens()
+		return nil
+	case usagelog.FieldCompletionReasoningTokens:
+		m.ClearCompletionReasoningTokens()
+		return nil
+	case usagelog.FieldCompletionAcceptedPredictionTokens:
+		m.ClearCompletionAcceptedPredictionTokens()
+		return nil
+	case usagelog.FieldCompletionRejectedPredictionTokens:
+		m.ClearCompletionRejectedPredictionTokens()
+		return nil
+	}
+	return fmt.Errorf("unknown UsageLog nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *UsageLogMutation) ResetField(name string) error {
+	switch name {
+	case usagelog.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case usagelog.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case usagelog.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case usagelog.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case usagelog.FieldRequestID:
+		m.ResetRequestID()
+		return nil
+	case usagelog.FieldChannelID:
+		m.ResetChannelID()
+		return nil
+	case usagelog.FieldModelID:
+		m.ResetModelID()
+		return nil
+	case usagelog.FieldPromptTokens:
+		m.ResetPromptTokens()
+		return nil
+	case usagelog.FieldCompletionTokens:
+		m.ResetCompletionTokens()
+		return nil
+	case usagelog.FieldTotalTokens:
+		m.ResetTotalTokens()
+		return nil
+	case usagelog.FieldPromptAudioTokens:
+		m.ResetPromptAudioTokens()
+		return nil
+	case usagelog.FieldPromptCachedTokens:
+		m.ResetPromptCachedTokens()
+		return nil
+	case usagelog.FieldPromptCacheCreationTokens:
+		m.ResetPromptCacheCreationTokens()
+		return nil
+	case usagelog.FieldCompletionAudioTokens:
+		m.ResetCompletionAudioTokens()
+		return nil
+	case usagelog.FieldCompletionReasoningTokens:
+		m.ResetCompletionReasoningTokens()
+		return nil
+	case usagelog.FieldCompletionAcceptedPredictionTokens:
+		m.ResetCompletionAcceptedPredictionTokens()
+		return nil
+	case usagelog.FieldCompletionRejectedPredictionTokens:
+		m.ResetCompletionRejectedPredictionTokens()
+		return nil
+	case usagelog.FieldEstimated:
+		m.ResetEstimated()
+		return nil
+	case usagelog.FieldSource:
+		m.ResetSource()
+		return nil
+	case usagelog.FieldFormat:
+		m.ResetFormat()
+		return nil
+	}
+	return fmt.Errorf("unknown UsageLog field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *UsageLogMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.user != nil {
+		edges = append(edges, usagelog.EdgeUser)
+	}
+	if m.request != nil {
+		edges = append(edges, usagelog.EdgeRequest)
+	}
+	if m.channel != nil {
+		edges = append(edges, usagelog.EdgeChannel)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *UsageLogMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case usagelog.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case usagelog.EdgeRequest:
+		if id := m.request; id != nil {
+			return []ent.Value{*id}
+		}
+	case usagelog.EdgeChannel:
+		if id := m.channel; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *UsageLogMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *UsageLogMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *UsageLogMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.cleareduser {
+		edges = append(edges, usagelog.EdgeUser)
+	}
+	if m.clearedrequest {
+		edges = append(edges, usagelog.EdgeRequest)
+	}
+	if m.clearedchannel {
+		edges = append(edges, usagelog.EdgeChannel)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *UsageLogMutation) EdgeCleared(name string) bool {
+	switch name {
+	case usagelog.EdgeUser:
+		return m.cleareduser
+	case usagelog.EdgeRequest:
+		return m.clearedrequest
+	case usagelog.EdgeChannel:
+		return m.clearedchannel
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *UsageLogMutation) ClearEdge(name string) error {
+	switch name {
+	case usagelog.EdgeUser:
+		m.ClearUser()
+		return nil
+	case usagelog.EdgeRequest:
+		m.ClearRequest()
+		return nil
+	case usagelog.EdgeChannel:
+		m.ClearChannel()
+		return nil
+	}
+	return fmt.Errorf("unknown UsageLog unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *UsageLogMutation) ResetEdge(name string) error {
+	switch name {
+	case usagelog.EdgeUser:
+		m.ResetUser()
+		return nil
+	case usagelog.EdgeRequest:
+		m.ResetRequest()
+		return nil
+	case usagelog.EdgeChannel:
+		m.ResetChannel()
+		return nil
+	}
+	return fmt.Errorf("unknown UsageLog edge %s", name)
+}
+
+// UserMutation represents an operation that mutates the User nodes in the graph.
+type UserMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	created_at        *time.Time
+	updated_at        *time.Time
+	deleted_at        *int
+	adddeleted_at     *int
+	email             *string
+	status            *user.Status
+	prefer_language   *string
+	password          *string
+	first_name        *string
+	last_name         *string
+	avatar            *string
+	is_owner          *bool
+	scopes            *[]string
+	appendscopes      []string
+	clearedFields     map[string]struct{}
+	requests          map[int]struct{}
+	removedrequests   map[int]struct{}
+	clearedrequests   bool
+	api_keys          map[int]struct{}
+	removedapi_keys   map[int]struct{}
+	clearedapi_keys   bool
+	roles             map[int]struct{}
+	removedroles      map[int]struct{}
+	clearedroles      bool
+	usage_logs        map[int]struct{}
+	removedusage_logs map[int]struct{}
+	clearedusage_logs bool
+	done              bool
+	oldValue          func(context.Context) (*User, error)
+	predicates        []predicate.User
+}
+
+var _ ent.Mutation = (*UserMutation)(nil)
+
+// userOption allows management of the mutation configuration using functional options.
+type userOption func(*UserMutation)
+
+// newUserMutation creates new mutation for the User entity.
+func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
+	m := &UserMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUser,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withUserID sets the ID field of the mutation.
+func withUserID(id int) userOption {
+	return func(m *UserMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *User
+		)
+		m.oldValue = func(ctx context.Context) (*User, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().User.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withUser sets the old User of the mutation.
+func withUser(node *User) userOption {
+	return func(m *UserMutation) {
+		m.oldValue = func(context.Context) (*User, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *UserMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *UserMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *UserMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *UserMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *UserMutation) SetDeletedAt(i int) {
+	m.deleted_at = &i
+	m.adddeleted_at = nil
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *UserMutation) DeletedAt() (r int, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldDeletedAt(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// AddDeletedAt adds i to the "deleted_at" field.
+func (m *UserMutation) AddDeletedAt(i int) {
+	if m.adddeleted_at != nil {
+		*m.adddeleted_at += i
+	} else {
+		m.adddeleted_at = &i
+	}
+}
+
+// AddedDeletedAt returns the value that was added to the "deleted_at" field in this mutation.
+func (m *UserMutation) AddedDeletedAt() (r int, exists bool) {
+	v := m.adddeleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *UserMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	m.adddeleted_at = nil
+}
+
+// SetEmail sets the "email" field.
+func (m *UserMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *UserMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *UserMutation) ResetEmail() {
+	m.email = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *UserMutation) SetStatus(u user.Status) {
+	m.status = &u
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *UserMutation) Status() (r user.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldStatus(ctx context.Context) (v user.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *UserMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetPreferLanguage sets the "prefer_language" field.
+func (m *UserMutation) SetPreferLanguage(s string) {
+	m.prefer_language = &s
+}
+
+// PreferLanguage returns the value of the "prefer_language" field in the mutation.
+func (m *UserMutation) PreferLanguage() (r string, exists bool) {
+	v := m.prefer_language
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPreferLanguage returns the old "prefer_language" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldPreferLanguage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPreferLanguage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPreferLanguage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPreferLanguage: %w", err)
+	}
+	return oldValue.PreferLanguage, nil
+}
+
+// ResetPreferLanguage resets all changes to the "prefer_language" field.
+func (m *UserMutation) ResetPreferLanguage() {
+	m.prefer_language = nil
+}
+
+// SetPassword sets the "password" field.
+func (m *UserMutation) SetPassword(s string) {
+	m.password = &s
+}
+
+// Password returns the value of the "password" field in the mutation.
+func (m *UserMutation) Password() (r string, exists bool) {
+	v := m.password
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPassword returns the old "password" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldPassword(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPassword requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
+	}
+	return oldValue.Password, nil
+}
+
+// ResetPassword resets all changes to the "password" field.
+func (m *UserMutation) ResetPassword() {
+	m.password = nil
+}
+
+// SetFirstName sets the "first_name" field.
+func (m *UserMutation) SetFirstName(s string) {
+	m.first_name = &s
+}
+
+// FirstName returns the value of the "first_name" field in the mutation.
+func (m *UserMutation) FirstName() (r string, exists bool) {
+	v := m.first_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstName returns the old "first_name" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldFirstName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstName: %w", err)
+	}
+	return oldValue.FirstName, nil
+}
+
+// ResetFirstName resets all changes to the "first_name" field.
+func (m *UserMutation) ResetFirstName() {
+	m.first_name = nil
+}
+
+// SetLastName sets the "last_name" field.
+func (m *UserMutation) SetLastName(s string) {
+	m.last_name = &s
+}
+
+// LastName returns the value of the "last_name" field in the mutation.
+func (m *UserMutation) LastName() (r string, exists bool) {
+	v := m.last_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastName returns the old "last_name" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldLastName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastName: %w", err)
+	}
+	return oldValue.LastName, nil
+}
+
+// ResetLastName resets all changes to the "last_name" field.
+func (m *UserMutation) ResetLastName() {
+	m.last_name = nil
+}
+
+// SetAvatar sets the "avatar" field.
+func (m *UserMutation) SetAvatar(s string) {
+	m.avatar = &s
+}
+
+// Avatar returns the value of the "avatar" field in the mutation.
+func (m *UserMutation) Avatar() (r string, exists bool) {
+	v := m.avatar
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAvatar returns the old "avatar" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldAvatar(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAvatar is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAvatar requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAvatar: %w", err)
+	}
+	return oldValue.Avatar, nil
+}
+
+// ClearAvatar clears the value of the "avatar" field.
+func (m *UserMutation) ClearAvatar() {
+	m.avatar = nil
+	m.clearedFields[user.FieldAvatar] = struct{}{}
+}
+
+// AvatarCleared returns if the "avatar" field was cleared in this mutation.
+func (m *UserMutation) AvatarCleared() bool {
+	_, ok := m.clearedFields[user.FieldAvatar]
+	return ok
+}
+
+// ResetAvatar resets all changes to the "avatar" field.
+func (m *UserMutation) ResetAvatar() {
+	m.avatar = nil
+	delete(m.clearedFields, user.FieldAvatar)
+}
+
+// SetIsOwner sets the "is_owner" field.
+func (m *UserMutation) SetIsOwner(b bool) {
+	m.is_owner = &b
+}
+
+// IsOwner returns the value of the "is_owner" field in the mutation.
+func (m *UserMutation) IsOwner() (r bool, exists bool) {
+	v := m.is_owner
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsOwner returns the old "is_owner" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldIsOwner(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsOwner is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsOwner requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsOwner: %w", err)
+	}
+	return oldValue.IsOwner, nil
+}
+
+// ResetIsOwner resets all changes to the "is_owner" field.
+func (m *UserMutation) ResetIsOwner() {
+	m.is_owner = nil
+}
+
+// SetScopes sets the "scopes" field.
+func (m *UserMutation) SetScopes(s []string) {
+	m.scopes = &s
+	m.appendscopes = nil
+}
+
+// Scopes returns the value of the "scopes" field in the mutation.
+func (m *UserMutation) Scopes() (r []string, exists bool) {
+	v := m.scopes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScopes returns the old "scopes" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldScopes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScopes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScopes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScopes: %w", err)
+	}
+	return oldValue.Scopes, nil
+}
+
+// AppendScopes adds s to the "scopes" field.
+func (m *UserMutation) AppendScopes(s []string) {
+	m.appendscopes = append(m.appendscopes, s...)
+}
+
+// AppendedScopes returns the list of values that were appended to the "scopes" field in this mutation.
+func (m *UserMutation) AppendedScopes() ([]string, bool) {
+	if len(m.appendscopes) == 0 {
+		return nil, false
+	}
+	return m.appendscopes, true
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (m *UserMutation) ClearScopes() {
+	m.scopes = nil
+	m.appendscopes = nil
+	m.clearedFields[user.FieldScopes] = struct{}{}
+}
+
+// ScopesCleared returns if the "scopes" field was cleared in this mutation.
+func (m *UserMutation) ScopesCleared() bool {
+	_, ok := m.clearedFields[user.FieldScopes]
+	return ok
+}
+
+// ResetScopes resets all changes to the "scopes" field.
+func (m *UserMutation) ResetScopes() {
+	m.scopes = nil
+	m.appendscopes = nil
+	delete(m.clearedFields, user.FieldScopes)
+}
+
+// AddRequestIDs adds the "requests" edge to the Request entity by ids.
+func (m *UserMutation) AddRequestIDs(ids ...int) {
+	if m.requests == nil {
+		m.requests = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.requests[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRequests clears the "requests" edge to the Request entity.
+func (m *UserMutation) ClearRequests() {
+	m.clearedrequests = true
+}
+
+// RequestsCleared reports if the "requests" edge to the Request entity was cleared.
+func (m *UserMutation) RequestsCleared() bool {
+	return m.clearedrequests
+}
+
+// RemoveRequestIDs removes the "requests" edge to the Request entity by IDs.
+func (m *UserMutation) RemoveRequestIDs(ids ...int) {
+	if m.removedrequests == nil {
+		m.removedrequests = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.requests, ids[i])
+		m.removedrequests[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRequests returns the removed IDs of the "requests" edge to the Request entity.
+func (m *UserMutation) RemovedRequestsIDs() (ids []int) {
+	for id := range m.removedrequests {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RequestsIDs returns the "requests" edge IDs in the mutation.
+func (m *UserMutation) RequestsIDs() (ids []int) {
+	for id := range m.requests {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRequests resets all changes to the "requests" edge.
+func (m *UserMutation) ResetRequests() {
+	m.requests = nil
+	m.clearedrequests = false
+	m.removedrequests = nil
+}
+
+// AddAPIKeyIDs adds the "api_keys" edge to the APIKey entity by ids.
+func (m *UserMutation) AddAPIKeyIDs(ids ...int) {
+	if m.api_keys == nil {
+		m.api_keys = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.api_keys[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAPIKeys clears the "api_keys" edge to the APIKey entity.
+func (m *UserMutation) ClearAPIKeys() {
+	m.clearedapi_keys = true
+}
+
+// APIKeysCleared reports if the "api_keys" edge to the APIKey entity was cleared.
+func (m *UserMutation) APIKeysCleared() bool {
+	return m.clearedapi_keys
+}
+
+// RemoveAPIKeyIDs removes the "api_keys" edge to the APIKey entity by IDs.
+func (m *UserMutation) RemoveAPIKeyIDs(ids ...int) {
+	if m.removedapi_keys == nil {
+		m.removedapi_keys = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.api_keys, ids[i])
+		m.removedapi_keys[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAPIKeys returns the removed IDs of the "api_keys" edge to the APIKey entity.
+func (m *UserMutation) RemovedAPIKeysIDs() (ids []int) {
+	for id := range m.removedapi_keys {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// APIKeysIDs returns the "api_keys" edge IDs in the mutation.
+func (m *UserMutation) APIKeysIDs() (ids []int) {
+	for id := range m.api_keys {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAPIKeys resets all changes to the "api_keys" edge.
+func (m *UserMutation) ResetAPIKeys() {
+	m.api_keys = nil
+	m.clearedapi_keys = false
+	m.removedapi_keys = nil
+}
+
+// AddRoleIDs adds the "roles" edge to the Role entity by ids.
+func (m *UserMutation) AddRoleIDs(ids ...int) {
+	if m.roles == nil {
+		m.roles = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.roles[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRoles clears the "roles" edge to the Role entity.
+func (m *UserMutation) ClearRoles() {
+	m.clearedroles = true
+}
+
+// RolesCleared reports if the "roles" edge to the Role entity was cleared.
+func (m *UserMutation) RolesCleared() bool {
+	return m.clearedroles
+}
+
+// RemoveRoleIDs removes the "roles" edge to the Role entity by IDs.
+func (m *UserMutation) RemoveRoleIDs(ids ...int) {
+	if m.removedroles == nil {
+		m.removedroles = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.roles, ids[i])
+		m.removedroles[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRoles returns the removed IDs of the "roles" edge to the Role entity.
+func (m *UserMutation) RemovedRolesIDs() (ids []int) {
+	for id := range m.removedroles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RolesIDs returns the "roles" edge IDs in the mutation.
+func (m *UserMutation) RolesIDs() (ids []int) {
+	for id := range m.roles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRoles resets all changes to the "roles" edge.
+func (m *UserMutation) ResetRoles() {
+	m.roles = nil
+	m.clearedroles = false
+	m.removedroles = nil
+}
+
+// AddUsageLogIDs adds the "usage_logs" edge to the UsageLog entity by ids.
+func (m *UserMutation) AddUsageLogIDs(ids ...int) {
+	if m.usage_logs == nil {
+		m.usage_logs = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.usage_logs[ids[i]] = struct{}{}
+	}
+}
+
+// ClearUsageLogs clears the "usage_logs" edge to the UsageLog entity.
+func (m *UserMutation) ClearUsageLogs() {
+	m.clearedusage_logs = true
+}
+
+// UsageLogsCleared reports if the "usage_logs" edge to the UsageLog entity was cleared.
+func (m *UserMutation) UsageLogsCleared() bool {
+	return m.clearedusage_logs
+}
+
+// RemoveUsageLogIDs removes the "usage_logs" edge to the UsageLog entity by IDs.
+func (m *UserMutation) RemoveUsageLogIDs(ids ...int) {
+	if m.removedusage_logs == nil {
+		m.removedusage_logs = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.usage_logs, ids[i])
+		m.removedusage_logs[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedUsageLogs returns the removed IDs of the "usage_logs" edge to the UsageLog entity.
+func (m *UserMutation) RemovedUsageLogsIDs() (ids []int) {
+	for id := range m.removedusage_logs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// UsageLogsIDs returns the "usage_logs" edge IDs in the mutation.
+func (m *UserMutation) UsageLogsIDs() (ids []int) {
+	for id := range m.usage_logs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetUsageLogs resets all changes to the "usage_logs" edge.
+func (m *UserMutation) ResetUsageLogs() {
+	m.usage_logs = nil
+	m.clearedusage_logs = false
+	m.removedusage_logs = nil
+}
+
+// Where appends a list predicates to the UserMutation builder.
+func (m *UserMutation) Where(ps ...predicate.User) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.User, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *UserMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *UserMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (User).
+func (m *UserMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *UserMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.created_at != nil {
+		fields = append(fields, user.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, user.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, user.FieldDeletedAt)
+	}
+	if m.email != nil {
+		fields = append(fields, user.FieldEmail)
+	}
+	if m.status != nil {
+		fields = append(fields, user.FieldStatus)
+	}
+	if m.prefer_language != nil {
+		fields = append(fields, user.FieldPreferLanguage)
+	}
+	if m.password != nil {
+		fields = append(fields, user.FieldPassword)
+	}
+	if m.first_name != nil {
+		fields = append(fields, user.FieldFirstName)
+	}
+	if m.last_name != nil {
+		fields = append(fields, user.FieldLastName)
+	}
+	if m.avatar != nil {
+		fields = append(fields, user.FieldAvatar)
+	}
+	if m.is_owner != nil {
+		fields = append(fields, user.FieldIsOwner)
+	}
+	if m.scopes != nil {
+		fields = append(fields, user.FieldScopes)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *UserMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case user.FieldCreatedAt:
+		return m.CreatedAt()
+	case user.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case user.FieldDeletedAt:
+		return m.DeletedAt()
+	case user.FieldEmail:
+		return m.Email()
+	case user.FieldStatus:
+		return m.Status()
+	case user.FieldPreferLanguage:
+		return m.PreferLanguage()
+	case user.FieldPassword:
+		return m.Password()
+	case user.FieldFirstName:
+		return m.FirstName()
+	case user.FieldLastName:
+		return m.LastName()
+	case user.FieldAvatar:
+		return m.Avatar()
+	case user.FieldIsOwner:
+		return m.IsOwner()
+	case user.FieldScopes:
+		return m.Scopes()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case user.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case user.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case user.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case user.FieldEmail:
+		return m.OldEmail(ctx)
+	case user.FieldStatus:
+		return m.OldStatus(ctx)
+	case user.FieldPreferLanguage:
+		return m.OldPreferLanguage(ctx)
+	case user.FieldPassword:
+		return m.OldPassword(ctx)
+	case user.FieldFirstName:
+		return m.OldFirstName(ctx)
+	case user.FieldLastName:
+		return m.OldLastName(ctx)
+	case user.FieldAvatar:
+		return m.OldAvatar(ctx)
+	case user.FieldIsOwner:
+		return m.OldIsOwner(ctx)
+	case user.FieldScopes:
+		return m.OldScopes(ctx)
+	}
+	return nil, fmt.Errorf("unknown User field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case user.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case user.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case user.FieldDeletedAt:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case user.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
+		return nil
+	case user.FieldStatus:
+		v, ok := value.(user.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case user.FieldPreferLanguage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPreferLanguage(v)
+		return nil
+	case user.FieldPassword:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPassword(v)
+		return nil
+	case user.FieldFirstName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstName(v)
+		return nil
+	case user.FieldLastName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastName(v)
+		return nil
+	case user.FieldAvatar:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAvatar(v)
+		return nil
+	case user.FieldIsOwner:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsOwner(v)
+		return nil
+	case user.FieldScopes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScopes(v)
+		return nil
+	}
+	return fmt.Errorf("unknown User field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *UserMutation) AddedFields() []string {
+	var fields []string
+	if m.adddeleted_at != nil {
+		fields = append(fields, user.FieldDeletedAt)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case user.FieldDeletedAt:
+		return m.AddedDeletedAt()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case user.FieldDeletedAt:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDeletedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown User numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *UserMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(user.FieldAvatar) {
+		fields = append(fields, user.FieldAvatar)
+	}
+	if m.FieldCleared(user.FieldScopes) {
+		fields = append(fields, user.FieldScopes)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *UserMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *UserMutation) ClearField(name string) error {
+	switch name {
+	case user.FieldAvatar:
+		m.ClearAvatar()
+		return nil
+	case user.FieldScopes:
+		m.ClearScopes()
+		return nil
+	}
+	return fmt.Errorf("unknown User nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *UserMutation) ResetField(name string) error {
+	switch name {
+	case user.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case user.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case user.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case user.FieldEmail:
+		m.ResetEmail()
+		return nil
+	case user.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case user.FieldPreferLanguage:
+		m.ResetPreferLanguage()
+		return nil
+	case user.FieldPassword:
+		m.ResetPassword()
+		return nil
+	case user.FieldFirstName:
+		m.ResetFirstName()
+		return nil
+	case user.FieldLastName:
+		m.ResetLastName()
+		return nil
+	case user.FieldAvatar:
+		m.ResetAvatar()
+		return nil
+	case user.FieldIsOwner:
+		m.ResetIsOwner()
+		return nil
+	case user.FieldScopes:
+		m.ResetScopes()
+		return nil
+	}
+	return fmt.Errorf("unknown User field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *UserMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.requests != nil {
+		edges = append(edges, user.EdgeRequests)
+	}
+	if m.api_keys != nil {
+		edges = append(edges, user.EdgeAPIKeys)
+	}
+	if m.roles != nil {
+		edges = append(edges, user.EdgeRoles)
+	}
+	if m.usage_logs != nil {
+		edges = append(edges, user.EdgeUsageLogs)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *UserMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgeRequests:
+		ids := make([]ent.Value, 0, len(m.requests))
+		for id := range m.requests {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeAPIKeys:
+		ids := make([]ent.Value, 0, len(m.api_keys))
+		for id := range m.api_keys {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeRoles:
+		ids := make([]ent.Value, 0, len(m.roles))
+		for id := range m.roles {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeUsageLogs:
+		ids := make([]ent.Value, 0, len(m.usage_logs))
+		for id := range m.usage_logs {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *UserMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedrequests != nil {
+		edges = append(edges, user.EdgeRequests)
+	}
+	if m.removedapi_keys != nil {
+		edges = append(edges, user.EdgeAPIKeys)
+	}
+	if m.removedroles != nil {
+		edges = append(edges, user.EdgeRoles)
+	}
+	if m.removedusage_logs != nil {
+		edges = append(edges, user.EdgeUsageLogs)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *UserMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgeRequests:
+		ids := make([]ent.Value, 0, len(m.removedrequests))
+		for id := range m.removedrequests {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeAPIKeys:
+		ids := make([]ent.Value, 0, len(m.removedapi_keys))
+		for id := range m.removedapi_keys {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeRoles:
+		ids := make([]ent.Value, 0, len(m.removedroles))
+		for id := range m.removedroles {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeUsageLogs:
+		ids := make([]ent.Value, 0, len(m.removedusage_logs))
+		for id := range m.removedusage_logs {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *UserMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedrequests {
+		edges = append(edges, user.EdgeRequests)
+	}
+	if m.clearedapi_keys {
+		edges = append(edges, user.EdgeAPIKeys)
+	}
+	if m.clearedroles {
+		edges = append(edges, user.EdgeRoles)
+	}
+	if m.clearedusage_logs {
+		edges = append(edges, user.EdgeUsageLogs)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *UserMutation) EdgeCleared(name string) bool {
+	switch name {
+	case user.EdgeRequests:
+		return m.clearedrequests
+	case user.EdgeAPIKeys:
+		return m.clearedapi_keys
+	case user.EdgeRoles:
+		return m.clearedroles
+	case user.EdgeUsageLogs:
+		return m.clearedusage_logs
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *UserMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown User unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *UserMutation) ResetEdge(name string) error {
+	switch name {
+	case user.EdgeRequests:
+		m.ResetRequests()
+		return nil
+	case user.EdgeAPIKeys:
+		m.ResetAPIKeys()
+		return nil
+	case user.EdgeRoles:
+		m.ResetRoles()
+		return nil
+	case user.EdgeUsageLogs:
+		m.ResetUsageLogs()
+		return nil
+	}
+	return fmt.Errorf("unknown User edge %s", name)
+}
+
+// VirtualModelMutation represents an operation that mutates the VirtualModel nodes in the graph.
+type VirtualModelMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	created_at    *time.Time
+	updated_at    *time.Time
+	deleted_at    *int
+	adddeleted_at *int
+	name          *string
+	description   *string
+	status        *virtualmodel.Status
+	targets       *[]objects.VirtualModelTarget
+	appendtargets []objects.VirtualModelTarget
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*VirtualModel, error)
+	predicates    []predicate.VirtualModel
+}
+
+var _ ent.Mutation = (*VirtualModelMutation)(nil)
+
+// virtualmodelOption allows management of the mutation configuration using functional options.
+type virtualmodelOption func(*VirtualModelMutation)
+
+// newVirtualModelMutation creates new mutation for the VirtualModel entity.
+func newVirtualModelMutation(c config, op Op, opts ...virtualmodelOption) *VirtualModelMutation {
+	m := &VirtualModelMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeVirtualModel,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// OldFirstName returns the old "first_name" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldFirstName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFirstName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFirstName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFirstName: %w", err)
+// withVirtualModelID sets the ID field of the mutation.
+func withVirtualModelID(id int) virtualmodelOption {
+	return func(m *VirtualModelMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *VirtualModel
+		)
+		m.oldValue = func(ctx context.Context) (*VirtualModel, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().VirtualModel.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return oldValue.FirstName, nil
 }
 
-// ResetFirstName resets all changes to the "first_name" field.
-func (m *UserMutation) ResetFirstName() {
-	m.first_name = nil
+// withVirtualModel sets the old VirtualModel of the mutation.
+func withVirtualModel(node *VirtualModel) virtualmodelOption {
+	return func(m *VirtualModelMutation) {
+		m.oldValue = func(context.Context) (*VirtualModel, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// SetLastName sets the "last_name" field.
-func (m *UserMutation) SetLastName(s string) {
-	m.last_name = &s
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m VirtualModelMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// LastName returns the value of the "last_name" field in the mutation.
-func (m *UserMutation) LastName() (r string, exists bool) {
-	v := m.last_name
-	if v == nil {
-		return
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m VirtualModelMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	return *v, true
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// OldLastName returns the old "last_name" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldLastName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastName: %w", err)
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *VirtualModelMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
 	}
-	return oldValue.LastName, nil
+	return *m.id, true
 }
 
-// ResetLastName resets all changes to the "last_name" field.
-func (m *UserMutation) ResetLastName() {
-	m.last_name = nil
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *VirtualModelMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().VirtualModel.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetAvatar sets the "avatar" field.
-func (m *UserMutation) SetAvatar(s string) {
-	m.avatar = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *VirtualModelMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Avatar returns the value of the "avatar" field in the mutation.
-func (m *UserMutation) Avatar() (r string, exists bool) {
-	v := m.avatar
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *VirtualModelMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAvatar returns the old "avatar" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the VirtualModel entity.
+// If the VirtualModel object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldAvatar(ctx context.Context) (v string, err error) {
+func (m *VirtualModelMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAvatar is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAvatar requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAvatar: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Avatar, nil
-}
-
-// ClearAvatar clears the value of the "avatar" field.
-func (m *UserMutation) ClearAvatar() {
-	m.avatar = nil
-	m.clearedFields[user.FieldAvatar] = struct{}{}
-}
-
-// AvatarCleared returns if the "avatar" field was cleared in this mutation.
-func (m *UserMutation) AvatarCleared() bool {
-	_, ok := m.clearedFields[user.FieldAvatar]
-	return ok
+	return oldValue.CreatedAt, nil
 }
 
-// ResetAvatar resets all changes to the "avatar" field.
-func (m *UserMutation) ResetAvatar() {
-	m.avatar = nil
-	delete(m.clearedFields, user.FieldAvatar)
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *VirtualModelMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetIsOwner sets the "is_owner" field.
-func (m *UserMutation) SetIsOwner(b bool) {
-	m.is_owner = &b
+// SetUpdatedAt sets the "updated_at" field.
+func (m *VirtualModelMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// IsOwner returns the value of the "is_owner" field in the mutation.
-func (m *UserMutation) IsOwner() (r bool, exists bool) {
-	v := m.is_owner
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *VirtualModelMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsOwner returns the old "is_owner" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the VirtualModel entity.
+// If the VirtualModel object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldIsOwner(ctx context.Context) (v bool, err error) {
+func (m *VirtualModelMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsOwner is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsOwner requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsOwner: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.IsOwner, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetIsOwner resets all changes to the "is_owner" field.
-func (m *UserMutation) ResetIsOwner() {
-	m.is_owner = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *VirtualModelMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// SetScopes sets the "scopes" field.
-func (m *UserMutation) SetScopes(s []string) {
-	m.scopes = &s
-	m.appendscopes = nil
+// SetDeletedAt sets the "deleted_at" field.
+func (m *VirtualModelMutation) SetDeletedAt(i int) {
+	m.deleted_at = &i
+	m.adddeleted_at = nil
 }
 
-// Scopes returns the value of the "scopes" field in the mutation.
-func (m *UserMutation) Scopes() (r []string, exists bool) {
-	v := m.scopes
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *VirtualModelMutation) DeletedAt() (r int, exists bool) {
+	v := m.deleted_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldScopes returns the old "scopes" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldDeletedAt returns the old "deleted_at" field's value of the VirtualModel entity.
+// If the VirtualModel object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldScopes(ctx context.Context) (v []string, err error) {
+func (m *VirtualModelMutation) OldDeletedAt(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldScopes is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldScopes requires an ID field in the mutation")
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldScopes: %w", err)
-	}
-	return oldValue.Scopes, nil
-}
-
-// AppendScopes adds s to the "scopes" field.
-func (m *UserMutation) AppendScopes(s []string) {
-	m.appendscopes = append(m.appendscopes, s...)
-}
-
-// AppendedScopes returns the list of values that were appended to the "scopes" field in this mutation.
-func (m *UserMutation) AppendedScopes() ([]string, bool) {
-	if len(m.appendscopes) == 0 {
-		return nil, false
-	}
-	return m.appendscopes, true
-}
-
-// ClearScopes clears the value of the "scopes" field.
-func (m *UserMutation) ClearScopes() {
-	m.scopes = nil
-	m.appendscopes = nil
-	m.clearedFields[user.FieldScopes] = struct{}{}
-}
-
-// ScopesCleared returns if the "scopes" field was cleared in this mutation.
-func (m *UserMutation) ScopesCleared() bool {
-	_, ok := m.clearedFields[user.FieldScopes]
-	return ok
-}
-
-// ResetScopes resets all changes to the "scopes" field.
-func (m *UserMutation) ResetScopes() {
-	m.scopes = nil
-	m.appendscopes = nil
-	delete(m.clearedFields, user.FieldScopes)
-}
-
-// AddRequestIDs adds the "requests" edge to the Request entity by ids.
-func (m *UserMutation) AddRequestIDs(ids ...int) {
-	if m.requests == nil {
-		m.requests = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.requests[ids[i]] = struct{}{}
-	}
-}
-
-// ClearRequests clears the "requests" edge to the Request entity.
-func (m *UserMutation) ClearRequests() {
-	m.clearedrequests = true
-}
-
-// RequestsCleared reports if the "requests" edge to the Request entity was cleared.
-func (m *UserMutation) RequestsCleared() bool {
-	return m.clearedrequests
-}
-
-// RemoveRequestIDs removes the "requests" edge to the Request entity by IDs.
-func (m *UserMutation) RemoveRequestIDs(ids ...int) {
-	if m.removedrequests == nil {
-		m.removedrequests = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.requests, ids[i])
-		m.removedrequests[ids[i]] = struct{}{}
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
 	}
+	return oldValue.DeletedAt, nil
 }
 
-// RemovedRequests returns the removed IDs of the "requests" edge to the Request entity.
-func (m *UserMutation) RemovedRequestsIDs() (ids []int) {
-	for id := range m.removedrequests {
-		ids = append(ids, id)
+// AddDeletedAt adds i to the "deleted_at" field.
+func (m *VirtualModelMutation) AddDeletedAt(i int) {
+	if m.adddeleted_at != nil {
+		*m.adddeleted_at += i
+	} else {
+		m.adddeleted_at = &i
 	}
-	return
 }
 
-// RequestsIDs returns the "requests" edge IDs in the mutation.
-func (m *UserMutation) RequestsIDs() (ids []int) {
-	for id := range m.requests {
-		ids = append(ids, id)
+// AddedDeletedAt returns the value that was added to the "deleted_at" field in this mutation.
+func (m *VirtualModelMutation) AddedDeletedAt() (r int, exists bool) {
+	v := m.adddeleted_at
+	if v == nil {
+		return
 	}
-	return
-}
-
-// ResetRequests resets all changes to the "requests" edge.
-func (m *UserMutation) ResetRequests() {
-	m.requests = nil
-	m.clearedrequests = false
-	m.removedrequests = nil
+	return *v, true
 }
 
-// AddAPIKeyIDs adds the "api_keys" edge to the APIKey entity by ids.
-func (m *UserMutation) AddAPIKeyIDs(ids ...int) {
-	if m.api_keys == nil {
-		m.api_keys = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.api_keys[ids[i]] = struct{}{}
-	}
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *VirtualModelMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	m.adddeleted_at = nil
 }
 
-// ClearAPIKeys clears the "api_keys" edge to the APIKey entity.
-func (m *UserMutation) ClearAPIKeys() {
-	m.clearedapi_keys = true
+// SetName sets the "name" field.
+func (m *VirtualModelMutation) SetName(s string) {
+	m.name = &s
 }
 
-// APIKeysCleared reports if the "api_keys" edge to the APIKey entity was cleared.
-func (m *UserMutation) APIKeysCleared() bool {
-	return m.clearedapi_keys
+// Name returns the value of the "name" field in the mutation.
+func (m *VirtualModelMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveAPIKeyIDs removes the "api_keys" edge to the APIKey entity by IDs.
-func (m *UserMutation) RemoveAPIKeyIDs(ids ...int) {
-	if m.removedapi_keys == nil {
-		m.removedapi_keys = make(map[int]struct{})
+// OldName returns the old "name" field's value of the VirtualModel entity.
+// If the VirtualModel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VirtualModelMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.api_keys, ids[i])
-		m.removedapi_keys[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
+	return oldValue.Name, nil
 }
 
-// RemovedAPIKeys returns the removed IDs of the "api_keys" edge to the APIKey entity.
-func (m *UserMutation) RemovedAPIKeysIDs() (ids []int) {
-	for id := range m.removedapi_keys {
-		ids = append(ids, id)
-	}
-	return
+// ResetName resets all changes to the "name" field.
+func (m *VirtualModelMutation) ResetName() {
+	m.name = nil
 }
 
-// APIKeysIDs returns the "api_keys" edge IDs in the mutation.
-func (m *UserMutation) APIKeysIDs() (ids []int) {
-	for id := range m.api_keys {
-		ids = append(ids, id)
-	}
-	return
+// SetDescription sets the "description" field.
+func (m *VirtualModelMutation) SetDescription(s string) {
+	m.description = &s
 }
 
-// ResetAPIKeys resets all changes to the "api_keys" edge.
-func (m *UserMutation) ResetAPIKeys() {
-	m.api_keys = nil
-	m.clearedapi_keys = false
-	m.removedapi_keys = nil
+// Description returns the value of the "description" field in the mutation.
+func (m *VirtualModelMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddRoleIDs adds the "roles" edge to the Role entity by ids.
-func (m *UserMutation) AddRoleIDs(ids ...int) {
-	if m.roles == nil {
-		m.roles = make(map[int]struct{})
+// OldDescription returns the old "description" field's value of the VirtualModel entity.
+// If the VirtualModel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VirtualModelMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.roles[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
 }
 
-// ClearRoles clears the "roles" edge to the Role entity.
-func (m *UserMutation) ClearRoles() {
-	m.clearedroles = true
+// ClearDescription clears the value of the "description" field.
+func (m *VirtualModelMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[virtualmodel.FieldDescription] = struct{}{}
 }
 
-// RolesCleared reports if the "roles" edge to the Role entity was cleared.
-func (m *UserMutation) RolesCleared() bool {
-	return m.clearedroles
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *VirtualModelMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[virtualmodel.FieldDescription]
+	return ok
 }
 
-// RemoveRoleIDs removes the "roles" edge to the Role entity by IDs.
-func (m *UserMutation) RemoveRoleIDs(ids ...int) {
-	if m.removedroles == nil {
-		m.removedroles = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.roles, ids[i])
-		m.removedroles[ids[i]] = struct{}{}
-	}
+// ResetDescription resets all changes to the "description" field.
+func (m *VirtualModelMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, virtualmodel.FieldDescription)
 }
 
-// RemovedRoles returns the removed IDs of the "roles" edge to the Role entity.
-func (m *UserMutation) RemovedRolesIDs() (ids []int) {
-	for id := range m.removedroles {
-		ids = append(ids, id)
-	}
-	return
+// SetStatus sets the "status" field.
+func (m *VirtualModelMutation) SetStatus(v virtualmodel.Status) {
+	m.status = &v
 }
 
-// RolesIDs returns the "roles" edge IDs in the mutation.
-func (m *UserMutation) RolesIDs() (ids []int) {
-	for id := range m.roles {
-		ids = append(ids, id)
+// Status returns the value of the "status" field in the mutation.
+func (m *VirtualModelMutation) Status() (r virtualmodel.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
 	}
-	return
-}
-
-// ResetRoles resets all changes to the "roles" edge.
-func (m *UserMutation) ResetRoles() {
-	m.roles = nil
-	m.clearedroles = false
-	m.removedroles = nil
+	return *v, true
 }
 
-// AddUsageLogIDs adds the "usage_logs" edge to the UsageLog entity by ids.
-func (m *UserMutation) AddUsageLogIDs(ids ...int) {
-	if m.usage_logs == nil {
-		m.usage_logs = make(map[int]struct{})
+// OldStatus returns the old "status" field's value of the VirtualModel entity.
+// If the VirtualModel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VirtualModelMutation) OldStatus(ctx context.Context) (v virtualmodel.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.usage_logs[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
+	return oldValue.Status, nil
 }
 
-// ClearUsageLogs clears the "usage_logs" edge to the UsageLog entity.
-func (m *UserMutation) ClearUsageLogs() {
-	m.clearedusage_logs = true
+// ResetStatus resets all changes to the "status" field.
+func (m *VirtualModelMutation) ResetStatus() {
+	m.status = nil
 }
 
-// UsageLogsCleared reports if the "usage_logs" edge to the UsageLog entity was cleared.
-func (m *UserMutation) UsageLogsCleared() bool {
-	return m.clearedusage_logs
+// SetTargets sets the "targets" field.
+func (m *VirtualModelMutation) SetTargets(omt []objects.VirtualModelTarget) {
+	m.targets = &omt
+	m.appendtargets = nil
 }
 
-// RemoveUsageLogIDs removes the "usage_logs" edge to the UsageLog entity by IDs.
-func (m *UserMutation) RemoveUsageLogIDs(ids ...int) {
-	if m.removedusage_logs == nil {
-		m.removedusage_logs = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.usage_logs, ids[i])
-		m.removedusage_logs[ids[i]] = struct{}{}
+// Targets returns the value of the "targets" field in the mutation.
+func (m *VirtualModelMutation) Targets() (r []objects.VirtualModelTarget, exists bool) {
+	v := m.targets
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedUsageLogs returns the removed IDs of the "usage_logs" edge to the UsageLog entity.
-func (m *UserMutation) RemovedUsageLogsIDs() (ids []int) {
-	for id := range m.removedusage_logs {
-		ids = append(ids, id)
+// OldTargets returns the old "targets" field's value of the VirtualModel entity.
+// If the VirtualModel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VirtualModelMutation) OldTargets(ctx context.Context) (v []objects.VirtualModelTarget, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargets is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargets requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargets: %w", err)
+	}
+	return oldValue.Targets, nil
 }
 
-// UsageLogsIDs returns the "usage_logs" edge IDs in the mutation.
-func (m *UserMutation) UsageLogsIDs() (ids []int) {
-	for id := range m.usage_logs {
-		ids = append(ids, id)
+// AppendTargets adds omt to the "targets" field.
+func (m *VirtualModelMutation) AppendTargets(omt []objects.VirtualModelTarget) {
+	m.appendtargets = append(m.appendtargets, omt...)
+}
+
+// AppendedTargets returns the list of values that were appended to the "targets" field in this mutation.
+func (m *VirtualModelMutation) AppendedTargets() ([]objects.VirtualModelTarget, bool) {
+	if len(m.appendtargets) == 0 {
+		return nil, false
 	}
-	return
+	return m.appendtargets, true
 }
 
-// ResetUsageLogs resets all changes to the "usage_logs" edge.
-func (m *UserMutation) ResetUsageLogs() {
-	m.usage_logs = nil
-	m.clearedusage_logs = false
-	m.removedusage_logs = nil
+// ResetTargets resets all changes to the "targets" field.
+func (m *VirtualModelMutation) ResetTargets() {
+	m.targets = nil
+	m.appendtargets = nil
 }
 
-// Where appends a list predicates to the UserMutation builder.
-func (m *UserMutation) Where(ps ...predicate.User) {
+// Where appends a list predicates to the VirtualModelMutation builder.
+func (m *VirtualModelMutation) Where(ps ...predicate.VirtualModel) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// WhereP appends storage-level predicates to the VirtualModelMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.User, len(ps))
+func (m *VirtualModelMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.VirtualModel, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -9172,60 +12957,45 @@ func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *UserMutation) Op() Op {
+func (m *VirtualModelMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *UserMutation) SetOp(op Op) {
+func (m *VirtualModelMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (User).
-func (m *UserMutation) Type() string {
+// Type returns the node type of this mutation (VirtualModel).
+func (m *VirtualModelMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 12)
+func (m *VirtualModelMutation) Fields() []string {
+	fields := make([]string, 0, 7)
 	if m.created_at != nil {
-		fields = append(fields, user.FieldCreatedAt)
+		fields = append(fields, virtualmodel.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, user.FieldUpdatedAt)
+		fields = append(fields, virtualmodel.FieldUpdatedAt)
 	}
 	if m.deleted_at != nil {
-		fields = append(fields, user.FieldDeletedAt)
-	}
-	if m.email != nil {
-		fields = append(fields, user.FieldEmail)
-	}
-	if m.status != nil {
-		fields = append(fields, user.FieldStatus)
-	}
-	if m.prefer_language != nil {
-		fields = append(fields, user.FieldPreferLanguage)
-	}
-	if m.password != nil {
-		fields = append(fields, user.FieldPassword)
-	}
-	if m.first_name != nil {
-		fields = append(fields, user.FieldFirstName)
+		fields = append(fields, virtualmodel.FieldDeletedAt)
 	}
-	if m.last_name != nil {
-		fields = append(fields, user.FieldLastName)
+	if m.name != nil {
+		fields = append(fields, virtualmodel.FieldName)
 	}
-	if m.avatar != nil {
-		fields = append(fields, user.FieldAvatar)
+	if m.description != nil {
+		fields = append(fields, virtualmodel.FieldDescription)
 	}
-	if m.is_owner != nil {
-		fields = append(fields, user.FieldIsOwner)
+	if m.status != nil {
+		fields = append(fields, virtualmodel.FieldStatus)
 	}
-	if m.scopes != nil {
-		fields = append(fields, user.FieldScopes)
+	if m.targets != nil {
+		fields = append(fields, virtualmodel.FieldTargets)
 	}
 	return fields
 }
@@ -9233,32 +13003,22 @@ func (m *UserMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *UserMutation) Field(name string) (ent.Value, bool) {
+func (m *VirtualModelMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case user.FieldCreatedAt:
+	case virtualmodel.FieldCreatedAt:
 		return m.CreatedAt()
-	case user.FieldUpdatedAt:
+	case virtualmodel.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case user.FieldDeletedAt:
+	case virtualmodel.FieldDeletedAt:
 		return m.DeletedAt()
-	case user.FieldEmail:
-		return m.Email()
-	case user.FieldStatus:
+	case virtualmodel.FieldName:
+		return m.Name()
+	case virtualmodel.FieldDescription:
+		return m.Description()
+	case virtualmodel.FieldStatus:
 		return m.Status()
-	case user.FieldPreferLanguage:
-		return m.PreferLanguage()
-	case user.FieldPassword:
-		return m.Password()
-	case user.FieldFirstName:
-		return m.FirstName()
-	case user.FieldLastName:
-		return m.LastName()
-	case user.FieldAvatar:
-		return m.Avatar()
-	case user.FieldIsOwner:
-		return m.IsOwner()
-	case user.FieldScopes:
-		return m.Scopes()
+	case virtualmodel.FieldTargets:
+		return m.Targets()
 	}
 	return nil, false
 }
@@ -9266,135 +13026,90 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *VirtualModelMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case user.FieldCreatedAt:
+	case virtualmodel.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case user.FieldUpdatedAt:
+	case virtualmodel.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case user.FieldDeletedAt:
+	case virtualmodel.FieldDeletedAt:
 		return m.OldDeletedAt(ctx)
-	case user.FieldEmail:
-		return m.OldEmail(ctx)
-	case user.FieldStatus:
+	case virtualmodel.FieldName:
+		return m.OldName(ctx)
+	case virtualmodel.FieldDescription:
+		return m.OldDescription(ctx)
+	case virtualmodel.FieldStatus:
 		return m.OldStatus(ctx)
-	case user.FieldPreferLanguage:
-		return m.OldPreferLanguage(ctx)
-	case user.FieldPassword:
-		return m.OldPassword(ctx)
-	case user.FieldFirstName:
-		return m.OldFirstName(ctx)
-	case user.FieldLastName:
-		return m.OldLastName(ctx)
-	case user.FieldAvatar:
-		return m.OldAvatar(ctx)
-	case user.FieldIsOwner:
-		return m.OldIsOwner(ctx)
-	case user.FieldScopes:
-		return m.OldScopes(ctx)
+	case virtualmodel.FieldTargets:
+		return m.OldTargets(ctx)
 	}
-	return nil, fmt.Errorf("unknown User field %s", name)
+	return nil, fmt.Errorf("unknown VirtualModel field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) SetField(name string, value ent.Value) error {
+func (m *VirtualModelMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case user.FieldCreatedAt:
+	case virtualmodel.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case user.FieldUpdatedAt:
+	case virtualmodel.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case user.FieldDeletedAt:
+	case virtualmodel.FieldDeletedAt:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetDeletedAt(v)
 		return nil
-	case user.FieldEmail:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEmail(v)
-		return nil
-	case user.FieldStatus:
-		v, ok := value.(user.Status)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
-		return nil
-	case user.FieldPreferLanguage:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPreferLanguage(v)
-		return nil
-	case user.FieldPassword:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPassword(v)
-		return nil
-	case user.FieldFirstName:
+	case virtualmodel.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFirstName(v)
-		return nil
-	case user.FieldLastName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLastName(v)
+		m.SetName(v)
 		return nil
-	case user.FieldAvatar:
+	case virtualmodel.FieldDescription:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAvatar(v)
+		m.SetDescription(v)
 		return nil
-	case user.FieldIsOwner:
-		v, ok := value.(bool)
+	case virtualmodel.FieldStatus:
+		v, ok := value.(virtualmodel.Status)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsOwner(v)
+		m.SetStatus(v)
 		return nil
-	case user.FieldScopes:
-		v, ok := value.([]string)
+	case virtualmodel.FieldTargets:
+		v, ok := value.([]objects.VirtualModelTarget)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetScopes(v)
+		m.SetTargets(v)
 		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown VirtualModel field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *UserMutation) AddedFields() []string {
+func (m *VirtualModelMutation) AddedFields() []string {
 	var fields []string
 	if m.adddeleted_at != nil {
-		fields = append(fields, user.FieldDeletedAt)
+		fields = append(fields, virtualmodel.FieldDeletedAt)
 	}
 	return fields
 }
@@ -9402,9 +13117,9 @@ func (m *UserMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+func (m *VirtualModelMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case user.FieldDeletedAt:
+	case virtualmodel.FieldDeletedAt:
 		return m.AddedDeletedAt()
 	}
 	return nil, false
@@ -9413,9 +13128,9 @@ func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) AddField(name string, value ent.Value) error {
+func (m *VirtualModelMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case user.FieldDeletedAt:
+	case virtualmodel.FieldDeletedAt:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -9423,245 +13138,110 @@ func (m *UserMutation) AddField(name string, value ent.Value) error {
 		m.AddDeletedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown User numeric field %s", name)
+	return fmt.Errorf("unknown VirtualModel numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *UserMutation) ClearedFields() []string {
+func (m *VirtualModelMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(user.FieldAvatar) {
-		fields = append(fields, user.FieldAvatar)
-	}
-	if m.FieldCleared(user.FieldScopes) {
-		fields = append(fields, user.FieldScopes)
+	if m.FieldCleared(virtualmodel.FieldDescription) {
+		fields = append(fields, virtualmodel.FieldDescription)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *UserMutation) FieldCleared(name string) bool {
+func (m *VirtualModelMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *UserMutation) ClearField(name string) error {
+func (m *VirtualModelMutation) ClearField(name string) error {
 	switch name {
-	case user.FieldAvatar:
-		m.ClearAvatar()
-		return nil
-	case user.FieldScopes:
-		m.ClearScopes()
+	case virtualmodel.FieldDescription:
+		m.ClearDescription()
 		return nil
 	}
-	return fmt.Errorf("unknown User nullable field %s", name)
+	return fmt.Errorf("unknown VirtualModel nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *UserMutation) ResetField(name string) error {
+func (m *VirtualModelMutation) ResetField(name string) error {
 	switch name {
-	case user.FieldCreatedAt:
+	case virtualmodel.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case user.FieldUpdatedAt:
+	case virtualmodel.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case user.FieldDeletedAt:
+	case virtualmodel.FieldDeletedAt:
 		m.ResetDeletedAt()
 		return nil
-	case user.FieldEmail:
-		m.ResetEmail()
-		return nil
-	case user.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case user.FieldPreferLanguage:
-		m.ResetPreferLanguage()
-		return nil
-	case user.FieldPassword:
-		m.ResetPassword()
-		return nil
-	case user.FieldFirstName:
-		m.ResetFirstName()
-		return nil
-	case user.FieldLastName:
-		m.ResetLastName()
+	case virtualmodel.FieldName:
+		m.ResetName()
 		return nil
-	case user.FieldAvatar:
-		m.ResetAvatar()
+	case virtualmodel.FieldDescription:
+		m.ResetDescription()
 		return nil
-	case user.FieldIsOwner:
-		m.ResetIsOwner()
+	case virtualmodel.FieldStatus:
+		m.ResetStatus()
 		return nil
-	case user.FieldScopes:
-		m.ResetScopes()
+	case virtualmodel.FieldTargets:
+		m.ResetTargets()
 		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown VirtualModel field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.requests != nil {
-		edges = append(edges, user.EdgeRequests)
-	}
-	if m.api_keys != nil {
-		edges = append(edges, user.EdgeAPIKeys)
-	}
-	if m.roles != nil {
-		edges = append(edges, user.EdgeRoles)
-	}
-	if m.usage_logs != nil {
-		edges = append(edges, user.EdgeUsageLogs)
-	}
+func (m *VirtualModelMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *UserMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case user.EdgeRequests:
-		ids := make([]ent.Value, 0, len(m.requests))
-		for id := range m.requests {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeAPIKeys:
-		ids := make([]ent.Value, 0, len(m.api_keys))
-		for id := range m.api_keys {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeRoles:
-		ids := make([]ent.Value, 0, len(m.roles))
-		for id := range m.roles {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeUsageLogs:
-		ids := make([]ent.Value, 0, len(m.usage_logs))
-		for id := range m.usage_logs {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *VirtualModelMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.removedrequests != nil {
-		edges = append(edges, user.EdgeRequests)
-	}
-	if m.removedapi_keys != nil {
-		edges = append(edges, user.EdgeAPIKeys)
-	}
-	if m.removedroles != nil {
-		edges = append(edges, user.EdgeRoles)
-	}
-	if m.removedusage_logs != nil {
-		edges = append(edges, user.EdgeUsageLogs)
-	}
+func (m *VirtualModelMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *UserMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case user.EdgeRequests:
-		ids := make([]ent.Value, 0, len(m.removedrequests))
-		for id := range m.removedrequests {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeAPIKeys:
-		ids := make([]ent.Value, 0, len(m.removedapi_keys))
-		for id := range m.removedapi_keys {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeRoles:
-		ids := make([]ent.Value, 0, len(m.removedroles))
-		for id := range m.removedroles {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeUsageLogs:
-		ids := make([]ent.Value, 0, len(m.removedusage_logs))
-		for id := range m.removedusage_logs {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *VirtualModelMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.clearedrequests {
-		edges = append(edges, user.EdgeRequests)
-	}
-	if m.clearedapi_keys {
-		edges = append(edges, user.EdgeAPIKeys)
-	}
-	if m.clearedroles {
-		edges = append(edges, user.EdgeRoles)
-	}
-	if m.clearedusage_logs {
-		edges = append(edges, user.EdgeUsageLogs)
-	}
+func (m *VirtualModelMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *UserMutation) EdgeCleared(name string) bool {
-	switch name {
-	case user.EdgeRequests:
-		return m.clearedrequests
-	case user.EdgeAPIKeys:
-		return m.clearedapi_keys
-	case user.EdgeRoles:
-		return m.clearedroles
-	case user.EdgeUsageLogs:
-		return m.clearedusage_logs
-	}
+func (m *VirtualModelMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *UserMutation) ClearEdge(name string) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown User unique edge %s", name)
+func (m *VirtualModelMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown VirtualModel unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *UserMutation) ResetEdge(name string) error {
-	switch name {
-	case user.EdgeRequests:
-		m.ResetRequests()
-		return nil
-	case user.EdgeAPIKeys:
-		m.ResetAPIKeys()
-		return nil
-	case user.EdgeRoles:
-		m.ResetRoles()
-		return nil
-	case user.EdgeUsageLogs:
-		m.ResetUsageLogs()
-		return nil
-	}
-	return fmt.Errorf("unknown User edge %s", name)
+func (m *VirtualModelMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown VirtualModel edge %s", name)
 }
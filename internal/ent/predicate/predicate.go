@@ -18,9 +18,15 @@ type Request func(*sql.Selector)
 // RequestExecution is the predicate function for requestexecution builders.
 type RequestExecution func(*sql.Selector)
 
+// ResponseCache is the predicate function for responsecache builders.
+type ResponseCache func(*sql.Selector)
+
 // Role is the predicate function for role builders.
 type Role func(*sql.Selector)
 
+// RoutingRule is the predicate function for routingrule builders.
+type RoutingRule func(*sql.Selector)
+
 // System is the predicate function for system builders.
 type System func(*sql.Selector)
 
@@ -29,3 +35,6 @@ type UsageLog func(*sql.Selector)
 
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
+
+// VirtualModel is the predicate function for virtualmodel builders.
+type VirtualModel func(*sql.Selector)
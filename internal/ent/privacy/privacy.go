@@ -207,6 +207,30 @@ func (f RequestExecutionMutationRuleFunc) EvalMutation(ctx context.Context, m en
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.RequestExecutionMutation", m)
 }
 
+// The ResponseCacheQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type ResponseCacheQueryRuleFunc func(context.Context, *ent.ResponseCacheQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f ResponseCacheQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ResponseCacheQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.ResponseCacheQuery", q)
+}
+
+// The ResponseCacheMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type ResponseCacheMutationRuleFunc func(context.Context, *ent.ResponseCacheMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f ResponseCacheMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.ResponseCacheMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.ResponseCacheMutation", m)
+}
+
 // The RoleQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type RoleQueryRuleFunc func(context.Context, *ent.RoleQuery) error
@@ -231,6 +255,30 @@ func (f RoleMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation)
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.RoleMutation", m)
 }
 
+// The RoutingRuleQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type RoutingRuleQueryRuleFunc func(context.Context, *ent.RoutingRuleQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f RoutingRuleQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.RoutingRuleQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.RoutingRuleQuery", q)
+}
+
+// The RoutingRuleMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type RoutingRuleMutationRuleFunc func(context.Context, *ent.RoutingRuleMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f RoutingRuleMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.RoutingRuleMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.RoutingRuleMutation", m)
+}
+
 // The SystemQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type SystemQueryRuleFunc func(context.Context, *ent.SystemQuery) error
@@ -303,6 +351,30 @@ func (f UserMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation)
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.UserMutation", m)
 }
 
+// The VirtualModelQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type VirtualModelQueryRuleFunc func(context.Context, *ent.VirtualModelQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f VirtualModelQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.VirtualModelQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.VirtualModelQuery", q)
+}
+
+// The VirtualModelMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type VirtualModelMutationRuleFunc func(context.Context, *ent.VirtualModelMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f VirtualModelMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.VirtualModelMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.VirtualModelMutation", m)
+}
+
 type (
 	// Filter is the interface that wraps the Where function
 	// for filtering nodes in queries and mutations.
@@ -346,14 +418,20 @@ func queryFilter(q ent.Query) (Filter, error) {
 		return q.Filter(), nil
 	case *ent.RequestExecutionQuery:
 		return q.Filter(), nil
+	case *ent.ResponseCacheQuery:
+		return q.Filter(), nil
 	case *ent.RoleQuery:
 		return q.Filter(), nil
+	case *ent.RoutingRuleQuery:
+		return q.Filter(), nil
 	case *ent.SystemQuery:
 		return q.Filter(), nil
 	case *ent.UsageLogQuery:
 		return q.Filter(), nil
 	case *ent.UserQuery:
 		return q.Filter(), nil
+	case *ent.VirtualModelQuery:
+		return q.Filter(), nil
 	default:
 		return nil, Denyf("ent/privacy: unexpected query type %T for query filter", q)
 	}
@@ -369,14 +447,20 @@ func mutationFilter(m ent.Mutation) (Filter, error) {
 		return m.Filter(), nil
 	case *ent.RequestExecutionMutation:
 		return m.Filter(), nil
+	case *ent.ResponseCacheMutation:
+		return m.Filter(), nil
 	case *ent.RoleMutation:
 		return m.Filter(), nil
+	case *ent.RoutingRuleMutation:
+		return m.Filter(), nil
 	case *ent.SystemMutation:
 		return m.Filter(), nil
 	case *ent.UsageLogMutation:
 		return m.Filter(), nil
 	case *ent.UserMutation:
 		return m.Filter(), nil
+	case *ent.VirtualModelMutation:
+		return m.Filter(), nil
 	default:
 		return nil, Denyf("ent/privacy: unexpected mutation type %T for mutation filter", m)
 	}
@@ -48,6 +48,12 @@ type Request struct {
 	ChannelID int `json:"channel_id,omitempty"`
 	// ExternalID holds the value of the "external_id" field.
 	ExternalID string `json:"external_id,omitempty"`
+	// Cached holds the value of the "cached" field.
+	Cached bool `json:"cached,omitempty"`
+	// GuardrailViolations holds the value of the "guardrail_violations" field.
+	GuardrailViolations []objects.GuardrailViolation `json:"guardrail_violations,omitempty"`
+	// FallbackHops holds the value of the "fallback_hops" field.
+	FallbackHops []objects.FallbackHop `json:"fallback_hops,omitempty"`
 	// Status holds the value of the "status" field.
 	Status request.Status `json:"status,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -134,8 +140,10 @@ func (*Request) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case request.FieldRequestBody, request.FieldResponseBody, request.FieldResponseChunks:
+		case request.FieldRequestBody, request.FieldResponseBody, request.FieldResponseChunks, request.FieldGuardrailViolations, request.FieldFallbackHops:
 			values[i] = new([]byte)
+		case request.FieldCached:
+			values[i] = new(sql.NullBool)
 		case request.FieldID, request.FieldDeletedAt, request.FieldUserID, request.FieldAPIKeyID, request.FieldChannelID:
 			values[i] = new(sql.NullInt64)
 		case request.FieldSource, request.FieldModelID, request.FieldFormat, request.FieldExternalID, request.FieldStatus:
@@ -247,6 +255,28 @@ func (r *Request) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				r.ExternalID = value.String
 			}
+		case request.FieldCached:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field cached", values[i])
+			} else if value.Valid {
+				r.Cached = value.Bool
+			}
+		case request.FieldGuardrailViolations:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field guardrail_violations", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &r.GuardrailViolations); err != nil {
+					return fmt.Errorf("unmarshal field guardrail_violations: %w", err)
+				}
+			}
+		case request.FieldFallbackHops:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field fallback_hops", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &r.FallbackHops); err != nil {
+					return fmt.Errorf("unmarshal field fallback_hops: %w", err)
+				}
+			}
 		case request.FieldStatus:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field status", values[i])
@@ -353,6 +383,15 @@ func (r *Request) String() string {
 	builder.WriteString("external_id=")
 	builder.WriteString(r.ExternalID)
 	builder.WriteString(", ")
+	builder.WriteString("cached=")
+	builder.WriteString(fmt.Sprintf("%v", r.Cached))
+	builder.WriteString(", ")
+	builder.WriteString("guardrail_violations=")
+	builder.WriteString(fmt.Sprintf("%v", r.GuardrailViolations))
+	builder.WriteString(", ")
+	builder.WriteString("fallback_hops=")
+	builder.WriteString(fmt.Sprintf("%v", r.FallbackHops))
+	builder.WriteString(", ")
 	builder.WriteString("status=")
 	builder.WriteString(fmt.Sprintf("%v", r.Status))
 	builder.WriteByte(')')
@@ -44,6 +44,12 @@ const (
 	FieldChannelID = "channel_id"
 	// FieldExternalID holds the string denoting the external_id field in the database.
 	FieldExternalID = "external_id"
+	// FieldCached holds the string denoting the cached field in the database.
+	FieldCached = "cached"
+	// FieldGuardrailViolations holds the string denoting the guardrail_violations field in the database.
+	FieldGuardrailViolations = "guardrail_violations"
+	// FieldFallbackHops holds the string denoting the fallback_hops field in the database.
+	FieldFallbackHops = "fallback_hops"
 	// FieldStatus holds the string denoting the status field in the database.
 	FieldStatus = "status"
 	// EdgeUser holds the string denoting the user edge name in mutations.
@@ -111,6 +117,9 @@ var Columns = []string{
 	FieldResponseChunks,
 	FieldChannelID,
 	FieldExternalID,
+	FieldCached,
+	FieldGuardrailViolations,
+	FieldFallbackHops,
 	FieldStatus,
 }
 
@@ -143,6 +152,8 @@ var (
 	DefaultDeletedAt int
 	// DefaultFormat holds the default value on creation for the "format" field.
 	DefaultFormat string
+	// DefaultCached holds the default value on creation for the "cached" field.
+	DefaultCached bool
 )
 
 // Source defines the type for the "source" enum field.
@@ -256,6 +267,11 @@ func ByExternalID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldExternalID, opts...).ToFunc()
 }
 
+// ByCached orders the results by the cached field.
+func ByCached(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCached, opts...).ToFunc()
+}
+
 // ByStatus orders the results by the status field.
 func ByStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatus, opts...).ToFunc()
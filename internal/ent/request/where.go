@@ -100,6 +100,11 @@ func ExternalID(v string) predicate.Request {
 	return predicate.Request(sql.FieldEQ(FieldExternalID, v))
 }
 
+// Cached applies equality check predicate on the "cached" field. It's identical to CachedEQ.
+func Cached(v bool) predicate.Request {
+	return predicate.Request(sql.FieldEQ(FieldCached, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Request {
 	return predicate.Request(sql.FieldEQ(FieldCreatedAt, v))
@@ -545,6 +550,36 @@ func ExternalIDContainsFold(v string) predicate.Request {
 	return predicate.Request(sql.FieldContainsFold(FieldExternalID, v))
 }
 
+// CachedEQ applies the EQ predicate on the "cached" field.
+func CachedEQ(v bool) predicate.Request {
+	return predicate.Request(sql.FieldEQ(FieldCached, v))
+}
+
+// CachedNEQ applies the NEQ predicate on the "cached" field.
+func CachedNEQ(v bool) predicate.Request {
+	return predicate.Request(sql.FieldNEQ(FieldCached, v))
+}
+
+// GuardrailViolationsIsNil applies the IsNil predicate on the "guardrail_violations" field.
+func GuardrailViolationsIsNil() predicate.Request {
+	return predicate.Request(sql.FieldIsNull(FieldGuardrailViolations))
+}
+
+// GuardrailViolationsNotNil applies the NotNil predicate on the "guardrail_violations" field.
+func GuardrailViolationsNotNil() predicate.Request {
+	return predicate.Request(sql.FieldNotNull(FieldGuardrailViolations))
+}
+
+// FallbackHopsIsNil applies the IsNil predicate on the "fallback_hops" field.
+func FallbackHopsIsNil() predicate.Request {
+	return predicate.Request(sql.FieldIsNull(FieldFallbackHops))
+}
+
+// FallbackHopsNotNil applies the NotNil predicate on the "fallback_hops" field.
+func FallbackHopsNotNil() predicate.Request {
+	return predicate.Request(sql.FieldNotNull(FieldFallbackHops))
+}
+
 // StatusEQ applies the EQ predicate on the "status" field.
 func StatusEQ(v Status) predicate.Request {
 	return predicate.Request(sql.FieldEQ(FieldStatus, v))
@@ -170,6 +170,32 @@ func (rc *RequestCreate) SetNillableExternalID(s *string) *RequestCreate {
 	return rc
 }
 
+// SetCached sets the "cached" field.
+func (rc *RequestCreate) SetCached(b bool) *RequestCreate {
+	rc.mutation.SetCached(b)
+	return rc
+}
+
+// SetNillableCached sets the "cached" field if the given value is not nil.
+func (rc *RequestCreate) SetNillableCached(b *bool) *RequestCreate {
+	if b != nil {
+		rc.SetCached(*b)
+	}
+	return rc
+}
+
+// SetGuardrailViolations sets the "guardrail_violations" field.
+func (rc *RequestCreate) SetGuardrailViolations(ov []objects.GuardrailViolation) *RequestCreate {
+	rc.mutation.SetGuardrailViolations(ov)
+	return rc
+}
+
+// SetFallbackHops sets the "fallback_hops" field.
+func (rc *RequestCreate) SetFallbackHops(oh []objects.FallbackHop) *RequestCreate {
+	rc.mutation.SetFallbackHops(oh)
+	return rc
+}
+
 // SetStatus sets the "status" field.
 func (rc *RequestCreate) SetStatus(r request.Status) *RequestCreate {
 	rc.mutation.SetStatus(r)
@@ -284,6 +310,10 @@ func (rc *RequestCreate) defaults() error {
 		v := request.DefaultFormat
 		rc.mutation.SetFormat(v)
 	}
+	if _, ok := rc.mutation.Cached(); !ok {
+		v := request.DefaultCached
+		rc.mutation.SetCached(v)
+	}
 	return nil
 }
 
@@ -318,6 +348,9 @@ func (rc *RequestCreate) check() error {
 	if _, ok := rc.mutation.RequestBody(); !ok {
 		return &ValidationError{Name: "request_body", err: errors.New(`ent: missing required field "Request.request_body"`)}
 	}
+	if _, ok := rc.mutation.Cached(); !ok {
+		return &ValidationError{Name: "cached", err: errors.New(`ent: missing required field "Request.cached"`)}
+	}
 	if _, ok := rc.mutation.Status(); !ok {
 		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Request.status"`)}
 	}
@@ -396,6 +429,18 @@ func (rc *RequestCreate) createSpec() (*Request, *sqlgraph.CreateSpec) {
 		_spec.SetField(request.FieldExternalID, field.TypeString, value)
 		_node.ExternalID = value
 	}
+	if value, ok := rc.mutation.Cached(); ok {
+		_spec.SetField(request.FieldCached, field.TypeBool, value)
+		_node.Cached = value
+	}
+	if value, ok := rc.mutation.GuardrailViolations(); ok {
+		_spec.SetField(request.FieldGuardrailViolations, field.TypeJSON, value)
+		_node.GuardrailViolations = value
+	}
+	if value, ok := rc.mutation.FallbackHops(); ok {
+		_spec.SetField(request.FieldFallbackHops, field.TypeJSON, value)
+		_node.FallbackHops = value
+	}
 	if value, ok := rc.mutation.Status(); ok {
 		_spec.SetField(request.FieldStatus, field.TypeEnum, value)
 		_node.Status = value
@@ -637,6 +682,54 @@ func (u *RequestUpsert) ClearExternalID() *RequestUpsert {
 	return u
 }
 
+// SetCached sets the "cached" field.
+func (u *RequestUpsert) SetCached(v bool) *RequestUpsert {
+	u.Set(request.FieldCached, v)
+	return u
+}
+
+// UpdateCached sets the "cached" field to the value that was provided on create.
+func (u *RequestUpsert) UpdateCached() *RequestUpsert {
+	u.SetExcluded(request.FieldCached)
+	return u
+}
+
+// SetGuardrailViolations sets the "guardrail_violations" field.
+func (u *RequestUpsert) SetGuardrailViolations(v []objects.GuardrailViolation) *RequestUpsert {
+	u.Set(request.FieldGuardrailViolations, v)
+	return u
+}
+
+// UpdateGuardrailViolations sets the "guardrail_violations" field to the value that was provided on create.
+func (u *RequestUpsert) UpdateGuardrailViolations() *RequestUpsert {
+	u.SetExcluded(request.FieldGuardrailViolations)
+	return u
+}
+
+// ClearGuardrailViolations clears the value of the "guardrail_violations" field.
+func (u *RequestUpsert) ClearGuardrailViolations() *RequestUpsert {
+	u.SetNull(request.FieldGuardrailViolations)
+	return u
+}
+
+// SetFallbackHops sets the "fallback_hops" field.
+func (u *RequestUpsert) SetFallbackHops(v []objects.FallbackHop) *RequestUpsert {
+	u.Set(request.FieldFallbackHops, v)
+	return u
+}
+
+// UpdateFallbackHops sets the "fallback_hops" field to the value that was provided on create.
+func (u *RequestUpsert) UpdateFallbackHops() *RequestUpsert {
+	u.SetExcluded(request.FieldFallbackHops)
+	return u
+}
+
+// ClearFallbackHops clears the value of the "fallback_hops" field.
+func (u *RequestUpsert) ClearFallbackHops() *RequestUpsert {
+	u.SetNull(request.FieldFallbackHops)
+	return u
+}
+
 // SetStatus sets the "status" field.
 func (u *RequestUpsert) SetStatus(v request.Status) *RequestUpsert {
 	u.Set(request.FieldStatus, v)
@@ -831,6 +924,62 @@ func (u *RequestUpsertOne) ClearExternalID() *RequestUpsertOne {
 	})
 }
 
+// SetCached sets the "cached" field.
+func (u *RequestUpsertOne) SetCached(v bool) *RequestUpsertOne {
+	return u.Update(func(s *RequestUpsert) {
+		s.SetCached(v)
+	})
+}
+
+// UpdateCached sets the "cached" field to the value that was provided on create.
+func (u *RequestUpsertOne) UpdateCached() *RequestUpsertOne {
+	return u.Update(func(s *RequestUpsert) {
+		s.UpdateCached()
+	})
+}
+
+// SetGuardrailViolations sets the "guardrail_violations" field.
+func (u *RequestUpsertOne) SetGuardrailViolations(v []objects.GuardrailViolation) *RequestUpsertOne {
+	return u.Update(func(s *RequestUpsert) {
+		s.SetGuardrailViolations(v)
+	})
+}
+
+// UpdateGuardrailViolations sets the "guardrail_violations" field to the value that was provided on create.
+func (u *RequestUpsertOne) UpdateGuardrailViolations() *RequestUpsertOne {
+	return u.Update(func(s *RequestUpsert) {
+		s.UpdateGuardrailViolations()
+	})
+}
+
+// ClearGuardrailViolations clears the value of the "guardrail_violations" field.
+func (u *RequestUpsertOne) ClearGuardrailViolations() *RequestUpsertOne {
+	return u.Update(func(s *RequestUpsert) {
+		s.ClearGuardrailViolations()
+	})
+}
+
+// SetFallbackHops sets the "fallback_hops" field.
+func (u *RequestUpsertOne) SetFallbackHops(v []objects.FallbackHop) *RequestUpsertOne {
+	return u.Update(func(s *RequestUpsert) {
+		s.SetFallbackHops(v)
+	})
+}
+
+// UpdateFallbackHops sets the "fallback_hops" field to the value that was provided on create.
+func (u *RequestUpsertOne) UpdateFallbackHops() *RequestUpsertOne {
+	return u.Update(func(s *RequestUpsert) {
+		s.UpdateFallbackHops()
+	})
+}
+
+// ClearFallbackHops clears the value of the "fallback_hops" field.
+func (u *RequestUpsertOne) ClearFallbackHops() *RequestUpsertOne {
+	return u.Update(func(s *RequestUpsert) {
+		s.ClearFallbackHops()
+	})
+}
+
 // SetStatus sets the "status" field.
 func (u *RequestUpsertOne) SetStatus(v request.Status) *RequestUpsertOne {
 	return u.Update(func(s *RequestUpsert) {
@@ -1193,6 +1342,62 @@ func (u *RequestUpsertBulk) ClearExternalID() *RequestUpsertBulk {
 	})
 }
 
+// SetCached sets the "cached" field.
+func (u *RequestUpsertBulk) SetCached(v bool) *RequestUpsertBulk {
+	return u.Update(func(s *RequestUpsert) {
+		s.SetCached(v)
+	})
+}
+
+// UpdateCached sets the "cached" field to the value that was provided on create.
+func (u *RequestUpsertBulk) UpdateCached() *RequestUpsertBulk {
+	return u.Update(func(s *RequestUpsert) {
+		s.UpdateCached()
+	})
+}
+
+// SetGuardrailViolations sets the "guardrail_violations" field.
+func (u *RequestUpsertBulk) SetGuardrailViolations(v []objects.GuardrailViolation) *RequestUpsertBulk {
+	return u.Update(func(s *RequestUpsert) {
+		s.SetGuardrailViolations(v)
+	})
+}
+
+// UpdateGuardrailViolations sets the "guardrail_violations" field to the value that was provided on create.
+func (u *RequestUpsertBulk) UpdateGuardrailViolations() *RequestUpsertBulk {
+	return u.Update(func(s *RequestUpsert) {
+		s.UpdateGuardrailViolations()
+	})
+}
+
+// ClearGuardrailViolations clears the value of the "guardrail_violations" field.
+func (u *RequestUpsertBulk) ClearGuardrailViolations() *RequestUpsertBulk {
+	return u.Update(func(s *RequestUpsert) {
+		s.ClearGuardrailViolations()
+	})
+}
+
+// SetFallbackHops sets the "fallback_hops" field.
+func (u *RequestUpsertBulk) SetFallbackHops(v []objects.FallbackHop) *RequestUpsertBulk {
+	return u.Update(func(s *RequestUpsert) {
+		s.SetFallbackHops(v)
+	})
+}
+
+// UpdateFallbackHops sets the "fallback_hops" field to the value that was provided on create.
+func (u *RequestUpsertBulk) UpdateFallbackHops() *RequestUpsertBulk {
+	return u.Update(func(s *RequestUpsert) {
+		s.UpdateFallbackHops()
+	})
+}
+
+// ClearFallbackHops clears the value of the "fallback_hops" field.
+func (u *RequestUpsertBulk) ClearFallbackHops() *RequestUpsertBulk {
+	return u.Update(func(s *RequestUpsert) {
+		s.ClearFallbackHops()
+	})
+}
+
 // SetStatus sets the "status" field.
 func (u *RequestUpsertBulk) SetStatus(v request.Status) *RequestUpsertBulk {
 	return u.Update(func(s *RequestUpsert) {
@@ -137,6 +137,56 @@ func (ru *RequestUpdate) ClearExternalID() *RequestUpdate {
 	return ru
 }
 
+// SetCached sets the "cached" field.
+func (ru *RequestUpdate) SetCached(b bool) *RequestUpdate {
+	ru.mutation.SetCached(b)
+	return ru
+}
+
+// SetNillableCached sets the "cached" field if the given value is not nil.
+func (ru *RequestUpdate) SetNillableCached(b *bool) *RequestUpdate {
+	if b != nil {
+		ru.SetCached(*b)
+	}
+	return ru
+}
+
+// SetGuardrailViolations sets the "guardrail_violations" field.
+func (ru *RequestUpdate) SetGuardrailViolations(ov []objects.GuardrailViolation) *RequestUpdate {
+	ru.mutation.SetGuardrailViolations(ov)
+	return ru
+}
+
+// AppendGuardrailViolations appends ov to the "guardrail_violations" field.
+func (ru *RequestUpdate) AppendGuardrailViolations(ov []objects.GuardrailViolation) *RequestUpdate {
+	ru.mutation.AppendGuardrailViolations(ov)
+	return ru
+}
+
+// ClearGuardrailViolations clears the value of the "guardrail_violations" field.
+func (ru *RequestUpdate) ClearGuardrailViolations() *RequestUpdate {
+	ru.mutation.ClearGuardrailViolations()
+	return ru
+}
+
+// SetFallbackHops sets the "fallback_hops" field.
+func (ru *RequestUpdate) SetFallbackHops(oh []objects.FallbackHop) *RequestUpdate {
+	ru.mutation.SetFallbackHops(oh)
+	return ru
+}
+
+// AppendFallbackHops appends oh to the "fallback_hops" field.
+func (ru *RequestUpdate) AppendFallbackHops(oh []objects.FallbackHop) *RequestUpdate {
+	ru.mutation.AppendFallbackHops(oh)
+	return ru
+}
+
+// ClearFallbackHops clears the value of the "fallback_hops" field.
+func (ru *RequestUpdate) ClearFallbackHops() *RequestUpdate {
+	ru.mutation.ClearFallbackHops()
+	return ru
+}
+
 // SetStatus sets the "status" field.
 func (ru *RequestUpdate) SetStatus(r request.Status) *RequestUpdate {
 	ru.mutation.SetStatus(r)
@@ -349,6 +399,31 @@ func (ru *RequestUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if ru.mutation.ExternalIDCleared() {
 		_spec.ClearField(request.FieldExternalID, field.TypeString)
 	}
+	if value, ok := ru.mutation.Cached(); ok {
+		_spec.SetField(request.FieldCached, field.TypeBool, value)
+	}
+	if value, ok := ru.mutation.GuardrailViolations(); ok {
+		_spec.SetField(request.FieldGuardrailViolations, field.TypeJSON, value)
+	}
+	if value, ok := ru.mutation.AppendedGuardrailViolations(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, request.FieldGuardrailViolations, value)
+		})
+	}
+	if ru.mutation.GuardrailViolationsCleared() {
+		_spec.ClearField(request.FieldGuardrailViolations, field.TypeJSON)
+	}
+	if value, ok := ru.mutation.FallbackHops(); ok {
+		_spec.SetField(request.FieldFallbackHops, field.TypeJSON, value)
+	}
+	if value, ok := ru.mutation.AppendedFallbackHops(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, request.FieldFallbackHops, value)
+		})
+	}
+	if ru.mutation.FallbackHopsCleared() {
+		_spec.ClearField(request.FieldFallbackHops, field.TypeJSON)
+	}
 	if value, ok := ru.mutation.Status(); ok {
 		_spec.SetField(request.FieldStatus, field.TypeEnum, value)
 	}
@@ -596,6 +671,56 @@ func (ruo *RequestUpdateOne) ClearExternalID() *RequestUpdateOne {
 	return ruo
 }
 
+// SetCached sets the "cached" field.
+func (ruo *RequestUpdateOne) SetCached(b bool) *RequestUpdateOne {
+	ruo.mutation.SetCached(b)
+	return ruo
+}
+
+// SetNillableCached sets the "cached" field if the given value is not nil.
+func (ruo *RequestUpdateOne) SetNillableCached(b *bool) *RequestUpdateOne {
+	if b != nil {
+		ruo.SetCached(*b)
+	}
+	return ruo
+}
+
+// SetGuardrailViolations sets the "guardrail_violations" field.
+func (ruo *RequestUpdateOne) SetGuardrailViolations(ov []objects.GuardrailViolation) *RequestUpdateOne {
+	ruo.mutation.SetGuardrailViolations(ov)
+	return ruo
+}
+
+// AppendGuardrailViolations appends ov to the "guardrail_violations" field.
+func (ruo *RequestUpdateOne) AppendGuardrailViolations(ov []objects.GuardrailViolation) *RequestUpdateOne {
+	ruo.mutation.AppendGuardrailViolations(ov)
+	return ruo
+}
+
+// ClearGuardrailViolations clears the value of the "guardrail_violations" field.
+func (ruo *RequestUpdateOne) ClearGuardrailViolations() *RequestUpdateOne {
+	ruo.mutation.ClearGuardrailViolations()
+	return ruo
+}
+
+// SetFallbackHops sets the "fallback_hops" field.
+func (ruo *RequestUpdateOne) SetFallbackHops(oh []objects.FallbackHop) *RequestUpdateOne {
+	ruo.mutation.SetFallbackHops(oh)
+	return ruo
+}
+
+// AppendFallbackHops appends oh to the "fallback_hops" field.
+func (ruo *RequestUpdateOne) AppendFallbackHops(oh []objects.FallbackHop) *RequestUpdateOne {
+	ruo.mutation.AppendFallbackHops(oh)
+	return ruo
+}
+
+// ClearFallbackHops clears the value of the "fallback_hops" field.
+func (ruo *RequestUpdateOne) ClearFallbackHops() *RequestUpdateOne {
+	ruo.mutation.ClearFallbackHops()
+	return ruo
+}
+
 // SetStatus sets the "status" field.
 func (ruo *RequestUpdateOne) SetStatus(r request.Status) *RequestUpdateOne {
 	ruo.mutation.SetStatus(r)
@@ -838,6 +963,31 @@ func (ruo *RequestUpdateOne) sqlSave(ctx context.Context) (_node *Request, err e
 	if ruo.mutation.ExternalIDCleared() {
 		_spec.ClearField(request.FieldExternalID, field.TypeString)
 	}
+	if value, ok := ruo.mutation.Cached(); ok {
+		_spec.SetField(request.FieldCached, field.TypeBool, value)
+	}
+	if value, ok := ruo.mutation.GuardrailViolations(); ok {
+		_spec.SetField(request.FieldGuardrailViolations, field.TypeJSON, value)
+	}
+	if value, ok := ruo.mutation.AppendedGuardrailViolations(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, request.FieldGuardrailViolations, value)
+		})
+	}
+	if ruo.mutation.GuardrailViolationsCleared() {
+		_spec.ClearField(request.FieldGuardrailViolations, field.TypeJSON)
+	}
+	if value, ok := ruo.mutation.FallbackHops(); ok {
+		_spec.SetField(request.FieldFallbackHops, field.TypeJSON, value)
+	}
+	if value, ok := ruo.mutation.AppendedFallbackHops(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, request.FieldFallbackHops, value)
+		})
+	}
+	if ruo.mutation.FallbackHopsCleared() {
+		_spec.ClearField(request.FieldFallbackHops, field.TypeJSON)
+	}
 	if value, ok := ruo.mutation.Status(); ok {
 		_spec.SetField(request.FieldStatus, field.TypeEnum, value)
 	}
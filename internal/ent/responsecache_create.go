@@ -0,0 +1,636 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/looplj/axonhub/internal/ent/responsecache"
+	"github.com/looplj/axonhub/internal/objects"
+)
+
+// ResponseCacheCreate is the builder for creating a ResponseCache entity.
+type ResponseCacheCreate struct {
+	config
+	mutation *ResponseCacheMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rcc *ResponseCacheCreate) SetCreatedAt(t time.Time) *ResponseCacheCreate {
+	rcc.mutation.SetCreatedAt(t)
+	return rcc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rcc *ResponseCacheCreate) SetNillableCreatedAt(t *time.Time) *ResponseCacheCreate {
+	if t != nil {
+		rcc.SetCreatedAt(*t)
+	}
+	return rcc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rcc *ResponseCacheCreate) SetUpdatedAt(t time.Time) *ResponseCacheCreate {
+	rcc.mutation.SetUpdatedAt(t)
+	return rcc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (rcc *ResponseCacheCreate) SetNillableUpdatedAt(t *time.Time) *ResponseCacheCreate {
+	if t != nil {
+		rcc.SetUpdatedAt(*t)
+	}
+	return rcc
+}
+
+// SetKey sets the "key" field.
+func (rcc *ResponseCacheCreate) SetKey(s string) *ResponseCacheCreate {
+	rcc.mutation.SetKey(s)
+	return rcc
+}
+
+// SetResponse sets the "response" field.
+func (rcc *ResponseCacheCreate) SetResponse(orm objects.JSONRawMessage) *ResponseCacheCreate {
+	rcc.mutation.SetResponse(orm)
+	return rcc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (rcc *ResponseCacheCreate) SetExpiresAt(t time.Time) *ResponseCacheCreate {
+	rcc.mutation.SetExpiresAt(t)
+	return rcc
+}
+
+// Mutation returns the ResponseCacheMutation object of the builder.
+func (rcc *ResponseCacheCreate) Mutation() *ResponseCacheMutation {
+	return rcc.mutation
+}
+
+// Save creates the ResponseCache in the database.
+func (rcc *ResponseCacheCreate) Save(ctx context.Context) (*ResponseCache, error) {
+	rcc.defaults()
+	return withHooks(ctx, rcc.sqlSave, rcc.mutation, rcc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rcc *ResponseCacheCreate) SaveX(ctx context.Context) *ResponseCache {
+	v, err := rcc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rcc *ResponseCacheCreate) Exec(ctx context.Context) error {
+	_, err := rcc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcc *ResponseCacheCreate) ExecX(ctx context.Context) {
+	if err := rcc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rcc *ResponseCacheCreate) defaults() {
+	if _, ok := rcc.mutation.CreatedAt(); !ok {
+		v := responsecache.DefaultCreatedAt()
+		rcc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := rcc.mutation.UpdatedAt(); !ok {
+		v := responsecache.DefaultUpdatedAt()
+		rcc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rcc *ResponseCacheCreate) check() error {
+	if _, ok := rcc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ResponseCache.created_at"`)}
+	}
+	if _, ok := rcc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ResponseCache.updated_at"`)}
+	}
+	if _, ok := rcc.mutation.Key(); !ok {
+		return &ValidationError{Name: "key", err: errors.New(`ent: missing required field "ResponseCache.key"`)}
+	}
+	if _, ok := rcc.mutation.Response(); !ok {
+		return &ValidationError{Name: "response", err: errors.New(`ent: missing required field "ResponseCache.response"`)}
+	}
+	if _, ok := rcc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "ResponseCache.expires_at"`)}
+	}
+	return nil
+}
+
+func (rcc *ResponseCacheCreate) sqlSave(ctx context.Context) (*ResponseCache, error) {
+	if err := rcc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rcc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rcc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	rcc.mutation.id = &_node.ID
+	rcc.mutation.done = true
+	return _node, nil
+}
+
+func (rcc *ResponseCacheCreate) createSpec() (*ResponseCache, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ResponseCache{config: rcc.config}
+		_spec = sqlgraph.NewCreateSpec(responsecache.Table, sqlgraph.NewFieldSpec(responsecache.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = rcc.conflict
+	if value, ok := rcc.mutation.CreatedAt(); ok {
+		_spec.SetField(responsecache.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := rcc.mutation.UpdatedAt(); ok {
+		_spec.SetField(responsecache.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := rcc.mutation.Key(); ok {
+		_spec.SetField(responsecache.FieldKey, field.TypeString, value)
+		_node.Key = value
+	}
+	if value, ok := rcc.mutation.Response(); ok {
+		_spec.SetField(responsecache.FieldResponse, field.TypeJSON, value)
+		_node.Response = value
+	}
+	if value, ok := rcc.mutation.ExpiresAt(); ok {
+		_spec.SetField(responsecache.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ResponseCache.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ResponseCacheUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (rcc *ResponseCacheCreate) OnConflict(opts ...sql.ConflictOption) *ResponseCacheUpsertOne {
+	rcc.conflict = opts
+	return &ResponseCacheUpsertOne{
+		create: rcc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ResponseCache.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rcc *ResponseCacheCreate) OnConflictColumns(columns ...string) *ResponseCacheUpsertOne {
+	rcc.conflict = append(rcc.conflict, sql.ConflictColumns(columns...))
+	return &ResponseCacheUpsertOne{
+		create: rcc,
+	}
+}
+
+type (
+	// ResponseCacheUpsertOne is the builder for "upsert"-ing
+	//  one ResponseCache node.
+	ResponseCacheUpsertOne struct {
+		create *ResponseCacheCreate
+	}
+
+	// ResponseCacheUpsert is the "OnConflict" setter.
+	ResponseCacheUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ResponseCacheUpsert) SetUpdatedAt(v time.Time) *ResponseCacheUpsert {
+	u.Set(responsecache.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ResponseCacheUpsert) UpdateUpdatedAt() *ResponseCacheUpsert {
+	u.SetExcluded(responsecache.FieldUpdatedAt)
+	return u
+}
+
+// SetResponse sets the "response" field.
+func (u *ResponseCacheUpsert) SetResponse(v objects.JSONRawMessage) *ResponseCacheUpsert {
+	u.Set(responsecache.FieldResponse, v)
+	return u
+}
+
+// UpdateResponse sets the "response" field to the value that was provided on create.
+func (u *ResponseCacheUpsert) UpdateResponse() *ResponseCacheUpsert {
+	u.SetExcluded(responsecache.FieldResponse)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *ResponseCacheUpsert) SetExpiresAt(v time.Time) *ResponseCacheUpsert {
+	u.Set(responsecache.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *ResponseCacheUpsert) UpdateExpiresAt() *ResponseCacheUpsert {
+	u.SetExcluded(responsecache.FieldExpiresAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.ResponseCache.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ResponseCacheUpsertOne) UpdateNewValues() *ResponseCacheUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(responsecache.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.Key(); exists {
+			s.SetIgnore(responsecache.FieldKey)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ResponseCache.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ResponseCacheUpsertOne) Ignore() *ResponseCacheUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ResponseCacheUpsertOne) DoNothing() *ResponseCacheUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ResponseCacheCreate.OnConflict
+// documentation for more info.
+func (u *ResponseCacheUpsertOne) Update(set func(*ResponseCacheUpsert)) *ResponseCacheUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ResponseCacheUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ResponseCacheUpsertOne) SetUpdatedAt(v time.Time) *ResponseCacheUpsertOne {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ResponseCacheUpsertOne) UpdateUpdatedAt() *ResponseCacheUpsertOne {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetResponse sets the "response" field.
+func (u *ResponseCacheUpsertOne) SetResponse(v objects.JSONRawMessage) *ResponseCacheUpsertOne {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.SetResponse(v)
+	})
+}
+
+// UpdateResponse sets the "response" field to the value that was provided on create.
+func (u *ResponseCacheUpsertOne) UpdateResponse() *ResponseCacheUpsertOne {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.UpdateResponse()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *ResponseCacheUpsertOne) SetExpiresAt(v time.Time) *ResponseCacheUpsertOne {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *ResponseCacheUpsertOne) UpdateExpiresAt() *ResponseCacheUpsertOne {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ResponseCacheUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ResponseCacheCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ResponseCacheUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ResponseCacheUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ResponseCacheUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ResponseCacheCreateBulk is the builder for creating many ResponseCache entities in bulk.
+type ResponseCacheCreateBulk struct {
+	config
+	err      error
+	builders []*ResponseCacheCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ResponseCache entities in the database.
+func (rccb *ResponseCacheCreateBulk) Save(ctx context.Context) ([]*ResponseCache, error) {
+	if rccb.err != nil {
+		return nil, rccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rccb.builders))
+	nodes := make([]*ResponseCache, len(rccb.builders))
+	mutators := make([]Mutator, len(rccb.builders))
+	for i := range rccb.builders {
+		func(i int, root context.Context) {
+			builder := rccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ResponseCacheMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = rccb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rccb *ResponseCacheCreateBulk) SaveX(ctx context.Context) []*ResponseCache {
+	v, err := rccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rccb *ResponseCacheCreateBulk) Exec(ctx context.Context) error {
+	_, err := rccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rccb *ResponseCacheCreateBulk) ExecX(ctx context.Context) {
+	if err := rccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ResponseCache.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ResponseCacheUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (rccb *ResponseCacheCreateBulk) OnConflict(opts ...sql.ConflictOption) *ResponseCacheUpsertBulk {
+	rccb.conflict = opts
+	return &ResponseCacheUpsertBulk{
+		create: rccb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ResponseCache.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rccb *ResponseCacheCreateBulk) OnConflictColumns(columns ...string) *ResponseCacheUpsertBulk {
+	rccb.conflict = append(rccb.conflict, sql.ConflictColumns(columns...))
+	return &ResponseCacheUpsertBulk{
+		create: rccb,
+	}
+}
+
+// ResponseCacheUpsertBulk is the builder for "upsert"-ing
+// a bulk of ResponseCache nodes.
+type ResponseCacheUpsertBulk struct {
+	create *ResponseCacheCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ResponseCache.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ResponseCacheUpsertBulk) UpdateNewValues() *ResponseCacheUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(responsecache.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.Key(); exists {
+				s.SetIgnore(responsecache.FieldKey)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ResponseCache.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ResponseCacheUpsertBulk) Ignore() *ResponseCacheUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ResponseCacheUpsertBulk) DoNothing() *ResponseCacheUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ResponseCacheCreateBulk.OnConflict
+// documentation for more info.
+func (u *ResponseCacheUpsertBulk) Update(set func(*ResponseCacheUpsert)) *ResponseCacheUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ResponseCacheUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ResponseCacheUpsertBulk) SetUpdatedAt(v time.Time) *ResponseCacheUpsertBulk {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ResponseCacheUpsertBulk) UpdateUpdatedAt() *ResponseCacheUpsertBulk {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetResponse sets the "response" field.
+func (u *ResponseCacheUpsertBulk) SetResponse(v objects.JSONRawMessage) *ResponseCacheUpsertBulk {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.SetResponse(v)
+	})
+}
+
+// UpdateResponse sets the "response" field to the value that was provided on create.
+func (u *ResponseCacheUpsertBulk) UpdateResponse() *ResponseCacheUpsertBulk {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.UpdateResponse()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *ResponseCacheUpsertBulk) SetExpiresAt(v time.Time) *ResponseCacheUpsertBulk {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *ResponseCacheUpsertBulk) UpdateExpiresAt() *ResponseCacheUpsertBulk {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ResponseCacheUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ResponseCacheCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ResponseCacheCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ResponseCacheUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,304 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/looplj/axonhub/internal/ent/predicate"
+	"github.com/looplj/axonhub/internal/ent/responsecache"
+	"github.com/looplj/axonhub/internal/objects"
+)
+
+// ResponseCacheUpdate is the builder for updating ResponseCache entities.
+type ResponseCacheUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ResponseCacheMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ResponseCacheUpdate builder.
+func (rcu *ResponseCacheUpdate) Where(ps ...predicate.ResponseCache) *ResponseCacheUpdate {
+	rcu.mutation.Where(ps...)
+	return rcu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rcu *ResponseCacheUpdate) SetUpdatedAt(t time.Time) *ResponseCacheUpdate {
+	rcu.mutation.SetUpdatedAt(t)
+	return rcu
+}
+
+// SetResponse sets the "response" field.
+func (rcu *ResponseCacheUpdate) SetResponse(orm objects.JSONRawMessage) *ResponseCacheUpdate {
+	rcu.mutation.SetResponse(orm)
+	return rcu
+}
+
+// AppendResponse appends orm to the "response" field.
+func (rcu *ResponseCacheUpdate) AppendResponse(orm objects.JSONRawMessage) *ResponseCacheUpdate {
+	rcu.mutation.AppendResponse(orm)
+	return rcu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (rcu *ResponseCacheUpdate) SetExpiresAt(t time.Time) *ResponseCacheUpdate {
+	rcu.mutation.SetExpiresAt(t)
+	return rcu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (rcu *ResponseCacheUpdate) SetNillableExpiresAt(t *time.Time) *ResponseCacheUpdate {
+	if t != nil {
+		rcu.SetExpiresAt(*t)
+	}
+	return rcu
+}
+
+// Mutation returns the ResponseCacheMutation object of the builder.
+func (rcu *ResponseCacheUpdate) Mutation() *ResponseCacheMutation {
+	return rcu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rcu *ResponseCacheUpdate) Save(ctx context.Context) (int, error) {
+	rcu.defaults()
+	return withHooks(ctx, rcu.sqlSave, rcu.mutation, rcu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rcu *ResponseCacheUpdate) SaveX(ctx context.Context) int {
+	affected, err := rcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rcu *ResponseCacheUpdate) Exec(ctx context.Context) error {
+	_, err := rcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcu *ResponseCacheUpdate) ExecX(ctx context.Context) {
+	if err := rcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rcu *ResponseCacheUpdate) defaults() {
+	if _, ok := rcu.mutation.UpdatedAt(); !ok {
+		v := responsecache.UpdateDefaultUpdatedAt()
+		rcu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (rcu *ResponseCacheUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ResponseCacheUpdate {
+	rcu.modifiers = append(rcu.modifiers, modifiers...)
+	return rcu
+}
+
+func (rcu *ResponseCacheUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(responsecache.Table, responsecache.Columns, sqlgraph.NewFieldSpec(responsecache.FieldID, field.TypeInt))
+	if ps := rcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rcu.mutation.UpdatedAt(); ok {
+		_spec.SetField(responsecache.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := rcu.mutation.Response(); ok {
+		_spec.SetField(responsecache.FieldResponse, field.TypeJSON, value)
+	}
+	if value, ok := rcu.mutation.AppendedResponse(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, responsecache.FieldResponse, value)
+		})
+	}
+	if value, ok := rcu.mutation.ExpiresAt(); ok {
+		_spec.SetField(responsecache.FieldExpiresAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(rcu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, rcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{responsecache.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rcu.mutation.done = true
+	return n, nil
+}
+
+// ResponseCacheUpdateOne is the builder for updating a single ResponseCache entity.
+type ResponseCacheUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ResponseCacheMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rcuo *ResponseCacheUpdateOne) SetUpdatedAt(t time.Time) *ResponseCacheUpdateOne {
+	rcuo.mutation.SetUpdatedAt(t)
+	return rcuo
+}
+
+// SetResponse sets the "response" field.
+func (rcuo *ResponseCacheUpdateOne) SetResponse(orm objects.JSONRawMessage) *ResponseCacheUpdateOne {
+	rcuo.mutation.SetResponse(orm)
+	return rcuo
+}
+
+// AppendResponse appends orm to the "response" field.
+func (rcuo *ResponseCacheUpdateOne) AppendResponse(orm objects.JSONRawMessage) *ResponseCacheUpdateOne {
+	rcuo.mutation.AppendResponse(orm)
+	return rcuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (rcuo *ResponseCacheUpdateOne) SetExpiresAt(t time.Time) *ResponseCacheUpdateOne {
+	rcuo.mutation.SetExpiresAt(t)
+	return rcuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (rcuo *ResponseCacheUpdateOne) SetNillableExpiresAt(t *time.Time) *ResponseCacheUpdateOne {
+	if t != nil {
+		rcuo.SetExpiresAt(*t)
+	}
+	return rcuo
+}
+
+// Mutation returns the ResponseCacheMutation object of the builder.
+func (rcuo *ResponseCacheUpdateOne) Mutation() *ResponseCacheMutation {
+	return rcuo.mutation
+}
+
+// Where appends a list predicates to the ResponseCacheUpdate builder.
+func (rcuo *ResponseCacheUpdateOne) Where(ps ...predicate.ResponseCache) *ResponseCacheUpdateOne {
+	rcuo.mutation.Where(ps...)
+	return rcuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rcuo *ResponseCacheUpdateOne) Select(field string, fields ...string) *ResponseCacheUpdateOne {
+	rcuo.fields = append([]string{field}, fields...)
+	return rcuo
+}
+
+// Save executes the query and returns the updated ResponseCache entity.
+func (rcuo *ResponseCacheUpdateOne) Save(ctx context.Context) (*ResponseCache, error) {
+	rcuo.defaults()
+	return withHooks(ctx, rcuo.sqlSave, rcuo.mutation, rcuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rcuo *ResponseCacheUpdateOne) SaveX(ctx context.Context) *ResponseCache {
+	node, err := rcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rcuo *ResponseCacheUpdateOne) Exec(ctx context.Context) error {
+	_, err := rcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcuo *ResponseCacheUpdateOne) ExecX(ctx context.Context) {
+	if err := rcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rcuo *ResponseCacheUpdateOne) defaults() {
+	if _, ok := rcuo.mutation.UpdatedAt(); !ok {
+		v := responsecache.UpdateDefaultUpdatedAt()
+		rcuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (rcuo *ResponseCacheUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ResponseCacheUpdateOne {
+	rcuo.modifiers = append(rcuo.modifiers, modifiers...)
+	return rcuo
+}
+
+func (rcuo *ResponseCacheUpdateOne) sqlSave(ctx context.Context) (_node *ResponseCache, err error) {
+	_spec := sqlgraph.NewUpdateSpec(responsecache.Table, responsecache.Columns, sqlgraph.NewFieldSpec(responsecache.FieldID, field.TypeInt))
+	id, ok := rcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ResponseCache.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, responsecache.FieldID)
+		for _, f := range fields {
+			if !responsecache.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != responsecache.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rcuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(responsecache.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := rcuo.mutation.Response(); ok {
+		_spec.SetField(responsecache.FieldResponse, field.TypeJSON, value)
+	}
+	if value, ok := rcuo.mutation.AppendedResponse(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, responsecache.FieldResponse, value)
+		})
+	}
+	if value, ok := rcuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(responsecache.FieldExpiresAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(rcuo.modifiers...)
+	_node = &ResponseCache{config: rcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{responsecache.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rcuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,254 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/looplj/axonhub/internal/ent/routingrule"
+	"github.com/looplj/axonhub/internal/objects"
+)
+
+// RoutingRule is the model entity for the RoutingRule schema.
+type RoutingRule struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt int `json:"deleted_at,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Status holds the value of the "status" field.
+	Status routingrule.Status `json:"status,omitempty"`
+	// The rules are evaluated by the priority in descending order, the first matched rule is applied.
+	Priority int `json:"priority,omitempty"`
+	// Conditions holds the value of the "conditions" field.
+	Conditions *objects.RoutingConditions `json:"conditions,omitempty"`
+	// restrict selects only the rule channels, prefer tries the rule channels before the others.
+	Action routingrule.Action `json:"action,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the RoutingRuleQuery when eager-loading is set.
+	Edges        RoutingRuleEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// RoutingRuleEdges holds the relations/edges for other nodes in the graph.
+type RoutingRuleEdges struct {
+	// Channels holds the value of the channels edge.
+	Channels []*Channel `json:"channels,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+
+	namedChannels map[string][]*Channel
+}
+
+// ChannelsOrErr returns the Channels value or an error if the edge
+// was not loaded in eager-loading.
+func (e RoutingRuleEdges) ChannelsOrErr() ([]*Channel, error) {
+	if e.loadedTypes[0] {
+		return e.Channels, nil
+	}
+	return nil, &NotLoadedError{edge: "channels"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RoutingRule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case routingrule.FieldConditions:
+			values[i] = new([]byte)
+		case routingrule.FieldID, routingrule.FieldDeletedAt, routingrule.FieldPriority:
+			values[i] = new(sql.NullInt64)
+		case routingrule.FieldName, routingrule.FieldDescription, routingrule.FieldStatus, routingrule.FieldAction:
+			values[i] = new(sql.NullString)
+		case routingrule.FieldCreatedAt, routingrule.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RoutingRule fields.
+func (rr *RoutingRule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case routingrule.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			rr.ID = int(value.Int64)
+		case routingrule.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				rr.CreatedAt = value.Time
+			}
+		case routingrule.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				rr.UpdatedAt = value.Time
+			}
+		case routingrule.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				rr.DeletedAt = int(value.Int64)
+			}
+		case routingrule.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				rr.Name = value.String
+			}
+		case routingrule.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				rr.Description = value.String
+			}
+		case routingrule.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				rr.Status = routingrule.Status(value.String)
+			}
+		case routingrule.FieldPriority:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field priority", values[i])
+			} else if value.Valid {
+				rr.Priority = int(value.Int64)
+			}
+		case routingrule.FieldConditions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field conditions", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &rr.Conditions); err != nil {
+					return fmt.Errorf("unmarshal field conditions: %w", err)
+				}
+			}
+		case routingrule.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				rr.Action = routingrule.Action(value.String)
+			}
+		default:
+			rr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RoutingRule.
+// This includes values selected through modifiers, order, etc.
+func (rr *RoutingRule) Value(name string) (ent.Value, error) {
+	return rr.selectValues.Get(name)
+}
+
+// QueryChannels queries the "channels" edge of the RoutingRule entity.
+func (rr *RoutingRule) QueryChannels() *ChannelQuery {
+	return NewRoutingRuleClient(rr.config).QueryChannels(rr)
+}
+
+// Update returns a builder for updating this RoutingRule.
+// Note that you need to call RoutingRule.Unwrap() before calling this method if this RoutingRule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rr *RoutingRule) Update() *RoutingRuleUpdateOne {
+	return NewRoutingRuleClient(rr.config).UpdateOne(rr)
+}
+
+// Unwrap unwraps the RoutingRule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rr *RoutingRule) Unwrap() *RoutingRule {
+	_tx, ok := rr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RoutingRule is not a transactional entity")
+	}
+	rr.config.driver = _tx.drv
+	return rr
+}
+
+// String implements the fmt.Stringer.
+func (rr *RoutingRule) String() string {
+	var builder strings.Builder
+	builder.WriteString("RoutingRule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rr.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(rr.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(rr.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(fmt.Sprintf("%v", rr.DeletedAt))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(rr.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(rr.Description)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", rr.Status))
+	builder.WriteString(", ")
+	builder.WriteString("priority=")
+	builder.WriteString(fmt.Sprintf("%v", rr.Priority))
+	builder.WriteString(", ")
+	builder.WriteString("conditions=")
+	builder.WriteString(fmt.Sprintf("%v", rr.Conditions))
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(fmt.Sprintf("%v", rr.Action))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NamedChannels returns the Channels named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (rr *RoutingRule) NamedChannels(name string) ([]*Channel, error) {
+	if rr.Edges.namedChannels == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := rr.Edges.namedChannels[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (rr *RoutingRule) appendNamedChannels(name string, edges ...*Channel) {
+	if rr.Edges.namedChannels == nil {
+		rr.Edges.namedChannels = make(map[string][]*Channel)
+	}
+	if len(edges) == 0 {
+		rr.Edges.namedChannels[name] = []*Channel{}
+	} else {
+		rr.Edges.namedChannels[name] = append(rr.Edges.namedChannels[name], edges...)
+	}
+}
+
+// RoutingRules is a parsable slice of RoutingRule.
+type RoutingRules []*RoutingRule
@@ -0,0 +1,1055 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/looplj/axonhub/internal/ent/channel"
+	"github.com/looplj/axonhub/internal/ent/routingrule"
+	"github.com/looplj/axonhub/internal/objects"
+)
+
+// RoutingRuleCreate is the builder for creating a RoutingRule entity.
+type RoutingRuleCreate struct {
+	config
+	mutation *RoutingRuleMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rrc *RoutingRuleCreate) SetCreatedAt(t time.Time) *RoutingRuleCreate {
+	rrc.mutation.SetCreatedAt(t)
+	return rrc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rrc *RoutingRuleCreate) SetNillableCreatedAt(t *time.Time) *RoutingRuleCreate {
+	if t != nil {
+		rrc.SetCreatedAt(*t)
+	}
+	return rrc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rrc *RoutingRuleCreate) SetUpdatedAt(t time.Time) *RoutingRuleCreate {
+	rrc.mutation.SetUpdatedAt(t)
+	return rrc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (rrc *RoutingRuleCreate) SetNillableUpdatedAt(t *time.Time) *RoutingRuleCreate {
+	if t != nil {
+		rrc.SetUpdatedAt(*t)
+	}
+	return rrc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (rrc *RoutingRuleCreate) SetDeletedAt(i int) *RoutingRuleCreate {
+	rrc.mutation.SetDeletedAt(i)
+	return rrc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (rrc *RoutingRuleCreate) SetNillableDeletedAt(i *int) *RoutingRuleCreate {
+	if i != nil {
+		rrc.SetDeletedAt(*i)
+	}
+	return rrc
+}
+
+// SetName sets the "name" field.
+func (rrc *RoutingRuleCreate) SetName(s string) *RoutingRuleCreate {
+	rrc.mutation.SetName(s)
+	return rrc
+}
+
+// SetDescription sets the "description" field.
+func (rrc *RoutingRuleCreate) SetDescription(s string) *RoutingRuleCreate {
+	rrc.mutation.SetDescription(s)
+	return rrc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (rrc *RoutingRuleCreate) SetNillableDescription(s *string) *RoutingRuleCreate {
+	if s != nil {
+		rrc.SetDescription(*s)
+	}
+	return rrc
+}
+
+// SetStatus sets the "status" field.
+func (rrc *RoutingRuleCreate) SetStatus(r routingrule.Status) *RoutingRuleCreate {
+	rrc.mutation.SetStatus(r)
+	return rrc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (rrc *RoutingRuleCreate) SetNillableStatus(r *routingrule.Status) *RoutingRuleCreate {
+	if r != nil {
+		rrc.SetStatus(*r)
+	}
+	return rrc
+}
+
+// SetPriority sets the "priority" field.
+func (rrc *RoutingRuleCreate) SetPriority(i int) *RoutingRuleCreate {
+	rrc.mutation.SetPriority(i)
+	return rrc
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (rrc *RoutingRuleCreate) SetNillablePriority(i *int) *RoutingRuleCreate {
+	if i != nil {
+		rrc.SetPriority(*i)
+	}
+	return rrc
+}
+
+// SetConditions sets the "conditions" field.
+func (rrc *RoutingRuleCreate) SetConditions(oc *objects.RoutingConditions) *RoutingRuleCreate {
+	rrc.mutation.SetConditions(oc)
+	return rrc
+}
+
+// SetAction sets the "action" field.
+func (rrc *RoutingRuleCreate) SetAction(r routingrule.Action) *RoutingRuleCreate {
+	rrc.mutation.SetAction(r)
+	return rrc
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (rrc *RoutingRuleCreate) SetNillableAction(r *routingrule.Action) *RoutingRuleCreate {
+	if r != nil {
+		rrc.SetAction(*r)
+	}
+	return rrc
+}
+
+// AddChannelIDs adds the "channels" edge to the Channel entity by IDs.
+func (rrc *RoutingRuleCreate) AddChannelIDs(ids ...int) *RoutingRuleCreate {
+	rrc.mutation.AddChannelIDs(ids...)
+	return rrc
+}
+
+// AddChannels adds the "channels" edges to the Channel entity.
+func (rrc *RoutingRuleCreate) AddChannels(c ...*Channel) *RoutingRuleCreate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return rrc.AddChannelIDs(ids...)
+}
+
+// Mutation returns the RoutingRuleMutation object of the builder.
+func (rrc *RoutingRuleCreate) Mutation() *RoutingRuleMutation {
+	return rrc.mutation
+}
+
+// Save creates the RoutingRule in the database.
+func (rrc *RoutingRuleCreate) Save(ctx context.Context) (*RoutingRule, error) {
+	if err := rrc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, rrc.sqlSave, rrc.mutation, rrc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rrc *RoutingRuleCreate) SaveX(ctx context.Context) *RoutingRule {
+	v, err := rrc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rrc *RoutingRuleCreate) Exec(ctx context.Context) error {
+	_, err := rrc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rrc *RoutingRuleCreate) ExecX(ctx context.Context) {
+	if err := rrc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rrc *RoutingRuleCreate) defaults() error {
+	if _, ok := rrc.mutation.CreatedAt(); !ok {
+		if routingrule.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized routingrule.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := routingrule.DefaultCreatedAt()
+		rrc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := rrc.mutation.UpdatedAt(); !ok {
+		if routingrule.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized routingrule.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := routingrule.DefaultUpdatedAt()
+		rrc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := rrc.mutation.DeletedAt(); !ok {
+		v := routingrule.DefaultDeletedAt
+		rrc.mutation.SetDeletedAt(v)
+	}
+	if _, ok := rrc.mutation.Status(); !ok {
+		v := routingrule.DefaultStatus
+		rrc.mutation.SetStatus(v)
+	}
+	if _, ok := rrc.mutation.Priority(); !ok {
+		v := routingrule.DefaultPriority
+		rrc.mutation.SetPriority(v)
+	}
+	if _, ok := rrc.mutation.Conditions(); !ok {
+		v := routingrule.DefaultConditions
+		rrc.mutation.SetConditions(v)
+	}
+	if _, ok := rrc.mutation.Action(); !ok {
+		v := routingrule.DefaultAction
+		rrc.mutation.SetAction(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rrc *RoutingRuleCreate) check() error {
+	if _, ok := rrc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "RoutingRule.created_at"`)}
+	}
+	if _, ok := rrc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "RoutingRule.updated_at"`)}
+	}
+	if _, ok := rrc.mutation.DeletedAt(); !ok {
+		return &ValidationError{Name: "deleted_at", err: errors.New(`ent: missing required field "RoutingRule.deleted_at"`)}
+	}
+	if _, ok := rrc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "RoutingRule.name"`)}
+	}
+	if v, ok := rrc.mutation.Name(); ok {
+		if err := routingrule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "RoutingRule.name": %w`, err)}
+		}
+	}
+	if _, ok := rrc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "RoutingRule.status"`)}
+	}
+	if v, ok := rrc.mutation.Status(); ok {
+		if err := routingrule.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "RoutingRule.status": %w`, err)}
+		}
+	}
+	if _, ok := rrc.mutation.Priority(); !ok {
+		return &ValidationError{Name: "priority", err: errors.New(`ent: missing required field "RoutingRule.priority"`)}
+	}
+	if _, ok := rrc.mutation.Conditions(); !ok {
+		return &ValidationError{Name: "conditions", err: errors.New(`ent: missing required field "RoutingRule.conditions"`)}
+	}
+	if _, ok := rrc.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "RoutingRule.action"`)}
+	}
+	if v, ok := rrc.mutation.Action(); ok {
+		if err := routingrule.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "RoutingRule.action": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (rrc *RoutingRuleCreate) sqlSave(ctx context.Context) (*RoutingRule, error) {
+	if err := rrc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rrc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rrc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	rrc.mutation.id = &_node.ID
+	rrc.mutation.done = true
+	return _node, nil
+}
+
+func (rrc *RoutingRuleCreate) createSpec() (*RoutingRule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RoutingRule{config: rrc.config}
+		_spec = sqlgraph.NewCreateSpec(routingrule.Table, sqlgraph.NewFieldSpec(routingrule.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = rrc.conflict
+	if value, ok := rrc.mutation.CreatedAt(); ok {
+		_spec.SetField(routingrule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := rrc.mutation.UpdatedAt(); ok {
+		_spec.SetField(routingrule.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := rrc.mutation.DeletedAt(); ok {
+		_spec.SetField(routingrule.FieldDeletedAt, field.TypeInt, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := rrc.mutation.Name(); ok {
+		_spec.SetField(routingrule.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := rrc.mutation.Description(); ok {
+		_spec.SetField(routingrule.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := rrc.mutation.Status(); ok {
+		_spec.SetField(routingrule.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := rrc.mutation.Priority(); ok {
+		_spec.SetField(routingrule.FieldPriority, field.TypeInt, value)
+		_node.Priority = value
+	}
+	if value, ok := rrc.mutation.Conditions(); ok {
+		_spec.SetField(routingrule.FieldConditions, field.TypeJSON, value)
+		_node.Conditions = value
+	}
+	if value, ok := rrc.mutation.Action(); ok {
+		_spec.SetField(routingrule.FieldAction, field.TypeEnum, value)
+		_node.Action = value
+	}
+	if nodes := rrc.mutation.ChannelsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   routingrule.ChannelsTable,
+			Columns: routingrule.ChannelsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(channel.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RoutingRule.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RoutingRuleUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (rrc *RoutingRuleCreate) OnConflict(opts ...sql.ConflictOption) *RoutingRuleUpsertOne {
+	rrc.conflict = opts
+	return &RoutingRuleUpsertOne{
+		create: rrc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RoutingRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rrc *RoutingRuleCreate) OnConflictColumns(columns ...string) *RoutingRuleUpsertOne {
+	rrc.conflict = append(rrc.conflict, sql.ConflictColumns(columns...))
+	return &RoutingRuleUpsertOne{
+		create: rrc,
+	}
+}
+
+type (
+	// RoutingRuleUpsertOne is the builder for "upsert"-ing
+	//  one RoutingRule node.
+	RoutingRuleUpsertOne struct {
+		create *RoutingRuleCreate
+	}
+
+	// RoutingRuleUpsert is the "OnConflict" setter.
+	RoutingRuleUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RoutingRuleUpsert) SetUpdatedAt(v time.Time) *RoutingRuleUpsert {
+	u.Set(routingrule.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RoutingRuleUpsert) UpdateUpdatedAt() *RoutingRuleUpsert {
+	u.SetExcluded(routingrule.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *RoutingRuleUpsert) SetDeletedAt(v int) *RoutingRuleUpsert {
+	u.Set(routingrule.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *RoutingRuleUpsert) UpdateDeletedAt() *RoutingRuleUpsert {
+	u.SetExcluded(routingrule.FieldDeletedAt)
+	return u
+}
+
+// AddDeletedAt adds v to the "deleted_at" field.
+func (u *RoutingRuleUpsert) AddDeletedAt(v int) *RoutingRuleUpsert {
+	u.Add(routingrule.FieldDeletedAt, v)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *RoutingRuleUpsert) SetName(v string) *RoutingRuleUpsert {
+	u.Set(routingrule.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *RoutingRuleUpsert) UpdateName() *RoutingRuleUpsert {
+	u.SetExcluded(routingrule.FieldName)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *RoutingRuleUpsert) SetDescription(v string) *RoutingRuleUpsert {
+	u.Set(routingrule.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *RoutingRuleUpsert) UpdateDescription() *RoutingRuleUpsert {
+	u.SetExcluded(routingrule.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *RoutingRuleUpsert) ClearDescription() *RoutingRuleUpsert {
+	u.SetNull(routingrule.FieldDescription)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *RoutingRuleUpsert) SetStatus(v routingrule.Status) *RoutingRuleUpsert {
+	u.Set(routingrule.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *RoutingRuleUpsert) UpdateStatus() *RoutingRuleUpsert {
+	u.SetExcluded(routingrule.FieldStatus)
+	return u
+}
+
+// SetPriority sets the "priority" field.
+func (u *RoutingRuleUpsert) SetPriority(v int) *RoutingRuleUpsert {
+	u.Set(routingrule.FieldPriority, v)
+	return u
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *RoutingRuleUpsert) UpdatePriority() *RoutingRuleUpsert {
+	u.SetExcluded(routingrule.FieldPriority)
+	return u
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *RoutingRuleUpsert) AddPriority(v int) *RoutingRuleUpsert {
+	u.Add(routingrule.FieldPriority, v)
+	return u
+}
+
+// SetConditions sets the "conditions" field.
+func (u *RoutingRuleUpsert) SetConditions(v *objects.RoutingConditions) *RoutingRuleUpsert {
+	u.Set(routingrule.FieldConditions, v)
+	return u
+}
+
+// UpdateConditions sets the "conditions" field to the value that was provided on create.
+func (u *RoutingRuleUpsert) UpdateConditions() *RoutingRuleUpsert {
+	u.SetExcluded(routingrule.FieldConditions)
+	return u
+}
+
+// SetAction sets the "action" field.
+func (u *RoutingRuleUpsert) SetAction(v routingrule.Action) *RoutingRuleUpsert {
+	u.Set(routingrule.FieldAction, v)
+	return u
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *RoutingRuleUpsert) UpdateAction() *RoutingRuleUpsert {
+	u.SetExcluded(routingrule.FieldAction)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.RoutingRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *RoutingRuleUpsertOne) UpdateNewValues() *RoutingRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(routingrule.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RoutingRule.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *RoutingRuleUpsertOne) Ignore() *RoutingRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RoutingRuleUpsertOne) DoNothing() *RoutingRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RoutingRuleCreate.OnConflict
+// documentation for more info.
+func (u *RoutingRuleUpsertOne) Update(set func(*RoutingRuleUpsert)) *RoutingRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RoutingRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RoutingRuleUpsertOne) SetUpdatedAt(v time.Time) *RoutingRuleUpsertOne {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RoutingRuleUpsertOne) UpdateUpdatedAt() *RoutingRuleUpsertOne {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *RoutingRuleUpsertOne) SetDeletedAt(v int) *RoutingRuleUpsertOne {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// AddDeletedAt adds v to the "deleted_at" field.
+func (u *RoutingRuleUpsertOne) AddDeletedAt(v int) *RoutingRuleUpsertOne {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.AddDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *RoutingRuleUpsertOne) UpdateDeletedAt() *RoutingRuleUpsertOne {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *RoutingRuleUpsertOne) SetName(v string) *RoutingRuleUpsertOne {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *RoutingRuleUpsertOne) UpdateName() *RoutingRuleUpsertOne {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *RoutingRuleUpsertOne) SetDescription(v string) *RoutingRuleUpsertOne {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *RoutingRuleUpsertOne) UpdateDescription() *RoutingRuleUpsertOne {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *RoutingRuleUpsertOne) ClearDescription() *RoutingRuleUpsertOne {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *RoutingRuleUpsertOne) SetStatus(v routingrule.Status) *RoutingRuleUpsertOne {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *RoutingRuleUpsertOne) UpdateStatus() *RoutingRuleUpsertOne {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetPriority sets the "priority" field.
+func (u *RoutingRuleUpsertOne) SetPriority(v int) *RoutingRuleUpsertOne {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *RoutingRuleUpsertOne) AddPriority(v int) *RoutingRuleUpsertOne {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.AddPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *RoutingRuleUpsertOne) UpdatePriority() *RoutingRuleUpsertOne {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// SetConditions sets the "conditions" field.
+func (u *RoutingRuleUpsertOne) SetConditions(v *objects.RoutingConditions) *RoutingRuleUpsertOne {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.SetConditions(v)
+	})
+}
+
+// UpdateConditions sets the "conditions" field to the value that was provided on create.
+func (u *RoutingRuleUpsertOne) UpdateConditions() *RoutingRuleUpsertOne {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.UpdateConditions()
+	})
+}
+
+// SetAction sets the "action" field.
+func (u *RoutingRuleUpsertOne) SetAction(v routingrule.Action) *RoutingRuleUpsertOne {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.SetAction(v)
+	})
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *RoutingRuleUpsertOne) UpdateAction() *RoutingRuleUpsertOne {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.UpdateAction()
+	})
+}
+
+// Exec executes the query.
+func (u *RoutingRuleUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RoutingRuleCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RoutingRuleUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *RoutingRuleUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *RoutingRuleUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// RoutingRuleCreateBulk is the builder for creating many RoutingRule entities in bulk.
+type RoutingRuleCreateBulk struct {
+	config
+	err      error
+	builders []*RoutingRuleCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the RoutingRule entities in the database.
+func (rrcb *RoutingRuleCreateBulk) Save(ctx context.Context) ([]*RoutingRule, error) {
+	if rrcb.err != nil {
+		return nil, rrcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rrcb.builders))
+	nodes := make([]*RoutingRule, len(rrcb.builders))
+	mutators := make([]Mutator, len(rrcb.builders))
+	for i := range rrcb.builders {
+		func(i int, root context.Context) {
+			builder := rrcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RoutingRuleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rrcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = rrcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rrcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rrcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rrcb *RoutingRuleCreateBulk) SaveX(ctx context.Context) []*RoutingRule {
+	v, err := rrcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rrcb *RoutingRuleCreateBulk) Exec(ctx context.Context) error {
+	_, err := rrcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rrcb *RoutingRuleCreateBulk) ExecX(ctx context.Context) {
+	if err := rrcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RoutingRule.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RoutingRuleUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (rrcb *RoutingRuleCreateBulk) OnConflict(opts ...sql.ConflictOption) *RoutingRuleUpsertBulk {
+	rrcb.conflict = opts
+	return &RoutingRuleUpsertBulk{
+		create: rrcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RoutingRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rrcb *RoutingRuleCreateBulk) OnConflictColumns(columns ...string) *RoutingRuleUpsertBulk {
+	rrcb.conflict = append(rrcb.conflict, sql.ConflictColumns(columns...))
+	return &RoutingRuleUpsertBulk{
+		create: rrcb,
+	}
+}
+
+// RoutingRuleUpsertBulk is the builder for "upsert"-ing
+// a bulk of RoutingRule nodes.
+type RoutingRuleUpsertBulk struct {
+	create *RoutingRuleCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.RoutingRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *RoutingRuleUpsertBulk) UpdateNewValues() *RoutingRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(routingrule.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RoutingRule.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *RoutingRuleUpsertBulk) Ignore() *RoutingRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RoutingRuleUpsertBulk) DoNothing() *RoutingRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RoutingRuleCreateBulk.OnConflict
+// documentation for more info.
+func (u *RoutingRuleUpsertBulk) Update(set func(*RoutingRuleUpsert)) *RoutingRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RoutingRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RoutingRuleUpsertBulk) SetUpdatedAt(v time.Time) *RoutingRuleUpsertBulk {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RoutingRuleUpsertBulk) UpdateUpdatedAt() *RoutingRuleUpsertBulk {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *RoutingRuleUpsertBulk) SetDeletedAt(v int) *RoutingRuleUpsertBulk {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// AddDeletedAt adds v to the "deleted_at" field.
+func (u *RoutingRuleUpsertBulk) AddDeletedAt(v int) *RoutingRuleUpsertBulk {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.AddDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *RoutingRuleUpsertBulk) UpdateDeletedAt() *RoutingRuleUpsertBulk {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *RoutingRuleUpsertBulk) SetName(v string) *RoutingRuleUpsertBulk {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *RoutingRuleUpsertBulk) UpdateName() *RoutingRuleUpsertBulk {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *RoutingRuleUpsertBulk) SetDescription(v string) *RoutingRuleUpsertBulk {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *RoutingRuleUpsertBulk) UpdateDescription() *RoutingRuleUpsertBulk {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *RoutingRuleUpsertBulk) ClearDescription() *RoutingRuleUpsertBulk {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *RoutingRuleUpsertBulk) SetStatus(v routingrule.Status) *RoutingRuleUpsertBulk {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *RoutingRuleUpsertBulk) UpdateStatus() *RoutingRuleUpsertBulk {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetPriority sets the "priority" field.
+func (u *RoutingRuleUpsertBulk) SetPriority(v int) *RoutingRuleUpsertBulk {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *RoutingRuleUpsertBulk) AddPriority(v int) *RoutingRuleUpsertBulk {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.AddPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *RoutingRuleUpsertBulk) UpdatePriority() *RoutingRuleUpsertBulk {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// SetConditions sets the "conditions" field.
+func (u *RoutingRuleUpsertBulk) SetConditions(v *objects.RoutingConditions) *RoutingRuleUpsertBulk {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.SetConditions(v)
+	})
+}
+
+// UpdateConditions sets the "conditions" field to the value that was provided on create.
+func (u *RoutingRuleUpsertBulk) UpdateConditions() *RoutingRuleUpsertBulk {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.UpdateConditions()
+	})
+}
+
+// SetAction sets the "action" field.
+func (u *RoutingRuleUpsertBulk) SetAction(v routingrule.Action) *RoutingRuleUpsertBulk {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.SetAction(v)
+	})
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *RoutingRuleUpsertBulk) UpdateAction() *RoutingRuleUpsertBulk {
+	return u.Update(func(s *RoutingRuleUpsert) {
+		s.UpdateAction()
+	})
+}
+
+// Exec executes the query.
+func (u *RoutingRuleUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the RoutingRuleCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RoutingRuleCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RoutingRuleUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,695 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/looplj/axonhub/internal/ent/channel"
+	"github.com/looplj/axonhub/internal/ent/predicate"
+	"github.com/looplj/axonhub/internal/ent/routingrule"
+)
+
+// RoutingRuleQuery is the builder for querying RoutingRule entities.
+type RoutingRuleQuery struct {
+	config
+	ctx               *QueryContext
+	order             []routingrule.OrderOption
+	inters            []Interceptor
+	predicates        []predicate.RoutingRule
+	withChannels      *ChannelQuery
+	loadTotal         []func(context.Context, []*RoutingRule) error
+	modifiers         []func(*sql.Selector)
+	withNamedChannels map[string]*ChannelQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the RoutingRuleQuery builder.
+func (rrq *RoutingRuleQuery) Where(ps ...predicate.RoutingRule) *RoutingRuleQuery {
+	rrq.predicates = append(rrq.predicates, ps...)
+	return rrq
+}
+
+// Limit the number of records to be returned by this query.
+func (rrq *RoutingRuleQuery) Limit(limit int) *RoutingRuleQuery {
+	rrq.ctx.Limit = &limit
+	return rrq
+}
+
+// Offset to start from.
+func (rrq *RoutingRuleQuery) Offset(offset int) *RoutingRuleQuery {
+	rrq.ctx.Offset = &offset
+	return rrq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (rrq *RoutingRuleQuery) Unique(unique bool) *RoutingRuleQuery {
+	rrq.ctx.Unique = &unique
+	return rrq
+}
+
+// Order specifies how the records should be ordered.
+func (rrq *RoutingRuleQuery) Order(o ...routingrule.OrderOption) *RoutingRuleQuery {
+	rrq.order = append(rrq.order, o...)
+	return rrq
+}
+
+// QueryChannels chains the current query on the "channels" edge.
+func (rrq *RoutingRuleQuery) QueryChannels() *ChannelQuery {
+	query := (&ChannelClient{config: rrq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rrq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rrq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(routingrule.Table, routingrule.FieldID, selector),
+			sqlgraph.To(channel.Table, channel.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, routingrule.ChannelsTable, routingrule.ChannelsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(rrq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first RoutingRule entity from the query.
+// Returns a *NotFoundError when no RoutingRule was found.
+func (rrq *RoutingRuleQuery) First(ctx context.Context) (*RoutingRule, error) {
+	nodes, err := rrq.Limit(1).All(setContextOp(ctx, rrq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{routingrule.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (rrq *RoutingRuleQuery) FirstX(ctx context.Context) *RoutingRule {
+	node, err := rrq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first RoutingRule ID from the query.
+// Returns a *NotFoundError when no RoutingRule ID was found.
+func (rrq *RoutingRuleQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = rrq.Limit(1).IDs(setContextOp(ctx, rrq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{routingrule.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (rrq *RoutingRuleQuery) FirstIDX(ctx context.Context) int {
+	id, err := rrq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single RoutingRule entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one RoutingRule entity is found.
+// Returns a *NotFoundError when no RoutingRule entities are found.
+func (rrq *RoutingRuleQuery) Only(ctx context.Context) (*RoutingRule, error) {
+	nodes, err := rrq.Limit(2).All(setContextOp(ctx, rrq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{routingrule.Label}
+	default:
+		return nil, &NotSingularError{routingrule.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (rrq *RoutingRuleQuery) OnlyX(ctx context.Context) *RoutingRule {
+	node, err := rrq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only RoutingRule ID in the query.
+// Returns a *NotSingularError when more than one RoutingRule ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (rrq *RoutingRuleQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = rrq.Limit(2).IDs(setContextOp(ctx, rrq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{routingrule.Label}
+	default:
+		err = &NotSingularError{routingrule.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (rrq *RoutingRuleQuery) OnlyIDX(ctx context.Context) int {
+	id, err := rrq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of RoutingRules.
+func (rrq *RoutingRuleQuery) All(ctx context.Context) ([]*RoutingRule, error) {
+	ctx = setContextOp(ctx, rrq.ctx, ent.OpQueryAll)
+	if err := rrq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*RoutingRule, *RoutingRuleQuery]()
+	return withInterceptors[[]*RoutingRule](ctx, rrq, qr, rrq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (rrq *RoutingRuleQuery) AllX(ctx context.Context) []*RoutingRule {
+	nodes, err := rrq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of RoutingRule IDs.
+func (rrq *RoutingRuleQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if rrq.ctx.Unique == nil && rrq.path != nil {
+		rrq.Unique(true)
+	}
+	ctx = setContextOp(ctx, rrq.ctx, ent.OpQueryIDs)
+	if err = rrq.Select(routingrule.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (rrq *RoutingRuleQuery) IDsX(ctx context.Context) []int {
+	ids, err := rrq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (rrq *RoutingRuleQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, rrq.ctx, ent.OpQueryCount)
+	if err := rrq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, rrq, querierCount[*RoutingRuleQuery](), rrq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (rrq *RoutingRuleQuery) CountX(ctx context.Context) int {
+	count, err := rrq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (rrq *RoutingRuleQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, rrq.ctx, ent.OpQueryExist)
+	switch _, err := rrq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (rrq *RoutingRuleQuery) ExistX(ctx context.Context) bool {
+	exist, err := rrq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the RoutingRuleQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (rrq *RoutingRuleQuery) Clone() *RoutingRuleQuery {
+	if rrq == nil {
+		return nil
+	}
+	return &RoutingRuleQuery{
+		config:       rrq.config,
+		ctx:          rrq.ctx.Clone(),
+		order:        append([]routingrule.OrderOption{}, rrq.order...),
+		inters:       append([]Interceptor{}, rrq.inters...),
+		predicates:   append([]predicate.RoutingRule{}, rrq.predicates...),
+		withChannels: rrq.withChannels.Clone(),
+		// clone intermediate query.
+		sql:       rrq.sql.Clone(),
+		path:      rrq.path,
+		modifiers: append([]func(*sql.Selector){}, rrq.modifiers...),
+	}
+}
+
+// WithChannels tells the query-builder to eager-load the nodes that are connected to
+// the "channels" edge. The optional arguments are used to configure the query builder of the edge.
+func (rrq *RoutingRuleQuery) WithChannels(opts ...func(*ChannelQuery)) *RoutingRuleQuery {
+	query := (&ChannelClient{config: rrq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rrq.withChannels = query
+	return rrq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.RoutingRule.Query().
+//		GroupBy(routingrule.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (rrq *RoutingRuleQuery) GroupBy(field string, fields ...string) *RoutingRuleGroupBy {
+	rrq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &RoutingRuleGroupBy{build: rrq}
+	grbuild.flds = &rrq.ctx.Fields
+	grbuild.label = routingrule.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.RoutingRule.Query().
+//		Select(routingrule.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (rrq *RoutingRuleQuery) Select(fields ...string) *RoutingRuleSelect {
+	rrq.ctx.Fields = append(rrq.ctx.Fields, fields...)
+	sbuild := &RoutingRuleSelect{RoutingRuleQuery: rrq}
+	sbuild.label = routingrule.Label
+	sbuild.flds, sbuild.scan = &rrq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a RoutingRuleSelect configured with the given aggregations.
+func (rrq *RoutingRuleQuery) Aggregate(fns ...AggregateFunc) *RoutingRuleSelect {
+	return rrq.Select().Aggregate(fns...)
+}
+
+func (rrq *RoutingRuleQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range rrq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, rrq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range rrq.ctx.Fields {
+		if !routingrule.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if rrq.path != nil {
+		prev, err := rrq.path(ctx)
+		if err != nil {
+			return err
+		}
+		rrq.sql = prev
+	}
+	if routingrule.Policy == nil {
+		return errors.New("ent: uninitialized routingrule.Policy (forgotten import ent/runtime?)")
+	}
+	if err := routingrule.Policy.EvalQuery(ctx, rrq); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (rrq *RoutingRuleQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*RoutingRule, error) {
+	var (
+		nodes       = []*RoutingRule{}
+		_spec       = rrq.querySpec()
+		loadedTypes = [1]bool{
+			rrq.withChannels != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*RoutingRule).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &RoutingRule{config: rrq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(rrq.modifiers) > 0 {
+		_spec.Modifiers = rrq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, rrq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := rrq.withChannels; query != nil {
+		if err := rrq.loadChannels(ctx, query, nodes,
+			func(n *RoutingRule) { n.Edges.Channels = []*Channel{} },
+			func(n *RoutingRule, e *Channel) { n.Edges.Channels = append(n.Edges.Channels, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range rrq.withNamedChannels {
+		if err := rrq.loadChannels(ctx, query, nodes,
+			func(n *RoutingRule) { n.appendNamedChannels(name) },
+			func(n *RoutingRule, e *Channel) { n.appendNamedChannels(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range rrq.loadTotal {
+		if err := rrq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (rrq *RoutingRuleQuery) loadChannels(ctx context.Context, query *ChannelQuery, nodes []*RoutingRule, init func(*RoutingRule), assign func(*RoutingRule, *Channel)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*RoutingRule)
+	nids := make(map[int]map[*RoutingRule]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(routingrule.ChannelsTable)
+		s.Join(joinT).On(s.C(channel.FieldID), joinT.C(routingrule.ChannelsPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(routingrule.ChannelsPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(routingrule.ChannelsPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*RoutingRule]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Channel](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "channels" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+
+func (rrq *RoutingRuleQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := rrq.querySpec()
+	if len(rrq.modifiers) > 0 {
+		_spec.Modifiers = rrq.modifiers
+	}
+	_spec.Node.Columns = rrq.ctx.Fields
+	if len(rrq.ctx.Fields) > 0 {
+		_spec.Unique = rrq.ctx.Unique != nil && *rrq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, rrq.driver, _spec)
+}
+
+func (rrq *RoutingRuleQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(routingrule.Table, routingrule.Columns, sqlgraph.NewFieldSpec(routingrule.FieldID, field.TypeInt))
+	_spec.From = rrq.sql
+	if unique := rrq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if rrq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := rrq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, routingrule.FieldID)
+		for i := range fields {
+			if fields[i] != routingrule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := rrq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := rrq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := rrq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := rrq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (rrq *RoutingRuleQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(rrq.driver.Dialect())
+	t1 := builder.Table(routingrule.Table)
+	columns := rrq.ctx.Fields
+	if len(columns) == 0 {
+		columns = routingrule.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if rrq.sql != nil {
+		selector = rrq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if rrq.ctx.Unique != nil && *rrq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range rrq.modifiers {
+		m(selector)
+	}
+	for _, p := range rrq.predicates {
+		p(selector)
+	}
+	for _, p := range rrq.order {
+		p(selector)
+	}
+	if offset := rrq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := rrq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (rrq *RoutingRuleQuery) Modify(modifiers ...func(s *sql.Selector)) *RoutingRuleSelect {
+	rrq.modifiers = append(rrq.modifiers, modifiers...)
+	return rrq.Select()
+}
+
+// WithNamedChannels tells the query-builder to eager-load the nodes that are connected to the "channels"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (rrq *RoutingRuleQuery) WithNamedChannels(name string, opts ...func(*ChannelQuery)) *RoutingRuleQuery {
+	query := (&ChannelClient{config: rrq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if rrq.withNamedChannels == nil {
+		rrq.withNamedChannels = make(map[string]*ChannelQuery)
+	}
+	rrq.withNamedChannels[name] = query
+	return rrq
+}
+
+// RoutingRuleGroupBy is the group-by builder for RoutingRule entities.
+type RoutingRuleGroupBy struct {
+	selector
+	build *RoutingRuleQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (rrgb *RoutingRuleGroupBy) Aggregate(fns ...AggregateFunc) *RoutingRuleGroupBy {
+	rrgb.fns = append(rrgb.fns, fns...)
+	return rrgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rrgb *RoutingRuleGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rrgb.build.ctx, ent.OpQueryGroupBy)
+	if err := rrgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RoutingRuleQuery, *RoutingRuleGroupBy](ctx, rrgb.build, rrgb, rrgb.build.inters, v)
+}
+
+func (rrgb *RoutingRuleGroupBy) sqlScan(ctx context.Context, root *RoutingRuleQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(rrgb.fns))
+	for _, fn := range rrgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*rrgb.flds)+len(rrgb.fns))
+		for _, f := range *rrgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*rrgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rrgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// RoutingRuleSelect is the builder for selecting fields of RoutingRule entities.
+type RoutingRuleSelect struct {
+	*RoutingRuleQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (rrs *RoutingRuleSelect) Aggregate(fns ...AggregateFunc) *RoutingRuleSelect {
+	rrs.fns = append(rrs.fns, fns...)
+	return rrs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rrs *RoutingRuleSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rrs.ctx, ent.OpQuerySelect)
+	if err := rrs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RoutingRuleQuery, *RoutingRuleSelect](ctx, rrs.RoutingRuleQuery, rrs, rrs.inters, v)
+}
+
+func (rrs *RoutingRuleSelect) sqlScan(ctx context.Context, root *RoutingRuleQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(rrs.fns))
+	for _, fn := range rrs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*rrs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rrs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (rrs *RoutingRuleSelect) Modify(modifiers ...func(s *sql.Selector)) *RoutingRuleSelect {
+	rrs.modifiers = append(rrs.modifiers, modifiers...)
+	return rrs
+}
@@ -0,0 +1,730 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/looplj/axonhub/internal/ent/channel"
+	"github.com/looplj/axonhub/internal/ent/predicate"
+	"github.com/looplj/axonhub/internal/ent/routingrule"
+	"github.com/looplj/axonhub/internal/objects"
+)
+
+// RoutingRuleUpdate is the builder for updating RoutingRule entities.
+type RoutingRuleUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *RoutingRuleMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the RoutingRuleUpdate builder.
+func (rru *RoutingRuleUpdate) Where(ps ...predicate.RoutingRule) *RoutingRuleUpdate {
+	rru.mutation.Where(ps...)
+	return rru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rru *RoutingRuleUpdate) SetUpdatedAt(t time.Time) *RoutingRuleUpdate {
+	rru.mutation.SetUpdatedAt(t)
+	return rru
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (rru *RoutingRuleUpdate) SetDeletedAt(i int) *RoutingRuleUpdate {
+	rru.mutation.ResetDeletedAt()
+	rru.mutation.SetDeletedAt(i)
+	return rru
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (rru *RoutingRuleUpdate) SetNillableDeletedAt(i *int) *RoutingRuleUpdate {
+	if i != nil {
+		rru.SetDeletedAt(*i)
+	}
+	return rru
+}
+
+// AddDeletedAt adds i to the "deleted_at" field.
+func (rru *RoutingRuleUpdate) AddDeletedAt(i int) *RoutingRuleUpdate {
+	rru.mutation.AddDeletedAt(i)
+	return rru
+}
+
+// SetName sets the "name" field.
+func (rru *RoutingRuleUpdate) SetName(s string) *RoutingRuleUpdate {
+	rru.mutation.SetName(s)
+	return rru
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (rru *RoutingRuleUpdate) SetNillableName(s *string) *RoutingRuleUpdate {
+	if s != nil {
+		rru.SetName(*s)
+	}
+	return rru
+}
+
+// SetDescription sets the "description" field.
+func (rru *RoutingRuleUpdate) SetDescription(s string) *RoutingRuleUpdate {
+	rru.mutation.SetDescription(s)
+	return rru
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (rru *RoutingRuleUpdate) SetNillableDescription(s *string) *RoutingRuleUpdate {
+	if s != nil {
+		rru.SetDescription(*s)
+	}
+	return rru
+}
+
+// ClearDescription clears the value of the "description" field.
+func (rru *RoutingRuleUpdate) ClearDescription() *RoutingRuleUpdate {
+	rru.mutation.ClearDescription()
+	return rru
+}
+
+// SetStatus sets the "status" field.
+func (rru *RoutingRuleUpdate) SetStatus(r routingrule.Status) *RoutingRuleUpdate {
+	rru.mutation.SetStatus(r)
+	return rru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (rru *RoutingRuleUpdate) SetNillableStatus(r *routingrule.Status) *RoutingRuleUpdate {
+	if r != nil {
+		rru.SetStatus(*r)
+	}
+	return rru
+}
+
+// SetPriority sets the "priority" field.
+func (rru *RoutingRuleUpdate) SetPriority(i int) *RoutingRuleUpdate {
+	rru.mutation.ResetPriority()
+	rru.mutation.SetPriority(i)
+	return rru
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (rru *RoutingRuleUpdate) SetNillablePriority(i *int) *RoutingRuleUpdate {
+	if i != nil {
+		rru.SetPriority(*i)
+	}
+	return rru
+}
+
+// AddPriority adds i to the "priority" field.
+func (rru *RoutingRuleUpdate) AddPriority(i int) *RoutingRuleUpdate {
+	rru.mutation.AddPriority(i)
+	return rru
+}
+
+// SetConditions sets the "conditions" field.
+func (rru *RoutingRuleUpdate) SetConditions(oc *objects.RoutingConditions) *RoutingRuleUpdate {
+	rru.mutation.SetConditions(oc)
+	return rru
+}
+
+// SetAction sets the "action" field.
+func (rru *RoutingRuleUpdate) SetAction(r routingrule.Action) *RoutingRuleUpdate {
+	rru.mutation.SetAction(r)
+	return rru
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (rru *RoutingRuleUpdate) SetNillableAction(r *routingrule.Action) *RoutingRuleUpdate {
+	if r != nil {
+		rru.SetAction(*r)
+	}
+	return rru
+}
+
+// AddChannelIDs adds the "channels" edge to the Channel entity by IDs.
+func (rru *RoutingRuleUpdate) AddChannelIDs(ids ...int) *RoutingRuleUpdate {
+	rru.mutation.AddChannelIDs(ids...)
+	return rru
+}
+
+// AddChannels adds the "channels" edges to the Channel entity.
+func (rru *RoutingRuleUpdate) AddChannels(c ...*Channel) *RoutingRuleUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return rru.AddChannelIDs(ids...)
+}
+
+// Mutation returns the RoutingRuleMutation object of the builder.
+func (rru *RoutingRuleUpdate) Mutation() *RoutingRuleMutation {
+	return rru.mutation
+}
+
+// ClearChannels clears all "channels" edges to the Channel entity.
+func (rru *RoutingRuleUpdate) ClearChannels() *RoutingRuleUpdate {
+	rru.mutation.ClearChannels()
+	return rru
+}
+
+// RemoveChannelIDs removes the "channels" edge to Channel entities by IDs.
+func (rru *RoutingRuleUpdate) RemoveChannelIDs(ids ...int) *RoutingRuleUpdate {
+	rru.mutation.RemoveChannelIDs(ids...)
+	return rru
+}
+
+// RemoveChannels removes "channels" edges to Channel entities.
+func (rru *RoutingRuleUpdate) RemoveChannels(c ...*Channel) *RoutingRuleUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return rru.RemoveChannelIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rru *RoutingRuleUpdate) Save(ctx context.Context) (int, error) {
+	if err := rru.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, rru.sqlSave, rru.mutation, rru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rru *RoutingRuleUpdate) SaveX(ctx context.Context) int {
+	affected, err := rru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rru *RoutingRuleUpdate) Exec(ctx context.Context) error {
+	_, err := rru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rru *RoutingRuleUpdate) ExecX(ctx context.Context) {
+	if err := rru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rru *RoutingRuleUpdate) defaults() error {
+	if _, ok := rru.mutation.UpdatedAt(); !ok {
+		if routingrule.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized routingrule.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := routingrule.UpdateDefaultUpdatedAt()
+		rru.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rru *RoutingRuleUpdate) check() error {
+	if v, ok := rru.mutation.Name(); ok {
+		if err := routingrule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "RoutingRule.name": %w`, err)}
+		}
+	}
+	if v, ok := rru.mutation.Status(); ok {
+		if err := routingrule.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "RoutingRule.status": %w`, err)}
+		}
+	}
+	if v, ok := rru.mutation.Action(); ok {
+		if err := routingrule.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "RoutingRule.action": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (rru *RoutingRuleUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *RoutingRuleUpdate {
+	rru.modifiers = append(rru.modifiers, modifiers...)
+	return rru
+}
+
+func (rru *RoutingRuleUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := rru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(routingrule.Table, routingrule.Columns, sqlgraph.NewFieldSpec(routingrule.FieldID, field.TypeInt))
+	if ps := rru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rru.mutation.UpdatedAt(); ok {
+		_spec.SetField(routingrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := rru.mutation.DeletedAt(); ok {
+		_spec.SetField(routingrule.FieldDeletedAt, field.TypeInt, value)
+	}
+	if value, ok := rru.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(routingrule.FieldDeletedAt, field.TypeInt, value)
+	}
+	if value, ok := rru.mutation.Name(); ok {
+		_spec.SetField(routingrule.FieldName, field.TypeString, value)
+	}
+	if value, ok := rru.mutation.Description(); ok {
+		_spec.SetField(routingrule.FieldDescription, field.TypeString, value)
+	}
+	if rru.mutation.DescriptionCleared() {
+		_spec.ClearField(routingrule.FieldDescription, field.TypeString)
+	}
+	if value, ok := rru.mutation.Status(); ok {
+		_spec.SetField(routingrule.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := rru.mutation.Priority(); ok {
+		_spec.SetField(routingrule.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := rru.mutation.AddedPriority(); ok {
+		_spec.AddField(routingrule.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := rru.mutation.Conditions(); ok {
+		_spec.SetField(routingrule.FieldConditions, field.TypeJSON, value)
+	}
+	if value, ok := rru.mutation.Action(); ok {
+		_spec.SetField(routingrule.FieldAction, field.TypeEnum, value)
+	}
+	if rru.mutation.ChannelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   routingrule.ChannelsTable,
+			Columns: routingrule.ChannelsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(channel.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rru.mutation.RemovedChannelsIDs(); len(nodes) > 0 && !rru.mutation.ChannelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   routingrule.ChannelsTable,
+			Columns: routingrule.ChannelsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(channel.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rru.mutation.ChannelsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   routingrule.ChannelsTable,
+			Columns: routingrule.ChannelsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(channel.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(rru.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, rru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{routingrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rru.mutation.done = true
+	return n, nil
+}
+
+// RoutingRuleUpdateOne is the builder for updating a single RoutingRule entity.
+type RoutingRuleUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *RoutingRuleMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rruo *RoutingRuleUpdateOne) SetUpdatedAt(t time.Time) *RoutingRuleUpdateOne {
+	rruo.mutation.SetUpdatedAt(t)
+	return rruo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (rruo *RoutingRuleUpdateOne) SetDeletedAt(i int) *RoutingRuleUpdateOne {
+	rruo.mutation.ResetDeletedAt()
+	rruo.mutation.SetDeletedAt(i)
+	return rruo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (rruo *RoutingRuleUpdateOne) SetNillableDeletedAt(i *int) *RoutingRuleUpdateOne {
+	if i != nil {
+		rruo.SetDeletedAt(*i)
+	}
+	return rruo
+}
+
+// AddDeletedAt adds i to the "deleted_at" field.
+func (rruo *RoutingRuleUpdateOne) AddDeletedAt(i int) *RoutingRuleUpdateOne {
+	rruo.mutation.AddDeletedAt(i)
+	return rruo
+}
+
+// SetName sets the "name" field.
+func (rruo *RoutingRuleUpdateOne) SetName(s string) *RoutingRuleUpdateOne {
+	rruo.mutation.SetName(s)
+	return rruo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (rruo *RoutingRuleUpdateOne) SetNillableName(s *string) *RoutingRuleUpdateOne {
+	if s != nil {
+		rruo.SetName(*s)
+	}
+	return rruo
+}
+
+// SetDescription sets the "description" field.
+func (rruo *RoutingRuleUpdateOne) SetDescription(s string) *RoutingRuleUpdateOne {
+	rruo.mutation.SetDescription(s)
+	return rruo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (rruo *RoutingRuleUpdateOne) SetNillableDescription(s *string) *RoutingRuleUpdateOne {
+	if s != nil {
+		rruo.SetDescription(*s)
+	}
+	return rruo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (rruo *RoutingRuleUpdateOne) ClearDescription() *RoutingRuleUpdateOne {
+	rruo.mutation.ClearDescription()
+	return rruo
+}
+
+// SetStatus sets the "status" field.
+func (rruo *RoutingRuleUpdateOne) SetStatus(r routingrule.Status) *RoutingRuleUpdateOne {
+	rruo.mutation.SetStatus(r)
+	return rruo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (rruo *RoutingRuleUpdateOne) SetNillableStatus(r *routingrule.Status) *RoutingRuleUpdateOne {
+	if r != nil {
+		rruo.SetStatus(*r)
+	}
+	return rruo
+}
+
+// SetPriority sets the "priority" field.
+func (rruo *RoutingRuleUpdateOne) SetPriority(i int) *RoutingRuleUpdateOne {
+	rruo.mutation.ResetPriority()
+	rruo.mutation.SetPriority(i)
+	return rruo
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (rruo *RoutingRuleUpdateOne) SetNillablePriority(i *int) *RoutingRuleUpdateOne {
+	if i != nil {
+		rruo.SetPriority(*i)
+	}
+	return rruo
+}
+
+// AddPriority adds i to the "priority" field.
+func (rruo *RoutingRuleUpdateOne) AddPriority(i int) *RoutingRuleUpdateOne {
+	rruo.mutation.AddPriority(i)
+	return rruo
+}
+
+// SetConditions sets the "conditions" field.
+func (rruo *RoutingRuleUpdateOne) SetConditions(oc *objects.RoutingConditions) *RoutingRuleUpdateOne {
+	rruo.mutation.SetConditions(oc)
+	return rruo
+}
+
+// SetAction sets the "action" field.
+func (rruo *RoutingRuleUpdateOne) SetAction(r routingrule.Action) *RoutingRuleUpdateOne {
+	rruo.mutation.SetAction(r)
+	return rruo
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (rruo *RoutingRuleUpdateOne) SetNillableAction(r *routingrule.Action) *RoutingRuleUpdateOne {
+	if r != nil {
+		rruo.SetAction(*r)
+	}
+	return rruo
+}
+
+// AddChannelIDs adds the "channels" edge to the Channel entity by IDs.
+func (rruo *RoutingRuleUpdateOne) AddChannelIDs(ids ...int) *RoutingRuleUpdateOne {
+	rruo.mutation.AddChannelIDs(ids...)
+	return rruo
+}
+
+// AddChannels adds the "channels" edges to the Channel entity.
+func (rruo *RoutingRuleUpdateOne) AddChannels(c ...*Channel) *RoutingRuleUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return rruo.AddChannelIDs(ids...)
+}
+
+// Mutation returns the RoutingRuleMutation object of the builder.
+func (rruo *RoutingRuleUpdateOne) Mutation() *RoutingRuleMutation {
+	return rruo.mutation
+}
+
+// ClearChannels clears all "channels" edges to the Channel entity.
+func (rruo *RoutingRuleUpdateOne) ClearChannels() *RoutingRuleUpdateOne {
+	rruo.mutation.ClearChannels()
+	return rruo
+}
+
+// RemoveChannelIDs removes the "channels" edge to Channel entities by IDs.
+func (rruo *RoutingRuleUpdateOne) RemoveChannelIDs(ids ...int) *RoutingRuleUpdateOne {
+	rruo.mutation.RemoveChannelIDs(ids...)
+	return rruo
+}
+
+// RemoveChannels removes "channels" edges to Channel entities.
+func (rruo *RoutingRuleUpdateOne) RemoveChannels(c ...*Channel) *RoutingRuleUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return rruo.RemoveChannelIDs(ids...)
+}
+
+// Where appends a list predicates to the RoutingRuleUpdate builder.
+func (rruo *RoutingRuleUpdateOne) Where(ps ...predicate.RoutingRule) *RoutingRuleUpdateOne {
+	rruo.mutation.Where(ps...)
+	return rruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rruo *RoutingRuleUpdateOne) Select(field string, fields ...string) *RoutingRuleUpdateOne {
+	rruo.fields = append([]string{field}, fields...)
+	return rruo
+}
+
+// Save executes the query and returns the updated RoutingRule entity.
+func (rruo *RoutingRuleUpdateOne) Save(ctx context.Context) (*RoutingRule, error) {
+	if err := rruo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, rruo.sqlSave, rruo.mutation, rruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rruo *RoutingRuleUpdateOne) SaveX(ctx context.Context) *RoutingRule {
+	node, err := rruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rruo *RoutingRuleUpdateOne) Exec(ctx context.Context) error {
+	_, err := rruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rruo *RoutingRuleUpdateOne) ExecX(ctx context.Context) {
+	if err := rruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rruo *RoutingRuleUpdateOne) defaults() error {
+	if _, ok := rruo.mutation.UpdatedAt(); !ok {
+		if routingrule.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized routingrule.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := routingrule.UpdateDefaultUpdatedAt()
+		rruo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rruo *RoutingRuleUpdateOne) check() error {
+	if v, ok := rruo.mutation.Name(); ok {
+		if err := routingrule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "RoutingRule.name": %w`, err)}
+		}
+	}
+	if v, ok := rruo.mutation.Status(); ok {
+		if err := routingrule.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "RoutingRule.status": %w`, err)}
+		}
+	}
+	if v, ok := rruo.mutation.Action(); ok {
+		if err := routingrule.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "RoutingRule.action": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (rruo *RoutingRuleUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *RoutingRuleUpdateOne {
+	rruo.modifiers = append(rruo.modifiers, modifiers...)
+	return rruo
+}
+
+func (rruo *RoutingRuleUpdateOne) sqlSave(ctx context.Context) (_node *RoutingRule, err error) {
+	if err := rruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(routingrule.Table, routingrule.Columns, sqlgraph.NewFieldSpec(routingrule.FieldID, field.TypeInt))
+	id, ok := rruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RoutingRule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, routingrule.FieldID)
+		for _, f := range fields {
+			if !routingrule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != routingrule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(routingrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := rruo.mutation.DeletedAt(); ok {
+		_spec.SetField(routingrule.FieldDeletedAt, field.TypeInt, value)
+	}
+	if value, ok := rruo.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(routingrule.FieldDeletedAt, field.TypeInt, value)
+	}
+	if value, ok := rruo.mutation.Name(); ok {
+		_spec.SetField(routingrule.FieldName, field.TypeString, value)
+	}
+	if value, ok := rruo.mutation.Description(); ok {
+		_spec.SetField(routingrule.FieldDescription, field.TypeString, value)
+	}
+	if rruo.mutation.DescriptionCleared() {
+		_spec.ClearField(routingrule.FieldDescription, field.TypeString)
+	}
+	if value, ok := rruo.mutation.Status(); ok {
+		_spec.SetField(routingrule.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := rruo.mutation.Priority(); ok {
+		_spec.SetField(routingrule.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := rruo.mutation.AddedPriority(); ok {
+		_spec.AddField(routingrule.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := rruo.mutation.Conditions(); ok {
+		_spec.SetField(routingrule.FieldConditions, field.TypeJSON, value)
+	}
+	if value, ok := rruo.mutation.Action(); ok {
+		_spec.SetField(routingrule.FieldAction, field.TypeEnum, value)
+	}
+	if rruo.mutation.ChannelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   routingrule.ChannelsTable,
+			Columns: routingrule.ChannelsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(channel.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rruo.mutation.RemovedChannelsIDs(); len(nodes) > 0 && !rruo.mutation.ChannelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   routingrule.ChannelsTable,
+			Columns: routingrule.ChannelsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(channel.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rruo.mutation.ChannelsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   routingrule.ChannelsTable,
+			Columns: routingrule.ChannelsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(channel.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(rruo.modifiers...)
+	_node = &RoutingRule{config: rruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{routingrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rruo.mutation.done = true
+	return _node, nil
+}
@@ -10,11 +10,14 @@ import (
 	"github.com/looplj/axonhub/internal/ent/channel"
 	"github.com/looplj/axonhub/internal/ent/request"
 	"github.com/looplj/axonhub/internal/ent/requestexecution"
+	"github.com/looplj/axonhub/internal/ent/responsecache"
 	"github.com/looplj/axonhub/internal/ent/role"
+	"github.com/looplj/axonhub/internal/ent/routingrule"
 	"github.com/looplj/axonhub/internal/ent/schema"
 	"github.com/looplj/axonhub/internal/ent/system"
 	"github.com/looplj/axonhub/internal/ent/usagelog"
 	"github.com/looplj/axonhub/internal/ent/user"
+	"github.com/looplj/axonhub/internal/ent/virtualmodel"
 	"github.com/looplj/axonhub/internal/objects"
 
 	"entgo.io/ent"
@@ -60,12 +63,16 @@ func init() {
 	apikeyDescDeletedAt := apikeyMixinFields1[0].Descriptor()
 	// apikey.DefaultDeletedAt holds the default value on creation for the deleted_at field.
 	apikey.DefaultDeletedAt = apikeyDescDeletedAt.Default.(int)
+	// apikeyDescKeyPrefix is the schema descriptor for key_prefix field.
+	apikeyDescKeyPrefix := apikeyFields[2].Descriptor()
+	// apikey.DefaultKeyPrefix holds the default value on creation for the key_prefix field.
+	apikey.DefaultKeyPrefix = apikeyDescKeyPrefix.Default.(string)
 	// apikeyDescScopes is the schema descriptor for scopes field.
-	apikeyDescScopes := apikeyFields[4].Descriptor()
+	apikeyDescScopes := apikeyFields[10].Descriptor()
 	// apikey.DefaultScopes holds the default value on creation for the scopes field.
 	apikey.DefaultScopes = apikeyDescScopes.Default.([]string)
 	// apikeyDescProfiles is the schema descriptor for profiles field.
-	apikeyDescProfiles := apikeyFields[5].Descriptor()
+	apikeyDescProfiles := apikeyFields[17].Descriptor()
 	// apikey.DefaultProfiles holds the default value on creation for the profiles field.
 	apikey.DefaultProfiles = apikeyDescProfiles.Default.(*objects.APIKeyProfiles)
 	channelMixin := schema.Channel{}.Mixin()
@@ -154,6 +161,10 @@ func init() {
 	requestDescFormat := requestFields[4].Descriptor()
 	// request.DefaultFormat holds the default value on creation for the format field.
 	request.DefaultFormat = requestDescFormat.Default.(string)
+	// requestDescCached is the schema descriptor for cached field.
+	requestDescCached := requestFields[10].Descriptor()
+	// request.DefaultCached holds the default value on creation for the cached field.
+	request.DefaultCached = requestDescCached.Default.(bool)
 	requestexecutionMixin := schema.RequestExecution{}.Mixin()
 	requestexecutionMixinFields0 := requestexecutionMixin[0].Fields()
 	_ = requestexecutionMixinFields0
@@ -173,6 +184,21 @@ func init() {
 	requestexecutionDescFormat := requestexecutionFields[5].Descriptor()
 	// requestexecution.DefaultFormat holds the default value on creation for the format field.
 	requestexecution.DefaultFormat = requestexecutionDescFormat.Default.(string)
+	responsecacheMixin := schema.ResponseCache{}.Mixin()
+	responsecacheMixinFields0 := responsecacheMixin[0].Fields()
+	_ = responsecacheMixinFields0
+	responsecacheFields := schema.ResponseCache{}.Fields()
+	_ = responsecacheFields
+	// responsecacheDescCreatedAt is the schema descriptor for created_at field.
+	responsecacheDescCreatedAt := responsecacheMixinFields0[0].Descriptor()
+	// responsecache.DefaultCreatedAt holds the default value on creation for the created_at field.
+	responsecache.DefaultCreatedAt = responsecacheDescCreatedAt.Default.(func() time.Time)
+	// responsecacheDescUpdatedAt is the schema descriptor for updated_at field.
+	responsecacheDescUpdatedAt := responsecacheMixinFields0[1].Descriptor()
+	// responsecache.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	responsecache.DefaultUpdatedAt = responsecacheDescUpdatedAt.Default.(func() time.Time)
+	// responsecache.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	responsecache.UpdateDefaultUpdatedAt = responsecacheDescUpdatedAt.UpdateDefault.(func() time.Time)
 	roleMixin := schema.Role{}.Mixin()
 	role.Policy = privacy.NewPolicies(schema.Role{})
 	role.Hooks[0] = func(next ent.Mutator) ent.Mutator {
@@ -212,6 +238,53 @@ func init() {
 	roleDescScopes := roleFields[2].Descriptor()
 	// role.DefaultScopes holds the default value on creation for the scopes field.
 	role.DefaultScopes = roleDescScopes.Default.([]string)
+	routingruleMixin := schema.RoutingRule{}.Mixin()
+	routingrule.Policy = privacy.NewPolicies(schema.RoutingRule{})
+	routingrule.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := routingrule.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	routingruleMixinHooks1 := routingruleMixin[1].Hooks()
+
+	routingrule.Hooks[1] = routingruleMixinHooks1[0]
+	routingruleMixinInters1 := routingruleMixin[1].Interceptors()
+	routingrule.Interceptors[0] = routingruleMixinInters1[0]
+	routingruleMixinFields0 := routingruleMixin[0].Fields()
+	_ = routingruleMixinFields0
+	routingruleMixinFields1 := routingruleMixin[1].Fields()
+	_ = routingruleMixinFields1
+	routingruleFields := schema.RoutingRule{}.Fields()
+	_ = routingruleFields
+	// routingruleDescCreatedAt is the schema descriptor for created_at field.
+	routingruleDescCreatedAt := routingruleMixinFields0[0].Descriptor()
+	// routingrule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	routingrule.DefaultCreatedAt = routingruleDescCreatedAt.Default.(func() time.Time)
+	// routingruleDescUpdatedAt is the schema descriptor for updated_at field.
+	routingruleDescUpdatedAt := routingruleMixinFields0[1].Descriptor()
+	// routingrule.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	routingrule.DefaultUpdatedAt = routingruleDescUpdatedAt.Default.(func() time.Time)
+	// routingrule.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	routingrule.UpdateDefaultUpdatedAt = routingruleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// routingruleDescDeletedAt is the schema descriptor for deleted_at field.
+	routingruleDescDeletedAt := routingruleMixinFields1[0].Descriptor()
+	// routingrule.DefaultDeletedAt holds the default value on creation for the deleted_at field.
+	routingrule.DefaultDeletedAt = routingruleDescDeletedAt.Default.(int)
+	// routingruleDescName is the schema descriptor for name field.
+	routingruleDescName := routingruleFields[0].Descriptor()
+	// routingrule.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	routingrule.NameValidator = routingruleDescName.Validators[0].(func(string) error)
+	// routingruleDescPriority is the schema descriptor for priority field.
+	routingruleDescPriority := routingruleFields[3].Descriptor()
+	// routingrule.DefaultPriority holds the default value on creation for the priority field.
+	routingrule.DefaultPriority = routingruleDescPriority.Default.(int)
+	// routingruleDescConditions is the schema descriptor for conditions field.
+	routingruleDescConditions := routingruleFields[4].Descriptor()
+	// routingrule.DefaultConditions holds the default value on creation for the conditions field.
+	routingrule.DefaultConditions = routingruleDescConditions.Default.(*objects.RoutingConditions)
 	systemMixin := schema.System{}.Mixin()
 	system.Policy = privacy.NewPolicies(schema.System{})
 	system.Hooks[0] = func(next ent.Mutator) ent.Mutator {
@@ -302,24 +375,32 @@ func init() {
 	usagelogDescPromptCachedTokens := usagelogFields[8].Descriptor()
 	// usagelog.DefaultPromptCachedTokens holds the default value on creation for the prompt_cached_tokens field.
 	usagelog.DefaultPromptCachedTokens = usagelogDescPromptCachedTokens.Default.(int)
+	// usagelogDescPromptCacheCreationTokens is the schema descriptor for prompt_cache_creation_tokens field.
+	usagelogDescPromptCacheCreationTokens := usagelogFields[9].Descriptor()
+	// usagelog.DefaultPromptCacheCreationTokens holds the default value on creation for the prompt_cache_creation_tokens field.
+	usagelog.DefaultPromptCacheCreationTokens = usagelogDescPromptCacheCreationTokens.Default.(int)
 	// usagelogDescCompletionAudioTokens is the schema descriptor for completion_audio_tokens field.
-	usagelogDescCompletionAudioTokens := usagelogFields[9].Descriptor()
+	usagelogDescCompletionAudioTokens := usagelogFields[10].Descriptor()
 	// usagelog.DefaultCompletionAudioTokens holds the default value on creation for the completion_audio_tokens field.
 	usagelog.DefaultCompletionAudioTokens = usagelogDescCompletionAudioTokens.Default.(int)
 	// usagelogDescCompletionReasoningTokens is the schema descriptor for completion_reasoning_tokens field.
-	usagelogDescCompletionReasoningTokens := usagelogFields[10].Descriptor()
+	usagelogDescCompletionReasoningTokens := usagelogFields[11].Descriptor()
 	// usagelog.DefaultCompletionReasoningTokens holds the default value on creation for the completion_reasoning_tokens field.
 	usagelog.DefaultCompletionReasoningTokens = usagelogDescCompletionReasoningTokens.Default.(int)
 	// usagelogDescCompletionAcceptedPredictionTokens is the schema descriptor for completion_accepted_prediction_tokens field.
-	usagelogDescCompletionAcceptedPredictionTokens := usagelogFields[11].Descriptor()
+	usagelogDescCompletionAcceptedPredictionTokens := usagelogFields[12].Descriptor()
 	// usagelog.DefaultCompletionAcceptedPredictionTokens holds the default value on creation for the completion_accepted_prediction_tokens field.
 	usagelog.DefaultCompletionAcceptedPredictionTokens = usagelogDescCompletionAcceptedPredictionTokens.Default.(int)
 	// usagelogDescCompletionRejectedPredictionTokens is the schema descriptor for completion_rejected_prediction_tokens field.
-	usagelogDescCompletionRejectedPredictionTokens := usagelogFields[12].Descriptor()
+	usagelogDescCompletionRejectedPredictionTokens := usagelogFields[13].Descriptor()
 	// usagelog.DefaultCompletionRejectedPredictionTokens holds the default value on creation for the completion_rejected_prediction_tokens field.
 	usagelog.DefaultCompletionRejectedPredictionTokens = usagelogDescCompletionRejectedPredictionTokens.Default.(int)
+	// usagelogDescEstimated is the schema descriptor for estimated field.
+	usagelogDescEstimated := usagelogFields[14].Descriptor()
+	// usagelog.DefaultEstimated holds the default value on creation for the estimated field.
+	usagelog.DefaultEstimated = usagelogDescEstimated.Default.(bool)
 	// usagelogDescFormat is the schema descriptor for format field.
-	usagelogDescFormat := usagelogFields[14].Descriptor()
+	usagelogDescFormat := usagelogFields[16].Descriptor()
 	// usagelog.DefaultFormat holds the default value on creation for the format field.
 	usagelog.DefaultFormat = usagelogDescFormat.Default.(string)
 	userMixin := schema.User{}.Mixin()
@@ -377,6 +458,45 @@ func init() {
 	userDescScopes := userFields[8].Descriptor()
 	// user.DefaultScopes holds the default value on creation for the scopes field.
 	user.DefaultScopes = userDescScopes.Default.([]string)
+	virtualmodelMixin := schema.VirtualModel{}.Mixin()
+	virtualmodel.Policy = privacy.NewPolicies(schema.VirtualModel{})
+	virtualmodel.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := virtualmodel.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	virtualmodelMixinHooks1 := virtualmodelMixin[1].Hooks()
+
+	virtualmodel.Hooks[1] = virtualmodelMixinHooks1[0]
+	virtualmodelMixinInters1 := virtualmodelMixin[1].Interceptors()
+	virtualmodel.Interceptors[0] = virtualmodelMixinInters1[0]
+	virtualmodelMixinFields0 := virtualmodelMixin[0].Fields()
+	_ = virtualmodelMixinFields0
+	virtualmodelMixinFields1 := virtualmodelMixin[1].Fields()
+	_ = virtualmodelMixinFields1
+	virtualmodelFields := schema.VirtualModel{}.Fields()
+	_ = virtualmodelFields
+	// virtualmodelDescCreatedAt is the schema descriptor for created_at field.
+	virtualmodelDescCreatedAt := virtualmodelMixinFields0[0].Descriptor()
+	// virtualmodel.DefaultCreatedAt holds the default value on creation for the created_at field.
+	virtualmodel.DefaultCreatedAt = virtualmodelDescCreatedAt.Default.(func() time.Time)
+	// virtualmodelDescUpdatedAt is the schema descriptor for updated_at field.
+	virtualmodelDescUpdatedAt := virtualmodelMixinFields0[1].Descriptor()
+	// virtualmodel.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	virtualmodel.DefaultUpdatedAt = virtualmodelDescUpdatedAt.Default.(func() time.Time)
+	// virtualmodel.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	virtualmodel.UpdateDefaultUpdatedAt = virtualmodelDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// virtualmodelDescDeletedAt is the schema descriptor for deleted_at field.
+	virtualmodelDescDeletedAt := virtualmodelMixinFields1[0].Descriptor()
+	// virtualmodel.DefaultDeletedAt holds the default value on creation for the deleted_at field.
+	virtualmodel.DefaultDeletedAt = virtualmodelDescDeletedAt.Default.(int)
+	// virtualmodelDescName is the schema descriptor for name field.
+	virtualmodelDescName := virtualmodelFields[0].Descriptor()
+	// virtualmodel.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	virtualmodel.NameValidator = virtualmodelDescName.Validators[0].(func(string) error)
 }
 
 const (
@@ -31,6 +31,10 @@ func (APIKey) Indexes() []ent.Index {
 		index.Fields("key").
 			StorageKey("api_keys_by_key").
 			Unique(),
+		index.Fields("key_prefix").
+			StorageKey("api_keys_by_key_prefix"),
+		index.Fields("previous_key_prefix").
+			StorageKey("api_keys_by_previous_key_prefix"),
 	}
 }
 
@@ -40,7 +44,49 @@ func (APIKey) Fields() []ent.Field {
 			Annotations(
 				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
 			),
-		field.String("key").Immutable().
+		field.String("key").
+			Comment("The salted hash of the API key, the plain key is only returned once on creation.").
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			).
+			Sensitive(),
+		field.String("key_prefix").
+			Comment("The visible prefix of the API key for display.").
+			Default("").
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
+		field.Time("last_used_at").
+			Comment("The last time the current key was used.").
+			Optional().
+			Nillable().
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
+		field.String("previous_key").
+			Comment("The salted hash of the key before the last rotation, it is valid until the grace period ends.").
+			Optional().
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			).
+			Sensitive(),
+		field.String("previous_key_prefix").
+			Comment("The visible prefix of the key before the last rotation.").
+			Optional().
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
+		field.Time("previous_key_expires_at").
+			Comment("The end of the grace period of the key before the last rotation.").
+			Optional().
+			Nillable().
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
+		field.Time("previous_key_last_used_at").
+			Comment("The last time the key before the last rotation was used.").
+			Optional().
+			Nillable().
 			Annotations(
 				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
 			),
@@ -56,6 +102,29 @@ func (APIKey) Fields() []ent.Field {
 			).
 			Sensitive().
 			Optional(),
+		field.Time("expires_at").
+			Comment("The API key can not be used after the expiration time, never expires if not set.").
+			Optional().
+			Nillable(),
+		field.Strings("allowed_cidrs").
+			Comment("The CIDRs or IPs the API key can be used from, no restriction if empty.").
+			Optional(),
+		field.Strings("allowed_models").
+			Comment("The model patterns the API key can request, supports wildcard and regex, no restriction if empty.").
+			Optional(),
+		field.Enum("cache_mode").
+			Comment("The response cache mode, opt_in caches the requests with the `AH-Cache: true` header, always caches the requests unless the `AH-Cache: false` header is present.").
+			Values("disabled", "opt_in", "always").
+			Default("disabled"),
+		field.String("cache_scope").
+			Comment("The API keys with the same cache scope share the cached responses, default to the API key itself.").
+			Optional(),
+		field.JSON("guardrail_policy", &objects.GuardrailPolicy{}).
+			Comment("The guardrail rules applied to the requests of the API key, in addition to the global guardrail policy.").
+			Optional().
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
 		field.JSON("profiles", &objects.APIKeyProfiles{}).
 			Default(&objects.APIKeyProfiles{}).
 			Optional().
@@ -26,8 +26,9 @@ func (Channel) Mixin() []ent.Mixin {
 
 func (Channel) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("name").
-			StorageKey("channels_by_name").
+		// The deleted_at is 0 for the active channels, so the name of a soft deleted channel can be reused.
+		index.Fields("name", "deleted_at").
+			StorageKey("channels_by_name_deleted_at").
 			Unique(),
 	}
 }
@@ -88,6 +89,12 @@ func (Channel) Edges() []ent.Edge {
 				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
 				entgql.RelayConnection(),
 			),
+		edge.From("routing_rules", RoutingRule.Type).
+			Ref("channels").
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+				entgql.RelayConnection(),
+			),
 	}
 }
 
@@ -62,6 +62,18 @@ func (Request) Fields() []ent.Field {
 		field.Int("channel_id").Optional(),
 		// External ID for tracking requests in external systems
 		field.String("external_id").Optional(),
+		// Whether the response is replayed from the response cache, the cached request has no execution and no cost.
+		field.Bool("cached").Default(false).Annotations(
+			entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+		),
+		// The guardrail rules violated by the request and the response.
+		field.JSON("guardrail_violations", []objects.GuardrailViolation{}).Optional().Annotations(
+			entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+		),
+		// The fallback models tried after all the channels of the previous model failed.
+		field.JSON("fallback_hops", []objects.FallbackHop{}).Optional().Annotations(
+			entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+		),
 		// The status of the request.
 		field.Enum("status").Values("pending", "processing", "completed", "failed", "canceled"),
 	}
@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/looplj/axonhub/internal/objects"
+)
+
+// ResponseCache holds the cached responses of the database response cache store.
+type ResponseCache struct {
+	ent.Schema
+}
+
+func (ResponseCache) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+func (ResponseCache) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("expires_at").
+			StorageKey("response_caches_by_expires_at"),
+	}
+}
+
+func (ResponseCache) Fields() []ent.Field {
+	return []ent.Field{
+		// The hash of the cache scope and the normalized request.
+		field.String("key").Unique().Immutable(),
+		// The unified llm response.
+		field.JSON("response", objects.JSONRawMessage{}),
+		field.Time("expires_at"),
+	}
+}
+
+func (ResponseCache) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(entgql.SkipAll),
+	}
+}
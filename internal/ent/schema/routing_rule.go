@@ -0,0 +1,74 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+
+	"github.com/looplj/axonhub/internal/ent/schema/schematype"
+	"github.com/looplj/axonhub/internal/objects"
+	"github.com/looplj/axonhub/internal/scopes"
+)
+
+// RoutingRule routes the matched requests to the channels of the rule, e.g. the requests with images to the vision channels.
+type RoutingRule struct {
+	ent.Schema
+}
+
+func (RoutingRule) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+		schematype.SoftDeleteMixin{},
+	}
+}
+
+func (RoutingRule) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").NotEmpty(),
+		field.String("description").Optional(),
+		field.Enum("status").Values("enabled", "disabled").Default("enabled"),
+		field.Int("priority").Default(0).
+			Comment("The rules are evaluated by the priority in descending order, the first matched rule is applied.").
+			Annotations(
+				entgql.OrderField("PRIORITY"),
+			),
+		field.JSON("conditions", &objects.RoutingConditions{}).Default(&objects.RoutingConditions{}),
+		field.Enum("action").
+			Values("restrict", "prefer").
+			Default("restrict").
+			Comment("restrict selects only the rule channels, prefer tries the rule channels before the others."),
+	}
+}
+
+func (RoutingRule) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("channels", Channel.Type).
+			Annotations(
+				entgql.RelayConnection(),
+			),
+	}
+}
+
+func (RoutingRule) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.QueryField(),
+		entgql.RelayConnection(),
+		entgql.Mutations(entgql.MutationCreate(), entgql.MutationUpdate()),
+	}
+}
+
+// Policy 定义 RoutingRule 的权限策略，路由规则属于渠道路由配置.
+func (RoutingRule) Policy() ent.Policy {
+	return scopes.Policy{
+		Query: scopes.QueryPolicy{
+			scopes.OwnerRule(),
+			scopes.UserReadScopeRule(scopes.ScopeReadChannels),
+		},
+		Mutation: scopes.MutationPolicy{
+			scopes.OwnerRule(),
+			scopes.UserWriteScopeRule(scopes.ScopeWriteChannels),
+		},
+	}
+}
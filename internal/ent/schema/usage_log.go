@@ -59,12 +59,14 @@ func (UsageLog) Fields() []ent.Field {
 		// Prompt tokens details from llm.PromptTokensDetails
 		field.Int("prompt_audio_tokens").Default(0).Optional().Comment("Number of audio tokens in the prompt"),
 		field.Int("prompt_cached_tokens").Default(0).Optional().Comment("Number of cached tokens in the prompt"),
+		field.Int("prompt_cache_creation_tokens").Default(0).Optional().Comment("Number of tokens written to the prompt cache"),
 
 		// Completion tokens details from llm.CompletionTokensDetails
 		field.Int("completion_audio_tokens").Default(0).Optional().Comment("Number of audio tokens in the completion"),
 		field.Int("completion_reasoning_tokens").Default(0).Optional().Comment("Number of reasoning tokens in the completion"),
 		field.Int("completion_accepted_prediction_tokens").Default(0).Optional().Comment("Number of accepted prediction tokens"),
 		field.Int("completion_rejected_prediction_tokens").Default(0).Optional().Comment("Number of rejected prediction tokens"),
+		field.Bool("estimated").Default(false).Comment("Whether the usage is estimated locally because the provider did not report it"),
 
 		// Additional metadata
 		field.Enum("source").Values("api", "playground", "test").Default("api").Immutable().Comment("Source of the request"),
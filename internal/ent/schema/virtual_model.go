@@ -0,0 +1,65 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/looplj/axonhub/internal/ent/schema/schematype"
+	"github.com/looplj/axonhub/internal/objects"
+	"github.com/looplj/axonhub/internal/scopes"
+)
+
+// VirtualModel is a public model name routed to one or more concrete models, e.g. "fast" or "team-default".
+type VirtualModel struct {
+	ent.Schema
+}
+
+func (VirtualModel) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+		schematype.SoftDeleteMixin{},
+	}
+}
+
+func (VirtualModel) Indexes() []ent.Index {
+	return []ent.Index{
+		// The deleted_at is 0 for the active virtual models, so the name of a soft deleted virtual model can be reused.
+		index.Fields("name", "deleted_at").
+			StorageKey("virtual_models_by_name_deleted_at").
+			Unique(),
+	}
+}
+
+func (VirtualModel) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").NotEmpty(),
+		field.String("description").Optional(),
+		field.Enum("status").Values("enabled", "disabled").Default("enabled"),
+		field.JSON("targets", []objects.VirtualModelTarget{}),
+	}
+}
+
+func (VirtualModel) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.QueryField(),
+		entgql.RelayConnection(),
+		entgql.Mutations(entgql.MutationCreate(), entgql.MutationUpdate()),
+	}
+}
+
+// Policy 定义 VirtualModel 的权限策略，虚拟模型属于渠道路由配置.
+func (VirtualModel) Policy() ent.Policy {
+	return scopes.Policy{
+		Query: scopes.QueryPolicy{
+			scopes.OwnerRule(),
+			scopes.UserReadScopeRule(scopes.ScopeReadChannels),
+		},
+		Mutation: scopes.MutationPolicy{
+			scopes.OwnerRule(),
+			scopes.UserWriteScopeRule(scopes.ScopeWriteChannels),
+		},
+	}
+}
@@ -44,6 +44,8 @@ type UsageLog struct {
 	PromptAudioTokens int `json:"prompt_audio_tokens,omitempty"`
 	// Number of cached tokens in the prompt
 	PromptCachedTokens int `json:"prompt_cached_tokens,omitempty"`
+	// Number of tokens written to the prompt cache
+	PromptCacheCreationTokens int `json:"prompt_cache_creation_tokens,omitempty"`
 	// Number of audio tokens in the completion
 	CompletionAudioTokens int `json:"completion_audio_tokens,omitempty"`
 	// Number of reasoning tokens in the completion
@@ -52,6 +54,8 @@ type UsageLog struct {
 	CompletionAcceptedPredictionTokens int `json:"completion_accepted_prediction_tokens,omitempty"`
 	// Number of rejected prediction tokens
 	CompletionRejectedPredictionTokens int `json:"completion_rejected_prediction_tokens,omitempty"`
+	// Whether the usage is estimated locally because the provider did not report it
+	Estimated bool `json:"estimated,omitempty"`
 	// Source of the request
 	Source usagelog.Source `json:"source,omitempty"`
 	// Request format used
@@ -115,7 +119,9 @@ func (*UsageLog) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case usagelog.FieldID, usagelog.FieldDeletedAt, usagelog.FieldUserID, usagelog.FieldRequestID, usagelog.FieldChannelID, usagelog.FieldPromptTokens, usagelog.FieldCompletionTokens, usagelog.FieldTotalTokens, usagelog.FieldPromptAudioTokens, usagelog.FieldPromptCachedTokens, usagelog.FieldCompletionAudioTokens, usagelog.FieldCompletionReasoningTokens, usagelog.FieldCompletionAcceptedPredictionTokens, usagelog.FieldCompletionRejectedPredictionTokens:
+		case usagelog.FieldEstimated:
+			values[i] = new(sql.NullBool)
+		case usagelog.FieldID, usagelog.FieldDeletedAt, usagelog.FieldUserID, usagelog.FieldRequestID, usagelog.FieldChannelID, usagelog.FieldPromptTokens, usagelog.FieldCompletionTokens, usagelog.FieldTotalTokens, usagelog.FieldPromptAudioTokens, usagelog.FieldPromptCachedTokens, usagelog.FieldPromptCacheCreationTokens, usagelog.FieldCompletionAudioTokens, usagelog.FieldCompletionReasoningTokens, usagelog.FieldCompletionAcceptedPredictionTokens, usagelog.FieldCompletionRejectedPredictionTokens:
 			values[i] = new(sql.NullInt64)
 		case usagelog.FieldModelID, usagelog.FieldSource, usagelog.FieldFormat:
 			values[i] = new(sql.NullString)
@@ -214,6 +220,12 @@ func (ul *UsageLog) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				ul.PromptCachedTokens = int(value.Int64)
 			}
+		case usagelog.FieldPromptCacheCreationTokens:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field prompt_cache_creation_tokens", values[i])
+			} else if value.Valid {
+				ul.PromptCacheCreationTokens = int(value.Int64)
+			}
 		case usagelog.FieldCompletionAudioTokens:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field completion_audio_tokens", values[i])
@@ -238,6 +250,12 @@ func (ul *UsageLog) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				ul.CompletionRejectedPredictionTokens = int(value.Int64)
 			}
+		case usagelog.FieldEstimated:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field estimated", values[i])
+			} else if value.Valid {
+				ul.Estimated = value.Bool
+			}
 		case usagelog.FieldSource:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field source", values[i])
@@ -337,6 +355,9 @@ func (ul *UsageLog) String() string {
 	builder.WriteString("prompt_cached_tokens=")
 	builder.WriteString(fmt.Sprintf("%v", ul.PromptCachedTokens))
 	builder.WriteString(", ")
+	builder.WriteString("prompt_cache_creation_tokens=")
+	builder.WriteString(fmt.Sprintf("%v", ul.PromptCacheCreationTokens))
+	builder.WriteString(", ")
 	builder.WriteString("completion_audio_tokens=")
 	builder.WriteString(fmt.Sprintf("%v", ul.CompletionAudioTokens))
 	builder.WriteString(", ")
@@ -349,6 +370,9 @@ func (ul *UsageLog) String() string {
 	builder.WriteString("completion_rejected_prediction_tokens=")
 	builder.WriteString(fmt.Sprintf("%v", ul.CompletionRejectedPredictionTokens))
 	builder.WriteString(", ")
+	builder.WriteString("estimated=")
+	builder.WriteString(fmt.Sprintf("%v", ul.Estimated))
+	builder.WriteString(", ")
 	builder.WriteString("source=")
 	builder.WriteString(fmt.Sprintf("%v", ul.Source))
 	builder.WriteString(", ")
@@ -42,6 +42,8 @@ const (
 	FieldPromptAudioTokens = "prompt_audio_tokens"
 	// FieldPromptCachedTokens holds the string denoting the prompt_cached_tokens field in the database.
 	FieldPromptCachedTokens = "prompt_cached_tokens"
+	// FieldPromptCacheCreationTokens holds the string denoting the prompt_cache_creation_tokens field in the database.
+	FieldPromptCacheCreationTokens = "prompt_cache_creation_tokens"
 	// FieldCompletionAudioTokens holds the string denoting the completion_audio_tokens field in the database.
 	FieldCompletionAudioTokens = "completion_audio_tokens"
 	// FieldCompletionReasoningTokens holds the string denoting the completion_reasoning_tokens field in the database.
@@ -50,6 +52,8 @@ const (
 	FieldCompletionAcceptedPredictionTokens = "completion_accepted_prediction_tokens"
 	// FieldCompletionRejectedPredictionTokens holds the string denoting the completion_rejected_prediction_tokens field in the database.
 	FieldCompletionRejectedPredictionTokens = "completion_rejected_prediction_tokens"
+	// FieldEstimated holds the string denoting the estimated field in the database.
+	FieldEstimated = "estimated"
 	// FieldSource holds the string denoting the source field in the database.
 	FieldSource = "source"
 	// FieldFormat holds the string denoting the format field in the database.
@@ -100,10 +104,12 @@ var Columns = []string{
 	FieldTotalTokens,
 	FieldPromptAudioTokens,
 	FieldPromptCachedTokens,
+	FieldPromptCacheCreationTokens,
 	FieldCompletionAudioTokens,
 	FieldCompletionReasoningTokens,
 	FieldCompletionAcceptedPredictionTokens,
 	FieldCompletionRejectedPredictionTokens,
+	FieldEstimated,
 	FieldSource,
 	FieldFormat,
 }
@@ -145,6 +151,8 @@ var (
 	DefaultPromptAudioTokens int
 	// DefaultPromptCachedTokens holds the default value on creation for the "prompt_cached_tokens" field.
 	DefaultPromptCachedTokens int
+	// DefaultPromptCacheCreationTokens holds the default value on creation for the "prompt_cache_creation_tokens" field.
+	DefaultPromptCacheCreationTokens int
 	// DefaultCompletionAudioTokens holds the default value on creation for the "completion_audio_tokens" field.
 	DefaultCompletionAudioTokens int
 	// DefaultCompletionReasoningTokens holds the default value on creation for the "completion_reasoning_tokens" field.
@@ -153,6 +161,8 @@ var (
 	DefaultCompletionAcceptedPredictionTokens int
 	// DefaultCompletionRejectedPredictionTokens holds the default value on creation for the "completion_rejected_prediction_tokens" field.
 	DefaultCompletionRejectedPredictionTokens int
+	// DefaultEstimated holds the default value on creation for the "estimated" field.
+	DefaultEstimated bool
 	// DefaultFormat holds the default value on creation for the "format" field.
 	DefaultFormat string
 )
@@ -252,6 +262,11 @@ func ByPromptCachedTokens(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPromptCachedTokens, opts...).ToFunc()
 }
 
+// ByPromptCacheCreationTokens orders the results by the prompt_cache_creation_tokens field.
+func ByPromptCacheCreationTokens(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPromptCacheCreationTokens, opts...).ToFunc()
+}
+
 // ByCompletionAudioTokens orders the results by the completion_audio_tokens field.
 func ByCompletionAudioTokens(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCompletionAudioTokens, opts...).ToFunc()
@@ -272,6 +287,11 @@ func ByCompletionRejectedPredictionTokens(opts ...sql.OrderTermOption) OrderOpti
 	return sql.OrderByField(FieldCompletionRejectedPredictionTokens, opts...).ToFunc()
 }
 
+// ByEstimated orders the results by the estimated field.
+func ByEstimated(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEstimated, opts...).ToFunc()
+}
+
 // BySource orders the results by the source field.
 func BySource(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSource, opts...).ToFunc()
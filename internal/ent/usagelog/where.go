@@ -115,6 +115,11 @@ func PromptCachedTokens(v int) predicate.UsageLog {
 	return predicate.UsageLog(sql.FieldEQ(FieldPromptCachedTokens, v))
 }
 
+// PromptCacheCreationTokens applies equality check predicate on the "prompt_cache_creation_tokens" field. It's identical to PromptCacheCreationTokensEQ.
+func PromptCacheCreationTokens(v int) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldEQ(FieldPromptCacheCreationTokens, v))
+}
+
 // CompletionAudioTokens applies equality check predicate on the "completion_audio_tokens" field. It's identical to CompletionAudioTokensEQ.
 func CompletionAudioTokens(v int) predicate.UsageLog {
 	return predicate.UsageLog(sql.FieldEQ(FieldCompletionAudioTokens, v))
@@ -135,6 +140,11 @@ func CompletionRejectedPredictionTokens(v int) predicate.UsageLog {
 	return predicate.UsageLog(sql.FieldEQ(FieldCompletionRejectedPredictionTokens, v))
 }
 
+// Estimated applies equality check predicate on the "estimated" field. It's identical to EstimatedEQ.
+func Estimated(v bool) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldEQ(FieldEstimated, v))
+}
+
 // Format applies equality check predicate on the "format" field. It's identical to FormatEQ.
 func Format(v string) predicate.UsageLog {
 	return predicate.UsageLog(sql.FieldEQ(FieldFormat, v))
@@ -615,6 +625,56 @@ func PromptCachedTokensNotNil() predicate.UsageLog {
 	return predicate.UsageLog(sql.FieldNotNull(FieldPromptCachedTokens))
 }
 
+// PromptCacheCreationTokensEQ applies the EQ predicate on the "prompt_cache_creation_tokens" field.
+func PromptCacheCreationTokensEQ(v int) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldEQ(FieldPromptCacheCreationTokens, v))
+}
+
+// PromptCacheCreationTokensNEQ applies the NEQ predicate on the "prompt_cache_creation_tokens" field.
+func PromptCacheCreationTokensNEQ(v int) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldNEQ(FieldPromptCacheCreationTokens, v))
+}
+
+// PromptCacheCreationTokensIn applies the In predicate on the "prompt_cache_creation_tokens" field.
+func PromptCacheCreationTokensIn(vs ...int) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldIn(FieldPromptCacheCreationTokens, vs...))
+}
+
+// PromptCacheCreationTokensNotIn applies the NotIn predicate on the "prompt_cache_creation_tokens" field.
+func PromptCacheCreationTokensNotIn(vs ...int) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldNotIn(FieldPromptCacheCreationTokens, vs...))
+}
+
+// PromptCacheCreationTokensGT applies the GT predicate on the "prompt_cache_creation_tokens" field.
+func PromptCacheCreationTokensGT(v int) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldGT(FieldPromptCacheCreationTokens, v))
+}
+
+// PromptCacheCreationTokensGTE applies the GTE predicate on the "prompt_cache_creation_tokens" field.
+func PromptCacheCreationTokensGTE(v int) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldGTE(FieldPromptCacheCreationTokens, v))
+}
+
+// PromptCacheCreationTokensLT applies the LT predicate on the "prompt_cache_creation_tokens" field.
+func PromptCacheCreationTokensLT(v int) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldLT(FieldPromptCacheCreationTokens, v))
+}
+
+// PromptCacheCreationTokensLTE applies the LTE predicate on the "prompt_cache_creation_tokens" field.
+func PromptCacheCreationTokensLTE(v int) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldLTE(FieldPromptCacheCreationTokens, v))
+}
+
+// PromptCacheCreationTokensIsNil applies the IsNil predicate on the "prompt_cache_creation_tokens" field.
+func PromptCacheCreationTokensIsNil() predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldIsNull(FieldPromptCacheCreationTokens))
+}
+
+// PromptCacheCreationTokensNotNil applies the NotNil predicate on the "prompt_cache_creation_tokens" field.
+func PromptCacheCreationTokensNotNil() predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldNotNull(FieldPromptCacheCreationTokens))
+}
+
 // CompletionAudioTokensEQ applies the EQ predicate on the "completion_audio_tokens" field.
 func CompletionAudioTokensEQ(v int) predicate.UsageLog {
 	return predicate.UsageLog(sql.FieldEQ(FieldCompletionAudioTokens, v))
@@ -815,6 +875,16 @@ func CompletionRejectedPredictionTokensNotNil() predicate.UsageLog {
 	return predicate.UsageLog(sql.FieldNotNull(FieldCompletionRejectedPredictionTokens))
 }
 
+// EstimatedEQ applies the EQ predicate on the "estimated" field.
+func EstimatedEQ(v bool) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldEQ(FieldEstimated, v))
+}
+
+// EstimatedNEQ applies the NEQ predicate on the "estimated" field.
+func EstimatedNEQ(v bool) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldNEQ(FieldEstimated, v))
+}
+
 // SourceEQ applies the EQ predicate on the "source" field.
 func SourceEQ(v Source) predicate.UsageLog {
 	return predicate.UsageLog(sql.FieldEQ(FieldSource, v))
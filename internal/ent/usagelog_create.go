@@ -169,6 +169,20 @@ func (ulc *UsageLogCreate) SetNillablePromptCachedTokens(i *int) *UsageLogCreate
 	return ulc
 }
 
+// SetPromptCacheCreationTokens sets the "prompt_cache_creation_tokens" field.
+func (ulc *UsageLogCreate) SetPromptCacheCreationTokens(i int) *UsageLogCreate {
+	ulc.mutation.SetPromptCacheCreationTokens(i)
+	return ulc
+}
+
+// SetNillablePromptCacheCreationTokens sets the "prompt_cache_creation_tokens" field if the given value is not nil.
+func (ulc *UsageLogCreate) SetNillablePromptCacheCreationTokens(i *int) *UsageLogCreate {
+	if i != nil {
+		ulc.SetPromptCacheCreationTokens(*i)
+	}
+	return ulc
+}
+
 // SetCompletionAudioTokens sets the "completion_audio_tokens" field.
 func (ulc *UsageLogCreate) SetCompletionAudioTokens(i int) *UsageLogCreate {
 	ulc.mutation.SetCompletionAudioTokens(i)
@@ -225,6 +239,20 @@ func (ulc *UsageLogCreate) SetNillableCompletionRejectedPredictionTokens(i *int)
 	return ulc
 }
 
+// SetEstimated sets the "estimated" field.
+func (ulc *UsageLogCreate) SetEstimated(b bool) *UsageLogCreate {
+	ulc.mutation.SetEstimated(b)
+	return ulc
+}
+
+// SetNillableEstimated sets the "estimated" field if the given value is not nil.
+func (ulc *UsageLogCreate) SetNillableEstimated(b *bool) *UsageLogCreate {
+	if b != nil {
+		ulc.SetEstimated(*b)
+	}
+	return ulc
+}
+
 // SetSource sets the "source" field.
 func (ulc *UsageLogCreate) SetSource(u usagelog.Source) *UsageLogCreate {
 	ulc.mutation.SetSource(u)
@@ -343,6 +371,10 @@ func (ulc *UsageLogCreate) defaults() error {
 		v := usagelog.DefaultPromptCachedTokens
 		ulc.mutation.SetPromptCachedTokens(v)
 	}
+	if _, ok := ulc.mutation.PromptCacheCreationTokens(); !ok {
+		v := usagelog.DefaultPromptCacheCreationTokens
+		ulc.mutation.SetPromptCacheCreationTokens(v)
+	}
 	if _, ok := ulc.mutation.CompletionAudioTokens(); !ok {
 		v := usagelog.DefaultCompletionAudioTokens
 		ulc.mutation.SetCompletionAudioTokens(v)
@@ -359,6 +391,10 @@ func (ulc *UsageLogCreate) defaults() error {
 		v := usagelog.DefaultCompletionRejectedPredictionTokens
 		ulc.mutation.SetCompletionRejectedPredictionTokens(v)
 	}
+	if _, ok := ulc.mutation.Estimated(); !ok {
+		v := usagelog.DefaultEstimated
+		ulc.mutation.SetEstimated(v)
+	}
 	if _, ok := ulc.mutation.Source(); !ok {
 		v := usagelog.DefaultSource
 		ulc.mutation.SetSource(v)
@@ -399,6 +435,9 @@ func (ulc *UsageLogCreate) check() error {
 	if _, ok := ulc.mutation.TotalTokens(); !ok {
 		return &ValidationError{Name: "total_tokens", err: errors.New(`ent: missing required field "UsageLog.total_tokens"`)}
 	}
+	if _, ok := ulc.mutation.Estimated(); !ok {
+		return &ValidationError{Name: "estimated", err: errors.New(`ent: missing required field "UsageLog.estimated"`)}
+	}
 	if _, ok := ulc.mutation.Source(); !ok {
 		return &ValidationError{Name: "source", err: errors.New(`ent: missing required field "UsageLog.source"`)}
 	}
@@ -479,6 +518,10 @@ func (ulc *UsageLogCreate) createSpec() (*UsageLog, *sqlgraph.CreateSpec) {
 		_spec.SetField(usagelog.FieldPromptCachedTokens, field.TypeInt, value)
 		_node.PromptCachedTokens = value
 	}
+	if value, ok := ulc.mutation.PromptCacheCreationTokens(); ok {
+		_spec.SetField(usagelog.FieldPromptCacheCreationTokens, field.TypeInt, value)
+		_node.PromptCacheCreationTokens = value
+	}
 	if value, ok := ulc.mutation.CompletionAudioTokens(); ok {
 		_spec.SetField(usagelog.FieldCompletionAudioTokens, field.TypeInt, value)
 		_node.CompletionAudioTokens = value
@@ -495,6 +538,10 @@ func (ulc *UsageLogCreate) createSpec() (*UsageLog, *sqlgraph.CreateSpec) {
 		_spec.SetField(usagelog.FieldCompletionRejectedPredictionTokens, field.TypeInt, value)
 		_node.CompletionRejectedPredictionTokens = value
 	}
+	if value, ok := ulc.mutation.Estimated(); ok {
+		_spec.SetField(usagelog.FieldEstimated, field.TypeBool, value)
+		_node.Estimated = value
+	}
 	if value, ok := ulc.mutation.Source(); ok {
 		_spec.SetField(usagelog.FieldSource, field.TypeEnum, value)
 		_node.Source = value
@@ -756,6 +803,30 @@ func (u *UsageLogUpsert) ClearPromptCachedTokens() *UsageLogUpsert {
 	return u
 }
 
+// SetPromptCacheCreationTokens sets the "prompt_cache_creation_tokens" field.
+func (u *UsageLogUpsert) SetPromptCacheCreationTokens(v int) *UsageLogUpsert {
+	u.Set(usagelog.FieldPromptCacheCreationTokens, v)
+	return u
+}
+
+// UpdatePromptCacheCreationTokens sets the "prompt_cache_creation_tokens" field to the value that was provided on create.
+func (u *UsageLogUpsert) UpdatePromptCacheCreationTokens() *UsageLogUpsert {
+	u.SetExcluded(usagelog.FieldPromptCacheCreationTokens)
+	return u
+}
+
+// AddPromptCacheCreationTokens adds v to the "prompt_cache_creation_tokens" field.
+func (u *UsageLogUpsert) AddPromptCacheCreationTokens(v int) *UsageLogUpsert {
+	u.Add(usagelog.FieldPromptCacheCreationTokens, v)
+	return u
+}
+
+// ClearPromptCacheCreationTokens clears the value of the "prompt_cache_creation_tokens" field.
+func (u *UsageLogUpsert) ClearPromptCacheCreationTokens() *UsageLogUpsert {
+	u.SetNull(usagelog.FieldPromptCacheCreationTokens)
+	return u
+}
+
 // SetCompletionAudioTokens sets the "completion_audio_tokens" field.
 func (u *UsageLogUpsert) SetCompletionAudioTokens(v int) *UsageLogUpsert {
 	u.Set(usagelog.FieldCompletionAudioTokens, v)
@@ -852,6 +923,18 @@ func (u *UsageLogUpsert) ClearCompletionRejectedPredictionTokens() *UsageLogUpse
 	return u
 }
 
+// SetEstimated sets the "estimated" field.
+func (u *UsageLogUpsert) SetEstimated(v bool) *UsageLogUpsert {
+	u.Set(usagelog.FieldEstimated, v)
+	return u
+}
+
+// UpdateEstimated sets the "estimated" field to the value that was provided on create.
+func (u *UsageLogUpsert) UpdateEstimated() *UsageLogUpsert {
+	u.SetExcluded(usagelog.FieldEstimated)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -1087,6 +1170,34 @@ func (u *UsageLogUpsertOne) ClearPromptCachedTokens() *UsageLogUpsertOne {
 	})
 }
 
+// SetPromptCacheCreationTokens sets the "prompt_cache_creation_tokens" field.
+func (u *UsageLogUpsertOne) SetPromptCacheCreationTokens(v int) *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.SetPromptCacheCreationTokens(v)
+	})
+}
+
+// AddPromptCacheCreationTokens adds v to the "prompt_cache_creation_tokens" field.
+func (u *UsageLogUpsertOne) AddPromptCacheCreationTokens(v int) *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.AddPromptCacheCreationTokens(v)
+	})
+}
+
+// UpdatePromptCacheCreationTokens sets the "prompt_cache_creation_tokens" field to the value that was provided on create.
+func (u *UsageLogUpsertOne) UpdatePromptCacheCreationTokens() *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.UpdatePromptCacheCreationTokens()
+	})
+}
+
+// ClearPromptCacheCreationTokens clears the value of the "prompt_cache_creation_tokens" field.
+func (u *UsageLogUpsertOne) ClearPromptCacheCreationTokens() *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.ClearPromptCacheCreationTokens()
+	})
+}
+
 // SetCompletionAudioTokens sets the "completion_audio_tokens" field.
 func (u *UsageLogUpsertOne) SetCompletionAudioTokens(v int) *UsageLogUpsertOne {
 	return u.Update(func(s *UsageLogUpsert) {
@@ -1199,6 +1310,20 @@ func (u *UsageLogUpsertOne) ClearCompletionRejectedPredictionTokens() *UsageLogU
 	})
 }
 
+// SetEstimated sets the "estimated" field.
+func (u *UsageLogUpsertOne) SetEstimated(v bool) *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.SetEstimated(v)
+	})
+}
+
+// UpdateEstimated sets the "estimated" field to the value that was provided on create.
+func (u *UsageLogUpsertOne) UpdateEstimated() *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.UpdateEstimated()
+	})
+}
+
 // Exec executes the query.
 func (u *UsageLogUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1600,6 +1725,34 @@ func (u *UsageLogUpsertBulk) ClearPromptCachedTokens() *UsageLogUpsertBulk {
 	})
 }
 
+// SetPromptCacheCreationTokens sets the "prompt_cache_creation_tokens" field.
+func (u *UsageLogUpsertBulk) SetPromptCacheCreationTokens(v int) *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.SetPromptCacheCreationTokens(v)
+	})
+}
+
+// AddPromptCacheCreationTokens adds v to the "prompt_cache_creation_tokens" field.
+func (u *UsageLogUpsertBulk) AddPromptCacheCreationTokens(v int) *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.AddPromptCacheCreationTokens(v)
+	})
+}
+
+// UpdatePromptCacheCreationTokens sets the "prompt_cache_creation_tokens" field to the value that was provided on create.
+func (u *UsageLogUpsertBulk) UpdatePromptCacheCreationTokens() *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.UpdatePromptCacheCreationTokens()
+	})
+}
+
+// ClearPromptCacheCreationTokens clears the value of the "prompt_cache_creation_tokens" field.
+func (u *UsageLogUpsertBulk) ClearPromptCacheCreationTokens() *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.ClearPromptCacheCreationTokens()
+	})
+}
+
 // SetCompletionAudioTokens sets the "completion_audio_tokens" field.
 func (u *UsageLogUpsertBulk) SetCompletionAudioTokens(v int) *UsageLogUpsertBulk {
 	return u.Update(func(s *UsageLogUpsert) {
@@ -1712,6 +1865,20 @@ func (u *UsageLogUpsertBulk) ClearCompletionRejectedPredictionTokens() *UsageLog
 	})
 }
 
+// SetEstimated sets the "estimated" field.
+func (u *UsageLogUpsertBulk) SetEstimated(v bool) *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.SetEstimated(v)
+	})
+}
+
+// UpdateEstimated sets the "estimated" field to the value that was provided on create.
+func (u *UsageLogUpsertBulk) UpdateEstimated() *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.UpdateEstimated()
+	})
+}
+
 // Exec executes the query.
 func (u *UsageLogUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
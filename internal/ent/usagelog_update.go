@@ -194,6 +194,33 @@ func (ulu *UsageLogUpdate) ClearPromptCachedTokens() *UsageLogUpdate {
 	return ulu
 }
 
+// SetPromptCacheCreationTokens sets the "prompt_cache_creation_tokens" field.
+func (ulu *UsageLogUpdate) SetPromptCacheCreationTokens(i int) *UsageLogUpdate {
+	ulu.mutation.ResetPromptCacheCreationTokens()
+	ulu.mutation.SetPromptCacheCreationTokens(i)
+	return ulu
+}
+
+// SetNillablePromptCacheCreationTokens sets the "prompt_cache_creation_tokens" field if the given value is not nil.
+func (ulu *UsageLogUpdate) SetNillablePromptCacheCreationTokens(i *int) *UsageLogUpdate {
+	if i != nil {
+		ulu.SetPromptCacheCreationTokens(*i)
+	}
+	return ulu
+}
+
+// AddPromptCacheCreationTokens adds i to the "prompt_cache_creation_tokens" field.
+func (ulu *UsageLogUpdate) AddPromptCacheCreationTokens(i int) *UsageLogUpdate {
+	ulu.mutation.AddPromptCacheCreationTokens(i)
+	return ulu
+}
+
+// ClearPromptCacheCreationTokens clears the value of the "prompt_cache_creation_tokens" field.
+func (ulu *UsageLogUpdate) ClearPromptCacheCreationTokens() *UsageLogUpdate {
+	ulu.mutation.ClearPromptCacheCreationTokens()
+	return ulu
+}
+
 // SetCompletionAudioTokens sets the "completion_audio_tokens" field.
 func (ulu *UsageLogUpdate) SetCompletionAudioTokens(i int) *UsageLogUpdate {
 	ulu.mutation.ResetCompletionAudioTokens()
@@ -302,6 +329,20 @@ func (ulu *UsageLogUpdate) ClearCompletionRejectedPredictionTokens() *UsageLogUp
 	return ulu
 }
 
+// SetEstimated sets the "estimated" field.
+func (ulu *UsageLogUpdate) SetEstimated(b bool) *UsageLogUpdate {
+	ulu.mutation.SetEstimated(b)
+	return ulu
+}
+
+// SetNillableEstimated sets the "estimated" field if the given value is not nil.
+func (ulu *UsageLogUpdate) SetNillableEstimated(b *bool) *UsageLogUpdate {
+	if b != nil {
+		ulu.SetEstimated(*b)
+	}
+	return ulu
+}
+
 // SetChannel sets the "channel" edge to the Channel entity.
 func (ulu *UsageLogUpdate) SetChannel(c *Channel) *UsageLogUpdate {
 	return ulu.SetChannelID(c.ID)
@@ -434,6 +475,15 @@ func (ulu *UsageLogUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if ulu.mutation.PromptCachedTokensCleared() {
 		_spec.ClearField(usagelog.FieldPromptCachedTokens, field.TypeInt)
 	}
+	if value, ok := ulu.mutation.PromptCacheCreationTokens(); ok {
+		_spec.SetField(usagelog.FieldPromptCacheCreationTokens, field.TypeInt, value)
+	}
+	if value, ok := ulu.mutation.AddedPromptCacheCreationTokens(); ok {
+		_spec.AddField(usagelog.FieldPromptCacheCreationTokens, field.TypeInt, value)
+	}
+	if ulu.mutation.PromptCacheCreationTokensCleared() {
+		_spec.ClearField(usagelog.FieldPromptCacheCreationTokens, field.TypeInt)
+	}
 	if value, ok := ulu.mutation.CompletionAudioTokens(); ok {
 		_spec.SetField(usagelog.FieldCompletionAudioTokens, field.TypeInt, value)
 	}
@@ -470,6 +520,9 @@ func (ulu *UsageLogUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if ulu.mutation.CompletionRejectedPredictionTokensCleared() {
 		_spec.ClearField(usagelog.FieldCompletionRejectedPredictionTokens, field.TypeInt)
 	}
+	if value, ok := ulu.mutation.Estimated(); ok {
+		_spec.SetField(usagelog.FieldEstimated, field.TypeBool, value)
+	}
 	if ulu.mutation.ChannelCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -685,6 +738,33 @@ func (uluo *UsageLogUpdateOne) ClearPromptCachedTokens() *UsageLogUpdateOne {
 	return uluo
 }
 
+// SetPromptCacheCreationTokens sets the "prompt_cache_creation_tokens" field.
+func (uluo *UsageLogUpdateOne) SetPromptCacheCreationTokens(i int) *UsageLogUpdateOne {
+	uluo.mutation.ResetPromptCacheCreationTokens()
+	uluo.mutation.SetPromptCacheCreationTokens(i)
+	return uluo
+}
+
+// SetNillablePromptCacheCreationTokens sets the "prompt_cache_creation_tokens" field if the given value is not nil.
+func (uluo *UsageLogUpdateOne) SetNillablePromptCacheCreationTokens(i *int) *UsageLogUpdateOne {
+	if i != nil {
+		uluo.SetPromptCacheCreationTokens(*i)
+	}
+	return uluo
+}
+
+// AddPromptCacheCreationTokens adds i to the "prompt_cache_creation_tokens" field.
+func (uluo *UsageLogUpdateOne) AddPromptCacheCreationTokens(i int) *UsageLogUpdateOne {
+	uluo.mutation.AddPromptCacheCreationTokens(i)
+	return uluo
+}
+
+// ClearPromptCacheCreationTokens clears the value of the "prompt_cache_creation_tokens" field.
+func (uluo *UsageLogUpdateOne) ClearPromptCacheCreationTokens() *UsageLogUpdateOne {
+	uluo.mutation.ClearPromptCacheCreationTokens()
+	return uluo
+}
+
 // SetCompletionAudioTokens sets the "completion_audio_tokens" field.
 func (uluo *UsageLogUpdateOne) SetCompletionAudioTokens(i int) *UsageLogUpdateOne {
 	uluo.mutation.ResetCompletionAudioTokens()
@@ -793,6 +873,20 @@ func (uluo *UsageLogUpdateOne) ClearCompletionRejectedPredictionTokens() *UsageL
 	return uluo
 }
 
+// SetEstimated sets the "estimated" field.
+func (uluo *UsageLogUpdateOne) SetEstimated(b bool) *UsageLogUpdateOne {
+	uluo.mutation.SetEstimated(b)
+	return uluo
+}
+
+// SetNillableEstimated sets the "estimated" field if the given value is not nil.
+func (uluo *UsageLogUpdateOne) SetNillableEstimated(b *bool) *UsageLogUpdateOne {
+	if b != nil {
+		uluo.SetEstimated(*b)
+	}
+	return uluo
+}
+
 // SetChannel sets the "channel" edge to the Channel entity.
 func (uluo *UsageLogUpdateOne) SetChannel(c *Channel) *UsageLogUpdateOne {
 	return uluo.SetChannelID(c.ID)
@@ -955,6 +1049,15 @@ func (uluo *UsageLogUpdateOne) sqlSave(ctx context.Context) (_node *UsageLog, er
 	if uluo.mutation.PromptCachedTokensCleared() {
 		_spec.ClearField(usagelog.FieldPromptCachedTokens, field.TypeInt)
 	}
+	if value, ok := uluo.mutation.PromptCacheCreationTokens(); ok {
+		_spec.SetField(usagelog.FieldPromptCacheCreationTokens, field.TypeInt, value)
+	}
+	if value, ok := uluo.mutation.AddedPromptCacheCreationTokens(); ok {
+		_spec.AddField(usagelog.FieldPromptCacheCreationTokens, field.TypeInt, value)
+	}
+	if uluo.mutation.PromptCacheCreationTokensCleared() {
+		_spec.ClearField(usagelog.FieldPromptCacheCreationTokens, field.TypeInt)
+	}
 	if value, ok := uluo.mutation.CompletionAudioTokens(); ok {
 		_spec.SetField(usagelog.FieldCompletionAudioTokens, field.TypeInt, value)
 	}
@@ -991,6 +1094,9 @@ func (uluo *UsageLogUpdateOne) sqlSave(ctx context.Context) (_node *UsageLog, er
 	if uluo.mutation.CompletionRejectedPredictionTokensCleared() {
 		_spec.ClearField(usagelog.FieldCompletionRejectedPredictionTokens, field.TypeInt)
 	}
+	if value, ok := uluo.mutation.Estimated(); ok {
+		_spec.SetField(usagelog.FieldEstimated, field.TypeBool, value)
+	}
 	if uluo.mutation.ChannelCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
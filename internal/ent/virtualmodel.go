@@ -0,0 +1,178 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/looplj/axonhub/internal/ent/virtualmodel"
+	"github.com/looplj/axonhub/internal/objects"
+)
+
+// VirtualModel is the model entity for the VirtualModel schema.
+type VirtualModel struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt int `json:"deleted_at,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Status holds the value of the "status" field.
+	Status virtualmodel.Status `json:"status,omitempty"`
+	// Targets holds the value of the "targets" field.
+	Targets      []objects.VirtualModelTarget `json:"targets,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*VirtualModel) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case virtualmodel.FieldTargets:
+			values[i] = new([]byte)
+		case virtualmodel.FieldID, virtualmodel.FieldDeletedAt:
+			values[i] = new(sql.NullInt64)
+		case virtualmodel.FieldName, virtualmodel.FieldDescription, virtualmodel.FieldStatus:
+			values[i] = new(sql.NullString)
+		case virtualmodel.FieldCreatedAt, virtualmodel.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the VirtualModel fields.
+func (vm *VirtualModel) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case virtualmodel.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			vm.ID = int(value.Int64)
+		case virtualmodel.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				vm.CreatedAt = value.Time
+			}
+		case virtualmodel.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				vm.UpdatedAt = value.Time
+			}
+		case virtualmodel.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				vm.DeletedAt = int(value.Int64)
+			}
+		case virtualmodel.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				vm.Name = value.String
+			}
+		case virtualmodel.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				vm.Description = value.String
+			}
+		case virtualmodel.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				vm.Status = virtualmodel.Status(value.String)
+			}
+		case virtualmodel.FieldTargets:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field targets", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &vm.Targets); err != nil {
+					return fmt.Errorf("unmarshal field targets: %w", err)
+				}
+			}
+		default:
+			vm.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the VirtualModel.
+// This includes values selected through modifiers, order, etc.
+func (vm *VirtualModel) Value(name string) (ent.Value, error) {
+	return vm.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this VirtualModel.
+// Note that you need to call VirtualModel.Unwrap() before calling this method if this VirtualModel
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (vm *VirtualModel) Update() *VirtualModelUpdateOne {
+	return NewVirtualModelClient(vm.config).UpdateOne(vm)
+}
+
+// Unwrap unwraps the VirtualModel entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (vm *VirtualModel) Unwrap() *VirtualModel {
+	_tx, ok := vm.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: VirtualModel is not a transactional entity")
+	}
+	vm.config.driver = _tx.drv
+	return vm
+}
+
+// String implements the fmt.Stringer.
+func (vm *VirtualModel) String() string {
+	var builder strings.Builder
+	builder.WriteString("VirtualModel(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", vm.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(vm.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(vm.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(fmt.Sprintf("%v", vm.DeletedAt))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(vm.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(vm.Description)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", vm.Status))
+	builder.WriteString(", ")
+	builder.WriteString("targets=")
+	builder.WriteString(fmt.Sprintf("%v", vm.Targets))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// VirtualModels is a parsable slice of VirtualModel.
+type VirtualModels []*VirtualModel
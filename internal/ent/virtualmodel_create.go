@@ -0,0 +1,864 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/looplj/axonhub/internal/ent/virtualmodel"
+	"github.com/looplj/axonhub/internal/objects"
+)
+
+// VirtualModelCreate is the builder for creating a VirtualModel entity.
+type VirtualModelCreate struct {
+	config
+	mutation *VirtualModelMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (vmc *VirtualModelCreate) SetCreatedAt(t time.Time) *VirtualModelCreate {
+	vmc.mutation.SetCreatedAt(t)
+	return vmc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (vmc *VirtualModelCreate) SetNillableCreatedAt(t *time.Time) *VirtualModelCreate {
+	if t != nil {
+		vmc.SetCreatedAt(*t)
+	}
+	return vmc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (vmc *VirtualModelCreate) SetUpdatedAt(t time.Time) *VirtualModelCreate {
+	vmc.mutation.SetUpdatedAt(t)
+	return vmc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (vmc *VirtualModelCreate) SetNillableUpdatedAt(t *time.Time) *VirtualModelCreate {
+	if t != nil {
+		vmc.SetUpdatedAt(*t)
+	}
+	return vmc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (vmc *VirtualModelCreate) SetDeletedAt(i int) *VirtualModelCreate {
+	vmc.mutation.SetDeletedAt(i)
+	return vmc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (vmc *VirtualModelCreate) SetNillableDeletedAt(i *int) *VirtualModelCreate {
+	if i != nil {
+		vmc.SetDeletedAt(*i)
+	}
+	return vmc
+}
+
+// SetName sets the "name" field.
+func (vmc *VirtualModelCreate) SetName(s string) *VirtualModelCreate {
+	vmc.mutation.SetName(s)
+	return vmc
+}
+
+// SetDescription sets the "description" field.
+func (vmc *VirtualModelCreate) SetDescription(s string) *VirtualModelCreate {
+	vmc.mutation.SetDescription(s)
+	return vmc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (vmc *VirtualModelCreate) SetNillableDescription(s *string) *VirtualModelCreate {
+	if s != nil {
+		vmc.SetDescription(*s)
+	}
+	return vmc
+}
+
+// SetStatus sets the "status" field.
+func (vmc *VirtualModelCreate) SetStatus(v virtualmodel.Status) *VirtualModelCreate {
+	vmc.mutation.SetStatus(v)
+	return vmc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (vmc *VirtualModelCreate) SetNillableStatus(v *virtualmodel.Status) *VirtualModelCreate {
+	if v != nil {
+		vmc.SetStatus(*v)
+	}
+	return vmc
+}
+
+// SetTargets sets the "targets" field.
+func (vmc *VirtualModelCreate) SetTargets(omt []objects.VirtualModelTarget) *VirtualModelCreate {
+	vmc.mutation.SetTargets(omt)
+	return vmc
+}
+
+// Mutation returns the VirtualModelMutation object of the builder.
+func (vmc *VirtualModelCreate) Mutation() *VirtualModelMutation {
+	return vmc.mutation
+}
+
+// Save creates the VirtualModel in the database.
+func (vmc *VirtualModelCreate) Save(ctx context.Context) (*VirtualModel, error) {
+	if err := vmc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, vmc.sqlSave, vmc.mutation, vmc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (vmc *VirtualModelCreate) SaveX(ctx context.Context) *VirtualModel {
+	v, err := vmc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (vmc *VirtualModelCreate) Exec(ctx context.Context) error {
+	_, err := vmc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vmc *VirtualModelCreate) ExecX(ctx context.Context) {
+	if err := vmc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (vmc *VirtualModelCreate) defaults() error {
+	if _, ok := vmc.mutation.CreatedAt(); !ok {
+		if virtualmodel.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized virtualmodel.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := virtualmodel.DefaultCreatedAt()
+		vmc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := vmc.mutation.UpdatedAt(); !ok {
+		if virtualmodel.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized virtualmodel.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := virtualmodel.DefaultUpdatedAt()
+		vmc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := vmc.mutation.DeletedAt(); !ok {
+		v := virtualmodel.DefaultDeletedAt
+		vmc.mutation.SetDeletedAt(v)
+	}
+	if _, ok := vmc.mutation.Status(); !ok {
+		v := virtualmodel.DefaultStatus
+		vmc.mutation.SetStatus(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (vmc *VirtualModelCreate) check() error {
+	if _, ok := vmc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "VirtualModel.created_at"`)}
+	}
+	if _, ok := vmc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "VirtualModel.updated_at"`)}
+	}
+	if _, ok := vmc.mutation.DeletedAt(); !ok {
+		return &ValidationError{Name: "deleted_at", err: errors.New(`ent: missing required field "VirtualModel.deleted_at"`)}
+	}
+	if _, ok := vmc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "VirtualModel.name"`)}
+	}
+	if v, ok := vmc.mutation.Name(); ok {
+		if err := virtualmodel.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "VirtualModel.name": %w`, err)}
+		}
+	}
+	if _, ok := vmc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "VirtualModel.status"`)}
+	}
+	if v, ok := vmc.mutation.Status(); ok {
+		if err := virtualmodel.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "VirtualModel.status": %w`, err)}
+		}
+	}
+	if _, ok := vmc.mutation.Targets(); !ok {
+		return &ValidationError{Name: "targets", err: errors.New(`ent: missing required field "VirtualModel.targets"`)}
+	}
+	return nil
+}
+
+func (vmc *VirtualModelCreate) sqlSave(ctx context.Context) (*VirtualModel, error) {
+	if err := vmc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := vmc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, vmc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	vmc.mutation.id = &_node.ID
+	vmc.mutation.done = true
+	return _node, nil
+}
+
+func (vmc *VirtualModelCreate) createSpec() (*VirtualModel, *sqlgraph.CreateSpec) {
+	var (
+		_node = &VirtualModel{config: vmc.config}
+		_spec = sqlgraph.NewCreateSpec(virtualmodel.Table, sqlgraph.NewFieldSpec(virtualmodel.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = vmc.conflict
+	if value, ok := vmc.mutation.CreatedAt(); ok {
+		_spec.SetField(virtualmodel.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := vmc.mutation.UpdatedAt(); ok {
+		_spec.SetField(virtualmodel.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := vmc.mutation.DeletedAt(); ok {
+		_spec.SetField(virtualmodel.FieldDeletedAt, field.TypeInt, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := vmc.mutation.Name(); ok {
+		_spec.SetField(virtualmodel.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := vmc.mutation.Description(); ok {
+		_spec.SetField(virtualmodel.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := vmc.mutation.Status(); ok {
+		_spec.SetField(virtualmodel.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := vmc.mutation.Targets(); ok {
+		_spec.SetField(virtualmodel.FieldTargets, field.TypeJSON, value)
+		_node.Targets = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.VirtualModel.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.VirtualModelUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (vmc *VirtualModelCreate) OnConflict(opts ...sql.ConflictOption) *VirtualModelUpsertOne {
+	vmc.conflict = opts
+	return &VirtualModelUpsertOne{
+		create: vmc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.VirtualModel.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (vmc *VirtualModelCreate) OnConflictColumns(columns ...string) *VirtualModelUpsertOne {
+	vmc.conflict = append(vmc.conflict, sql.ConflictColumns(columns...))
+	return &VirtualModelUpsertOne{
+		create: vmc,
+	}
+}
+
+type (
+	// VirtualModelUpsertOne is the builder for "upsert"-ing
+	//  one VirtualModel node.
+	VirtualModelUpsertOne struct {
+		create *VirtualModelCreate
+	}
+
+	// VirtualModelUpsert is the "OnConflict" setter.
+	VirtualModelUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *VirtualModelUpsert) SetUpdatedAt(v time.Time) *VirtualModelUpsert {
+	u.Set(virtualmodel.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *VirtualModelUpsert) UpdateUpdatedAt() *VirtualModelUpsert {
+	u.SetExcluded(virtualmodel.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *VirtualModelUpsert) SetDeletedAt(v int) *VirtualModelUpsert {
+	u.Set(virtualmodel.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *VirtualModelUpsert) UpdateDeletedAt() *VirtualModelUpsert {
+	u.SetExcluded(virtualmodel.FieldDeletedAt)
+	return u
+}
+
+// AddDeletedAt adds v to the "deleted_at" field.
+func (u *VirtualModelUpsert) AddDeletedAt(v int) *VirtualModelUpsert {
+	u.Add(virtualmodel.FieldDeletedAt, v)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *VirtualModelUpsert) SetName(v string) *VirtualModelUpsert {
+	u.Set(virtualmodel.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *VirtualModelUpsert) UpdateName() *VirtualModelUpsert {
+	u.SetExcluded(virtualmodel.FieldName)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *VirtualModelUpsert) SetDescription(v string) *VirtualModelUpsert {
+	u.Set(virtualmodel.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *VirtualModelUpsert) UpdateDescription() *VirtualModelUpsert {
+	u.SetExcluded(virtualmodel.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *VirtualModelUpsert) ClearDescription() *VirtualModelUpsert {
+	u.SetNull(virtualmodel.FieldDescription)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *VirtualModelUpsert) SetStatus(v virtualmodel.Status) *VirtualModelUpsert {
+	u.Set(virtualmodel.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *VirtualModelUpsert) UpdateStatus() *VirtualModelUpsert {
+	u.SetExcluded(virtualmodel.FieldStatus)
+	return u
+}
+
+// SetTargets sets the "targets" field.
+func (u *VirtualModelUpsert) SetTargets(v []objects.VirtualModelTarget) *VirtualModelUpsert {
+	u.Set(virtualmodel.FieldTargets, v)
+	return u
+}
+
+// UpdateTargets sets the "targets" field to the value that was provided on create.
+func (u *VirtualModelUpsert) UpdateTargets() *VirtualModelUpsert {
+	u.SetExcluded(virtualmodel.FieldTargets)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.VirtualModel.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *VirtualModelUpsertOne) UpdateNewValues() *VirtualModelUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(virtualmodel.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.VirtualModel.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *VirtualModelUpsertOne) Ignore() *VirtualModelUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *VirtualModelUpsertOne) DoNothing() *VirtualModelUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the VirtualModelCreate.OnConflict
+// documentation for more info.
+func (u *VirtualModelUpsertOne) Update(set func(*VirtualModelUpsert)) *VirtualModelUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&VirtualModelUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *VirtualModelUpsertOne) SetUpdatedAt(v time.Time) *VirtualModelUpsertOne {
+	return u.Update(func(s *VirtualModelUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *VirtualModelUpsertOne) UpdateUpdatedAt() *VirtualModelUpsertOne {
+	return u.Update(func(s *VirtualModelUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *VirtualModelUpsertOne) SetDeletedAt(v int) *VirtualModelUpsertOne {
+	return u.Update(func(s *VirtualModelUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// AddDeletedAt adds v to the "deleted_at" field.
+func (u *VirtualModelUpsertOne) AddDeletedAt(v int) *VirtualModelUpsertOne {
+	return u.Update(func(s *VirtualModelUpsert) {
+		s.AddDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *VirtualModelUpsertOne) UpdateDeletedAt() *VirtualModelUpsertOne {
+	return u.Update(func(s *VirtualModelUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *VirtualModelUpsertOne) SetName(v string) *VirtualModelUpsertOne {
+	return u.Update(func(s *VirtualModelUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *VirtualModelUpsertOne) UpdateName() *VirtualModelUpsertOne {
+	return u.Update(func(s *VirtualModelUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *VirtualModelUpsertOne) SetDescription(v string) *VirtualModelUpsertOne {
+	return u.Update(func(s *VirtualModelUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *VirtualModelUpsertOne) UpdateDescription() *VirtualModelUpsertOne {
+	return u.Update(func(s *VirtualModelUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *VirtualModelUpsertOne) ClearDescription() *VirtualModelUpsertOne {
+	return u.Update(func(s *VirtualModelUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *VirtualModelUpsertOne) SetStatus(v virtualmodel.Status) *VirtualModelUpsertOne {
+	return u.Update(func(s *VirtualModelUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *VirtualModelUpsertOne) UpdateStatus() *VirtualModelUpsertOne {
+	return u.Update(func(s *VirtualModelUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetTargets sets the "targets" field.
+func (u *VirtualModelUpsertOne) SetTargets(v []objects.VirtualModelTarget) *VirtualModelUpsertOne {
+	return u.Update(func(s *VirtualModelUpsert) {
+		s.SetTargets(v)
+	})
+}
+
+// UpdateTargets sets the "targets" field to the value that was provided on create.
+func (u *VirtualModelUpsertOne) UpdateTargets() *VirtualModelUpsertOne {
+	return u.Update(func(s *VirtualModelUpsert) {
+		s.UpdateTargets()
+	})
+}
+
+// Exec executes the query.
+func (u *VirtualModelUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for VirtualModelCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *VirtualModelUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *VirtualModelUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *VirtualModelUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// VirtualModelCreateBulk is the builder for creating many VirtualModel entities in bulk.
+type VirtualModelCreateBulk struct {
+	config
+	err      error
+	builders []*VirtualModelCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the VirtualModel entities in the database.
+func (vmcb *VirtualModelCreateBulk) Save(ctx context.Context) ([]*VirtualModel, error) {
+	if vmcb.err != nil {
+		return nil, vmcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(vmcb.builders))
+	nodes := make([]*VirtualModel, len(vmcb.builders))
+	mutators := make([]Mutator, len(vmcb.builders))
+	for i := range vmcb.builders {
+		func(i int, root context.Context) {
+			builder := vmcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*VirtualModelMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, vmcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = vmcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, vmcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, vmcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (vmcb *VirtualModelCreateBulk) SaveX(ctx context.Context) []*VirtualModel {
+	v, err := vmcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (vmcb *VirtualModelCreateBulk) Exec(ctx context.Context) error {
+	_, err := vmcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vmcb *VirtualModelCreateBulk) ExecX(ctx context.Context) {
+	if err := vmcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.VirtualModel.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.VirtualModelUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (vmcb *VirtualModelCreateBulk) OnConflict(opts ...sql.ConflictOption) *VirtualModelUpsertBulk {
+	vmcb.conflict = opts
+	return &VirtualModelUpsertBulk{
+		create: vmcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.VirtualModel.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (vmcb *VirtualModelCreateBulk) OnConflictColumns(columns ...string) *VirtualModelUpsertBulk {
+	vmcb.conflict = append(vmcb.conflict, sql.ConflictColumns(columns...))
+	return &VirtualModelUpsertBulk{
+		create: vmcb,
+	}
+}
+
+// VirtualModelUpsertBulk is the builder for "upsert"-ing
+// a bulk of VirtualModel nodes.
+type VirtualModelUpsertBulk struct {
+	create *VirtualModelCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.VirtualModel.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *VirtualModelUpsertBulk) UpdateNewValues() *VirtualModelUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(virtualmodel.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.VirtualModel.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *VirtualModelUpsertBulk) Ignore() *VirtualModelUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *VirtualModelUpsertBulk) DoNothing() *VirtualModelUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the VirtualModelCreateBulk.OnConflict
+// documentation for more info.
+func (u *VirtualModelUpsertBulk) Update(set func(*VirtualModelUpsert)) *VirtualModelUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&VirtualModelUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *VirtualModelUpsertBulk) SetUpdatedAt(v time.Time) *VirtualModelUpsertBulk {
+	return u.Update(func(s *VirtualModelUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *VirtualModelUpsertBulk) UpdateUpdatedAt() *VirtualModelUpsertBulk {
+	return u.Update(func(s *VirtualModelUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *VirtualModelUpsertBulk) SetDeletedAt(v int) *VirtualModelUpsertBulk {
+	return u.Update(func(s *VirtualModelUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// AddDeletedAt adds v to the "deleted_at" field.
+func (u *VirtualModelUpsertBulk) AddDeletedAt(v int) *VirtualModelUpsertBulk {
+	return u.Update(func(s *VirtualModelUpsert) {
+		s.AddDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *VirtualModelUpsertBulk) UpdateDeletedAt() *VirtualModelUpsertBulk {
+	return u.Update(func(s *VirtualModelUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *VirtualModelUpsertBulk) SetName(v string) *VirtualModelUpsertBulk {
+	return u.Update(func(s *VirtualModelUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *VirtualModelUpsertBulk) UpdateName() *VirtualModelUpsertBulk {
+	return u.Update(func(s *VirtualModelUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *VirtualModelUpsertBulk) SetDescription(v string) *VirtualModelUpsertBulk {
+	return u.Update(func(s *VirtualModelUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *VirtualModelUpsertBulk) UpdateDescription() *VirtualModelUpsertBulk {
+	return u.Update(func(s *VirtualModelUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *VirtualModelUpsertBulk) ClearDescription() *VirtualModelUpsertBulk {
+	return u.Update(func(s *VirtualModelUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *VirtualModelUpsertBulk) SetStatus(v virtualmodel.Status) *VirtualModelUpsertBulk {
+	return u.Update(func(s *VirtualModelUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *VirtualModelUpsertBulk) UpdateStatus() *VirtualModelUpsertBulk {
+	return u.Update(func(s *VirtualModelUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetTargets sets the "targets" field.
+func (u *VirtualModelUpsertBulk) SetTargets(v []objects.VirtualModelTarget) *VirtualModelUpsertBulk {
+	return u.Update(func(s *VirtualModelUpsert) {
+		s.SetTargets(v)
+	})
+}
+
+// UpdateTargets sets the "targets" field to the value that was provided on create.
+func (u *VirtualModelUpsertBulk) UpdateTargets() *VirtualModelUpsertBulk {
+	return u.Update(func(s *VirtualModelUpsert) {
+		s.UpdateTargets()
+	})
+}
+
+// Exec executes the query.
+func (u *VirtualModelUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the VirtualModelCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for VirtualModelCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *VirtualModelUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
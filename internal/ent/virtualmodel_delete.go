@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/looplj/axonhub/internal/ent/predicate"
+	"github.com/looplj/axonhub/internal/ent/virtualmodel"
+)
+
+// VirtualModelDelete is the builder for deleting a VirtualModel entity.
+type VirtualModelDelete struct {
+	config
+	hooks    []Hook
+	mutation *VirtualModelMutation
+}
+
+// Where appends a list predicates to the VirtualModelDelete builder.
+func (vmd *VirtualModelDelete) Where(ps ...predicate.VirtualModel) *VirtualModelDelete {
+	vmd.mutation.Where(ps...)
+	return vmd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (vmd *VirtualModelDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, vmd.sqlExec, vmd.mutation, vmd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vmd *VirtualModelDelete) ExecX(ctx context.Context) int {
+	n, err := vmd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (vmd *VirtualModelDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(virtualmodel.Table, sqlgraph.NewFieldSpec(virtualmodel.FieldID, field.TypeInt))
+	if ps := vmd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, vmd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	vmd.mutation.done = true
+	return affected, err
+}
+
+// VirtualModelDeleteOne is the builder for deleting a single VirtualModel entity.
+type VirtualModelDeleteOne struct {
+	vmd *VirtualModelDelete
+}
+
+// Where appends a list predicates to the VirtualModelDelete builder.
+func (vmdo *VirtualModelDeleteOne) Where(ps ...predicate.VirtualModel) *VirtualModelDeleteOne {
+	vmdo.vmd.mutation.Where(ps...)
+	return vmdo
+}
+
+// Exec executes the deletion query.
+func (vmdo *VirtualModelDeleteOne) Exec(ctx context.Context) error {
+	n, err := vmdo.vmd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{virtualmodel.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vmdo *VirtualModelDeleteOne) ExecX(ctx context.Context) {
+	if err := vmdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,563 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/looplj/axonhub/internal/ent/predicate"
+	"github.com/looplj/axonhub/internal/ent/virtualmodel"
+)
+
+// VirtualModelQuery is the builder for querying VirtualModel entities.
+type VirtualModelQuery struct {
+	config
+	ctx        *QueryContext
+	order      []virtualmodel.OrderOption
+	inters     []Interceptor
+	predicates []predicate.VirtualModel
+	loadTotal  []func(context.Context, []*VirtualModel) error
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the VirtualModelQuery builder.
+func (vmq *VirtualModelQuery) Where(ps ...predicate.VirtualModel) *VirtualModelQuery {
+	vmq.predicates = append(vmq.predicates, ps...)
+	return vmq
+}
+
+// Limit the number of records to be returned by this query.
+func (vmq *VirtualModelQuery) Limit(limit int) *VirtualModelQuery {
+	vmq.ctx.Limit = &limit
+	return vmq
+}
+
+// Offset to start from.
+func (vmq *VirtualModelQuery) Offset(offset int) *VirtualModelQuery {
+	vmq.ctx.Offset = &offset
+	return vmq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (vmq *VirtualModelQuery) Unique(unique bool) *VirtualModelQuery {
+	vmq.ctx.Unique = &unique
+	return vmq
+}
+
+// Order specifies how the records should be ordered.
+func (vmq *VirtualModelQuery) Order(o ...virtualmodel.OrderOption) *VirtualModelQuery {
+	vmq.order = append(vmq.order, o...)
+	return vmq
+}
+
+// First returns the first VirtualModel entity from the query.
+// Returns a *NotFoundError when no VirtualModel was found.
+func (vmq *VirtualModelQuery) First(ctx context.Context) (*VirtualModel, error) {
+	nodes, err := vmq.Limit(1).All(setContextOp(ctx, vmq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{virtualmodel.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (vmq *VirtualModelQuery) FirstX(ctx context.Context) *VirtualModel {
+	node, err := vmq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first VirtualModel ID from the query.
+// Returns a *NotFoundError when no VirtualModel ID was found.
+func (vmq *VirtualModelQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = vmq.Limit(1).IDs(setContextOp(ctx, vmq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{virtualmodel.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (vmq *VirtualModelQuery) FirstIDX(ctx context.Context) int {
+	id, err := vmq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single VirtualModel entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one VirtualModel entity is found.
+// Returns a *NotFoundError when no VirtualModel entities are found.
+func (vmq *VirtualModelQuery) Only(ctx context.Context) (*VirtualModel, error) {
+	nodes, err := vmq.Limit(2).All(setContextOp(ctx, vmq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{virtualmodel.Label}
+	default:
+		return nil, &NotSingularError{virtualmodel.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (vmq *VirtualModelQuery) OnlyX(ctx context.Context) *VirtualModel {
+	node, err := vmq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only VirtualModel ID in the query.
+// Returns a *NotSingularError when more than one VirtualModel ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (vmq *VirtualModelQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = vmq.Limit(2).IDs(setContextOp(ctx, vmq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{virtualmodel.Label}
+	default:
+		err = &NotSingularError{virtualmodel.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (vmq *VirtualModelQuery) OnlyIDX(ctx context.Context) int {
+	id, err := vmq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of VirtualModels.
+func (vmq *VirtualModelQuery) All(ctx context.Context) ([]*VirtualModel, error) {
+	ctx = setContextOp(ctx, vmq.ctx, ent.OpQueryAll)
+	if err := vmq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*VirtualModel, *VirtualModelQuery]()
+	return withInterceptors[[]*VirtualModel](ctx, vmq, qr, vmq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (vmq *VirtualModelQuery) AllX(ctx context.Context) []*VirtualModel {
+	nodes, err := vmq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of VirtualModel IDs.
+func (vmq *VirtualModelQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if vmq.ctx.Unique == nil && vmq.path != nil {
+		vmq.Unique(true)
+	}
+	ctx = setContextOp(ctx, vmq.ctx, ent.OpQueryIDs)
+	if err = vmq.Select(virtualmodel.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (vmq *VirtualModelQuery) IDsX(ctx context.Context) []int {
+	ids, err := vmq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (vmq *VirtualModelQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, vmq.ctx, ent.OpQueryCount)
+	if err := vmq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, vmq, querierCount[*VirtualModelQuery](), vmq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (vmq *VirtualModelQuery) CountX(ctx context.Context) int {
+	count, err := vmq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (vmq *VirtualModelQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, vmq.ctx, ent.OpQueryExist)
+	switch _, err := vmq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (vmq *VirtualModelQuery) ExistX(ctx context.Context) bool {
+	exist, err := vmq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the VirtualModelQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (vmq *VirtualModelQuery) Clone() *VirtualModelQuery {
+	if vmq == nil {
+		return nil
+	}
+	return &VirtualModelQuery{
+		config:     vmq.config,
+		ctx:        vmq.ctx.Clone(),
+		order:      append([]virtualmodel.OrderOption{}, vmq.order...),
+		inters:     append([]Interceptor{}, vmq.inters...),
+		predicates: append([]predicate.VirtualModel{}, vmq.predicates...),
+		// clone intermediate query.
+		sql:       vmq.sql.Clone(),
+		path:      vmq.path,
+		modifiers: append([]func(*sql.Selector){}, vmq.modifiers...),
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.VirtualModel.Query().
+//		GroupBy(virtualmodel.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (vmq *VirtualModelQuery) GroupBy(field string, fields ...string) *VirtualModelGroupBy {
+	vmq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &VirtualModelGroupBy{build: vmq}
+	grbuild.flds = &vmq.ctx.Fields
+	grbuild.label = virtualmodel.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.VirtualModel.Query().
+//		Select(virtualmodel.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (vmq *VirtualModelQuery) Select(fields ...string) *VirtualModelSelect {
+	vmq.ctx.Fields = append(vmq.ctx.Fields, fields...)
+	sbuild := &VirtualModelSelect{VirtualModelQuery: vmq}
+	sbuild.label = virtualmodel.Label
+	sbuild.flds, sbuild.scan = &vmq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a VirtualModelSelect configured with the given aggregations.
+func (vmq *VirtualModelQuery) Aggregate(fns ...AggregateFunc) *VirtualModelSelect {
+	return vmq.Select().Aggregate(fns...)
+}
+
+func (vmq *VirtualModelQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range vmq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, vmq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range vmq.ctx.Fields {
+		if !virtualmodel.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if vmq.path != nil {
+		prev, err := vmq.path(ctx)
+		if err != nil {
+			return err
+		}
+		vmq.sql = prev
+	}
+	if virtualmodel.Policy == nil {
+		return errors.New("ent: uninitialized virtualmodel.Policy (forgotten import ent/runtime?)")
+	}
+	if err := virtualmodel.Policy.EvalQuery(ctx, vmq); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (vmq *VirtualModelQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*VirtualModel, error) {
+	var (
+		nodes = []*VirtualModel{}
+		_spec = vmq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*VirtualModel).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &VirtualModel{config: vmq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(vmq.modifiers) > 0 {
+		_spec.Modifiers = vmq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, vmq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	for i := range vmq.loadTotal {
+		if err := vmq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (vmq *VirtualModelQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := vmq.querySpec()
+	if len(vmq.modifiers) > 0 {
+		_spec.Modifiers = vmq.modifiers
+	}
+	_spec.Node.Columns = vmq.ctx.Fields
+	if len(vmq.ctx.Fields) > 0 {
+		_spec.Unique = vmq.ctx.Unique != nil && *vmq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, vmq.driver, _spec)
+}
+
+func (vmq *VirtualModelQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(virtualmodel.Table, virtualmodel.Columns, sqlgraph.NewFieldSpec(virtualmodel.FieldID, field.TypeInt))
+	_spec.From = vmq.sql
+	if unique := vmq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if vmq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := vmq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, virtualmodel.FieldID)
+		for i := range fields {
+			if fields[i] != virtualmodel.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := vmq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := vmq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := vmq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := vmq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (vmq *VirtualModelQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(vmq.driver.Dialect())
+	t1 := builder.Table(virtualmodel.Table)
+	columns := vmq.ctx.Fields
+	if len(columns) == 0 {
+		columns = virtualmodel.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if vmq.sql != nil {
+		selector = vmq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if vmq.ctx.Unique != nil && *vmq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range vmq.modifiers {
+		m(selector)
+	}
+	for _, p := range vmq.predicates {
+		p(selector)
+	}
+	for _, p := range vmq.order {
+		p(selector)
+	}
+	if offset := vmq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := vmq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (vmq *VirtualModelQuery) Modify(modifiers ...func(s *sql.Selector)) *VirtualModelSelect {
+	vmq.modifiers = append(vmq.modifiers, modifiers...)
+	return vmq.Select()
+}
+
+// VirtualModelGroupBy is the group-by builder for VirtualModel entities.
+type VirtualModelGroupBy struct {
+	selector
+	build *VirtualModelQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (vmgb *VirtualModelGroupBy) Aggregate(fns ...AggregateFunc) *VirtualModelGroupBy {
+	vmgb.fns = append(vmgb.fns, fns...)
+	return vmgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (vmgb *VirtualModelGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, vmgb.build.ctx, ent.OpQueryGroupBy)
+	if err := vmgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*VirtualModelQuery, *VirtualModelGroupBy](ctx, vmgb.build, vmgb, vmgb.build.inters, v)
+}
+
+func (vmgb *VirtualModelGroupBy) sqlScan(ctx context.Context, root *VirtualModelQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(vmgb.fns))
+	for _, fn := range vmgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*vmgb.flds)+len(vmgb.fns))
+		for _, f := range *vmgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*vmgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := vmgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// VirtualModelSelect is the builder for selecting fields of VirtualModel entities.
+type VirtualModelSelect struct {
+	*VirtualModelQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (vms *VirtualModelSelect) Aggregate(fns ...AggregateFunc) *VirtualModelSelect {
+	vms.fns = append(vms.fns, fns...)
+	return vms
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (vms *VirtualModelSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, vms.ctx, ent.OpQuerySelect)
+	if err := vms.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*VirtualModelQuery, *VirtualModelSelect](ctx, vms.VirtualModelQuery, vms, vms.inters, v)
+}
+
+func (vms *VirtualModelSelect) sqlScan(ctx context.Context, root *VirtualModelQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(vms.fns))
+	for _, fn := range vms.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*vms.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := vms.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (vms *VirtualModelSelect) Modify(modifiers ...func(s *sql.Selector)) *VirtualModelSelect {
+	vms.modifiers = append(vms.modifiers, modifiers...)
+	return vms
+}
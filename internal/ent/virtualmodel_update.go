@@ -0,0 +1,492 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/looplj/axonhub/internal/ent/predicate"
+	"github.com/looplj/axonhub/internal/ent/virtualmodel"
+	"github.com/looplj/axonhub/internal/objects"
+)
+
+// VirtualModelUpdate is the builder for updating VirtualModel entities.
+type VirtualModelUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *VirtualModelMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the VirtualModelUpdate builder.
+func (vmu *VirtualModelUpdate) Where(ps ...predicate.VirtualModel) *VirtualModelUpdate {
+	vmu.mutation.Where(ps...)
+	return vmu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (vmu *VirtualModelUpdate) SetUpdatedAt(t time.Time) *VirtualModelUpdate {
+	vmu.mutation.SetUpdatedAt(t)
+	return vmu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (vmu *VirtualModelUpdate) SetDeletedAt(i int) *VirtualModelUpdate {
+	vmu.mutation.ResetDeletedAt()
+	vmu.mutation.SetDeletedAt(i)
+	return vmu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (vmu *VirtualModelUpdate) SetNillableDeletedAt(i *int) *VirtualModelUpdate {
+	if i != nil {
+		vmu.SetDeletedAt(*i)
+	}
+	return vmu
+}
+
+// AddDeletedAt adds i to the "deleted_at" field.
+func (vmu *VirtualModelUpdate) AddDeletedAt(i int) *VirtualModelUpdate {
+	vmu.mutation.AddDeletedAt(i)
+	return vmu
+}
+
+// SetName sets the "name" field.
+func (vmu *VirtualModelUpdate) SetName(s string) *VirtualModelUpdate {
+	vmu.mutation.SetName(s)
+	return vmu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (vmu *VirtualModelUpdate) SetNillableName(s *string) *VirtualModelUpdate {
+	if s != nil {
+		vmu.SetName(*s)
+	}
+	return vmu
+}
+
+// SetDescription sets the "description" field.
+func (vmu *VirtualModelUpdate) SetDescription(s string) *VirtualModelUpdate {
+	vmu.mutation.SetDescription(s)
+	return vmu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (vmu *VirtualModelUpdate) SetNillableDescription(s *string) *VirtualModelUpdate {
+	if s != nil {
+		vmu.SetDescription(*s)
+	}
+	return vmu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (vmu *VirtualModelUpdate) ClearDescription() *VirtualModelUpdate {
+	vmu.mutation.ClearDescription()
+	return vmu
+}
+
+// SetStatus sets the "status" field.
+func (vmu *VirtualModelUpdate) SetStatus(v virtualmodel.Status) *VirtualModelUpdate {
+	vmu.mutation.SetStatus(v)
+	return vmu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (vmu *VirtualModelUpdate) SetNillableStatus(v *virtualmodel.Status) *VirtualModelUpdate {
+	if v != nil {
+		vmu.SetStatus(*v)
+	}
+	return vmu
+}
+
+// SetTargets sets the "targets" field.
+func (vmu *VirtualModelUpdate) SetTargets(omt []objects.VirtualModelTarget) *VirtualModelUpdate {
+	vmu.mutation.SetTargets(omt)
+	return vmu
+}
+
+// AppendTargets appends omt to the "targets" field.
+func (vmu *VirtualModelUpdate) AppendTargets(omt []objects.VirtualModelTarget) *VirtualModelUpdate {
+	vmu.mutation.AppendTargets(omt)
+	return vmu
+}
+
+// Mutation returns the VirtualModelMutation object of the builder.
+func (vmu *VirtualModelUpdate) Mutation() *VirtualModelMutation {
+	return vmu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (vmu *VirtualModelUpdate) Save(ctx context.Context) (int, error) {
+	if err := vmu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, vmu.sqlSave, vmu.mutation, vmu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (vmu *VirtualModelUpdate) SaveX(ctx context.Context) int {
+	affected, err := vmu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (vmu *VirtualModelUpdate) Exec(ctx context.Context) error {
+	_, err := vmu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vmu *VirtualModelUpdate) ExecX(ctx context.Context) {
+	if err := vmu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (vmu *VirtualModelUpdate) defaults() error {
+	if _, ok := vmu.mutation.UpdatedAt(); !ok {
+		if virtualmodel.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized virtualmodel.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := virtualmodel.UpdateDefaultUpdatedAt()
+		vmu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (vmu *VirtualModelUpdate) check() error {
+	if v, ok := vmu.mutation.Name(); ok {
+		if err := virtualmodel.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "VirtualModel.name": %w`, err)}
+		}
+	}
+	if v, ok := vmu.mutation.Status(); ok {
+		if err := virtualmodel.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "VirtualModel.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (vmu *VirtualModelUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *VirtualModelUpdate {
+	vmu.modifiers = append(vmu.modifiers, modifiers...)
+	return vmu
+}
+
+func (vmu *VirtualModelUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := vmu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(virtualmodel.Table, virtualmodel.Columns, sqlgraph.NewFieldSpec(virtualmodel.FieldID, field.TypeInt))
+	if ps := vmu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := vmu.mutation.UpdatedAt(); ok {
+		_spec.SetField(virtualmodel.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := vmu.mutation.DeletedAt(); ok {
+		_spec.SetField(virtualmodel.FieldDeletedAt, field.TypeInt, value)
+	}
+	if value, ok := vmu.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(virtualmodel.FieldDeletedAt, field.TypeInt, value)
+	}
+	if value, ok := vmu.mutation.Name(); ok {
+		_spec.SetField(virtualmodel.FieldName, field.TypeString, value)
+	}
+	if value, ok := vmu.mutation.Description(); ok {
+		_spec.SetField(virtualmodel.FieldDescription, field.TypeString, value)
+	}
+	if vmu.mutation.DescriptionCleared() {
+		_spec.ClearField(virtualmodel.FieldDescription, field.TypeString)
+	}
+	if value, ok := vmu.mutation.Status(); ok {
+		_spec.SetField(virtualmodel.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := vmu.mutation.Targets(); ok {
+		_spec.SetField(virtualmodel.FieldTargets, field.TypeJSON, value)
+	}
+	if value, ok := vmu.mutation.AppendedTargets(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, virtualmodel.FieldTargets, value)
+		})
+	}
+	_spec.AddModifiers(vmu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, vmu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{virtualmodel.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	vmu.mutation.done = true
+	return n, nil
+}
+
+// VirtualModelUpdateOne is the builder for updating a single VirtualModel entity.
+type VirtualModelUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *VirtualModelMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (vmuo *VirtualModelUpdateOne) SetUpdatedAt(t time.Time) *VirtualModelUpdateOne {
+	vmuo.mutation.SetUpdatedAt(t)
+	return vmuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (vmuo *VirtualModelUpdateOne) SetDeletedAt(i int) *VirtualModelUpdateOne {
+	vmuo.mutation.ResetDeletedAt()
+	vmuo.mutation.SetDeletedAt(i)
+	return vmuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (vmuo *VirtualModelUpdateOne) SetNillableDeletedAt(i *int) *VirtualModelUpdateOne {
+	if i != nil {
+		vmuo.SetDeletedAt(*i)
+	}
+	return vmuo
+}
+
+// AddDeletedAt adds i to the "deleted_at" field.
+func (vmuo *VirtualModelUpdateOne) AddDeletedAt(i int) *VirtualModelUpdateOne {
+	vmuo.mutation.AddDeletedAt(i)
+	return vmuo
+}
+
+// SetName sets the "name" field.
+func (vmuo *VirtualModelUpdateOne) SetName(s string) *VirtualModelUpdateOne {
+	vmuo.mutation.SetName(s)
+	return vmuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (vmuo *VirtualModelUpdateOne) SetNillableName(s *string) *VirtualModelUpdateOne {
+	if s != nil {
+		vmuo.SetName(*s)
+	}
+	return vmuo
+}
+
+// SetDescription sets the "description" field.
+func (vmuo *VirtualModelUpdateOne) SetDescription(s string) *VirtualModelUpdateOne {
+	vmuo.mutation.SetDescription(s)
+	return vmuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (vmuo *VirtualModelUpdateOne) SetNillableDescription(s *string) *VirtualModelUpdateOne {
+	if s != nil {
+		vmuo.SetDescription(*s)
+	}
+	return vmuo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (vmuo *VirtualModelUpdateOne) ClearDescription() *VirtualModelUpdateOne {
+	vmuo.mutation.ClearDescription()
+	return vmuo
+}
+
+// SetStatus sets the "status" field.
+func (vmuo *VirtualModelUpdateOne) SetStatus(v virtualmodel.Status) *VirtualModelUpdateOne {
+	vmuo.mutation.SetStatus(v)
+	return vmuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (vmuo *VirtualModelUpdateOne) SetNillableStatus(v *virtualmodel.Status) *VirtualModelUpdateOne {
+	if v != nil {
+		vmuo.SetStatus(*v)
+	}
+	return vmuo
+}
+
+// SetTargets sets the "targets" field.
+func (vmuo *VirtualModelUpdateOne) SetTargets(omt []objects.VirtualModelTarget) *VirtualModelUpdateOne {
+	vmuo.mutation.SetTargets(omt)
+	return vmuo
+}
+
+// AppendTargets appends omt to the "targets" field.
+func (vmuo *VirtualModelUpdateOne) AppendTargets(omt []objects.VirtualModelTarget) *VirtualModelUpdateOne {
+	vmuo.mutation.AppendTargets(omt)
+	return vmuo
+}
+
+// Mutation returns the VirtualModelMutation object of the builder.
+func (vmuo *VirtualModelUpdateOne) Mutation() *VirtualModelMutation {
+	return vmuo.mutation
+}
+
+// Where appends a list predicates to the VirtualModelUpdate builder.
+func (vmuo *VirtualModelUpdateOne) Where(ps ...predicate.VirtualModel) *VirtualModelUpdateOne {
+	vmuo.mutation.Where(ps...)
+	return vmuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (vmuo *VirtualModelUpdateOne) Select(field string, fields ...string) *VirtualModelUpdateOne {
+	vmuo.fields = append([]string{field}, fields...)
+	return vmuo
+}
+
+// Save executes the query and returns the updated VirtualModel entity.
+func (vmuo *VirtualModelUpdateOne) Save(ctx context.Context) (*VirtualModel, error) {
+	if err := vmuo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, vmuo.sqlSave, vmuo.mutation, vmuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (vmuo *VirtualModelUpdateOne) SaveX(ctx context.Context) *VirtualModel {
+	node, err := vmuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (vmuo *VirtualModelUpdateOne) Exec(ctx context.Context) error {
+	_, err := vmuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vmuo *VirtualModelUpdateOne) ExecX(ctx context.Context) {
+	if err := vmuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (vmuo *VirtualModelUpdateOne) defaults() error {
+	if _, ok := vmuo.mutation.UpdatedAt(); !ok {
+		if virtualmodel.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized virtualmodel.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := virtualmodel.UpdateDefaultUpdatedAt()
+		vmuo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (vmuo *VirtualModelUpdateOne) check() error {
+	if v, ok := vmuo.mutation.Name(); ok {
+		if err := virtualmodel.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "VirtualModel.name": %w`, err)}
+		}
+	}
+	if v, ok := vmuo.mutation.Status(); ok {
+		if err := virtualmodel.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "VirtualModel.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (vmuo *VirtualModelUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *VirtualModelUpdateOne {
+	vmuo.modifiers = append(vmuo.modifiers, modifiers...)
+	return vmuo
+}
+
+func (vmuo *VirtualModelUpdateOne) sqlSave(ctx context.Context) (_node *VirtualModel, err error) {
+	if err := vmuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(virtualmodel.Table, virtualmodel.Columns, sqlgraph.NewFieldSpec(virtualmodel.FieldID, field.TypeInt))
+	id, ok := vmuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "VirtualModel.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := vmuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, virtualmodel.FieldID)
+		for _, f := range fields {
+			if !virtualmodel.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != virtualmodel.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := vmuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := vmuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(virtualmodel.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := vmuo.mutation.DeletedAt(); ok {
+		_spec.SetField(virtualmodel.FieldDeletedAt, field.TypeInt, value)
+	}
+	if value, ok := vmuo.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(virtualmodel.FieldDeletedAt, field.TypeInt, value)
+	}
+	if value, ok := vmuo.mutation.Name(); ok {
+		_spec.SetField(virtualmodel.FieldName, field.TypeString, value)
+	}
+	if value, ok := vmuo.mutation.Description(); ok {
+		_spec.SetField(virtualmodel.FieldDescription, field.TypeString, value)
+	}
+	if vmuo.mutation.DescriptionCleared() {
+		_spec.ClearField(virtualmodel.FieldDescription, field.TypeString)
+	}
+	if value, ok := vmuo.mutation.Status(); ok {
+		_spec.SetField(virtualmodel.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := vmuo.mutation.Targets(); ok {
+		_spec.SetField(virtualmodel.FieldTargets, field.TypeJSON, value)
+	}
+	if value, ok := vmuo.mutation.AppendedTargets(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, virtualmodel.FieldTargets, value)
+		})
+	}
+	_spec.AddModifiers(vmuo.modifiers...)
+	_node = &VirtualModel{config: vmuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, vmuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{virtualmodel.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	vmuo.mutation.done = true
+	return _node, nil
+}
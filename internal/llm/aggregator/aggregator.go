@@ -0,0 +1,240 @@
+// Package aggregator converts between the stream chunks and the complete response.
+package aggregator
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/samber/lo"
+
+	"github.com/looplj/axonhub/internal/llm"
+)
+
+// Split splits the complete response into the stream chunks, e.g. to replay the cached response to the streaming clients.
+// The reasoning content, the content and the tool calls of each choice are sent in separate chunks,
+// followed by the finish reason chunk, the usage chunk and the done event.
+func Split(response *llm.Response) []*llm.Response {
+	var chunks []*llm.Response
+
+	newChunk := func(choices ...llm.Choice) *llm.Response {
+		return &llm.Response{
+			ID:                response.ID,
+			Object:            "chat.completion.chunk",
+			Created:           response.Created,
+			Model:             response.Model,
+			SystemFingerprint: response.SystemFingerprint,
+			ServiceTier:       response.ServiceTier,
+			Choices:           choices,
+		}
+	}
+
+	for _, choice := range response.Choices {
+		message := choice.Message
+		if message == nil {
+			continue
+		}
+
+		role := lo.CoalesceOrEmpty(message.Role, "assistant")
+
+		if message.ReasoningContent != nil && *message.ReasoningContent != "" {
+			chunks = append(chunks, newChunk(llm.Choice{
+				Index: choice.Index,
+				Delta: &llm.Message{Role: role, ReasoningContent: message.ReasoningContent},
+			}))
+		}
+
+		if hasContent(message.Content) || message.Audio != nil || message.Refusal != "" {
+			chunks = append(chunks, newChunk(llm.Choice{
+				Index: choice.Index,
+				Delta: &llm.Message{
+					Role:    role,
+					Content: message.Content,
+					Audio:   message.Audio,
+					Refusal: message.Refusal,
+				},
+				Logprobs: choice.Logprobs,
+			}))
+		}
+
+		// The tool call is started with the ID and the name, the arguments are sent in the following chunk as the providers do.
+		for i, toolCall := range message.ToolCalls {
+			chunks = append(chunks, newChunk(llm.Choice{
+				Index: choice.Index,
+				Delta: &llm.Message{Role: role, ToolCalls: []llm.ToolCall{{
+					Index:    i,
+					ID:       toolCall.ID,
+					Type:     toolCall.Type,
+					Function: llm.FunctionCall{Name: toolCall.Function.Name},
+				}}},
+			}))
+
+			if toolCall.Function.Arguments != "" {
+				chunks = append(chunks, newChunk(llm.Choice{
+					Index: choice.Index,
+					Delta: &llm.Message{Role: role, ToolCalls: []llm.ToolCall{{
+						Index:    i,
+						Function: llm.FunctionCall{Arguments: toolCall.Function.Arguments},
+					}}},
+				}))
+			}
+		}
+
+		chunks = append(chunks, newChunk(llm.Choice{
+			Index:        choice.Index,
+			Delta:        &llm.Message{},
+			FinishReason: choice.FinishReason,
+		}))
+	}
+
+	if response.Usage != nil {
+		usageChunk := newChunk()
+		usageChunk.Choices = []llm.Choice{}
+		usageChunk.Usage = response.Usage
+		chunks = append(chunks, usageChunk)
+	}
+
+	return append(chunks, llm.DoneResponse)
+}
+
+// Aggregate aggregates the stream chunks into the complete response, nil if there is no chunk.
+// The done event should not be included in the chunks.
+func Aggregate(chunks []*llm.Response) *llm.Response {
+	if len(chunks) == 0 {
+		return nil
+	}
+
+	type choiceAggregator struct {
+		role             string
+		content          strings.Builder
+		reasoningContent strings.Builder
+		refusal          strings.Builder
+		audio            *llm.MessageAudio
+		toolCalls        []llm.ToolCall
+		logprobs         *llm.LogprobsContent
+		finishReason     *string
+	}
+
+	var (
+		response = &llm.Response{Object: "chat.completion"}
+		choices  = map[int]*choiceAggregator{}
+	)
+
+	for _, chunk := range chunks {
+		response.ID = lo.CoalesceOrEmpty(response.ID, chunk.ID)
+		response.Created = lo.CoalesceOrEmpty(response.Created, chunk.Created)
+		response.Model = lo.CoalesceOrEmpty(response.Model, chunk.Model)
+		response.SystemFingerprint = lo.CoalesceOrEmpty(response.SystemFingerprint, chunk.SystemFingerprint)
+		response.ServiceTier = lo.CoalesceOrEmpty(response.ServiceTier, chunk.ServiceTier)
+
+		if chunk.Usage != nil {
+			response.Usage = chunk.Usage
+		}
+
+		for _, choice := range chunk.Choices {
+			agg, ok := choices[choice.Index]
+			if !ok {
+				agg = &choiceAggregator{role: "assistant"}
+				choices[choice.Index] = agg
+			}
+
+			if choice.FinishReason != nil {
+				agg.finishReason = choice.FinishReason
+			}
+
+			if choice.Logprobs != nil {
+				if agg.logprobs == nil {
+					agg.logprobs = &llm.LogprobsContent{}
+				}
+
+				agg.logprobs.Content = append(agg.logprobs.Content, choice.Logprobs.Content...)
+			}
+
+			delta := choice.Delta
+			if delta == nil {
+				continue
+			}
+
+			agg.role = lo.CoalesceOrEmpty(delta.Role, agg.role)
+			agg.content.WriteString(text(delta.Content))
+			agg.refusal.WriteString(delta.Refusal)
+
+			if delta.ReasoningContent != nil {
+				agg.reasoningContent.WriteString(*delta.ReasoningContent)
+			}
+
+			if delta.Audio != nil {
+				if agg.audio == nil {
+					agg.audio = &llm.MessageAudio{}
+				}
+
+				agg.audio.ID = lo.CoalesceOrEmpty(delta.Audio.ID, agg.audio.ID)
+				agg.audio.ExpiresAt = lo.CoalesceOrEmpty(delta.Audio.ExpiresAt, agg.audio.ExpiresAt)
+				agg.audio.Data += delta.Audio.Data
+				agg.audio.Transcript += delta.Audio.Transcript
+			}
+
+			for _, toolCall := range delta.ToolCalls {
+				for len(agg.toolCalls) <= toolCall.Index {
+					agg.toolCalls = append(agg.toolCalls, llm.ToolCall{})
+				}
+
+				target := &agg.toolCalls[toolCall.Index]
+				target.ID = lo.CoalesceOrEmpty(toolCall.ID, target.ID)
+				target.Type = lo.CoalesceOrEmpty(toolCall.Type, target.Type)
+				target.Function.Name = lo.CoalesceOrEmpty(toolCall.Function.Name, target.Function.Name)
+				target.Function.Arguments += toolCall.Function.Arguments
+			}
+		}
+	}
+
+	indexes := lo.Keys(choices)
+	slices.Sort(indexes)
+
+	for _, index := range indexes {
+		agg := choices[index]
+
+		message := &llm.Message{
+			Role:      agg.role,
+			Refusal:   agg.refusal.String(),
+			Audio:     agg.audio,
+			ToolCalls: agg.toolCalls,
+		}
+
+		if agg.content.Len() > 0 || len(agg.toolCalls) == 0 {
+			message.Content = llm.MessageContent{Content: lo.ToPtr(agg.content.String())}
+		}
+
+		if agg.reasoningContent.Len() > 0 {
+			message.ReasoningContent = lo.ToPtr(agg.reasoningContent.String())
+		}
+
+		response.Choices = append(response.Choices, llm.Choice{
+			Index:        index,
+			Message:      message,
+			FinishReason: agg.finishReason,
+			Logprobs:     agg.logprobs,
+		})
+	}
+
+	return response
+}
+
+func hasContent(content llm.MessageContent) bool {
+	return (content.Content != nil && *content.Content != "") || len(content.MultipleContent) > 0
+}
+
+func text(content llm.MessageContent) string {
+	if content.Content != nil {
+		return *content.Content
+	}
+
+	var sb strings.Builder
+
+	for _, part := range content.MultipleContent {
+		if part.Type == "text" && part.Text != nil {
+			sb.WriteString(*part.Text)
+		}
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,49 @@
+package aggregator
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/looplj/axonhub/internal/llm"
+)
+
+func TestSplitAndAggregate(t *testing.T) {
+	response := &llm.Response{
+		ID:      "chatcmpl-1",
+		Object:  "chat.completion",
+		Created: 1700000000,
+		Model:   "gpt-4o",
+		Choices: []llm.Choice{
+			{
+				Index: 0,
+				Message: &llm.Message{
+					Role:             "assistant",
+					Content:          llm.MessageContent{Content: lo.ToPtr("Checking.")},
+					ReasoningContent: lo.ToPtr("Need the weather."),
+					ToolCalls: []llm.ToolCall{
+						{ID: "call_1", Type: "function", Function: llm.FunctionCall{Name: "get_weather", Arguments: `{"city":"Paris"}`}},
+					},
+				},
+				FinishReason: lo.ToPtr("tool_calls"),
+			},
+			{
+				Index:        1,
+				Message:      &llm.Message{Role: "assistant", Content: llm.MessageContent{Content: lo.ToPtr("Sunny.")}},
+				FinishReason: lo.ToPtr("stop"),
+			},
+		},
+		Usage: &llm.Usage{PromptTokens: 5, CompletionTokens: 7, TotalTokens: 12},
+	}
+
+	chunks := Split(response)
+	require.Equal(t, llm.DoneResponse, chunks[len(chunks)-1])
+
+	for _, chunk := range chunks[:len(chunks)-1] {
+		require.Equal(t, "chat.completion.chunk", chunk.Object)
+	}
+
+	require.Equal(t, response, Aggregate(chunks[:len(chunks)-1]))
+	require.Nil(t, Aggregate(nil))
+}
@@ -0,0 +1,17 @@
+package llm
+
+import "context"
+
+type requestContextKey struct{}
+
+// NewRequestContext returns a context carrying the request sent with the outbound transformer,
+// so the outbound transformer can convert the response according to the request.
+func NewRequestContext(ctx context.Context, req *Request) context.Context {
+	return context.WithValue(ctx, requestContextKey{}, req)
+}
+
+// RequestFromContext returns the request carried by the context, or nil if there is none.
+func RequestFromContext(ctx context.Context) *Request {
+	req, _ := ctx.Value(requestContextKey{}).(*Request)
+	return req
+}
@@ -0,0 +1,251 @@
+package guardrail
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/looplj/axonhub/internal/llm"
+	"github.com/looplj/axonhub/internal/llm/decorator"
+	"github.com/looplj/axonhub/internal/pkg/streams"
+)
+
+// Violation is the violation of a rule in the request or the response.
+type Violation struct {
+	Rule   string
+	Type   RuleType
+	Action Action
+	Target Target
+	// Count is the number of the matches.
+	Count int
+}
+
+// Config is the config of the guardrail.
+type Config struct {
+	Rules []Rule
+	// OnViolation is called for each violated rule, the violations of the request are reported once even if the request is retried.
+	OnViolation func(ctx context.Context, violation Violation)
+}
+
+// New creates a decorator that masks or rejects the content of the prompts and the responses violating the rules.
+// The decorator holds state across the retries of the request, so a new one should be created for each request.
+func New(config Config) (decorator.Decorator, error) {
+	detectors := make([]*detector, 0, len(config.Rules))
+
+	for _, rule := range config.Rules {
+		d, err := newDetector(rule)
+		if err != nil {
+			return nil, err
+		}
+
+		detectors = append(detectors, d)
+	}
+
+	return &guardrail{
+		detectors:   detectors,
+		onViolation: config.OnViolation,
+	}, nil
+}
+
+type guardrail struct {
+	detectors   []*detector
+	onViolation func(ctx context.Context, violation Violation)
+
+	requestChecked bool
+	requestErr     error
+}
+
+var (
+	_ decorator.Decorator       = (*guardrail)(nil)
+	_ decorator.StreamDecorator = (*guardrail)(nil)
+)
+
+func (g *guardrail) Name() string {
+	return "guardrail"
+}
+
+func (g *guardrail) DecorateRequest(ctx context.Context, request *llm.Request) (*llm.Request, error) {
+	if g.requestErr != nil {
+		return nil, g.requestErr
+	}
+
+	c := g.newChecker(TargetRequest)
+
+	for i := range request.Messages {
+		if err := c.checkContent(&request.Messages[i].Content); err != nil {
+			g.requestErr = err
+			break
+		}
+	}
+
+	if !g.requestChecked {
+		g.requestChecked = true
+		g.report(ctx, c)
+	}
+
+	if g.requestErr != nil {
+		return nil, g.requestErr
+	}
+
+	return request, nil
+}
+
+func (g *guardrail) DecorateResponse(ctx context.Context, response *llm.Response) (*llm.Response, error) {
+	if response == nil {
+		return response, nil
+	}
+
+	c := g.newChecker(TargetResponse)
+	defer g.report(ctx, c)
+
+	for i := range response.Choices {
+		choice := &response.Choices[i]
+
+		for _, message := range []*llm.Message{choice.Message, choice.Delta} {
+			if message == nil {
+				continue
+			}
+
+			if err := c.checkContent(&message.Content); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return response, nil
+}
+
+func (g *guardrail) DecorateStream(ctx context.Context, stream streams.Stream[*llm.Response]) streams.Stream[*llm.Response] {
+	return newGuardedStream(ctx, g, stream)
+}
+
+func (g *guardrail) report(ctx context.Context, c *checker) {
+	if g.onViolation == nil {
+		return
+	}
+
+	for _, violation := range c.violations() {
+		g.onViolation(ctx, violation)
+	}
+}
+
+func (g *guardrail) newChecker(target Target) *checker {
+	c := &checker{target: target, counts: map[*detector]int{}}
+
+	for _, d := range g.detectors {
+		if d.appliesTo(target) {
+			c.detectors = append(c.detectors, d)
+		}
+	}
+
+	return c
+}
+
+// checker applies the detectors of the target to the texts and counts the matches.
+type checker struct {
+	target    Target
+	detectors []*detector
+	counts    map[*detector]int
+	order     []*detector
+}
+
+// check returns the masked text, or the error if a reject rule is violated.
+func (c *checker) check(text string) (string, error) {
+	for _, d := range c.detectors {
+		if d.rule.Action == ActionReject {
+			matches := d.find(text)
+			if len(matches) == 0 {
+				continue
+			}
+
+			c.count(d, len(matches))
+
+			return "", newViolationError(c.target, d.rule)
+		}
+
+		var n int
+
+		text, n = d.replace(text)
+		if n > 0 {
+			c.count(d, n)
+		}
+	}
+
+	return text, nil
+}
+
+func (c *checker) checkContent(content *llm.MessageContent) error {
+	if content.Content != nil && *content.Content != "" {
+		text, err := c.check(*content.Content)
+		if err != nil {
+			return err
+		}
+
+		content.Content = &text
+	}
+
+	for i := range content.MultipleContent {
+		part := &content.MultipleContent[i]
+		if part.Type != "text" || part.Text == nil || *part.Text == "" {
+			continue
+		}
+
+		text, err := c.check(*part.Text)
+		if err != nil {
+			return err
+		}
+
+		part.Text = &text
+	}
+
+	return nil
+}
+
+func (c *checker) count(d *detector, n int) {
+	if _, ok := c.counts[d]; !ok {
+		c.order = append(c.order, d)
+	}
+
+	c.counts[d] += n
+}
+
+func (c *checker) violations() []Violation {
+	violations := make([]Violation, 0, len(c.order))
+
+	for _, d := range c.order {
+		violations = append(violations, Violation{
+			Rule:   d.rule.Name,
+			Type:   d.rule.Type,
+			Action: d.rule.Action,
+			Target: c.target,
+			Count:  c.counts[d],
+		})
+	}
+
+	c.counts = map[*detector]int{}
+	c.order = nil
+
+	return violations
+}
+
+func newViolationError(target Target, rule Rule) error {
+	if target == TargetRequest {
+		return &llm.ResponseError{
+			StatusCode: http.StatusBadRequest,
+			Detail: llm.ErrorDetail{
+				Type:    "invalid_request_error",
+				Code:    "guardrail_violation",
+				Message: fmt.Sprintf("the request violates the guardrail rule %q", rule.Name),
+			},
+		}
+	}
+
+	return &llm.ResponseError{
+		StatusCode: http.StatusBadGateway,
+		Detail: llm.ErrorDetail{
+			Type:    "invalid_response_error",
+			Code:    "guardrail_violation",
+			Message: fmt.Sprintf("the response violates the guardrail rule %q", rule.Name),
+		},
+	}
+}
@@ -0,0 +1,255 @@
+package guardrail
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/looplj/axonhub/internal/llm"
+	"github.com/looplj/axonhub/internal/pkg/streams"
+)
+
+func TestDetectors(t *testing.T) {
+	tests := []struct {
+		rule Rule
+		text string
+		want string
+	}{
+		{
+			rule: Rule{Type: RuleTypeEmail},
+			text: "Contact john.doe+test@example.co.uk please",
+			want: "Contact [EMAIL] please",
+		},
+		{
+			rule: Rule{Type: RuleTypePhone},
+			text: "Call +1 (415) 555-2671 or 555-2671",
+			want: "Call [PHONE] or 555-2671",
+		},
+		{
+			rule: Rule{Type: RuleTypeCreditCard},
+			text: "Card 4111 1111 1111 1111, order 1234567890123456",
+			want: "Card [CREDIT_CARD], order 1234567890123456",
+		},
+		{
+			rule: Rule{Type: RuleTypeIDNumber},
+			text: "SSN 123-45-6789, invalid 000-12-3456, ID 11010519491231002X",
+			want: "SSN [ID_NUMBER], invalid 000-12-3456, ID [ID_NUMBER]",
+		},
+		{
+			rule: Rule{Type: RuleTypeIDNumber},
+			text: "ID 110105194912310021",
+			want: "ID 110105194912310021",
+		},
+		{
+			rule: Rule{Type: RuleTypeKeyword, Patterns: []string{"Project X", "a.b"}},
+			text: "About project x and a.b, not axb",
+			want: "About [REDACTED] and [REDACTED], not axb",
+		},
+		{
+			rule: Rule{Type: RuleTypeRegex, Patterns: []string{`sk-[A-Za-z0-9]{8,}`}},
+			text: "key sk-abcdefgh123",
+			want: "key [REDACTED]",
+		},
+	}
+
+	for _, tt := range tests {
+		d, err := newDetector(tt.rule)
+		require.NoError(t, err)
+
+		got, _ := d.replace(tt.text)
+		require.Equal(t, tt.want, got, "rule %s", tt.rule.Type)
+	}
+}
+
+func TestNew_InvalidRules(t *testing.T) {
+	_, err := New(Config{Rules: []Rule{{Type: "unknown"}}})
+	require.Error(t, err)
+
+	_, err = New(Config{Rules: []Rule{{Type: RuleTypeRegex, Patterns: []string{"("}}}})
+	require.Error(t, err)
+
+	_, err = New(Config{Rules: []Rule{{Type: RuleTypeKeyword}}})
+	require.Error(t, err)
+
+	_, err = New(Config{Rules: []Rule{{Type: RuleTypeEmail, Action: "drop"}}})
+	require.Error(t, err)
+}
+
+func TestGuardrail_Request(t *testing.T) {
+	var violations []Violation
+
+	g, err := New(Config{
+		Rules: []Rule{
+			{Type: RuleTypeEmail, Target: TargetRequest},
+			{Name: "secret", Type: RuleTypeKeyword, Patterns: []string{"secret"}, Action: ActionReject},
+			{Type: RuleTypePhone, Target: TargetResponse},
+		},
+		OnViolation: func(ctx context.Context, violation Violation) {
+			violations = append(violations, violation)
+		},
+	})
+	require.NoError(t, err)
+
+	request := &llm.Request{
+		Messages: []llm.Message{
+			{Role: "system", Content: llm.MessageContent{Content: lo.ToPtr("Reply to a@example.com")}},
+			{Role: "user", Content: llm.MessageContent{MultipleContent: []llm.MessageContentPart{
+				{Type: "text", Text: lo.ToPtr("Mail b@example.com or call 415-555-2671")},
+				{Type: "image_url", ImageURL: &llm.ImageURL{URL: "https://example.com/a@example.com.png"}},
+			}}},
+		},
+	}
+
+	result, err := g.DecorateRequest(context.Background(), request)
+	require.NoError(t, err)
+	require.Equal(t, "Reply to [EMAIL]", *result.Messages[0].Content.Content)
+	require.Equal(t, "Mail [EMAIL] or call 415-555-2671", *result.Messages[1].Content.MultipleContent[0].Text)
+	require.Equal(t, "https://example.com/a@example.com.png", result.Messages[1].Content.MultipleContent[1].ImageURL.URL)
+	require.Equal(t, []Violation{{Rule: "email", Type: RuleTypeEmail, Action: ActionMask, Target: TargetRequest, Count: 2}}, violations)
+
+	// The retried request is not reported again.
+	_, err = g.DecorateRequest(context.Background(), request)
+	require.NoError(t, err)
+	require.Len(t, violations, 1)
+
+	g, err = New(Config{
+		Rules: []Rule{{Name: "secret", Type: RuleTypeKeyword, Patterns: []string{"secret"}, Action: ActionReject}},
+		OnViolation: func(ctx context.Context, violation Violation) {
+			violations = append(violations, violation)
+		},
+	})
+	require.NoError(t, err)
+
+	rejected := &llm.Request{Messages: []llm.Message{{Role: "user", Content: llm.MessageContent{Content: lo.ToPtr("Tell me the SECRET")}}}}
+
+	for range 2 {
+		_, err = g.DecorateRequest(context.Background(), rejected)
+
+		var respErr *llm.ResponseError
+		require.ErrorAs(t, err, &respErr)
+		require.Equal(t, 400, respErr.StatusCode)
+		require.Equal(t, "guardrail_violation", respErr.Detail.Code)
+	}
+
+	require.Len(t, violations, 2)
+	require.Equal(t, Violation{Rule: "secret", Type: RuleTypeKeyword, Action: ActionReject, Target: TargetRequest, Count: 1}, violations[1])
+}
+
+func TestGuardrail_Response(t *testing.T) {
+	var violations []Violation
+
+	g, err := New(Config{
+		Rules: []Rule{{Type: RuleTypeCreditCard}},
+		OnViolation: func(ctx context.Context, violation Violation) {
+			violations = append(violations, violation)
+		},
+	})
+	require.NoError(t, err)
+
+	response, err := g.DecorateResponse(context.Background(), &llm.Response{
+		Choices: []llm.Choice{{
+			Message: &llm.Message{Role: "assistant", Content: llm.MessageContent{Content: lo.ToPtr("Use 4111-1111-1111-1111")}},
+		}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Use [CREDIT_CARD]", *response.Choices[0].Message.Content.Content)
+	require.Equal(t, []Violation{{Rule: "credit_card", Type: RuleTypeCreditCard, Action: ActionMask, Target: TargetResponse, Count: 1}}, violations)
+}
+
+func streamChunks(texts ...string) []*llm.Response {
+	chunks := make([]*llm.Response, 0, len(texts)+2)
+	for _, text := range texts {
+		chunks = append(chunks, &llm.Response{
+			ID:      "chatcmpl-1",
+			Object:  "chat.completion.chunk",
+			Choices: []llm.Choice{{Delta: &llm.Message{Role: "assistant", Content: llm.MessageContent{Content: lo.ToPtr(text)}}}},
+		})
+	}
+
+	return chunks
+}
+
+func collect(t *testing.T, stream streams.Stream[*llm.Response]) (string, []*llm.Response, error) {
+	t.Helper()
+
+	var (
+		sb     strings.Builder
+		chunks []*llm.Response
+	)
+
+	for stream.Next() {
+		chunk := stream.Current()
+		chunks = append(chunks, chunk)
+
+		if chunk == llm.DoneResponse {
+			continue
+		}
+
+		for _, choice := range chunk.Choices {
+			if choice.Delta != nil && choice.Delta.Content.Content != nil {
+				sb.WriteString(*choice.Delta.Content.Content)
+			}
+		}
+	}
+
+	return sb.String(), chunks, stream.Err()
+}
+
+func TestGuardrail_Stream(t *testing.T) {
+	var violations []Violation
+
+	g, err := New(Config{
+		Rules: []Rule{{Type: RuleTypeEmail}},
+		OnViolation: func(ctx context.Context, violation Violation) {
+			violations = append(violations, violation)
+		},
+	})
+	require.NoError(t, err)
+
+	chunks := streamChunks("Send it to john", ".doe@exam", "ple.com, ", strings.Repeat("then wait. ", 10))
+	chunks = append(chunks,
+		&llm.Response{ID: "chatcmpl-1", Choices: []llm.Choice{{Delta: &llm.Message{}, FinishReason: lo.ToPtr("stop")}}},
+		llm.DoneResponse,
+	)
+
+	stream := g.(*guardrail).DecorateStream(context.Background(), streams.SliceStream(chunks))
+
+	text, result, err := collect(t, stream)
+	require.NoError(t, err)
+	require.Equal(t, "Send it to [EMAIL], "+strings.Repeat("then wait. ", 10), text)
+	require.Equal(t, []Violation{{Rule: "email", Type: RuleTypeEmail, Action: ActionMask, Target: TargetResponse, Count: 1}}, violations)
+
+	// The held back content is sent before the finish reason.
+	finish := result[len(result)-2]
+	require.Equal(t, "stop", *finish.Choices[0].FinishReason)
+	require.Nil(t, finish.Choices[0].Delta.Content.Content)
+	require.Equal(t, llm.DoneResponse, result[len(result)-1])
+}
+
+func TestGuardrail_StreamWithoutFinish(t *testing.T) {
+	g, err := New(Config{Rules: []Rule{{Type: RuleTypePhone}}})
+	require.NoError(t, err)
+
+	stream := g.(*guardrail).DecorateStream(context.Background(), streams.SliceStream(streamChunks("Call 415 555", " 2671")))
+
+	text, _, err := collect(t, stream)
+	require.NoError(t, err)
+	require.Equal(t, "Call [PHONE]", text)
+}
+
+func TestGuardrail_StreamReject(t *testing.T) {
+	g, err := New(Config{Rules: []Rule{{Type: RuleTypeKeyword, Patterns: []string{"forbidden"}, Action: ActionReject}}})
+	require.NoError(t, err)
+
+	stream := g.(*guardrail).DecorateStream(context.Background(), streams.SliceStream(streamChunks("This is forb", "idden", strings.Repeat(".", 100))))
+
+	text, _, err := collect(t, stream)
+	require.NotContains(t, text, "forb")
+
+	var respErr *llm.ResponseError
+	require.ErrorAs(t, err, &respErr)
+	require.Equal(t, "guardrail_violation", respErr.Detail.Code)
+}
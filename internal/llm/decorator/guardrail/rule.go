@@ -0,0 +1,250 @@
+package guardrail
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// RuleType is the type of the content detected by the rule.
+type RuleType string
+
+const (
+	// RuleTypeKeyword matches the keywords case-insensitively.
+	RuleTypeKeyword RuleType = "keyword"
+	// RuleTypeRegex matches the regular expressions.
+	RuleTypeRegex RuleType = "regex"
+	// RuleTypeEmail detects the email addresses.
+	RuleTypeEmail RuleType = "email"
+	// RuleTypePhone detects the phone numbers with 10 to 15 digits.
+	RuleTypePhone RuleType = "phone"
+	// RuleTypeCreditCard detects the credit card numbers passing the Luhn check.
+	RuleTypeCreditCard RuleType = "credit_card"
+	// RuleTypeIDNumber detects the US social security numbers and the Chinese resident identity card numbers.
+	RuleTypeIDNumber RuleType = "id_number"
+)
+
+// Action is the action taken when the rule is violated.
+type Action string
+
+const (
+	// ActionMask replaces the matched content with the mask token of the rule type.
+	ActionMask Action = "mask"
+	// ActionReject rejects the request or the response.
+	ActionReject Action = "reject"
+)
+
+// Target is the content the rule applies to.
+type Target string
+
+const (
+	TargetRequest  Target = "request"
+	TargetResponse Target = "response"
+	// TargetBoth applies the rule to the request and the response, it is the default target.
+	TargetBoth Target = "both"
+)
+
+// Rule is a guardrail rule.
+type Rule struct {
+	// Name is the name of the rule recorded in the violations, defaults to the type.
+	Name string
+	Type RuleType
+	// Patterns are the keywords or the regular expressions, only used by the keyword and the regex rules.
+	Patterns []string
+	Action   Action
+	Target   Target
+}
+
+var (
+	emailPattern      = regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9-]+(?:\.[A-Za-z0-9-]+)*\.[A-Za-z]{2,}`)
+	phonePattern      = regexp.MustCompile(`\+?\(?\d[\d\s().-]{8,}\d`)
+	creditCardPattern = regexp.MustCompile(`\b\d(?:[ -]?\d){12,18}\b`)
+	idNumberPattern   = regexp.MustCompile(`\b(?:\d{3}-\d{2}-\d{4}|\d{17}[\dXx])\b`)
+)
+
+// detector finds the content violating the rule.
+type detector struct {
+	rule     Rule
+	pattern  *regexp.Regexp
+	validate func(match string) bool
+	mask     string
+}
+
+func newDetector(rule Rule) (*detector, error) {
+	if rule.Name == "" {
+		rule.Name = string(rule.Type)
+	}
+
+	switch rule.Action {
+	case ActionMask, ActionReject:
+	case "":
+		rule.Action = ActionMask
+	default:
+		return nil, fmt.Errorf("unknown action %q of rule %q", rule.Action, rule.Name)
+	}
+
+	switch rule.Target {
+	case TargetRequest, TargetResponse, TargetBoth:
+	case "":
+		rule.Target = TargetBoth
+	default:
+		return nil, fmt.Errorf("unknown target %q of rule %q", rule.Target, rule.Name)
+	}
+
+	d := &detector{rule: rule}
+
+	switch rule.Type {
+	case RuleTypeKeyword:
+		keywords := make([]string, 0, len(rule.Patterns))
+		for _, keyword := range rule.Patterns {
+			if keyword != "" {
+				keywords = append(keywords, regexp.QuoteMeta(keyword))
+			}
+		}
+
+		if len(keywords) == 0 {
+			return nil, fmt.Errorf("keyword rule %q has no keywords", rule.Name)
+		}
+
+		d.pattern = regexp.MustCompile(`(?i)` + strings.Join(keywords, "|"))
+		d.mask = "[REDACTED]"
+	case RuleTypeRegex:
+		patterns := make([]string, 0, len(rule.Patterns))
+		for _, pattern := range rule.Patterns {
+			if _, err := regexp.Compile(pattern); err != nil {
+				return nil, fmt.Errorf("invalid pattern %q of rule %q: %w", pattern, rule.Name, err)
+			}
+
+			patterns = append(patterns, "(?:"+pattern+")")
+		}
+
+		if len(patterns) == 0 {
+			return nil, fmt.Errorf("regex rule %q has no patterns", rule.Name)
+		}
+
+		d.pattern = regexp.MustCompile(strings.Join(patterns, "|"))
+		d.mask = "[REDACTED]"
+	case RuleTypeEmail:
+		d.pattern = emailPattern
+		d.mask = "[EMAIL]"
+	case RuleTypePhone:
+		d.pattern = phonePattern
+		d.validate = isPhoneNumber
+		d.mask = "[PHONE]"
+	case RuleTypeCreditCard:
+		d.pattern = creditCardPattern
+		d.validate = isCreditCardNumber
+		d.mask = "[CREDIT_CARD]"
+	case RuleTypeIDNumber:
+		d.pattern = idNumberPattern
+		d.validate = isIDNumber
+		d.mask = "[ID_NUMBER]"
+	default:
+		return nil, fmt.Errorf("unknown type %q of rule %q", rule.Type, rule.Name)
+	}
+
+	return d, nil
+}
+
+func (d *detector) appliesTo(target Target) bool {
+	return d.rule.Target == TargetBoth || d.rule.Target == target
+}
+
+// find returns the byte ranges of the matches in the text.
+func (d *detector) find(text string) [][]int {
+	matches := d.pattern.FindAllStringIndex(text, -1)
+	if d.validate == nil {
+		return matches
+	}
+
+	valid := matches[:0]
+	for _, match := range matches {
+		if d.validate(text[match[0]:match[1]]) {
+			valid = append(valid, match)
+		}
+	}
+
+	return valid
+}
+
+// replace replaces the matches in the text with the mask token, and returns the number of the matches.
+func (d *detector) replace(text string) (string, int) {
+	matches := d.find(text)
+	if len(matches) == 0 {
+		return text, 0
+	}
+
+	var (
+		sb   strings.Builder
+		last int
+	)
+
+	for _, match := range matches {
+		sb.WriteString(text[last:match[0]])
+		sb.WriteString(d.mask)
+		last = match[1]
+	}
+
+	sb.WriteString(text[last:])
+
+	return sb.String(), len(matches)
+}
+
+func digits(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsDigit(r) {
+			return r
+		}
+
+		return -1
+	}, s)
+}
+
+func isPhoneNumber(match string) bool {
+	n := len(digits(match))
+	return n >= 10 && n <= 15
+}
+
+// isCreditCardNumber checks the length and the Luhn checksum of the number.
+func isCreditCardNumber(match string) bool {
+	number := digits(match)
+	if len(number) < 13 || len(number) > 19 {
+		return false
+	}
+
+	sum := 0
+
+	for i := range len(number) {
+		digit := int(number[len(number)-1-i] - '0')
+		if i%2 == 1 {
+			digit *= 2
+			if digit > 9 {
+				digit -= 9
+			}
+		}
+
+		sum += digit
+	}
+
+	return sum%10 == 0
+}
+
+var chineseIDWeights = []int{7, 9, 10, 5, 8, 4, 2, 1, 6, 3, 7, 9, 10, 5, 8, 4, 2}
+
+// isIDNumber checks the US social security number ranges and the checksum of the Chinese resident identity card number.
+func isIDNumber(match string) bool {
+	if len(match) == 11 {
+		area, group, serial := match[0:3], match[4:6], match[7:11]
+		return area != "000" && area != "666" && area[0] != '9' && group != "00" && serial != "0000"
+	}
+
+	sum := 0
+	for i, weight := range chineseIDWeights {
+		sum += int(match[i]-'0') * weight
+	}
+
+	check := "10X98765432"[sum%11]
+
+	return unicode.ToUpper(rune(match[17])) == rune(check)
+}
@@ -0,0 +1,234 @@
+package guardrail
+
+import (
+	"context"
+	"slices"
+	"unicode/utf8"
+
+	"github.com/samber/lo"
+
+	"github.com/looplj/axonhub/internal/llm"
+	"github.com/looplj/axonhub/internal/pkg/streams"
+)
+
+// holdBackRunes is the number of the trailing runes held back in the stream,
+// so the content spanning multiple chunks can be detected before it is sent to the client.
+const holdBackRunes = 64
+
+// guardedStream buffers the content of each choice and checks it before sending.
+// The tail of the content is held back until it can not be a part of a match, or the choice finishes.
+type guardedStream struct {
+	streams.Stream[*llm.Response]
+
+	ctx      context.Context
+	g        *guardrail
+	checker  *checker
+	holdBack int
+	pending  map[int]string
+	last     *llm.Response
+	queue    []*llm.Response
+	current  *llm.Response
+	err      error
+}
+
+func newGuardedStream(ctx context.Context, g *guardrail, stream streams.Stream[*llm.Response]) *guardedStream {
+	holdBack := holdBackRunes
+
+	for _, d := range g.detectors {
+		if d.rule.Type != RuleTypeKeyword {
+			continue
+		}
+
+		for _, keyword := range d.rule.Patterns {
+			holdBack = max(holdBack, utf8.RuneCountInString(keyword))
+		}
+	}
+
+	return &guardedStream{
+		Stream:   stream,
+		ctx:      ctx,
+		g:        g,
+		checker:  g.newChecker(TargetResponse),
+		holdBack: holdBack,
+		pending:  map[int]string{},
+	}
+}
+
+func (s *guardedStream) Next() bool {
+	if len(s.queue) == 0 && s.err == nil {
+		if s.Stream.Next() {
+			chunk := s.Stream.Current()
+
+			switch {
+			case chunk == nil:
+				s.queue = append(s.queue, chunk)
+			case chunk == llm.DoneResponse:
+				s.err = s.flush()
+				s.queue = append(s.queue, chunk)
+			default:
+				s.last = chunk
+
+				if err := s.process(chunk); err != nil {
+					s.err = err
+				}
+			}
+		} else if s.Stream.Err() == nil {
+			// The upstream ended without the done event, the pending content is sent in the last chunk.
+			s.err = s.flush()
+		}
+
+		s.g.report(s.ctx, s.checker)
+	}
+
+	if s.err != nil || len(s.queue) == 0 {
+		return false
+	}
+
+	s.current, s.queue = s.queue[0], s.queue[1:]
+
+	return true
+}
+
+func (s *guardedStream) Current() *llm.Response {
+	return s.current
+}
+
+func (s *guardedStream) Err() error {
+	if s.err != nil {
+		return s.err
+	}
+
+	return s.Stream.Err()
+}
+
+// process checks the content of the chunk and queues it, the tail of the unfinished choices is held back.
+// The pending content of the choices finished without content is queued in a separate chunk before the chunk.
+func (s *guardedStream) process(chunk *llm.Response) error {
+	var finished []int
+
+	for i := range chunk.Choices {
+		choice := &chunk.Choices[i]
+
+		if choice.Delta != nil && len(choice.Delta.Content.MultipleContent) > 0 {
+			if err := s.checker.checkContent(&choice.Delta.Content); err != nil {
+				return err
+			}
+		}
+
+		hasText := choice.Delta != nil && choice.Delta.Content.Content != nil
+
+		text := s.pending[choice.Index]
+		if hasText {
+			text += *choice.Delta.Content.Content
+		}
+
+		if text == "" {
+			continue
+		}
+
+		var ready string
+
+		switch {
+		case choice.FinishReason != nil && !hasText:
+			finished = append(finished, choice.Index)
+			continue
+		case choice.FinishReason != nil:
+			ready = text
+
+			delete(s.pending, choice.Index)
+		default:
+			cut := s.cut(text)
+			ready, s.pending[choice.Index] = text[:cut], text[cut:]
+		}
+
+		if ready == "" && !hasText {
+			continue
+		}
+
+		checked, err := s.checker.check(ready)
+		if err != nil {
+			return err
+		}
+
+		choice.Delta.Content.Content = &checked
+	}
+
+	if len(finished) > 0 {
+		if err := s.flush(finished...); err != nil {
+			return err
+		}
+	}
+
+	s.queue = append(s.queue, chunk)
+
+	return nil
+}
+
+// cut returns the byte offset of the text which can be sent, the matches around the held back tail are not split.
+func (s *guardedStream) cut(text string) int {
+	cut := len(text)
+	for range s.holdBack {
+		if cut == 0 {
+			return 0
+		}
+
+		_, size := utf8.DecodeLastRuneInString(text[:cut])
+		cut -= size
+	}
+
+	for moved := true; moved; {
+		moved = false
+
+		for _, d := range s.checker.detectors {
+			for _, match := range d.find(text) {
+				if match[0] < cut && cut < match[1] {
+					cut = match[0]
+					moved = true
+				}
+			}
+		}
+	}
+
+	return cut
+}
+
+// flush queues the pending content of the choices, all the choices are flushed if no index is given.
+func (s *guardedStream) flush(indexes ...int) error {
+	if len(indexes) == 0 {
+		indexes = lo.Keys(s.pending)
+		slices.Sort(indexes)
+	}
+
+	flush := &llm.Response{Object: "chat.completion.chunk"}
+	if s.last != nil {
+		flush.ID = s.last.ID
+		flush.Created = s.last.Created
+		flush.Model = s.last.Model
+		flush.Object = s.last.Object
+	}
+
+	for _, index := range indexes {
+		pending, ok := s.pending[index]
+		if !ok {
+			continue
+		}
+
+		delete(s.pending, index)
+
+		checked, err := s.checker.check(pending)
+		if err != nil {
+			return err
+		}
+
+		flush.Choices = append(flush.Choices, llm.Choice{
+			Index: index,
+			Delta: &llm.Message{Role: "assistant", Content: llm.MessageContent{Content: &checked}},
+		})
+	}
+
+	if len(flush.Choices) > 0 {
+		s.queue = append(s.queue, flush)
+	}
+
+	return nil
+}
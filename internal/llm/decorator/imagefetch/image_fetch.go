@@ -0,0 +1,263 @@
+package imagefetch
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"slices"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/looplj/axonhub/internal/llm"
+	"github.com/looplj/axonhub/internal/llm/decorator"
+)
+
+const (
+	// DefaultMaxBytes is the default maximum size of the fetched image, it is the limit of the Anthropic API.
+	DefaultMaxBytes = 5 * 1024 * 1024
+	// DefaultTimeout is the default timeout of fetching one image.
+	DefaultTimeout = 10 * time.Second
+
+	maxRedirects = 3
+)
+
+// Config is the config of the remote image inlining.
+type Config struct {
+	// MaxBytes is the maximum size of the fetched image, defaults to DefaultMaxBytes.
+	MaxBytes int64
+	// Timeout is the timeout of fetching one image, defaults to DefaultTimeout.
+	Timeout time.Duration
+	// MaxDimension downscales the images whose width or height exceeds it, 0 disables the downscaling.
+	MaxDimension int
+	// AllowPrivateNetworks allows fetching the images from the private networks, it should only be used in tests.
+	AllowPrivateNetworks bool
+}
+
+// InlineRemoteImages creates a decorator that fetches the remote images of the request
+// and replaces them with the base64 data URLs, for the providers which can not fetch the images by themselves.
+func InlineRemoteImages(config Config) decorator.Decorator {
+	f := newFetcher(config)
+
+	return decorator.RequestDecorator("image-fetch", f.inline)
+}
+
+type fetcher struct {
+	config Config
+	client *http.Client
+}
+
+func newFetcher(config Config) *fetcher {
+	if config.MaxBytes <= 0 {
+		config.MaxBytes = DefaultMaxBytes
+	}
+
+	if config.Timeout <= 0 {
+		config.Timeout = DefaultTimeout
+	}
+
+	dialer := &net.Dialer{Timeout: config.Timeout}
+	if !config.AllowPrivateNetworks {
+		// The address is checked after the resolution, so the DNS rebinding can not bypass it.
+		dialer.Control = func(network, address string, _ syscall.RawConn) error {
+			return checkAddress(address)
+		}
+	}
+
+	return &fetcher{
+		config: config,
+		client: &http.Client{
+			Timeout: config.Timeout,
+			Transport: &http.Transport{
+				// The proxy is not used, otherwise the dialer checks the address of the proxy instead of the target.
+				Proxy:               nil,
+				DialContext:         dialer.DialContext,
+				TLSHandshakeTimeout: config.Timeout,
+			},
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				if len(via) >= maxRedirects {
+					return fmt.Errorf("stopped after %d redirects", maxRedirects)
+				}
+
+				return checkScheme(req.URL.Scheme)
+			},
+		},
+	}
+}
+
+// inline returns the copy of the request with the remote images replaced by the data URLs, the messages and
+// the content parts are cloned before the change, as the request is shared with the attempts of the other channels.
+func (f *fetcher) inline(ctx context.Context, request *llm.Request) (*llm.Request, error) {
+	// The same image may be referenced multiple times in the conversation.
+	fetched := map[string]string{}
+
+	var messages []llm.Message
+
+	for i, message := range request.Messages {
+		var parts []llm.MessageContentPart
+
+		for j, part := range message.Content.MultipleContent {
+			if part.Type != "image_url" || part.ImageURL == nil || !isRemoteURL(part.ImageURL.URL) {
+				continue
+			}
+
+			url := part.ImageURL.URL
+
+			dataURL, ok := fetched[url]
+			if !ok {
+				var err error
+
+				dataURL, err = f.fetch(ctx, url)
+				if err != nil {
+					return nil, &llm.ResponseError{
+						StatusCode: http.StatusBadRequest,
+						Detail: llm.ErrorDetail{
+							Type:    "invalid_request_error",
+							Code:    "image_fetch_failed",
+							Message: fmt.Sprintf("failed to fetch image %q: %v", url, err),
+						},
+					}
+				}
+
+				fetched[url] = dataURL
+			}
+
+			if parts == nil {
+				parts = slices.Clone(message.Content.MultipleContent)
+			}
+
+			parts[j].ImageURL = &llm.ImageURL{URL: dataURL, Detail: part.ImageURL.Detail}
+		}
+
+		if parts == nil {
+			continue
+		}
+
+		if messages == nil {
+			messages = slices.Clone(request.Messages)
+		}
+
+		messages[i].Content.MultipleContent = parts
+	}
+
+	if messages == nil {
+		return request, nil
+	}
+
+	cloned := *request
+	cloned.Messages = messages
+
+	return &cloned, nil
+}
+
+func (f *fetcher) fetch(ctx context.Context, url string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	if resp.ContentLength > f.config.MaxBytes {
+		return "", fmt.Errorf("image size %d exceeds the limit %d", resp.ContentLength, f.config.MaxBytes)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, f.config.MaxBytes+1))
+	if err != nil {
+		return "", err
+	}
+
+	if int64(len(data)) > f.config.MaxBytes {
+		return "", fmt.Errorf("image size exceeds the limit %d", f.config.MaxBytes)
+	}
+
+	// The content type of the response is not trusted, the media type is detected from the data.
+	mediaType := http.DetectContentType(data)
+	if !strings.HasPrefix(mediaType, "image/") {
+		return "", fmt.Errorf("unsupported media type %q", mediaType)
+	}
+
+	if f.config.MaxDimension > 0 {
+		data, mediaType, err = downscale(data, mediaType, f.config.MaxDimension)
+		if err != nil {
+			return "", fmt.Errorf("failed to downscale image: %w", err)
+		}
+	}
+
+	return fmt.Sprintf("data:%s;base64,%s", mediaType, base64.StdEncoding.EncodeToString(data)), nil
+}
+
+func isRemoteURL(url string) bool {
+	return strings.HasPrefix(url, "http://") || strings.HasPrefix(url, "https://")
+}
+
+func checkScheme(scheme string) error {
+	if scheme != "http" && scheme != "https" {
+		return fmt.Errorf("unsupported scheme %q", scheme)
+	}
+
+	return nil
+}
+
+var errPrivateAddress = errors.New("private network address is not allowed")
+
+// blockedPrefixes are the special purpose ranges not covered by the net/netip helpers.
+var blockedPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	netip.MustParsePrefix("64:ff9b::/96"),
+}
+
+func checkAddress(address string) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return err
+	}
+
+	if isPrivateAddr(addr) {
+		return fmt.Errorf("%w: %s", errPrivateAddress, addr)
+	}
+
+	return nil
+}
+
+func isPrivateAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+
+	if addr.IsLoopback() || addr.IsPrivate() || addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() || addr.IsMulticast() || addr.IsUnspecified() {
+		return true
+	}
+
+	for _, prefix := range blockedPrefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,200 @@
+package imagefetch
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"image"
+	"image/color"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/looplj/axonhub/internal/llm"
+)
+
+func pngImage(t *testing.T, width, height int) []byte {
+	t.Helper()
+
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for y := range height {
+		for x := range width {
+			img.SetNRGBA(x, y, color.NRGBA{R: 255, A: 255})
+		}
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+
+	return buf.Bytes()
+}
+
+func imageRequest(urls ...string) *llm.Request {
+	parts := []llm.MessageContentPart{{Type: "text", Text: lo.ToPtr("describe")}}
+	for _, url := range urls {
+		parts = append(parts, llm.MessageContentPart{Type: "image_url", ImageURL: &llm.ImageURL{URL: url}})
+	}
+
+	return &llm.Request{
+		Messages: []llm.Message{{Role: "user", Content: llm.MessageContent{MultipleContent: parts}}},
+	}
+}
+
+func decodeDataURL(t *testing.T, url string) (string, []byte) {
+	t.Helper()
+
+	header, data, ok := strings.Cut(url, ",")
+	require.True(t, ok)
+
+	decoded, err := base64.StdEncoding.DecodeString(data)
+	require.NoError(t, err)
+
+	return strings.TrimSuffix(strings.TrimPrefix(header, "data:"), ";base64"), decoded
+}
+
+func TestInlineRemoteImages(t *testing.T) {
+	img := pngImage(t, 4, 4)
+
+	var hits atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+
+		switch r.URL.Path {
+		case "/image":
+			// The wrong content type should be ignored.
+			w.Header().Set("Content-Type", "application/octet-stream")
+			_, _ = w.Write(img)
+		case "/text":
+			_, _ = w.Write([]byte("hello world"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	dec := InlineRemoteImages(Config{AllowPrivateNetworks: true})
+
+	t.Run("inline image", func(t *testing.T) {
+		hits.Store(0)
+
+		req := imageRequest(server.URL+"/image", server.URL+"/image", "data:image/png;base64,AAAA")
+
+		result, err := dec.DecorateRequest(context.Background(), req)
+		require.NoError(t, err)
+
+		parts := result.Messages[0].Content.MultipleContent
+		mediaType, data := decodeDataURL(t, parts[1].ImageURL.URL)
+		require.Equal(t, "image/png", mediaType)
+		require.Equal(t, img, data)
+		require.Equal(t, parts[1].ImageURL.URL, parts[2].ImageURL.URL)
+		require.Equal(t, "data:image/png;base64,AAAA", parts[3].ImageURL.URL)
+		require.Equal(t, int32(1), hits.Load())
+
+		// The original request is not changed, it is sent to the channels without the image fetch.
+		require.NotSame(t, req, result)
+		require.Equal(t, server.URL+"/image", req.Messages[0].Content.MultipleContent[1].ImageURL.URL)
+		require.Equal(t, server.URL+"/image", req.Messages[0].Content.MultipleContent[2].ImageURL.URL)
+	})
+
+	t.Run("no remote image", func(t *testing.T) {
+		req := imageRequest("data:image/png;base64,AAAA")
+
+		result, err := dec.DecorateRequest(context.Background(), req)
+		require.NoError(t, err)
+		require.Same(t, req, result)
+	})
+
+	t.Run("not an image", func(t *testing.T) {
+		_, err := dec.DecorateRequest(context.Background(), imageRequest(server.URL+"/text"))
+		require.ErrorContains(t, err, "unsupported media type")
+
+		var respErr *llm.ResponseError
+		require.ErrorAs(t, err, &respErr)
+		require.Equal(t, http.StatusBadRequest, respErr.StatusCode)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := dec.DecorateRequest(context.Background(), imageRequest(server.URL+"/missing"))
+		require.ErrorContains(t, err, "unexpected status code 404")
+	})
+
+	t.Run("size limit", func(t *testing.T) {
+		limited := InlineRemoteImages(Config{AllowPrivateNetworks: true, MaxBytes: 10})
+
+		_, err := limited.DecorateRequest(context.Background(), imageRequest(server.URL+"/image"))
+		require.ErrorContains(t, err, "exceeds the limit")
+	})
+}
+
+func TestInlineRemoteImages_BlockPrivateNetworks(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("the private network should not be reached")
+	}))
+	defer server.Close()
+
+	dec := InlineRemoteImages(Config{})
+
+	_, err := dec.DecorateRequest(context.Background(), imageRequest(server.URL+"/image"))
+	require.ErrorContains(t, err, "private network address is not allowed")
+}
+
+func TestInlineRemoteImages_Downscale(t *testing.T) {
+	img := pngImage(t, 200, 100)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(img)
+	}))
+	defer server.Close()
+
+	dec := InlineRemoteImages(Config{AllowPrivateNetworks: true, MaxDimension: 50})
+
+	result, err := dec.DecorateRequest(context.Background(), imageRequest(server.URL))
+	require.NoError(t, err)
+
+	mediaType, data := decodeDataURL(t, result.Messages[0].Content.MultipleContent[1].ImageURL.URL)
+	require.Equal(t, "image/png", mediaType)
+
+	decoded, err := png.Decode(bytes.NewReader(data))
+	require.NoError(t, err)
+	require.Equal(t, 50, decoded.Bounds().Dx())
+	require.Equal(t, 25, decoded.Bounds().Dy())
+
+	r, _, _, a := decoded.At(10, 10).RGBA()
+	require.Equal(t, uint32(0xffff), r)
+	require.Equal(t, uint32(0xffff), a)
+}
+
+func TestIsPrivateAddr(t *testing.T) {
+	tests := []struct {
+		addr    string
+		private bool
+	}{
+		{"127.0.0.1", true},
+		{"10.1.2.3", true},
+		{"172.16.0.1", true},
+		{"192.168.1.1", true},
+		{"169.254.169.254", true},
+		{"100.64.0.1", true},
+		{"0.0.0.0", true},
+		{"::1", true},
+		{"fe80::1", true},
+		{"fd00::1", true},
+		{"::ffff:127.0.0.1", true},
+		{"8.8.8.8", false},
+		{"2606:4700:4700::1111", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.addr, func(t *testing.T) {
+			require.Equal(t, tt.private, isPrivateAddr(netip.MustParseAddr(tt.addr)))
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/looplj/axonhub/internal/llm"
+	"github.com/looplj/axonhub/internal/pkg/streams"
 )
 
 // Decorator modifies chat completion requests before they are sent to the provider.
@@ -18,6 +19,14 @@ type Decorator interface {
 	DecorateResponse(ctx context.Context, response *llm.Response) (*llm.Response, error)
 }
 
+// StreamDecorator is implemented by the decorators which need to process the response stream as a whole,
+// e.g. to hold back the content spanning multiple chunks.
+// The chunks of the stream are passed to DecorateResponse one by one if the decorator does not implement it.
+type StreamDecorator interface {
+	// DecorateStream wraps the response stream and returns the decorated stream
+	DecorateStream(ctx context.Context, stream streams.Stream[*llm.Response]) streams.Stream[*llm.Response]
+}
+
 // DecoratorChain manages a chain of decorators.
 type DecoratorChain interface {
 	Add(decorator Decorator)
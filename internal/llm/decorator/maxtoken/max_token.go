@@ -9,8 +9,21 @@ import (
 
 // EnsureMaxTokens creates a decorator that ensures requests have a max tokens value
 // by setting it to the provided default when not already specified.
+// The max_completion_tokens is capped instead when the request specifies it.
 func EnsureMaxTokens(defaultValue int64) decorator.Decorator {
 	return decorator.RequestDecorator("max-tokens", func(ctx context.Context, request *llm.Request) (*llm.Request, error) {
+		if request.MaxCompletionTokens != nil {
+			if *request.MaxCompletionTokens > defaultValue {
+				request.MaxCompletionTokens = &defaultValue
+			}
+
+			if request.MaxTokens != nil && *request.MaxTokens > defaultValue {
+				request.MaxTokens = &defaultValue
+			}
+
+			return request, nil
+		}
+
 		if request.MaxTokens == nil {
 			request.MaxTokens = &defaultValue
 		}
@@ -44,3 +44,17 @@ func TestEnsureMaxTokens_ExistingValue(t *testing.T) {
 	assert.Equal(t, &existingValue, result.MaxTokens) // Should remain unchanged
 	assert.NotEqual(t, &defaultValue, result.MaxTokens)
 }
+
+func TestEnsureMaxTokens_MaxCompletionTokens(t *testing.T) {
+	decorator := EnsureMaxTokens(200)
+
+	existingValue := int64(1000)
+	req := &llm.Request{
+		MaxCompletionTokens: &existingValue,
+	}
+
+	result, err := decorator.DecorateRequest(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(200), *result.MaxCompletionTokens)
+	assert.Nil(t, result.MaxTokens)
+}
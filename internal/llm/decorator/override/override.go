@@ -0,0 +1,248 @@
+package override
+
+import (
+	"context"
+	"slices"
+
+	"github.com/looplj/axonhub/internal/llm"
+	"github.com/looplj/axonhub/internal/llm/decorator"
+	"github.com/looplj/axonhub/internal/llm/decorator/maxtoken"
+)
+
+// Params are the sampling parameters which can be defaulted or forced.
+type Params struct {
+	Temperature      *float64
+	TopP             *float64
+	PresencePenalty  *float64
+	FrequencyPenalty *float64
+	Seed             *int64
+	ReasoningEffort  string
+}
+
+// Config is the config of the request overrides.
+type Config struct {
+	// Defaults are set when the request does not specify them.
+	Defaults Params
+
+	// Forced replace the values of the request.
+	Forced Params
+
+	// MaxTemperature clamps the temperature of the request.
+	MaxTemperature *float64
+
+	// MaxTokens sets the max tokens when the request does not specify it and caps it otherwise, 0 disables it.
+	MaxTokens int64
+
+	// SystemPromptPrepend is added before the system prompt, a system message is inserted if there is none.
+	SystemPromptPrepend string
+
+	// SystemPromptAppend is added after the system prompt, a system message is inserted if there is none.
+	SystemPromptAppend string
+
+	// StripParams are the names of the request parameters to remove, e.g. "logit_bias",
+	// the unknown names are ignored.
+	StripParams []string
+}
+
+// IsZero reports whether the config overrides nothing.
+func (c Config) IsZero() bool {
+	return c.Defaults == Params{} &&
+		c.Forced == Params{} &&
+		c.MaxTemperature == nil &&
+		c.MaxTokens <= 0 &&
+		c.SystemPromptPrepend == "" &&
+		c.SystemPromptAppend == "" &&
+		len(c.StripParams) == 0
+}
+
+// strippers are the functions removing the request parameters by their JSON names.
+var strippers = map[string]func(request *llm.Request){
+	"frequency_penalty":     func(r *llm.Request) { r.FrequencyPenalty = nil },
+	"presence_penalty":      func(r *llm.Request) { r.PresencePenalty = nil },
+	"temperature":           func(r *llm.Request) { r.Temperature = nil },
+	"top_p":                 func(r *llm.Request) { r.TopP = nil },
+	"seed":                  func(r *llm.Request) { r.Seed = nil },
+	"logprobs":              func(r *llm.Request) { r.Logprobs = nil },
+	"top_logprobs":          func(r *llm.Request) { r.TopLogprobs = nil },
+	"logit_bias":            func(r *llm.Request) { r.LogitBias = nil },
+	"max_tokens":            func(r *llm.Request) { r.MaxTokens = nil },
+	"max_completion_tokens": func(r *llm.Request) { r.MaxCompletionTokens = nil },
+	"n":                     func(r *llm.Request) { r.N = nil },
+	"stop":                  func(r *llm.Request) { r.Stop = nil },
+	"store":                 func(r *llm.Request) { r.Store = nil },
+	"user":                  func(r *llm.Request) { r.User = nil },
+	"metadata":              func(r *llm.Request) { r.Metadata = nil },
+	"service_tier":          func(r *llm.Request) { r.ServiceTier = nil },
+	"reasoning_effort":      func(r *llm.Request) { r.ReasoningEffort = "" },
+	"parallel_tool_calls":   func(r *llm.Request) { r.ParallelToolCalls = nil },
+	"safety_identifier":     func(r *llm.Request) { r.SafetyIdentifier = nil },
+	"prompt_cache_key":      func(r *llm.Request) { r.PromptCacheKey = nil },
+}
+
+// IsKnownParam reports whether the parameter can be stripped.
+func IsKnownParam(name string) bool {
+	_, ok := strippers[name]
+	return ok
+}
+
+// New creates the decorator applying the overrides, in the order of stripping the parameters,
+// setting the defaults, forcing the values, clamping the limits and changing the system prompt.
+// The request is copied before it is changed, so the retried requests are not overridden twice.
+func New(config Config) decorator.Decorator {
+	var ensureMaxTokens decorator.Decorator
+	if config.MaxTokens > 0 {
+		ensureMaxTokens = maxtoken.EnsureMaxTokens(config.MaxTokens)
+	}
+
+	return decorator.RequestDecorator("request-override", func(ctx context.Context, request *llm.Request) (*llm.Request, error) {
+		overridden := *request
+
+		for _, name := range config.StripParams {
+			if strip, ok := strippers[name]; ok {
+				strip(&overridden)
+			}
+		}
+
+		applyDefaults(&overridden, config.Defaults)
+		applyForced(&overridden, config.Forced)
+
+		if config.MaxTemperature != nil && overridden.Temperature != nil && *overridden.Temperature > *config.MaxTemperature {
+			overridden.Temperature = config.MaxTemperature
+		}
+
+		if config.SystemPromptPrepend != "" || config.SystemPromptAppend != "" {
+			overridden.Messages = overrideSystemPrompt(request.Messages, config.SystemPromptPrepend, config.SystemPromptAppend)
+		}
+
+		if ensureMaxTokens != nil {
+			return ensureMaxTokens.DecorateRequest(ctx, &overridden)
+		}
+
+		return &overridden, nil
+	})
+}
+
+func applyDefaults(request *llm.Request, params Params) {
+	if request.Temperature == nil {
+		request.Temperature = params.Temperature
+	}
+
+	if request.TopP == nil {
+		request.TopP = params.TopP
+	}
+
+	if request.PresencePenalty == nil {
+		request.PresencePenalty = params.PresencePenalty
+	}
+
+	if request.FrequencyPenalty == nil {
+		request.FrequencyPenalty = params.FrequencyPenalty
+	}
+
+	if request.Seed == nil {
+		request.Seed = params.Seed
+	}
+
+	if request.ReasoningEffort == "" {
+		request.ReasoningEffort = params.ReasoningEffort
+	}
+}
+
+func applyForced(request *llm.Request, params Params) {
+	if params.Temperature != nil {
+		request.Temperature = params.Temperature
+	}
+
+	if params.TopP != nil {
+		request.TopP = params.TopP
+	}
+
+	if params.PresencePenalty != nil {
+		request.PresencePenalty = params.PresencePenalty
+	}
+
+	if params.FrequencyPenalty != nil {
+		request.FrequencyPenalty = params.FrequencyPenalty
+	}
+
+	if params.Seed != nil {
+		request.Seed = params.Seed
+	}
+
+	if params.ReasoningEffort != "" {
+		request.ReasoningEffort = params.ReasoningEffort
+	}
+}
+
+func isSystemMessage(message llm.Message) bool {
+	return message.Role == "system" || message.Role == "developer"
+}
+
+// overrideSystemPrompt returns the copy of the messages with the text prepended to the first leading
+// system message and appended to the last one, a system message is inserted if there is none.
+func overrideSystemPrompt(messages []llm.Message, prepend, appendText string) []llm.Message {
+	leading := 0
+	for leading < len(messages) && isSystemMessage(messages[leading]) {
+		leading++
+	}
+
+	if leading == 0 {
+		text := joinText(prepend, appendText)
+
+		return slices.Insert(slices.Clone(messages), 0, llm.Message{
+			Role:    "system",
+			Content: llm.MessageContent{Content: &text},
+		})
+	}
+
+	messages = slices.Clone(messages)
+
+	if prepend != "" {
+		messages[0].Content = addText(messages[0].Content, prepend, true)
+	}
+
+	if appendText != "" {
+		messages[leading-1].Content = addText(messages[leading-1].Content, appendText, false)
+	}
+
+	return messages
+}
+
+// addText returns the content with the text added before or after it.
+func addText(content llm.MessageContent, text string, before bool) llm.MessageContent {
+	if content.Content != nil || len(content.MultipleContent) == 0 {
+		var existing string
+		if content.Content != nil {
+			existing = *content.Content
+		}
+
+		merged := joinText(existing, text)
+		if before {
+			merged = joinText(text, existing)
+		}
+
+		return llm.MessageContent{Content: &merged}
+	}
+
+	part := llm.MessageContentPart{Type: "text", Text: &text}
+
+	parts := slices.Clone(content.MultipleContent)
+	if before {
+		parts = slices.Insert(parts, 0, part)
+	} else {
+		parts = append(parts, part)
+	}
+
+	return llm.MessageContent{MultipleContent: parts}
+}
+
+func joinText(first, second string) string {
+	switch {
+	case first == "":
+		return second
+	case second == "":
+		return first
+	default:
+		return first + "\n\n" + second
+	}
+}
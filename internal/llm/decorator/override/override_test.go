@@ -0,0 +1,92 @@
+package override
+
+import (
+	"context"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/looplj/axonhub/internal/llm"
+)
+
+func TestOverride_Params(t *testing.T) {
+	dec := New(Config{
+		Defaults:       Params{Temperature: lo.ToPtr(0.7), TopP: lo.ToPtr(0.9), Seed: lo.ToPtr(int64(42))},
+		Forced:         Params{TopP: lo.ToPtr(0.5), ReasoningEffort: "low"},
+		MaxTemperature: lo.ToPtr(1.0),
+		MaxTokens:      1024,
+		StripParams:    []string{"logit_bias", "unknown"},
+	})
+
+	request := &llm.Request{
+		Model:       "gpt-4o",
+		Messages:    []llm.Message{{Role: "user", Content: llm.MessageContent{Content: lo.ToPtr("hello")}}},
+		Seed:        lo.ToPtr(int64(1)),
+		Temperature: lo.ToPtr(1.8),
+		MaxTokens:   lo.ToPtr(int64(4096)),
+		LogitBias:   map[string]int64{"50256": -100},
+	}
+
+	result, err := dec.DecorateRequest(context.Background(), request)
+	require.NoError(t, err)
+	require.Equal(t, 1.0, *result.Temperature)
+	require.Equal(t, 0.5, *result.TopP)
+	require.Equal(t, int64(1), *result.Seed)
+	require.Equal(t, "low", result.ReasoningEffort)
+	require.Equal(t, int64(1024), *result.MaxTokens)
+	require.Nil(t, result.LogitBias)
+
+	// The original request is not changed.
+	require.Equal(t, 1.8, *request.Temperature)
+	require.Equal(t, int64(4096), *request.MaxTokens)
+	require.NotNil(t, request.LogitBias)
+
+	result, err = dec.DecorateRequest(context.Background(), &llm.Request{})
+	require.NoError(t, err)
+	require.Equal(t, 0.7, *result.Temperature)
+	require.Equal(t, int64(42), *result.Seed)
+	require.Equal(t, int64(1024), *result.MaxTokens)
+}
+
+func TestOverride_SystemPrompt(t *testing.T) {
+	dec := New(Config{SystemPromptPrepend: "You work for ACME.", SystemPromptAppend: "Be brief."})
+
+	request := &llm.Request{
+		Messages: []llm.Message{{Role: "user", Content: llm.MessageContent{Content: lo.ToPtr("hello")}}},
+	}
+
+	result, err := dec.DecorateRequest(context.Background(), request)
+	require.NoError(t, err)
+	require.Len(t, result.Messages, 2)
+	require.Equal(t, "system", result.Messages[0].Role)
+	require.Equal(t, "You work for ACME.\n\nBe brief.", *result.Messages[0].Content.Content)
+	require.Len(t, request.Messages, 1)
+
+	request = &llm.Request{
+		Messages: []llm.Message{
+			{Role: "system", Content: llm.MessageContent{Content: lo.ToPtr("You are helpful.")}},
+			{Role: "developer", Content: llm.MessageContent{MultipleContent: []llm.MessageContentPart{{Type: "text", Text: lo.ToPtr("Use JSON.")}}}},
+			{Role: "user", Content: llm.MessageContent{Content: lo.ToPtr("hello")}},
+		},
+	}
+
+	// The retried request gets the same result.
+	for range 2 {
+		result, err = dec.DecorateRequest(context.Background(), request)
+		require.NoError(t, err)
+		require.Len(t, result.Messages, 3)
+		require.Equal(t, "You work for ACME.\n\nYou are helpful.", *result.Messages[0].Content.Content)
+		require.Len(t, result.Messages[1].Content.MultipleContent, 2)
+		require.Equal(t, "Be brief.", *result.Messages[1].Content.MultipleContent[1].Text)
+	}
+
+	require.Equal(t, "You are helpful.", *request.Messages[0].Content.Content)
+	require.Len(t, request.Messages[1].Content.MultipleContent, 1)
+}
+
+func TestConfig_IsZero(t *testing.T) {
+	require.True(t, Config{}.IsZero())
+	require.False(t, Config{MaxTokens: 10}.IsZero())
+	require.False(t, Config{Forced: Params{ReasoningEffort: "low"}}.IsZero())
+}
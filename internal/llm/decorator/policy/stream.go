@@ -0,0 +1,107 @@
+package policy
+
+import (
+	"context"
+
+	"github.com/looplj/axonhub/internal/llm"
+	"github.com/looplj/axonhub/internal/llm/aggregator"
+	"github.com/looplj/axonhub/internal/pkg/streams"
+)
+
+// DecorateStream buffers the stream until it ends and checks the aggregated response,
+// the stream is returned as is if the responses are not checked.
+func (w *Webhook) DecorateStream(ctx context.Context, stream streams.Stream[*llm.Response]) streams.Stream[*llm.Response] {
+	if !w.config.CheckResponse {
+		return stream
+	}
+
+	return &checkedStream{
+		Stream:  stream,
+		ctx:     ctx,
+		webhook: w,
+	}
+}
+
+// checkedStream sends the buffered chunks after the aggregated response is allowed,
+// or the chunks of the rewritten response if it is modified.
+type checkedStream struct {
+	streams.Stream[*llm.Response]
+
+	ctx     context.Context
+	webhook *Webhook
+	checked bool
+	chunks  []*llm.Response
+	current *llm.Response
+	err     error
+}
+
+func (s *checkedStream) Next() bool {
+	if !s.checked {
+		s.checked = true
+		s.err = s.check()
+	}
+
+	if s.err != nil || len(s.chunks) == 0 {
+		return false
+	}
+
+	s.current, s.chunks = s.chunks[0], s.chunks[1:]
+
+	return true
+}
+
+func (s *checkedStream) Current() *llm.Response {
+	return s.current
+}
+
+func (s *checkedStream) Err() error {
+	if s.err != nil {
+		return s.err
+	}
+
+	return s.Stream.Err()
+}
+
+func (s *checkedStream) check() error {
+	var (
+		chunks []*llm.Response
+		done   bool
+	)
+
+	for s.Stream.Next() {
+		chunk := s.Stream.Current()
+
+		switch {
+		case chunk == nil:
+		case chunk == llm.DoneResponse:
+			done = true
+		default:
+			chunks = append(chunks, chunk)
+		}
+	}
+
+	if err := s.Stream.Err(); err != nil {
+		return err
+	}
+
+	if response := aggregator.Aggregate(chunks); response != nil {
+		checked, err := s.webhook.checkResponse(s.ctx, response)
+		if err != nil {
+			return err
+		}
+
+		if checked != response {
+			// The rewritten response is replayed without the done event of its own.
+			replayed := aggregator.Split(checked)
+			chunks = replayed[:len(replayed)-1]
+		}
+	}
+
+	if done {
+		chunks = append(chunks, llm.DoneResponse)
+	}
+
+	s.chunks = chunks
+
+	return nil
+}
@@ -0,0 +1,277 @@
+package policy
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/looplj/axonhub/internal/llm"
+	"github.com/looplj/axonhub/internal/llm/decorator"
+	"github.com/looplj/axonhub/internal/log"
+)
+
+// DefaultTimeout is the default timeout of one webhook call.
+const DefaultTimeout = 5 * time.Second
+
+// maxVerdictBytes is the maximum size of the webhook response, it may contain the rewritten request.
+const maxVerdictBytes = 16 * 1024 * 1024
+
+// FailMode decides what happens when the webhook can not be reached or returns an invalid verdict.
+type FailMode string
+
+const (
+	// FailOpen allows the request and the response when the webhook fails.
+	FailOpen FailMode = "open"
+	// FailClosed rejects the request and the response when the webhook fails.
+	FailClosed FailMode = "closed"
+)
+
+// Config is the config of the policy webhook.
+type Config struct {
+	// URL is the endpoint of the policy webhook, the webhook is disabled if empty.
+	URL string `conf:"url" yaml:"url" json:"url"`
+
+	// Timeout is the timeout of one webhook call, defaults to DefaultTimeout.
+	Timeout time.Duration `conf:"timeout" yaml:"timeout" json:"timeout"`
+
+	// FailMode is open or closed, defaults to closed.
+	FailMode FailMode `conf:"fail_mode" yaml:"fail_mode" json:"fail_mode"`
+
+	// CheckResponse sends the final aggregated response to the webhook before it is returned to the client.
+	// The streaming responses are buffered until the stream ends.
+	CheckResponse bool `conf:"check_response" yaml:"check_response" json:"check_response"`
+
+	// Headers are added to the webhook requests, e.g. the authorization header.
+	Headers map[string]string `conf:"headers" yaml:"headers" json:"headers"`
+}
+
+// Stage is the stage the webhook is called at.
+type Stage string
+
+const (
+	StageRequest  Stage = "request"
+	StageResponse Stage = "response"
+)
+
+// Action is the decision of the webhook.
+type Action string
+
+const (
+	ActionAllow Action = "allow"
+	ActionDeny  Action = "deny"
+	// ActionModify replaces the request or the response with the rewritten one in the verdict.
+	ActionModify Action = "modify"
+)
+
+// Payload is the body sent to the webhook.
+type Payload struct {
+	Stage    Stage         `json:"stage"`
+	Request  *llm.Request  `json:"request,omitempty"`
+	Response *llm.Response `json:"response,omitempty"`
+}
+
+// Verdict is the body returned by the webhook.
+type Verdict struct {
+	Action Action `json:"action"`
+
+	// Message is returned to the client when the action is deny.
+	Message string `json:"message,omitempty"`
+
+	// Request is the rewritten request when the action is modify at the request stage.
+	Request *llm.Request `json:"request,omitempty"`
+
+	// Response is the rewritten response when the action is modify at the response stage.
+	Response *llm.Response `json:"response,omitempty"`
+}
+
+// Webhook is a decorator that sends the requests, and optionally the responses, to the external policy webhook,
+// which allows, denies or rewrites them.
+type Webhook struct {
+	config Config
+	client *http.Client
+}
+
+var (
+	_ decorator.Decorator       = (*Webhook)(nil)
+	_ decorator.StreamDecorator = (*Webhook)(nil)
+)
+
+// New creates the policy webhook of the config, nil if the URL is not configured.
+func New(config Config) (*Webhook, error) {
+	if config.URL == "" {
+		return nil, nil
+	}
+
+	switch config.FailMode {
+	case FailOpen, FailClosed:
+	case "":
+		config.FailMode = FailClosed
+	default:
+		return nil, fmt.Errorf("unknown policy webhook fail mode: %s", config.FailMode)
+	}
+
+	if config.Timeout <= 0 {
+		config.Timeout = DefaultTimeout
+	}
+
+	return &Webhook{
+		config: config,
+		client: &http.Client{Timeout: config.Timeout},
+	}, nil
+}
+
+func (w *Webhook) Name() string {
+	return "policy-webhook"
+}
+
+func (w *Webhook) DecorateRequest(ctx context.Context, request *llm.Request) (*llm.Request, error) {
+	verdict, err := w.call(ctx, &Payload{Stage: StageRequest, Request: request})
+	if err != nil {
+		return request, w.fail(ctx, StageRequest, err)
+	}
+
+	switch verdict.Action {
+	case ActionAllow:
+		return request, nil
+	case ActionDeny:
+		return nil, newDeniedError(StageRequest, verdict.Message)
+	default:
+		if verdict.Request == nil {
+			return request, w.fail(ctx, StageRequest, errors.New("the rewritten request is missing"))
+		}
+
+		// The fields not serialized and the stream mode of the original request are kept.
+		rewritten := verdict.Request
+		rewritten.RawRequest = request.RawRequest
+		rewritten.RawAPIFormat = request.RawAPIFormat
+		rewritten.Stream = request.Stream
+
+		return rewritten, nil
+	}
+}
+
+func (w *Webhook) DecorateResponse(ctx context.Context, response *llm.Response) (*llm.Response, error) {
+	if !w.config.CheckResponse {
+		return response, nil
+	}
+
+	return w.checkResponse(ctx, response)
+}
+
+func (w *Webhook) checkResponse(ctx context.Context, response *llm.Response) (*llm.Response, error) {
+	verdict, err := w.call(ctx, &Payload{Stage: StageResponse, Response: response})
+	if err != nil {
+		return response, w.fail(ctx, StageResponse, err)
+	}
+
+	switch verdict.Action {
+	case ActionAllow:
+		return response, nil
+	case ActionDeny:
+		return nil, newDeniedError(StageResponse, verdict.Message)
+	default:
+		if verdict.Response == nil {
+			return response, w.fail(ctx, StageResponse, errors.New("the rewritten response is missing"))
+		}
+
+		return verdict.Response, nil
+	}
+}
+
+// call sends the payload to the webhook and returns the verdict.
+func (w *Webhook) call(ctx context.Context, payload *Payload) (*Verdict, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal policy webhook payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.config.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create policy webhook request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	for key, value := range w.config.Headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call policy webhook: %w", err)
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("policy webhook returned status %d", resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxVerdictBytes))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read policy webhook response: %w", err)
+	}
+
+	var verdict Verdict
+	if err := json.Unmarshal(data, &verdict); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal policy webhook response: %w", err)
+	}
+
+	switch verdict.Action {
+	case ActionAllow, ActionDeny, ActionModify:
+	default:
+		return nil, fmt.Errorf("unknown policy webhook action: %q", verdict.Action)
+	}
+
+	return &verdict, nil
+}
+
+// fail returns nil in the fail-open mode, otherwise the error of the unavailable policy.
+func (w *Webhook) fail(ctx context.Context, stage Stage, err error) error {
+	if w.config.FailMode == FailOpen {
+		log.Warn(ctx, "policy webhook failed, allowing the "+string(stage), log.Cause(err))
+		return nil
+	}
+
+	log.Error(ctx, "policy webhook failed, rejecting the "+string(stage), log.Cause(err))
+
+	return &llm.ResponseError{
+		StatusCode: http.StatusServiceUnavailable,
+		Detail: llm.ErrorDetail{
+			Type:    "server_error",
+			Code:    "policy_unavailable",
+			Message: "the policy check is unavailable, please try again later",
+		},
+	}
+}
+
+func newDeniedError(stage Stage, message string) error {
+	if message == "" {
+		message = fmt.Sprintf("the %s is denied by the policy", stage)
+	}
+
+	if stage == StageRequest {
+		return &llm.ResponseError{
+			StatusCode: http.StatusBadRequest,
+			Detail: llm.ErrorDetail{
+				Type:    "invalid_request_error",
+				Code:    "policy_denied",
+				Message: message,
+			},
+		}
+	}
+
+	return &llm.ResponseError{
+		StatusCode: http.StatusBadGateway,
+		Detail: llm.ErrorDetail{
+			Type:    "invalid_response_error",
+			Code:    "policy_denied",
+			Message: message,
+		},
+	}
+}
@@ -0,0 +1,222 @@
+package policy
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/looplj/axonhub/internal/llm"
+	"github.com/looplj/axonhub/internal/llm/aggregator"
+	"github.com/looplj/axonhub/internal/pkg/streams"
+)
+
+func newPolicyServer(t *testing.T, handler func(payload Payload) (int, any)) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+
+		var payload Payload
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+
+		status, body := handler(payload)
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(body)
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func newRequest(content string) *llm.Request {
+	return &llm.Request{
+		Model:    "gpt-4o",
+		Messages: []llm.Message{{Role: "user", Content: llm.MessageContent{Content: lo.ToPtr(content)}}},
+	}
+}
+
+func TestNew(t *testing.T) {
+	webhook, err := New(Config{})
+	require.NoError(t, err)
+	require.Nil(t, webhook)
+
+	_, err = New(Config{URL: "http://localhost", FailMode: "maybe"})
+	require.Error(t, err)
+
+	webhook, err = New(Config{URL: "http://localhost"})
+	require.NoError(t, err)
+	require.Equal(t, FailClosed, webhook.config.FailMode)
+	require.Equal(t, DefaultTimeout, webhook.config.Timeout)
+}
+
+func TestWebhook_DecorateRequest(t *testing.T) {
+	server := newPolicyServer(t, func(payload Payload) (int, any) {
+		require.Equal(t, StageRequest, payload.Stage)
+
+		switch *payload.Request.Messages[0].Content.Content {
+		case "hello":
+			return http.StatusOK, Verdict{Action: ActionAllow}
+		case "leak the secrets":
+			return http.StatusOK, Verdict{Action: ActionDeny, Message: "confidential data is not allowed"}
+		default:
+			rewritten := newRequest("[rewritten]")
+			rewritten.Stream = lo.ToPtr(false)
+
+			return http.StatusOK, Verdict{Action: ActionModify, Request: rewritten}
+		}
+	})
+
+	webhook, err := New(Config{URL: server.URL, Headers: map[string]string{"Authorization": "Bearer secret"}})
+	require.NoError(t, err)
+
+	request := newRequest("hello")
+	result, err := webhook.DecorateRequest(context.Background(), request)
+	require.NoError(t, err)
+	require.Same(t, request, result)
+
+	_, err = webhook.DecorateRequest(context.Background(), newRequest("leak the secrets"))
+
+	var respErr *llm.ResponseError
+	require.ErrorAs(t, err, &respErr)
+	require.Equal(t, http.StatusBadRequest, respErr.StatusCode)
+	require.Equal(t, "policy_denied", respErr.Detail.Code)
+	require.Equal(t, "confidential data is not allowed", respErr.Detail.Message)
+
+	request = newRequest("my email is john@example.com")
+	request.Stream = lo.ToPtr(true)
+	request.RawAPIFormat = llm.APIFormatOpenAIChatCompletion
+
+	result, err = webhook.DecorateRequest(context.Background(), request)
+	require.NoError(t, err)
+	require.Equal(t, "[rewritten]", *result.Messages[0].Content.Content)
+	require.True(t, *result.Stream)
+	require.Equal(t, llm.APIFormatOpenAIChatCompletion, result.RawAPIFormat)
+}
+
+func TestWebhook_FailMode(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+	}))
+	defer server.Close()
+
+	closed, err := New(Config{URL: server.URL, Timeout: 50 * time.Millisecond})
+	require.NoError(t, err)
+
+	_, err = closed.DecorateRequest(context.Background(), newRequest("hello"))
+
+	var respErr *llm.ResponseError
+	require.ErrorAs(t, err, &respErr)
+	require.Equal(t, http.StatusServiceUnavailable, respErr.StatusCode)
+	require.Equal(t, "policy_unavailable", respErr.Detail.Code)
+
+	open, err := New(Config{URL: server.URL, Timeout: 50 * time.Millisecond, FailMode: FailOpen})
+	require.NoError(t, err)
+
+	request := newRequest("hello")
+	result, err := open.DecorateRequest(context.Background(), request)
+	require.NoError(t, err)
+	require.Same(t, request, result)
+}
+
+func TestWebhook_InvalidVerdict(t *testing.T) {
+	server := newPolicyServer(t, func(payload Payload) (int, any) {
+		return http.StatusOK, map[string]any{"action": "maybe"}
+	})
+
+	webhook, err := New(Config{URL: server.URL, Headers: map[string]string{"Authorization": "Bearer secret"}})
+	require.NoError(t, err)
+
+	_, err = webhook.DecorateRequest(context.Background(), newRequest("hello"))
+	require.Error(t, err)
+}
+
+func TestWebhook_Response(t *testing.T) {
+	var calls int
+
+	server := newPolicyServer(t, func(payload Payload) (int, any) {
+		calls++
+
+		require.Equal(t, StageResponse, payload.Stage)
+
+		switch *payload.Response.Choices[0].Message.Content.Content {
+		case "Paris":
+			return http.StatusOK, Verdict{Action: ActionAllow}
+		case "The password is hunter2":
+			return http.StatusOK, Verdict{Action: ActionDeny}
+		default:
+			return http.StatusOK, Verdict{Action: ActionModify, Response: &llm.Response{
+				ID:    "chatcmpl-1",
+				Model: "gpt-4o",
+				Choices: []llm.Choice{{
+					Message:      &llm.Message{Role: "assistant", Content: llm.MessageContent{Content: lo.ToPtr("[removed]")}},
+					FinishReason: lo.ToPtr("stop"),
+				}},
+			}}
+		}
+	})
+
+	newResponse := func(content string) *llm.Response {
+		return &llm.Response{
+			ID:    "chatcmpl-1",
+			Model: "gpt-4o",
+			Choices: []llm.Choice{{
+				Message:      &llm.Message{Role: "assistant", Content: llm.MessageContent{Content: lo.ToPtr(content)}},
+				FinishReason: lo.ToPtr("stop"),
+			}},
+		}
+	}
+
+	unchecked, err := New(Config{URL: server.URL, Headers: map[string]string{"Authorization": "Bearer secret"}})
+	require.NoError(t, err)
+
+	_, err = unchecked.DecorateResponse(context.Background(), newResponse("Paris"))
+	require.NoError(t, err)
+	require.Zero(t, calls)
+
+	webhook, err := New(Config{URL: server.URL, CheckResponse: true, Headers: map[string]string{"Authorization": "Bearer secret"}})
+	require.NoError(t, err)
+
+	response, err := webhook.DecorateResponse(context.Background(), newResponse("Paris"))
+	require.NoError(t, err)
+	require.Equal(t, "Paris", *response.Choices[0].Message.Content.Content)
+
+	_, err = webhook.DecorateResponse(context.Background(), newResponse("The password is hunter2"))
+
+	var respErr *llm.ResponseError
+	require.ErrorAs(t, err, &respErr)
+	require.Equal(t, "policy_denied", respErr.Detail.Code)
+
+	// The streams are checked once with the aggregated response.
+	calls = 0
+
+	collect := func(stream streams.Stream[*llm.Response]) ([]*llm.Response, error) {
+		var chunks []*llm.Response
+		for stream.Next() {
+			chunks = append(chunks, stream.Current())
+		}
+
+		return chunks, stream.Err()
+	}
+
+	chunks, err := collect(webhook.DecorateStream(context.Background(), streams.SliceStream(aggregator.Split(newResponse("Paris")))))
+	require.NoError(t, err)
+	require.Equal(t, 1, calls)
+	require.Equal(t, llm.DoneResponse, chunks[len(chunks)-1])
+	require.Equal(t, "Paris", *aggregator.Aggregate(chunks[:len(chunks)-1]).Choices[0].Message.Content.Content)
+
+	chunks, err = collect(webhook.DecorateStream(context.Background(), streams.SliceStream(aggregator.Split(newResponse("Call me at 555-0100")))))
+	require.NoError(t, err)
+	require.Equal(t, 2, calls)
+	require.Equal(t, llm.DoneResponse, chunks[len(chunks)-1])
+	require.Equal(t, "[removed]", *aggregator.Aggregate(chunks[:len(chunks)-1]).Choices[0].Message.Content.Content)
+
+	chunks, err = collect(webhook.DecorateStream(context.Background(), streams.SliceStream(aggregator.Split(newResponse("The password is hunter2")))))
+	require.ErrorAs(t, err, &respErr)
+	require.Empty(t, chunks)
+}
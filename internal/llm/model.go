@@ -58,8 +58,8 @@ type Request struct {
 	// How many chat completion choices to generate for each input message. Note that
 	// you will be charged based on the number of generated tokens across all of the
 	// choices. Keep `n` as `1` to minimize costs.
-	// NOTE: Not supported, always 1.
-	// N *int64 `json:"n,omitempty"`
+	// The request is fanned out to the parallel upstream calls if the provider does not support it natively.
+	N *int64 `json:"n,omitempty"`
 
 	// Number between -2.0 and 2.0. Positive values penalize new tokens based on
 	// whether they appear in the text so far, increasing the model's likelihood to
@@ -117,11 +117,18 @@ type Request struct {
 	// [Learn more](https://platform.openai.com/docs/guides/safety-best-practices#safety-identifiers).
 	User *string `json:"user,omitempty"`
 
+	// Output types that you would like the model to generate. Most models are capable
+	// of generating text, which is the default: `["text"]`.
+	// The `gpt-4o-audio-preview` model can also be used to generate audio:
+	// `["text", "audio"]`.
+	//
+	// Any of "text", "audio".
+	Modalities []string `json:"modalities,omitempty"`
+
 	// Parameters for audio output. Required when audio output is requested with
 	// `modalities: ["audio"]`.
 	// [Learn more](https://platform.openai.com/docs/guides/audio).
-	// TODO
-	// Audio ChatCompletionAudioParam `json:"audio,omitzero"`
+	Audio *AudioOutputParams `json:"audio,omitempty"`
 
 	// Modify the likelihood of specified tokens appearing in the completion.
 	//
@@ -298,6 +305,25 @@ type Message struct {
 	// the doc from deepseek:
 	// - https://api-docs.deepseek.com/api/create-chat-completion#responses
 	ReasoningContent *string `json:"reasoning_content,omitempty"`
+
+	// Audio is the audio output of the assistant message when the audio modality is requested.
+	// In the stream response, the data and the transcript are the deltas.
+	// In the request, only the id is required to refer to the previous audio response.
+	Audio *MessageAudio `json:"audio,omitempty"`
+
+	// CacheControl is the cache breakpoint of the message when the content is a string, e.g. the system prompt or the tool result.
+	// This field is a help field, will not be sent to the llm service.
+	CacheControl *CacheControl `json:"-"`
+}
+
+// CacheControl marks a prompt cache breakpoint, the prompt prefix up to and including the marked block is cached.
+// It is modeled after the Anthropic prompt caching, other providers ignore it.
+type CacheControl struct {
+	// Type is the type of the cache, only "ephemeral" is supported now.
+	Type string `json:"type"`
+
+	// TTL is the time to live of the cache, e.g. "5m" or "1h", default to the provider default.
+	TTL string `json:"ttl,omitempty"`
 }
 
 type MessageContent struct {
@@ -344,7 +370,7 @@ func (c *MessageContent) UnmarshalJSON(data []byte) error {
 // MessageContentPart represents different types of content (text, image, etc.)
 type MessageContentPart struct {
 	// Type is the type of the content part.
-	// e.g. "text", "image_url"
+	// e.g. "text", "image_url", "input_audio", "file"
 	Type string `json:"type"`
 	// Text is the text content, required when type is "text"
 	Text *string `json:"text,omitempty"`
@@ -352,8 +378,15 @@ type MessageContentPart struct {
 	// ImageURL is the image URL content, required when type is "image_url"
 	ImageURL *ImageURL `json:"image_url,omitempty"`
 
-	// Audio is the audio content, required when type is "input_audio"
-	Audio *Audio `json:"audio,omitempty"`
+	// InputAudio is the audio content, required when type is "input_audio"
+	InputAudio *Audio `json:"input_audio,omitempty"`
+
+	// File is the file content, e.g. PDF and plain text documents, required when type is "file"
+	File *File `json:"file,omitempty"`
+
+	// CacheControl is the cache breakpoint of the content part.
+	// This field is a help field, will not be sent to the llm service.
+	CacheControl *CacheControl `json:"-"`
 }
 
 // ImageURL represents an image URL with optional detail level.
@@ -378,10 +411,62 @@ type Audio struct {
 	Data string `json:"data"`
 }
 
+// AudioOutputParams represents the parameters for the audio output.
+type AudioOutputParams struct {
+	// The voice the model uses to respond, e.g. "alloy", "ash", "coral".
+	Voice string `json:"voice"`
+
+	// Specifies the output audio format.
+	//
+	// Any of "wav", "aac", "mp3", "flac", "opus", "pcm16".
+	Format string `json:"format"`
+}
+
+// MessageAudio represents the audio response of the model.
+type MessageAudio struct {
+	// Unique identifier for this audio response.
+	ID string `json:"id,omitempty"`
+
+	// Base64 encoded audio bytes generated by the model, in the format specified in the request.
+	Data string `json:"data,omitempty"`
+
+	// The Unix timestamp (in seconds) for when this audio response will no longer be
+	// accessible on the server for use in multi-turn conversations.
+	ExpiresAt int64 `json:"expires_at,omitempty"`
+
+	// Transcript of the audio generated by the model.
+	Transcript string `json:"transcript,omitempty"`
+}
+
+// File represents a file input, e.g. a PDF or plain text document.
+type File struct {
+	// FileData is the file data, it can be a data URL with the base64 encoded data,
+	// e.g. "data:application/pdf;base64,...", or the URL of the file.
+	FileData string `json:"file_data,omitempty"`
+
+	// FileID is the ID of an uploaded file to use as input.
+	FileID string `json:"file_id,omitempty"`
+
+	// Filename is the name of the file, used as the document title for the Anthropic providers.
+	Filename string `json:"filename,omitempty"`
+
+	// Context is the context about the document, only for the Anthropic providers.
+	// This field is a help field, will not be sent to the llm service.
+	Context string `json:"-"`
+
+	// Citations is the citations configuration of the document, e.g. {"enabled":true}, only for the Anthropic providers.
+	// This field is a help field, will not be sent to the llm service.
+	Citations json.RawMessage `json:"-"`
+}
+
 // Tool represents a function tool.
 type Tool struct {
 	Type     string   `json:"type"`
 	Function Function `json:"function"`
+
+	// CacheControl is the cache breakpoint of the tool definitions.
+	// This field is a help field, will not be sent to the llm service.
+	CacheControl *CacheControl `json:"-"`
 }
 
 // FunctionRequest represents a function definition.
@@ -414,12 +499,40 @@ type ToolCall struct {
 
 	// The index of the tool call in the list of tool calls.
 	Index int `json:"index,omitempty"`
+
+	// CacheControl is the cache breakpoint of the tool call.
+	// This field is a help field, will not be sent to the llm service.
+	CacheControl *CacheControl `json:"-"`
 }
 
 // ResponseFormat specifies the format of the response.
 type ResponseFormat struct {
+	// Type is the type of the response format, it can be "text", "json_object" or "json_schema".
 	Type string `json:"type"`
-	// TODO: Schema
+
+	// JSONSchema is the schema of the response, it is required when Type is "json_schema".
+	JSONSchema *JSONSchema `json:"json_schema,omitempty"`
+}
+
+// JSONSchema is the structured output schema of the response.
+type JSONSchema struct {
+	// The name of the response format.
+	Name string `json:"name"`
+
+	// A description of what the response format is for.
+	Description string `json:"description,omitempty"`
+
+	// The schema for the response format, described as a JSON Schema object.
+	Schema json.RawMessage `json:"schema,omitempty"`
+
+	// Whether to enable strict schema adherence when generating the output.
+	// The final output will be validated against the schema if the provider does not support it natively.
+	Strict *bool `json:"strict,omitempty"`
+}
+
+// IsJSONSchema returns true if the response format requires the response to follow a JSON schema.
+func (f *ResponseFormat) IsJSONSchema() bool {
+	return f != nil && f.Type == "json_schema" && f.JSONSchema != nil
 }
 
 // Response is the unified response model.
@@ -513,6 +626,10 @@ type Usage struct {
 	TotalTokens             int                      `json:"total_tokens"`
 	PromptTokensDetails     *PromptTokensDetails     `json:"prompt_tokens_details"`
 	CompletionTokensDetails *CompletionTokensDetails `json:"completion_tokens_details"`
+
+	// Estimated is true if the usage is estimated locally because the provider did not report it.
+	// This field is a help field, will not be sent to the client.
+	Estimated bool `json:"-"`
 }
 
 // CompletionTokensDetails Breakdown of tokens used in a completion.
@@ -527,6 +644,9 @@ type CompletionTokensDetails struct {
 type PromptTokensDetails struct {
 	AudioTokens  int `json:"audio_tokens"`
 	CachedTokens int `json:"cached_tokens"`
+
+	// CacheCreationTokens is the number of tokens written to the prompt cache, reported by the Anthropic compatible providers.
+	CacheCreationTokens int `json:"cache_creation_tokens,omitempty"`
 }
 
 // ResponseError represents an error response.
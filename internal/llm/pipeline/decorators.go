@@ -0,0 +1,61 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/looplj/axonhub/internal/llm"
+	"github.com/looplj/axonhub/internal/llm/decorator"
+	"github.com/looplj/axonhub/internal/log"
+	"github.com/looplj/axonhub/internal/pkg/streams"
+)
+
+// decorateRequest applies the decorators to the request in order.
+func (p *pipeline) decorateRequest(ctx context.Context, request *llm.Request) (*llm.Request, error) {
+	for _, dec := range p.decorators {
+		var err error
+
+		request, err = dec.DecorateRequest(ctx, request)
+		if err != nil {
+			log.Error(ctx, "Failed to apply decorator", log.Cause(err))
+			return nil, err
+		}
+	}
+
+	return request, nil
+}
+
+// decorateResponse applies the decorators to the response in order.
+func (p *pipeline) decorateResponse(ctx context.Context, response *llm.Response) (*llm.Response, error) {
+	for _, dec := range p.decorators {
+		var err error
+
+		response, err = dec.DecorateResponse(ctx, response)
+		if err != nil {
+			log.Error(ctx, "Failed to apply response decorator", log.Cause(err))
+			return nil, err
+		}
+	}
+
+	return response, nil
+}
+
+// decorateStream applies the decorators to the response stream in order.
+// The decorators not implementing the StreamDecorator are applied to each chunk of the stream.
+func (p *pipeline) decorateStream(ctx context.Context, stream streams.Stream[*llm.Response]) streams.Stream[*llm.Response] {
+	for _, dec := range p.decorators {
+		if sd, ok := dec.(decorator.StreamDecorator); ok {
+			stream = sd.DecorateStream(ctx, stream)
+			continue
+		}
+
+		stream = streams.MapErr(stream, func(response *llm.Response) (*llm.Response, error) {
+			if response == nil || response == llm.DoneResponse {
+				return response, nil
+			}
+
+			return dec.DecorateResponse(ctx, response)
+		})
+	}
+
+	return stream
+}
@@ -0,0 +1,78 @@
+package pipeline_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+
+	"github.com/looplj/axonhub/internal/llm"
+	"github.com/looplj/axonhub/internal/llm/decorator"
+	"github.com/looplj/axonhub/internal/llm/pipeline"
+	"github.com/looplj/axonhub/internal/llm/transformer/openai"
+	"github.com/looplj/axonhub/internal/pkg/httpclient"
+	"github.com/looplj/axonhub/internal/pkg/streams"
+)
+
+func upperCaseDecorator() decorator.Decorator {
+	return decorator.ResponseDecorator("upper-case", func(ctx context.Context, response *llm.Response) (*llm.Response, error) {
+		for _, choice := range response.Choices {
+			for _, message := range []*llm.Message{choice.Message, choice.Delta} {
+				if message != nil && message.Content.Content != nil {
+					message.Content.Content = lo.ToPtr(strings.ToUpper(*message.Content.Content))
+				}
+			}
+		}
+
+		return response, nil
+	})
+}
+
+func TestPipeline_DecorateResponse(t *testing.T) {
+	ctx := context.Background()
+
+	outbound, err := openai.NewOutboundTransformer("https://api.openai.com", "test-api-key")
+	require.NoError(t, err)
+
+	executor := &mockExecutor{
+		doFunc: func(ctx context.Context, request *httpclient.Request) (*httpclient.Response, error) {
+			body, err := json.Marshal(&llm.Response{
+				ID:     "chatcmpl-123",
+				Object: "chat.completion",
+				Model:  "gpt-4",
+				Choices: []llm.Choice{{
+					Message:      &llm.Message{Role: "assistant", Content: llm.MessageContent{Content: lo.ToPtr("paris")}},
+					FinishReason: lo.ToPtr("stop"),
+				}},
+			})
+			require.NoError(t, err)
+
+			return &httpclient.Response{StatusCode: http.StatusOK, Body: body}, nil
+		},
+		doStreamFunc: func(ctx context.Context, request *httpclient.Request) (streams.Stream[*httpclient.StreamEvent], error) {
+			return streams.SliceStream([]*httpclient.StreamEvent{
+				{Data: []byte(`{"id":"chatcmpl-123","object":"chat.completion.chunk","model":"gpt-4","choices":[{"index":0,"delta":{"role":"assistant","content":"par"}}]}`)},
+				{Data: []byte(`{"id":"chatcmpl-123","object":"chat.completion.chunk","model":"gpt-4","choices":[{"index":0,"delta":{"content":"is"},"finish_reason":"stop"}]}`)},
+				{Data: []byte(`[DONE]`)},
+			}), nil
+		},
+	}
+
+	pipe := pipeline.NewFactory(executor).Pipeline(openai.NewInboundTransformer(), outbound, pipeline.WithDecorators(upperCaseDecorator()))
+
+	result, err := pipe.Process(ctx, newChatRequest(t, false, "capital of France?"))
+	require.NoError(t, err)
+	require.Equal(t, "PARIS", gjson.GetBytes(result.Response.Body, "choices.0.message.content").String())
+
+	result, err = pipe.Process(ctx, newChatRequest(t, true, "capital of France?"))
+	require.NoError(t, err)
+
+	aggregated, _, err := openai.NewInboundTransformer().AggregateStreamChunks(ctx, collectStream(t, result.EventStream))
+	require.NoError(t, err)
+	require.Equal(t, "PARIS", gjson.GetBytes(aggregated, "choices.0.message.content").String())
+}
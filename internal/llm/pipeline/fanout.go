@@ -0,0 +1,222 @@
+package pipeline
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/tidwall/sjson"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/looplj/axonhub/internal/pkg/httpclient"
+	"github.com/looplj/axonhub/internal/pkg/streams"
+)
+
+// ChoiceIndexField is the field added to the data of the stream events of the fanout requests,
+// it indicates which choice the event belongs to.
+const ChoiceIndexField = "choice_index"
+
+// fanoutExecutor executes the request multiple times in parallel to emulate the n>1 choices
+// for the providers which do not support it natively.
+//
+// The response bodies are merged into a JSON array, the element index is the choice index.
+// The stream events are interleaved, and the choice index is added to the event data with the ChoiceIndexField.
+type fanoutExecutor struct {
+	Executor
+}
+
+func newFanoutExecutor(executor Executor) Executor {
+	return &fanoutExecutor{Executor: executor}
+}
+
+func fanoutRequests(request *httpclient.Request) []*httpclient.Request {
+	requests := make([]*httpclient.Request, request.Fanout)
+	for i := range requests {
+		req := *request
+		req.Fanout = 0
+		req.Headers = request.Headers.Clone()
+		requests[i] = &req
+	}
+
+	return requests
+}
+
+func (e *fanoutExecutor) Do(ctx context.Context, request *httpclient.Request) (*httpclient.Response, error) {
+	requests := fanoutRequests(request)
+	responses := make([]*httpclient.Response, len(requests))
+
+	g, gctx := errgroup.WithContext(ctx)
+
+	for i, req := range requests {
+		g.Go(func() error {
+			resp, err := e.Executor.Do(gctx, req)
+			if err != nil {
+				return err
+			}
+
+			responses[i] = resp
+
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	bodies := make([][]byte, len(responses))
+	for i, resp := range responses {
+		bodies[i] = resp.Body
+	}
+
+	merged := *responses[0]
+	merged.Body = append(append([]byte("["), bytes.Join(bodies, []byte(","))...), ']')
+
+	return &merged, nil
+}
+
+func (e *fanoutExecutor) DoStream(ctx context.Context, request *httpclient.Request) (streams.Stream[*httpclient.StreamEvent], error) {
+	requests := fanoutRequests(request)
+	upstreams := make([]streams.Stream[*httpclient.StreamEvent], len(requests))
+
+	// The streams outlive the group, so the context of the group which is canceled after Wait can not be used.
+	var g errgroup.Group
+
+	for i, req := range requests {
+		g.Go(func() error {
+			stream, err := e.Executor.DoStream(ctx, req)
+			if err != nil {
+				return err
+			}
+
+			upstreams[i] = stream
+
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		for _, stream := range upstreams {
+			if stream != nil {
+				_ = stream.Close()
+			}
+		}
+
+		return nil, err
+	}
+
+	return newFanoutStream(upstreams), nil
+}
+
+type fanoutEvent struct {
+	event *httpclient.StreamEvent
+	err   error
+}
+
+// fanoutStream merges the upstream streams, the events are emitted in the order of arrival.
+type fanoutStream struct {
+	upstreams []streams.Stream[*httpclient.StreamEvent]
+	events    chan fanoutEvent
+	done      chan struct{}
+	closeOnce sync.Once
+	current   *httpclient.StreamEvent
+	err       error
+}
+
+func newFanoutStream(upstreams []streams.Stream[*httpclient.StreamEvent]) *fanoutStream {
+	s := &fanoutStream{
+		upstreams: upstreams,
+		events:    make(chan fanoutEvent),
+		done:      make(chan struct{}),
+	}
+
+	var wg sync.WaitGroup
+
+	for i, upstream := range upstreams {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+			s.consume(i, upstream)
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(s.events)
+	}()
+
+	return s
+}
+
+func (s *fanoutStream) consume(index int, upstream streams.Stream[*httpclient.StreamEvent]) {
+	for upstream.Next() {
+		event := upstream.Current()
+		if event == nil {
+			continue
+		}
+
+		tagged := *event
+		if data, err := sjson.SetBytes(event.Data, ChoiceIndexField, index); err == nil {
+			tagged.Data = data
+		}
+
+		select {
+		case s.events <- fanoutEvent{event: &tagged}:
+		case <-s.done:
+			return
+		}
+	}
+
+	if err := upstream.Err(); err != nil {
+		select {
+		case s.events <- fanoutEvent{err: err}:
+		case <-s.done:
+		}
+	}
+}
+
+func (s *fanoutStream) Next() bool {
+	if s.err != nil {
+		return false
+	}
+
+	item, ok := <-s.events
+	if !ok {
+		return false
+	}
+
+	if item.err != nil {
+		s.err = item.err
+		return false
+	}
+
+	s.current = item.event
+
+	return true
+}
+
+func (s *fanoutStream) Current() *httpclient.StreamEvent {
+	return s.current
+}
+
+func (s *fanoutStream) Err() error {
+	return s.err
+}
+
+func (s *fanoutStream) Close() error {
+	var errs []error
+
+	s.closeOnce.Do(func() {
+		close(s.done)
+
+		for _, upstream := range s.upstreams {
+			if err := upstream.Close(); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	})
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,120 @@
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+
+	"github.com/looplj/axonhub/internal/pkg/httpclient"
+	"github.com/looplj/axonhub/internal/pkg/streams"
+)
+
+type fakeFanoutExecutor struct {
+	calls  atomic.Int32
+	failAt int32
+
+	mu      sync.Mutex
+	streams []*closeTrackingStream
+}
+
+func (e *fakeFanoutExecutor) Do(ctx context.Context, request *httpclient.Request) (*httpclient.Response, error) {
+	call := e.calls.Add(1)
+	if call == e.failAt {
+		return nil, errors.New("upstream failed")
+	}
+
+	return &httpclient.Response{
+		StatusCode: 200,
+		Body:       []byte(fmt.Sprintf(`{"fanout":%d}`, request.Fanout)),
+	}, nil
+}
+
+func (e *fakeFanoutExecutor) DoStream(ctx context.Context, request *httpclient.Request) (streams.Stream[*httpclient.StreamEvent], error) {
+	call := e.calls.Add(1)
+	if call == e.failAt {
+		return nil, errors.New("upstream failed")
+	}
+
+	stream := &closeTrackingStream{
+		Stream: streams.SliceStream([]*httpclient.StreamEvent{
+			{Type: "message_start", Data: []byte(`{"type":"message_start"}`)},
+			{Type: "message_stop", Data: []byte(`{"type":"message_stop"}`)},
+		}),
+	}
+
+	e.mu.Lock()
+	e.streams = append(e.streams, stream)
+	e.mu.Unlock()
+
+	return stream, nil
+}
+
+type closeTrackingStream struct {
+	streams.Stream[*httpclient.StreamEvent]
+
+	closed atomic.Bool
+}
+
+func (s *closeTrackingStream) Close() error {
+	s.closed.Store(true)
+	return nil
+}
+
+func TestFanoutExecutor_Do(t *testing.T) {
+	executor := &fakeFanoutExecutor{}
+
+	resp, err := newFanoutExecutor(executor).Do(context.Background(), &httpclient.Request{Fanout: 3})
+	require.NoError(t, err)
+	require.Equal(t, int32(3), executor.calls.Load())
+	// The upstream requests are not fanned out again.
+	require.JSONEq(t, `[{"fanout":0},{"fanout":0},{"fanout":0}]`, string(resp.Body))
+}
+
+func TestFanoutExecutor_DoError(t *testing.T) {
+	executor := &fakeFanoutExecutor{failAt: 2}
+
+	_, err := newFanoutExecutor(executor).Do(context.Background(), &httpclient.Request{Fanout: 3})
+	require.ErrorContains(t, err, "upstream failed")
+}
+
+func TestFanoutExecutor_DoStream(t *testing.T) {
+	executor := &fakeFanoutExecutor{}
+
+	stream, err := newFanoutExecutor(executor).DoStream(context.Background(), &httpclient.Request{Fanout: 2})
+	require.NoError(t, err)
+
+	var indexes []int
+
+	for stream.Next() {
+		indexes = append(indexes, int(gjson.GetBytes(stream.Current().Data, ChoiceIndexField).Int()))
+	}
+
+	require.NoError(t, stream.Err())
+	require.NoError(t, stream.Close())
+
+	sort.Ints(indexes)
+	require.Equal(t, []int{0, 0, 1, 1}, indexes)
+
+	for _, upstream := range executor.streams {
+		require.True(t, upstream.closed.Load())
+	}
+}
+
+func TestFanoutExecutor_DoStreamError(t *testing.T) {
+	executor := &fakeFanoutExecutor{failAt: 1}
+
+	_, err := newFanoutExecutor(executor).DoStream(context.Background(), &httpclient.Request{Fanout: 2})
+	require.ErrorContains(t, err, "upstream failed")
+
+	// The opened streams are closed.
+	for _, upstream := range executor.streams {
+		require.True(t, upstream.closed.Load())
+	}
+}
@@ -0,0 +1,226 @@
+package pipeline
+
+import (
+	"context"
+	"time"
+
+	"github.com/looplj/axonhub/internal/llm"
+	"github.com/looplj/axonhub/internal/llm/transformer"
+	"github.com/looplj/axonhub/internal/log"
+	"github.com/looplj/axonhub/internal/pkg/streams"
+)
+
+// ChannelHedgeable interface for transformers that support sending the same request to the next channel concurrently.
+type ChannelHedgeable interface {
+	// HedgeChannel returns the outbound transformer of the next channel for the hedged attempt,
+	// false if there is no more channel available.
+	HedgeChannel(ctx context.Context) (transformer.Outbound, bool)
+
+	// ResolveHedge continues with the outbound transformer of the winner attempt, it is called after the loser is closed.
+	// It returns the function to finish the execution of the loser,
+	// the execution is marked canceled if the cause is nil.
+	ResolveHedge(ctx context.Context, winner, loser transformer.Outbound) func(ctx context.Context, cause error)
+}
+
+// WithHedging configures the pipeline to hedge the streaming requests,
+// if the channel has not produced the first event within the delay, the same request is sent to the next channel,
+// and the attempt which responds first is streamed while the other one is canceled.
+func WithHedging(delay time.Duration) Option {
+	return func(p *pipeline) {
+		p.hedgeDelay = delay
+	}
+}
+
+// hedgeAttempt is a streaming attempt which is executed and awaited for the first event in the background.
+type hedgeAttempt struct {
+	outbound transformer.Outbound
+	cancel   context.CancelFunc
+
+	first chan hedgeResult
+
+	// result is the result of the execution and the first Next call, valid when done is true.
+	result hedgeResult
+	done   bool
+}
+
+type hedgeResult struct {
+	stream streams.Stream[*llm.Response]
+	ok     bool
+	err    error
+}
+
+// succeeded reports whether the attempt produced the first event.
+func (a *hedgeAttempt) succeeded() bool {
+	return a.result.err == nil && a.result.ok
+}
+
+// startHedgeAttempt transforms the request with the outbound transformer, and executes it in the background,
+// so the hedge delay covers the time of the connection and the response headers too.
+func (p *pipeline) startHedgeAttempt(
+	ctx context.Context,
+	outbound transformer.Outbound,
+	request *llm.Request,
+) (*hedgeAttempt, error) {
+	attemptCtx, cancel := context.WithCancel(llm.NewRequestContext(ctx, request))
+
+	httpReq, err := outbound.TransformRequest(attemptCtx, request)
+	if err != nil {
+		cancel()
+		log.Error(ctx, "Failed to transform streaming request", log.Cause(err))
+
+		return nil, err
+	}
+
+	attempt := &hedgeAttempt{
+		outbound: outbound,
+		cancel:   cancel,
+		first:    make(chan hedgeResult, 1),
+	}
+
+	go func() {
+		stream, err := p.executeStream(attemptCtx, outbound, httpReq)
+		if err != nil {
+			attempt.first <- hedgeResult{err: err}
+			return
+		}
+
+		attempt.first <- hedgeResult{stream: stream, ok: stream.Next()}
+	}()
+
+	return attempt, nil
+}
+
+func (a *hedgeAttempt) wait() {
+	if !a.done {
+		a.result = <-a.first
+		a.done = true
+	}
+}
+
+// stream returns the stream of the attempt with the awaited first event replayed.
+func (a *hedgeAttempt) stream() (streams.Stream[*llm.Response], error) {
+	a.wait()
+
+	if a.result.err != nil {
+		a.cancel()
+		return nil, a.result.err
+	}
+
+	return &replayedStream{
+		Stream: a.result.stream,
+		cancel: a.cancel,
+		first:  a.result.ok,
+	}, nil
+}
+
+// close cancels the attempt and closes its stream.
+func (a *hedgeAttempt) close() {
+	a.cancel()
+	a.wait()
+
+	if a.result.stream != nil {
+		_ = a.result.stream.Close()
+	}
+}
+
+// hedgedStream sends the request to the outbound transformer, and hedges it with the next channel
+// if the first event is not produced within the hedge delay.
+func (p *pipeline) hedgedStream(
+	ctx context.Context,
+	hedgeable ChannelHedgeable,
+	request *llm.Request,
+) (streams.Stream[*llm.Response], error) {
+	// The outbound transformer may change the request in place, the hedged attempt sends the original one.
+	hedgeRequest := *request
+
+	primary, err := p.startHedgeAttempt(ctx, p.Outbound, request)
+	if err != nil {
+		return nil, err
+	}
+
+	timer := time.NewTimer(p.hedgeDelay)
+	defer timer.Stop()
+
+	select {
+	case primary.result = <-primary.first:
+		primary.done = true
+		return primary.stream()
+	case <-timer.C:
+	}
+
+	outbound, ok := hedgeable.HedgeChannel(ctx)
+	if !ok {
+		log.Debug(ctx, "no more channels available for hedging")
+		return primary.stream()
+	}
+
+	log.Debug(ctx, "first event not received within the hedge delay, hedging the request", log.Duration("delay", p.hedgeDelay))
+
+	secondary, err := p.startHedgeAttempt(ctx, outbound, &hedgeRequest)
+	if err != nil {
+		hedgeable.ResolveHedge(ctx, p.Outbound, outbound)(context.WithoutCancel(ctx), err)
+		return primary.stream()
+	}
+
+	winner, loser := raceHedgeAttempts(primary, secondary)
+
+	// The loser failed by itself if it finished before the winner, otherwise it is canceled.
+	var cause error
+	if loser.done {
+		cause = loser.result.err
+	}
+
+	// The primary attempt runs on the outbound transformer of the pipeline, which takes the state of the winner
+	// when the hedge is resolved, so the loser is canceled and closed before, and does not run on the state of the winner.
+	loser.close()
+	hedgeable.ResolveHedge(ctx, winner.outbound, loser.outbound)(context.WithoutCancel(ctx), cause)
+
+	return winner.stream()
+}
+
+// raceHedgeAttempts returns the first attempt which produces an event,
+// the primary attempt wins if both of them fail before the first event.
+func raceHedgeAttempts(primary, secondary *hedgeAttempt) (*hedgeAttempt, *hedgeAttempt) {
+	for {
+		select {
+		case primary.result = <-primary.first:
+			primary.done = true
+			if primary.succeeded() || secondary.done {
+				return primary, secondary
+			}
+		case secondary.result = <-secondary.first:
+			secondary.done = true
+			if secondary.succeeded() {
+				return secondary, primary
+			}
+
+			if primary.done {
+				return primary, secondary
+			}
+		}
+	}
+}
+
+// replayedStream replays the awaited first Next result of the attempt,
+// and cancels the attempt context when closed.
+type replayedStream struct {
+	streams.Stream[*llm.Response]
+
+	cancel   context.CancelFunc
+	first    bool
+	replayed bool
+}
+
+func (s *replayedStream) Next() bool {
+	if !s.replayed {
+		s.replayed = true
+		return s.first
+	}
+
+	return s.Stream.Next()
+}
+
+func (s *replayedStream) Close() error {
+	defer s.cancel()
+	return s.Stream.Close()
+}
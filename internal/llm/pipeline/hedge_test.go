@@ -0,0 +1,53 @@
+package pipeline
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newFinishedHedgeAttempt(result hedgeResult) *hedgeAttempt {
+	attempt := &hedgeAttempt{first: make(chan hedgeResult, 1)}
+	attempt.first <- result
+
+	return attempt
+}
+
+func TestRaceHedgeAttempts(t *testing.T) {
+	failed := hedgeResult{err: errors.New("upstream failed")}
+
+	t.Run("secondary responds first", func(t *testing.T) {
+		primary := &hedgeAttempt{first: make(chan hedgeResult, 1)}
+		secondary := newFinishedHedgeAttempt(hedgeResult{ok: true})
+
+		winner, loser := raceHedgeAttempts(primary, secondary)
+		require.Same(t, secondary, winner)
+		require.Same(t, primary, loser)
+		require.False(t, loser.done)
+	})
+
+	t.Run("primary fails before the secondary responds", func(t *testing.T) {
+		primary := newFinishedHedgeAttempt(failed)
+		secondary := &hedgeAttempt{first: make(chan hedgeResult, 1)}
+
+		go func() {
+			secondary.first <- hedgeResult{ok: true}
+		}()
+
+		winner, loser := raceHedgeAttempts(primary, secondary)
+		require.Same(t, secondary, winner)
+		require.Same(t, primary, loser)
+		require.True(t, loser.done)
+		require.Error(t, loser.result.err)
+	})
+
+	t.Run("both fail", func(t *testing.T) {
+		primary := newFinishedHedgeAttempt(failed)
+		secondary := newFinishedHedgeAttempt(failed)
+
+		winner, loser := raceHedgeAttempts(primary, secondary)
+		require.Same(t, primary, winner)
+		require.Same(t, secondary, loser)
+	})
+}
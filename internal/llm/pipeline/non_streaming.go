@@ -10,25 +10,15 @@ import (
 )
 
 // Process executes the non-streaming LLM pipeline
-// Steps: apply decorators -> outbound transform -> HTTP request -> outbound response transform -> inbound response transform.
+// Steps: outbound transform -> HTTP request -> outbound response transform -> inbound response transform.
 func (p *pipeline) notStream(
 	ctx context.Context,
 	request *llm.Request,
+	cacheKey string,
 ) (*httpclient.Response, error) {
-	// Step 1: Apply decorators to the request
-	if len(p.decorators) > 0 {
-		for _, dec := range p.decorators {
-			var err error
+	// Step 1: Transform to provider-specific HTTP request using outbound transformer
+	ctx = llm.NewRequestContext(ctx, request)
 
-			request, err = dec.DecorateRequest(ctx, request)
-			if err != nil {
-				log.Error(ctx, "Failed to apply decorator", log.Cause(err))
-				return nil, err
-			}
-		}
-	}
-
-	// Step 2: Transform to provider-specific HTTP request using outbound transformer
 	httpReq, err := p.Outbound.TransformRequest(ctx, request)
 	if err != nil {
 		log.Error(ctx, "Failed to transform request", log.Cause(err))
@@ -40,7 +30,11 @@ func (p *pipeline) notStream(
 		executor = c.CustomizeExecutor(executor)
 	}
 
-	// Step 3: Execute HTTP request
+	if httpReq.Fanout > 1 {
+		executor = newFanoutExecutor(executor)
+	}
+
+	// Step 2: Execute HTTP request
 	httpResp, err := executor.Do(ctx, httpReq)
 	if err != nil {
 		if httpErr, ok := xerrors.As[*httpclient.Error](err); ok {
@@ -50,7 +44,7 @@ func (p *pipeline) notStream(
 		return nil, err
 	}
 
-	// Step 4: Transform HTTP response to unified LLM response using outbound transformer
+	// Step 3: Transform HTTP response to unified LLM response using outbound transformer
 	llmResp, err := p.Outbound.TransformResponse(ctx, httpResp)
 	if err != nil {
 		log.Error(ctx, "Failed to transform response", log.Cause(err))
@@ -59,7 +53,21 @@ func (p *pipeline) notStream(
 
 	log.Debug(ctx, "LLM response", log.Any("response", llmResp))
 
-	// Step 5: Transform LLM response to final HTTP response using inbound transformer
+	if shouldValidateStructuredOutput(request) {
+		if err := validateStructuredOutputResponse(request, llmResp); err != nil {
+			log.Warn(ctx, "LLM response does not match the json schema", log.Cause(err))
+			return nil, err
+		}
+	}
+
+	llmResp, err = p.decorateResponse(ctx, llmResp)
+	if err != nil {
+		return nil, err
+	}
+
+	p.cacheResponse(ctx, cacheKey, llmResp)
+
+	// Step 4: Transform LLM response to final HTTP response using inbound transformer
 	finalResp, err := p.Inbound.TransformResponse(ctx, llmResp)
 	if err != nil {
 		log.Error(ctx, "Failed to transform final response", log.Cause(err))
@@ -38,6 +38,14 @@ func WithRetry(maxRetries int, retryDelay time.Duration, retryableErrors ...stri
 	}
 }
 
+// WithRetryBudget configures the number of the retries decided after the channels are selected,
+// the budget is evaluated before each retry and replaces the max retries of WithRetry.
+func WithRetryBudget(budget func() int) Option {
+	return func(p *pipeline) {
+		p.retryBudget = budget
+	}
+}
+
 // WithDecorators configures decorators for the pipeline.
 func WithDecorators(decorators ...decorator.Decorator) Option {
 	return func(p *pipeline) {
@@ -86,6 +94,9 @@ type pipeline struct {
 	maxRetries      int
 	retryDelay      time.Duration
 	retryableErrors []string
+	retryBudget     func() int
+	responseCache   ResponseCache
+	hedgeDelay      time.Duration
 }
 
 type Result struct {
@@ -106,11 +117,22 @@ func (p *pipeline) Process(ctx context.Context, request *httpclient.Request) (*R
 		return nil, err
 	}
 
-	var lastErr error
+	// The decorators are applied before the cache lookup, so the requests rejected by the guardrail
+	// or the policy are never served from the cache, and the cache key covers the rewritten request.
+	llmRequest, err = p.decorateRequest(ctx, llmRequest)
+	if err != nil {
+		return nil, err
+	}
 
-	maxAttempts := p.maxRetries + 1 // maxRetries + initial attempt
+	cacheKey, cached := p.lookupResponseCache(ctx, llmRequest)
+	if cached != nil {
+		log.Debug(ctx, "response cache hit", log.String("cache_key", cacheKey))
+		return p.replay(ctx, llmRequest, cached)
+	}
+
+	var lastErr error
 
-	for attempt := range maxAttempts {
+	for attempt := 0; attempt <= p.retries(); attempt++ {
 		if attempt > 0 {
 			log.Debug(ctx, "retrying pipeline process", log.Any("attempt", attempt))
 
@@ -134,7 +156,7 @@ func (p *pipeline) Process(ctx context.Context, request *httpclient.Request) (*R
 			}
 		}
 
-		result, err := p.processRequest(ctx, llmRequest)
+		result, err := p.processRequest(ctx, llmRequest, cacheKey)
 		if err == nil {
 			return result, nil
 		}
@@ -150,20 +172,29 @@ func (p *pipeline) Process(ctx context.Context, request *httpclient.Request) (*R
 		log.Warn(ctx, "pipeline process failed, will retry",
 			log.Cause(err),
 			log.Any("attempt", attempt),
-			log.Any("maxRetries", p.maxRetries))
+			log.Any("maxRetries", p.retries()))
 	}
 
 	return nil, lastErr
 }
 
-func (p *pipeline) processRequest(ctx context.Context, request *llm.Request) (*Result, error) {
+// retries returns the max retries of the request.
+func (p *pipeline) retries() int {
+	if p.retryBudget != nil {
+		return p.retryBudget()
+	}
+
+	return p.maxRetries
+}
+
+func (p *pipeline) processRequest(ctx context.Context, request *llm.Request, cacheKey string) (*Result, error) {
 	var result *Result
 	if request.Stream != nil && *request.Stream {
 		result = &Result{
 			Stream: true,
 		}
 
-		stream, err := p.stream(ctx, request)
+		stream, err := p.stream(ctx, request, cacheKey)
 		if err != nil {
 			return nil, err
 		}
@@ -174,7 +205,7 @@ func (p *pipeline) processRequest(ctx context.Context, request *llm.Request) (*R
 			Stream: false,
 		}
 
-		response, err := p.notStream(ctx, request)
+		response, err := p.notStream(ctx, request, cacheKey)
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,148 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/looplj/axonhub/internal/llm"
+	"github.com/looplj/axonhub/internal/llm/aggregator"
+	"github.com/looplj/axonhub/internal/log"
+	"github.com/looplj/axonhub/internal/pkg/streams"
+)
+
+// ResponseCache caches the responses of the identical requests.
+// The cached response is replayed to the client without calling the channels.
+type ResponseCache interface {
+	// Key returns the cache key of the request, false if the request should not be cached.
+	Key(ctx context.Context, request *llm.Request) (string, bool)
+	// Get returns the cached response of the key.
+	Get(ctx context.Context, key string) (*llm.Response, bool)
+	// Set caches the complete response of the key.
+	Set(ctx context.Context, key string, response *llm.Response)
+}
+
+// WithResponseCache configures the response cache for the pipeline.
+func WithResponseCache(cache ResponseCache) Option {
+	return func(p *pipeline) {
+		p.responseCache = cache
+	}
+}
+
+// lookupResponseCache returns the cache key of the request and the cached response if hit.
+// The key is empty if the request is not cacheable.
+func (p *pipeline) lookupResponseCache(ctx context.Context, request *llm.Request) (string, *llm.Response) {
+	if p.responseCache == nil {
+		return "", nil
+	}
+
+	key, ok := p.responseCache.Key(ctx, request)
+	if !ok {
+		return "", nil
+	}
+
+	response, ok := p.responseCache.Get(ctx, key)
+	if !ok {
+		return key, nil
+	}
+
+	return key, response
+}
+
+// replay transforms the cached response to the client, a synthetic stream is replayed for the streaming clients.
+func (p *pipeline) replay(ctx context.Context, request *llm.Request, response *llm.Response) (*Result, error) {
+	if request.Stream != nil && *request.Stream {
+		stream, err := p.Inbound.TransformStream(ctx, streams.SliceStream(aggregator.Split(response)))
+		if err != nil {
+			log.Error(ctx, "Failed to transform cached response stream", log.Cause(err))
+			return nil, err
+		}
+
+		return &Result{
+			Stream:      true,
+			EventStream: stream,
+		}, nil
+	}
+
+	finalResp, err := p.Inbound.TransformResponse(ctx, response)
+	if err != nil {
+		log.Error(ctx, "Failed to transform cached response", log.Cause(err))
+		return nil, err
+	}
+
+	return &Result{
+		Stream:   false,
+		Response: finalResp,
+	}, nil
+}
+
+// cacheResponse caches the response if it is complete.
+func (p *pipeline) cacheResponse(ctx context.Context, key string, response *llm.Response) {
+	if key == "" || !isCompleteResponse(response) {
+		return
+	}
+
+	p.responseCache.Set(ctx, key, response)
+}
+
+// cacheStream aggregates the chunks of the stream and caches the response when the stream ends without error.
+func (p *pipeline) cacheStream(ctx context.Context, key string, stream streams.Stream[*llm.Response]) streams.Stream[*llm.Response] {
+	if key == "" {
+		return stream
+	}
+
+	return &cachingStream{
+		Stream: stream,
+		onComplete: func(chunks []*llm.Response) {
+			p.cacheResponse(ctx, key, aggregator.Aggregate(chunks))
+		},
+	}
+}
+
+type cachingStream struct {
+	streams.Stream[*llm.Response]
+
+	chunks     []*llm.Response
+	onComplete func(chunks []*llm.Response)
+}
+
+func (s *cachingStream) Next() bool {
+	if s.Stream.Next() {
+		return true
+	}
+
+	if s.Stream.Err() == nil && s.onComplete != nil {
+		s.onComplete(s.chunks)
+		s.onComplete = nil
+	}
+
+	return false
+}
+
+func (s *cachingStream) Current() *llm.Response {
+	chunk := s.Stream.Current()
+	if chunk != nil && chunk != llm.DoneResponse {
+		s.chunks = append(s.chunks, chunk)
+	}
+
+	return chunk
+}
+
+// isCompleteResponse returns true if all the choices of the response are finished normally.
+func isCompleteResponse(response *llm.Response) bool {
+	if response == nil || response.Error != nil || len(response.Choices) == 0 {
+		return false
+	}
+
+	for _, choice := range response.Choices {
+		if choice.Message == nil || choice.FinishReason == nil {
+			return false
+		}
+
+		switch *choice.FinishReason {
+		case "stop", "length", "tool_calls", "function_call":
+		default:
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,337 @@
+package pipeline_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+
+	"github.com/looplj/axonhub/internal/llm"
+	"github.com/looplj/axonhub/internal/llm/decorator"
+	"github.com/looplj/axonhub/internal/llm/pipeline"
+	"github.com/looplj/axonhub/internal/llm/transformer/anthropic"
+	"github.com/looplj/axonhub/internal/llm/transformer/openai"
+	"github.com/looplj/axonhub/internal/pkg/httpclient"
+	"github.com/looplj/axonhub/internal/pkg/streams"
+	"github.com/looplj/axonhub/internal/pkg/xtest"
+)
+
+type mapResponseCache struct {
+	responses map[string]*llm.Response
+}
+
+func (c *mapResponseCache) Key(ctx context.Context, request *llm.Request) (string, bool) {
+	if len(request.Messages) == 0 || request.Messages[0].Content.Content == nil {
+		return "", false
+	}
+
+	return request.Model + ":" + *request.Messages[0].Content.Content, true
+}
+
+func (c *mapResponseCache) Get(ctx context.Context, key string) (*llm.Response, bool) {
+	response, ok := c.responses[key]
+	return response, ok
+}
+
+func (c *mapResponseCache) Set(ctx context.Context, key string, response *llm.Response) {
+	c.responses[key] = response
+}
+
+func newChatRequest(t *testing.T, stream bool, content string) *httpclient.Request {
+	t.Helper()
+
+	body, err := json.Marshal(map[string]any{
+		"model":  "gpt-4",
+		"stream": stream,
+		"messages": []map[string]any{
+			{"role": "user", "content": content},
+		},
+	})
+	require.NoError(t, err)
+
+	return &httpclient.Request{
+		Method:  http.MethodPost,
+		URL:     "/v1/chat/completions",
+		Headers: http.Header{"Content-Type": []string{"application/json"}},
+		Body:    body,
+	}
+}
+
+func collectStream(t *testing.T, stream streams.Stream[*httpclient.StreamEvent]) []*httpclient.StreamEvent {
+	t.Helper()
+
+	var events []*httpclient.StreamEvent
+	for stream.Next() {
+		events = append(events, stream.Current())
+	}
+
+	require.NoError(t, stream.Err())
+	require.NoError(t, stream.Close())
+
+	return events
+}
+
+func TestPipeline_ResponseCache_DecoratorsBeforeLookup(t *testing.T) {
+	ctx := context.Background()
+
+	outbound, err := openai.NewOutboundTransformer("https://api.openai.com", "test-api-key")
+	require.NoError(t, err)
+
+	executor := &mockExecutor{
+		doFunc: func(ctx context.Context, request *httpclient.Request) (*httpclient.Response, error) {
+			t.Fatal("the rejected request should not be sent")
+			return nil, nil
+		},
+	}
+
+	cache := &mapResponseCache{responses: map[string]*llm.Response{
+		"gpt-4:blocked prompt": {
+			ID:      "chatcmpl-123",
+			Object:  "chat.completion",
+			Model:   "gpt-4",
+			Choices: []llm.Choice{{Message: &llm.Message{Role: "assistant"}, FinishReason: lo.ToPtr("stop")}},
+		},
+	}}
+
+	errRejected := errors.New("prompt rejected")
+	reject := decorator.RequestDecorator("reject", func(ctx context.Context, request *llm.Request) (*llm.Request, error) {
+		if strings.Contains(*request.Messages[0].Content.Content, "blocked") {
+			return nil, errRejected
+		}
+
+		return request, nil
+	})
+
+	pipe := pipeline.NewFactory(executor).Pipeline(
+		openai.NewInboundTransformer(),
+		outbound,
+		pipeline.WithDecorators(reject),
+		pipeline.WithResponseCache(cache),
+	)
+
+	// The cached response of the rejected prompt is not replayed.
+	_, err = pipe.Process(ctx, newChatRequest(t, false, "blocked prompt"))
+	require.ErrorIs(t, err, errRejected)
+}
+
+func TestPipeline_ResponseCache_NonStream(t *testing.T) {
+	ctx := context.Background()
+
+	outbound, err := openai.NewOutboundTransformer("https://api.openai.com", "test-api-key")
+	require.NoError(t, err)
+
+	calls := 0
+	executor := &mockExecutor{
+		doFunc: func(ctx context.Context, request *httpclient.Request) (*httpclient.Response, error) {
+			calls++
+
+			body, err := json.Marshal(&llm.Response{
+				ID:      "chatcmpl-123",
+				Object:  "chat.completion",
+				Created: 1700000000,
+				Model:   "gpt-4",
+				Choices: []llm.Choice{
+					{
+						Index: 0,
+						Message: &llm.Message{
+							Role:             "assistant",
+							Content:          llm.MessageContent{Content: lo.ToPtr("Paris")},
+							ReasoningContent: lo.ToPtr("The capital of France."),
+						},
+						FinishReason: lo.ToPtr("stop"),
+					},
+				},
+				Usage: &llm.Usage{PromptTokens: 10, CompletionTokens: 1, TotalTokens: 11},
+			})
+			require.NoError(t, err)
+
+			return &httpclient.Response{StatusCode: http.StatusOK, Body: body}, nil
+		},
+	}
+
+	cache := &mapResponseCache{responses: map[string]*llm.Response{}}
+	pipe := pipeline.NewFactory(executor).Pipeline(openai.NewInboundTransformer(), outbound, pipeline.WithResponseCache(cache))
+
+	first, err := pipe.Process(ctx, newChatRequest(t, false, "capital of France?"))
+	require.NoError(t, err)
+	require.Equal(t, 1, calls)
+	require.Len(t, cache.responses, 1)
+
+	second, err := pipe.Process(ctx, newChatRequest(t, false, "capital of France?"))
+	require.NoError(t, err)
+	require.Equal(t, 1, calls)
+	require.JSONEq(t, string(first.Response.Body), string(second.Response.Body))
+
+	// The cached response is replayed as a stream for the streaming client.
+	result, err := pipe.Process(ctx, newChatRequest(t, true, "capital of France?"))
+	require.NoError(t, err)
+	require.Equal(t, 1, calls)
+	require.True(t, result.Stream)
+
+	events := collectStream(t, result.EventStream)
+	require.Equal(t, "[DONE]", string(events[len(events)-1].Data))
+
+	aggregated, _, err := openai.NewInboundTransformer().AggregateStreamChunks(ctx, events)
+	require.NoError(t, err)
+	require.Equal(t, "Paris", gjson.GetBytes(aggregated, "choices.0.message.content").String())
+	require.Equal(t, "The capital of France.", gjson.GetBytes(aggregated, "choices.0.message.reasoning_content").String())
+	require.Equal(t, "stop", gjson.GetBytes(aggregated, "choices.0.finish_reason").String())
+	require.Equal(t, int64(11), gjson.GetBytes(aggregated, "usage.total_tokens").Int())
+
+	// The different request is not served from the cache.
+	_, err = pipe.Process(ctx, newChatRequest(t, false, "capital of Germany?"))
+	require.NoError(t, err)
+	require.Equal(t, 2, calls)
+}
+
+func TestPipeline_ResponseCache_Stream(t *testing.T) {
+	ctx := context.Background()
+
+	outbound, err := openai.NewOutboundTransformer("https://api.openai.com", "test-api-key")
+	require.NoError(t, err)
+
+	streamEvents, err := xtest.LoadStreamChunks(t, "openai-tool.stream.jsonl")
+	require.NoError(t, err)
+
+	calls := 0
+	executor := &mockExecutor{
+		doStreamFunc: func(ctx context.Context, request *httpclient.Request) (streams.Stream[*httpclient.StreamEvent], error) {
+			calls++
+			return streams.SliceStream(streamEvents), nil
+		},
+	}
+
+	cache := &mapResponseCache{responses: map[string]*llm.Response{}}
+	pipe := pipeline.NewFactory(executor).Pipeline(openai.NewInboundTransformer(), outbound, pipeline.WithResponseCache(cache))
+
+	result, err := pipe.Process(ctx, newChatRequest(t, true, "What is the weather in New York City?"))
+	require.NoError(t, err)
+
+	original, _, err := openai.NewInboundTransformer().AggregateStreamChunks(ctx, collectStream(t, result.EventStream))
+	require.NoError(t, err)
+	require.Equal(t, 1, calls)
+	require.Len(t, cache.responses, 1)
+
+	cached := lo.Values(cache.responses)[0]
+	require.Len(t, cached.Choices, 1)
+	require.Equal(t, "tool_calls", *cached.Choices[0].FinishReason)
+	require.NotEmpty(t, cached.Choices[0].Message.ToolCalls)
+
+	// The aggregated stream is served to the non-streaming client.
+	nonStream, err := pipe.Process(ctx, newChatRequest(t, false, "What is the weather in New York City?"))
+	require.NoError(t, err)
+	require.Equal(t, 1, calls)
+	require.Equal(t,
+		gjson.GetBytes(original, "choices.0.message.tool_calls").Raw,
+		gjson.GetBytes(nonStream.Response.Body, "choices.0.message.tool_calls").Raw,
+	)
+
+	// The replayed stream aggregates to the same tool calls.
+	replayed, err := pipe.Process(ctx, newChatRequest(t, true, "What is the weather in New York City?"))
+	require.NoError(t, err)
+	require.Equal(t, 1, calls)
+
+	aggregated, _, err := openai.NewInboundTransformer().AggregateStreamChunks(ctx, collectStream(t, replayed.EventStream))
+	require.NoError(t, err)
+	require.JSONEq(t,
+		gjson.GetBytes(original, "choices.0.message.tool_calls").Raw,
+		gjson.GetBytes(aggregated, "choices.0.message.tool_calls").Raw,
+	)
+}
+
+func TestPipeline_ResponseCache_SkipIncomplete(t *testing.T) {
+	ctx := context.Background()
+
+	outbound, err := openai.NewOutboundTransformer("https://api.openai.com", "test-api-key")
+	require.NoError(t, err)
+
+	executor := &mockExecutor{
+		doFunc: func(ctx context.Context, request *httpclient.Request) (*httpclient.Response, error) {
+			body, err := json.Marshal(&llm.Response{
+				ID:     "chatcmpl-123",
+				Object: "chat.completion",
+				Model:  "gpt-4",
+				Choices: []llm.Choice{
+					{
+						Index:        0,
+						Message:      &llm.Message{Role: "assistant", Content: llm.MessageContent{Content: lo.ToPtr("")}},
+						FinishReason: lo.ToPtr("content_filter"),
+					},
+				},
+			})
+			require.NoError(t, err)
+
+			return &httpclient.Response{StatusCode: http.StatusOK, Body: body}, nil
+		},
+	}
+
+	cache := &mapResponseCache{responses: map[string]*llm.Response{}}
+	pipe := pipeline.NewFactory(executor).Pipeline(openai.NewInboundTransformer(), outbound, pipeline.WithResponseCache(cache))
+
+	_, err = pipe.Process(ctx, newChatRequest(t, false, "hello"))
+	require.NoError(t, err)
+	require.Empty(t, cache.responses)
+}
+
+func TestPipeline_ResponseCache_ReplayToAnthropic(t *testing.T) {
+	ctx := context.Background()
+
+	outbound, err := openai.NewOutboundTransformer("https://api.openai.com", "test-api-key")
+	require.NoError(t, err)
+
+	cache := &mapResponseCache{responses: map[string]*llm.Response{
+		"claude-3-5-sonnet:hi": {
+			ID:    "msg_123",
+			Model: "claude-3-5-sonnet",
+			Choices: []llm.Choice{
+				{
+					Index: 0,
+					Message: &llm.Message{
+						Role:             "assistant",
+						ReasoningContent: lo.ToPtr("Let me check."),
+						Content:          llm.MessageContent{Content: lo.ToPtr("Checking the weather.")},
+						ToolCalls: []llm.ToolCall{
+							{ID: "toolu_1", Type: "function", Function: llm.FunctionCall{Name: "get_weather", Arguments: `{"location":"Paris"}`}},
+						},
+					},
+					FinishReason: lo.ToPtr("tool_calls"),
+				},
+			},
+			Usage: &llm.Usage{PromptTokens: 3, CompletionTokens: 4, TotalTokens: 7},
+		},
+	}}
+
+	inbound := anthropic.NewInboundTransformer()
+	pipe := pipeline.NewFactory(&mockExecutor{}).Pipeline(inbound, outbound, pipeline.WithResponseCache(cache))
+
+	body, err := json.Marshal(map[string]any{
+		"model":      "claude-3-5-sonnet",
+		"max_tokens": 1024,
+		"stream":     true,
+		"messages":   []map[string]any{{"role": "user", "content": "hi"}},
+	})
+	require.NoError(t, err)
+
+	result, err := pipe.Process(ctx, &httpclient.Request{
+		Method:  http.MethodPost,
+		URL:     "/v1/messages",
+		Headers: http.Header{"Content-Type": []string{"application/json"}},
+		Body:    body,
+	})
+	require.NoError(t, err)
+	require.True(t, result.Stream)
+
+	aggregated, _, err := inbound.AggregateStreamChunks(ctx, collectStream(t, result.EventStream))
+	require.NoError(t, err)
+	require.Equal(t, "Let me check.", gjson.GetBytes(aggregated, "content.0.thinking").String())
+	require.Equal(t, "Checking the weather.", gjson.GetBytes(aggregated, "content.1.text").String())
+	require.Equal(t, "get_weather", gjson.GetBytes(aggregated, "content.2.name").String())
+	require.JSONEq(t, `{"location":"Paris"}`, gjson.GetBytes(aggregated, "content.2.input").Raw)
+	require.Equal(t, "tool_use", gjson.GetBytes(aggregated, "stop_reason").String())
+}
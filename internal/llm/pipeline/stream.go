@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/looplj/axonhub/internal/llm"
+	"github.com/looplj/axonhub/internal/llm/transformer"
 	"github.com/looplj/axonhub/internal/log"
 	"github.com/looplj/axonhub/internal/pkg/httpclient"
 	"github.com/looplj/axonhub/internal/pkg/streams"
@@ -11,84 +12,119 @@ import (
 )
 
 // Process executes the streaming LLM pipeline
-// Steps: apply decorators -> outbound transform -> HTTP stream -> outbound stream transform -> inbound stream transform.
+// Steps: outbound transform -> HTTP stream -> outbound stream transform -> inbound stream transform.
 func (p *pipeline) stream(
 	ctx context.Context,
 	request *llm.Request,
+	cacheKey string,
 ) (streams.Stream[*httpclient.StreamEvent], error) {
-	// Step 1: Apply decorators to the request
-	if len(p.decorators) > 0 {
-		for _, dec := range p.decorators {
-			var err error
-
-			request, err = dec.DecorateRequest(ctx, request)
-			if err != nil {
-				log.Error(ctx, "Failed to apply decorator", log.Cause(err))
-				return nil, err
-			}
-		}
+	// Step 1: Transform, execute and transform back the request of the outbound channel
+	var (
+		llmStream streams.Stream[*llm.Response]
+		err       error
+	)
+
+	if hedgeable, ok := p.Outbound.(ChannelHedgeable); ok && p.hedgeDelay > 0 {
+		llmStream, err = p.hedgedStream(ctx, hedgeable, request)
+	} else {
+		llmStream, err = p.outboundStream(ctx, p.Outbound, request)
 	}
 
-	// Step 2: Transform to provider-specific HTTP request using outbound transformer
-	httpReq, err := p.Outbound.TransformRequest(ctx, request)
 	if err != nil {
-		log.Error(ctx, "Failed to transform streaming request", log.Cause(err))
 		return nil, err
 	}
 
-	executor := p.Executor
-	if c, ok := p.Outbound.(ChannelCustomizedExecutor); ok {
-		executor = c.CustomizeExecutor(executor)
+	if shouldValidateStructuredOutput(request) {
+		llmStream = validateStructuredOutputStream(request, llmStream)
 	}
 
-	// Step 3: Execute streaming HTTP request
-	outboundStream, err := executor.DoStream(ctx, httpReq)
-	if err != nil {
-		if httpErr, ok := xerrors.As[*httpclient.Error](err); ok {
-			return nil, p.Outbound.TransformError(ctx, httpErr)
-		}
+	llmStream = p.decorateStream(ctx, llmStream)
+	llmStream = p.cacheStream(ctx, cacheKey, llmStream)
+
+	if log.DebugEnabled(ctx) {
+		llmStream = streams.Map(llmStream, func(event *llm.Response) *llm.Response {
+			log.Debug(ctx, "LLM stream event", log.Any("event", event))
+			return event
+		})
+	}
 
+	inboundStream, err := p.Inbound.TransformStream(ctx, llmStream)
+	if err != nil {
+		log.Error(ctx, "Failed to transform streaming request", log.Cause(err))
 		return nil, err
 	}
 
 	if log.DebugEnabled(ctx) {
-		outboundStream = streams.Map(
-			outboundStream,
+		inboundStream = streams.Map(
+			inboundStream,
 			func(event *httpclient.StreamEvent) *httpclient.StreamEvent {
-				log.Debug(ctx, "Outbound stream event", log.Any("event", event))
+				log.Debug(ctx, "Inbound stream event", log.Any("event", event))
 				return event
 			},
 		)
 	}
 
-	llmStream, err := p.Outbound.TransformStream(ctx, outboundStream)
+	return inboundStream, nil
+}
+
+// outboundStream sends the streaming request with the outbound transformer and returns the transformed llm stream.
+func (p *pipeline) outboundStream(
+	ctx context.Context,
+	outbound transformer.Outbound,
+	request *llm.Request,
+) (streams.Stream[*llm.Response], error) {
+	// Transform to provider-specific HTTP request using outbound transformer
+	ctx = llm.NewRequestContext(ctx, request)
+
+	httpReq, err := outbound.TransformRequest(ctx, request)
 	if err != nil {
 		log.Error(ctx, "Failed to transform streaming request", log.Cause(err))
 		return nil, err
 	}
 
-	if log.DebugEnabled(ctx) {
-		llmStream = streams.Map(llmStream, func(event *llm.Response) *llm.Response {
-			log.Debug(ctx, "LLM stream event", log.Any("event", event))
-			return event
-		})
+	return p.executeStream(ctx, outbound, httpReq)
+}
+
+// executeStream executes the streaming HTTP request and transforms the events with the outbound transformer.
+func (p *pipeline) executeStream(
+	ctx context.Context,
+	outbound transformer.Outbound,
+	httpReq *httpclient.Request,
+) (streams.Stream[*llm.Response], error) {
+	executor := p.Executor
+	if c, ok := outbound.(ChannelCustomizedExecutor); ok {
+		executor = c.CustomizeExecutor(executor)
 	}
 
-	inboundStream, err := p.Inbound.TransformStream(ctx, llmStream)
+	if httpReq.Fanout > 1 {
+		executor = newFanoutExecutor(executor)
+	}
+
+	// Execute streaming HTTP request
+	outboundStream, err := executor.DoStream(ctx, httpReq)
 	if err != nil {
-		log.Error(ctx, "Failed to transform streaming request", log.Cause(err))
+		if httpErr, ok := xerrors.As[*httpclient.Error](err); ok {
+			return nil, outbound.TransformError(ctx, httpErr)
+		}
+
 		return nil, err
 	}
 
 	if log.DebugEnabled(ctx) {
-		inboundStream = streams.Map(
-			inboundStream,
+		outboundStream = streams.Map(
+			outboundStream,
 			func(event *httpclient.StreamEvent) *httpclient.StreamEvent {
-				log.Debug(ctx, "Inbound stream event", log.Any("event", event))
+				log.Debug(ctx, "Outbound stream event", log.Any("event", event))
 				return event
 			},
 		)
 	}
 
-	return inboundStream, nil
+	llmStream, err := outbound.TransformStream(ctx, outboundStream)
+	if err != nil {
+		log.Error(ctx, "Failed to transform streaming request", log.Cause(err))
+		return nil, err
+	}
+
+	return llmStream, nil
 }
@@ -0,0 +1,117 @@
+package pipeline
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/looplj/axonhub/internal/llm"
+	"github.com/looplj/axonhub/internal/pkg/jsonschema"
+	"github.com/looplj/axonhub/internal/pkg/streams"
+)
+
+// shouldValidateStructuredOutput returns true if the client requires the strict json schema response format.
+func shouldValidateStructuredOutput(request *llm.Request) bool {
+	if !request.ResponseFormat.IsJSONSchema() {
+		return false
+	}
+
+	jsonSchema := request.ResponseFormat.JSONSchema
+
+	return jsonSchema.Strict != nil && *jsonSchema.Strict && len(jsonSchema.Schema) > 0
+}
+
+// validateStructuredOutput validates the final content of the choices against the json schema.
+// Only the choices finished normally are validated, the tool calls and the truncated content are skipped.
+func validateStructuredOutput(request *llm.Request, index int, content string) error {
+	err := jsonschema.Validate(request.ResponseFormat.JSONSchema.Schema, []byte(content))
+	if err == nil {
+		return nil
+	}
+
+	return &llm.ResponseError{
+		StatusCode: http.StatusBadGateway,
+		Detail: llm.ErrorDetail{
+			Type:    "invalid_response_error",
+			Code:    "json_schema_validation_failed",
+			Message: fmt.Sprintf("the content of choice %d does not match the json schema %q: %v", index, request.ResponseFormat.JSONSchema.Name, err),
+		},
+	}
+}
+
+func validateStructuredOutputResponse(request *llm.Request, response *llm.Response) error {
+	for _, choice := range response.Choices {
+		if choice.Message == nil || !isStopFinishReason(choice.FinishReason) || len(choice.Message.ToolCalls) > 0 {
+			continue
+		}
+
+		if err := validateStructuredOutput(request, choice.Index, messageText(choice.Message.Content)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateStructuredOutputStream accumulates the content of the stream and validates it when the choice finishes.
+func validateStructuredOutputStream(request *llm.Request, stream streams.Stream[*llm.Response]) streams.Stream[*llm.Response] {
+	contents := map[int]*strings.Builder{}
+	toolCalls := map[int]bool{}
+
+	return streams.MapErr(stream, func(response *llm.Response) (*llm.Response, error) {
+		if response == nil || response == llm.DoneResponse {
+			return response, nil
+		}
+
+		for _, choice := range response.Choices {
+			if choice.Delta != nil {
+				if len(choice.Delta.ToolCalls) > 0 {
+					toolCalls[choice.Index] = true
+				}
+
+				if text := messageText(choice.Delta.Content); text != "" {
+					if contents[choice.Index] == nil {
+						contents[choice.Index] = &strings.Builder{}
+					}
+
+					contents[choice.Index].WriteString(text)
+				}
+			}
+
+			if !isStopFinishReason(choice.FinishReason) || toolCalls[choice.Index] {
+				continue
+			}
+
+			var content string
+			if contents[choice.Index] != nil {
+				content = contents[choice.Index].String()
+			}
+
+			if err := validateStructuredOutput(request, choice.Index, content); err != nil {
+				return nil, err
+			}
+		}
+
+		return response, nil
+	})
+}
+
+func isStopFinishReason(reason *string) bool {
+	return reason != nil && *reason == "stop"
+}
+
+func messageText(content llm.MessageContent) string {
+	if content.Content != nil {
+		return *content.Content
+	}
+
+	var sb strings.Builder
+
+	for _, part := range content.MultipleContent {
+		if part.Type == "text" && part.Text != nil {
+			sb.WriteString(*part.Text)
+		}
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,104 @@
+package pipeline
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/looplj/axonhub/internal/llm"
+	"github.com/looplj/axonhub/internal/pkg/streams"
+)
+
+func newStrictRequest(strict bool) *llm.Request {
+	return &llm.Request{
+		ResponseFormat: &llm.ResponseFormat{
+			Type: "json_schema",
+			JSONSchema: &llm.JSONSchema{
+				Name:   "user",
+				Schema: json.RawMessage(`{"type":"object","properties":{"name":{"type":"string"}},"required":["name"]}`),
+				Strict: lo.ToPtr(strict),
+			},
+		},
+	}
+}
+
+func TestShouldValidateStructuredOutput(t *testing.T) {
+	require.True(t, shouldValidateStructuredOutput(newStrictRequest(true)))
+	require.False(t, shouldValidateStructuredOutput(newStrictRequest(false)))
+	require.False(t, shouldValidateStructuredOutput(&llm.Request{}))
+	require.False(t, shouldValidateStructuredOutput(&llm.Request{ResponseFormat: &llm.ResponseFormat{Type: "json_object"}}))
+}
+
+func TestValidateStructuredOutputResponse(t *testing.T) {
+	request := newStrictRequest(true)
+
+	newResponse := func(content, finishReason string) *llm.Response {
+		return &llm.Response{
+			Choices: []llm.Choice{
+				{
+					Message:      &llm.Message{Role: "assistant", Content: llm.MessageContent{Content: lo.ToPtr(content)}},
+					FinishReason: lo.ToPtr(finishReason),
+				},
+			},
+		}
+	}
+
+	require.NoError(t, validateStructuredOutputResponse(request, newResponse(`{"name":"alice"}`, "stop")))
+	// The truncated content is not validated.
+	require.NoError(t, validateStructuredOutputResponse(request, newResponse(`{"name":`, "length")))
+
+	err := validateStructuredOutputResponse(request, newResponse(`{"age":1}`, "stop"))
+	require.Error(t, err)
+
+	var respErr *llm.ResponseError
+	require.ErrorAs(t, err, &respErr)
+	require.Equal(t, 502, respErr.StatusCode)
+	require.Equal(t, "json_schema_validation_failed", respErr.Detail.Code)
+	require.Contains(t, respErr.Detail.Message, `missing required property "name"`)
+}
+
+func TestValidateStructuredOutputStream(t *testing.T) {
+	request := newStrictRequest(true)
+
+	newStream := func(deltas ...string) streams.Stream[*llm.Response] {
+		responses := lo.Map(deltas, func(delta string, _ int) *llm.Response {
+			return &llm.Response{
+				Choices: []llm.Choice{
+					{Delta: &llm.Message{Content: llm.MessageContent{Content: lo.ToPtr(delta)}}},
+				},
+			}
+		})
+		responses = append(responses,
+			&llm.Response{Choices: []llm.Choice{{FinishReason: lo.ToPtr("stop")}}},
+			llm.DoneResponse,
+		)
+
+		return validateStructuredOutputStream(request, streams.SliceStream(responses))
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		stream := newStream(`{"name":`, `"alice"}`)
+
+		count := 0
+		for stream.Next() {
+			count++
+		}
+
+		require.NoError(t, stream.Err())
+		require.Equal(t, 4, count)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		stream := newStream(`{"age":`, `1}`)
+
+		count := 0
+		for stream.Next() {
+			count++
+		}
+
+		require.Equal(t, 2, count)
+		require.ErrorContains(t, stream.Err(), "does not match the json schema")
+	})
+}
@@ -0,0 +1,228 @@
+package tokenizer
+
+import (
+	"bufio"
+	"container/heap"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// encodingPatterns are the pre-tokenization patterns of the tiktoken encodings.
+// The `\s+(?!\S)` alternative of the original patterns is not supported by the Go regexp,
+// it is emulated in the split function.
+var encodingPatterns = map[string]string{
+	"cl100k_base": `(?i:'s|'t|'re|'ve|'m|'ll|'d)|[^\r\n\p{L}\p{N}]?\p{L}+|\p{N}{1,3}| ?[^\s\p{L}\p{N}]+[\r\n]*|\s*[\r\n]+|\s+`,
+	"o200k_base": `[^\r\n\p{L}\p{N}]?[\p{Lu}\p{Lt}\p{Lm}\p{Lo}\p{M}]*[\p{Ll}\p{Lm}\p{Lo}\p{M}]+(?i:'s|'t|'re|'ve|'m|'ll|'d)?` +
+		`|[^\r\n\p{L}\p{N}]?[\p{Lu}\p{Lt}\p{Lm}\p{Lo}\p{M}]+[\p{Ll}\p{Lm}\p{Lo}\p{M}]*(?i:'s|'t|'re|'ve|'m|'ll|'d)?` +
+		`|\p{N}{1,3}| ?[^\s\p{L}\p{N}]+[\r\n/]*|\s*[\r\n]+|\s+`,
+}
+
+// BPE is the byte pair encoding tokenizer compatible with tiktoken.
+type BPE struct {
+	name    string
+	ranks   map[string]int
+	pattern *regexp.Regexp
+}
+
+// LoadBPE loads the BPE ranks in the tiktoken format, each line is the base64 encoded token and its rank.
+func LoadBPE(name string, r io.Reader, pattern string) (*BPE, error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile pattern: %w", err)
+	}
+
+	ranks := map[string]int{}
+	scanner := bufio.NewScanner(r)
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		token, rank, found := strings.Cut(line, " ")
+		if !found {
+			return nil, fmt.Errorf("invalid line %q", line)
+		}
+
+		decoded, err := base64.StdEncoding.DecodeString(token)
+		if err != nil {
+			return nil, fmt.Errorf("invalid token %q: %w", token, err)
+		}
+
+		value, err := strconv.Atoi(rank)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rank %q: %w", rank, err)
+		}
+
+		ranks[string(decoded)] = value
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return &BPE{
+		name:    name,
+		ranks:   ranks,
+		pattern: re,
+	}, nil
+}
+
+func (e *BPE) Name() string {
+	return e.name
+}
+
+func (e *BPE) Count(text string) int {
+	count := 0
+	for _, piece := range e.split(text) {
+		count += e.countPiece(piece)
+	}
+
+	return count
+}
+
+// split splits the text into the pieces with the pre-tokenization pattern.
+func (e *BPE) split(text string) []string {
+	var pieces []string
+
+	for len(text) > 0 {
+		loc := e.pattern.FindStringIndex(text)
+		if loc == nil || loc[1] == loc[0] {
+			_, size := utf8.DecodeRuneInString(text)
+			loc = []int{0, size}
+		}
+
+		if loc[0] > 0 {
+			pieces = append(pieces, text[:loc[0]])
+		}
+
+		end := loc[1]
+		piece := text[loc[0]:end]
+
+		// Emulate `\s+(?!\S)`: the last whitespace before a non-whitespace character is left to the next piece.
+		if end < len(text) && isSpaces(piece) && !strings.HasSuffix(piece, "\n") && !strings.HasSuffix(piece, "\r") {
+			if _, size := utf8.DecodeLastRuneInString(piece); size < len(piece) {
+				end -= size
+				piece = piece[:len(piece)-size]
+			}
+		}
+
+		pieces = append(pieces, piece)
+		text = text[end:]
+	}
+
+	return pieces
+}
+
+// countPiece counts the tokens of the piece by merging the byte pairs with the lowest rank first,
+// the candidate pairs are kept in a heap, so the long pieces are counted in O(n log n) time.
+func (e *BPE) countPiece(piece string) int {
+	if _, ok := e.ranks[piece]; ok {
+		return 1
+	}
+
+	n := len(piece)
+
+	// The parts are linked by their start offsets, the part i is piece[i:next[i]].
+	next := make([]int, n)
+	prev := make([]int, n)
+	merged := make([]bool, n)
+
+	for i := range n {
+		next[i] = i + 1
+		prev[i] = i - 1
+	}
+
+	candidates := &mergeHeap{}
+	push := func(left int) {
+		if left < 0 || next[left] >= n {
+			return
+		}
+
+		right := next[left]
+		end := next[right]
+
+		if rank, ok := e.ranks[piece[left:end]]; ok {
+			heap.Push(candidates, mergeCandidate{rank: rank, left: left, right: right, end: end})
+		}
+	}
+
+	for i := range n {
+		push(i)
+	}
+
+	parts := n
+
+	for candidates.Len() > 0 {
+		candidate := heap.Pop(candidates).(mergeCandidate)
+
+		// Skip the stale candidate whose parts were merged with the others.
+		if merged[candidate.left] || next[candidate.left] != candidate.right || next[candidate.right] != candidate.end {
+			continue
+		}
+
+		merged[candidate.right] = true
+		next[candidate.left] = candidate.end
+
+		if candidate.end < n {
+			prev[candidate.end] = candidate.left
+		}
+
+		parts--
+
+		push(prev[candidate.left])
+		push(candidate.left)
+	}
+
+	return parts
+}
+
+// mergeCandidate is the pair of the adjacent parts piece[left:right] and piece[right:end].
+type mergeCandidate struct {
+	rank  int
+	left  int
+	right int
+	end   int
+}
+
+// mergeHeap orders the candidates by the rank, and the leftmost pair first for the same rank.
+type mergeHeap []mergeCandidate
+
+func (h mergeHeap) Len() int { return len(h) }
+
+func (h mergeHeap) Less(i, j int) bool {
+	if h[i].rank != h[j].rank {
+		return h[i].rank < h[j].rank
+	}
+
+	return h[i].left < h[j].left
+}
+
+func (h mergeHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *mergeHeap) Push(x any) { *h = append(*h, x.(mergeCandidate)) }
+
+func (h *mergeHeap) Pop() any {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+
+	return x
+}
+
+func isSpaces(s string) bool {
+	for _, r := range s {
+		if !unicode.IsSpace(r) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,101 @@
+package tokenizer
+
+import (
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// testRanks builds a tiny rank file in the tiktoken format: all the single bytes and a few merges.
+func testRanks() string {
+	var sb strings.Builder
+
+	for b := range 256 {
+		fmt.Fprintf(&sb, "%s %d\n", base64.StdEncoding.EncodeToString([]byte{byte(b)}), b)
+	}
+
+	for i, token := range []string{"he", "ll", "hell", "hello", " w", " wo"} {
+		fmt.Fprintf(&sb, "%s %d\n", base64.StdEncoding.EncodeToString([]byte(token)), 256+i)
+	}
+
+	return sb.String()
+}
+
+func TestBPE_Count(t *testing.T) {
+	encoding, err := LoadBPE("cl100k_base", strings.NewReader(testRanks()), encodingPatterns["cl100k_base"])
+	require.NoError(t, err)
+
+	require.Equal(t, 0, encoding.Count(""))
+	require.Equal(t, 1, encoding.Count("hello"))
+	// "hello" + " wo" "r" "l" "d"
+	require.Equal(t, 5, encoding.Count("hello world"))
+	// The pairs with the lowest rank are merged first.
+	require.Equal(t, 2, encoding.Count("hellohello"))
+	// The multi-byte characters without merges are counted by bytes.
+	require.Equal(t, 3, encoding.Count("中"))
+}
+
+func TestBPE_CountLongPiece(t *testing.T) {
+	encoding, err := LoadBPE("cl100k_base", strings.NewReader(testRanks()), encodingPatterns["cl100k_base"])
+	require.NoError(t, err)
+
+	// The long piece without spaces is counted without the quadratic merges.
+	require.Equal(t, 100000, encoding.Count(strings.Repeat("hello", 100000)))
+	require.Equal(t, 100000, encoding.Count(strings.Repeat("ll", 100000)))
+}
+
+func TestBPE_Split(t *testing.T) {
+	for name, pattern := range encodingPatterns {
+		t.Run(name, func(t *testing.T) {
+			encoding, err := LoadBPE(name, strings.NewReader(""), pattern)
+			require.NoError(t, err)
+
+			require.Equal(t, []string{"hello", "  ", " world"}, encoding.split("hello   world"))
+			require.Equal(t, []string{" here", "\n\n", " ", " ok"}, encoding.split(" here\n\n  ok"))
+			require.Equal(t, []string{"123", "45", " ", " ", "6", "   "}, encoding.split("12345  6   "))
+			require.Equal(t, []string{"a", " +=", " b", ";"}, encoding.split("a += b;"))
+		})
+	}
+}
+
+func TestBPE_SplitContractions(t *testing.T) {
+	cl100k, err := LoadBPE("cl100k_base", strings.NewReader(""), encodingPatterns["cl100k_base"])
+	require.NoError(t, err)
+	require.Equal(t, []string{"I", "'m", " fine"}, cl100k.split("I'm fine"))
+
+	o200k, err := LoadBPE("o200k_base", strings.NewReader(""), encodingPatterns["o200k_base"])
+	require.NoError(t, err)
+	require.Equal(t, []string{"I'm", " fine"}, o200k.split("I'm fine"))
+}
+
+func TestLoadBPE_Invalid(t *testing.T) {
+	_, err := LoadBPE("test", strings.NewReader("aGVsbG8="), encodingPatterns["cl100k_base"])
+	require.ErrorContains(t, err, "invalid line")
+
+	_, err = LoadBPE("test", strings.NewReader("aGVsbG8= x"), encodingPatterns["cl100k_base"])
+	require.ErrorContains(t, err, "invalid rank")
+}
+
+func TestRegistry_ForModel(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "cl100k_base.tiktoken"), []byte(testRanks()), 0o600))
+
+	registry, err := New(Config{EncodingsDir: dir})
+	require.NoError(t, err)
+
+	require.Equal(t, "cl100k_base", registry.ForModel("gpt-4-turbo").Name())
+	require.Equal(t, "cl100k_base", registry.ForModel("openai/gpt-3.5-turbo").Name())
+	// The o200k_base encoding is not provided.
+	require.Equal(t, "estimate/openai", registry.ForModel("gpt-4o").Name())
+	require.Equal(t, "estimate/claude", registry.ForModel("claude-sonnet-4-20250514").Name())
+	require.Equal(t, "estimate/gemini", registry.ForModel("gemini-2.5-pro").Name())
+	require.Equal(t, "estimate/default", registry.ForModel("deepseek-chat").Name())
+
+	var nilRegistry *Registry
+	require.Equal(t, "estimate/openai", nilRegistry.ForModel("gpt-4").Name())
+}
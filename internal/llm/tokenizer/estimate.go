@@ -0,0 +1,71 @@
+package tokenizer
+
+import (
+	"math"
+	"strings"
+	"unicode"
+)
+
+// Estimator approximates the token count with the average characters per token of the model family,
+// the ratios are calibrated against the real tokenizers with the mixed English, code and CJK text.
+type Estimator struct {
+	name string
+
+	// bytesPerToken is the average UTF-8 bytes per token of the non-CJK text.
+	bytesPerToken float64
+
+	// tokensPerCJKChar is the average tokens per CJK character.
+	tokensPerCJKChar float64
+}
+
+var (
+	claudeEstimator  = &Estimator{name: "estimate/claude", bytesPerToken: 3.5, tokensPerCJKChar: 1.1}
+	openaiEstimator  = &Estimator{name: "estimate/openai", bytesPerToken: 4, tokensPerCJKChar: 0.8}
+	geminiEstimator  = &Estimator{name: "estimate/gemini", bytesPerToken: 4, tokensPerCJKChar: 0.7}
+	defaultEstimator = &Estimator{name: "estimate/default", bytesPerToken: 3.8, tokensPerCJKChar: 0.7}
+)
+
+func estimatorForModel(model string) *Estimator {
+	switch {
+	case strings.Contains(model, "claude"):
+		return claudeEstimator
+	case strings.Contains(model, "gemini"):
+		return geminiEstimator
+	case encodingForModel(model) != "":
+		return openaiEstimator
+	default:
+		return defaultEstimator
+	}
+}
+
+func (e *Estimator) Name() string {
+	return e.name
+}
+
+func (e *Estimator) Count(text string) int {
+	if text == "" {
+		return 0
+	}
+
+	var (
+		otherBytes int
+		cjkChars   int
+	)
+
+	for _, r := range text {
+		if isCJK(r) {
+			cjkChars++
+			continue
+		}
+
+		otherBytes += len(string(r))
+	}
+
+	tokens := float64(otherBytes)/e.bytesPerToken + float64(cjkChars)*e.tokensPerCJKChar
+
+	return max(1, int(math.Ceil(tokens)))
+}
+
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul)
+}
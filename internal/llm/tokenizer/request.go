@@ -0,0 +1,145 @@
+package tokenizer
+
+import (
+	"encoding/base64"
+	"strings"
+
+	"github.com/looplj/axonhub/internal/llm"
+)
+
+const (
+	// tokensPerMessage is the overhead of the role and the separators of each message.
+	tokensPerMessage = 3
+	// tokensPerReply is the overhead of priming the assistant reply.
+	tokensPerReply = 3
+	// tokensPerTool is the overhead of each tool definition.
+	tokensPerTool = 8
+	// tokensPerImage is the approximate tokens of an image with the default detail.
+	tokensPerImage = 765
+	// tokensPerLowDetailImage is the tokens of an image with the low detail.
+	tokensPerLowDetailImage = 85
+)
+
+// CountRequest counts the prompt tokens of the request, including the messages and the tool definitions.
+func CountRequest(tk Tokenizer, req *llm.Request) int {
+	if req == nil {
+		return 0
+	}
+
+	count := tokensPerReply
+
+	for _, message := range req.Messages {
+		count += tokensPerMessage + tk.Count(message.Role) + countMessage(tk, message)
+
+		if message.Name != nil {
+			count += tk.Count(*message.Name) + 1
+		}
+	}
+
+	for _, tool := range req.Tools {
+		count += tokensPerTool + tk.Count(tool.Function.Name) + tk.Count(tool.Function.Description) + tk.Count(string(tool.Function.Parameters))
+	}
+
+	return count
+}
+
+// CountResponse counts the completion tokens of the response, including all the choices.
+func CountResponse(tk Tokenizer, resp *llm.Response) int {
+	if resp == nil {
+		return 0
+	}
+
+	count := 0
+
+	for _, choice := range resp.Choices {
+		message := choice.Message
+		if message == nil {
+			message = choice.Delta
+		}
+
+		if message != nil {
+			count += countMessage(tk, *message)
+		}
+	}
+
+	return count
+}
+
+// EstimateUsage estimates the usage of the request and the response, the usage is flagged as estimated.
+func EstimateUsage(tk Tokenizer, req *llm.Request, resp *llm.Response) *llm.Usage {
+	promptTokens := CountRequest(tk, req)
+	completionTokens := CountResponse(tk, resp)
+
+	return &llm.Usage{
+		PromptTokens:     promptTokens,
+		CompletionTokens: completionTokens,
+		TotalTokens:      promptTokens + completionTokens,
+		Estimated:        true,
+	}
+}
+
+func countMessage(tk Tokenizer, message llm.Message) int {
+	count := countContent(tk, message.Content)
+
+	if message.ReasoningContent != nil {
+		count += tk.Count(*message.ReasoningContent)
+	}
+
+	if message.Refusal != "" {
+		count += tk.Count(message.Refusal)
+	}
+
+	for _, toolCall := range message.ToolCalls {
+		count += tk.Count(toolCall.Function.Name) + tk.Count(toolCall.Function.Arguments)
+	}
+
+	if message.Audio != nil {
+		count += tk.Count(message.Audio.Transcript)
+	}
+
+	return count
+}
+
+func countContent(tk Tokenizer, content llm.MessageContent) int {
+	if content.Content != nil {
+		return tk.Count(*content.Content)
+	}
+
+	count := 0
+
+	for _, part := range content.MultipleContent {
+		switch part.Type {
+		case "text":
+			if part.Text != nil {
+				count += tk.Count(*part.Text)
+			}
+		case "image_url":
+			if part.ImageURL != nil && part.ImageURL.Detail == "low" {
+				count += tokensPerLowDetailImage
+			} else {
+				count += tokensPerImage
+			}
+		case "file":
+			if part.File != nil {
+				count += tk.Count(fileText(part.File.FileData))
+			}
+		}
+	}
+
+	return count
+}
+
+// fileText returns the text of the plain text files, the binary files are not counted.
+func fileText(fileData string) string {
+	header, data, found := strings.Cut(fileData, ",")
+	if !found || !strings.HasPrefix(header, "data:text/") || !strings.HasSuffix(header, ";base64") {
+		return ""
+	}
+
+	text, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return ""
+	}
+
+	return string(text)
+}
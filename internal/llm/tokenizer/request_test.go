@@ -0,0 +1,74 @@
+package tokenizer
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/looplj/axonhub/internal/llm"
+)
+
+func TestEstimator_Count(t *testing.T) {
+	require.Equal(t, 0, claudeEstimator.Count(""))
+	require.Equal(t, 1, claudeEstimator.Count("a"))
+	// 35 bytes / 3.5 bytes per token.
+	require.Equal(t, 10, claudeEstimator.Count("The quick brown fox jumps over dogs"))
+	// 4 CJK characters * 1.1 tokens per character.
+	require.Equal(t, 5, claudeEstimator.Count("你好世界"))
+	require.Equal(t, 3, defaultEstimator.Count("你好世界"))
+}
+
+// countingTokenizer counts one token per byte, so the expected counts are easy to compute.
+type countingTokenizer struct{}
+
+func (countingTokenizer) Name() string { return "bytes" }
+
+func (countingTokenizer) Count(text string) int { return len(text) }
+
+func TestCountRequest(t *testing.T) {
+	req := &llm.Request{
+		Model: "gpt-4o",
+		Messages: []llm.Message{
+			{Role: "system", Content: llm.MessageContent{Content: lo.ToPtr("be nice")}},
+			{Role: "user", Content: llm.MessageContent{MultipleContent: []llm.MessageContentPart{
+				{Type: "text", Text: lo.ToPtr("what")},
+				{Type: "image_url", ImageURL: &llm.ImageURL{URL: "https://example.com/a.png", Detail: "low"}},
+				{Type: "file", File: &llm.File{FileData: "data:text/plain;base64,aGVsbG8="}},
+				{Type: "file", File: &llm.File{FileData: "data:application/pdf;base64,JVBERi0="}},
+			}}},
+			{Role: "assistant", ToolCalls: []llm.ToolCall{{Function: llm.FunctionCall{Name: "get", Arguments: `{}`}}}},
+		},
+		Tools: []llm.Tool{
+			{Type: "function", Function: llm.Function{Name: "get", Parameters: json.RawMessage(`{}`)}},
+		},
+	}
+
+	expected := tokensPerReply +
+		tokensPerMessage + len("system") + len("be nice") +
+		tokensPerMessage + len("user") + len("what") + tokensPerLowDetailImage + len("hello") +
+		tokensPerMessage + len("assistant") + len("get") + len("{}") +
+		tokensPerTool + len("get") + len("{}")
+
+	require.Equal(t, expected, CountRequest(countingTokenizer{}, req))
+	require.Equal(t, 0, CountRequest(countingTokenizer{}, nil))
+}
+
+func TestEstimateUsage(t *testing.T) {
+	req := &llm.Request{
+		Messages: []llm.Message{{Role: "user", Content: llm.MessageContent{Content: lo.ToPtr("hi")}}},
+	}
+	resp := &llm.Response{
+		Choices: []llm.Choice{
+			{Message: &llm.Message{Content: llm.MessageContent{Content: lo.ToPtr("hello")}, ReasoningContent: lo.ToPtr("think")}},
+			{Index: 1, Message: &llm.Message{Audio: &llm.MessageAudio{Transcript: "audio"}}},
+		},
+	}
+
+	usage := EstimateUsage(countingTokenizer{}, req, resp)
+	require.True(t, usage.Estimated)
+	require.Equal(t, tokensPerReply+tokensPerMessage+len("user")+len("hi"), usage.PromptTokens)
+	require.Equal(t, len("hello")+len("think")+len("audio"), usage.CompletionTokens)
+	require.Equal(t, usage.PromptTokens+usage.CompletionTokens, usage.TotalTokens)
+}
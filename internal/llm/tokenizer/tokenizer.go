@@ -0,0 +1,106 @@
+package tokenizer
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Tokenizer counts the tokens of the text.
+type Tokenizer interface {
+	// Name returns the name of the tokenizer, e.g. "o200k_base" or "estimate/claude".
+	Name() string
+
+	// Count returns the number of tokens of the text.
+	Count(text string) int
+}
+
+// Config is the config of the tokenizers.
+type Config struct {
+	// EncodingsDir is the directory of the tiktoken BPE rank files, e.g. "o200k_base.tiktoken" and "cl100k_base.tiktoken".
+	// The tokens of the OpenAI models are estimated with the calibrated approximations if the files are not provided.
+	EncodingsDir string `conf:"encodings_dir" yaml:"encodings_dir" json:"encodings_dir"`
+}
+
+// Registry resolves the tokenizer of the models.
+// The nil registry is valid, it uses the approximations for all models.
+type Registry struct {
+	encodings map[string]*BPE
+}
+
+// New creates the registry and loads the BPE encodings found in the encodings dir.
+func New(config Config) (*Registry, error) {
+	registry := &Registry{
+		encodings: map[string]*BPE{},
+	}
+
+	if config.EncodingsDir == "" {
+		return registry, nil
+	}
+
+	for name, pattern := range encodingPatterns {
+		file, err := os.Open(filepath.Join(config.EncodingsDir, name+".tiktoken"))
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+
+			return nil, fmt.Errorf("failed to open encoding %s: %w", name, err)
+		}
+
+		encoding, err := LoadBPE(name, file, pattern)
+		_ = file.Close()
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to load encoding %s: %w", name, err)
+		}
+
+		registry.encodings[name] = encoding
+	}
+
+	return registry, nil
+}
+
+// ForModel returns the tokenizer of the model, the exact BPE encoding is used if it is loaded,
+// otherwise the approximation of the model family is used.
+func (r *Registry) ForModel(model string) Tokenizer {
+	model = normalizeModel(model)
+
+	if r != nil {
+		if encoding, ok := r.encodings[encodingForModel(model)]; ok {
+			return encoding
+		}
+	}
+
+	return estimatorForModel(model)
+}
+
+// normalizeModel removes the provider prefix of the model, e.g. "openai/gpt-4o" to "gpt-4o".
+func normalizeModel(model string) string {
+	model = strings.ToLower(model)
+	if _, name, found := strings.Cut(model, "/"); found {
+		return name
+	}
+
+	return model
+}
+
+// encodingForModel returns the tiktoken encoding name of the OpenAI models, empty for other models.
+func encodingForModel(model string) string {
+	for _, prefix := range []string{"gpt-4o", "chatgpt-4o", "gpt-4.1", "gpt-4.5", "gpt-5", "gpt-oss", "o1", "o3", "o4"} {
+		if strings.HasPrefix(model, prefix) {
+			return "o200k_base"
+		}
+	}
+
+	for _, prefix := range []string{"gpt-4", "gpt-3.5", "text-embedding-3", "text-embedding-ada-002"} {
+		if strings.HasPrefix(model, prefix) {
+			return "cl100k_base"
+		}
+	}
+
+	return ""
+}
@@ -55,7 +55,7 @@ func convertToLLMRequestWithOptions(req *Request, options *ConvertToLLMRequestOp
 		}
 	}
 
-	// Helper: map file part to LLM content part (image only for now)
+	// Helper: map file part to LLM content part, images via image_url and other files (e.g. PDF) via file
 	toContentPartFromFile := func(p UIMessagePart) *llm.MessageContentPart {
 		if p.URL == "" {
 			return nil
@@ -68,7 +68,13 @@ func convertToLLMRequestWithOptions(req *Request, options *ConvertToLLMRequestOp
 			}
 		}
 
-		return nil
+		return &llm.MessageContentPart{
+			Type: "file",
+			File: &llm.File{
+				FileData: p.URL,
+				Filename: p.Filename,
+			},
+		}
 	}
 
 	// Helper: compact RawMessage to string
@@ -205,6 +205,36 @@ func TestConvertToLLMRequestComprehensive_UserMessage(t *testing.T) {
 		assert.Equal(t, "https://example.com/image.jpg", imagePart.ImageURL.URL)
 	})
 
+	t.Run("user message with pdf file", func(t *testing.T) {
+		req := &Request{
+			Model: "gpt-4",
+			Messages: []UIMessage{
+				{
+					Role: "user",
+					Parts: []UIMessagePart{
+						{
+							Type:      "file",
+							MediaType: "application/pdf",
+							URL:       "data:application/pdf;base64,JVBERi0xLjQ=",
+							Filename:  "report.pdf",
+						},
+					},
+				},
+			},
+		}
+
+		result, err := convertToLLMRequest(req)
+		require.NoError(t, err)
+		require.Len(t, result.Messages, 1)
+		require.Len(t, result.Messages[0].Content.MultipleContent, 1)
+
+		filePart := result.Messages[0].Content.MultipleContent[0]
+		assert.Equal(t, "file", filePart.Type)
+		require.NotNil(t, filePart.File)
+		assert.Equal(t, "data:application/pdf;base64,JVBERi0xLjQ=", filePart.File.FileData)
+		assert.Equal(t, "report.pdf", filePart.File.Filename)
+	})
+
 	t.Run("user message from content string", func(t *testing.T) {
 		req := &Request{
 			Model: "gpt-4",
@@ -7,18 +7,70 @@ import (
 
 	"github.com/kaptinlin/jsonrepair"
 	"github.com/samber/lo"
+	"github.com/tidwall/gjson"
 
 	"github.com/looplj/axonhub/internal/llm"
+	"github.com/looplj/axonhub/internal/llm/pipeline"
 	"github.com/looplj/axonhub/internal/log"
 	"github.com/looplj/axonhub/internal/pkg/httpclient"
 )
 
-//nolint:maintidx // TODO: fix this.
 func AggregateStreamChunks(ctx context.Context, chunks []*httpclient.StreamEvent) ([]byte, llm.ResponseMeta, error) {
 	if len(chunks) == 0 {
 		return nil, llm.ResponseMeta{}, errors.New("empty stream chunks")
 	}
 
+	// The chunks of the fanout request are aggregated to the array of the messages, one message for each choice.
+	choiceChunks := lo.GroupBy(chunks, func(chunk *httpclient.StreamEvent) int {
+		return int(gjson.GetBytes(chunk.Data, pipeline.ChoiceIndexField).Int())
+	})
+	if len(choiceChunks) > 1 {
+		messages := make([]*Message, len(choiceChunks))
+
+		var usage *llm.Usage
+
+		for index := range messages {
+			messages[index] = aggregateMessage(ctx, choiceChunks[index])
+			if messages[index].Usage != nil {
+				usage = addUsage(usage, lo.ToPtr(convertToLlmUsage(*messages[index].Usage)))
+			}
+		}
+
+		data, err := json.Marshal(messages)
+		if err != nil {
+			return nil, llm.ResponseMeta{}, err
+		}
+
+		return data, llm.ResponseMeta{
+			ID:    messages[0].ID,
+			Usage: usage,
+		}, nil
+	}
+
+	message := aggregateMessage(ctx, chunks)
+
+	data, err := json.Marshal(message)
+	if err != nil {
+		return nil, llm.ResponseMeta{}, err
+	}
+
+	// Convert and return usage if available
+	if message.Usage != nil {
+		return data, llm.ResponseMeta{
+			ID:    message.ID,
+			Usage: lo.ToPtr(convertToLlmUsage(*message.Usage)),
+		}, nil
+	}
+
+	return data, llm.ResponseMeta{
+		ID: message.ID,
+	}, nil
+}
+
+// aggregateMessage aggregates the stream chunks of a message.
+//
+//nolint:maintidx // TODO: fix this.
+func aggregateMessage(ctx context.Context, chunks []*httpclient.StreamEvent) *Message {
 	var (
 		messageStart  *StreamEvent
 		contentBlocks []MessageContentBlock
@@ -190,20 +242,5 @@ func AggregateStreamChunks(ctx context.Context, chunks []*httpclient.StreamEvent
 		}
 	}
 
-	data, err := json.Marshal(message)
-	if err != nil {
-		return nil, llm.ResponseMeta{}, err
-	}
-
-	// Convert and return usage if available
-	if usage != nil {
-		return data, llm.ResponseMeta{
-			ID:    message.ID,
-			Usage: lo.ToPtr(convertToLlmUsage(*usage)),
-		}, nil
-	}
-
-	return data, llm.ResponseMeta{
-		ID: message.ID,
-	}, nil
+	return message
 }
@@ -0,0 +1,109 @@
+package anthropic
+
+import (
+	"github.com/looplj/axonhub/internal/llm"
+)
+
+func convertToLLMCacheControl(cacheControl *CacheControl) *llm.CacheControl {
+	if cacheControl == nil {
+		return nil
+	}
+
+	return &llm.CacheControl{
+		Type: cacheControl.Type,
+		TTL:  cacheControl.TTL,
+	}
+}
+
+func convertToAnthropicCacheControl(cacheControl *llm.CacheControl) *CacheControl {
+	if cacheControl == nil {
+		return nil
+	}
+
+	return &CacheControl{
+		Type: cacheControl.Type,
+		TTL:  cacheControl.TTL,
+	}
+}
+
+// hasCacheControl reports whether the request has any cache breakpoint.
+func hasCacheControl(req *MessageRequest) bool {
+	if req.System != nil {
+		for _, part := range req.System.MultiplePrompts {
+			if part.CacheControl != nil {
+				return true
+			}
+		}
+	}
+
+	for _, tool := range req.Tools {
+		if tool.CacheControl != nil {
+			return true
+		}
+	}
+
+	for _, msg := range req.Messages {
+		for _, block := range msg.Content.MultipleContent {
+			if block.CacheControl != nil {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// applyAutoCacheControl adds the cache breakpoints to the request without any cache breakpoint,
+// e.g. the requests from the OpenAI format clients.
+// The tools, the system prompt and the conversation up to the last message are cached,
+// which uses 3 of the 4 breakpoints allowed by Anthropic.
+func applyAutoCacheControl(req *MessageRequest, cacheControl *CacheControl) {
+	if cacheControl == nil || hasCacheControl(req) {
+		return
+	}
+
+	if len(req.Tools) > 0 {
+		req.Tools[len(req.Tools)-1].CacheControl = cacheControl
+	}
+
+	if req.System != nil {
+		if req.System.Prompt != nil && *req.System.Prompt != "" {
+			req.System = &SystemPrompt{
+				MultiplePrompts: []SystemPromptPart{
+					{
+						Type: "text",
+						Text: *req.System.Prompt,
+					},
+				},
+			}
+		}
+
+		if len(req.System.MultiplePrompts) > 0 {
+			req.System.MultiplePrompts[len(req.System.MultiplePrompts)-1].CacheControl = cacheControl
+		}
+	}
+
+	if len(req.Messages) > 0 {
+		last := &req.Messages[len(req.Messages)-1]
+		if last.Content.Content != nil && *last.Content.Content != "" {
+			last.Content = MessageContent{
+				MultipleContent: []MessageContentBlock{
+					{
+						Type: "text",
+						Text: *last.Content.Content,
+					},
+				},
+			}
+		}
+
+		if blocks := last.Content.MultipleContent; len(blocks) > 0 {
+			// The thinking blocks can not be cached directly.
+			for i := len(blocks) - 1; i >= 0; i-- {
+				if blocks[i].Type != "thinking" && blocks[i].Type != "redacted_thinking" {
+					blocks[i].CacheControl = cacheControl
+					break
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,164 @@
+package anthropic
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/looplj/axonhub/internal/llm"
+)
+
+func TestCacheControl_RoundTrip(t *testing.T) {
+	ephemeral := &CacheControl{Type: "ephemeral"}
+	hour := &CacheControl{Type: "ephemeral", TTL: "1h"}
+
+	anthropicReq := &MessageRequest{
+		Model:     "claude-sonnet-4",
+		MaxTokens: 1024,
+		System: &SystemPrompt{
+			MultiplePrompts: []SystemPromptPart{
+				{Type: "text", Text: "You are a helpful assistant.", CacheControl: hour},
+			},
+		},
+		Tools: []Tool{
+			{Name: "read", InputSchema: json.RawMessage(`{"type":"object"}`)},
+			{Name: "write", InputSchema: json.RawMessage(`{"type":"object"}`), CacheControl: ephemeral},
+		},
+		Messages: []MessageParam{
+			{
+				Role: "user",
+				Content: MessageContent{
+					MultipleContent: []MessageContentBlock{
+						{Type: "text", Text: "Read the file.", CacheControl: ephemeral},
+					},
+				},
+			},
+			{
+				Role: "assistant",
+				Content: MessageContent{
+					MultipleContent: []MessageContentBlock{
+						{Type: "tool_use", ID: "toolu_1", Name: lo.ToPtr("read"), Input: json.RawMessage(`{}`)},
+					},
+				},
+			},
+			{
+				Role: "user",
+				Content: MessageContent{
+					MultipleContent: []MessageContentBlock{
+						{
+							Type:         "tool_result",
+							ToolUseID:    lo.ToPtr("toolu_1"),
+							Content:      &MessageContent{Content: lo.ToPtr("content")},
+							CacheControl: ephemeral,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	chatReq, err := convertToLLMRequest(anthropicReq)
+	require.NoError(t, err)
+
+	require.Equal(t, &llm.CacheControl{Type: "ephemeral", TTL: "1h"}, chatReq.Messages[0].CacheControl)
+	require.Nil(t, chatReq.Tools[0].CacheControl)
+	require.Equal(t, &llm.CacheControl{Type: "ephemeral"}, chatReq.Tools[1].CacheControl)
+	require.Equal(t, &llm.CacheControl{Type: "ephemeral"}, chatReq.Messages[1].CacheControl)
+	require.Nil(t, chatReq.Messages[2].ToolCalls[0].CacheControl)
+	require.Equal(t, &llm.CacheControl{Type: "ephemeral"}, chatReq.Messages[3].CacheControl)
+
+	// The cache breakpoints are not sent to the OpenAI compatible providers.
+	body, err := json.Marshal(chatReq)
+	require.NoError(t, err)
+	require.NotContains(t, string(body), "cache_control")
+
+	got := convertToAnthropicRequestWithConfig(chatReq, nil)
+
+	require.Equal(t, hour, got.System.MultiplePrompts[0].CacheControl)
+	require.Nil(t, got.Tools[0].CacheControl)
+	require.Equal(t, ephemeral, got.Tools[1].CacheControl)
+	require.Equal(t, ephemeral, got.Messages[0].Content.MultipleContent[0].CacheControl)
+	require.Nil(t, got.Messages[1].Content.MultipleContent[0].CacheControl)
+	require.Equal(t, ephemeral, got.Messages[2].Content.MultipleContent[0].CacheControl)
+}
+
+func TestCacheControl_NoBreakpoint(t *testing.T) {
+	chatReq := &llm.Request{
+		Model: "claude-sonnet-4",
+		Messages: []llm.Message{
+			{Role: "system", Content: llm.MessageContent{Content: lo.ToPtr("system 1")}},
+			{Role: "system", Content: llm.MessageContent{Content: lo.ToPtr("system 2")}},
+			{Role: "user", Content: llm.MessageContent{Content: lo.ToPtr("hello")}},
+		},
+	}
+
+	got := convertToAnthropicRequestWithConfig(chatReq, nil)
+	require.False(t, hasCacheControl(got))
+	require.NotNil(t, got.Messages[0].Content.Content)
+}
+
+func TestApplyAutoCacheControl(t *testing.T) {
+	auto := &CacheControl{Type: "ephemeral", TTL: "1h"}
+
+	newRequest := func() *llm.Request {
+		return &llm.Request{
+			Model: "claude-sonnet-4",
+			Messages: []llm.Message{
+				{Role: "system", Content: llm.MessageContent{Content: lo.ToPtr("You are a helpful assistant.")}},
+				{Role: "user", Content: llm.MessageContent{Content: lo.ToPtr("hello")}},
+				{
+					Role: "assistant",
+					Content: llm.MessageContent{
+						MultipleContent: []llm.MessageContentPart{
+							{Type: "text", Text: lo.ToPtr("hi")},
+						},
+					},
+				},
+				{Role: "user", Content: llm.MessageContent{Content: lo.ToPtr("how are you?")}},
+			},
+			Tools: []llm.Tool{
+				{Type: "function", Function: llm.Function{Name: "read"}},
+				{Type: "function", Function: llm.Function{Name: "write"}},
+			},
+		}
+	}
+
+	t.Run("add breakpoints", func(t *testing.T) {
+		got := convertToAnthropicRequestWithConfig(newRequest(), &Config{AutoCacheControl: auto})
+
+		require.Nil(t, got.Tools[0].CacheControl)
+		require.Equal(t, auto, got.Tools[1].CacheControl)
+
+		require.Nil(t, got.System.Prompt)
+		require.Len(t, got.System.MultiplePrompts, 1)
+		require.Equal(t, "You are a helpful assistant.", got.System.MultiplePrompts[0].Text)
+		require.Equal(t, auto, got.System.MultiplePrompts[0].CacheControl)
+
+		require.NotNil(t, got.Messages[0].Content.Content)
+
+		last := got.Messages[len(got.Messages)-1]
+		require.Nil(t, last.Content.Content)
+		require.Len(t, last.Content.MultipleContent, 1)
+		require.Equal(t, "how are you?", last.Content.MultipleContent[0].Text)
+		require.Equal(t, auto, last.Content.MultipleContent[0].CacheControl)
+	})
+
+	t.Run("keep the client breakpoints", func(t *testing.T) {
+		chatReq := newRequest()
+		chatReq.Tools[0].CacheControl = &llm.CacheControl{Type: "ephemeral"}
+
+		got := convertToAnthropicRequestWithConfig(chatReq, &Config{AutoCacheControl: auto})
+
+		require.Equal(t, &CacheControl{Type: "ephemeral"}, got.Tools[0].CacheControl)
+		require.Nil(t, got.Tools[1].CacheControl)
+		require.NotNil(t, got.System.Prompt)
+		require.NotNil(t, got.Messages[len(got.Messages)-1].Content.Content)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		got := convertToAnthropicRequestWithConfig(newRequest(), &Config{})
+		require.False(t, hasCacheControl(got))
+	})
+}
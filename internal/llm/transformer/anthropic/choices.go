@@ -0,0 +1,78 @@
+package anthropic
+
+import (
+	"github.com/looplj/axonhub/internal/llm"
+)
+
+// convertToMultipleChoicesResponse converts the messages of the fanout request to the unified response with multiple choices.
+// The message index is the choice index, and the usage of all messages are summed.
+func convertToMultipleChoicesResponse(messages []Message, unwrapStructuredOutput bool) *llm.Response {
+	if len(messages) == 0 {
+		return convertToChatCompletionResponse(nil, unwrapStructuredOutput)
+	}
+
+	resp := convertToChatCompletionResponse(&messages[0], unwrapStructuredOutput)
+
+	for index := 1; index < len(messages); index++ {
+		choiceResp := convertToChatCompletionResponse(&messages[index], unwrapStructuredOutput)
+
+		for _, choice := range choiceResp.Choices {
+			choice.Index = index
+			resp.Choices = append(resp.Choices, choice)
+		}
+
+		resp.Usage = addUsage(resp.Usage, choiceResp.Usage)
+	}
+
+	return resp
+}
+
+// addUsage returns the sum of the usages, the nil usage is ignored.
+func addUsage(total, usage *llm.Usage) *llm.Usage {
+	if usage == nil {
+		return total
+	}
+
+	if total == nil {
+		sum := *usage
+		if usage.PromptTokensDetails != nil {
+			sum.PromptTokensDetails = &llm.PromptTokensDetails{}
+			*sum.PromptTokensDetails = *usage.PromptTokensDetails
+		}
+
+		if usage.CompletionTokensDetails != nil {
+			sum.CompletionTokensDetails = &llm.CompletionTokensDetails{}
+			*sum.CompletionTokensDetails = *usage.CompletionTokensDetails
+		}
+
+		return &sum
+	}
+
+	sum := addUsage(nil, total)
+	sum.PromptTokens += usage.PromptTokens
+	sum.CompletionTokens += usage.CompletionTokens
+	sum.TotalTokens += usage.TotalTokens
+
+	if usage.PromptTokensDetails != nil {
+		if sum.PromptTokensDetails == nil {
+			sum.PromptTokensDetails = &llm.PromptTokensDetails{}
+		}
+
+		sum.PromptTokensDetails.AudioTokens += usage.PromptTokensDetails.AudioTokens
+		sum.PromptTokensDetails.CachedTokens += usage.PromptTokensDetails.CachedTokens
+		sum.PromptTokensDetails.CacheCreationTokens += usage.PromptTokensDetails.CacheCreationTokens
+	}
+
+	if usage.CompletionTokensDetails != nil {
+		if sum.CompletionTokensDetails == nil {
+			sum.CompletionTokensDetails = &llm.CompletionTokensDetails{}
+		}
+
+		sum.CompletionTokensDetails.AudioTokens += usage.CompletionTokensDetails.AudioTokens
+		sum.CompletionTokensDetails.ReasoningTokens += usage.CompletionTokensDetails.ReasoningTokens
+		sum.CompletionTokensDetails.AcceptedPredictionTokens += usage.CompletionTokensDetails.AcceptedPredictionTokens
+		sum.CompletionTokensDetails.RejectedPredictionTokens += usage.CompletionTokensDetails.RejectedPredictionTokens
+	}
+
+	return sum
+}
@@ -0,0 +1,160 @@
+package anthropic
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/looplj/axonhub/internal/llm"
+	"github.com/looplj/axonhub/internal/pkg/httpclient"
+	"github.com/looplj/axonhub/internal/pkg/streams"
+)
+
+func TestOutboundTransformer_MultipleChoices(t *testing.T) {
+	transformer, err := NewOutboundTransformer("https://api.anthropic.com", "test-key")
+	require.NoError(t, err)
+
+	t.Run("fanout request", func(t *testing.T) {
+		req, err := transformer.TransformRequest(context.Background(), &llm.Request{
+			Model:    "claude-sonnet-4",
+			N:        lo.ToPtr(int64(3)),
+			Messages: []llm.Message{{Role: "user", Content: llm.MessageContent{Content: lo.ToPtr("hi")}}},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 3, req.Fanout)
+		require.NotContains(t, string(req.Body), `"n"`)
+
+		req, err = transformer.TransformRequest(context.Background(), &llm.Request{
+			Model:    "claude-sonnet-4",
+			N:        lo.ToPtr(int64(1)),
+			Messages: []llm.Message{{Role: "user", Content: llm.MessageContent{Content: lo.ToPtr("hi")}}},
+		})
+		require.NoError(t, err)
+		require.Zero(t, req.Fanout)
+	})
+
+	t.Run("merge responses", func(t *testing.T) {
+		body := `[
+			{"id":"msg_1","type":"message","role":"assistant","model":"claude-sonnet-4","content":[{"type":"text","text":"first"}],"stop_reason":"end_turn","usage":{"input_tokens":10,"output_tokens":3}},
+			{"id":"msg_2","type":"message","role":"assistant","model":"claude-sonnet-4","content":[{"type":"text","text":"second"}],"stop_reason":"max_tokens","usage":{"input_tokens":10,"output_tokens":5,"cache_read_input_tokens":4}}
+		]`
+
+		resp, err := transformer.TransformResponse(context.Background(), &httpclient.Response{StatusCode: 200, Body: []byte(body)})
+		require.NoError(t, err)
+
+		require.Equal(t, "msg_1", resp.ID)
+		require.Len(t, resp.Choices, 2)
+		require.Equal(t, 0, resp.Choices[0].Index)
+		require.Equal(t, "first", *resp.Choices[0].Message.Content.Content)
+		require.Equal(t, "stop", *resp.Choices[0].FinishReason)
+		require.Equal(t, 1, resp.Choices[1].Index)
+		require.Equal(t, "second", *resp.Choices[1].Message.Content.Content)
+		require.Equal(t, "length", *resp.Choices[1].FinishReason)
+
+		require.Equal(t, 20, resp.Usage.PromptTokens)
+		require.Equal(t, 8, resp.Usage.CompletionTokens)
+		require.Equal(t, 28, resp.Usage.TotalTokens)
+		require.Equal(t, 4, resp.Usage.PromptTokensDetails.CachedTokens)
+	})
+}
+
+func newChoiceStreamEvents(t *testing.T, events []string) []*httpclient.StreamEvent {
+	t.Helper()
+
+	return lo.Map(events, func(data string, _ int) *httpclient.StreamEvent {
+		var event StreamEvent
+		require.NoError(t, json.Unmarshal([]byte(data), &event))
+
+		return &httpclient.StreamEvent{Type: event.Type, Data: []byte(data)}
+	})
+}
+
+var interleavedChoiceEvents = []string{
+	`{"type":"message_start","message":{"id":"msg_1","type":"message","role":"assistant","model":"claude-sonnet-4","content":[],"usage":{"input_tokens":10,"output_tokens":1}},"choice_index":0}`,
+	`{"type":"message_start","message":{"id":"msg_2","type":"message","role":"assistant","model":"claude-sonnet-4","content":[],"usage":{"input_tokens":10,"output_tokens":1}},"choice_index":1}`,
+	`{"type":"content_block_start","index":0,"content_block":{"type":"text","text":""},"choice_index":1}`,
+	`{"type":"content_block_delta","index":0,"delta":{"type":"text_delta","text":"second"},"choice_index":1}`,
+	`{"type":"content_block_start","index":0,"content_block":{"type":"text","text":""},"choice_index":0}`,
+	`{"type":"content_block_delta","index":0,"delta":{"type":"text_delta","text":"first"},"choice_index":0}`,
+	`{"type":"content_block_stop","index":0,"choice_index":0}`,
+	`{"type":"message_delta","delta":{"stop_reason":"end_turn"},"usage":{"output_tokens":3},"choice_index":0}`,
+	`{"type":"message_stop","choice_index":0}`,
+	`{"type":"content_block_stop","index":0,"choice_index":1}`,
+	`{"type":"message_delta","delta":{"stop_reason":"end_turn"},"usage":{"output_tokens":5},"choice_index":1}`,
+	`{"type":"message_stop","choice_index":1}`,
+}
+
+func TestOutboundTransformer_MultipleChoicesStream(t *testing.T) {
+	transformer, err := NewOutboundTransformer("https://api.anthropic.com", "test-key")
+	require.NoError(t, err)
+
+	stream, err := transformer.TransformStream(context.Background(), streams.SliceStream(newChoiceStreamEvents(t, interleavedChoiceEvents)))
+	require.NoError(t, err)
+
+	var (
+		contents  = map[int]string{}
+		finished  = map[int]string{}
+		lastUsage *llm.Usage
+		ids       = map[string]bool{}
+	)
+
+	for stream.Next() {
+		resp := stream.Current()
+		if resp == llm.DoneResponse {
+			continue
+		}
+
+		ids[resp.ID] = true
+
+		if resp.Usage != nil {
+			lastUsage = resp.Usage
+		}
+
+		for _, choice := range resp.Choices {
+			if choice.Delta != nil && choice.Delta.Content.Content != nil {
+				contents[choice.Index] += *choice.Delta.Content.Content
+			}
+
+			if choice.FinishReason != nil {
+				finished[choice.Index] = *choice.FinishReason
+			}
+		}
+	}
+
+	require.NoError(t, stream.Err())
+	require.Equal(t, map[int]string{0: "first", 1: "second"}, contents)
+	require.Equal(t, map[int]string{0: "stop", 1: "stop"}, finished)
+	require.Equal(t, map[string]bool{"msg_1": true}, ids)
+	require.Equal(t, 20, lastUsage.PromptTokens)
+	require.Equal(t, 8, lastUsage.CompletionTokens)
+	require.Equal(t, 28, lastUsage.TotalTokens)
+}
+
+func TestAggregateStreamChunks_MultipleChoices(t *testing.T) {
+	data, meta, err := AggregateStreamChunks(context.Background(), newChoiceStreamEvents(t, interleavedChoiceEvents))
+	require.NoError(t, err)
+
+	var messages []Message
+	require.NoError(t, json.Unmarshal(data, &messages))
+	require.Len(t, messages, 2)
+	require.Equal(t, "msg_1", messages[0].ID)
+	require.Equal(t, "first", messages[0].Content[0].Text)
+	require.Equal(t, "msg_2", messages[1].ID)
+	require.Equal(t, "second", messages[1].Content[0].Text)
+
+	require.Equal(t, "msg_1", meta.ID)
+	require.Equal(t, 20, meta.Usage.PromptTokens)
+	require.Equal(t, 8, meta.Usage.CompletionTokens)
+	require.Equal(t, 28, meta.Usage.TotalTokens)
+
+	// The aggregated response can be transformed like the non-stream response.
+	transformer, err := NewOutboundTransformer("https://api.anthropic.com", "test-key")
+	require.NoError(t, err)
+
+	resp, err := transformer.TransformResponse(context.Background(), &httpclient.Response{StatusCode: 200, Body: data})
+	require.NoError(t, err)
+	require.Len(t, resp.Choices, 2)
+}
@@ -0,0 +1,84 @@
+package anthropic
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/looplj/axonhub/internal/llm"
+	"github.com/looplj/axonhub/internal/pkg/httpclient"
+)
+
+// ErrCountTokensNotSupported is returned when the platform does not support the count tokens API.
+var ErrCountTokensNotSupported = errors.New("count tokens is not supported by the platform")
+
+// CountTokensRequest is the request of the count tokens API, only the fields affecting the input tokens are allowed.
+type CountTokensRequest struct {
+	Model      string         `json:"model"`
+	Messages   []MessageParam `json:"messages"`
+	System     *SystemPrompt  `json:"system,omitempty"`
+	Thinking   *Thinking      `json:"thinking,omitempty"`
+	Tools      []Tool         `json:"tools,omitempty"`
+	ToolChoice *ToolChoice    `json:"tool_choice,omitempty"`
+}
+
+// CountTokensResponse is the response of the count tokens API.
+type CountTokensResponse struct {
+	InputTokens int64 `json:"input_tokens"`
+}
+
+// TransformCountTokensRequest transforms the request to the count tokens API request, only the direct platform is supported.
+func (t *OutboundTransformer) TransformCountTokensRequest(ctx context.Context, chatReq *llm.Request) (*httpclient.Request, error) {
+	if t.config.Type != PlatformDirect {
+		return nil, ErrCountTokensNotSupported
+	}
+
+	anthropicReq := convertToAnthropicRequestWithConfig(chatReq, t.config)
+
+	body, err := json.Marshal(CountTokensRequest{
+		Model:      anthropicReq.Model,
+		Messages:   anthropicReq.Messages,
+		System:     anthropicReq.System,
+		Thinking:   anthropicReq.Thinking,
+		Tools:      anthropicReq.Tools,
+		ToolChoice: anthropicReq.ToolChoice,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal count tokens request: %w", err)
+	}
+
+	headers := make(http.Header)
+	headers.Set("Content-Type", "application/json")
+	headers.Set("Accept", "application/json")
+	headers.Set("Anthropic-Version", "2023-06-01")
+
+	var auth *httpclient.AuthConfig
+	if t.config.APIKey != "" {
+		auth = &httpclient.AuthConfig{
+			Type:      "api_key",
+			APIKey:    t.config.APIKey,
+			HeaderKey: "X-API-Key",
+		}
+	}
+
+	return &httpclient.Request{
+		Method:  http.MethodPost,
+		URL:     strings.TrimSuffix(t.config.BaseURL, "/") + "/v1/messages/count_tokens",
+		Headers: headers,
+		Body:    body,
+		Auth:    auth,
+	}, nil
+}
+
+// TransformCountTokensResponse returns the input tokens of the count tokens API response.
+func (t *OutboundTransformer) TransformCountTokensResponse(ctx context.Context, resp *httpclient.Response) (int64, error) {
+	var countResp CountTokensResponse
+	if err := json.Unmarshal(resp.Body, &countResp); err != nil {
+		return 0, fmt.Errorf("failed to unmarshal count tokens response: %w", err)
+	}
+
+	return countResp.InputTokens, nil
+}
@@ -0,0 +1,59 @@
+package anthropic
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/looplj/axonhub/internal/pkg/httpclient"
+)
+
+func TestCountTokens_Transform(t *testing.T) {
+	httpReq := &httpclient.Request{
+		Method:  http.MethodPost,
+		Headers: http.Header{"Content-Type": []string{"application/json"}},
+		Body: []byte(`{"model":"claude-sonnet-4-20250514","system":"You are helpful.",` +
+			`"messages":[{"role":"user","content":"Hello"}],` +
+			`"tools":[{"name":"get_weather","description":"Get weather","input_schema":{"type":"object"}}]}`),
+	}
+
+	inbound := NewInboundTransformer()
+
+	// The max_tokens is required by the messages API but not by the count tokens API.
+	_, err := inbound.TransformRequest(context.Background(), httpReq)
+	require.ErrorContains(t, err, "max_tokens is required")
+
+	chatReq, err := inbound.TransformCountTokensRequest(context.Background(), httpReq)
+	require.NoError(t, err)
+
+	outbound, err := NewOutboundTransformer("https://api.anthropic.com/", "test-key")
+	require.NoError(t, err)
+
+	countReq, err := outbound.(*OutboundTransformer).TransformCountTokensRequest(context.Background(), chatReq)
+	require.NoError(t, err)
+	require.Equal(t, "https://api.anthropic.com/v1/messages/count_tokens", countReq.URL)
+	require.Equal(t, "test-key", countReq.Auth.APIKey)
+
+	var body map[string]any
+	require.NoError(t, json.Unmarshal(countReq.Body, &body))
+	require.Equal(t, "claude-sonnet-4-20250514", body["model"])
+	require.Contains(t, body, "system")
+	require.Contains(t, body, "tools")
+	require.NotContains(t, body, "max_tokens")
+
+	count, err := outbound.(*OutboundTransformer).TransformCountTokensResponse(context.Background(), &httpclient.Response{
+		Body: []byte(`{"input_tokens":25}`),
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(25), count)
+}
+
+func TestCountTokens_NotSupportedPlatform(t *testing.T) {
+	outbound := &OutboundTransformer{config: &Config{Type: PlatformBedrock}}
+
+	_, err := outbound.TransformCountTokensRequest(context.Background(), nil)
+	require.ErrorIs(t, err, ErrCountTokensNotSupported)
+}
@@ -0,0 +1,123 @@
+package anthropic
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/looplj/axonhub/internal/llm"
+)
+
+// parseDataURL parses the data URL with base64 encoded data, e.g. "data:application/pdf;base64,...".
+func parseDataURL(url string) (mediaType string, data string, ok bool) {
+	if !strings.HasPrefix(url, "data:") {
+		return "", "", false
+	}
+
+	header, data, found := strings.Cut(url, ",")
+	if !found {
+		return "", "", false
+	}
+
+	mediaType, encoding, found := strings.Cut(strings.TrimPrefix(header, "data:"), ";")
+	if !found || encoding != "base64" {
+		return "", "", false
+	}
+
+	return mediaType, data, true
+}
+
+// convertToLLMFilePart converts the Anthropic document block to the unified file content part.
+func convertToLLMFilePart(block MessageContentBlock) (llm.MessageContentPart, bool) {
+	if block.Source == nil {
+		return llm.MessageContentPart{}, false
+	}
+
+	file := &llm.File{
+		Filename:  block.Title,
+		Context:   block.Context,
+		Citations: block.Citations,
+	}
+
+	switch block.Source.Type {
+	case "base64":
+		file.FileData = fmt.Sprintf("data:%s;base64,%s", block.Source.MediaType, block.Source.Data)
+	case "text":
+		mediaType := block.Source.MediaType
+		if mediaType == "" {
+			mediaType = "text/plain"
+		}
+
+		file.FileData = fmt.Sprintf("data:%s;base64,%s", mediaType, base64.StdEncoding.EncodeToString([]byte(block.Source.Data)))
+	case "url":
+		file.FileData = block.Source.URL
+	case "file":
+		file.FileID = block.Source.FileID
+	default:
+		return llm.MessageContentPart{}, false
+	}
+
+	return llm.MessageContentPart{
+		Type:         "file",
+		File:         file,
+		CacheControl: convertToLLMCacheControl(block.CacheControl),
+	}, true
+}
+
+// convertToAnthropicDocumentBlock converts the unified file content part to the Anthropic document block.
+// The plain text files are converted to the text source, and other files are converted to the base64 source.
+func convertToAnthropicDocumentBlock(part llm.MessageContentPart) (MessageContentBlock, bool) {
+	if part.File == nil {
+		return MessageContentBlock{}, false
+	}
+
+	var source *ImageSource
+
+	switch {
+	case part.File.FileID != "":
+		source = &ImageSource{
+			Type:   "file",
+			FileID: part.File.FileID,
+		}
+	case strings.HasPrefix(part.File.FileData, "data:"):
+		mediaType, data, ok := parseDataURL(part.File.FileData)
+		if !ok {
+			return MessageContentBlock{}, false
+		}
+
+		if strings.HasPrefix(mediaType, "text/") {
+			text, err := base64.StdEncoding.DecodeString(data)
+			if err != nil {
+				return MessageContentBlock{}, false
+			}
+
+			source = &ImageSource{
+				Type:      "text",
+				MediaType: "text/plain",
+				Data:      string(text),
+			}
+		} else {
+			source = &ImageSource{
+				Type:      "base64",
+				MediaType: mediaType,
+				Data:      data,
+			}
+		}
+	case part.File.FileData != "":
+		source = &ImageSource{
+			Type: "url",
+			URL:  part.File.FileData,
+		}
+	default:
+		return MessageContentBlock{}, false
+	}
+
+	return MessageContentBlock{
+		Type:         "document",
+		Source:       source,
+		Title:        part.File.Filename,
+		Context:      part.File.Context,
+		Citations:    part.File.Citations,
+		CacheControl: convertToAnthropicCacheControl(part.CacheControl),
+	}, true
+}
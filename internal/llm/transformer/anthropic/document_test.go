@@ -0,0 +1,157 @@
+package anthropic
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/looplj/axonhub/internal/llm"
+)
+
+func TestDocument_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name     string
+		block    MessageContentBlock
+		wantFile *llm.File
+	}{
+		{
+			name: "base64 pdf",
+			block: MessageContentBlock{
+				Type:      "document",
+				Source:    &ImageSource{Type: "base64", MediaType: "application/pdf", Data: "JVBERi0xLjQ="},
+				Title:     "report.pdf",
+				Context:   "Quarterly report",
+				Citations: json.RawMessage(`{"enabled":true}`),
+			},
+			wantFile: &llm.File{
+				FileData:  "data:application/pdf;base64,JVBERi0xLjQ=",
+				Filename:  "report.pdf",
+				Context:   "Quarterly report",
+				Citations: json.RawMessage(`{"enabled":true}`),
+			},
+		},
+		{
+			name: "plain text",
+			block: MessageContentBlock{
+				Type:   "document",
+				Source: &ImageSource{Type: "text", MediaType: "text/plain", Data: "hello world"},
+			},
+			wantFile: &llm.File{
+				FileData: "data:text/plain;base64,aGVsbG8gd29ybGQ=",
+			},
+		},
+		{
+			name: "url",
+			block: MessageContentBlock{
+				Type:   "document",
+				Source: &ImageSource{Type: "url", URL: "https://example.com/report.pdf"},
+			},
+			wantFile: &llm.File{
+				FileData: "https://example.com/report.pdf",
+			},
+		},
+		{
+			name: "file",
+			block: MessageContentBlock{
+				Type:   "document",
+				Source: &ImageSource{Type: "file", FileID: "file_123"},
+			},
+			wantFile: &llm.File{
+				FileID: "file_123",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chatReq, err := convertToLLMRequest(&MessageRequest{
+				Model:     "claude-sonnet-4",
+				MaxTokens: 1024,
+				Messages: []MessageParam{
+					{
+						Role: "user",
+						Content: MessageContent{
+							MultipleContent: []MessageContentBlock{
+								tt.block,
+								{Type: "text", Text: "Summarize the document."},
+							},
+						},
+					},
+				},
+			})
+			require.NoError(t, err)
+
+			parts := chatReq.Messages[0].Content.MultipleContent
+			require.Len(t, parts, 2)
+			require.Equal(t, "file", parts[0].Type)
+			require.Equal(t, tt.wantFile, parts[0].File)
+
+			got := convertToAnthropicRequest(chatReq)
+			require.Equal(t, tt.block, got.Messages[0].Content.MultipleContent[0])
+		})
+	}
+}
+
+func TestDocument_OpenAIFilePart(t *testing.T) {
+	var chatReq llm.Request
+
+	err := json.Unmarshal([]byte(`{
+		"model": "claude-sonnet-4",
+		"messages": [{
+			"role": "user",
+			"content": [
+				{"type": "file", "file": {"filename": "report.pdf", "file_data": "data:application/pdf;base64,JVBERi0xLjQ="}},
+				{"type": "text", "text": "Summarize the document."}
+			]
+		}]
+	}`), &chatReq)
+	require.NoError(t, err)
+
+	got := convertToAnthropicRequest(&chatReq)
+	require.Equal(t, MessageContentBlock{
+		Type:   "document",
+		Source: &ImageSource{Type: "base64", MediaType: "application/pdf", Data: "JVBERi0xLjQ="},
+		Title:  "report.pdf",
+	}, got.Messages[0].Content.MultipleContent[0])
+
+	// The Anthropic only fields are not sent to the OpenAI compatible providers.
+	chatReq.Messages[0].Content.MultipleContent[0].File.Context = "context"
+	body, err := json.Marshal(chatReq.Messages[0].Content.MultipleContent[0])
+	require.NoError(t, err)
+	require.JSONEq(t, `{"type":"file","file":{"filename":"report.pdf","file_data":"data:application/pdf;base64,JVBERi0xLjQ="}}`, string(body))
+}
+
+func TestImageURLSource(t *testing.T) {
+	got := convertToAnthropicRequest(&llm.Request{
+		Model: "claude-sonnet-4",
+		Messages: []llm.Message{
+			{
+				Role: "user",
+				Content: llm.MessageContent{
+					MultipleContent: []llm.MessageContentPart{
+						{Type: "image_url", ImageURL: &llm.ImageURL{URL: "https://example.com/cat.png"}},
+						{Type: "text", Text: lo.ToPtr("What is it?")},
+					},
+				},
+			},
+		},
+	})
+
+	body, err := json.Marshal(got.Messages[0].Content.MultipleContent[0])
+	require.NoError(t, err)
+	require.JSONEq(t, `{"type":"image","source":{"type":"url","url":"https://example.com/cat.png"}}`, string(body))
+
+	resp := convertToChatCompletionResponse(&Message{
+		ID:   "msg_1",
+		Role: "assistant",
+		Content: []MessageContentBlock{
+			{Type: "image", Source: &ImageSource{Type: "url", URL: "https://example.com/cat.png"}},
+			{Type: "image", Source: &ImageSource{Type: "base64", MediaType: "image/png", Data: "iVBORw0KGgo="}},
+		},
+	}, false)
+	parts := resp.Choices[0].Message.Content.MultipleContent
+	require.Equal(t, "https://example.com/cat.png", parts[0].ImageURL.URL)
+	require.Equal(t, "data:image/png;base64,iVBORw0KGgo=", parts[1].ImageURL.URL)
+}
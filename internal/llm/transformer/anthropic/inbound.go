@@ -30,6 +30,15 @@ func (t *InboundTransformer) APIFormat() llm.APIFormat {
 //
 //nolint:maintidx
 func (t *InboundTransformer) TransformRequest(ctx context.Context, httpReq *httpclient.Request) (*llm.Request, error) {
+	return t.transformRequest(httpReq, true)
+}
+
+// TransformCountTokensRequest transforms the count tokens request, it is the same as the message request without max_tokens.
+func (t *InboundTransformer) TransformCountTokensRequest(ctx context.Context, httpReq *httpclient.Request) (*llm.Request, error) {
+	return t.transformRequest(httpReq, false)
+}
+
+func (t *InboundTransformer) transformRequest(httpReq *httpclient.Request, requireMaxTokens bool) (*llm.Request, error) {
 	if httpReq == nil {
 		return nil, fmt.Errorf("%w: http request is nil", transformer.ErrInvalidRequest)
 	}
@@ -64,7 +73,7 @@ func (t *InboundTransformer) TransformRequest(ctx context.Context, httpReq *http
 		return nil, fmt.Errorf("%w: messages are required", transformer.ErrInvalidRequest)
 	}
 
-	if anthropicReq.MaxTokens <= 0 {
+	if requireMaxTokens && anthropicReq.MaxTokens <= 0 {
 		return nil, fmt.Errorf("%w: max_tokens is required and must be positive", transformer.ErrInvalidRequest)
 	}
 
@@ -43,6 +43,7 @@ func convertToLLMRequest(anthropicReq *MessageRequest) (*llm.Request, error) {
 					Content: llm.MessageContent{
 						Content: &prompt.Text,
 					},
+					CacheControl: convertToLLMCacheControl(prompt.CacheControl),
 				})
 			}
 		}
@@ -68,8 +69,9 @@ func convertToLLMRequest(anthropicReq *MessageRequest) (*llm.Request, error) {
 				switch block.Type {
 				case "text":
 					contentParts = append(contentParts, llm.MessageContentPart{
-						Type: "text",
-						Text: &block.Text,
+						Type:         "text",
+						Text:         &block.Text,
+						CacheControl: convertToLLMCacheControl(block.CacheControl),
 					})
 					hasContent = true
 				case "image":
@@ -82,6 +84,7 @@ func convertToLLMRequest(anthropicReq *MessageRequest) (*llm.Request, error) {
 								ImageURL: &llm.ImageURL{
 									URL: imageURL,
 								},
+								CacheControl: convertToLLMCacheControl(block.CacheControl),
 							})
 						} else {
 							contentParts = append(contentParts, llm.MessageContentPart{
@@ -89,9 +92,15 @@ func convertToLLMRequest(anthropicReq *MessageRequest) (*llm.Request, error) {
 								ImageURL: &llm.ImageURL{
 									URL: block.Source.URL,
 								},
+								CacheControl: convertToLLMCacheControl(block.CacheControl),
 							})
 						}
 
+						hasContent = true
+					}
+				case "document":
+					if part, ok := convertToLLMFilePart(block); ok {
+						contentParts = append(contentParts, part)
 						hasContent = true
 					}
 				case "tool_result":
@@ -105,6 +114,7 @@ func convertToLLMRequest(anthropicReq *MessageRequest) (*llm.Request, error) {
 								Content: block.Content.Content,
 							},
 							ToolCallIsError: block.IsError,
+							CacheControl:    convertToLLMCacheControl(block.CacheControl),
 						})
 					}
 				case "tool_use":
@@ -115,6 +125,7 @@ func convertToLLMRequest(anthropicReq *MessageRequest) (*llm.Request, error) {
 							Name:      lo.FromPtr(block.Name),
 							Arguments: string(block.Input),
 						},
+						CacheControl: convertToLLMCacheControl(block.CacheControl),
 					})
 					hasContent = true
 				}
@@ -126,6 +137,7 @@ func convertToLLMRequest(anthropicReq *MessageRequest) (*llm.Request, error) {
 				chatMsg.Content = llm.MessageContent{
 					Content: contentParts[0].Text,
 				}
+				chatMsg.CacheControl = contentParts[0].CacheControl
 				hasContent = true
 			} else {
 				chatMsg.Content = llm.MessageContent{
@@ -154,6 +166,7 @@ func convertToLLMRequest(anthropicReq *MessageRequest) (*llm.Request, error) {
 					Description: tool.Description,
 					Parameters:  tool.InputSchema,
 				},
+				CacheControl: convertToLLMCacheControl(tool.CacheControl),
 			}
 			tools = append(tools, llmTool)
 		}
@@ -323,6 +336,7 @@ func convertToAnthropicResponse(chatResp *llm.Response) *Message {
 		// Map detailed token information from unified model to Anthropic format
 		if chatResp.Usage.PromptTokensDetails != nil {
 			usage.CacheReadInputTokens = int64(chatResp.Usage.PromptTokensDetails.CachedTokens)
+			usage.CacheCreationInputTokens = int64(chatResp.Usage.PromptTokensDetails.CacheCreationTokens)
 		}
 
 		// Note: Anthropic doesn't have a direct equivalent for reasoning tokens in their current API
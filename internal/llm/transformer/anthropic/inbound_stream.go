@@ -424,6 +424,7 @@ func (s *anthropicInboundStream) Next() bool {
 		// Map detailed token information
 		if chunk.Usage.PromptTokensDetails != nil {
 			usage.CacheReadInputTokens = int64(chunk.Usage.PromptTokensDetails.CachedTokens)
+			usage.CacheCreationInputTokens = int64(chunk.Usage.PromptTokensDetails.CacheCreationTokens)
 		}
 
 		streamEvent.Usage = usage
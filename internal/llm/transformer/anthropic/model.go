@@ -160,6 +160,8 @@ type Tool struct {
 
 type CacheControl struct {
 	Type string `json:"type" validate:"required,oneof=ephemeral"`
+	// TTL is the time to live of the cache, "5m" or "1h", default to "5m".
+	TTL string `json:"ttl,omitempty"`
 }
 
 // InputSchema represents the JSON schema for tool input.
@@ -215,7 +217,7 @@ func (c *MessageContent) UnmarshalJSON(data []byte) error {
 
 // MessageContentBlock represents different types of content blocks.
 type MessageContentBlock struct {
-	// Any of "text", "image", "thinking", "redacted_thinking", "tool_use", "server_tool_use", "tool_result".
+	// Any of "text", "image", "document", "thinking", "redacted_thinking", "tool_use", "server_tool_use", "tool_result".
 	Type string `json:"type"`
 
 	// Text will be present if type is "text".
@@ -230,9 +232,16 @@ type MessageContentBlock struct {
 	// Data will be present if type is "redacted_thinking".
 	Data string `json:"data,omitempty"`
 
-	// Image will be present if type is "image".
+	// Source will be present if type is "image" or "document".
 	Source *ImageSource `json:"source,omitempty"`
 
+	// Document fields, will be present if type is "document".
+	Title   string `json:"title,omitempty"`
+	Context string `json:"context,omitempty"`
+	// Citations is the citations configuration of the document, e.g. {"enabled":true},
+	// and it is the citations of the text if type is "text" in the response.
+	Citations json.RawMessage `json:"citations,omitempty"`
+
 	// Tool use request
 	// tool_use or server_tool_use
 	ID           string          `json:"id,omitempty"`
@@ -248,22 +257,28 @@ type MessageContentBlock struct {
 	IsError *bool           `json:"is_error,omitempty"`
 }
 
-// ImageSource represents image source for Anthropic.
+// ImageSource represents image and document source for Anthropic.
 type ImageSource struct {
 	// Type is the type of image source.
-	// Available values: base64, url
+	// Available values: base64, url, and text, file for the documents.
 	Type string `json:"type"`
 	// MediaType is the media type of image.
-	// Available values: image/png, image/jpeg, image/gif, image/webp
-	MediaType string `json:"media_type"`
+	// Available values: image/png, image/jpeg, image/gif, image/webp,
+	// and application/pdf, text/plain for the documents.
+	MediaType string `json:"media_type,omitempty"`
 
 	// Data is the image data.
 	// If Type is base64, Data is the base64-encoded image data.
-	Data string `json:"data"`
+	// If Type is text, Data is the plain text of the document.
+	Data string `json:"data,omitempty"`
 
 	// URL is the URL of the image.
 	// It will be present if Type is url.
 	URL string `json:"url,omitempty"`
+
+	// FileID is the ID of the uploaded file.
+	// It will be present if Type is file.
+	FileID string `json:"file_id,omitempty"`
 }
 
 // StreamEvent represents events in Anthropic streaming response.
@@ -285,6 +300,10 @@ type StreamEvent struct {
 	Delta *StreamDelta `json:"delta,omitempty"`
 
 	Usage *Usage `json:"usage,omitempty"`
+
+	// ChoiceIndex is the index of the choice the event belongs to, it is not a field of the Anthropic API,
+	// it is added to the events of the fanout requests to emulate the n>1 choices.
+	ChoiceIndex int `json:"choice_index,omitempty"`
 }
 
 // StreamDelta represents delta in streaming response.
@@ -43,6 +43,13 @@ type Config struct {
 	// Thinking configuration
 	// Maps ReasoningEffort values to Anthropic thinking budget tokens
 	ReasoningEffortToBudget map[string]int64 `json:"reasoning_effort_to_budget,omitempty"`
+
+	// AutoCacheControl is the cache breakpoint added to the requests without any cache breakpoint, e.g. from the OpenAI format clients.
+	// No cache breakpoint is added if it is nil.
+	AutoCacheControl *CacheControl `json:"auto_cache_control,omitempty"`
+
+	// Transport is the HTTP transport of the Bedrock and Vertex executors, the default transport is used if nil.
+	Transport http.RoundTripper `json:"-"`
 }
 
 // OutboundTransformer implements transformer.Outbound for Anthropic format.
@@ -73,7 +80,7 @@ func NewOutboundTransformerWithConfig(config *Config) (transformer.Outbound, err
 	}
 
 	if config.Type == PlatformBedrock {
-		executor, err := bedrock.NewExecutor(config.Region, config.AccessKeyID, config.SecretAccessKey)
+		executor, err := bedrock.NewExecutorWithTransport(config.Region, config.AccessKeyID, config.SecretAccessKey, config.Transport)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create bedrock executor: %w", err)
 		}
@@ -85,7 +92,7 @@ func NewOutboundTransformerWithConfig(config *Config) (transformer.Outbound, err
 	}
 
 	if config.Type == PlatformVertex {
-		executor, err := vertex.NewExecutorFromJSON(config.Region, config.ProjectID, config.JSONData)
+		executor, err := vertex.NewExecutorFromJSONWithTransport(config.Region, config.ProjectID, config.JSONData, config.Transport)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create vertex transformer: %w", err)
 		}
@@ -192,13 +199,20 @@ func (t *OutboundTransformer) TransformRequest(
 		return nil, fmt.Errorf("failed to build platform URL: %w", err)
 	}
 
-	return &httpclient.Request{
+	httpReq := &httpclient.Request{
 		Method:  http.MethodPost,
 		URL:     url,
 		Headers: headers,
 		Body:    body,
 		Auth:    auth,
-	}, nil
+	}
+
+	// Anthropic does not support n>1, the request is fanned out to the parallel upstream calls.
+	if chatReq.N != nil && *chatReq.N > 1 {
+		httpReq.Fanout = int(*chatReq.N)
+	}
+
+	return httpReq, nil
 }
 
 // buildFullRequestURL constructs the appropriate URL based on the platform.
@@ -265,6 +279,18 @@ func (t *OutboundTransformer) TransformResponse(
 		return nil, fmt.Errorf("response body is empty")
 	}
 
+	// The response of the fanout request is the array of the messages, one message for each choice.
+	if httpResp.Body[0] == '[' {
+		var anthropicResps []Message
+
+		err := json.Unmarshal(httpResp.Body, &anthropicResps)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unmarshal anthropic response: %w", err)
+		}
+
+		return convertToMultipleChoicesResponse(anthropicResps, usesStructuredOutput(llm.RequestFromContext(ctx))), nil
+	}
+
 	var anthropicResp Message
 
 	err := json.Unmarshal(httpResp.Body, &anthropicResp)
@@ -273,7 +299,7 @@ func (t *OutboundTransformer) TransformResponse(
 	}
 
 	// Convert to ChatCompletionResponse
-	chatResp := convertToChatCompletionResponse(&anthropicResp)
+	chatResp := convertToChatCompletionResponse(&anthropicResp, usesStructuredOutput(llm.RequestFromContext(ctx)))
 
 	return chatResp, nil
 }
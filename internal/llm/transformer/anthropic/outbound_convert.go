@@ -1,6 +1,7 @@
 package anthropic
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/samber/lo"
@@ -55,15 +56,17 @@ func convertToAnthropicRequestWithConfig(chatReq *llm.Request, config *Config) *
 		for _, tool := range chatReq.Tools {
 			if tool.Type == "function" {
 				anthropicTool := Tool{
-					Name:        tool.Function.Name,
-					Description: tool.Function.Description,
-					InputSchema: tool.Function.Parameters,
+					Name:         tool.Function.Name,
+					Description:  tool.Function.Description,
+					InputSchema:  tool.Function.Parameters,
+					CacheControl: convertToAnthropicCacheControl(tool.CacheControl),
 				}
 				tools = append(tools, anthropicTool)
 			}
 		}
 
 		req.Tools = tools
+		req.ToolChoice = convertToAnthropicToolChoice(chatReq.ToolChoice)
 	}
 
 	// Convert messages
@@ -90,6 +93,7 @@ func convertToAnthropicRequestWithConfig(chatReq *llm.Request, config *Config) *
 								Content: &MessageContent{
 									Content: msg.Content.Content,
 								},
+								CacheControl: convertToAnthropicCacheControl(msg.CacheControl),
 							},
 						},
 					},
@@ -118,7 +122,8 @@ func convertToAnthropicRequestWithConfig(chatReq *llm.Request, config *Config) *
 								Content: &MessageContent{
 									Content: item.Content.Content,
 								},
-								IsError: item.ToolCallIsError,
+								IsError:      item.ToolCallIsError,
+								CacheControl: convertToAnthropicCacheControl(item.CacheControl),
 							}
 						}),
 					},
@@ -137,8 +142,9 @@ func convertToAnthropicRequestWithConfig(chatReq *llm.Request, config *Config) *
 			var contextBlock *MessageContentBlock
 			if msg.Content.Content != nil {
 				contextBlock = &MessageContentBlock{
-					Type: "text",
-					Text: *msg.Content.Content,
+					Type:         "text",
+					Text:         *msg.Content.Content,
+					CacheControl: convertToAnthropicCacheControl(msg.CacheControl),
 				}
 			}
 
@@ -151,9 +157,23 @@ func convertToAnthropicRequestWithConfig(chatReq *llm.Request, config *Config) *
 			messages = append(messages, anthropicMsg)
 		} else {
 			if msg.Content.Content != nil {
-				anthropicMsg.Content = MessageContent{
-					Content: msg.Content.Content,
+				if msg.CacheControl != nil {
+					// The cache breakpoint can only be set on the content block.
+					anthropicMsg.Content = MessageContent{
+						MultipleContent: []MessageContentBlock{
+							{
+								Type:         "text",
+								Text:         *msg.Content.Content,
+								CacheControl: convertToAnthropicCacheControl(msg.CacheControl),
+							},
+						},
+					}
+				} else {
+					anthropicMsg.Content = MessageContent{
+						Content: msg.Content.Content,
+					}
 				}
+
 				messages = append(messages, anthropicMsg)
 			} else if len(msg.Content.MultipleContent) > 0 {
 				content, ok := convertMultiplePartContent(msg)
@@ -175,6 +195,12 @@ func convertToAnthropicRequestWithConfig(chatReq *llm.Request, config *Config) *
 		}
 	}
 
+	applyStructuredOutput(req, chatReq)
+
+	if config != nil {
+		applyAutoCacheControl(req, config.AutoCacheControl)
+	}
+
 	return req
 }
 
@@ -183,11 +209,15 @@ func convertAoAnthropicSystemPrompt(chatReq *llm.Request) *SystemPrompt {
 		return msg.Role == "system"
 	})
 
-	switch len(systemMessages) {
-	case 0:
+	hasCacheControl := lo.ContainsBy(systemMessages, func(msg llm.Message) bool {
+		return msg.CacheControl != nil
+	})
+
+	switch {
+	case len(systemMessages) == 0:
 		// Leave System as nil when there are no system messages
 		return nil
-	case 1:
+	case len(systemMessages) == 1 && !hasCacheControl:
 		return &SystemPrompt{
 			Prompt: systemMessages[0].Content.Content,
 		}
@@ -195,11 +225,9 @@ func convertAoAnthropicSystemPrompt(chatReq *llm.Request) *SystemPrompt {
 		return &SystemPrompt{
 			MultiplePrompts: lo.Map(systemMessages, func(msg llm.Message, _ int) SystemPromptPart {
 				return SystemPromptPart{
-					Type: "text",
-					Text: *msg.Content.Content,
-					CacheControl: &CacheControl{
-						Type: "ephemeral",
-					},
+					Type:         "text",
+					Text:         lo.FromPtr(msg.Content.Content),
+					CacheControl: convertToAnthropicCacheControl(msg.CacheControl),
 				}
 			}),
 		}
@@ -213,8 +241,9 @@ func convertMultiplePartContent(msg llm.Message) (MessageContent, bool) {
 		case "text":
 			if part.Text != nil {
 				blocks = append(blocks, MessageContentBlock{
-					Type: "text",
-					Text: *part.Text,
+					Type:         "text",
+					Text:         *part.Text,
+					CacheControl: convertToAnthropicCacheControl(part.CacheControl),
 				})
 			}
 		case "image_url":
@@ -235,6 +264,7 @@ func convertMultiplePartContent(msg llm.Message) (MessageContent, bool) {
 									MediaType: mediaType,
 									Data:      parts[1],
 								},
+								CacheControl: convertToAnthropicCacheControl(part.CacheControl),
 							})
 						}
 					}
@@ -245,21 +275,24 @@ func convertMultiplePartContent(msg llm.Message) (MessageContent, bool) {
 							Type: "url",
 							URL:  part.ImageURL.URL,
 						},
+						CacheControl: convertToAnthropicCacheControl(part.CacheControl),
 					})
 				}
 			}
+		case "file":
+			if block, ok := convertToAnthropicDocumentBlock(part); ok {
+				blocks = append(blocks, block)
+			}
 		}
 	}
 
 	for _, toolCall := range msg.ToolCalls {
 		blocks = append(blocks, MessageContentBlock{
-			Type:  "tool_use",
-			ID:    toolCall.ID,
-			Name:  &toolCall.Function.Name,
-			Input: []byte(toolCall.Function.Arguments),
-			CacheControl: &CacheControl{
-				Type: "ephemeral",
-			},
+			Type:         "tool_use",
+			ID:           toolCall.ID,
+			Name:         &toolCall.Function.Name,
+			Input:        []byte(toolCall.Function.Arguments),
+			CacheControl: convertToAnthropicCacheControl(toolCall.CacheControl),
 		})
 	}
 
@@ -289,17 +322,43 @@ func convertToLlmUsage(usage Usage) llm.Usage {
 	}
 
 	// Map detailed token information from Anthropic format to unified model
-	if usage.CacheReadInputTokens > 0 {
+	if usage.CacheReadInputTokens > 0 || usage.CacheCreationInputTokens > 0 {
 		u.PromptTokensDetails = &llm.PromptTokensDetails{
-			CachedTokens: int(usage.CacheReadInputTokens),
+			CachedTokens:        int(usage.CacheReadInputTokens),
+			CacheCreationTokens: int(usage.CacheCreationInputTokens),
 		}
 	}
 
 	return u
 }
 
-// convertToChatCompletionResponse converts Anthropic Message to unified Response format.
-func convertToChatCompletionResponse(anthropicResp *Message) *llm.Response {
+// convertToAnthropicToolChoice converts the tool choice of the chat request to the Anthropic tool choice.
+func convertToAnthropicToolChoice(toolChoice *llm.ToolChoice) *ToolChoice {
+	if toolChoice == nil {
+		return nil
+	}
+
+	if toolChoice.NamedToolChoice != nil {
+		return &ToolChoice{Type: "tool", Name: lo.ToPtr(toolChoice.NamedToolChoice.Function.Name)}
+	}
+
+	if toolChoice.ToolChoice == nil {
+		return nil
+	}
+
+	switch *toolChoice.ToolChoice {
+	case "auto", "none":
+		return &ToolChoice{Type: *toolChoice.ToolChoice}
+	case "required":
+		return &ToolChoice{Type: "any"}
+	default:
+		return nil
+	}
+}
+
+// convertToChatCompletionResponse converts Anthropic Message to unified Response format,
+// the call of the structured output tool is unwrapped into the content if unwrapStructuredOutput is true.
+func convertToChatCompletionResponse(anthropicResp *Message, unwrapStructuredOutput bool) *llm.Response {
 	if anthropicResp == nil {
 		return &llm.Response{
 			ID:      "",
@@ -318,9 +377,10 @@ func convertToChatCompletionResponse(anthropicResp *Message) *llm.Response {
 
 	// Convert content to message
 	var (
-		content   llm.MessageContent
-		toolCalls []llm.ToolCall
-		textParts []string
+		content          llm.MessageContent
+		toolCalls        []llm.ToolCall
+		textParts        []string
+		structuredOutput bool
 	)
 
 	for _, block := range anthropicResp.Content {
@@ -336,15 +396,34 @@ func convertToChatCompletionResponse(anthropicResp *Message) *llm.Response {
 			}
 		case "image":
 			if block.Source != nil {
+				url := block.Source.URL
+				if block.Source.Type == "base64" {
+					url = fmt.Sprintf("data:%s;base64,%s", block.Source.MediaType, block.Source.Data)
+				}
+
 				content.MultipleContent = append(content.MultipleContent, llm.MessageContentPart{
-					Type: "image",
+					Type: "image_url",
 					ImageURL: &llm.ImageURL{
-						URL:    block.Source.Data,
+						URL:    url,
 						Detail: "",
 					},
 				})
 			}
 		case "tool_use":
+			// Unwrap the structured output tool input back into the message content.
+			if unwrapStructuredOutput && isStructuredOutputBlock(&block) {
+				structuredOutput = true
+				text := string(block.Input)
+				textParts = append(textParts, text)
+				content.MultipleContent = append(content.MultipleContent, llm.MessageContentPart{
+					Type:     "text",
+					Text:     &text,
+					ImageURL: &llm.ImageURL{},
+				})
+
+				continue
+			}
+
 			if block.ID != "" && block.Name != nil {
 				toolCall := llm.ToolCall{
 					ID:   block.ID,
@@ -389,10 +468,16 @@ func convertToChatCompletionResponse(anthropicResp *Message) *llm.Response {
 		ToolCalls: toolCalls,
 	}
 
+	finishReason := convertFinishReason(anthropicResp.StopReason)
+	// The structured output tool call is the final answer, not a tool call for the client.
+	if structuredOutput && len(toolCalls) == 0 && finishReason != nil && *finishReason == "tool_calls" {
+		finishReason = lo.ToPtr("stop")
+	}
+
 	choice := llm.Choice{
 		Index:        0,
 		Message:      message,
-		FinishReason: convertFinishReason(anthropicResp.StopReason),
+		FinishReason: finishReason,
 	}
 
 	resp.Choices = []llm.Choice{choice}
@@ -408,9 +493,10 @@ func convertToChatCompletionResponse(anthropicResp *Message) *llm.Response {
 		}
 
 		// Map detailed token information from Anthropic format to unified model
-		if anthropicResp.Usage.CacheReadInputTokens > 0 {
+		if anthropicResp.Usage.CacheReadInputTokens > 0 || anthropicResp.Usage.CacheCreationInputTokens > 0 {
 			usage.PromptTokensDetails = &llm.PromptTokensDetails{
-				CachedTokens: int(anthropicResp.Usage.CacheReadInputTokens),
+				CachedTokens:        int(anthropicResp.Usage.CacheReadInputTokens),
+				CacheCreationTokens: int(anthropicResp.Usage.CacheCreationInputTokens),
 			}
 		}
 
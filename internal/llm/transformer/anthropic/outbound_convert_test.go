@@ -29,7 +29,7 @@ func TestConvertToChatCompletionResponse(t *testing.T) {
 		},
 	}
 
-	result := convertToChatCompletionResponse(anthropicResp)
+	result := convertToChatCompletionResponse(anthropicResp, false)
 
 	require.Equal(t, "msg_123", result.ID)
 	require.Equal(t, "chat.completion", result.Object)
@@ -199,7 +199,7 @@ func TestConvertToChatCompletionResponse_EdgeCases(t *testing.T) {
 						StopReason: func() *string { s := anthropicReason; return &s }(),
 					}
 
-					result := convertToChatCompletionResponse(msg)
+					result := convertToChatCompletionResponse(msg, false)
 					if expectedReason == "stop" {
 						require.Equal(t, expectedReason, *result.Choices[0].FinishReason)
 					} else {
@@ -321,7 +321,7 @@ func TestConvertToChatCompletionResponse_EdgeCases(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := convertToChatCompletionResponse(tt.input)
+			result := convertToChatCompletionResponse(tt.input, false)
 			tt.validate(t, result)
 		})
 	}
@@ -567,7 +567,8 @@ func Test_convertUsage(t *testing.T) {
 				CompletionTokens: 50,
 				TotalTokens:      150,
 				PromptTokensDetails: &llm.PromptTokensDetails{
-					CachedTokens: 30,
+					CachedTokens:        30,
+					CacheCreationTokens: 20,
 				},
 			},
 		},
@@ -587,7 +588,8 @@ func Test_convertUsage(t *testing.T) {
 				CompletionTokens: 50,
 				TotalTokens:      300,
 				PromptTokensDetails: &llm.PromptTokensDetails{
-					CachedTokens: 150,
+					CachedTokens:        150,
+					CacheCreationTokens: 20,
 				},
 			},
 		},
@@ -23,7 +23,7 @@ func (t *OutboundTransformer) TransformStream(
 	// Append the DONE event to the filtered stream
 	streamWithDone := streams.AppendStream(filteredStream, doneEvent)
 
-	return streams.NoNil(newOutboundStream(streamWithDone)), nil
+	return streams.NoNil(newOutboundStream(streamWithDone, usesStructuredOutput(llm.RequestFromContext(ctx)))), nil
 }
 
 // filterStreamEvent determines if a stream event should be processed
@@ -44,32 +44,70 @@ func filterStreamEvent(event *httpclient.StreamEvent) bool {
 	}
 }
 
-// streamState holds the state for a streaming session.
+// streamState holds the state of a choice for a streaming session.
 type streamState struct {
-	streamID    string
-	streamModel string
 	streamUsage *llm.Usage
 	// Tool call tracking
 	toolIndex int
 	toolCalls map[int]*llm.ToolCall // index -> tool call
+	// structuredOutput indicates the current content block is the structured output tool call,
+	// the input of it will be unwrapped into the message content.
+	structuredOutput bool
+	// structuredOutputUsed indicates the structured output tool has been called in the stream.
+	structuredOutputUsed bool
 }
 
 // outboundStream wraps a stream and maintains state during processing.
 type outboundStream struct {
-	stream  streams.Stream[*httpclient.StreamEvent]
-	state   *streamState
+	stream      streams.Stream[*httpclient.StreamEvent]
+	streamID    string
+	streamModel string
+	// states holds the state of each choice, there are multiple choices if the request is fanned out.
+	states  map[int]*streamState
 	current *llm.Response
 	err     error
+	// unwrapStructuredOutput is true if the call of the structured output tool is unwrapped into the content.
+	unwrapStructuredOutput bool
 }
 
-func newOutboundStream(stream streams.Stream[*httpclient.StreamEvent]) *outboundStream {
+func newOutboundStream(stream streams.Stream[*httpclient.StreamEvent], unwrapStructuredOutput bool) *outboundStream {
 	return &outboundStream{
-		stream: stream,
-		state: &streamState{
+		stream:                 stream,
+		states:                 make(map[int]*streamState),
+		unwrapStructuredOutput: unwrapStructuredOutput,
+	}
+}
+
+func (s *outboundStream) choiceState(index int) *streamState {
+	state, ok := s.states[index]
+	if !ok {
+		state = &streamState{
 			toolCalls: make(map[int]*llm.ToolCall),
 			toolIndex: -1,
-		},
+		}
+		s.states[index] = state
+	}
+
+	return state
+}
+
+// usage returns the usage of the stream, the usage of all choices are summed.
+func (s *outboundStream) usage() *llm.Usage {
+	var total *llm.Usage
+
+	if len(s.states) == 1 {
+		for _, state := range s.states {
+			total = state.streamUsage
+		}
+
+		return total
 	}
+
+	for _, state := range s.states {
+		total = addUsage(total, state.streamUsage)
+	}
+
+	return total
 }
 
 func (s *outboundStream) Next() bool {
@@ -115,8 +153,6 @@ func (s *outboundStream) transformStreamChunk(event *httpclient.StreamEvent) (*l
 		}
 	}
 
-	state := s.state
-
 	// Parse the streaming event
 	var streamEvent StreamEvent
 
@@ -125,27 +161,32 @@ func (s *outboundStream) transformStreamChunk(event *httpclient.StreamEvent) (*l
 		return nil, fmt.Errorf("failed to unmarshal anthropic stream event: %w", err)
 	}
 
+	choiceIndex := streamEvent.ChoiceIndex
+	state := s.choiceState(choiceIndex)
+
 	// Convert the stream event to ChatCompletionResponse
 	resp := &llm.Response{
 		Object: "chat.completion.chunk",
-		ID:     state.streamID,    // Use stored ID from message_start
-		Model:  state.streamModel, // Use stored model from message_start
+		ID:     s.streamID,    // Use stored ID from message_start
+		Model:  s.streamModel, // Use stored model from message_start
 	}
 
 	switch streamEvent.Type {
 	case "message_start":
 		if streamEvent.Message != nil {
-			// Store ID, model, and usage for subsequent events
-			state.streamID = streamEvent.Message.ID
-			state.streamModel = streamEvent.Message.Model
+			// Store ID, model, and usage for subsequent events, the first choice is used for the fanout requests.
+			if s.streamID == "" {
+				s.streamID = streamEvent.Message.ID
+				s.streamModel = streamEvent.Message.Model
+			}
 
 			// Update response with stored values
-			resp.ID = state.streamID
-			resp.Model = state.streamModel
+			resp.ID = s.streamID
+			resp.Model = s.streamModel
 
 			if streamEvent.Message.Usage != nil {
 				state.streamUsage = lo.ToPtr(convertToLlmUsage(*streamEvent.Message.Usage))
-				resp.Usage = state.streamUsage
+				resp.Usage = s.usage()
 			}
 
 			resp.Created = 0
@@ -156,7 +197,7 @@ func (s *outboundStream) transformStreamChunk(event *httpclient.StreamEvent) (*l
 		// For message_start, we return an empty choice to indicate the start
 		resp.Choices = []llm.Choice{
 			{
-				Index: 0,
+				Index: choiceIndex,
 				Delta: &llm.Message{
 					Role: "assistant",
 				},
@@ -164,6 +205,14 @@ func (s *outboundStream) transformStreamChunk(event *httpclient.StreamEvent) (*l
 		}
 
 	case "content_block_start":
+		state.structuredOutput = s.unwrapStructuredOutput && isStructuredOutputBlock(streamEvent.ContentBlock)
+		if state.structuredOutput {
+			state.structuredOutputUsed = true
+
+			//nolint:nilnil // It is expected.
+			return nil, nil
+		}
+
 		// Only process tool_use content blocks, skip text content blocks
 		if streamEvent.ContentBlock != nil && streamEvent.ContentBlock.Type == "tool_use" {
 			// Initialize a new tool call
@@ -180,7 +229,7 @@ func (s *outboundStream) transformStreamChunk(event *httpclient.StreamEvent) (*l
 			state.toolCalls[state.toolIndex] = &toolCall
 
 			choice := llm.Choice{
-				Index: 0,
+				Index: choiceIndex,
 				Delta: &llm.Message{
 					Role:      "assistant",
 					ToolCalls: []llm.ToolCall{toolCall},
@@ -194,10 +243,32 @@ func (s *outboundStream) transformStreamChunk(event *httpclient.StreamEvent) (*l
 
 	case "content_block_delta":
 		if streamEvent.Delta != nil {
+			// Unwrap the structured output tool input into the content.
+			if streamEvent.Delta.PartialJSON != nil && state.structuredOutput {
+				if *streamEvent.Delta.PartialJSON == "" {
+					//nolint:nilnil // It is expected.
+					return nil, nil
+				}
+
+				resp.Choices = []llm.Choice{
+					{
+						Index: choiceIndex,
+						Delta: &llm.Message{
+							Role: "assistant",
+							Content: llm.MessageContent{
+								Content: streamEvent.Delta.PartialJSON,
+							},
+						},
+					},
+				}
+
+				return resp, nil
+			}
+
 			// Handle tool use deltas (input_json_delta)
 			if streamEvent.Delta.PartialJSON != nil {
 				choice := llm.Choice{
-					Index: 0,
+					Index: choiceIndex,
 					Delta: &llm.Message{
 						Role: "assistant",
 						ToolCalls: []llm.ToolCall{
@@ -218,7 +289,7 @@ func (s *outboundStream) transformStreamChunk(event *httpclient.StreamEvent) (*l
 			}
 
 			choice := llm.Choice{
-				Index: 0,
+				Index: choiceIndex,
 				Delta: &llm.Message{
 					Role: "assistant",
 				},
@@ -261,7 +332,7 @@ func (s *outboundStream) transformStreamChunk(event *httpclient.StreamEvent) (*l
 			state.streamUsage = &usage
 		}
 
-		resp.Usage = state.streamUsage
+		resp.Usage = s.usage()
 
 		if streamEvent.Delta != nil && streamEvent.Delta.StopReason != nil {
 			// Determine finish reason
@@ -279,6 +350,11 @@ func (s *outboundStream) transformStreamChunk(event *httpclient.StreamEvent) (*l
 				finishReason = &reason
 			case "tool_use":
 				reason := "tool_calls"
+				// The structured output tool call is the final answer, not a tool call for the client.
+				if state.structuredOutputUsed && len(state.toolCalls) == 0 {
+					reason = "stop"
+				}
+
 				finishReason = &reason
 			default:
 				finishReason = streamEvent.Delta.StopReason
@@ -286,7 +362,7 @@ func (s *outboundStream) transformStreamChunk(event *httpclient.StreamEvent) (*l
 
 			resp.Choices = []llm.Choice{
 				{
-					Index:        0,
+					Index:        choiceIndex,
 					FinishReason: finishReason,
 				},
 			}
@@ -296,9 +372,7 @@ func (s *outboundStream) transformStreamChunk(event *httpclient.StreamEvent) (*l
 		// Final event - return empty response to indicate completion
 		resp.Choices = []llm.Choice{}
 		// Include final usage information
-		if state.streamUsage != nil {
-			resp.Usage = state.streamUsage
-		}
+		resp.Usage = s.usage()
 
 	default:
 		// This should not happen due to filtering, but handle gracefully
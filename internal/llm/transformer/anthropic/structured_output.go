@@ -0,0 +1,76 @@
+package anthropic
+
+import (
+	"encoding/json"
+
+	"github.com/samber/lo"
+
+	"github.com/looplj/axonhub/internal/llm"
+)
+
+// structuredOutputToolName is the name of the tool used to emulate the json schema response format,
+// Anthropic does not support the response format natively, so the model is forced to call the tool
+// with the schema as the input schema, and the tool input is unwrapped back into the message content.
+const structuredOutputToolName = "structured_output"
+
+const structuredOutputToolDescription = "Respond to the user with the final answer by calling this tool, the input must follow the input schema."
+
+// usesStructuredOutput returns true if the structured output tool is added to the request to emulate the json schema
+// response format, the tool of the client with the same name is not overridden and its calls are kept as is.
+func usesStructuredOutput(chatReq *llm.Request) bool {
+	if chatReq == nil || !chatReq.ResponseFormat.IsJSONSchema() {
+		return false
+	}
+
+	return !lo.ContainsBy(chatReq.Tools, func(tool llm.Tool) bool { return tool.Function.Name == structuredOutputToolName })
+}
+
+// applyStructuredOutput adds the structured output tool to the request if the response format is json schema.
+func applyStructuredOutput(req *MessageRequest, chatReq *llm.Request) {
+	if !usesStructuredOutput(chatReq) {
+		return
+	}
+
+	jsonSchema := chatReq.ResponseFormat.JSONSchema
+
+	schema := jsonSchema.Schema
+	if len(schema) == 0 {
+		schema = json.RawMessage(`{"type":"object"}`)
+	}
+
+	description := structuredOutputToolDescription
+	if jsonSchema.Description != "" {
+		description = jsonSchema.Description + "\n" + structuredOutputToolDescription
+	}
+
+	clientTools := len(req.Tools)
+
+	req.Tools = append(req.Tools, Tool{
+		Name:        structuredOutputToolName,
+		Description: description,
+		InputSchema: schema,
+	})
+
+	// Keep the tool choice of the client, the model follows the tool description to respond.
+	if req.ToolChoice != nil {
+		return
+	}
+
+	// Forced tool use is not compatible with extended thinking, the model follows the tool description instead.
+	if req.Thinking != nil && req.Thinking.Type == "enabled" {
+		return
+	}
+
+	// The model can still use the tools of the client, and respond with the structured output tool at the end.
+	if clientTools > 0 {
+		req.ToolChoice = &ToolChoice{Type: "any"}
+		return
+	}
+
+	req.ToolChoice = &ToolChoice{Type: "tool", Name: lo.ToPtr(structuredOutputToolName)}
+}
+
+// isStructuredOutputBlock returns true if the content block is the call of the structured output tool.
+func isStructuredOutputBlock(block *MessageContentBlock) bool {
+	return block != nil && block.Type == "tool_use" && block.Name != nil && *block.Name == structuredOutputToolName
+}
@@ -0,0 +1,181 @@
+package anthropic
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/looplj/axonhub/internal/llm"
+	"github.com/looplj/axonhub/internal/pkg/httpclient"
+	"github.com/looplj/axonhub/internal/pkg/streams"
+)
+
+func newStructuredOutputRequest() *llm.Request {
+	return &llm.Request{
+		Model: "claude-sonnet-4",
+		Messages: []llm.Message{
+			{Role: "user", Content: llm.MessageContent{Content: lo.ToPtr("Extract the user.")}},
+		},
+		ResponseFormat: &llm.ResponseFormat{
+			Type: "json_schema",
+			JSONSchema: &llm.JSONSchema{
+				Name:   "user",
+				Schema: json.RawMessage(`{"type":"object","properties":{"name":{"type":"string"}},"required":["name"]}`),
+			},
+		},
+	}
+}
+
+func TestApplyStructuredOutput(t *testing.T) {
+	t.Run("force the structured output tool", func(t *testing.T) {
+		got := convertToAnthropicRequest(newStructuredOutputRequest())
+
+		require.Len(t, got.Tools, 1)
+		require.Equal(t, structuredOutputToolName, got.Tools[0].Name)
+		require.JSONEq(t, `{"type":"object","properties":{"name":{"type":"string"}},"required":["name"]}`, string(got.Tools[0].InputSchema))
+		require.Equal(t, &ToolChoice{Type: "tool", Name: lo.ToPtr(structuredOutputToolName)}, got.ToolChoice)
+	})
+
+	t.Run("keep the client tools", func(t *testing.T) {
+		chatReq := newStructuredOutputRequest()
+		chatReq.Tools = []llm.Tool{{Type: "function", Function: llm.Function{Name: "search"}}}
+
+		got := convertToAnthropicRequest(chatReq)
+
+		require.Len(t, got.Tools, 2)
+		require.Equal(t, "search", got.Tools[0].Name)
+		require.Equal(t, structuredOutputToolName, got.Tools[1].Name)
+		require.Equal(t, &ToolChoice{Type: "any"}, got.ToolChoice)
+	})
+
+	t.Run("keep the tool choice of the client", func(t *testing.T) {
+		chatReq := newStructuredOutputRequest()
+		chatReq.Tools = []llm.Tool{{Type: "function", Function: llm.Function{Name: "search"}}}
+		chatReq.ToolChoice = &llm.ToolChoice{ToolChoice: lo.ToPtr("auto")}
+
+		got := convertToAnthropicRequest(chatReq)
+
+		require.Len(t, got.Tools, 2)
+		require.Equal(t, &ToolChoice{Type: "auto"}, got.ToolChoice)
+	})
+
+	t.Run("no forced tool with thinking", func(t *testing.T) {
+		chatReq := newStructuredOutputRequest()
+		chatReq.ReasoningEffort = "low"
+
+		got := convertToAnthropicRequest(chatReq)
+
+		require.Len(t, got.Tools, 1)
+		require.Nil(t, got.ToolChoice)
+	})
+
+	t.Run("json object is not emulated", func(t *testing.T) {
+		chatReq := newStructuredOutputRequest()
+		chatReq.ResponseFormat = &llm.ResponseFormat{Type: "json_object"}
+
+		got := convertToAnthropicRequest(chatReq)
+
+		require.Empty(t, got.Tools)
+		require.Nil(t, got.ToolChoice)
+	})
+}
+
+func TestStructuredOutput_Response(t *testing.T) {
+	message := &Message{
+		ID:    "msg_1",
+		Type:  "message",
+		Role:  "assistant",
+		Model: "claude-sonnet-4",
+		Content: []MessageContentBlock{
+			{Type: "tool_use", ID: "toolu_1", Name: lo.ToPtr(structuredOutputToolName), Input: json.RawMessage(`{"name":"alice"}`)},
+		},
+		StopReason: lo.ToPtr("tool_use"),
+	}
+
+	t.Run("unwrap the structured output tool", func(t *testing.T) {
+		resp := convertToChatCompletionResponse(message, true)
+
+		require.Len(t, resp.Choices, 1)
+		require.Equal(t, `{"name":"alice"}`, *resp.Choices[0].Message.Content.Content)
+		require.Empty(t, resp.Choices[0].Message.ToolCalls)
+		require.Equal(t, "stop", *resp.Choices[0].FinishReason)
+	})
+
+	t.Run("keep the tool call of the client", func(t *testing.T) {
+		resp := convertToChatCompletionResponse(message, false)
+
+		require.Len(t, resp.Choices, 1)
+		require.Len(t, resp.Choices[0].Message.ToolCalls, 1)
+		require.Equal(t, structuredOutputToolName, resp.Choices[0].Message.ToolCalls[0].Function.Name)
+		require.Equal(t, "tool_calls", *resp.Choices[0].FinishReason)
+	})
+}
+
+func TestUsesStructuredOutput(t *testing.T) {
+	require.True(t, usesStructuredOutput(newStructuredOutputRequest()))
+	require.False(t, usesStructuredOutput(nil))
+	require.False(t, usesStructuredOutput(&llm.Request{Model: "claude-sonnet-4"}))
+
+	chatReq := newStructuredOutputRequest()
+	chatReq.Tools = []llm.Tool{{Type: "function", Function: llm.Function{Name: structuredOutputToolName}}}
+	require.False(t, usesStructuredOutput(chatReq))
+}
+
+func TestStructuredOutput_Stream(t *testing.T) {
+	events := []string{
+		`{"type":"message_start","message":{"id":"msg_1","type":"message","role":"assistant","model":"claude-sonnet-4","content":[],"usage":{"input_tokens":10,"output_tokens":1}}}`,
+		`{"type":"content_block_start","index":0,"content_block":{"type":"tool_use","id":"toolu_1","name":"structured_output","input":{}}}`,
+		`{"type":"content_block_delta","index":0,"delta":{"type":"input_json_delta","partial_json":""}}`,
+		`{"type":"content_block_delta","index":0,"delta":{"type":"input_json_delta","partial_json":"{\"name\":"}}`,
+		`{"type":"content_block_delta","index":0,"delta":{"type":"input_json_delta","partial_json":"\"alice\"}"}}`,
+		`{"type":"message_delta","delta":{"stop_reason":"tool_use"},"usage":{"output_tokens":5}}`,
+		`{"type":"message_stop"}`,
+	}
+
+	chunks := lo.Map(events, func(data string, _ int) *httpclient.StreamEvent {
+		var event StreamEvent
+		require.NoError(t, json.Unmarshal([]byte(data), &event))
+
+		return &httpclient.StreamEvent{Type: event.Type, Data: []byte(data)}
+	})
+
+	transformer, err := NewOutboundTransformer("", "")
+	require.NoError(t, err)
+
+	stream, err := transformer.TransformStream(
+		llm.NewRequestContext(context.Background(), newStructuredOutputRequest()),
+		streams.SliceStream(chunks),
+	)
+	require.NoError(t, err)
+
+	var (
+		content      string
+		toolCalls    int
+		finishReason string
+	)
+
+	for stream.Next() {
+		resp := stream.Current()
+		for _, choice := range resp.Choices {
+			if choice.Delta != nil {
+				if choice.Delta.Content.Content != nil {
+					content += *choice.Delta.Content.Content
+				}
+
+				toolCalls += len(choice.Delta.ToolCalls)
+			}
+
+			if choice.FinishReason != nil {
+				finishReason = *choice.FinishReason
+			}
+		}
+	}
+
+	require.NoError(t, stream.Err())
+	require.Equal(t, `{"name":"alice"}`, content)
+	require.Zero(t, toolCalls)
+	require.Equal(t, "stop", finishReason)
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"slices"
 	"strings"
 
 	"github.com/samber/lo"
@@ -17,11 +18,42 @@ type choiceAggregator struct {
 	index            int
 	content          strings.Builder
 	reasoningContent strings.Builder
+	audio            *audioAggregator
 	toolCalls        map[int]*llm.ToolCall // Map to track tool calls by their index within the choice
 	finishReason     *string
 	role             string
 }
 
+// audioAggregator aggregates the audio deltas of a choice, the data and the transcript are streamed in chunks.
+type audioAggregator struct {
+	id         string
+	expiresAt  int64
+	data       strings.Builder
+	transcript strings.Builder
+}
+
+func (a *audioAggregator) add(delta *llm.MessageAudio) {
+	if delta.ID != "" {
+		a.id = delta.ID
+	}
+
+	if delta.ExpiresAt != 0 {
+		a.expiresAt = delta.ExpiresAt
+	}
+
+	a.data.WriteString(delta.Data)
+	a.transcript.WriteString(delta.Transcript)
+}
+
+func (a *audioAggregator) message() *llm.MessageAudio {
+	return &llm.MessageAudio{
+		ID:         a.id,
+		Data:       a.data.String(),
+		ExpiresAt:  a.expiresAt,
+		Transcript: a.transcript.String(),
+	}
+}
+
 type ChunkTransformFunc func(ctx context.Context, chunk *httpclient.StreamEvent) (*Response, error)
 
 func DefaultTransformChunk(ctx context.Context, chunk *httpclient.StreamEvent) (*Response, error) {
@@ -90,6 +122,15 @@ func AggregateStreamChunks(ctx context.Context, chunks []*httpclient.StreamEvent
 					choiceAgg.reasoningContent.WriteString(*choice.Delta.ReasoningContent)
 				}
 
+				// Handle audio
+				if choice.Delta.Audio != nil {
+					if choiceAgg.audio == nil {
+						choiceAgg.audio = &audioAggregator{}
+					}
+
+					choiceAgg.audio.add(choice.Delta.Audio)
+				}
+
 				// Handle tool calls
 				if len(choice.Delta.ToolCalls) > 0 {
 					for _, deltaToolCall := range choice.Delta.ToolCalls {
@@ -157,9 +198,13 @@ func AggregateStreamChunks(ctx context.Context, chunks []*httpclient.StreamEvent
 		return data, llm.ResponseMeta{}, err
 	}
 
-	choices := make([]llm.Choice, len(choicesAggs))
+	// Sort the choices by index, the chunks of the choices may be interleaved.
+	choiceIndexes := lo.Keys(choicesAggs)
+	slices.Sort(choiceIndexes)
 
-	for choiceIndex := range choices {
+	choices := make([]llm.Choice, 0, len(choiceIndexes))
+
+	for _, choiceIndex := range choiceIndexes {
 		choiceAgg := choicesAggs[choiceIndex]
 
 		var finalToolCalls []llm.ToolCall
@@ -181,6 +226,10 @@ func AggregateStreamChunks(ctx context.Context, chunks []*httpclient.StreamEvent
 			message.ReasoningContent = &reasoningContent
 		}
 
+		if choiceAgg.audio != nil {
+			message.Audio = choiceAgg.audio.message()
+		}
+
 		// Set content or tool calls
 		if len(finalToolCalls) > 0 {
 			message.ToolCalls = finalToolCalls
@@ -201,11 +250,11 @@ func AggregateStreamChunks(ctx context.Context, chunks []*httpclient.StreamEvent
 			}
 		}
 
-		choices[choiceIndex] = llm.Choice{
+		choices = append(choices, llm.Choice{
 			Index:        choiceIndex,
 			Message:      message,
 			FinishReason: finishReason,
-		}
+		})
 	}
 
 	// Build the final response using llm.Response struct
@@ -5,9 +5,11 @@ import (
 	"encoding/json"
 	"testing"
 
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
 
 	"github.com/looplj/axonhub/internal/llm"
+	"github.com/looplj/axonhub/internal/pkg/httpclient"
 	"github.com/looplj/axonhub/internal/pkg/xtest"
 )
 
@@ -150,3 +152,56 @@ func TestAggregateStreamChunks_EmptyChunks(t *testing.T) {
 
 	require.Equal(t, llm.Response{}, got)
 }
+
+func TestAggregateStreamChunks_InterleavedChoices(t *testing.T) {
+	chunks := []*httpclient.StreamEvent{
+		{Data: []byte(`{"id":"chatcmpl-1","object":"chat.completion.chunk","model":"gpt-4o","choices":[{"index":1,"delta":{"role":"assistant","content":"se"}}]}`)},
+		{Data: []byte(`{"id":"chatcmpl-1","object":"chat.completion.chunk","model":"gpt-4o","choices":[{"index":0,"delta":{"role":"assistant","content":"fir"}}]}`)},
+		{Data: []byte(`{"id":"chatcmpl-1","object":"chat.completion.chunk","model":"gpt-4o","choices":[{"index":1,"delta":{"content":"cond"},"finish_reason":"stop"}]}`)},
+		{Data: []byte(`{"id":"chatcmpl-1","object":"chat.completion.chunk","model":"gpt-4o","choices":[{"index":0,"delta":{"content":"st"},"finish_reason":"length"}]}`)},
+		{Data: []byte(`{"id":"chatcmpl-1","object":"chat.completion.chunk","model":"gpt-4o","choices":[],"usage":{"prompt_tokens":10,"completion_tokens":6,"total_tokens":16}}`)},
+		{Data: []byte(`[DONE]`)},
+	}
+
+	gotBytes, meta, err := AggregateStreamChunks(context.Background(), chunks, DefaultTransformChunk)
+	require.NoError(t, err)
+
+	var got llm.Response
+	require.NoError(t, json.Unmarshal(gotBytes, &got))
+
+	require.Len(t, got.Choices, 2)
+	require.Equal(t, 0, got.Choices[0].Index)
+	require.Equal(t, "first", *got.Choices[0].Message.Content.Content)
+	require.Equal(t, "length", *got.Choices[0].FinishReason)
+	require.Equal(t, 1, got.Choices[1].Index)
+	require.Equal(t, "second", *got.Choices[1].Message.Content.Content)
+	require.Equal(t, "stop", *got.Choices[1].FinishReason)
+	require.Equal(t, 16, meta.Usage.TotalTokens)
+}
+
+func TestAggregateStreamChunks_Audio(t *testing.T) {
+	chunks := []*httpclient.StreamEvent{
+		{Data: []byte(`{"id":"chatcmpl-1","object":"chat.completion.chunk","model":"gpt-4o-audio-preview","choices":[{"index":0,"delta":{"role":"assistant","audio":{"id":"audio_1","transcript":"Hel"}}}]}`)},
+		{Data: []byte(`{"id":"chatcmpl-1","object":"chat.completion.chunk","model":"gpt-4o-audio-preview","choices":[{"index":0,"delta":{"audio":{"transcript":"lo","data":"UklG"}}}]}`)},
+		{Data: []byte(`{"id":"chatcmpl-1","object":"chat.completion.chunk","model":"gpt-4o-audio-preview","choices":[{"index":0,"delta":{"audio":{"data":"RiQA","expires_at":1729234747}},"finish_reason":"stop"}]}`)},
+		{Data: []byte(`{"id":"chatcmpl-1","object":"chat.completion.chunk","model":"gpt-4o-audio-preview","choices":[],"usage":{"prompt_tokens":20,"completion_tokens":40,"total_tokens":60,"prompt_tokens_details":{"audio_tokens":12},"completion_tokens_details":{"audio_tokens":33}}}`)},
+		{Data: []byte(`[DONE]`)},
+	}
+
+	gotBytes, meta, err := AggregateStreamChunks(context.Background(), chunks, DefaultTransformChunk)
+	require.NoError(t, err)
+
+	var got llm.Response
+	require.NoError(t, json.Unmarshal(gotBytes, &got))
+
+	require.Len(t, got.Choices, 1)
+	require.Empty(t, lo.FromPtr(got.Choices[0].Message.Content.Content))
+	require.Equal(t, &llm.MessageAudio{
+		ID:         "audio_1",
+		Data:       "UklGRiQA",
+		ExpiresAt:  1729234747,
+		Transcript: "Hello",
+	}, got.Choices[0].Message.Audio)
+	require.Equal(t, 12, meta.Usage.PromptTokensDetails.AudioTokens)
+	require.Equal(t, 33, meta.Usage.CompletionTokensDetails.AudioTokens)
+}
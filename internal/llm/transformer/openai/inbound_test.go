@@ -50,6 +50,26 @@ func TestInboundTransformer_TransformRequest(t *testing.T) {
 					req.Messages[0].Content.Content != nil && *req.Messages[0].Content.Content == "Hello, world!"
 			},
 		},
+		{
+			name: "audio input and output",
+			request: &httpclient.Request{
+				Method: http.MethodPost,
+				URL:    "/v1/chat/completions",
+				Headers: http.Header{
+					"Content-Type": []string{"application/json"},
+				},
+				Body: []byte(`{"model":"gpt-4o-audio-preview","modalities":["text","audio"],"audio":{"voice":"alloy","format":"wav"},` +
+					`"messages":[{"role":"user","content":[{"type":"input_audio","input_audio":{"data":"SUQz","format":"mp3"}}]}]}`),
+			},
+			wantErr: false,
+			validate: func(req *llm.Request) bool {
+				part := req.Messages[0].Content.MultipleContent[0]
+
+				return len(req.Modalities) == 2 && req.Modalities[1] == "audio" &&
+					req.Audio != nil && req.Audio.Voice == "alloy" && req.Audio.Format == "wav" &&
+					part.InputAudio != nil && part.InputAudio.Data == "SUQz" && part.InputAudio.Format == "mp3"
+			},
+		},
 		{
 			name:        "nil request",
 			request:     nil,
@@ -76,6 +76,86 @@ func TestOutboundTransformer_TransformRequest(t *testing.T) {
 				return req.URL == "https://custom.api.com/v1/chat/completions"
 			},
 		},
+		{
+			name:        "json schema response format",
+			transformer: createTransformer("https://api.openai.com/v1", "test-key"),
+			request: &llm.Request{
+				Model: "gpt-4o",
+				Messages: []llm.Message{
+					{
+						Role: "user",
+						Content: llm.MessageContent{
+							Content: lo.ToPtr("Hello, world!"),
+						},
+					},
+				},
+				ResponseFormat: &llm.ResponseFormat{
+					Type: "json_schema",
+					JSONSchema: &llm.JSONSchema{
+						Name:   "greeting",
+						Schema: json.RawMessage(`{"type":"object","properties":{"text":{"type":"string"}}}`),
+						Strict: lo.ToPtr(true),
+					},
+				},
+			},
+			wantErr: false,
+			validate: func(req *httpclient.Request) bool {
+				var body map[string]any
+				if err := json.Unmarshal(req.Body, &body); err != nil {
+					return false
+				}
+
+				data, err := json.Marshal(body["response_format"])
+				if err != nil {
+					return false
+				}
+
+				return string(data) == `{"json_schema":{"name":"greeting","schema":{"properties":{"text":{"type":"string"}},"type":"object"},"strict":true},"type":"json_schema"}`
+			},
+		},
+		{
+			name:        "audio input and output",
+			transformer: createTransformer("https://api.openai.com/v1", "test-key"),
+			request: &llm.Request{
+				Model:      "gpt-4o-audio-preview",
+				Modalities: []string{"text", "audio"},
+				Audio:      &llm.AudioOutputParams{Voice: "alloy", Format: "wav"},
+				Messages: []llm.Message{
+					{
+						Role: "user",
+						Content: llm.MessageContent{
+							MultipleContent: []llm.MessageContentPart{
+								{Type: "text", Text: lo.ToPtr("What is in this recording?")},
+								{Type: "input_audio", InputAudio: &llm.Audio{Format: "mp3", Data: "SUQz"}},
+							},
+						},
+					},
+					{
+						Role:  "assistant",
+						Audio: &llm.MessageAudio{ID: "audio_1"},
+					},
+				},
+			},
+			wantErr: false,
+			validate: func(req *httpclient.Request) bool {
+				var body struct {
+					Modalities []string        `json:"modalities"`
+					Audio      json.RawMessage `json:"audio"`
+					Messages   []struct {
+						Content json.RawMessage `json:"content"`
+						Audio   json.RawMessage `json:"audio"`
+					} `json:"messages"`
+				}
+				if err := json.Unmarshal(req.Body, &body); err != nil {
+					return false
+				}
+
+				return len(body.Modalities) == 2 && body.Modalities[1] == "audio" &&
+					string(body.Audio) == `{"voice":"alloy","format":"wav"}` &&
+					strings.Contains(string(body.Messages[0].Content), `{"type":"input_audio","input_audio":{"format":"mp3","data":"SUQz"}}`) &&
+					string(body.Messages[1].Audio) == `{"id":"audio_1"}`
+			},
+		},
 
 		{
 			name:        "nil request",
@@ -412,6 +492,25 @@ func TestOutboundTransformer_TransformResponse(t *testing.T) {
 					*resp.Choices[0].Message.Content.Content == "Hello! How can I help you today?"
 			},
 		},
+		{
+			name: "audio response",
+			response: &httpclient.Response{
+				StatusCode: http.StatusOK,
+				Headers:    http.Header{"Content-Type": []string{"application/json"}},
+				Body: []byte(`{"id":"chatcmpl-audio","object":"chat.completion","created":1677652288,"model":"gpt-4o-audio-preview",` +
+					`"choices":[{"index":0,"message":{"role":"assistant","content":null,"audio":{"id":"audio_1","data":"UklGRiQA","expires_at":1729234747,"transcript":"Hello"}},"finish_reason":"stop"}],` +
+					`"usage":{"prompt_tokens":20,"completion_tokens":40,"total_tokens":60,"prompt_tokens_details":{"audio_tokens":12,"cached_tokens":0},"completion_tokens_details":{"audio_tokens":33,"reasoning_tokens":0}}}`),
+			},
+			wantErr: false,
+			validate: func(resp *llm.Response) bool {
+				audio := resp.Choices[0].Message.Audio
+
+				return audio != nil && audio.ID == "audio_1" && audio.Data == "UklGRiQA" &&
+					audio.ExpiresAt == 1729234747 && audio.Transcript == "Hello" &&
+					resp.Usage.PromptTokensDetails.AudioTokens == 12 &&
+					resp.Usage.CompletionTokensDetails.AudioTokens == 33
+			},
+		},
 		{
 			name:        "nil response",
 			response:    nil,
@@ -26,6 +26,10 @@ type _Metrics struct {
 	ChatTokenCount      metric.Int64Counter
 	ChatSuccessCount    metric.Int64Counter
 	ChatFailureCount    metric.Int64Counter
+
+	// Persistence metrics
+	PersistQueueDepth   metric.Int64UpDownCounter
+	PersistDroppedCount metric.Int64Counter
 }
 
 var Metrics *_Metrics
@@ -137,6 +141,29 @@ func SetupMetrics(provider *sdk.MeterProvider, name string) error {
 
 	Metrics.ChatFailureCount = chatFailureCount
 
+	// Persistence metrics
+	persistQueueDepth, err := meter.Int64UpDownCounter(
+		"persist_queue_depth",
+		metric.WithDescription("Number of persistence writes queued but not yet applied"),
+		metric.WithUnit("writes"),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create persist_queue_depth counter: %w", err)
+	}
+
+	Metrics.PersistQueueDepth = persistQueueDepth
+
+	persistDroppedCount, err := meter.Int64Counter(
+		"persist_dropped_count",
+		metric.WithDescription("Number of persistence writes dropped because the queue was full"),
+		metric.WithUnit("writes"),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create persist_dropped_count counter: %w", err)
+	}
+
+	Metrics.PersistDroppedCount = persistDroppedCount
+
 	return nil
 }
 
@@ -179,3 +206,17 @@ func (sm *_Metrics) RecordChatRequest(ctx context.Context, channelID, channelNam
 		sm.ChatFailureCount.Add(ctx, 1, metric.WithAttributes(labels...))
 	}
 }
+
+// RecordPersistQueueDepth records the change of the persistence queue depth.
+func (sm *_Metrics) RecordPersistQueueDepth(ctx context.Context, delta int64) {
+	sm.PersistQueueDepth.Add(ctx, delta)
+}
+
+// RecordPersistDrop records a dropped persistence write.
+func (sm *_Metrics) RecordPersistDrop(ctx context.Context, name string) {
+	labels := []attribute.KeyValue{
+		attribute.String("name", name),
+	}
+
+	sm.PersistDroppedCount.Add(ctx, 1, metric.WithAttributes(labels...))
+}
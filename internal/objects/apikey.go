@@ -8,4 +8,21 @@ type APIKeyProfiles struct {
 type APIKeyProfile struct {
 	Name          string         `json:"name"`
 	ModelMappings []ModelMapping `json:"modelMappings"`
+
+	// Overrides are applied to the requests of the API key when the profile is active.
+	Overrides *RequestOverrides `json:"overrides,omitempty"`
+
+	// ModelFallbacks take precedence over the global fallback chains of the same models.
+	ModelFallbacks []ModelFallback `json:"modelFallbacks,omitempty"`
+
+	// RoutingStrategy orders the channels of a model by the price or the latency,
+	// the ordering weight of the channels is used if empty.
+	RoutingStrategy string `json:"routingStrategy,omitempty"`
 }
+
+const (
+	// RoutingStrategyCheapest orders the channels by the price, then the health and the latency.
+	RoutingStrategyCheapest = "cheapest"
+	// RoutingStrategyFastest orders the channels by the latency, then the health and the price.
+	RoutingStrategyFastest = "fastest"
+)
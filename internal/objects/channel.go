@@ -1,5 +1,7 @@
 package objects
 
+import "time"
+
 type ModelMapping struct {
 	// From is the model name in the request.
 	From string `json:"from"`
@@ -10,6 +12,143 @@ type ModelMapping struct {
 
 type ChannelSettings struct {
 	ModelMappings []ModelMapping `json:"modelMappings"`
+
+	// PromptCaching is the prompt caching settings for the Anthropic compatible channels.
+	PromptCaching *PromptCachingSettings `json:"promptCaching,omitempty"`
+
+	// ImageFetch is the remote image fetching settings for the channels which only accept the base64 images.
+	ImageFetch *ImageFetchSettings `json:"imageFetch,omitempty"`
+
+	// Overrides are applied to the requests sent to the channel.
+	Overrides *RequestOverrides `json:"overrides,omitempty"`
+
+	// ExtraHeaders are added to the upstream requests, e.g. the HTTP-Referer and the X-Title of OpenRouter.
+	ExtraHeaders []ExtraHeader `json:"extraHeaders,omitempty"`
+
+	// BodyPatches are applied to the upstream request bodies, e.g. the enable_thinking of DashScope.
+	BodyPatches []BodyPatch `json:"bodyPatches,omitempty"`
+
+	// Transport is the HTTP transport settings of the channel, the shared HTTP client is used if nil.
+	Transport *TransportSettings `json:"transport,omitempty"`
+
+	// ModelPrices are the prices of the models of the channel, they order the channels for the cheapest routing strategy.
+	ModelPrices []ModelPrice `json:"modelPrices,omitempty"`
+
+	// Schedule limits the channel to the weekly time windows, e.g. the off-peak hours, the channel is always available if nil.
+	Schedule *ChannelSchedule `json:"schedule,omitempty"`
+
+	// MaintenanceWindows disable the channel temporarily, the channel is available again after the end of the window.
+	MaintenanceWindows []MaintenanceWindow `json:"maintenanceWindows,omitempty"`
+}
+
+type ChannelSchedule struct {
+	// Timezone is the IANA time zone of the windows, e.g. Asia/Shanghai, default to UTC.
+	Timezone string `json:"timezone,omitempty"`
+
+	// Windows are the weekly time windows the channel is available in.
+	Windows []TimeWindow `json:"windows"`
+}
+
+type TimeWindow struct {
+	// Days are the days of the week the window starts on, 0 is Sunday, every day if empty.
+	Days []int `json:"days,omitempty"`
+
+	// Start is the start time of the window in HH:MM.
+	Start string `json:"start"`
+
+	// End is the end time of the window in HH:MM, the window ends on the next day if it is not after the start.
+	End string `json:"end"`
+}
+
+type MaintenanceWindow struct {
+	StartAt time.Time `json:"startAt"`
+	EndAt   time.Time `json:"endAt"`
+
+	// Reason is shown to the operators, e.g. the nightly maintenance of the internal endpoint.
+	Reason string `json:"reason,omitempty"`
+}
+
+type ModelPrice struct {
+	// Model is the requested model, e.g. the supported model or the mapped model of the channel.
+	Model string `json:"model"`
+
+	// InputPrice is the price per million input tokens.
+	InputPrice float64 `json:"inputPrice"`
+
+	// OutputPrice is the price per million output tokens.
+	OutputPrice float64 `json:"outputPrice"`
+}
+
+type TransportSettings struct {
+	// ProxyURL is the http, https, socks5 or socks5h proxy URL, "direct" disables the proxy,
+	// the proxy from the environment variables is used if empty.
+	ProxyURL string `json:"proxyURL,omitempty"`
+
+	// CACert is the PEM encoded CA certificates trusted in addition to the system ones.
+	CACert string `json:"caCert,omitempty"`
+
+	// InsecureSkipVerify skips the verification of the server certificates, for the internal endpoints only.
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
+
+	// MaxIdleConns is the maximum number of the idle connections.
+	MaxIdleConns int `json:"maxIdleConns,omitempty"`
+
+	// MaxIdleConnsPerHost is the maximum number of the idle connections per host.
+	MaxIdleConnsPerHost int `json:"maxIdleConnsPerHost,omitempty"`
+
+	// IdleConnTimeoutSeconds is the time an idle connection is kept.
+	IdleConnTimeoutSeconds int `json:"idleConnTimeoutSeconds,omitempty"`
+
+	// ConnectTimeoutSeconds is the timeout of establishing the connection.
+	ConnectTimeoutSeconds int `json:"connectTimeoutSeconds,omitempty"`
+
+	// ResponseHeaderTimeoutSeconds is the timeout of waiting for the response headers.
+	ResponseHeaderTimeoutSeconds int `json:"responseHeaderTimeoutSeconds,omitempty"`
+}
+
+type ExtraHeader struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+
+	// Models limits the header to the upstream models, all the models if empty.
+	Models []string `json:"models,omitempty"`
+}
+
+type BodyPatch struct {
+	// Path is the sjson path of the field, e.g. thinking.type.
+	Path string `json:"path"`
+
+	// Value is the JSON value set to the path, e.g. true or {"type":"disabled"}.
+	Value string `json:"value,omitempty"`
+
+	// Delete removes the field instead of setting it.
+	Delete bool `json:"delete,omitempty"`
+
+	// Models limits the patch to the upstream models, all the models if empty.
+	Models []string `json:"models,omitempty"`
+}
+
+type PromptCachingSettings struct {
+	// Auto adds the cache breakpoints for the tools, the system prompt and the conversation
+	// to the requests without any cache breakpoint, e.g. from the OpenAI format clients.
+	Auto bool `json:"auto"`
+
+	// TTL is the time to live of the cache, "5m" or "1h", default to the provider default.
+	TTL string `json:"ttl,omitempty"`
+}
+
+type ImageFetchSettings struct {
+	// Enabled fetches the remote images and inlines them as the base64 data URLs before sending to the provider.
+	Enabled bool `json:"enabled"`
+
+	// MaxBytes is the maximum size of the fetched image, default to 5MB.
+	MaxBytes int `json:"maxBytes,omitempty"`
+
+	// TimeoutSeconds is the timeout of fetching one image, default to 10 seconds.
+	TimeoutSeconds int `json:"timeoutSeconds,omitempty"`
+
+	// MaxDimension downscales the images whose width or height exceeds it, 0 disables the downscaling.
+	MaxDimension int `json:"maxDimension,omitempty"`
 }
 
 type ChannelCredentials struct {
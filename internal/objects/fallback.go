@@ -0,0 +1,17 @@
+package objects
+
+type ModelFallback struct {
+	// Model is the requested model.
+	Model string `json:"model"`
+
+	// Fallbacks are the models tried in order when all the channels of the model fail.
+	Fallbacks []string `json:"fallbacks"`
+}
+
+type FallbackHop struct {
+	// From is the model whose channels failed.
+	From string `json:"from"`
+
+	// To is the fallback model tried next.
+	To string `json:"to"`
+}
@@ -0,0 +1,33 @@
+package objects
+
+type GuardrailPolicy struct {
+	// Enabled applies the rules to the requests and the responses.
+	Enabled bool `json:"enabled"`
+
+	Rules []GuardrailRule `json:"rules"`
+}
+
+type GuardrailRule struct {
+	// Name is the name of the rule recorded in the violations, default to the type.
+	Name string `json:"name,omitempty"`
+
+	// Type is one of keyword, regex, email, phone, credit_card and id_number.
+	Type string `json:"type"`
+
+	// Patterns are the keywords or the regular expressions of the keyword and the regex rules.
+	Patterns []string `json:"patterns,omitempty"`
+
+	// Action is mask or reject, default to mask.
+	Action string `json:"action,omitempty"`
+
+	// Target is request, response or both, default to both.
+	Target string `json:"target,omitempty"`
+}
+
+type GuardrailViolation struct {
+	Rule   string `json:"rule"`
+	Type   string `json:"type"`
+	Action string `json:"action"`
+	Target string `json:"target"`
+	Count  int    `json:"count"`
+}
@@ -0,0 +1,9 @@
+package objects
+
+type HedgingPolicy struct {
+	// Enabled hedges the streaming requests with the next channel.
+	Enabled bool `json:"enabled"`
+
+	// DelayMs is the time in milliseconds to wait for the first token before hedging.
+	DelayMs int `json:"delayMs"`
+}
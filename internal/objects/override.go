@@ -0,0 +1,33 @@
+package objects
+
+type RequestOverrides struct {
+	// DefaultParams are set when the request does not specify them.
+	DefaultParams *RequestParams `json:"defaultParams,omitempty"`
+
+	// ForcedParams replace the parameters of the request.
+	ForcedParams *RequestParams `json:"forcedParams,omitempty"`
+
+	// MaxTemperature clamps the temperature of the request.
+	MaxTemperature *float64 `json:"maxTemperature,omitempty"`
+
+	// MaxTokens sets the max tokens when the request does not specify it and caps it otherwise.
+	MaxTokens int64 `json:"maxTokens,omitempty"`
+
+	// SystemPromptPrepend is added before the system prompt of the request.
+	SystemPromptPrepend string `json:"systemPromptPrepend,omitempty"`
+
+	// SystemPromptAppend is added after the system prompt of the request.
+	SystemPromptAppend string `json:"systemPromptAppend,omitempty"`
+
+	// StripParams are the names of the request parameters to remove, e.g. logit_bias.
+	StripParams []string `json:"stripParams,omitempty"`
+}
+
+type RequestParams struct {
+	Temperature      *float64 `json:"temperature,omitempty"`
+	TopP             *float64 `json:"topP,omitempty"`
+	PresencePenalty  *float64 `json:"presencePenalty,omitempty"`
+	FrequencyPenalty *float64 `json:"frequencyPenalty,omitempty"`
+	Seed             *int64   `json:"seed,omitempty"`
+	ReasoningEffort  string   `json:"reasoningEffort,omitempty"`
+}
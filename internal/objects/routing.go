@@ -0,0 +1,27 @@
+package objects
+
+// RoutingConditions are matched against the request, all the set conditions must match.
+type RoutingConditions struct {
+	// Models restricts the rule to the requested models, the rule applies to all the models if empty.
+	Models []string `json:"models,omitempty"`
+
+	// Images matches the requests with images if true, the requests without images if false.
+	Images *bool `json:"images,omitempty"`
+
+	// Tools matches the requests with tools if true, the requests without tools if false.
+	Tools *bool `json:"tools,omitempty"`
+
+	// MinPromptTokens matches the requests with at least the estimated prompt tokens, ignored if zero.
+	MinPromptTokens int `json:"minPromptTokens,omitempty"`
+
+	// MaxPromptTokens matches the requests with at most the estimated prompt tokens, ignored if zero.
+	MaxPromptTokens int `json:"maxPromptTokens,omitempty"`
+
+	// Metadata matches the requests with all the metadata entries.
+	Metadata []MetadataEntry `json:"metadata,omitempty"`
+}
+
+type MetadataEntry struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
@@ -0,0 +1,29 @@
+package objects
+
+type VirtualModelTarget struct {
+	// Model is the concrete model requested from the channels.
+	Model string `json:"model"`
+
+	// ChannelID restricts the target to the channel, all the channels supporting the model are used if nil.
+	ChannelID *GUID `json:"channelID,omitempty"`
+
+	// Weight is the relative weight among the targets with the same priority, default to 1.
+	Weight int `json:"weight,omitempty"`
+
+	// Priority orders the targets, the targets with the higher priority are tried first.
+	Priority int `json:"priority,omitempty"`
+
+	// Conditions restrict the target to the matched requests, the target matches all the requests if nil.
+	Conditions *VirtualModelConditions `json:"conditions,omitempty"`
+}
+
+type VirtualModelConditions struct {
+	// Stream matches the streaming requests if true, the non-streaming requests if false.
+	Stream *bool `json:"stream,omitempty"`
+
+	// Tools matches the requests with tools if true, the requests without tools if false.
+	Tools *bool `json:"tools,omitempty"`
+
+	// Images matches the requests with images if true, the requests without images if false.
+	Images *bool `json:"images,omitempty"`
+}
@@ -46,6 +46,12 @@ type Executor struct {
 // NewExecutor creates a new Bedrock executor with the specified AWS region.
 // It reads AWS credentials from environment variables.
 func NewExecutor(region string, accessKeyID, secretAccessKey string) (*Executor, error) {
+	return NewExecutorWithTransport(region, accessKeyID, secretAccessKey, nil)
+}
+
+// NewExecutorWithTransport creates a new Bedrock executor sending the requests on the transport,
+// the default transport is used if nil.
+func NewExecutorWithTransport(region string, accessKeyID, secretAccessKey string, transport http.RoundTripper) (*Executor, error) {
 	cfg, err := config.LoadDefaultConfig(context.TODO(),
 		config.WithRegion(region),
 		config.WithCredentialsProvider(
@@ -62,7 +68,7 @@ func NewExecutor(region string, accessKeyID, secretAccessKey string) (*Executor,
 		region:     region,
 		config:     cfg,
 		signer:     v4.NewSigner(),
-		httpClient: &http.Client{},
+		httpClient: &http.Client{Transport: transport},
 	}, nil
 }
 
@@ -23,6 +23,10 @@ type Request struct {
 	// Request tracking
 	RequestID string `json:"request_id"`
 
+	// Fanout is the number of the parallel upstream calls of the request, it is used to emulate the n>1 choices
+	// for the providers which do not support it natively. No fanout if it is less than 2.
+	Fanout int `json:"-"`
+
 	// Raw HTTP request for advanced use cases
 	RawRequest *http.Request `json:"-"`
 }
@@ -0,0 +1,132 @@
+package httpclient
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// ProxyDirect disables the proxy, including the one from the environment variables.
+const ProxyDirect = "direct"
+
+// TransportConfig is the transport settings of the HTTP client.
+type TransportConfig struct {
+	// ProxyURL is the http, https, socks5 or socks5h proxy URL, ProxyDirect disables the proxy,
+	// the proxy from the environment variables is used if empty.
+	ProxyURL string
+
+	// CACert is the PEM encoded CA certificates trusted in addition to the system ones.
+	CACert string
+
+	// InsecureSkipVerify skips the verification of the server certificates, for the internal endpoints only.
+	InsecureSkipVerify bool
+
+	// MaxIdleConns is the maximum number of the idle connections, the default is used if 0.
+	MaxIdleConns int
+
+	// MaxIdleConnsPerHost is the maximum number of the idle connections per host, the default is used if 0.
+	MaxIdleConnsPerHost int
+
+	// IdleConnTimeout is the time an idle connection is kept, the default is used if 0.
+	IdleConnTimeout time.Duration
+
+	// ConnectTimeout is the timeout of establishing the connection, the default is used if 0.
+	ConnectTimeout time.Duration
+
+	// ResponseHeaderTimeout is the timeout of waiting for the response headers after the request is sent, no timeout if 0.
+	ResponseHeaderTimeout time.Duration
+}
+
+// NewTransport creates the HTTP transport of the config, based on the default transport.
+func NewTransport(config TransportConfig) (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	switch config.ProxyURL {
+	case "":
+	case ProxyDirect:
+		transport.Proxy = nil
+	default:
+		proxyURL, err := url.Parse(config.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse proxy url: %w", err)
+		}
+
+		switch proxyURL.Scheme {
+		case "http", "https", "socks5", "socks5h":
+		default:
+			return nil, fmt.Errorf("unsupported proxy scheme: %s", proxyURL.Scheme)
+		}
+
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	if config.CACert != "" || config.InsecureSkipVerify {
+		tlsConfig := &tls.Config{
+			MinVersion: tls.VersionTLS12,
+			//nolint:gosec // It is enabled explicitly for the internal endpoints.
+			InsecureSkipVerify: config.InsecureSkipVerify,
+		}
+
+		if config.CACert != "" {
+			pool, err := x509.SystemCertPool()
+			if err != nil {
+				pool = x509.NewCertPool()
+			}
+
+			if !pool.AppendCertsFromPEM([]byte(config.CACert)) {
+				return nil, errors.New("failed to parse CA certificate: no PEM certificate found")
+			}
+
+			tlsConfig.RootCAs = pool
+		}
+
+		transport.TLSClientConfig = tlsConfig
+	}
+
+	if config.MaxIdleConns > 0 {
+		transport.MaxIdleConns = config.MaxIdleConns
+	}
+
+	if config.MaxIdleConnsPerHost > 0 {
+		transport.MaxIdleConnsPerHost = config.MaxIdleConnsPerHost
+	}
+
+	if config.IdleConnTimeout > 0 {
+		transport.IdleConnTimeout = config.IdleConnTimeout
+	}
+
+	if config.ConnectTimeout > 0 {
+		dialer := &net.Dialer{
+			Timeout:   config.ConnectTimeout,
+			KeepAlive: 30 * time.Second,
+		}
+		transport.DialContext = dialer.DialContext
+		transport.TLSHandshakeTimeout = config.ConnectTimeout
+	}
+
+	if config.ResponseHeaderTimeout > 0 {
+		transport.ResponseHeaderTimeout = config.ResponseHeaderTimeout
+	}
+
+	return transport, nil
+}
+
+// NewHttpClientWithTransport creates a new HTTP client with the transport of the config.
+func NewHttpClientWithTransport(config TransportConfig) (*HttpClient, error) {
+	transport, err := NewTransport(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewHttpClientWithClient(&http.Client{Transport: transport}), nil
+}
+
+// CloseIdleConnections closes the idle connections of the client, e.g. when the client is replaced.
+func (hc *HttpClient) CloseIdleConnections() {
+	hc.client.CloseIdleConnections()
+}
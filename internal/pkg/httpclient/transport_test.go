@@ -0,0 +1,78 @@
+package httpclient
+
+import (
+	"context"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTransport_Invalid(t *testing.T) {
+	_, err := NewTransport(TransportConfig{ProxyURL: "ftp://proxy.local:21"})
+	require.Error(t, err)
+
+	_, err = NewTransport(TransportConfig{CACert: "not a certificate"})
+	require.Error(t, err)
+
+	transport, err := NewTransport(TransportConfig{ProxyURL: ProxyDirect})
+	require.NoError(t, err)
+	require.Nil(t, transport.Proxy)
+
+	transport, err = NewTransport(TransportConfig{ProxyURL: "socks5://127.0.0.1:1080", MaxIdleConnsPerHost: 32, ResponseHeaderTimeout: time.Minute})
+	require.NoError(t, err)
+	require.Equal(t, 32, transport.MaxIdleConnsPerHost)
+	require.Equal(t, time.Minute, transport.ResponseHeaderTimeout)
+}
+
+func TestNewHttpClientWithTransport_Proxy(t *testing.T) {
+	var proxied string
+
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = r.URL.String()
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	}))
+	defer proxy.Close()
+
+	client, err := NewHttpClientWithTransport(TransportConfig{ProxyURL: proxy.URL})
+	require.NoError(t, err)
+
+	resp, err := client.Do(context.Background(), &Request{Method: http.MethodGet, URL: "http://upstream.invalid/v1/models"})
+	require.NoError(t, err)
+	require.Equal(t, `{"ok":true}`, string(resp.Body))
+	require.Equal(t, "http://upstream.invalid/v1/models", proxied)
+}
+
+func TestNewHttpClientWithTransport_TLS(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	request := &Request{Method: http.MethodGet, URL: server.URL}
+
+	client, err := NewHttpClientWithTransport(TransportConfig{})
+	require.NoError(t, err)
+
+	_, err = client.Do(context.Background(), request)
+	require.Error(t, err)
+
+	caCert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	client, err = NewHttpClientWithTransport(TransportConfig{CACert: string(caCert)})
+	require.NoError(t, err)
+
+	_, err = client.Do(context.Background(), request)
+	require.NoError(t, err)
+
+	client, err = NewHttpClientWithTransport(TransportConfig{InsecureSkipVerify: true})
+	require.NoError(t, err)
+
+	_, err = client.Do(context.Background(), request)
+	require.NoError(t, err)
+}
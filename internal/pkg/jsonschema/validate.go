@@ -0,0 +1,514 @@
+package jsonschema
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// ValidationError is the error returned when the instance does not match the schema.
+type ValidationError struct {
+	// Path is the JSON pointer of the invalid value.
+	Path string
+	// Message describes the violation.
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	path := e.Path
+	if path == "" {
+		path = "/"
+	}
+
+	return fmt.Sprintf("%s: %s", path, e.Message)
+}
+
+// Validate validates the JSON data against the JSON schema.
+// It supports the subset of JSON Schema used by the structured outputs of the LLM providers:
+// type, enum, const, properties, required, additionalProperties, items, prefixItems,
+// anyOf, oneOf, allOf, not, $ref to local definitions, and the numeric, string and array constraints.
+func Validate(schema json.RawMessage, data []byte) error {
+	var root any
+	if err := json.Unmarshal(schema, &root); err != nil {
+		return fmt.Errorf("invalid json schema: %w", err)
+	}
+
+	instance, err := decode(data)
+	if err != nil {
+		return &ValidationError{Message: fmt.Sprintf("invalid json: %v", err)}
+	}
+
+	v := &validator{root: root}
+
+	return v.validate(root, instance, "")
+}
+
+func decode(data []byte) (any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var instance any
+	if err := decoder.Decode(&instance); err != nil {
+		return nil, err
+	}
+
+	if decoder.More() {
+		return nil, fmt.Errorf("unexpected data after top-level value")
+	}
+
+	return instance, nil
+}
+
+type validator struct {
+	root  any
+	depth int
+}
+
+// maxRefDepth limits the $ref resolution to avoid the infinite recursion.
+const maxRefDepth = 64
+
+//nolint:gocognit,cyclop,maintidx // Checked.
+func (v *validator) validate(schema, instance any, path string) error {
+	switch s := schema.(type) {
+	case bool:
+		if !s {
+			return &ValidationError{Path: path, Message: "value is not allowed"}
+		}
+
+		return nil
+	case map[string]any:
+		if ref, ok := s["$ref"].(string); ok {
+			resolved, err := v.resolve(ref)
+			if err != nil {
+				return err
+			}
+
+			v.depth++
+			defer func() { v.depth-- }()
+
+			if v.depth > maxRefDepth {
+				return fmt.Errorf("json schema $ref %s is too deep", ref)
+			}
+
+			if err := v.validate(resolved, instance, path); err != nil {
+				return err
+			}
+		}
+
+		if t, ok := s["type"]; ok {
+			if err := validateType(t, instance, path); err != nil {
+				return err
+			}
+		}
+
+		if enum, ok := s["enum"].([]any); ok {
+			if !contains(enum, instance) {
+				return &ValidationError{Path: path, Message: fmt.Sprintf("value must be one of %s", marshal(enum))}
+			}
+		}
+
+		if c, ok := s["const"]; ok {
+			if !equal(c, instance) {
+				return &ValidationError{Path: path, Message: fmt.Sprintf("value must be %s", marshal(c))}
+			}
+		}
+
+		if err := v.validateComposition(s, instance, path); err != nil {
+			return err
+		}
+
+		switch value := instance.(type) {
+		case map[string]any:
+			return v.validateObject(s, value, path)
+		case []any:
+			return v.validateArray(s, value, path)
+		case string:
+			return validateString(s, value, path)
+		case json.Number:
+			return validateNumber(s, value, path)
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("invalid json schema at %s", path)
+	}
+}
+
+func (v *validator) validateComposition(s map[string]any, instance any, path string) error {
+	if allOf, ok := s["allOf"].([]any); ok {
+		for _, sub := range allOf {
+			if err := v.validate(sub, instance, path); err != nil {
+				return err
+			}
+		}
+	}
+
+	if anyOf, ok := s["anyOf"].([]any); ok {
+		matched := false
+
+		for _, sub := range anyOf {
+			if v.validate(sub, instance, path) == nil {
+				matched = true
+				break
+			}
+		}
+
+		if !matched {
+			return &ValidationError{Path: path, Message: "value does not match any of the schemas in anyOf"}
+		}
+	}
+
+	if oneOf, ok := s["oneOf"].([]any); ok {
+		matched := 0
+
+		for _, sub := range oneOf {
+			if v.validate(sub, instance, path) == nil {
+				matched++
+			}
+		}
+
+		if matched != 1 {
+			return &ValidationError{Path: path, Message: fmt.Sprintf("value must match exactly one schema in oneOf, but matched %d", matched)}
+		}
+	}
+
+	if not, ok := s["not"]; ok {
+		if v.validate(not, instance, path) == nil {
+			return &ValidationError{Path: path, Message: "value must not match the schema in not"}
+		}
+	}
+
+	return nil
+}
+
+func (v *validator) validateObject(s map[string]any, value map[string]any, path string) error {
+	if required, ok := s["required"].([]any); ok {
+		for _, name := range required {
+			key, _ := name.(string)
+			if _, ok := value[key]; !ok {
+				return &ValidationError{Path: path, Message: fmt.Sprintf("missing required property %q", key)}
+			}
+		}
+	}
+
+	properties, _ := s["properties"].(map[string]any)
+	additional, hasAdditional := s["additionalProperties"]
+
+	for key, item := range value {
+		itemPath := path + "/" + escape(key)
+
+		if sub, ok := properties[key]; ok {
+			if err := v.validate(sub, item, itemPath); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if !hasAdditional {
+			continue
+		}
+
+		if allowed, ok := additional.(bool); ok && !allowed {
+			return &ValidationError{Path: path, Message: fmt.Sprintf("additional property %q is not allowed", key)}
+		}
+
+		if err := v.validate(additional, item, itemPath); err != nil {
+			return err
+		}
+	}
+
+	if n, ok := toInt(s["minProperties"]); ok && len(value) < n {
+		return &ValidationError{Path: path, Message: fmt.Sprintf("must have at least %d properties", n)}
+	}
+
+	if n, ok := toInt(s["maxProperties"]); ok && len(value) > n {
+		return &ValidationError{Path: path, Message: fmt.Sprintf("must have at most %d properties", n)}
+	}
+
+	return nil
+}
+
+func (v *validator) validateArray(s map[string]any, value []any, path string) error {
+	prefixItems, _ := s["prefixItems"].([]any)
+
+	for i, item := range value {
+		itemPath := fmt.Sprintf("%s/%d", path, i)
+
+		if i < len(prefixItems) {
+			if err := v.validate(prefixItems[i], item, itemPath); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if items, ok := s["items"]; ok {
+			if err := v.validate(items, item, itemPath); err != nil {
+				return err
+			}
+		}
+	}
+
+	if n, ok := toInt(s["minItems"]); ok && len(value) < n {
+		return &ValidationError{Path: path, Message: fmt.Sprintf("must have at least %d items", n)}
+	}
+
+	if n, ok := toInt(s["maxItems"]); ok && len(value) > n {
+		return &ValidationError{Path: path, Message: fmt.Sprintf("must have at most %d items", n)}
+	}
+
+	if unique, ok := s["uniqueItems"].(bool); ok && unique {
+		for i := range value {
+			for j := i + 1; j < len(value); j++ {
+				if equal(value[i], value[j]) {
+					return &ValidationError{Path: path, Message: "items must be unique"}
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateString(s map[string]any, value, path string) error {
+	length := utf8.RuneCountInString(value)
+
+	if n, ok := toInt(s["minLength"]); ok && length < n {
+		return &ValidationError{Path: path, Message: fmt.Sprintf("length must be at least %d", n)}
+	}
+
+	if n, ok := toInt(s["maxLength"]); ok && length > n {
+		return &ValidationError{Path: path, Message: fmt.Sprintf("length must be at most %d", n)}
+	}
+
+	if pattern, ok := s["pattern"].(string); ok {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return fmt.Errorf("invalid pattern %q in json schema: %w", pattern, err)
+		}
+
+		if !re.MatchString(value) {
+			return &ValidationError{Path: path, Message: fmt.Sprintf("value does not match the pattern %q", pattern)}
+		}
+	}
+
+	return nil
+}
+
+func validateNumber(s map[string]any, value json.Number, path string) error {
+	f, err := value.Float64()
+	if err != nil {
+		return &ValidationError{Path: path, Message: fmt.Sprintf("invalid number %s", value)}
+	}
+
+	if limit, ok := toFloat(s["minimum"]); ok && f < limit {
+		return &ValidationError{Path: path, Message: fmt.Sprintf("must be >= %v", limit)}
+	}
+
+	if limit, ok := toFloat(s["maximum"]); ok && f > limit {
+		return &ValidationError{Path: path, Message: fmt.Sprintf("must be <= %v", limit)}
+	}
+
+	if limit, ok := toFloat(s["exclusiveMinimum"]); ok && f <= limit {
+		return &ValidationError{Path: path, Message: fmt.Sprintf("must be > %v", limit)}
+	}
+
+	if limit, ok := toFloat(s["exclusiveMaximum"]); ok && f >= limit {
+		return &ValidationError{Path: path, Message: fmt.Sprintf("must be < %v", limit)}
+	}
+
+	if m, ok := toFloat(s["multipleOf"]); ok && m > 0 {
+		q := f / m
+		if math.Abs(q-math.Round(q)) > 1e-9 {
+			return &ValidationError{Path: path, Message: fmt.Sprintf("must be a multiple of %v", m)}
+		}
+	}
+
+	return nil
+}
+
+func validateType(t, instance any, path string) error {
+	var types []string
+
+	switch tt := t.(type) {
+	case string:
+		types = []string{tt}
+	case []any:
+		for _, item := range tt {
+			if s, ok := item.(string); ok {
+				types = append(types, s)
+			}
+		}
+	default:
+		return nil
+	}
+
+	for _, expected := range types {
+		if matchType(expected, instance) {
+			return nil
+		}
+	}
+
+	return &ValidationError{Path: path, Message: fmt.Sprintf("expected %s, got %s", strings.Join(types, " or "), typeOf(instance))}
+}
+
+func matchType(expected string, instance any) bool {
+	actual := typeOf(instance)
+	if expected == actual {
+		return true
+	}
+
+	if expected == "number" && actual == "integer" {
+		return true
+	}
+
+	return false
+}
+
+func typeOf(instance any) string {
+	switch value := instance.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	case json.Number:
+		if _, err := value.Int64(); err == nil {
+			return "integer"
+		}
+
+		if f, err := value.Float64(); err == nil && f == math.Trunc(f) {
+			return "integer"
+		}
+
+		return "number"
+	default:
+		return fmt.Sprintf("%T", instance)
+	}
+}
+
+// resolve resolves the local $ref, e.g. #/$defs/Item or #/definitions/Item.
+func (v *validator) resolve(ref string) (any, error) {
+	if ref == "#" {
+		return v.root, nil
+	}
+
+	if !strings.HasPrefix(ref, "#/") {
+		return nil, fmt.Errorf("unsupported json schema $ref %s", ref)
+	}
+
+	current := v.root
+
+	for _, token := range strings.Split(strings.TrimPrefix(ref, "#/"), "/") {
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+
+		obj, ok := current.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("unresolvable json schema $ref %s", ref)
+		}
+
+		current, ok = obj[token]
+		if !ok {
+			return nil, fmt.Errorf("unresolvable json schema $ref %s", ref)
+		}
+	}
+
+	return current, nil
+}
+
+func contains(enum []any, instance any) bool {
+	for _, item := range enum {
+		if equal(item, instance) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// equal compares the JSON values, the numbers are compared by value.
+func equal(a, b any) bool {
+	fa, aIsNumber := toFloat(a)
+	fb, bIsNumber := toFloat(b)
+
+	if aIsNumber || bIsNumber {
+		return aIsNumber && bIsNumber && fa == fb
+	}
+
+	switch av := a.(type) {
+	case []any:
+		bv, ok := b.([]any)
+		if !ok || len(av) != len(bv) {
+			return false
+		}
+
+		for i := range av {
+			if !equal(av[i], bv[i]) {
+				return false
+			}
+		}
+
+		return true
+	case map[string]any:
+		bv, ok := b.(map[string]any)
+		if !ok || len(av) != len(bv) {
+			return false
+		}
+
+		for key, value := range av {
+			other, ok := bv[key]
+			if !ok || !equal(value, other) {
+				return false
+			}
+		}
+
+		return true
+	default:
+		return reflect.DeepEqual(a, b)
+	}
+}
+
+func toFloat(value any) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
+
+func toInt(value any) (int, bool) {
+	f, ok := toFloat(value)
+	if !ok {
+		return 0, false
+	}
+
+	return int(f), true
+}
+
+func escape(key string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(key, "~", "~0"), "/", "~1")
+}
+
+func marshal(value any) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+
+	return string(data)
+}
@@ -0,0 +1,123 @@
+package jsonschema
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	schema := json.RawMessage(`{
+		"type": "object",
+		"properties": {
+			"name": {"type": "string", "minLength": 1},
+			"age": {"type": "integer", "minimum": 0},
+			"tags": {"type": "array", "items": {"type": "string"}, "maxItems": 2},
+			"role": {"enum": ["admin", "user"]},
+			"address": {"$ref": "#/$defs/address"},
+			"nickname": {"anyOf": [{"type": "string"}, {"type": "null"}]}
+		},
+		"required": ["name", "age"],
+		"additionalProperties": false,
+		"$defs": {
+			"address": {
+				"type": "object",
+				"properties": {"city": {"type": "string"}},
+				"required": ["city"]
+			}
+		}
+	}`)
+
+	tests := []struct {
+		name    string
+		data    string
+		wantErr string
+	}{
+		{
+			name: "valid",
+			data: `{"name":"alice","age":18,"tags":["a"],"role":"admin","address":{"city":"x"},"nickname":null}`,
+		},
+		{
+			name: "integer with fraction zero",
+			data: `{"name":"alice","age":18.0}`,
+		},
+		{
+			name:    "missing required",
+			data:    `{"name":"alice"}`,
+			wantErr: `/: missing required property "age"`,
+		},
+		{
+			name:    "wrong type",
+			data:    `{"name":"alice","age":"18"}`,
+			wantErr: "/age: expected integer, got string",
+		},
+		{
+			name:    "not an integer",
+			data:    `{"name":"alice","age":1.5}`,
+			wantErr: "/age: expected integer, got number",
+		},
+		{
+			name:    "minimum",
+			data:    `{"name":"alice","age":-1}`,
+			wantErr: "/age: must be >= 0",
+		},
+		{
+			name:    "additional property",
+			data:    `{"name":"alice","age":1,"extra":true}`,
+			wantErr: `/: additional property "extra" is not allowed`,
+		},
+		{
+			name:    "array item",
+			data:    `{"name":"alice","age":1,"tags":[1]}`,
+			wantErr: "/tags/0: expected string, got integer",
+		},
+		{
+			name:    "max items",
+			data:    `{"name":"alice","age":1,"tags":["a","b","c"]}`,
+			wantErr: "/tags: must have at most 2 items",
+		},
+		{
+			name:    "enum",
+			data:    `{"name":"alice","age":1,"role":"root"}`,
+			wantErr: `/role: value must be one of ["admin","user"]`,
+		},
+		{
+			name:    "ref",
+			data:    `{"name":"alice","age":1,"address":{}}`,
+			wantErr: `/address: missing required property "city"`,
+		},
+		{
+			name:    "anyOf",
+			data:    `{"name":"alice","age":1,"nickname":1}`,
+			wantErr: "/nickname: value does not match any of the schemas in anyOf",
+		},
+		{
+			name:    "invalid json",
+			data:    `{"name":"alice",`,
+			wantErr: "invalid json",
+		},
+		{
+			name:    "trailing data",
+			data:    `{"name":"alice","age":1} {}`,
+			wantErr: "invalid json",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(schema, []byte(tt.data))
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestValidate_InvalidSchema(t *testing.T) {
+	err := Validate(json.RawMessage(`{`), []byte(`{}`))
+	require.ErrorContains(t, err, "invalid json schema")
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/tidwall/gjson"
 	"github.com/tidwall/sjson"
+	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/google"
 	"google.golang.org/api/option"
 	"google.golang.org/api/transport"
@@ -31,6 +32,12 @@ type Executor struct {
 
 // NewExecutor creates a new Vertex AI executor with Google Cloud credentials.
 func NewExecutor(region, projectID string, creds *google.Credentials) (*Executor, error) {
+	return NewExecutorWithTransport(region, projectID, creds, nil)
+}
+
+// NewExecutorWithTransport creates a new Vertex AI executor sending the authenticated requests on the base transport,
+// the default transport of the Google Cloud client is used if nil.
+func NewExecutorWithTransport(region, projectID string, creds *google.Credentials, base http.RoundTripper) (*Executor, error) {
 	if region == "" {
 		return nil, fmt.Errorf("region must be provided")
 	}
@@ -44,9 +51,16 @@ func NewExecutor(region, projectID string, creds *google.Credentials) (*Executor
 	}
 
 	// Create HTTP client with Google Cloud authentication
-	client, _, err := transport.NewHTTPClient(context.Background(), option.WithTokenSource(creds.TokenSource))
-	if err != nil {
-		return nil, fmt.Errorf("failed to create HTTP client: %w", err)
+	var client *http.Client
+	if base != nil {
+		client = &http.Client{Transport: &oauth2.Transport{Source: creds.TokenSource, Base: base}}
+	} else {
+		var err error
+
+		client, _, err = transport.NewHTTPClient(context.Background(), option.WithTokenSource(creds.TokenSource))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create HTTP client: %w", err)
+		}
 	}
 
 	// Determine base URL based on region
@@ -67,12 +81,17 @@ func NewExecutor(region, projectID string, creds *google.Credentials) (*Executor
 
 // NewExecutorFromJSON creates a new Vertex AI executor from JSON credentials.
 func NewExecutorFromJSON(region, projectID string, jsonData string) (*Executor, error) {
+	return NewExecutorFromJSONWithTransport(region, projectID, jsonData, nil)
+}
+
+// NewExecutorFromJSONWithTransport creates a new Vertex AI executor from JSON credentials sending the requests on the base transport.
+func NewExecutorFromJSONWithTransport(region, projectID string, jsonData string, base http.RoundTripper) (*Executor, error) {
 	creds, err := google.CredentialsFromJSON(context.Background(), []byte(jsonData))
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse credentials: %w", err)
 	}
 
-	return NewExecutor(region, projectID, creds)
+	return NewExecutorWithTransport(region, projectID, creds, base)
 }
 
 // Do executes a HTTP request using the Vertex AI client.
@@ -0,0 +1,124 @@
+package affinity
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/golang-lru/v2/expirable"
+
+	"github.com/looplj/axonhub/internal/llm"
+)
+
+const defaultMaxSessions = 10000
+
+// Config holds the configuration of the session affinity.
+type Config struct {
+	// Enabled pins the conversations to the channels which served them, so the provider-side prompt caches are hit.
+	Enabled bool `conf:"enabled" yaml:"enabled" json:"enabled"`
+
+	// Header is the request header of the client-provided session id.
+	Header string `conf:"header" yaml:"header" json:"header"`
+
+	// TTL is the duration the conversation is pinned for after its last request.
+	TTL time.Duration `conf:"ttl" yaml:"ttl" json:"ttl"`
+
+	// MaxSessions is the maximum number of the pinned conversations, the least recently used are evicted.
+	MaxSessions int `conf:"max_sessions" yaml:"max_sessions" json:"max_sessions"`
+}
+
+// Pin is the channel and the model a conversation is pinned to.
+type Pin struct {
+	ChannelID int
+	Model     string
+}
+
+// Store keeps the pins of the conversations in an in-memory LRU, it is local to the instance.
+type Store struct {
+	header string
+	lru    *expirable.LRU[string, Pin]
+}
+
+// New creates the session affinity store of the configuration, nil if the session affinity is disabled.
+func New(config Config) *Store {
+	if !config.Enabled {
+		return nil
+	}
+
+	maxSessions := config.MaxSessions
+	if maxSessions <= 0 {
+		maxSessions = defaultMaxSessions
+	}
+
+	return &Store{
+		header: config.Header,
+		lru:    expirable.NewLRU[string, Pin](maxSessions, nil, config.TTL),
+	}
+}
+
+// Header returns the request header of the client-provided session id.
+func (s *Store) Header() string {
+	return s.header
+}
+
+// Key returns the affinity key of the conversation of the request, empty if the conversation can not be identified.
+// The conversation is identified by the client-provided session id, the user of the request,
+// or the hash of the messages up to the first user message, in order.
+func (s *Store) Key(apiKeyID int, sessionID string, request *llm.Request) string {
+	session := sessionID
+	if session == "" {
+		session = requestSession(request)
+	} else {
+		session = "session:" + session
+	}
+
+	if session == "" {
+		return ""
+	}
+
+	return fmt.Sprintf("%d:%s:%s", apiKeyID, request.Model, session)
+}
+
+func requestSession(request *llm.Request) string {
+	if request.User != nil && *request.User != "" {
+		return "user:" + *request.User
+	}
+
+	if userID := request.Metadata["user_id"]; userID != "" {
+		return "user:" + userID
+	}
+
+	for i, msg := range request.Messages {
+		if msg.Role != "user" {
+			continue
+		}
+
+		data, err := json.Marshal(request.Messages[:i+1])
+		if err != nil {
+			return ""
+		}
+
+		hash := sha256.Sum256(data)
+
+		return "prefix:" + hex.EncodeToString(hash[:16])
+	}
+
+	return ""
+}
+
+// Get returns the pin of the conversation, false if it is not pinned or the pin expired.
+func (s *Store) Get(key string) (Pin, bool) {
+	return s.lru.Get(key)
+}
+
+// Set pins the conversation, the TTL of the pin is renewed.
+func (s *Store) Set(key string, pin Pin) {
+	s.lru.Add(key, pin)
+}
+
+// Delete unpins the conversation.
+func (s *Store) Delete(key string) {
+	s.lru.Remove(key)
+}
@@ -0,0 +1,80 @@
+package affinity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/looplj/axonhub/internal/llm"
+)
+
+func newRequest(model string, messages ...string) *llm.Request {
+	request := &llm.Request{Model: model}
+
+	for i, content := range messages {
+		role := "user"
+		if i%2 == 1 {
+			role = "assistant"
+		}
+
+		request.Messages = append(request.Messages, llm.Message{Role: role, Content: llm.MessageContent{Content: lo.ToPtr(content)}})
+	}
+
+	return request
+}
+
+func TestNew(t *testing.T) {
+	require.Nil(t, New(Config{}))
+	require.NotNil(t, New(Config{Enabled: true, TTL: time.Minute}))
+}
+
+func TestStore_Key(t *testing.T) {
+	store := New(Config{Enabled: true, Header: "AH-Session-Id", TTL: time.Minute})
+
+	first := newRequest("gpt-4o", "Hi")
+	second := newRequest("gpt-4o", "Hi", "Hello", "How are you?")
+
+	// The turns of the conversation share the prefix up to the first user message.
+	require.NotEmpty(t, store.Key(1, "", first))
+	require.Equal(t, store.Key(1, "", first), store.Key(1, "", second))
+	require.NotEqual(t, store.Key(1, "", first), store.Key(1, "", newRequest("gpt-4o", "Bye")))
+	require.NotEqual(t, store.Key(1, "", first), store.Key(2, "", first))
+	require.NotEqual(t, store.Key(1, "", first), store.Key(1, "", newRequest("gpt-4o-mini", "Hi")))
+
+	require.Equal(t, "1:gpt-4o:session:abc", store.Key(1, "abc", first))
+
+	second.User = lo.ToPtr("alice")
+	require.Equal(t, "1:gpt-4o:user:alice", store.Key(1, "", second))
+	require.Equal(t, "1:gpt-4o:session:abc", store.Key(1, "abc", second))
+
+	first.Metadata = map[string]string{"user_id": "bob"}
+	require.Equal(t, "1:gpt-4o:user:bob", store.Key(1, "", first))
+
+	require.Empty(t, store.Key(1, "", newRequest("gpt-4o")))
+}
+
+func TestStore_Pin(t *testing.T) {
+	store := New(Config{Enabled: true, TTL: 50 * time.Millisecond})
+
+	_, ok := store.Get("key")
+	require.False(t, ok)
+
+	store.Set("key", Pin{ChannelID: 1, Model: "gpt-4o"})
+
+	pin, ok := store.Get("key")
+	require.True(t, ok)
+	require.Equal(t, Pin{ChannelID: 1, Model: "gpt-4o"}, pin)
+
+	store.Delete("key")
+
+	_, ok = store.Get("key")
+	require.False(t, ok)
+
+	store.Set("key", Pin{ChannelID: 1, Model: "gpt-4o"})
+	require.Eventually(t, func() bool {
+		_, ok := store.Get("key")
+		return !ok
+	}, time.Second, 10*time.Millisecond)
+}
@@ -7,11 +7,13 @@ import (
 	"github.com/gin-gonic/gin"
 	"go.uber.org/fx"
 
+	"github.com/looplj/axonhub/internal/llm/decorator/policy"
 	"github.com/looplj/axonhub/internal/llm/transformer/aisdk"
 	"github.com/looplj/axonhub/internal/log"
 	"github.com/looplj/axonhub/internal/pkg/httpclient"
 	"github.com/looplj/axonhub/internal/pkg/streams"
 	"github.com/looplj/axonhub/internal/server/biz"
+	"github.com/looplj/axonhub/internal/server/cache"
 	"github.com/looplj/axonhub/internal/server/chat"
 )
 
@@ -21,6 +23,8 @@ type AiSdkHandlersParams struct {
 	ChannelService *biz.ChannelService
 	RequestService *biz.RequestService
 	HttpClient     *httpclient.HttpClient
+	ResponseCache  cache.Store
+	PolicyWebhook  *policy.Webhook
 }
 
 type AiSDKHandlers struct {
@@ -35,6 +39,8 @@ func NewAiSDKHandlers(params AiSdkHandlersParams) *AiSDKHandlers {
 			params.RequestService,
 			params.HttpClient,
 			aisdk.NewTextTransformer(),
+			params.ResponseCache,
+			params.PolicyWebhook,
 		),
 		SSEChatCompletionHandler: &ChatCompletionSSEHandlers{
 			ChatCompletionProcessor: chat.NewChatCompletionProcessor(
@@ -42,6 +48,8 @@ func NewAiSDKHandlers(params AiSdkHandlersParams) *AiSDKHandlers {
 				params.RequestService,
 				params.HttpClient,
 				aisdk.NewDataStreamTransformer(),
+				params.ResponseCache,
+				params.PolicyWebhook,
 			),
 		},
 	}
@@ -76,6 +84,10 @@ func (handlers *AiSDKHandlers) ChatCompletion(c *gin.Context) {
 		return
 	}
 
+	if result.Model != "" {
+		c.Header(ServedModelHeader, result.Model)
+	}
+
 	if result.ChatCompletion != nil {
 		resp := result.ChatCompletion
 
@@ -2,15 +2,20 @@ package api
 
 import (
 	"encoding/json"
+	"net/http"
 
 	"github.com/gin-gonic/gin"
 	"go.uber.org/fx"
 
 	"github.com/looplj/axonhub/internal/llm"
+	"github.com/looplj/axonhub/internal/llm/decorator/policy"
+	"github.com/looplj/axonhub/internal/llm/tokenizer"
 	"github.com/looplj/axonhub/internal/llm/transformer/anthropic"
+	"github.com/looplj/axonhub/internal/log"
 	"github.com/looplj/axonhub/internal/pkg/httpclient"
 	"github.com/looplj/axonhub/internal/pkg/xerrors"
 	"github.com/looplj/axonhub/internal/server/biz"
+	"github.com/looplj/axonhub/internal/server/cache"
 	"github.com/looplj/axonhub/internal/server/chat"
 )
 
@@ -45,10 +50,14 @@ type AnthropicHandlersParams struct {
 	ChannelService *biz.ChannelService
 	RequestService *biz.RequestService
 	HttpClient     *httpclient.HttpClient
+	ResponseCache  cache.Store
+	PolicyWebhook  *policy.Webhook
+	Tokenizers     *tokenizer.Registry
 }
 
 type AnthropicHandlers struct {
 	ChatCompletionHandlers *ChatCompletionSSEHandlers
+	TokenCounter           *chat.TokenCounter
 }
 
 func NewAnthropicHandlers(params AnthropicHandlersParams) *AnthropicHandlers {
@@ -59,11 +68,48 @@ func NewAnthropicHandlers(params AnthropicHandlersParams) *AnthropicHandlers {
 				params.RequestService,
 				params.HttpClient,
 				anthropic.NewInboundTransformer(),
+				params.ResponseCache,
+				params.PolicyWebhook,
 			),
 		},
+		TokenCounter: chat.NewTokenCounter(params.ChannelService, params.HttpClient, params.Tokenizers),
 	}
 }
 
 func (handlers *AnthropicHandlers) CreateMessage(c *gin.Context) {
 	handlers.ChatCompletionHandlers.ChatCompletion(c)
 }
+
+// CountTokens counts the input tokens of the message request without creating it.
+func (handlers *AnthropicHandlers) CountTokens(c *gin.Context) {
+	ctx := c.Request.Context()
+	inbound := anthropic.NewInboundTransformer()
+
+	genericReq, err := httpclient.ReadHTTPRequest(c.Request)
+	if err != nil {
+		httpErr := inbound.TransformError(ctx, err)
+		c.JSON(httpErr.StatusCode, json.RawMessage(httpErr.Body))
+
+		return
+	}
+
+	llmReq, err := inbound.TransformCountTokensRequest(ctx, genericReq)
+	if err != nil {
+		httpErr := inbound.TransformError(ctx, err)
+		c.JSON(httpErr.StatusCode, json.RawMessage(httpErr.Body))
+
+		return
+	}
+
+	inputTokens, err := handlers.TokenCounter.CountTokens(ctx, llmReq)
+	if err != nil {
+		log.Error(ctx, "Error counting tokens", log.Cause(err))
+
+		httpErr := inbound.TransformError(ctx, err)
+		c.JSON(httpErr.StatusCode, json.RawMessage(httpErr.Body))
+
+		return
+	}
+
+	c.JSON(http.StatusOK, anthropic.CountTokensResponse{InputTokens: inputTokens})
+}
@@ -12,6 +12,10 @@ import (
 	"github.com/looplj/axonhub/internal/server/chat"
 )
 
+// ServedModelHeader is the response header of the model which served the request,
+// it differs from the requested model when a fallback model is used.
+const ServedModelHeader = "X-AxonHub-Model"
+
 type ChatCompletionSSEHandlers struct {
 	ChatCompletionProcessor *chat.ChatCompletionProcessor
 }
@@ -44,6 +48,10 @@ func (handlers *ChatCompletionSSEHandlers) ChatCompletion(c *gin.Context) {
 		return
 	}
 
+	if result.Model != "" {
+		c.Header(ServedModelHeader, result.Model)
+	}
+
 	if result.ChatCompletion != nil {
 		resp := result.ChatCompletion
 
@@ -1,12 +1,17 @@
 package api
 
 import (
+	"net/http"
+
 	"github.com/gin-gonic/gin"
 	"go.uber.org/fx"
 
+	"github.com/looplj/axonhub/internal/contexts"
+	"github.com/looplj/axonhub/internal/llm/decorator/policy"
 	"github.com/looplj/axonhub/internal/llm/transformer/openai"
 	"github.com/looplj/axonhub/internal/pkg/httpclient"
 	"github.com/looplj/axonhub/internal/server/biz"
+	"github.com/looplj/axonhub/internal/server/cache"
 	"github.com/looplj/axonhub/internal/server/chat"
 )
 
@@ -16,10 +21,14 @@ type OpenAIHandlersParams struct {
 	ChannelService *biz.ChannelService
 	RequestService *biz.RequestService
 	HttpClient     *httpclient.HttpClient
+	ResponseCache  cache.Store
+	PolicyWebhook  *policy.Webhook
 }
 
 type OpenAIHandlers struct {
 	ChatCompletionHandlers *ChatCompletionSSEHandlers
+	ChannelService         *biz.ChannelService
+	ModelMapper            *chat.ModelMapper
 }
 
 func NewOpenAIHandlers(params OpenAIHandlersParams) *OpenAIHandlers {
@@ -30,11 +39,48 @@ func NewOpenAIHandlers(params OpenAIHandlersParams) *OpenAIHandlers {
 				params.RequestService,
 				params.HttpClient,
 				openai.NewInboundTransformer(),
+				params.ResponseCache,
+				params.PolicyWebhook,
 			),
 		},
+		ChannelService: params.ChannelService,
+		ModelMapper:    chat.NewModelMapper(),
 	}
 }
 
 func (handlers *OpenAIHandlers) ChatCompletion(c *gin.Context) {
 	handlers.ChatCompletionHandlers.ChatCompletion(c)
 }
+
+// OpenAIModel is the model object of the OpenAI list models API.
+type OpenAIModel struct {
+	ID      string `json:"id"`
+	Object  string `json:"object"`
+	Created int64  `json:"created"`
+	OwnedBy string `json:"owned_by"`
+}
+
+// ListModels lists the models allowed for the API key, including the virtual models.
+func (handlers *OpenAIHandlers) ListModels(c *gin.Context) {
+	apiKey, _ := contexts.GetAPIKey(c.Request.Context())
+
+	models := make([]OpenAIModel, 0)
+
+	for _, model := range handlers.ChannelService.ListModels() {
+		if !handlers.ModelMapper.IsModelAllowed(apiKey, model.ID) {
+			continue
+		}
+
+		models = append(models, OpenAIModel{
+			ID:      model.ID,
+			Object:  "model",
+			Created: model.CreatedAt.Unix(),
+			OwnedBy: model.OwnedBy,
+		})
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"object": "list",
+		"data":   models,
+	})
+}
@@ -10,6 +10,7 @@ import (
 	"go.uber.org/fx"
 
 	"github.com/looplj/axonhub/internal/llm"
+	"github.com/looplj/axonhub/internal/llm/decorator/policy"
 	"github.com/looplj/axonhub/internal/llm/transformer"
 	"github.com/looplj/axonhub/internal/llm/transformer/aisdk"
 	"github.com/looplj/axonhub/internal/log"
@@ -34,12 +35,14 @@ type PlaygroundHandlersParams struct {
 	ChannelService *biz.ChannelService
 	RequestService *biz.RequestService
 	HttpClient     *httpclient.HttpClient
+	PolicyWebhook  *policy.Webhook
 }
 
 type PlaygroundHandlers struct {
 	ChannelService *biz.ChannelService
 	RequestService *biz.RequestService
 	HttpClient     *httpclient.HttpClient
+	PolicyWebhook  *policy.Webhook
 }
 
 func NewPlaygroundHandlers(params PlaygroundHandlersParams) *PlaygroundHandlers {
@@ -47,6 +50,7 @@ func NewPlaygroundHandlers(params PlaygroundHandlersParams) *PlaygroundHandlers
 		ChannelService: params.ChannelService,
 		RequestService: params.RequestService,
 		HttpClient:     params.HttpClient,
+		PolicyWebhook:  params.PolicyWebhook,
 	}
 }
 
@@ -199,6 +203,7 @@ func (handlers *PlaygroundHandlers) ChatCompletion(c *gin.Context) {
 
 	log.Debug(ctx, "Received request", log.Any("request", genericReq), log.String("channel_id", channelIDStr))
 
+	// The playground requests are not associated with an API key, so the response cache is not applied.
 	var processor *chat.ChatCompletionProcessor
 
 	if channelIDStr != "" {
@@ -223,6 +228,8 @@ func (handlers *PlaygroundHandlers) ChatCompletion(c *gin.Context) {
 			handlers.RequestService,
 			handlers.HttpClient,
 			aisdk.NewDataStreamTransformer(),
+			nil,
+			handlers.PolicyWebhook,
 		)
 	} else {
 		// Use default processor with all available channels
@@ -231,6 +238,8 @@ func (handlers *PlaygroundHandlers) ChatCompletion(c *gin.Context) {
 			handlers.RequestService,
 			handlers.HttpClient,
 			aisdk.NewDataStreamTransformer(),
+			nil,
+			handlers.PolicyWebhook,
 		)
 	}
 
@@ -243,6 +252,10 @@ func (handlers *PlaygroundHandlers) ChatCompletion(c *gin.Context) {
 		return
 	}
 
+	if result.Model != "" {
+		c.Header(ServedModelHeader, result.Model)
+	}
+
 	if result.ChatCompletion != nil {
 		resp := result.ChatCompletion
 
@@ -3,35 +3,85 @@ package biz
 import (
 	"context"
 	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
 	"encoding/hex"
 	"fmt"
+	"net/netip"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/hashicorp/golang-lru/v2/expirable"
+	"github.com/samber/lo"
 	"go.uber.org/fx"
 	"golang.org/x/crypto/bcrypt"
 
+	"github.com/looplj/axonhub/internal/contexts"
 	"github.com/looplj/axonhub/internal/ent"
 	"github.com/looplj/axonhub/internal/ent/apikey"
 	"github.com/looplj/axonhub/internal/ent/privacy"
+	"github.com/looplj/axonhub/internal/ent/schema/schematype"
 	"github.com/looplj/axonhub/internal/ent/user"
 	"github.com/looplj/axonhub/internal/log"
+	"github.com/looplj/axonhub/internal/server/persist"
+)
+
+const (
+	// apiKeyPrefixLength is the length of the visible prefix of the API key, e.g. "ah-1a2b3c4d".
+	apiKeyPrefixLength = 11
+
+	// apiKeyCacheSize is the maximum number of the authenticated API keys kept in memory.
+	apiKeyCacheSize = 4096
+
+	// apiKeyCacheTTL is the maximum duration an authenticated API key is kept in memory.
+	// The cache is invalidated on changes in this instance, the TTL bounds the staleness for other instances.
+	apiKeyCacheTTL = time.Minute
+
+	// apiKeyLastUsedInterval is the minimum interval to record the last used time of an API key secret,
+	// so the API key is not updated on every request.
+	apiKeyLastUsedInterval = time.Minute
+
+	// DefaultAPIKeyRotationGracePeriod is the default duration the previous key is still valid after a rotation.
+	DefaultAPIKeyRotationGracePeriod = 24 * time.Hour
 )
 
 type AuthServiceParams struct {
 	fx.In
 
 	SystemService *SystemService
+	Writer        *persist.Writer `optional:"true"`
 }
 
 func NewAuthService(params AuthServiceParams) *AuthService {
-	return &AuthService{
+	svc := &AuthService{
 		SystemService: params.SystemService,
+		Writer:        params.Writer,
+		apiKeyCache:   expirable.NewLRU[string, authenticatedAPIKey](apiKeyCacheSize, nil, apiKeyCacheTTL),
 	}
+
+	return svc
 }
 
 type AuthService struct {
 	SystemService *SystemService
+	Writer        *persist.Writer
+
+	// apiKeyCache caches the authenticated API keys by the digest of the plain key.
+	apiKeyCache *expirable.LRU[string, authenticatedAPIKey]
+	// apiKeyCacheGen is increased on every invalidation,
+	// so an authentication racing with an invalidation does not cache the stale API key.
+	apiKeyCacheGen atomic.Uint64
+	// apiKeyLastUsed records the last time the last used time of each secret was saved.
+	apiKeyLastUsed sync.Map
+}
+
+// authenticatedAPIKey is the API key authenticated by the current or the previous secret.
+type authenticatedAPIKey struct {
+	apiKey   *ent.APIKey
+	previous bool
 }
 
 // HashPassword hashes a password using bcrypt.
@@ -168,31 +218,429 @@ func (s *AuthService) AuthenticateJWTToken(ctx context.Context, tokenString stri
 	return u, nil
 }
 
+// AnthenticateAPIKey authenticates the plain API key and returns the API key with the owner loaded.
+// The authenticated API keys are cached, so most calls do not query the database.
 func (s *AuthService) AnthenticateAPIKey(ctx context.Context, key string) (*ent.APIKey, error) {
+	cacheKey := apiKeyDigest(key)
+	if cached, ok := s.apiKeyCache.Get(cacheKey); ok {
+		return s.checkAuthenticatedAPIKey(ctx, cached)
+	}
+
+	gen := s.apiKeyCacheGen.Load()
+
 	ctx = privacy.DecisionContext(ctx, privacy.Allow)
 	// 查询数据库验证 API key 是否存在
 	client := ent.FromContext(ctx)
+	prefix := APIKeyPrefix(key)
 
-	apiKey, err := client.APIKey.Query().
+	candidates, err := client.APIKey.Query().
 		WithUser().
-		Where(apikey.KeyEQ(key), apikey.StatusEQ(apikey.StatusEnabled)).
-		First(ctx)
+		Where(
+			apikey.Or(
+				apikey.KeyPrefixEQ(prefix),
+				apikey.And(
+					apikey.PreviousKeyPrefixEQ(prefix),
+					apikey.PreviousKeyExpiresAtGT(time.Now()),
+				),
+			),
+			apikey.StatusEQ(apikey.StatusEnabled),
+		).
+		All(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get api key: %w", err)
 	}
 
-	apiOwner, err := apiKey.User(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get api key: %w", err)
+	var (
+		authenticated authenticatedAPIKey
+		found         bool
+	)
+
+	for _, candidate := range candidates {
+		if VerifyAPIKey(candidate.Key, key) {
+			authenticated, found = authenticatedAPIKey{apiKey: candidate}, true
+			break
+		}
+
+		if candidate.PreviousKey != "" && VerifyAPIKey(candidate.PreviousKey, key) {
+			authenticated, found = authenticatedAPIKey{apiKey: candidate, previous: true}, true
+			break
+		}
 	}
 
+	if !found {
+		return nil, fmt.Errorf("api key not found: %w", ErrInvalidAPIKey)
+	}
+
+	apiOwner := authenticated.apiKey.Edges.User
 	if apiOwner == nil || apiOwner.Status != user.StatusActivated {
 		return nil, fmt.Errorf("api key owner not valid: %w", ErrInvalidAPIKey)
 	}
 
+	if s.apiKeyCacheGen.Load() == gen {
+		s.apiKeyCache.Add(cacheKey, authenticated)
+	}
+
+	return s.checkAuthenticatedAPIKey(ctx, authenticated)
+}
+
+// checkAuthenticatedAPIKey checks the expiration of the authenticated API key and records the last used time of the secret.
+func (s *AuthService) checkAuthenticatedAPIKey(ctx context.Context, authenticated authenticatedAPIKey) (*ent.APIKey, error) {
+	apiKey := authenticated.apiKey
+
+	if err := checkAPIKeyExpiration(apiKey); err != nil {
+		return nil, err
+	}
+
+	if authenticated.previous && (apiKey.PreviousKeyExpiresAt == nil || !time.Now().Before(*apiKey.PreviousKeyExpiresAt)) {
+		return nil, fmt.Errorf("rotated api key grace period ended: %w", ErrInvalidAPIKey)
+	}
+
+	s.recordAPIKeyLastUsed(ctx, apiKey.ID, authenticated.previous)
+
 	return apiKey, nil
 }
 
+// recordAPIKeyLastUsed saves the last used time of the secret at most once per apiKeyLastUsedInterval.
+func (s *AuthService) recordAPIKeyLastUsed(ctx context.Context, id int, previous bool) {
+	now := time.Now()
+	recordKey := fmt.Sprintf("%d:%t", id, previous)
+
+	if last, ok := s.apiKeyLastUsed.Load(recordKey); ok && now.Sub(last.(time.Time)) < apiKeyLastUsedInterval {
+		return
+	}
+
+	s.apiKeyLastUsed.Store(recordKey, now)
+
+	ctx = privacy.DecisionContext(ctx, privacy.Allow)
+
+	err := s.Writer.Submit(ctx, id, "api_key_last_used", func(ctx context.Context) error {
+		mut := ent.FromContext(ctx).APIKey.UpdateOneID(id)
+		if previous {
+			mut.SetPreviousKeyLastUsedAt(now)
+		} else {
+			mut.SetLastUsedAt(now)
+		}
+
+		return mut.Exec(ctx)
+	})
+	if err != nil {
+		log.Warn(ctx, "failed to record api key last used time", log.Int("api_key_id", id), log.Cause(err))
+	}
+}
+
+// RotateAPIKey issues a new key for the API key, the previous key is still valid until the grace period ends.
+// It returns the rotated API key and the plain new key, which is only returned once.
+func (s *AuthService) RotateAPIKey(ctx context.Context, id int, gracePeriod time.Duration) (*ent.APIKey, string, error) {
+	if gracePeriod < 0 {
+		return nil, "", fmt.Errorf("grace period must not be negative")
+	}
+
+	client := ent.FromContext(ctx)
+
+	apiKey, err := client.APIKey.Get(ctx, id)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get api key: %w", err)
+	}
+
+	newKey, err := s.GenerateAPIKey()
+	if err != nil {
+		return nil, "", err
+	}
+
+	hashedKey, err := HashAPIKey(newKey)
+	if err != nil {
+		return nil, "", err
+	}
+
+	mut := client.APIKey.UpdateOne(apiKey).
+		SetKey(hashedKey).
+		SetKeyPrefix(APIKeyPrefix(newKey)).
+		ClearLastUsedAt()
+
+	if gracePeriod > 0 {
+		mut.SetPreviousKey(apiKey.Key).
+			SetPreviousKeyPrefix(apiKey.KeyPrefix).
+			SetPreviousKeyExpiresAt(time.Now().Add(gracePeriod)).
+			SetNillablePreviousKeyLastUsedAt(apiKey.LastUsedAt)
+	} else {
+		mut.ClearPreviousKey().
+			ClearPreviousKeyPrefix().
+			ClearPreviousKeyExpiresAt().
+			ClearPreviousKeyLastUsedAt()
+	}
+
+	rotated, err := mut.Save(ctx)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to rotate api key: %w", err)
+	}
+
+	s.InvalidateAPIKeyCache(rotated.ID)
+	// The secrets have changed, so the last used time of the new key is recorded on its first use.
+	s.apiKeyLastUsed.Delete(fmt.Sprintf("%d:%t", rotated.ID, false))
+	s.apiKeyLastUsed.Delete(fmt.Sprintf("%d:%t", rotated.ID, true))
+
+	return rotated, newKey, nil
+}
+
+// DeleteAPIKey soft deletes the API key, it can not be used anymore.
+func (s *AuthService) DeleteAPIKey(ctx context.Context, id int) error {
+	if err := ent.FromContext(ctx).APIKey.DeleteOneID(id).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to delete api key: %w", err)
+	}
+
+	s.InvalidateAPIKeyCache(id)
+
+	return nil
+}
+
+// RestoreAPIKey restores the soft deleted API key, it fails if the owner user is deleted.
+func (s *AuthService) RestoreAPIKey(ctx context.Context, id int) (*ent.APIKey, error) {
+	client := ent.FromContext(ctx)
+
+	deleted, err := client.APIKey.Query().
+		Where(apikey.ID(id), apikey.DeletedAtGT(0)).
+		Only(schematype.SkipSoftDelete(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deleted api key: %w", err)
+	}
+
+	ownerExists, err := client.User.Query().
+		Where(user.ID(deleted.UserID)).
+		Exist(privacy.DecisionContext(ctx, privacy.Allow))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get api key owner: %w", err)
+	}
+
+	if !ownerExists {
+		return nil, ErrOwnerDeleted
+	}
+
+	restored, err := client.APIKey.UpdateOne(deleted).
+		SetDeletedAt(0).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore api key: %w", err)
+	}
+
+	s.InvalidateAPIKeyCache(id)
+
+	return restored, nil
+}
+
+// DeleteUser soft deletes the user and disables all the API keys of the user.
+// The current user and the owner user can not be deleted.
+func (s *AuthService) DeleteUser(ctx context.Context, id int) error {
+	if current, ok := contexts.GetUser(ctx); ok && current.ID == id {
+		return ErrDeleteSelf
+	}
+
+	client := ent.FromContext(ctx)
+
+	target, err := client.User.Get(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	if target.IsOwner {
+		return ErrDeleteOwner
+	}
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	if err = tx.User.DeleteOneID(id).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to delete user: %w", err)
+	}
+
+	// The API keys of the deleted user are disabled rather than deleted, so they are kept with the user when it is restored.
+	if err = tx.APIKey.Update().
+		Where(apikey.UserID(id)).
+		SetStatus(apikey.StatusDisabled).
+		Exec(privacy.DecisionContext(ctx, privacy.Allow)); err != nil {
+		return fmt.Errorf("failed to disable api keys of user: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	s.InvalidateUserAPIKeyCache(id)
+
+	return nil
+}
+
+// RestoreUser restores the soft deleted user, the API keys disabled by the deletion are not enabled automatically.
+func (s *AuthService) RestoreUser(ctx context.Context, id int) (*ent.User, error) {
+	client := ent.FromContext(ctx)
+
+	deleted, err := client.User.Query().
+		Where(user.ID(id), user.DeletedAtGT(0)).
+		Only(schematype.SkipSoftDelete(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deleted user: %w", err)
+	}
+
+	restored, err := client.User.UpdateOne(deleted).
+		SetDeletedAt(0).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore user: %w", err)
+	}
+
+	return restored, nil
+}
+
+func checkAPIKeyExpiration(apiKey *ent.APIKey) error {
+	if apiKey.ExpiresAt != nil && !time.Now().Before(*apiKey.ExpiresAt) {
+		return fmt.Errorf("api key expired at %s: %w", apiKey.ExpiresAt.Format(time.RFC3339), ErrAPIKeyExpired)
+	}
+
+	return nil
+}
+
+// CheckAPIKeyClientIP checks whether the API key can be used from the client IP.
+func CheckAPIKeyClientIP(apiKey *ent.APIKey, clientIP string) error {
+	if len(apiKey.AllowedCidrs) == 0 {
+		return nil
+	}
+
+	addr, err := netip.ParseAddr(clientIP)
+	if err != nil {
+		return fmt.Errorf("invalid client ip %q: %w", clientIP, ErrIPNotAllowed)
+	}
+
+	addr = addr.Unmap()
+
+	for _, cidr := range apiKey.AllowedCidrs {
+		prefix, err := parseCIDR(cidr)
+		if err != nil {
+			continue
+		}
+
+		if prefix.Contains(addr) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("client ip %s not allowed for the api key: %w", clientIP, ErrIPNotAllowed)
+}
+
+// ValidateCIDRs validates the CIDRs or IPs of the API key allowlist.
+func ValidateCIDRs(cidrs []string) error {
+	for _, cidr := range cidrs {
+		if _, err := parseCIDR(cidr); err != nil {
+			return fmt.Errorf("invalid cidr %q: %w", cidr, err)
+		}
+	}
+
+	return nil
+}
+
+// parseCIDR parses the CIDR, a single IP is treated as a CIDR with the full prefix length.
+func parseCIDR(cidr string) (netip.Prefix, error) {
+	cidr = strings.TrimSpace(cidr)
+	if !strings.Contains(cidr, "/") {
+		addr, err := netip.ParseAddr(cidr)
+		if err != nil {
+			return netip.Prefix{}, err
+		}
+
+		addr = addr.Unmap()
+
+		return netip.PrefixFrom(addr, addr.BitLen()), nil
+	}
+
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+
+	return prefix.Masked(), nil
+}
+
+// InvalidateAPIKeyCache removes the API keys from the authentication cache,
+// it should be called after the API keys are changed, disabled or deleted.
+func (s *AuthService) InvalidateAPIKeyCache(ids ...int) {
+	s.invalidateAPIKeyCache(func(apiKey *ent.APIKey) bool {
+		return lo.Contains(ids, apiKey.ID)
+	})
+}
+
+// InvalidateUserAPIKeyCache removes the API keys owned by the users from the authentication cache,
+// it should be called after the users are changed, deactivated or deleted.
+func (s *AuthService) InvalidateUserAPIKeyCache(userIDs ...int) {
+	s.invalidateAPIKeyCache(func(apiKey *ent.APIKey) bool {
+		return lo.Contains(userIDs, apiKey.UserID)
+	})
+}
+
+func (s *AuthService) invalidateAPIKeyCache(match func(apiKey *ent.APIKey) bool) {
+	s.apiKeyCacheGen.Add(1)
+
+	for _, cacheKey := range s.apiKeyCache.Keys() {
+		if cached, ok := s.apiKeyCache.Peek(cacheKey); ok && match(cached.apiKey) {
+			s.apiKeyCache.Remove(cacheKey)
+		}
+	}
+}
+
+// MigrateAPIKeys hashes the API keys stored in plaintext by the previous versions, it runs on the server start.
+// The keys failing to migrate are skipped and logged, so one bad key does not prevent the server from starting.
+func (s *AuthService) MigrateAPIKeys(ctx context.Context) error {
+	ctx = privacy.DecisionContext(ctx, privacy.Allow)
+	ctx = schematype.SkipSoftDelete(ctx)
+	client := ent.FromContext(ctx)
+
+	apiKeys, err := client.APIKey.Query().
+		Where(apikey.KeyPrefixEQ("")).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query plaintext api keys: %w", err)
+	}
+
+	var migrated int
+
+	for _, apiKey := range apiKeys {
+		if err := migrateAPIKey(ctx, client, apiKey); err != nil {
+			log.Warn(ctx, "failed to hash plaintext api key", log.Int("id", apiKey.ID), log.Cause(err))
+			continue
+		}
+
+		migrated++
+	}
+
+	if len(apiKeys) > 0 {
+		log.Info(ctx, "hashed plaintext api keys", log.Int("count", migrated), log.Int("failed", len(apiKeys)-migrated))
+	}
+
+	return nil
+}
+
+func migrateAPIKey(ctx context.Context, client *ent.Client, apiKey *ent.APIKey) error {
+	hashedKey, err := HashAPIKey(apiKey.Key)
+	if err != nil {
+		return err
+	}
+
+	err = client.APIKey.UpdateOneID(apiKey.ID).
+		SetKey(hashedKey).
+		SetKeyPrefix(APIKeyPrefix(apiKey.Key)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to hash api key %d: %w", apiKey.ID, err)
+	}
+
+	return nil
+}
+
 // GenerateAPIKey generates a new API key with ah- prefix (similar to OpenAI format).
 func (s *AuthService) GenerateAPIKey() (string, error) {
 	// Generate 32 bytes of random data
@@ -206,3 +654,62 @@ func (s *AuthService) GenerateAPIKey() (string, error) {
 	// Convert to hex and add ah- prefix
 	return "ah-" + hex.EncodeToString(bytes), nil
 }
+
+// HashAPIKey hashes the API key with a random salt, the result is in the format of "salt:hash".
+func HashAPIKey(key string) (string, error) {
+	salt := make([]byte, 16)
+
+	_, err := rand.Read(salt)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate random bytes: %w", err)
+	}
+
+	return hex.EncodeToString(salt) + ":" + hex.EncodeToString(saltedAPIKeyHash(salt, key)), nil
+}
+
+// VerifyAPIKey verifies the plain API key against the hashed key.
+func VerifyAPIKey(hashedKey, key string) bool {
+	saltHex, hashHex, ok := strings.Cut(hashedKey, ":")
+	if !ok {
+		return false
+	}
+
+	salt, err := hex.DecodeString(saltHex)
+	if err != nil {
+		return false
+	}
+
+	hash, err := hex.DecodeString(hashHex)
+	if err != nil {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare(hash, saltedAPIKeyHash(salt, key)) == 1
+}
+
+// APIKeyPrefix returns the visible prefix of the API key.
+func APIKeyPrefix(key string) string {
+	if len(key) <= apiKeyPrefixLength {
+		return key
+	}
+
+	return key[:apiKeyPrefixLength]
+}
+
+// MaskAPIKey returns the masked API key for display.
+func MaskAPIKey(prefix string) string {
+	return prefix + strings.Repeat("*", 8)
+}
+
+func saltedAPIKeyHash(salt []byte, key string) []byte {
+	h := sha256.New()
+	h.Write(salt)
+	h.Write([]byte(key))
+
+	return h.Sum(nil)
+}
+
+func apiKeyDigest(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,361 @@
+package biz
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/looplj/axonhub/internal/contexts"
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/apikey"
+	"github.com/looplj/axonhub/internal/ent/privacy"
+	"github.com/looplj/axonhub/internal/server/db"
+)
+
+func TestHashAPIKey(t *testing.T) {
+	key := "ah-0123456789abcdef"
+
+	hashed, err := HashAPIKey(key)
+	require.NoError(t, err)
+	require.NotContains(t, hashed, key)
+	require.True(t, VerifyAPIKey(hashed, key))
+	require.False(t, VerifyAPIKey(hashed, key+"0"))
+	require.False(t, VerifyAPIKey(key, key))
+
+	// The salt is random, so the same key is hashed differently.
+	hashed2, err := HashAPIKey(key)
+	require.NoError(t, err)
+	require.NotEqual(t, hashed, hashed2)
+
+	require.Equal(t, "ah-01234567", APIKeyPrefix(key))
+	require.Equal(t, "ah-", APIKeyPrefix("ah-"))
+}
+
+func TestAuthService_AuthenticateAPIKey(t *testing.T) {
+	client := db.NewEntClient(db.Config{
+		Dialect: "sqlite3",
+		DSN:     "file:ent?mode=memory&cache=shared&_fk=1",
+	})
+	defer client.Close()
+
+	ctx := ent.NewContext(t.Context(), client)
+	ctx = privacy.DecisionContext(ctx, privacy.Allow)
+
+	owner, err := client.User.Create().
+		SetEmail("owner@example.com").
+		SetPassword("password").
+		Save(ctx)
+	require.NoError(t, err)
+
+	service := NewAuthService(AuthServiceParams{})
+
+	key, err := service.GenerateAPIKey()
+	require.NoError(t, err)
+
+	hashed, err := HashAPIKey(key)
+	require.NoError(t, err)
+
+	created, err := client.APIKey.Create().
+		SetName("test").
+		SetKey(hashed).
+		SetKeyPrefix(APIKeyPrefix(key)).
+		SetUserID(owner.ID).
+		Save(ctx)
+	require.NoError(t, err)
+
+	apiKey, err := service.AnthenticateAPIKey(ctx, key)
+	require.NoError(t, err)
+	require.Equal(t, created.ID, apiKey.ID)
+	require.NotNil(t, apiKey.Edges.User)
+	require.Equal(t, owner.ID, apiKey.Edges.User.ID)
+
+	_, err = service.AnthenticateAPIKey(ctx, key[:len(key)-1]+"x")
+	require.ErrorIs(t, err, ErrInvalidAPIKey)
+
+	// The cached API key is returned until the cache is invalidated.
+	err = client.APIKey.UpdateOneID(created.ID).SetStatus(apikey.StatusDisabled).Exec(ctx)
+	require.NoError(t, err)
+
+	_, err = service.AnthenticateAPIKey(ctx, key)
+	require.NoError(t, err)
+
+	service.InvalidateAPIKeyCache(created.ID)
+
+	_, err = service.AnthenticateAPIKey(ctx, key)
+	require.ErrorIs(t, err, ErrInvalidAPIKey)
+}
+
+func TestAuthService_AuthenticateAPIKey_Expired(t *testing.T) {
+	client := db.NewEntClient(db.Config{
+		Dialect: "sqlite3",
+		DSN:     "file:ent?mode=memory&cache=shared&_fk=1",
+	})
+	defer client.Close()
+
+	ctx := ent.NewContext(t.Context(), client)
+	ctx = privacy.DecisionContext(ctx, privacy.Allow)
+
+	owner, err := client.User.Create().
+		SetEmail("owner@example.com").
+		SetPassword("password").
+		Save(ctx)
+	require.NoError(t, err)
+
+	service := NewAuthService(AuthServiceParams{})
+
+	key, err := service.GenerateAPIKey()
+	require.NoError(t, err)
+
+	hashed, err := HashAPIKey(key)
+	require.NoError(t, err)
+
+	_, err = client.APIKey.Create().
+		SetName("expired").
+		SetKey(hashed).
+		SetKeyPrefix(APIKeyPrefix(key)).
+		SetUserID(owner.ID).
+		SetExpiresAt(time.Now().Add(-time.Minute)).
+		Save(ctx)
+	require.NoError(t, err)
+
+	_, err = service.AnthenticateAPIKey(ctx, key)
+	require.ErrorIs(t, err, ErrAPIKeyExpired)
+}
+
+func TestCheckAPIKeyClientIP(t *testing.T) {
+	tests := []struct {
+		name     string
+		cidrs    []string
+		clientIP string
+		allowed  bool
+	}{
+		{name: "no allowlist", cidrs: nil, clientIP: "203.0.113.10", allowed: true},
+		{name: "cidr match", cidrs: []string{"10.0.0.0/8", "203.0.113.0/24"}, clientIP: "203.0.113.10", allowed: true},
+		{name: "single ip match", cidrs: []string{"203.0.113.10"}, clientIP: "203.0.113.10", allowed: true},
+		{name: "ipv4 mapped ipv6", cidrs: []string{"203.0.113.0/24"}, clientIP: "::ffff:203.0.113.10", allowed: true},
+		{name: "ipv6 match", cidrs: []string{"2001:db8::/32"}, clientIP: "2001:db8::1", allowed: true},
+		{name: "not match", cidrs: []string{"10.0.0.0/8"}, clientIP: "203.0.113.10", allowed: false},
+		{name: "invalid client ip", cidrs: []string{"10.0.0.0/8"}, clientIP: "unknown", allowed: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckAPIKeyClientIP(&ent.APIKey{AllowedCidrs: tt.cidrs}, tt.clientIP)
+			if tt.allowed {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, ErrIPNotAllowed)
+			}
+		})
+	}
+}
+
+func TestValidateCIDRs(t *testing.T) {
+	require.NoError(t, ValidateCIDRs(nil))
+	require.NoError(t, ValidateCIDRs([]string{"10.0.0.0/8", "203.0.113.10", "2001:db8::/32"}))
+	require.Error(t, ValidateCIDRs([]string{"10.0.0.0/33"}))
+	require.Error(t, ValidateCIDRs([]string{"example.com"}))
+}
+
+func TestAuthService_MigrateAPIKeys(t *testing.T) {
+	client := db.NewEntClient(db.Config{
+		Dialect: "sqlite3",
+		DSN:     "file:ent?mode=memory&cache=shared&_fk=1",
+	})
+	defer client.Close()
+
+	ctx := ent.NewContext(t.Context(), client)
+	ctx = privacy.DecisionContext(ctx, privacy.Allow)
+
+	owner, err := client.User.Create().
+		SetEmail("owner@example.com").
+		SetPassword("password").
+		Save(ctx)
+	require.NoError(t, err)
+
+	// The API key stored in plaintext by the previous versions.
+	key := "ah-fedcba9876543210fedcba9876543210"
+	legacy, err := client.APIKey.Create().
+		SetName("legacy").
+		SetKey(key).
+		SetUserID(owner.ID).
+		Save(ctx)
+	require.NoError(t, err)
+
+	service := NewAuthService(AuthServiceParams{})
+	require.NoError(t, service.MigrateAPIKeys(ctx))
+
+	migrated, err := client.APIKey.Get(ctx, legacy.ID)
+	require.NoError(t, err)
+	require.Equal(t, "ah-fedcba98", migrated.KeyPrefix)
+	require.NotEqual(t, key, migrated.Key)
+	require.True(t, VerifyAPIKey(migrated.Key, key))
+
+	apiKey, err := service.AnthenticateAPIKey(ctx, key)
+	require.NoError(t, err)
+	require.Equal(t, legacy.ID, apiKey.ID)
+}
+
+func TestAuthService_RotateAPIKey(t *testing.T) {
+	client := db.NewEntClient(db.Config{
+		Dialect: "sqlite3",
+		DSN:     "file:ent?mode=memory&cache=shared&_fk=1",
+	})
+	defer client.Close()
+
+	ctx := ent.NewContext(t.Context(), client)
+	ctx = privacy.DecisionContext(ctx, privacy.Allow)
+
+	owner, err := client.User.Create().
+		SetEmail("owner@example.com").
+		SetPassword("password").
+		Save(ctx)
+	require.NoError(t, err)
+
+	service := NewAuthService(AuthServiceParams{})
+
+	oldKey, err := service.GenerateAPIKey()
+	require.NoError(t, err)
+
+	hashed, err := HashAPIKey(oldKey)
+	require.NoError(t, err)
+
+	created, err := client.APIKey.Create().
+		SetName("rotate").
+		SetKey(hashed).
+		SetKeyPrefix(APIKeyPrefix(oldKey)).
+		SetUserID(owner.ID).
+		Save(ctx)
+	require.NoError(t, err)
+
+	// Cache the old key before the rotation.
+	_, err = service.AnthenticateAPIKey(ctx, oldKey)
+	require.NoError(t, err)
+
+	usedAt, err := client.APIKey.Get(ctx, created.ID)
+	require.NoError(t, err)
+	require.NotNil(t, usedAt.LastUsedAt)
+
+	rotated, newKey, err := service.RotateAPIKey(ctx, created.ID, time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, created.ID, rotated.ID)
+	require.Equal(t, "rotate", rotated.Name)
+	require.NotEqual(t, oldKey, newKey)
+	require.NotEqual(t, newKey, rotated.Key)
+
+	stored, err := client.APIKey.Get(ctx, created.ID)
+	require.NoError(t, err)
+	require.True(t, VerifyAPIKey(stored.Key, newKey))
+	require.True(t, VerifyAPIKey(stored.PreviousKey, oldKey))
+	require.Nil(t, stored.LastUsedAt)
+	require.NotNil(t, stored.PreviousKeyLastUsedAt)
+	require.NotNil(t, stored.PreviousKeyExpiresAt)
+
+	// Both keys are valid during the grace period.
+	apiKey, err := service.AnthenticateAPIKey(ctx, newKey)
+	require.NoError(t, err)
+	require.Equal(t, created.ID, apiKey.ID)
+
+	apiKey, err = service.AnthenticateAPIKey(ctx, oldKey)
+	require.NoError(t, err)
+	require.Equal(t, created.ID, apiKey.ID)
+
+	stored, err = client.APIKey.Get(ctx, created.ID)
+	require.NoError(t, err)
+	require.NotNil(t, stored.LastUsedAt)
+
+	// The old key is rejected once the grace period ends.
+	err = client.APIKey.UpdateOneID(created.ID).SetPreviousKeyExpiresAt(time.Now().Add(-time.Second)).Exec(ctx)
+	require.NoError(t, err)
+	service.InvalidateAPIKeyCache(created.ID)
+
+	_, err = service.AnthenticateAPIKey(ctx, oldKey)
+	require.ErrorIs(t, err, ErrInvalidAPIKey)
+
+	_, err = service.AnthenticateAPIKey(ctx, newKey)
+	require.NoError(t, err)
+
+	// Rotating without grace period revokes the previous key immediately.
+	_, rotatedKey, err := service.RotateAPIKey(ctx, created.ID, 0)
+	require.NoError(t, err)
+
+	_, err = service.AnthenticateAPIKey(ctx, newKey)
+	require.ErrorIs(t, err, ErrInvalidAPIKey)
+
+	_, err = service.AnthenticateAPIKey(ctx, rotatedKey)
+	require.NoError(t, err)
+}
+
+func TestAuthService_DeleteAndRestoreUser(t *testing.T) {
+	client := db.NewEntClient(db.Config{
+		Dialect: "sqlite3",
+		DSN:     "file:ent?mode=memory&cache=shared&_fk=1",
+	})
+	defer client.Close()
+
+	ctx := ent.NewContext(t.Context(), client)
+	ctx = privacy.DecisionContext(ctx, privacy.Allow)
+
+	owner, err := client.User.Create().
+		SetEmail("owner@example.com").
+		SetPassword("password").
+		SetIsOwner(true).
+		Save(ctx)
+	require.NoError(t, err)
+
+	member, err := client.User.Create().
+		SetEmail("member@example.com").
+		SetPassword("password").
+		Save(ctx)
+	require.NoError(t, err)
+
+	service := NewAuthService(AuthServiceParams{})
+
+	key, err := service.GenerateAPIKey()
+	require.NoError(t, err)
+
+	hashed, err := HashAPIKey(key)
+	require.NoError(t, err)
+
+	created, err := client.APIKey.Create().
+		SetName("member").
+		SetKey(hashed).
+		SetKeyPrefix(APIKeyPrefix(key)).
+		SetUserID(member.ID).
+		Save(ctx)
+	require.NoError(t, err)
+
+	_, err = service.AnthenticateAPIKey(ctx, key)
+	require.NoError(t, err)
+
+	require.ErrorIs(t, service.DeleteUser(ctx, owner.ID), ErrDeleteOwner)
+	require.ErrorIs(t, service.DeleteUser(contexts.WithUser(ctx, member), member.ID), ErrDeleteSelf)
+
+	require.NoError(t, service.DeleteUser(ctx, member.ID))
+
+	_, err = client.User.Get(ctx, member.ID)
+	require.True(t, ent.IsNotFound(err))
+
+	// The API keys of the deleted user are disabled, and the cached one is invalidated.
+	disabled, err := client.APIKey.Get(ctx, created.ID)
+	require.NoError(t, err)
+	require.Equal(t, apikey.StatusDisabled, disabled.Status)
+
+	_, err = service.AnthenticateAPIKey(ctx, key)
+	require.ErrorIs(t, err, ErrInvalidAPIKey)
+
+	// The API key of the deleted user can not be restored until the user is restored.
+	require.NoError(t, service.DeleteAPIKey(ctx, created.ID))
+
+	_, err = service.RestoreAPIKey(ctx, created.ID)
+	require.ErrorIs(t, err, ErrOwnerDeleted)
+
+	restored, err := service.RestoreUser(ctx, member.ID)
+	require.NoError(t, err)
+	require.Equal(t, member.ID, restored.ID)
+
+	restoredKey, err := service.RestoreAPIKey(ctx, created.ID)
+	require.NoError(t, err)
+	require.Equal(t, apikey.StatusDisabled, restoredKey.Status)
+}
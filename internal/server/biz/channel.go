@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"slices"
 	"time"
 
@@ -13,14 +14,21 @@ import (
 	"github.com/looplj/axonhub/internal/ent"
 	"github.com/looplj/axonhub/internal/ent/channel"
 	"github.com/looplj/axonhub/internal/ent/privacy"
+	"github.com/looplj/axonhub/internal/ent/schema/schematype"
 	"github.com/looplj/axonhub/internal/llm"
+	"github.com/looplj/axonhub/internal/llm/decorator"
+	"github.com/looplj/axonhub/internal/llm/decorator/imagefetch"
+	"github.com/looplj/axonhub/internal/llm/tokenizer"
 	"github.com/looplj/axonhub/internal/llm/transformer"
 	"github.com/looplj/axonhub/internal/llm/transformer/anthropic"
 	"github.com/looplj/axonhub/internal/llm/transformer/openai"
 	"github.com/looplj/axonhub/internal/llm/transformer/openrouter"
 	"github.com/looplj/axonhub/internal/llm/transformer/zai"
 	"github.com/looplj/axonhub/internal/log"
+	"github.com/looplj/axonhub/internal/objects"
+	"github.com/looplj/axonhub/internal/pkg/httpclient"
 	"github.com/looplj/axonhub/internal/pkg/xerrors"
+	"github.com/looplj/axonhub/internal/server/affinity"
 )
 
 type Channel struct {
@@ -28,6 +36,12 @@ type Channel struct {
 
 	// Outbound is the outbound transformer for the channel.
 	Outbound transformer.Outbound
+
+	// Decorators are applied to the request after the channel is selected, before the outbound transformer.
+	Decorators []decorator.Decorator
+
+	// Executor is the HTTP client of the channel transport settings, nil if the shared HTTP client is used.
+	Executor *httpclient.HttpClient
 }
 
 func (c Channel) IsModelSupported(model string) bool {
@@ -69,17 +83,24 @@ func (c Channel) ChooseModel(model string) (string, error) {
 type ChannelServiceParams struct {
 	fx.In
 
-	Executor executors.ScheduledExecutor
-	Client   *ent.Client
+	Executor   executors.ScheduledExecutor
+	Client     *ent.Client
+	Tokenizers *tokenizer.Registry
+	Affinity   *affinity.Store
 }
 
 func NewChannelService(params ChannelServiceParams) *ChannelService {
 	svc := &ChannelService{
-		Executors: params.Executor,
-		Ent:       params.Client,
+		Executors:  params.Executor,
+		Ent:        params.Client,
+		Tokenizers: params.Tokenizers,
+		Affinity:   params.Affinity,
+		Stats:      NewChannelStats(),
 	}
 
 	xerrors.NoErr(svc.loadChannels(context.Background()))
+	xerrors.NoErr(svc.LoadVirtualModels(context.Background()))
+	xerrors.NoErr(svc.LoadRoutingRules(context.Background()))
 	xerrors.NoErr2(
 		params.Executor.ScheduleFuncAtCronRate(
 			svc.loadChannelsPeriodic,
@@ -91,7 +112,17 @@ func NewChannelService(params ChannelServiceParams) *ChannelService {
 }
 
 type ChannelService struct {
-	Channels  []*Channel
+	Channels []*Channel
+	// VirtualModels are the enabled virtual models, they are resolved to the concrete models before choosing the channels.
+	VirtualModels []*ent.VirtualModel
+	// RoutingRules are the enabled routing rules with their channels, ordered by the priority.
+	RoutingRules []*ent.RoutingRule
+	// Tokenizers estimate the prompt tokens for the routing rules, the approximations are used if nil.
+	Tokenizers *tokenizer.Registry
+	// Affinity pins the conversations to the channels which served them, nil if the session affinity is disabled.
+	Affinity *affinity.Store
+	// Stats track the health and the latency of the channels for the routing strategies.
+	Stats     *ChannelStats
 	Executors executors.ScheduledExecutor
 	Ent       *ent.Client
 	// latestUpdate 记录最新的 channel 更新时间，用于优化定时加载
@@ -103,15 +134,23 @@ func (svc *ChannelService) loadChannelsPeriodic(ctx context.Context) {
 	if err != nil {
 		log.Error(ctx, "failed to load channels", log.Cause(err))
 	}
+
+	if err := svc.LoadVirtualModels(ctx); err != nil {
+		log.Error(ctx, "failed to load virtual models", log.Cause(err))
+	}
+
+	if err := svc.LoadRoutingRules(ctx); err != nil {
+		log.Error(ctx, "failed to load routing rules", log.Cause(err))
+	}
 }
 
 func (svc *ChannelService) loadChannels(ctx context.Context) error {
 	ctx = privacy.DecisionContext(ctx, privacy.Allow)
 
-	// 检查是否有 channels 被修改
+	// 检查是否有 channels 被修改，包含软删除的 channels，以便删除后重新加载
 	latestUpdatedChannel, err := svc.Ent.Channel.Query().
 		Order(ent.Desc(channel.FieldUpdatedAt)).
-		First(ctx)
+		First(schematype.SkipSoftDelete(ctx))
 	if err != nil && !ent.IsNotFound(err) {
 		return err
 	}
@@ -157,12 +196,42 @@ func (svc *ChannelService) loadChannels(ctx context.Context) error {
 		channels = append(channels, channel)
 	}
 
+	previous := svc.Channels
 	svc.Channels = channels
 
+	// The in-flight requests keep using the replaced clients, only their idle connections are closed.
+	for _, ch := range previous {
+		if ch.Executor != nil {
+			ch.Executor.CloseIdleConnections()
+		}
+	}
+
 	return nil
 }
 
 func (svc *ChannelService) buildChannel(c *ent.Channel) (*Channel, error) {
+	transport, err := channelTransport(c.Settings)
+	if err != nil {
+		return nil, err
+	}
+
+	ch, err := svc.buildOutboundChannel(c, transport)
+	if err != nil {
+		return nil, err
+	}
+
+	ch.Decorators = channelDecorators(c.Settings)
+
+	if transport != nil {
+		ch.Executor = httpclient.NewHttpClientWithClient(&http.Client{Transport: transport})
+	}
+
+	return ch, nil
+}
+
+// buildOutboundChannel creates the outbound transformer of the channel,
+// the Bedrock and Vertex executors send the requests on the transport of the channel.
+func (svc *ChannelService) buildOutboundChannel(c *ent.Channel, transport http.RoundTripper) (*Channel, error) {
 	//nolint:exhaustive // TODO SUPPORT more providers.
 	switch c.Type {
 	case channel.TypeOpenai, channel.TypeDeepseek, channel.TypeDoubao, channel.TypeMoonshot, channel.TypeGeminiOpenai:
@@ -196,7 +265,12 @@ func (svc *ChannelService) buildChannel(c *ent.Channel) (*Channel, error) {
 			Outbound: transformer,
 		}, nil
 	case channel.TypeAnthropic, channel.TypeDeepseekAnthropic, channel.TypeMoonshotAnthropic, channel.TypeZhipuAnthropic, channel.TypeZaiAnthropic:
-		transformer, err := anthropic.NewOutboundTransformer(c.BaseURL, c.Credentials.APIKey)
+		transformer, err := anthropic.NewOutboundTransformerWithConfig(&anthropic.Config{
+			Type:             anthropic.PlatformDirect,
+			BaseURL:          c.BaseURL,
+			APIKey:           c.Credentials.APIKey,
+			AutoCacheControl: autoCacheControl(c.Settings),
+		})
 		if err != nil {
 			return nil, fmt.Errorf("failed to create outbound transformer: %w", err)
 		}
@@ -209,10 +283,12 @@ func (svc *ChannelService) buildChannel(c *ent.Channel) (*Channel, error) {
 		// For anthropic_aws, we need to create a transformer with AWS credentials
 		// The transformer will handle AWS Bedrock integration
 		transformer, err := anthropic.NewOutboundTransformerWithConfig(&anthropic.Config{
-			Type:            anthropic.PlatformBedrock,
-			Region:          c.Credentials.AWS.Region,
-			AccessKeyID:     c.Credentials.AWS.AccessKeyID,
-			SecretAccessKey: c.Credentials.AWS.SecretAccessKey,
+			Type:             anthropic.PlatformBedrock,
+			Region:           c.Credentials.AWS.Region,
+			AccessKeyID:      c.Credentials.AWS.AccessKeyID,
+			SecretAccessKey:  c.Credentials.AWS.SecretAccessKey,
+			AutoCacheControl: autoCacheControl(c.Settings),
+			Transport:        transport,
 		})
 		if err != nil {
 			return nil, fmt.Errorf("failed to create outbound transformer: %w", err)
@@ -230,10 +306,12 @@ func (svc *ChannelService) buildChannel(c *ent.Channel) (*Channel, error) {
 		}
 
 		transformer, err := anthropic.NewOutboundTransformerWithConfig(&anthropic.Config{
-			Type:      anthropic.PlatformVertex,
-			Region:    c.Credentials.GCP.Region,
-			ProjectID: c.Credentials.GCP.ProjectID,
-			JSONData:  c.Credentials.GCP.JSONData,
+			Type:             anthropic.PlatformVertex,
+			Region:           c.Credentials.GCP.Region,
+			ProjectID:        c.Credentials.GCP.ProjectID,
+			JSONData:         c.Credentials.GCP.JSONData,
+			AutoCacheControl: autoCacheControl(c.Settings),
+			Transport:        transport,
 		})
 		if err != nil {
 			return nil, fmt.Errorf("failed to create outbound transformer: %w", err)
@@ -263,14 +341,51 @@ func (svc *ChannelService) buildChannel(c *ent.Channel) (*Channel, error) {
 	}
 }
 
+// autoCacheControl returns the cache breakpoint added automatically for the channel, nil if the automatic caching is disabled.
+func autoCacheControl(settings *objects.ChannelSettings) *anthropic.CacheControl {
+	if settings == nil || settings.PromptCaching == nil || !settings.PromptCaching.Auto {
+		return nil
+	}
+
+	return &anthropic.CacheControl{
+		Type: "ephemeral",
+		TTL:  settings.PromptCaching.TTL,
+	}
+}
+
+// channelDecorators returns the request decorators enabled in the channel settings.
+func channelDecorators(settings *objects.ChannelSettings) []decorator.Decorator {
+	var decorators []decorator.Decorator
+
+	if settings != nil && settings.ImageFetch != nil && settings.ImageFetch.Enabled {
+		decorators = append(decorators, imagefetch.InlineRemoteImages(imagefetch.Config{
+			MaxBytes:     int64(settings.ImageFetch.MaxBytes),
+			Timeout:      time.Duration(settings.ImageFetch.TimeoutSeconds) * time.Second,
+			MaxDimension: settings.ImageFetch.MaxDimension,
+		}))
+	}
+
+	if settings != nil {
+		if dec := RequestOverrides(settings.Overrides); dec != nil {
+			decorators = append(decorators, dec)
+		}
+	}
+
+	return decorators
+}
+
+// ChooseChannels returns the channels supporting the model of the request,
+// the channels out of their schedule windows or in maintenance are skipped.
 func (svc *ChannelService) ChooseChannels(
 	ctx context.Context,
 	chatReq *llm.Request,
 ) ([]*Channel, error) {
 	var channels []*Channel
 
+	now := time.Now()
+
 	for _, channel := range svc.Channels {
-		if channel.IsModelSupported(chatReq.Model) {
+		if channel.IsModelSupported(chatReq.Model) && channel.IsAvailableAt(now) {
 			channels = append(channels, channel)
 		}
 	}
@@ -278,6 +393,51 @@ func (svc *ChannelService) ChooseChannels(
 	return channels, nil
 }
 
+// Model is a model available to the API keys.
+type Model struct {
+	ID string
+	// OwnedBy is the type of the first channel supporting the model, "axonhub" for the virtual models.
+	OwnedBy string
+	// CreatedAt is the creation time of the first channel supporting the model, or the virtual model.
+	CreatedAt time.Time
+}
+
+// ListModels returns the models supported by the enabled channels, including the mapped ones, followed by the virtual models.
+func (svc *ChannelService) ListModels() []Model {
+	var (
+		models []Model
+		seen   = make(map[string]bool)
+	)
+
+	add := func(id, ownedBy string, createdAt time.Time) {
+		if !seen[id] {
+			seen[id] = true
+
+			models = append(models, Model{ID: id, OwnedBy: ownedBy, CreatedAt: createdAt})
+		}
+	}
+
+	for _, channel := range svc.Channels {
+		for _, model := range channel.SupportedModels {
+			add(model, channel.Type.String(), channel.CreatedAt)
+		}
+
+		if channel.Settings != nil {
+			for _, mapping := range channel.Settings.ModelMappings {
+				if slices.Contains(channel.SupportedModels, mapping.To) {
+					add(mapping.From, channel.Type.String(), channel.CreatedAt)
+				}
+			}
+		}
+	}
+
+	for _, vm := range svc.VirtualModels {
+		add(vm.Name, "axonhub", vm.CreatedAt)
+	}
+
+	return models
+}
+
 // GetChannelForTest retrieves a specific channel by ID for testing purposes,
 // including disabled channels. This bypasses the normal enabled-only filtering.
 func (svc *ChannelService) GetChannelForTest(ctx context.Context, channelID int) (*Channel, error) {
@@ -336,3 +496,77 @@ func (svc *ChannelService) BulkUpdateChannelOrdering(ctx context.Context, update
 
 	return updatedChannels, nil
 }
+
+// DeleteChannel soft deletes the channel, the name of the deleted channel can be reused by a new channel.
+func (svc *ChannelService) DeleteChannel(ctx context.Context, id int) error {
+	entity, err := svc.Ent.Channel.Get(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to get channel: %w", err)
+	}
+
+	// The name and deleted_at are unique together, so the channels with the same name deleted in the same second
+	// get different deleted_at.
+	deletedAt := int(time.Now().Unix())
+
+	last, err := svc.Ent.Channel.Query().
+		Where(channel.Name(entity.Name), channel.DeletedAtGT(0)).
+		Order(ent.Desc(channel.FieldDeletedAt)).
+		First(schematype.SkipSoftDelete(ctx))
+	if err != nil && !ent.IsNotFound(err) {
+		return fmt.Errorf("failed to get deleted channel: %w", err)
+	}
+
+	if last != nil && last.DeletedAt >= deletedAt {
+		deletedAt = last.DeletedAt + 1
+	}
+
+	if err := svc.Ent.Channel.UpdateOne(entity).SetDeletedAt(deletedAt).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to delete channel: %w", err)
+	}
+
+	svc.reloadChannels()
+
+	return nil
+}
+
+// RestoreChannel restores the soft deleted channel.
+// It fails if an active channel is using the same name.
+func (svc *ChannelService) RestoreChannel(ctx context.Context, id int) (*ent.Channel, error) {
+	deleted, err := svc.Ent.Channel.Query().
+		Where(channel.ID(id), channel.DeletedAtGT(0)).
+		Only(schematype.SkipSoftDelete(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deleted channel: %w", err)
+	}
+
+	exists, err := svc.Ent.Channel.Query().
+		Where(channel.Name(deleted.Name)).
+		Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check channel name: %w", err)
+	}
+
+	if exists {
+		return nil, fmt.Errorf("channel %s: %w", deleted.Name, ErrNameConflict)
+	}
+
+	restored, err := svc.Ent.Channel.UpdateOne(deleted).
+		SetDeletedAt(0).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore channel: %w", err)
+	}
+
+	svc.reloadChannels()
+
+	return restored, nil
+}
+
+// reloadChannels reloads the channels in background, so the in-memory cache reflects the changes without waiting for the periodic loading.
+func (svc *ChannelService) reloadChannels() {
+	go func() {
+		if reloadErr := svc.loadChannels(context.Background()); reloadErr != nil {
+			log.Error(context.Background(), "failed to reload channels", log.Cause(reloadErr))
+		}
+	}()
+}
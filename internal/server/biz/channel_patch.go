@@ -0,0 +1,112 @@
+package biz
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/tidwall/sjson"
+
+	"github.com/looplj/axonhub/internal/objects"
+	"github.com/looplj/axonhub/internal/pkg/httpclient"
+)
+
+// ValidateChannelSettings checks the request overrides, the extra headers, the body patches,
+// the transport, the model prices, the schedule and the maintenance windows of the settings.
+func ValidateChannelSettings(settings *objects.ChannelSettings) error {
+	if settings == nil {
+		return nil
+	}
+
+	if err := ValidateRequestOverrides(settings.Overrides); err != nil {
+		return err
+	}
+
+	if err := validateTransportSettings(settings.Transport); err != nil {
+		return err
+	}
+
+	if err := validateModelPrices(settings.ModelPrices); err != nil {
+		return err
+	}
+
+	if err := validateChannelSchedule(settings.Schedule); err != nil {
+		return err
+	}
+
+	if err := validateMaintenanceWindows(settings.MaintenanceWindows); err != nil {
+		return err
+	}
+
+	for _, header := range settings.ExtraHeaders {
+		if header.Key == "" {
+			return errors.New("invalid extra header: key is required")
+		}
+	}
+
+	for _, patch := range settings.BodyPatches {
+		if patch.Path == "" {
+			return errors.New("invalid body patch: path is required")
+		}
+
+		if !patch.Delete && !json.Valid([]byte(patch.Value)) {
+			return fmt.Errorf("invalid body patch %s: value must be valid JSON", patch.Path)
+		}
+	}
+
+	return nil
+}
+
+// PatchRequest adds the extra headers and applies the body patches of the channel settings
+// to the upstream request of the model.
+func (c *Channel) PatchRequest(request *httpclient.Request, model string) error {
+	if c.Settings == nil {
+		return nil
+	}
+
+	for _, header := range c.Settings.ExtraHeaders {
+		if !appliesTo(header.Models, model) {
+			continue
+		}
+
+		if request.Headers == nil {
+			request.Headers = make(http.Header)
+		}
+
+		request.Headers.Set(header.Key, header.Value)
+	}
+
+	// The requests without body, e.g. the GET requests, are not patched.
+	if len(request.Body) == 0 {
+		return nil
+	}
+
+	body := request.Body
+
+	for _, patch := range c.Settings.BodyPatches {
+		if !appliesTo(patch.Models, model) {
+			continue
+		}
+
+		var err error
+		if patch.Delete {
+			body, err = sjson.DeleteBytes(body, patch.Path)
+		} else {
+			body, err = sjson.SetRawBytes(body, patch.Path, []byte(patch.Value))
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to apply body patch %s: %w", patch.Path, err)
+		}
+	}
+
+	request.Body = body
+
+	return nil
+}
+
+func appliesTo(models []string, model string) bool {
+	return len(models) == 0 || slices.Contains(models, model)
+}
@@ -0,0 +1,174 @@
+package biz
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	// The time zones of the channel schedules are available without the system time zone database.
+	_ "time/tzdata"
+
+	"github.com/looplj/axonhub/internal/objects"
+)
+
+// locations caches the loaded time zones of the channel schedules by the name.
+var locations sync.Map
+
+func loadLocation(name string) (*time.Location, error) {
+	if name == "" {
+		return time.UTC, nil
+	}
+
+	if loc, ok := locations.Load(name); ok {
+		return loc.(*time.Location), nil
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, err
+	}
+
+	locations.Store(name, loc)
+
+	return loc, nil
+}
+
+// parseClock parses the HH:MM time of the day to the minutes since midnight.
+func parseClock(value string) (int, error) {
+	clock, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %q: must be HH:MM", value)
+	}
+
+	return clock.Hour()*60 + clock.Minute(), nil
+}
+
+// validateChannelSchedule checks the time zone, the days and the times of the schedule windows.
+func validateChannelSchedule(schedule *objects.ChannelSchedule) error {
+	if schedule == nil {
+		return nil
+	}
+
+	if _, err := loadLocation(schedule.Timezone); err != nil {
+		return fmt.Errorf("invalid schedule timezone %s: %w", schedule.Timezone, err)
+	}
+
+	if len(schedule.Windows) == 0 {
+		return errors.New("invalid schedule: at least one window is required")
+	}
+
+	for _, window := range schedule.Windows {
+		for _, day := range window.Days {
+			if day < 0 || day > 6 {
+				return fmt.Errorf("invalid schedule window: day %d must be between 0 and 6", day)
+			}
+		}
+
+		if _, err := parseClock(window.Start); err != nil {
+			return fmt.Errorf("invalid schedule window start: %w", err)
+		}
+
+		if _, err := parseClock(window.End); err != nil {
+			return fmt.Errorf("invalid schedule window end: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// validateMaintenanceWindows checks the maintenance windows end after they start.
+func validateMaintenanceWindows(windows []objects.MaintenanceWindow) error {
+	for _, window := range windows {
+		if window.StartAt.IsZero() || window.EndAt.IsZero() {
+			return errors.New("invalid maintenance window: start and end are required")
+		}
+
+		if !window.EndAt.After(window.StartAt) {
+			return fmt.Errorf("invalid maintenance window %s: end must be after start", window.StartAt.Format(time.RFC3339))
+		}
+	}
+
+	return nil
+}
+
+// ActiveMaintenanceWindow returns the maintenance window of the channel in effect at the time, nil if there is none.
+func (c *Channel) ActiveMaintenanceWindow(at time.Time) *objects.MaintenanceWindow {
+	if c.Settings == nil {
+		return nil
+	}
+
+	for i, window := range c.Settings.MaintenanceWindows {
+		if !at.Before(window.StartAt) && at.Before(window.EndAt) {
+			return &c.Settings.MaintenanceWindows[i]
+		}
+	}
+
+	return nil
+}
+
+// IsAvailableAt reports whether the channel is in one of its schedule windows and not in maintenance at the time.
+func (c *Channel) IsAvailableAt(at time.Time) bool {
+	if c.Settings == nil {
+		return true
+	}
+
+	if c.ActiveMaintenanceWindow(at) != nil {
+		return false
+	}
+
+	return inSchedule(c.Settings.Schedule, at)
+}
+
+func inSchedule(schedule *objects.ChannelSchedule, at time.Time) bool {
+	if schedule == nil {
+		return true
+	}
+
+	// The schedules are validated when saved, the invalid time zone falls back to UTC.
+	loc, err := loadLocation(schedule.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+
+	local := at.In(loc)
+	minute := local.Hour()*60 + local.Minute()
+	today := int(local.Weekday())
+	yesterday := (today + 6) % 7
+
+	for _, window := range schedule.Windows {
+		start, err := parseClock(window.Start)
+		if err != nil {
+			continue
+		}
+
+		end, err := parseClock(window.End)
+		if err != nil {
+			continue
+		}
+
+		startsOn := func(day int) bool {
+			return len(window.Days) == 0 || slices.Contains(window.Days, day)
+		}
+
+		if start < end {
+			if startsOn(today) && minute >= start && minute < end {
+				return true
+			}
+
+			continue
+		}
+
+		// The window ends on the next day, e.g. from 22:00 to 06:00, or lasts the whole day if the end equals the start.
+		if startsOn(today) && minute >= start {
+			return true
+		}
+
+		if startsOn(yesterday) && minute < end {
+			return true
+		}
+	}
+
+	return false
+}
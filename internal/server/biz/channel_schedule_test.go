@@ -0,0 +1,110 @@
+package biz
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/llm"
+	"github.com/looplj/axonhub/internal/objects"
+)
+
+func TestValidateChannelSettings_Schedule(t *testing.T) {
+	now := time.Now()
+
+	require.NoError(t, ValidateChannelSettings(&objects.ChannelSettings{
+		Schedule: &objects.ChannelSchedule{
+			Timezone: "Asia/Shanghai",
+			Windows:  []objects.TimeWindow{{Days: []int{1, 5}, Start: "00:30", End: "08:30"}},
+		},
+		MaintenanceWindows: []objects.MaintenanceWindow{{StartAt: now, EndAt: now.Add(time.Hour)}},
+	}))
+
+	require.Error(t, ValidateChannelSettings(&objects.ChannelSettings{
+		Schedule: &objects.ChannelSchedule{Timezone: "Mars/Olympus", Windows: []objects.TimeWindow{{Start: "00:00", End: "08:00"}}},
+	}))
+	require.Error(t, ValidateChannelSettings(&objects.ChannelSettings{Schedule: &objects.ChannelSchedule{}}))
+	require.Error(t, ValidateChannelSettings(&objects.ChannelSettings{
+		Schedule: &objects.ChannelSchedule{Windows: []objects.TimeWindow{{Days: []int{7}, Start: "00:00", End: "08:00"}}},
+	}))
+	require.Error(t, ValidateChannelSettings(&objects.ChannelSettings{
+		Schedule: &objects.ChannelSchedule{Windows: []objects.TimeWindow{{Start: "24:00", End: "08:00"}}},
+	}))
+	require.Error(t, ValidateChannelSettings(&objects.ChannelSettings{
+		MaintenanceWindows: []objects.MaintenanceWindow{{StartAt: now, EndAt: now}},
+	}))
+	require.Error(t, ValidateChannelSettings(&objects.ChannelSettings{
+		MaintenanceWindows: []objects.MaintenanceWindow{{EndAt: now}},
+	}))
+}
+
+func TestChannel_IsAvailableAt(t *testing.T) {
+	shanghai, err := time.LoadLocation("Asia/Shanghai")
+	require.NoError(t, err)
+
+	// The off-peak hours of DeepSeek, from 00:30 to 08:30 in Beijing time.
+	offPeak := &Channel{Channel: &ent.Channel{Settings: &objects.ChannelSettings{
+		Schedule: &objects.ChannelSchedule{
+			Timezone: "Asia/Shanghai",
+			Windows:  []objects.TimeWindow{{Start: "00:30", End: "08:30"}},
+		},
+	}}}
+
+	require.True(t, offPeak.IsAvailableAt(time.Date(2025, 6, 2, 0, 30, 0, 0, shanghai)))
+	require.True(t, offPeak.IsAvailableAt(time.Date(2025, 6, 2, 8, 29, 0, 0, shanghai)))
+	require.False(t, offPeak.IsAvailableAt(time.Date(2025, 6, 2, 8, 30, 0, 0, shanghai)))
+	require.False(t, offPeak.IsAvailableAt(time.Date(2025, 6, 2, 0, 29, 0, 0, shanghai)))
+	// 20:00 UTC is 04:00 in Beijing time.
+	require.True(t, offPeak.IsAvailableAt(time.Date(2025, 6, 1, 20, 0, 0, 0, time.UTC)))
+
+	// The window from Friday 22:00 to Saturday 06:00 in UTC.
+	overnight := &Channel{Channel: &ent.Channel{Settings: &objects.ChannelSettings{
+		Schedule: &objects.ChannelSchedule{
+			Windows: []objects.TimeWindow{{Days: []int{int(time.Friday)}, Start: "22:00", End: "06:00"}},
+		},
+	}}}
+
+	require.True(t, overnight.IsAvailableAt(time.Date(2025, 6, 6, 23, 0, 0, 0, time.UTC)))
+	require.True(t, overnight.IsAvailableAt(time.Date(2025, 6, 7, 5, 59, 0, 0, time.UTC)))
+	require.False(t, overnight.IsAvailableAt(time.Date(2025, 6, 7, 22, 0, 0, 0, time.UTC)))
+	require.False(t, overnight.IsAvailableAt(time.Date(2025, 6, 6, 5, 0, 0, 0, time.UTC)))
+
+	start := time.Date(2025, 6, 2, 2, 0, 0, 0, time.UTC)
+	maintained := &Channel{Channel: &ent.Channel{Settings: &objects.ChannelSettings{
+		MaintenanceWindows: []objects.MaintenanceWindow{{StartAt: start, EndAt: start.Add(time.Hour), Reason: "nightly"}},
+	}}}
+
+	require.True(t, maintained.IsAvailableAt(start.Add(-time.Minute)))
+	require.False(t, maintained.IsAvailableAt(start))
+	require.Equal(t, "nightly", maintained.ActiveMaintenanceWindow(start.Add(30*time.Minute)).Reason)
+	require.True(t, maintained.IsAvailableAt(start.Add(time.Hour)))
+	require.Nil(t, maintained.ActiveMaintenanceWindow(start.Add(time.Hour)))
+
+	require.True(t, (&Channel{Channel: &ent.Channel{}}).IsAvailableAt(start))
+}
+
+func TestChannelService_ChooseChannels_Availability(t *testing.T) {
+	now := time.Now()
+
+	newChannel := func(id int, settings *objects.ChannelSettings) *Channel {
+		return &Channel{Channel: &ent.Channel{ID: id, SupportedModels: []string{"gpt-4o"}, Settings: settings}}
+	}
+
+	svc := &ChannelService{Channels: []*Channel{
+		newChannel(1, nil),
+		newChannel(2, &objects.ChannelSettings{
+			MaintenanceWindows: []objects.MaintenanceWindow{{StartAt: now.Add(-time.Minute), EndAt: now.Add(time.Hour)}},
+		}),
+		newChannel(3, &objects.ChannelSettings{
+			MaintenanceWindows: []objects.MaintenanceWindow{{StartAt: now.Add(-2 * time.Hour), EndAt: now.Add(-time.Hour)}},
+		}),
+	}}
+
+	channels, err := svc.ChooseChannels(t.Context(), &llm.Request{Model: "gpt-4o"})
+	require.NoError(t, err)
+	require.Len(t, channels, 2)
+	require.Equal(t, 1, channels[0].ID)
+	require.Equal(t, 3, channels[1].ID)
+}
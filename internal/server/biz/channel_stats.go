@@ -0,0 +1,103 @@
+package biz
+
+import (
+	"sync"
+	"time"
+)
+
+// statsDecay is the weight of the latest request in the moving averages of the channel stats.
+const statsDecay = 0.2
+
+// ChannelStats tracks the health and the latency of the channels from their recent requests,
+// the stats are local to the instance and start from healthy with unknown latency.
+type ChannelStats struct {
+	mu    sync.RWMutex
+	stats map[int]channelStat
+}
+
+type channelStat struct {
+	// health is the moving average of the success rate.
+	health float64
+	// latency is the moving average of the latency of the successful requests, zero if unknown.
+	latency time.Duration
+}
+
+func NewChannelStats() *ChannelStats {
+	return &ChannelStats{
+		stats: make(map[int]channelStat),
+	}
+}
+
+// RecordSuccess records a successful request of the channel with its latency,
+// the time to the first event for the streaming requests.
+func (s *ChannelStats) RecordSuccess(channelID int, latency time.Duration) {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stat := s.get(channelID)
+	stat.health = stat.health*(1-statsDecay) + statsDecay
+
+	if stat.latency == 0 {
+		stat.latency = latency
+	} else {
+		stat.latency = time.Duration(float64(stat.latency)*(1-statsDecay) + float64(latency)*statsDecay)
+	}
+
+	s.stats[channelID] = stat
+}
+
+// RecordFailure records a failed request of the channel.
+func (s *ChannelStats) RecordFailure(channelID int) {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stat := s.get(channelID)
+	stat.health *= 1 - statsDecay
+	s.stats[channelID] = stat
+}
+
+// Health returns the success rate of the recent requests of the channel, from 0 to 1.
+func (s *ChannelStats) Health(channelID int) float64 {
+	if s == nil {
+		return 1
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.get(channelID).health
+}
+
+// Unhealthy returns true if the health of the channel is below the threshold the routing demotes the channel at.
+func (s *ChannelStats) Unhealthy(channelID int) bool {
+	return s.Health(channelID) < unhealthyThreshold
+}
+
+// Latency returns the average latency of the recent requests of the channel, zero if unknown.
+func (s *ChannelStats) Latency(channelID int) time.Duration {
+	if s == nil {
+		return 0
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.get(channelID).latency
+}
+
+func (s *ChannelStats) get(channelID int) channelStat {
+	stat, ok := s.stats[channelID]
+	if !ok {
+		return channelStat{health: 1}
+	}
+
+	return stat
+}
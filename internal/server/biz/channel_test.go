@@ -0,0 +1,208 @@
+package biz
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/channel"
+	"github.com/looplj/axonhub/internal/ent/privacy"
+	"github.com/looplj/axonhub/internal/llm/pipeline"
+	"github.com/looplj/axonhub/internal/objects"
+	"github.com/looplj/axonhub/internal/pkg/httpclient"
+	"github.com/looplj/axonhub/internal/server/db"
+)
+
+func TestChannelService_DeleteAndRestoreChannel(t *testing.T) {
+	client := db.NewEntClient(db.Config{
+		Dialect: "sqlite3",
+		DSN:     "file:ent?mode=memory&cache=shared&_fk=1",
+	})
+	defer client.Close()
+
+	ctx := ent.NewContext(t.Context(), client)
+	ctx = privacy.DecisionContext(ctx, privacy.Allow)
+
+	svc := &ChannelService{Ent: client}
+
+	createChannel := func() (*ent.Channel, error) {
+		return client.Channel.Create().
+			SetType(channel.TypeOpenai).
+			SetName("openai").
+			SetSupportedModels([]string{"gpt-4o"}).
+			SetDefaultTestModel("gpt-4o").
+			Save(ctx)
+	}
+
+	deleted, err := createChannel()
+	require.NoError(t, err)
+
+	require.NoError(t, svc.DeleteChannel(ctx, deleted.ID))
+
+	_, err = client.Channel.Get(ctx, deleted.ID)
+	require.True(t, ent.IsNotFound(err))
+
+	// The name of the deleted channel can be reused.
+	active, err := createChannel()
+	require.NoError(t, err)
+
+	_, err = svc.RestoreChannel(ctx, deleted.ID)
+	require.ErrorIs(t, err, ErrNameConflict)
+
+	require.NoError(t, svc.DeleteChannel(ctx, active.ID))
+
+	restored, err := svc.RestoreChannel(ctx, deleted.ID)
+	require.NoError(t, err)
+	require.Equal(t, deleted.ID, restored.ID)
+	require.Zero(t, restored.DeletedAt)
+
+	// Only the deleted channels can be restored.
+	_, err = svc.RestoreChannel(ctx, restored.ID)
+	require.True(t, ent.IsNotFound(err))
+}
+
+func TestChannelDecorators(t *testing.T) {
+	require.Empty(t, channelDecorators(nil))
+	require.Empty(t, channelDecorators(&objects.ChannelSettings{}))
+	require.Empty(t, channelDecorators(&objects.ChannelSettings{
+		ImageFetch: &objects.ImageFetchSettings{Enabled: false},
+	}))
+
+	decorators := channelDecorators(&objects.ChannelSettings{
+		ImageFetch: &objects.ImageFetchSettings{Enabled: true, MaxDimension: 1024},
+	})
+	require.Len(t, decorators, 1)
+	require.Equal(t, "image-fetch", decorators[0].Name())
+
+	require.Empty(t, channelDecorators(&objects.ChannelSettings{
+		Overrides: &objects.RequestOverrides{DefaultParams: &objects.RequestParams{}},
+	}))
+
+	decorators = channelDecorators(&objects.ChannelSettings{
+		ImageFetch: &objects.ImageFetchSettings{Enabled: true},
+		Overrides:  &objects.RequestOverrides{MaxTokens: 4096},
+	})
+	require.Len(t, decorators, 2)
+	require.Equal(t, "request-override", decorators[1].Name())
+}
+
+func TestValidateRequestOverrides(t *testing.T) {
+	require.NoError(t, ValidateRequestOverrides(nil))
+	require.NoError(t, ValidateRequestOverrides(&objects.RequestOverrides{MaxTokens: 1024, StripParams: []string{"logit_bias", "seed"}}))
+	require.Error(t, ValidateRequestOverrides(&objects.RequestOverrides{MaxTokens: -1}))
+	require.Error(t, ValidateRequestOverrides(&objects.RequestOverrides{StripParams: []string{"messages"}}))
+
+	require.Error(t, ValidateAPIKeyProfiles(&objects.APIKeyProfiles{
+		Profiles: []objects.APIKeyProfile{{Name: "default", Overrides: &objects.RequestOverrides{StripParams: []string{"model"}}}},
+	}))
+}
+
+func TestChannel_PatchRequest(t *testing.T) {
+	ch := &Channel{Channel: &ent.Channel{Settings: &objects.ChannelSettings{
+		ExtraHeaders: []objects.ExtraHeader{
+			{Key: "HTTP-Referer", Value: "https://example.com"},
+			{Key: "X-Title", Value: "AxonHub", Models: []string{"qwen3-32b"}},
+		},
+		BodyPatches: []objects.BodyPatch{
+			{Path: "enable_thinking", Value: "false", Models: []string{"qwen3-32b"}},
+			{Path: "thinking", Value: `{"type":"disabled"}`},
+			{Path: "user", Delete: true},
+		},
+	}}}
+
+	request := &httpclient.Request{Body: []byte(`{"model":"qwen3-32b","user":"u-1"}`)}
+	require.NoError(t, ch.PatchRequest(request, "qwen3-32b"))
+	require.Equal(t, "https://example.com", request.Headers.Get("HTTP-Referer"))
+	require.Equal(t, "AxonHub", request.Headers.Get("X-Title"))
+	require.JSONEq(t, `{"model":"qwen3-32b","enable_thinking":false,"thinking":{"type":"disabled"}}`, string(request.Body))
+
+	request = &httpclient.Request{Body: []byte(`{"model":"qwen-max"}`)}
+	require.NoError(t, ch.PatchRequest(request, "qwen-max"))
+	require.Empty(t, request.Headers.Get("X-Title"))
+	require.JSONEq(t, `{"model":"qwen-max","thinking":{"type":"disabled"}}`, string(request.Body))
+
+	request = &httpclient.Request{Method: "GET"}
+	require.NoError(t, ch.PatchRequest(request, "qwen-max"))
+	require.Empty(t, request.Body)
+}
+
+func TestValidateChannelSettings(t *testing.T) {
+	require.NoError(t, ValidateChannelSettings(nil))
+	require.NoError(t, ValidateChannelSettings(&objects.ChannelSettings{
+		ExtraHeaders: []objects.ExtraHeader{{Key: "X-Title", Value: "AxonHub"}},
+		BodyPatches:  []objects.BodyPatch{{Path: "enable_thinking", Value: "true"}, {Path: "user", Delete: true}},
+	}))
+	require.Error(t, ValidateChannelSettings(&objects.ChannelSettings{ExtraHeaders: []objects.ExtraHeader{{Value: "AxonHub"}}}))
+	require.Error(t, ValidateChannelSettings(&objects.ChannelSettings{BodyPatches: []objects.BodyPatch{{Path: "thinking", Value: "{type: disabled"}}}))
+	require.Error(t, ValidateChannelSettings(&objects.ChannelSettings{Overrides: &objects.RequestOverrides{MaxTokens: -1}}))
+}
+
+func TestChannelTransport(t *testing.T) {
+	transport, err := channelTransport(&objects.ChannelSettings{})
+	require.NoError(t, err)
+	require.Nil(t, transport)
+
+	transport, err = channelTransport(&objects.ChannelSettings{
+		Transport: &objects.TransportSettings{ProxyURL: "socks5://127.0.0.1:1080", ConnectTimeoutSeconds: 5},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, transport)
+
+	_, err = channelTransport(&objects.ChannelSettings{
+		Transport: &objects.TransportSettings{ProxyURL: "ftp://127.0.0.1:21"},
+	})
+	require.Error(t, err)
+
+	require.Error(t, ValidateChannelSettings(&objects.ChannelSettings{
+		Transport: &objects.TransportSettings{CACert: "not a certificate"},
+	}))
+}
+
+func TestChannelService_BuildChannel_BedrockTransport(t *testing.T) {
+	// The proxy records the tunnels requested by the channel and refuses them.
+	tunnels := make(chan string, 1)
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodConnect {
+			tunnels <- r.Host
+		}
+
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer proxy.Close()
+
+	svc := &ChannelService{}
+
+	ch, err := svc.buildChannel(&ent.Channel{
+		ID:   1,
+		Type: channel.TypeAnthropicAWS,
+		Credentials: &objects.ChannelCredentials{
+			AWS: &objects.AWSCredential{Region: "us-east-1", AccessKeyID: "access-key", SecretAccessKey: "secret-key"},
+		},
+		Settings: &objects.ChannelSettings{
+			Transport: &objects.TransportSettings{ProxyURL: proxy.URL},
+		},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, ch.Executor)
+
+	customized, ok := ch.Outbound.(pipeline.ChannelCustomizedExecutor)
+	require.True(t, ok)
+
+	_, err = customized.CustomizeExecutor(ch.Executor).Do(t.Context(), &httpclient.Request{
+		Method:  http.MethodPost,
+		URL:     "/v1/messages",
+		Headers: http.Header{"Content-Type": []string{"application/json"}},
+		Body:    []byte(`{"model":"anthropic.claude-3-5-sonnet-20241022-v2:0","max_tokens":16,"messages":[]}`),
+	})
+	require.Error(t, err)
+
+	select {
+	case host := <-tunnels:
+		require.Equal(t, "bedrock-runtime.us-east-1.amazonaws.com:443", host)
+	default:
+		require.Fail(t, "the request did not dial through the proxy")
+	}
+}
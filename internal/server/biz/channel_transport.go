@@ -0,0 +1,51 @@
+package biz
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/looplj/axonhub/internal/objects"
+	"github.com/looplj/axonhub/internal/pkg/httpclient"
+)
+
+// channelTransport returns the HTTP transport of the transport settings, nil if the shared client is used.
+// The transport is shared by the HTTP client of the channel and the Bedrock and Vertex executors.
+func channelTransport(settings *objects.ChannelSettings) (http.RoundTripper, error) {
+	if settings == nil || settings.Transport == nil {
+		return nil, nil
+	}
+
+	transport, err := httpclient.NewTransport(transportConfig(settings.Transport))
+	if err != nil {
+		return nil, fmt.Errorf("invalid transport settings: %w", err)
+	}
+
+	return transport, nil
+}
+
+func transportConfig(settings *objects.TransportSettings) httpclient.TransportConfig {
+	return httpclient.TransportConfig{
+		ProxyURL:              settings.ProxyURL,
+		CACert:                settings.CACert,
+		InsecureSkipVerify:    settings.InsecureSkipVerify,
+		MaxIdleConns:          settings.MaxIdleConns,
+		MaxIdleConnsPerHost:   settings.MaxIdleConnsPerHost,
+		IdleConnTimeout:       time.Duration(settings.IdleConnTimeoutSeconds) * time.Second,
+		ConnectTimeout:        time.Duration(settings.ConnectTimeoutSeconds) * time.Second,
+		ResponseHeaderTimeout: time.Duration(settings.ResponseHeaderTimeoutSeconds) * time.Second,
+	}
+}
+
+// validateTransportSettings checks the proxy URL and the CA certificate of the settings.
+func validateTransportSettings(settings *objects.TransportSettings) error {
+	if settings == nil {
+		return nil
+	}
+
+	if _, err := httpclient.NewTransport(transportConfig(settings)); err != nil {
+		return fmt.Errorf("invalid transport settings: %w", err)
+	}
+
+	return nil
+}
@@ -7,7 +7,13 @@ import (
 var (
 	ErrInvalidJWT      = errors.New("invalid jwt token")
 	ErrInvalidAPIKey   = errors.New("invalid api key")
+	ErrAPIKeyExpired   = errors.New("api key expired")
+	ErrIPNotAllowed    = errors.New("client ip not allowed")
 	ErrInvalidPassword = errors.New("invalid password")
 	ErrInvalidModel    = errors.New("invalid model")
+	ErrNameConflict    = errors.New("name is already in use")
+	ErrDeleteSelf      = errors.New("can not delete the current user")
+	ErrDeleteOwner     = errors.New("can not delete the owner user")
+	ErrOwnerDeleted    = errors.New("the owner user is deleted")
 	ErrInternal        = errors.New("server internal error, please try again later")
 )
@@ -0,0 +1,107 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/system"
+	"github.com/looplj/axonhub/internal/log"
+	"github.com/looplj/axonhub/internal/objects"
+)
+
+// ModelFallbacks retrieves the global model fallback chains, empty by default.
+func (s *SystemService) ModelFallbacks(ctx context.Context) ([]objects.ModelFallback, error) {
+	client := ent.FromContext(ctx)
+
+	sys, err := client.System.Query().Where(system.KeyEQ(SystemKeyModelFallbacks)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return []objects.ModelFallback{}, nil
+		}
+
+		return nil, fmt.Errorf("failed to get model fallbacks: %w", err)
+	}
+
+	var fallbacks []objects.ModelFallback
+	if err := json.Unmarshal([]byte(sys.Value), &fallbacks); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal model fallbacks: %w", err)
+	}
+
+	return fallbacks, nil
+}
+
+// SetModelFallbacks validates and sets the global model fallback chains.
+func (s *SystemService) SetModelFallbacks(ctx context.Context, fallbacks []objects.ModelFallback) error {
+	if err := ValidateModelFallbacks(fallbacks); err != nil {
+		return err
+	}
+
+	jsonBytes, err := json.Marshal(fallbacks)
+	if err != nil {
+		return fmt.Errorf("failed to marshal model fallbacks: %w", err)
+	}
+
+	return s.setSystemValue(ctx, SystemKeyModelFallbacks, string(jsonBytes))
+}
+
+// ValidateModelFallbacks checks each model has at most one chain, and the chains do not contain the model itself.
+func ValidateModelFallbacks(fallbacks []objects.ModelFallback) error {
+	models := make(map[string]bool, len(fallbacks))
+
+	for _, fallback := range fallbacks {
+		if fallback.Model == "" {
+			return errors.New("invalid model fallbacks: model is required")
+		}
+
+		if models[fallback.Model] {
+			return fmt.Errorf("invalid model fallbacks: duplicate chain of model %s", fallback.Model)
+		}
+
+		models[fallback.Model] = true
+
+		if len(fallback.Fallbacks) == 0 {
+			return fmt.Errorf("invalid model fallbacks: no fallback model of %s", fallback.Model)
+		}
+
+		if slices.Contains(fallback.Fallbacks, fallback.Model) || slices.Contains(fallback.Fallbacks, "") {
+			return fmt.Errorf("invalid model fallbacks: invalid fallback model of %s", fallback.Model)
+		}
+	}
+
+	return nil
+}
+
+// FallbackModels returns the fallback models of the model from the first chains containing it,
+// e.g. the chains of the API key profile before the global ones. The chains are not followed transitively.
+func FallbackModels(model string, fallbacks ...[]objects.ModelFallback) []string {
+	for _, fallbacks := range fallbacks {
+		for _, fallback := range fallbacks {
+			if fallback.Model == model {
+				return fallback.Fallbacks
+			}
+		}
+	}
+
+	return nil
+}
+
+// AppendRequestFallbackHops records the fallback hops of the request.
+func (s *RequestService) AppendRequestFallbackHops(ctx context.Context, requestID int, hops ...objects.FallbackHop) error {
+	return s.Writer.Submit(ctx, requestID, "request_fallback_hops", func(ctx context.Context) error {
+		client := ent.FromContext(ctx)
+
+		_, err := client.Request.UpdateOneID(requestID).
+			AppendFallbackHops(hops).
+			Save(ctx)
+		if err != nil {
+			log.Error(ctx, "Failed to record fallback hops", log.Cause(err))
+			return err
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,76 @@
+package biz
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/privacy"
+	"github.com/looplj/axonhub/internal/objects"
+	"github.com/looplj/axonhub/internal/server/db"
+)
+
+func TestSystemService_ModelFallbacks(t *testing.T) {
+	client := db.NewEntClient(db.Config{
+		Dialect: "sqlite3",
+		DSN:     "file:ent?mode=memory&_fk=1",
+	})
+	defer client.Close()
+
+	service := NewSystemService(SystemServiceParams{})
+	ctx := ent.NewContext(t.Context(), client)
+	ctx = privacy.DecisionContext(ctx, privacy.Allow)
+
+	fallbacks, err := service.ModelFallbacks(ctx)
+	require.NoError(t, err)
+	require.Empty(t, fallbacks)
+
+	require.Error(t, service.SetModelFallbacks(ctx, []objects.ModelFallback{{Model: "gpt-4o", Fallbacks: []string{"gpt-4o"}}}))
+
+	expected := []objects.ModelFallback{{Model: "gpt-4o", Fallbacks: []string{"claude-sonnet-4", "gpt-4o-mini"}}}
+	require.NoError(t, service.SetModelFallbacks(ctx, expected))
+
+	fallbacks, err = service.ModelFallbacks(ctx)
+	require.NoError(t, err)
+	require.Equal(t, expected, fallbacks)
+}
+
+func TestValidateModelFallbacks(t *testing.T) {
+	tests := []struct {
+		name      string
+		fallbacks []objects.ModelFallback
+		wantErr   bool
+	}{
+		{name: "empty"},
+		{name: "valid", fallbacks: []objects.ModelFallback{{Model: "a", Fallbacks: []string{"b", "c"}}, {Model: "b", Fallbacks: []string{"c"}}}},
+		{name: "missing model", fallbacks: []objects.ModelFallback{{Fallbacks: []string{"b"}}}, wantErr: true},
+		{name: "no fallbacks", fallbacks: []objects.ModelFallback{{Model: "a"}}, wantErr: true},
+		{name: "self fallback", fallbacks: []objects.ModelFallback{{Model: "a", Fallbacks: []string{"b", "a"}}}, wantErr: true},
+		{name: "empty fallback", fallbacks: []objects.ModelFallback{{Model: "a", Fallbacks: []string{""}}}, wantErr: true},
+		{name: "duplicate chain", fallbacks: []objects.ModelFallback{{Model: "a", Fallbacks: []string{"b"}}, {Model: "a", Fallbacks: []string{"c"}}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateModelFallbacks(tt.fallbacks)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestFallbackModels(t *testing.T) {
+	profile := []objects.ModelFallback{{Model: "a", Fallbacks: []string{"b"}}}
+	global := []objects.ModelFallback{
+		{Model: "a", Fallbacks: []string{"c", "d"}},
+		{Model: "b", Fallbacks: []string{"c"}},
+	}
+
+	require.Equal(t, []string{"b"}, FallbackModels("a", profile, global))
+	require.Equal(t, []string{"c"}, FallbackModels("b", profile, global))
+	require.Empty(t, FallbackModels("c", profile, global))
+}
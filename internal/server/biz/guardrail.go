@@ -0,0 +1,93 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/samber/lo"
+
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/system"
+	"github.com/looplj/axonhub/internal/llm/decorator/guardrail"
+	"github.com/looplj/axonhub/internal/log"
+	"github.com/looplj/axonhub/internal/objects"
+)
+
+// GuardrailPolicy retrieves the global guardrail policy, it is disabled by default.
+func (s *SystemService) GuardrailPolicy(ctx context.Context) (*objects.GuardrailPolicy, error) {
+	client := ent.FromContext(ctx)
+
+	sys, err := client.System.Query().Where(system.KeyEQ(SystemKeyGuardrailPolicy)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &objects.GuardrailPolicy{Rules: []objects.GuardrailRule{}}, nil
+		}
+
+		return nil, fmt.Errorf("failed to get guardrail policy: %w", err)
+	}
+
+	var policy objects.GuardrailPolicy
+	if err := json.Unmarshal([]byte(sys.Value), &policy); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal guardrail policy: %w", err)
+	}
+
+	return &policy, nil
+}
+
+// SetGuardrailPolicy validates and sets the global guardrail policy.
+func (s *SystemService) SetGuardrailPolicy(ctx context.Context, policy *objects.GuardrailPolicy) error {
+	if err := ValidateGuardrailPolicy(policy); err != nil {
+		return err
+	}
+
+	jsonBytes, err := json.Marshal(policy)
+	if err != nil {
+		return fmt.Errorf("failed to marshal guardrail policy: %w", err)
+	}
+
+	return s.setSystemValue(ctx, SystemKeyGuardrailPolicy, string(jsonBytes))
+}
+
+// ValidateGuardrailPolicy checks the types, the actions, the targets and the patterns of the rules.
+func ValidateGuardrailPolicy(policy *objects.GuardrailPolicy) error {
+	if _, err := guardrail.New(guardrail.Config{Rules: GuardrailRules(policy)}); err != nil {
+		return fmt.Errorf("invalid guardrail policy: %w", err)
+	}
+
+	return nil
+}
+
+// GuardrailRules returns the rules of the policy, nil if the policy is not enabled.
+func GuardrailRules(policy *objects.GuardrailPolicy) []guardrail.Rule {
+	if policy == nil || !policy.Enabled {
+		return nil
+	}
+
+	return lo.Map(policy.Rules, func(rule objects.GuardrailRule, _ int) guardrail.Rule {
+		return guardrail.Rule{
+			Name:     rule.Name,
+			Type:     guardrail.RuleType(rule.Type),
+			Patterns: rule.Patterns,
+			Action:   guardrail.Action(rule.Action),
+			Target:   guardrail.Target(rule.Target),
+		}
+	})
+}
+
+// AppendRequestGuardrailViolations records the guardrail violations of the request.
+func (s *RequestService) AppendRequestGuardrailViolations(ctx context.Context, requestID int, violations ...objects.GuardrailViolation) error {
+	return s.Writer.Submit(ctx, requestID, "request_guardrail_violations", func(ctx context.Context) error {
+		client := ent.FromContext(ctx)
+
+		_, err := client.Request.UpdateOneID(requestID).
+			AppendGuardrailViolations(violations).
+			Save(ctx)
+		if err != nil {
+			log.Error(ctx, "Failed to record guardrail violations", log.Cause(err))
+			return err
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,66 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/system"
+	"github.com/looplj/axonhub/internal/objects"
+)
+
+// HedgingPolicy retrieves the global hedging policy, it is disabled by default.
+func (s *SystemService) HedgingPolicy(ctx context.Context) (*objects.HedgingPolicy, error) {
+	client := ent.FromContext(ctx)
+
+	sys, err := client.System.Query().Where(system.KeyEQ(SystemKeyHedgingPolicy)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &objects.HedgingPolicy{}, nil
+		}
+
+		return nil, fmt.Errorf("failed to get hedging policy: %w", err)
+	}
+
+	var policy objects.HedgingPolicy
+	if err := json.Unmarshal([]byte(sys.Value), &policy); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal hedging policy: %w", err)
+	}
+
+	return &policy, nil
+}
+
+// SetHedgingPolicy validates and sets the global hedging policy.
+func (s *SystemService) SetHedgingPolicy(ctx context.Context, policy *objects.HedgingPolicy) error {
+	if err := ValidateHedgingPolicy(policy); err != nil {
+		return err
+	}
+
+	jsonBytes, err := json.Marshal(policy)
+	if err != nil {
+		return fmt.Errorf("failed to marshal hedging policy: %w", err)
+	}
+
+	return s.setSystemValue(ctx, SystemKeyHedgingPolicy, string(jsonBytes))
+}
+
+// ValidateHedgingPolicy checks the delay of the enabled policy is positive.
+func ValidateHedgingPolicy(policy *objects.HedgingPolicy) error {
+	if policy.DelayMs < 0 || (policy.Enabled && policy.DelayMs == 0) {
+		return errors.New("invalid hedging policy: delay must be positive")
+	}
+
+	return nil
+}
+
+// HedgeDelay returns the delay to wait for the first token before hedging, zero if hedging is disabled.
+func HedgeDelay(policy *objects.HedgingPolicy) time.Duration {
+	if policy == nil || !policy.Enabled {
+		return 0
+	}
+
+	return time.Duration(policy.DelayMs) * time.Millisecond
+}
@@ -0,0 +1,44 @@
+package biz
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/privacy"
+	"github.com/looplj/axonhub/internal/objects"
+	"github.com/looplj/axonhub/internal/server/db"
+)
+
+func TestSystemService_HedgingPolicy(t *testing.T) {
+	client := db.NewEntClient(db.Config{
+		Dialect: "sqlite3",
+		DSN:     "file:ent?mode=memory&_fk=1",
+	})
+	defer client.Close()
+
+	service := NewSystemService(SystemServiceParams{})
+	ctx := ent.NewContext(t.Context(), client)
+	ctx = privacy.DecisionContext(ctx, privacy.Allow)
+
+	policy, err := service.HedgingPolicy(ctx)
+	require.NoError(t, err)
+	require.Equal(t, time.Duration(0), HedgeDelay(policy))
+
+	require.Error(t, service.SetHedgingPolicy(ctx, &objects.HedgingPolicy{Enabled: true}))
+	require.Error(t, service.SetHedgingPolicy(ctx, &objects.HedgingPolicy{DelayMs: -1}))
+
+	require.NoError(t, service.SetHedgingPolicy(ctx, &objects.HedgingPolicy{Enabled: true, DelayMs: 800}))
+
+	policy, err = service.HedgingPolicy(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 800*time.Millisecond, HedgeDelay(policy))
+
+	require.NoError(t, service.SetHedgingPolicy(ctx, &objects.HedgingPolicy{DelayMs: 800}))
+
+	policy, err = service.HedgingPolicy(ctx)
+	require.NoError(t, err)
+	require.Equal(t, time.Duration(0), HedgeDelay(policy))
+}
@@ -0,0 +1,92 @@
+package biz
+
+import (
+	"fmt"
+
+	"github.com/looplj/axonhub/internal/llm/decorator"
+	"github.com/looplj/axonhub/internal/llm/decorator/override"
+	"github.com/looplj/axonhub/internal/objects"
+)
+
+// ValidateRequestOverrides checks the limits and the stripped parameters of the overrides.
+func ValidateRequestOverrides(overrides *objects.RequestOverrides) error {
+	if overrides == nil {
+		return nil
+	}
+
+	if overrides.MaxTokens < 0 {
+		return fmt.Errorf("invalid request overrides: max tokens must not be negative")
+	}
+
+	if overrides.MaxTemperature != nil && *overrides.MaxTemperature < 0 {
+		return fmt.Errorf("invalid request overrides: max temperature must not be negative")
+	}
+
+	for _, name := range overrides.StripParams {
+		if !override.IsKnownParam(name) {
+			return fmt.Errorf("invalid request overrides: unknown parameter %q", name)
+		}
+	}
+
+	return nil
+}
+
+// ValidateAPIKeyProfiles checks the request overrides, the model fallbacks and the routing strategies of the profiles.
+func ValidateAPIKeyProfiles(profiles *objects.APIKeyProfiles) error {
+	if profiles == nil {
+		return nil
+	}
+
+	for _, profile := range profiles.Profiles {
+		if err := ValidateRequestOverrides(profile.Overrides); err != nil {
+			return fmt.Errorf("profile %s: %w", profile.Name, err)
+		}
+
+		if err := ValidateModelFallbacks(profile.ModelFallbacks); err != nil {
+			return fmt.Errorf("profile %s: %w", profile.Name, err)
+		}
+
+		if err := ValidateRoutingStrategy(profile.RoutingStrategy); err != nil {
+			return fmt.Errorf("profile %s: %w", profile.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// RequestOverrides returns the decorator of the overrides, nil if nothing is overridden.
+func RequestOverrides(overrides *objects.RequestOverrides) decorator.Decorator {
+	if overrides == nil {
+		return nil
+	}
+
+	config := override.Config{
+		Defaults:            requestParams(overrides.DefaultParams),
+		Forced:              requestParams(overrides.ForcedParams),
+		MaxTemperature:      overrides.MaxTemperature,
+		MaxTokens:           overrides.MaxTokens,
+		SystemPromptPrepend: overrides.SystemPromptPrepend,
+		SystemPromptAppend:  overrides.SystemPromptAppend,
+		StripParams:         overrides.StripParams,
+	}
+	if config.IsZero() {
+		return nil
+	}
+
+	return override.New(config)
+}
+
+func requestParams(params *objects.RequestParams) override.Params {
+	if params == nil {
+		return override.Params{}
+	}
+
+	return override.Params{
+		Temperature:      params.Temperature,
+		TopP:             params.TopP,
+		PresencePenalty:  params.PresencePenalty,
+		FrequencyPenalty: params.FrequencyPenalty,
+		Seed:             params.Seed,
+		ReasoningEffort:  params.ReasoningEffort,
+	}
+}
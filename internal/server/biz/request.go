@@ -15,19 +15,24 @@ import (
 	"github.com/looplj/axonhub/internal/objects"
 	"github.com/looplj/axonhub/internal/pkg/httpclient"
 	"github.com/looplj/axonhub/internal/pkg/xjson"
+	"github.com/looplj/axonhub/internal/server/persist"
 )
 
 // RequestService handles request and request execution operations.
+// The creations are applied synchronously because the callers need the IDs,
+// the updates go through the persistence writer and may be applied asynchronously.
 type RequestService struct {
 	SystemService   *SystemService
 	UsageLogService *UsageLogService
+	Writer          *persist.Writer
 }
 
 // NewRequestService creates a new RequestService.
-func NewRequestService(systemService *SystemService, usageLogService *UsageLogService) *RequestService {
+func NewRequestService(systemService *SystemService, usageLogService *UsageLogService, writer *persist.Writer) *RequestService {
 	return &RequestService{
 		SystemService:   systemService,
 		UsageLogService: usageLogService,
+		Writer:          writer,
 	}
 }
 
@@ -142,35 +147,43 @@ func (s *RequestService) UpdateRequestCompleted(
 		log.Warn(ctx, "Failed to get storage policy, defaulting to store response body", log.Cause(err))
 	}
 
-	client := ent.FromContext(ctx)
-
-	upd := client.Request.UpdateOneID(requestID).
-		SetStatus(request.StatusCompleted).
-		SetExternalID(externalId)
+	var responseBodyBytes objects.JSONRawMessage
 
 	if storeResponseBody {
-		responseBodyBytes, err := xjson.Marshal(responseBody)
+		b, err := xjson.Marshal(responseBody)
 		if err != nil {
 			log.Error(ctx, "Failed to serialize response body", log.Cause(err))
 			return err
 		}
 
-		upd = upd.SetResponseBody(responseBodyBytes)
+		responseBodyBytes = b
 	}
 
-	_, err := upd.Save(ctx)
-	if err != nil {
-		log.Error(ctx, "Failed to update request status to completed", log.Cause(err))
-		return err
-	}
+	return s.Writer.SubmitTerminal(ctx, requestID, "request_completed", func(ctx context.Context) error {
+		client := ent.FromContext(ctx)
+
+		upd := client.Request.UpdateOneID(requestID).
+			SetStatus(request.StatusCompleted).
+			SetExternalID(externalId)
+
+		if storeResponseBody {
+			upd = upd.SetResponseBody(responseBodyBytes)
+		}
+
+		_, err := upd.Save(ctx)
+		if err != nil {
+			log.Error(ctx, "Failed to update request status to completed", log.Cause(err))
+			return err
+		}
 
-	return nil
+		return nil
+	})
 }
 
 // UpdateRequestExecutionCompleted updates request execution status to completed with response body.
 func (s *RequestService) UpdateRequestExecutionCompleted(
 	ctx context.Context,
-	executionID int,
+	execution *ent.RequestExecution,
 	externalId string,
 	responseBody any,
 ) error {
@@ -182,80 +195,90 @@ func (s *RequestService) UpdateRequestExecutionCompleted(
 		log.Warn(ctx, "Failed to get storage policy, defaulting to store response body", log.Cause(err))
 	}
 
-	client := ent.FromContext(ctx)
-
-	upd := client.RequestExecution.UpdateOneID(executionID).
-		SetStatus(requestexecution.StatusCompleted).
-		SetExternalID(externalId)
+	var responseBodyBytes objects.JSONRawMessage
 
 	if storeResponseBody {
-		responseBodyBytes, err := xjson.Marshal(responseBody)
+		b, err := xjson.Marshal(responseBody)
 		if err != nil {
 			return err
 		}
 
-		upd = upd.SetResponseBody(responseBodyBytes)
+		responseBodyBytes = b
 	}
 
-	_, err := upd.Save(ctx)
-	if err != nil {
-		log.Error(ctx, "Failed to update request execution status to completed", log.Cause(err))
-		return err
-	}
+	return s.Writer.SubmitTerminal(ctx, execution.RequestID, "request_execution_completed", func(ctx context.Context) error {
+		client := ent.FromContext(ctx)
+
+		upd := client.RequestExecution.UpdateOneID(execution.ID).
+			SetStatus(requestexecution.StatusCompleted).
+			SetExternalID(externalId)
+
+		if storeResponseBody {
+			upd = upd.SetResponseBody(responseBodyBytes)
+		}
 
-	return nil
+		_, err := upd.Save(ctx)
+		if err != nil {
+			log.Error(ctx, "Failed to update request execution status to completed", log.Cause(err))
+			return err
+		}
+
+		return nil
+	})
 }
 
 // UpdateRequestExecutionCanceled updates request execution status to canceled with error message.
 func (s *RequestService) UpdateRequestExecutionCanceled(
 	ctx context.Context,
-	executionID int,
+	execution *ent.RequestExecution,
 	errorMsg string,
 ) error {
-	return s.UpdateRequestExecutionStatus(ctx, executionID, requestexecution.StatusCanceled, errorMsg)
+	return s.UpdateRequestExecutionStatus(ctx, execution, requestexecution.StatusCanceled, errorMsg)
 }
 
 // UpdateRequestExecutionFailed updates request execution status to failed with error message.
 func (s *RequestService) UpdateRequestExecutionFailed(
 	ctx context.Context,
-	executionID int,
+	execution *ent.RequestExecution,
 	errorMsg string,
 ) error {
-	return s.UpdateRequestExecutionStatus(ctx, executionID, requestexecution.StatusFailed, errorMsg)
+	return s.UpdateRequestExecutionStatus(ctx, execution, requestexecution.StatusFailed, errorMsg)
 }
 
 // UpdateRequestExecutionStatus updates request execution status to the provided value (e.g., canceled or failed), with optional error message.
 func (s *RequestService) UpdateRequestExecutionStatus(
 	ctx context.Context,
-	executionID int,
+	execution *ent.RequestExecution,
 	status requestexecution.Status,
 	errorMsg string,
 ) error {
-	client := ent.FromContext(ctx)
+	return s.Writer.SubmitTerminal(ctx, execution.RequestID, "request_execution_status", func(ctx context.Context) error {
+		client := ent.FromContext(ctx)
 
-	upd := client.RequestExecution.UpdateOneID(executionID).
-		SetStatus(status)
-	if errorMsg != "" {
-		upd = upd.SetErrorMessage(errorMsg)
-	}
+		upd := client.RequestExecution.UpdateOneID(execution.ID).
+			SetStatus(status)
+		if errorMsg != "" {
+			upd = upd.SetErrorMessage(errorMsg)
+		}
 
-	_, err := upd.Save(ctx)
-	if err != nil {
-		log.Error(ctx, "Failed to update request execution status", log.Cause(err), log.Any("status", status))
-		return err
-	}
+		_, err := upd.Save(ctx)
+		if err != nil {
+			log.Error(ctx, "Failed to update request execution status", log.Cause(err), log.Any("status", status))
+			return err
+		}
 
-	return nil
+		return nil
+	})
 }
 
 // UpdateRequestExecutionStatusFromError updates request execution status based on error type and sets error message.
-func (s *RequestService) UpdateRequestExecutionStatusFromError(ctx context.Context, executionID int, rawErr error) error {
+func (s *RequestService) UpdateRequestExecutionStatusFromError(ctx context.Context, execution *ent.RequestExecution, rawErr error) error {
 	status := requestexecution.StatusFailed
 	if errors.Is(rawErr, context.Canceled) || errors.Is(ctx.Err(), context.Canceled) {
 		status = requestexecution.StatusCanceled
 	}
 
-	return s.UpdateRequestExecutionStatus(ctx, executionID, status, rawErr.Error())
+	return s.UpdateRequestExecutionStatus(ctx, execution, status, rawErr.Error())
 }
 
 type jsonStreamEvent struct {
@@ -268,7 +291,7 @@ type jsonStreamEvent struct {
 // Only stores chunks if the system StoreChunks setting is enabled.
 func (s *RequestService) AppendRequestExecutionChunk(
 	ctx context.Context,
-	executionID int,
+	execution *ent.RequestExecution,
 	chunk *httpclient.StreamEvent,
 ) error {
 	ctx = privacy.DecisionContext(ctx, privacy.Allow)
@@ -295,17 +318,19 @@ func (s *RequestService) AppendRequestExecutionChunk(
 		return err
 	}
 
-	client := ent.FromContext(ctx)
+	return s.Writer.Submit(ctx, execution.RequestID, "request_execution_chunk", func(ctx context.Context) error {
+		client := ent.FromContext(ctx)
 
-	_, err = client.RequestExecution.UpdateOneID(executionID).
-		AppendResponseChunks([]objects.JSONRawMessage{chunkBytes}).
-		Save(ctx)
-	if err != nil {
-		log.Error(ctx, "Failed to append response chunk", log.Cause(err))
-		return err
-	}
+		_, err := client.RequestExecution.UpdateOneID(execution.ID).
+			AppendResponseChunks([]objects.JSONRawMessage{chunkBytes}).
+			Save(ctx)
+		if err != nil {
+			log.Error(ctx, "Failed to append response chunk", log.Cause(err))
+			return err
+		}
 
-	return nil
+		return nil
+	})
 }
 
 func (s *RequestService) AppendRequestChunk(
@@ -337,17 +362,19 @@ func (s *RequestService) AppendRequestChunk(
 		return err
 	}
 
-	client := ent.FromContext(ctx)
+	return s.Writer.Submit(ctx, requestID, "request_chunk", func(ctx context.Context) error {
+		client := ent.FromContext(ctx)
 
-	_, err = client.Request.UpdateOneID(requestID).
-		AppendResponseChunks([]objects.JSONRawMessage{chunkBytes}).
-		Save(ctx)
-	if err != nil {
-		log.Error(ctx, "Failed to append response chunk", log.Cause(err))
-		return err
-	}
+		_, err := client.Request.UpdateOneID(requestID).
+			AppendResponseChunks([]objects.JSONRawMessage{chunkBytes}).
+			Save(ctx)
+		if err != nil {
+			log.Error(ctx, "Failed to append response chunk", log.Cause(err))
+			return err
+		}
 
-	return nil
+		return nil
+	})
 }
 
 // UpdateRequestCanceled updates request status to canceled.
@@ -362,17 +389,19 @@ func (s *RequestService) UpdateRequestFailed(ctx context.Context, requestID int)
 
 // UpdateRequestStatus updates request status to the provided value (e.g., canceled or failed).
 func (s *RequestService) UpdateRequestStatus(ctx context.Context, requestID int, status request.Status) error {
-	client := ent.FromContext(ctx)
+	return s.Writer.SubmitTerminal(ctx, requestID, "request_status", func(ctx context.Context) error {
+		client := ent.FromContext(ctx)
 
-	_, err := client.Request.UpdateOneID(requestID).
-		SetStatus(status).
-		Save(ctx)
-	if err != nil {
-		log.Error(ctx, "Failed to update request status", log.Cause(err), log.Any("status", status))
-		return err
-	}
+		_, err := client.Request.UpdateOneID(requestID).
+			SetStatus(status).
+			Save(ctx)
+		if err != nil {
+			log.Error(ctx, "Failed to update request status", log.Cause(err), log.Any("status", status))
+			return err
+		}
 
-	return nil
+		return nil
+	})
 }
 
 // UpdateRequestStatusFromError updates request status based on error type: canceled if context canceled, otherwise failed.
@@ -384,17 +413,36 @@ func (s *RequestService) UpdateRequestStatusFromError(ctx context.Context, reque
 	return s.UpdateRequestStatus(ctx, requestID, request.StatusFailed)
 }
 
+// UpdateRequestCached marks the request as served from the response cache.
+func (s *RequestService) UpdateRequestCached(ctx context.Context, requestID int) error {
+	return s.Writer.Submit(ctx, requestID, "request_cached", func(ctx context.Context) error {
+		client := ent.FromContext(ctx)
+
+		_, err := client.Request.UpdateOneID(requestID).
+			SetCached(true).
+			Save(ctx)
+		if err != nil {
+			log.Error(ctx, "Failed to mark request as cached", log.Cause(err))
+			return err
+		}
+
+		return nil
+	})
+}
+
 // UpdateRequestChannelID updates request with channel ID after channel selection.
 func (s *RequestService) UpdateRequestChannelID(ctx context.Context, requestID int, channelID int) error {
-	client := ent.FromContext(ctx)
+	return s.Writer.Submit(ctx, requestID, "request_channel", func(ctx context.Context) error {
+		client := ent.FromContext(ctx)
 
-	_, err := client.Request.UpdateOneID(requestID).
-		SetChannelID(channelID).
-		Save(ctx)
-	if err != nil {
-		log.Error(ctx, "Failed to update request channel ID", log.Cause(err))
-		return err
-	}
+		_, err := client.Request.UpdateOneID(requestID).
+			SetChannelID(channelID).
+			Save(ctx)
+		if err != nil {
+			log.Error(ctx, "Failed to update request channel ID", log.Cause(err))
+			return err
+		}
 
-	return nil
+		return nil
+	})
 }
@@ -0,0 +1,170 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/privacy"
+	"github.com/looplj/axonhub/internal/ent/routingrule"
+	"github.com/looplj/axonhub/internal/ent/schema/schematype"
+	"github.com/looplj/axonhub/internal/llm"
+	"github.com/looplj/axonhub/internal/llm/tokenizer"
+	"github.com/looplj/axonhub/internal/objects"
+)
+
+// ValidateRoutingConditions checks the prompt token range of the conditions.
+func ValidateRoutingConditions(conditions *objects.RoutingConditions) error {
+	if conditions == nil {
+		return nil
+	}
+
+	if conditions.MinPromptTokens < 0 || conditions.MaxPromptTokens < 0 {
+		return errors.New("invalid routing conditions: negative prompt tokens")
+	}
+
+	if conditions.MaxPromptTokens > 0 && conditions.MinPromptTokens > conditions.MaxPromptTokens {
+		return errors.New("invalid routing conditions: min prompt tokens is greater than max prompt tokens")
+	}
+
+	for _, entry := range conditions.Metadata {
+		if entry.Key == "" {
+			return errors.New("invalid routing conditions: metadata key is required")
+		}
+	}
+
+	return nil
+}
+
+// LoadRoutingRules reloads the enabled routing rules, it is called after the routing rules are changed.
+func (svc *ChannelService) LoadRoutingRules(ctx context.Context) error {
+	ctx = privacy.DecisionContext(ctx, privacy.Allow)
+
+	rules, err := svc.Ent.RoutingRule.Query().
+		Where(routingrule.StatusEQ(routingrule.StatusEnabled)).
+		WithChannels().
+		Order(ent.Desc(routingrule.FieldPriority), ent.Asc(routingrule.FieldID)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load routing rules: %w", err)
+	}
+
+	svc.RoutingRules = rules
+
+	return nil
+}
+
+// DeleteRoutingRule soft deletes the routing rule, the deleted rule is not applied anymore.
+func (svc *ChannelService) DeleteRoutingRule(ctx context.Context, id int) error {
+	if err := svc.Ent.RoutingRule.DeleteOneID(id).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to delete routing rule: %w", err)
+	}
+
+	return svc.LoadRoutingRules(ctx)
+}
+
+// RestoreRoutingRule restores the soft deleted routing rule with its channels.
+func (svc *ChannelService) RestoreRoutingRule(ctx context.Context, id int) (*ent.RoutingRule, error) {
+	deleted, err := svc.Ent.RoutingRule.Query().
+		Where(routingrule.ID(id), routingrule.DeletedAtGT(0)).
+		Only(schematype.SkipSoftDelete(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deleted routing rule: %w", err)
+	}
+
+	restored, err := svc.Ent.RoutingRule.UpdateOne(deleted).
+		SetDeletedAt(0).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore routing rule: %w", err)
+	}
+
+	if err := svc.LoadRoutingRules(ctx); err != nil {
+		return nil, err
+	}
+
+	return restored, nil
+}
+
+// EstimatePromptTokens estimates the prompt tokens of the request with the tokenizer of the requested model.
+func (svc *ChannelService) EstimatePromptTokens(req *llm.Request) int {
+	return tokenizer.CountRequest(svc.Tokenizers.ForModel(req.Model), req)
+}
+
+// ApplyRoutingRules applies the first routing rule matching the request to the candidate channels.
+// The rule is skipped if none of its channels is a candidate, so the requests are never rejected by the rules.
+func (svc *ChannelService) ApplyRoutingRules(req *llm.Request, candidates []*Channel) ([]*Channel, *ent.RoutingRule) {
+	// The prompt tokens are only estimated once and only if a rule needs them.
+	promptTokens := -1
+	estimate := func() int {
+		if promptTokens < 0 {
+			promptTokens = svc.EstimatePromptTokens(req)
+		}
+
+		return promptTokens
+	}
+
+	for _, rule := range svc.RoutingRules {
+		if !matchRoutingConditions(rule.Conditions, req, estimate) {
+			continue
+		}
+
+		var matched, others []*Channel
+
+		for _, channel := range candidates {
+			if slices.ContainsFunc(rule.Edges.Channels, func(c *ent.Channel) bool { return c.ID == channel.ID }) {
+				matched = append(matched, channel)
+			} else {
+				others = append(others, channel)
+			}
+		}
+
+		if len(matched) == 0 {
+			continue
+		}
+
+		if rule.Action == routingrule.ActionPrefer {
+			return append(matched, others...), rule
+		}
+
+		return matched, rule
+	}
+
+	return candidates, nil
+}
+
+func matchRoutingConditions(conditions *objects.RoutingConditions, req *llm.Request, promptTokens func() int) bool {
+	if conditions == nil {
+		return true
+	}
+
+	if len(conditions.Models) > 0 && !slices.Contains(conditions.Models, req.Model) {
+		return false
+	}
+
+	if conditions.Images != nil && *conditions.Images != hasImages(req) {
+		return false
+	}
+
+	if conditions.Tools != nil && *conditions.Tools != (len(req.Tools) > 0) {
+		return false
+	}
+
+	for _, entry := range conditions.Metadata {
+		if value, ok := req.Metadata[entry.Key]; !ok || value != entry.Value {
+			return false
+		}
+	}
+
+	if conditions.MinPromptTokens > 0 && promptTokens() < conditions.MinPromptTokens {
+		return false
+	}
+
+	if conditions.MaxPromptTokens > 0 && promptTokens() > conditions.MaxPromptTokens {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,156 @@
+package biz
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/looplj/axonhub/internal/llm"
+	"github.com/looplj/axonhub/internal/objects"
+)
+
+// ValidateRoutingStrategy checks the routing strategy is empty, cheapest or fastest.
+func ValidateRoutingStrategy(strategy string) error {
+	switch strategy {
+	case "", objects.RoutingStrategyCheapest, objects.RoutingStrategyFastest:
+		return nil
+	default:
+		return fmt.Errorf("invalid routing strategy: %s", strategy)
+	}
+}
+
+// validateModelPrices checks the models of the prices are unique and the prices are not negative.
+func validateModelPrices(prices []objects.ModelPrice) error {
+	models := make(map[string]bool, len(prices))
+
+	for _, price := range prices {
+		if price.Model == "" {
+			return errors.New("invalid model price: model is required")
+		}
+
+		if models[price.Model] {
+			return fmt.Errorf("invalid model price: duplicate price of model %s", price.Model)
+		}
+
+		models[price.Model] = true
+
+		if price.InputPrice < 0 || price.OutputPrice < 0 {
+			return fmt.Errorf("invalid model price of %s: price must not be negative", price.Model)
+		}
+	}
+
+	return nil
+}
+
+// defaultCompletionRatio is the completion tokens relative to the prompt tokens assumed for the requests without the max tokens.
+const defaultCompletionRatio = 0.25
+
+// EffectivePrice returns the price per million tokens of the requested model in the channel, the input and the output
+// prices weighted by the prompt and the completion tokens, false if the price is not configured.
+// The price is looked up by the model the channel maps the requested model to.
+func (c *Channel) EffectivePrice(model string, promptTokens, completionTokens int) (float64, bool) {
+	if c.Settings == nil {
+		return 0, false
+	}
+
+	model, err := c.ChooseModel(model)
+	if err != nil {
+		return 0, false
+	}
+
+	for _, price := range c.Settings.ModelPrices {
+		if price.Model != model {
+			continue
+		}
+
+		total := promptTokens + completionTokens
+		if total <= 0 {
+			return price.InputPrice, true
+		}
+
+		return (price.InputPrice*float64(promptTokens) + price.OutputPrice*float64(completionTokens)) / float64(total), true
+	}
+
+	return 0, false
+}
+
+// requestTokens returns the estimated prompt tokens and the max completion tokens of the request,
+// the completion tokens are the default ratio of the prompt tokens if the request does not set the max tokens.
+func (svc *ChannelService) requestTokens(req *llm.Request) (int, int) {
+	promptTokens := svc.EstimatePromptTokens(req)
+
+	switch {
+	case req.MaxCompletionTokens != nil && *req.MaxCompletionTokens > 0:
+		return promptTokens, int(*req.MaxCompletionTokens)
+	case req.MaxTokens != nil && *req.MaxTokens > 0:
+		return promptTokens, int(*req.MaxTokens)
+	default:
+		return promptTokens, int(math.Ceil(float64(promptTokens) * defaultCompletionRatio))
+	}
+}
+
+// unhealthyThreshold is the health below which the routing strategies demote the channel,
+// a healthy channel gets there after about 4 failures in a row.
+const unhealthyThreshold = 0.5
+
+// OrderChannels orders the channels of the requested model by the routing strategy, the channels keep their order if the strategy is empty.
+// The price of the channels is weighted by the tokens of the request.
+// The unhealthy channels are ordered after the healthy ones whatever their price or latency.
+// The channels without the price are ordered after the others, and the channels with unknown latency are ordered first,
+// so their latency gets measured. The order of the equal channels is kept.
+func (svc *ChannelService) OrderChannels(req *llm.Request, channels []*Channel, strategy string) []*Channel {
+	if strategy == "" || len(channels) < 2 {
+		return channels
+	}
+
+	// The tokens are only estimated once and only if a price is needed.
+	promptTokens, completionTokens := -1, 0
+
+	price := func(c *Channel) float64 {
+		if promptTokens < 0 {
+			promptTokens, completionTokens = svc.requestTokens(req)
+		}
+
+		if price, ok := c.EffectivePrice(req.Model, promptTokens, completionTokens); ok {
+			return price
+		}
+
+		return math.Inf(1)
+	}
+
+	unhealthy := func(c *Channel) bool {
+		return svc.Stats.Unhealthy(c.ID)
+	}
+
+	// The health is compared in steps of 10%, so the channels recovering from a few failures tie.
+	health := func(c *Channel) float64 {
+		return -math.Round(svc.Stats.Health(c.ID) * 10)
+	}
+
+	latency := func(c *Channel) time.Duration {
+		return svc.Stats.Latency(c.ID)
+	}
+
+	ordered := slices.Clone(channels)
+
+	slices.SortStableFunc(ordered, func(a, b *Channel) int {
+		if unhealthy(a) != unhealthy(b) {
+			if unhealthy(a) {
+				return 1
+			}
+
+			return -1
+		}
+
+		if strategy == objects.RoutingStrategyFastest {
+			return cmp.Or(cmp.Compare(latency(a), latency(b)), cmp.Compare(health(a), health(b)), cmp.Compare(price(a), price(b)))
+		}
+
+		return cmp.Or(cmp.Compare(price(a), price(b)), cmp.Compare(health(a), health(b)), cmp.Compare(latency(a), latency(b)))
+	})
+
+	return ordered
+}
@@ -0,0 +1,144 @@
+package biz
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/llm"
+	"github.com/looplj/axonhub/internal/objects"
+)
+
+func TestValidateRoutingStrategy(t *testing.T) {
+	require.NoError(t, ValidateRoutingStrategy(""))
+	require.NoError(t, ValidateRoutingStrategy(objects.RoutingStrategyCheapest))
+	require.NoError(t, ValidateRoutingStrategy(objects.RoutingStrategyFastest))
+	require.Error(t, ValidateRoutingStrategy("random"))
+}
+
+func TestValidateChannelSettings_ModelPrices(t *testing.T) {
+	require.NoError(t, ValidateChannelSettings(&objects.ChannelSettings{ModelPrices: []objects.ModelPrice{{Model: "gpt-4o", InputPrice: 2.5, OutputPrice: 10}}}))
+	require.Error(t, ValidateChannelSettings(&objects.ChannelSettings{ModelPrices: []objects.ModelPrice{{InputPrice: 1}}}))
+	require.Error(t, ValidateChannelSettings(&objects.ChannelSettings{ModelPrices: []objects.ModelPrice{{Model: "gpt-4o", InputPrice: -1}}}))
+	require.Error(t, ValidateChannelSettings(&objects.ChannelSettings{ModelPrices: []objects.ModelPrice{{Model: "gpt-4o"}, {Model: "gpt-4o"}}}))
+}
+
+func TestChannelStats(t *testing.T) {
+	stats := NewChannelStats()
+	require.InDelta(t, 1.0, stats.Health(1), 0.001)
+	require.Zero(t, stats.Latency(1))
+
+	stats.RecordSuccess(1, 100*time.Millisecond)
+	require.Equal(t, 100*time.Millisecond, stats.Latency(1))
+
+	stats.RecordSuccess(1, 200*time.Millisecond)
+	require.Equal(t, 120*time.Millisecond, stats.Latency(1))
+
+	stats.RecordFailure(1)
+	require.InDelta(t, 0.8, stats.Health(1), 0.001)
+
+	var disabled *ChannelStats
+	disabled.RecordFailure(1)
+	require.InDelta(t, 1.0, disabled.Health(1), 0.001)
+}
+
+func TestChannelService_OrderChannels(t *testing.T) {
+	newChannel := func(id int, prices ...objects.ModelPrice) *Channel {
+		return &Channel{Channel: &ent.Channel{
+			ID:              id,
+			SupportedModels: []string{"gpt-4o", "gpt-4o-mini"},
+			Settings:        &objects.ChannelSettings{ModelPrices: prices},
+		}}
+	}
+
+	direct := newChannel(1, objects.ModelPrice{Model: "gpt-4o", InputPrice: 2.5, OutputPrice: 10})
+	openrouter := newChannel(2, objects.ModelPrice{Model: "gpt-4o", InputPrice: 2, OutputPrice: 8})
+	bedrock := newChannel(3, objects.ModelPrice{Model: "gpt-4o", InputPrice: 2, OutputPrice: 8})
+	unpriced := newChannel(4)
+
+	svc := &ChannelService{Stats: NewChannelStats()}
+	channels := []*Channel{unpriced, direct, openrouter, bedrock}
+	gpt4o := &llm.Request{Model: "gpt-4o"}
+
+	ids := func(channels []*Channel) []int {
+		return lo.Map(channels, func(c *Channel, _ int) int { return c.ID })
+	}
+
+	require.Equal(t, []int{4, 1, 2, 3}, ids(svc.OrderChannels(gpt4o, channels, "")))
+
+	// The equal prices keep their order without the stats.
+	require.Equal(t, []int{2, 3, 1, 4}, ids(svc.OrderChannels(gpt4o, channels, objects.RoutingStrategyCheapest)))
+
+	// The ties are broken by the health, then the latency.
+	svc.Stats.RecordSuccess(2, 300*time.Millisecond)
+	svc.Stats.RecordSuccess(3, 100*time.Millisecond)
+	require.Equal(t, []int{3, 2, 1, 4}, ids(svc.OrderChannels(gpt4o, channels, objects.RoutingStrategyCheapest)))
+
+	svc.Stats.RecordFailure(3)
+	require.Equal(t, []int{2, 3, 1, 4}, ids(svc.OrderChannels(gpt4o, channels, objects.RoutingStrategyCheapest)))
+
+	// The channels with unknown latency are ordered first, so they are measured.
+	svc.Stats.RecordSuccess(1, 50*time.Millisecond)
+	require.Equal(t, []int{4, 1, 3, 2}, ids(svc.OrderChannels(gpt4o, channels, objects.RoutingStrategyFastest)))
+
+	// The prices of the other models do not apply, so the channels are ordered by the health and the latency.
+	require.Equal(t, []int{4, 1, 2, 3}, ids(svc.OrderChannels(&llm.Request{Model: "gpt-4o-mini"}, channels, objects.RoutingStrategyCheapest)))
+
+	// The unhealthy channels are demoted, even if they are the cheapest or the fastest.
+	for range 4 {
+		svc.Stats.RecordFailure(3)
+		svc.Stats.RecordFailure(4)
+	}
+	require.Equal(t, []int{2, 1, 3, 4}, ids(svc.OrderChannels(gpt4o, channels, objects.RoutingStrategyCheapest)))
+	require.Equal(t, []int{1, 2, 4, 3}, ids(svc.OrderChannels(gpt4o, channels, objects.RoutingStrategyFastest)))
+}
+
+func TestChannelService_OrderChannels_EffectivePrice(t *testing.T) {
+	// The channel a is cheaper for the input, the channel b for the output.
+	a := &Channel{Channel: &ent.Channel{ID: 1, SupportedModels: []string{"gpt-4o"}, Settings: &objects.ChannelSettings{
+		ModelPrices: []objects.ModelPrice{{Model: "gpt-4o", InputPrice: 1, OutputPrice: 20}},
+	}}}
+	b := &Channel{Channel: &ent.Channel{ID: 2, SupportedModels: []string{"gpt-4o"}, Settings: &objects.ChannelSettings{
+		ModelPrices: []objects.ModelPrice{{Model: "gpt-4o", InputPrice: 3, OutputPrice: 6}},
+	}}}
+	// The channel c maps the model, its price is configured for the mapped model.
+	c := &Channel{Channel: &ent.Channel{ID: 3, SupportedModels: []string{"gpt-4o-2024-08-06"}, Settings: &objects.ChannelSettings{
+		ModelMappings: []objects.ModelMapping{{From: "gpt-4o", To: "gpt-4o-2024-08-06"}},
+		ModelPrices:   []objects.ModelPrice{{Model: "gpt-4o-2024-08-06", InputPrice: 2, OutputPrice: 8}},
+	}}}
+
+	svc := &ChannelService{Stats: NewChannelStats()}
+
+	ids := func(channels []*Channel) []int {
+		return lo.Map(channels, func(c *Channel, _ int) int { return c.ID })
+	}
+
+	content := strings.Repeat("Summarize the document. ", 50)
+	request := func(maxTokens *int64) *llm.Request {
+		return &llm.Request{
+			Model:     "gpt-4o",
+			Messages:  []llm.Message{{Role: "user", Content: llm.MessageContent{Content: &content}}},
+			MaxTokens: maxTokens,
+		}
+	}
+
+	// The long prompt with a short completion is the cheapest on the channel a.
+	require.Equal(t, []int{1, 3, 2}, ids(svc.OrderChannels(request(lo.ToPtr[int64](1)), []*Channel{a, b, c}, objects.RoutingStrategyCheapest)))
+
+	// The long completion is the cheapest on the channel b.
+	require.Equal(t, []int{2, 3, 1}, ids(svc.OrderChannels(request(lo.ToPtr[int64](4096)), []*Channel{a, b, c}, objects.RoutingStrategyCheapest)))
+
+	// Without the max tokens the completion is a quarter of the prompt.
+	require.Equal(t, []int{3, 2, 1}, ids(svc.OrderChannels(request(nil), []*Channel{a, b, c}, objects.RoutingStrategyCheapest)))
+
+	price, ok := c.EffectivePrice("gpt-4o", 300, 100)
+	require.True(t, ok)
+	require.InDelta(t, 3.5, price, 1e-9)
+
+	_, ok = c.EffectivePrice("gpt-4o-mini", 300, 100)
+	require.False(t, ok)
+}
@@ -0,0 +1,165 @@
+package biz
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/channel"
+	"github.com/looplj/axonhub/internal/ent/privacy"
+	"github.com/looplj/axonhub/internal/ent/routingrule"
+	"github.com/looplj/axonhub/internal/llm"
+	"github.com/looplj/axonhub/internal/objects"
+	"github.com/looplj/axonhub/internal/server/db"
+)
+
+func TestValidateRoutingConditions(t *testing.T) {
+	require.NoError(t, ValidateRoutingConditions(nil))
+	require.NoError(t, ValidateRoutingConditions(&objects.RoutingConditions{MinPromptTokens: 100, MaxPromptTokens: 200}))
+	require.Error(t, ValidateRoutingConditions(&objects.RoutingConditions{MinPromptTokens: -1}))
+	require.Error(t, ValidateRoutingConditions(&objects.RoutingConditions{MinPromptTokens: 300, MaxPromptTokens: 200}))
+	require.Error(t, ValidateRoutingConditions(&objects.RoutingConditions{Metadata: []objects.MetadataEntry{{Value: "x"}}}))
+}
+
+func TestChannelService_ApplyRoutingRules(t *testing.T) {
+	client := db.NewEntClient(db.Config{
+		Dialect: "sqlite3",
+		DSN:     "file:ent?mode=memory&_fk=1",
+	})
+	defer client.Close()
+
+	ctx := ent.NewContext(t.Context(), client)
+	ctx = privacy.DecisionContext(ctx, privacy.Allow)
+
+	var candidates []*Channel
+
+	for _, name := range []string{"default", "vision", "long-context", "dedicated"} {
+		ch, err := client.Channel.Create().
+			SetType(channel.TypeOpenai).
+			SetName(name).
+			SetSupportedModels([]string{"gpt-4o"}).
+			SetDefaultTestModel("gpt-4o").
+			Save(ctx)
+		require.NoError(t, err)
+
+		candidates = append(candidates, &Channel{Channel: ch})
+	}
+
+	createRule := func(name string, priority int, action routingrule.Action, conditions *objects.RoutingConditions, channels ...*Channel) {
+		_, err := client.RoutingRule.Create().
+			SetName(name).
+			SetPriority(priority).
+			SetAction(action).
+			SetConditions(conditions).
+			AddChannelIDs(lo.Map(channels, func(c *Channel, _ int) int { return c.ID })...).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+
+	createRule("tenant", 3, routingrule.ActionRestrict,
+		&objects.RoutingConditions{Metadata: []objects.MetadataEntry{{Key: "tenant", Value: "acme"}}}, candidates[3])
+	createRule("vision", 2, routingrule.ActionRestrict, &objects.RoutingConditions{Images: lo.ToPtr(true)}, candidates[1])
+	createRule("long-context", 1, routingrule.ActionPrefer, &objects.RoutingConditions{MinPromptTokens: 1000}, candidates[2])
+	createRule("other-model", 0, routingrule.ActionRestrict, &objects.RoutingConditions{Models: []string{"claude-sonnet-4"}}, candidates[3])
+
+	_, err := client.RoutingRule.Create().
+		SetName("disabled").
+		SetPriority(10).
+		SetStatus(routingrule.StatusDisabled).
+		AddChannelIDs(candidates[3].ID).
+		Save(ctx)
+	require.NoError(t, err)
+
+	svc := &ChannelService{Ent: client}
+	require.NoError(t, svc.LoadRoutingRules(ctx))
+	require.Len(t, svc.RoutingRules, 4)
+
+	route := func(req *llm.Request) ([]string, string) {
+		channels, rule := svc.ApplyRoutingRules(req, candidates)
+
+		names := lo.Map(channels, func(c *Channel, _ int) string { return c.Name })
+		if rule == nil {
+			return names, ""
+		}
+
+		return names, rule.Name
+	}
+
+	text := func(content string) []llm.Message {
+		return []llm.Message{{Role: "user", Content: llm.MessageContent{Content: &content}}}
+	}
+
+	channels, rule := route(&llm.Request{Model: "gpt-4o", Messages: text("Hi")})
+	require.Empty(t, rule)
+	require.Equal(t, []string{"default", "vision", "long-context", "dedicated"}, channels)
+
+	channels, rule = route(&llm.Request{Model: "gpt-4o", Messages: text("Hi"), Metadata: map[string]string{"tenant": "acme"}})
+	require.Equal(t, "tenant", rule)
+	require.Equal(t, []string{"dedicated"}, channels)
+
+	channels, rule = route(&llm.Request{Model: "gpt-4o", Messages: []llm.Message{{
+		Role: "user",
+		Content: llm.MessageContent{MultipleContent: []llm.MessageContentPart{
+			{Type: "image_url", ImageURL: &llm.ImageURL{URL: "https://example.com/cat.png"}},
+		}},
+	}}})
+	require.Equal(t, "vision", rule)
+	require.Equal(t, []string{"vision"}, channels)
+
+	channels, rule = route(&llm.Request{Model: "gpt-4o", Messages: text(lo.RandomString(10000, lo.LettersCharset))})
+	require.Equal(t, "long-context", rule)
+	require.Equal(t, []string{"long-context", "default", "vision", "dedicated"}, channels)
+
+	// The rule is skipped if none of its channels is a candidate.
+	routed, matched := svc.ApplyRoutingRules(&llm.Request{Model: "gpt-4o", Metadata: map[string]string{"tenant": "acme"}}, candidates[:3])
+	require.Nil(t, matched)
+	require.Len(t, routed, 3)
+}
+
+func TestChannelService_DeleteAndRestoreRoutingRule(t *testing.T) {
+	client := db.NewEntClient(db.Config{
+		Dialect: "sqlite3",
+		DSN:     "file:ent?mode=memory&_fk=1",
+	})
+	defer client.Close()
+
+	ctx := ent.NewContext(t.Context(), client)
+	ctx = privacy.DecisionContext(ctx, privacy.Allow)
+
+	ch, err := client.Channel.Create().
+		SetType(channel.TypeOpenai).
+		SetName("vision").
+		SetSupportedModels([]string{"gpt-4o"}).
+		SetDefaultTestModel("gpt-4o").
+		Save(ctx)
+	require.NoError(t, err)
+
+	rule, err := client.RoutingRule.Create().
+		SetName("vision").
+		SetConditions(&objects.RoutingConditions{Images: lo.ToPtr(true)}).
+		AddChannelIDs(ch.ID).
+		Save(ctx)
+	require.NoError(t, err)
+
+	svc := &ChannelService{Ent: client}
+	require.NoError(t, svc.LoadRoutingRules(ctx))
+	require.Len(t, svc.RoutingRules, 1)
+
+	require.NoError(t, svc.DeleteRoutingRule(ctx, rule.ID))
+	require.Empty(t, svc.RoutingRules)
+
+	_, err = client.RoutingRule.Get(ctx, rule.ID)
+	require.True(t, ent.IsNotFound(err))
+
+	restored, err := svc.RestoreRoutingRule(ctx, rule.ID)
+	require.NoError(t, err)
+	require.Zero(t, restored.DeletedAt)
+	require.Len(t, svc.RoutingRules, 1)
+	require.Len(t, svc.RoutingRules[0].Edges.Channels, 1)
+
+	// Only the deleted routing rules can be restored.
+	_, err = svc.RestoreRoutingRule(ctx, rule.ID)
+	require.True(t, ent.IsNotFound(err))
+}
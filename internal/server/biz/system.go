@@ -40,6 +40,18 @@ const (
 	// SystemKeyStoragePolicy is the key used to store the storage policy configuration.
 	// The value is JSON-encoded StoragePolicy struct.
 	SystemKeyStoragePolicy = "storage_policy"
+
+	// SystemKeyGuardrailPolicy is the key used to store the global guardrail policy.
+	// The value is JSON-encoded objects.GuardrailPolicy struct.
+	SystemKeyGuardrailPolicy = "guardrail_policy"
+
+	// SystemKeyModelFallbacks is the key used to store the global model fallback chains.
+	// The value is JSON-encoded []objects.ModelFallback.
+	SystemKeyModelFallbacks = "model_fallbacks"
+
+	// SystemKeyHedgingPolicy is the key used to store the global hedging policy.
+	// The value is JSON-encoded objects.HedgingPolicy struct.
+	SystemKeyHedgingPolicy = "hedging_policy"
 )
 
 // StoragePolicy represents the storage policy configuration.
@@ -6,21 +6,38 @@ import (
 	"github.com/looplj/axonhub/internal/ent"
 	"github.com/looplj/axonhub/internal/ent/usagelog"
 	"github.com/looplj/axonhub/internal/llm"
+	"github.com/looplj/axonhub/internal/llm/tokenizer"
 	"github.com/looplj/axonhub/internal/log"
+	"github.com/looplj/axonhub/internal/server/persist"
 )
 
 // UsageLogService handles usage log operations.
 type UsageLogService struct {
 	SystemService *SystemService
+	Writer        *persist.Writer
+	Tokenizers    *tokenizer.Registry
 }
 
 // NewUsageLogService creates a new UsageLogService.
-func NewUsageLogService(systemService *SystemService) *UsageLogService {
+func NewUsageLogService(systemService *SystemService, writer *persist.Writer, tokenizers *tokenizer.Registry) *UsageLogService {
 	return &UsageLogService{
 		SystemService: systemService,
+		Writer:        writer,
+		Tokenizers:    tokenizers,
 	}
 }
 
+// EstimateUsage estimates the usage with the tokenizer of the model of the request,
+// it is used when the provider does not report the usage, e.g. the stream is aborted.
+// The response can be nil if nothing is received.
+func (s *UsageLogService) EstimateUsage(request *llm.Request, response *llm.Response) *llm.Usage {
+	if request == nil {
+		return nil
+	}
+
+	return tokenizer.EstimateUsage(s.Tokenizers.ForModel(request.Model), request, response)
+}
+
 // CreateUsageLog creates a new usage log record from LLM response usage data.
 func (s *UsageLogService) CreateUsageLog(
 	ctx context.Context,
@@ -46,7 +63,8 @@ func (s *UsageLogService) CreateUsageLog(
 		SetCompletionTokens(usage.CompletionTokens).
 		SetTotalTokens(usage.TotalTokens).
 		SetSource(source).
-		SetFormat(format)
+		SetFormat(format).
+		SetEstimated(usage.Estimated)
 
 	// Set channel ID if provided
 	if channelID != nil {
@@ -62,6 +80,10 @@ func (s *UsageLogService) CreateUsageLog(
 		if usage.PromptTokensDetails.CachedTokens > 0 {
 			mut = mut.SetPromptCachedTokens(usage.PromptTokensDetails.CachedTokens)
 		}
+
+		if usage.PromptTokensDetails.CacheCreationTokens > 0 {
+			mut = mut.SetPromptCacheCreationTokens(usage.PromptTokensDetails.CacheCreationTokens)
+		}
 	}
 
 	// Set completion tokens details if available
@@ -101,14 +123,15 @@ func (s *UsageLogService) CreateUsageLog(
 }
 
 // CreateUsageLogFromRequest creates a usage log from request and response data.
+// The usage log is created through the persistence writer, so it may not be visible yet when the method returns.
 func (s *UsageLogService) CreateUsageLogFromRequest(
 	ctx context.Context,
 	request *ent.Request,
 	requestExec *ent.RequestExecution,
 	usage *llm.Usage,
-) (*ent.UsageLog, error) {
+) error {
 	if request == nil || usage == nil {
-		return nil, nil
+		return nil
 	}
 
 	// Get channel ID from request if available
@@ -121,14 +144,18 @@ func (s *UsageLogService) CreateUsageLogFromRequest(
 		channelID = &requestExec.ChannelID
 	}
 
-	return s.CreateUsageLog(
-		ctx,
-		request.UserID,
-		request.ID,
-		channelID,
-		request.ModelID,
-		usage,
-		usagelog.Source(request.Source),
-		request.Format,
-	)
+	return s.Writer.Submit(ctx, request.ID, "usage_log", func(ctx context.Context) error {
+		_, err := s.CreateUsageLog(
+			ctx,
+			request.UserID,
+			request.ID,
+			channelID,
+			request.ModelID,
+			usage,
+			usagelog.Source(request.Source),
+			request.Format,
+		)
+
+		return err
+	})
 }
@@ -0,0 +1,219 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"time"
+
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/privacy"
+	"github.com/looplj/axonhub/internal/ent/schema/schematype"
+	"github.com/looplj/axonhub/internal/ent/virtualmodel"
+	"github.com/looplj/axonhub/internal/llm"
+	"github.com/looplj/axonhub/internal/objects"
+)
+
+// ValidateVirtualModelTargets checks the virtual model has targets, and the targets have the model and a valid weight.
+func ValidateVirtualModelTargets(targets []objects.VirtualModelTarget) error {
+	if len(targets) == 0 {
+		return errors.New("invalid virtual model: at least one target is required")
+	}
+
+	for _, target := range targets {
+		if target.Model == "" {
+			return errors.New("invalid virtual model: target model is required")
+		}
+
+		if target.Weight < 0 {
+			return fmt.Errorf("invalid virtual model: negative weight of target %s", target.Model)
+		}
+
+		if target.ChannelID != nil && target.ChannelID.Type != ent.TypeChannel {
+			return fmt.Errorf("invalid virtual model: invalid channel of target %s", target.Model)
+		}
+	}
+
+	return nil
+}
+
+// LoadVirtualModels reloads the enabled virtual models, it is called after the virtual models are changed.
+func (svc *ChannelService) LoadVirtualModels(ctx context.Context) error {
+	ctx = privacy.DecisionContext(ctx, privacy.Allow)
+
+	virtualModels, err := svc.Ent.VirtualModel.Query().
+		Where(virtualmodel.StatusEQ(virtualmodel.StatusEnabled)).
+		Order(ent.Asc(virtualmodel.FieldName)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load virtual models: %w", err)
+	}
+
+	svc.VirtualModels = virtualModels
+
+	return nil
+}
+
+// DeleteVirtualModel soft deletes the virtual model, the name of the deleted virtual model can be reused.
+func (svc *ChannelService) DeleteVirtualModel(ctx context.Context, id int) error {
+	entity, err := svc.Ent.VirtualModel.Get(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to get virtual model: %w", err)
+	}
+
+	// The name and deleted_at are unique together, so the virtual models with the same name deleted in the same second
+	// get different deleted_at.
+	deletedAt := int(time.Now().Unix())
+
+	last, err := svc.Ent.VirtualModel.Query().
+		Where(virtualmodel.Name(entity.Name), virtualmodel.DeletedAtGT(0)).
+		Order(ent.Desc(virtualmodel.FieldDeletedAt)).
+		First(schematype.SkipSoftDelete(ctx))
+	if err != nil && !ent.IsNotFound(err) {
+		return fmt.Errorf("failed to get deleted virtual model: %w", err)
+	}
+
+	if last != nil && last.DeletedAt >= deletedAt {
+		deletedAt = last.DeletedAt + 1
+	}
+
+	if err := svc.Ent.VirtualModel.UpdateOne(entity).SetDeletedAt(deletedAt).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to delete virtual model: %w", err)
+	}
+
+	return svc.LoadVirtualModels(ctx)
+}
+
+// RestoreVirtualModel restores the soft deleted virtual model.
+// It fails if an active virtual model is using the same name.
+func (svc *ChannelService) RestoreVirtualModel(ctx context.Context, id int) (*ent.VirtualModel, error) {
+	deleted, err := svc.Ent.VirtualModel.Query().
+		Where(virtualmodel.ID(id), virtualmodel.DeletedAtGT(0)).
+		Only(schematype.SkipSoftDelete(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deleted virtual model: %w", err)
+	}
+
+	exists, err := svc.Ent.VirtualModel.Query().
+		Where(virtualmodel.Name(deleted.Name)).
+		Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check virtual model name: %w", err)
+	}
+
+	if exists {
+		return nil, fmt.Errorf("virtual model %s: %w", deleted.Name, ErrNameConflict)
+	}
+
+	restored, err := svc.Ent.VirtualModel.UpdateOne(deleted).
+		SetDeletedAt(0).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore virtual model: %w", err)
+	}
+
+	if err := svc.LoadVirtualModels(ctx); err != nil {
+		return nil, err
+	}
+
+	return restored, nil
+}
+
+// ResolveVirtualModel returns the targets of the virtual model matching the request, false if the model is not a virtual model.
+// The targets with the higher priority come first, the targets with the same priority are shuffled by their weights.
+func (svc *ChannelService) ResolveVirtualModel(req *llm.Request) ([]objects.VirtualModelTarget, bool) {
+	idx := slices.IndexFunc(svc.VirtualModels, func(vm *ent.VirtualModel) bool {
+		return vm.Name == req.Model
+	})
+	if idx < 0 {
+		return nil, false
+	}
+
+	var targets []objects.VirtualModelTarget
+
+	for _, target := range svc.VirtualModels[idx].Targets {
+		if matchVirtualModelConditions(target.Conditions, req) {
+			targets = append(targets, target)
+		}
+	}
+
+	return orderVirtualModelTargets(targets), true
+}
+
+func orderVirtualModelTargets(targets []objects.VirtualModelTarget) []objects.VirtualModelTarget {
+	// Sort by priority first, then shuffle each group of the same priority in place.
+	slices.SortStableFunc(targets, func(a, b objects.VirtualModelTarget) int {
+		return b.Priority - a.Priority
+	})
+
+	for start := 0; start < len(targets); {
+		end := start + 1
+		for end < len(targets) && targets[end].Priority == targets[start].Priority {
+			end++
+		}
+
+		weightedShuffle(targets[start:end])
+
+		start = end
+	}
+
+	return targets
+}
+
+// weightedShuffle picks the targets one by one with the probability proportional to the weights.
+func weightedShuffle(targets []objects.VirtualModelTarget) {
+	weight := func(target objects.VirtualModelTarget) int {
+		return max(target.Weight, 1)
+	}
+
+	for i := range targets {
+		var total int
+		for _, target := range targets[i:] {
+			total += weight(target)
+		}
+
+		n := rand.IntN(total)
+
+		for j := i; j < len(targets); j++ {
+			n -= weight(targets[j])
+			if n < 0 {
+				targets[i], targets[j] = targets[j], targets[i]
+				break
+			}
+		}
+	}
+}
+
+func matchVirtualModelConditions(conditions *objects.VirtualModelConditions, req *llm.Request) bool {
+	if conditions == nil {
+		return true
+	}
+
+	if conditions.Stream != nil && *conditions.Stream != (req.Stream != nil && *req.Stream) {
+		return false
+	}
+
+	if conditions.Tools != nil && *conditions.Tools != (len(req.Tools) > 0) {
+		return false
+	}
+
+	if conditions.Images != nil && *conditions.Images != hasImages(req) {
+		return false
+	}
+
+	return true
+}
+
+func hasImages(req *llm.Request) bool {
+	for _, msg := range req.Messages {
+		for _, part := range msg.Content.MultipleContent {
+			if part.Type == "image_url" {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,170 @@
+package biz
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/channel"
+	"github.com/looplj/axonhub/internal/ent/privacy"
+	"github.com/looplj/axonhub/internal/ent/virtualmodel"
+	"github.com/looplj/axonhub/internal/llm"
+	"github.com/looplj/axonhub/internal/objects"
+	"github.com/looplj/axonhub/internal/server/db"
+)
+
+func TestValidateVirtualModelTargets(t *testing.T) {
+	require.Error(t, ValidateVirtualModelTargets(nil))
+	require.Error(t, ValidateVirtualModelTargets([]objects.VirtualModelTarget{{Weight: 1}}))
+	require.Error(t, ValidateVirtualModelTargets([]objects.VirtualModelTarget{{Model: "gpt-4o", Weight: -1}}))
+	require.Error(t, ValidateVirtualModelTargets([]objects.VirtualModelTarget{
+		{Model: "gpt-4o", ChannelID: &objects.GUID{Type: ent.TypeUser, ID: 1}},
+	}))
+	require.NoError(t, ValidateVirtualModelTargets([]objects.VirtualModelTarget{
+		{Model: "gpt-4o", ChannelID: &objects.GUID{Type: ent.TypeChannel, ID: 1}, Weight: 2},
+		{Model: "gpt-4o-mini"},
+	}))
+}
+
+func TestChannelService_ResolveVirtualModel(t *testing.T) {
+	client := db.NewEntClient(db.Config{
+		Dialect: "sqlite3",
+		DSN:     "file:ent?mode=memory&_fk=1",
+	})
+	defer client.Close()
+
+	ctx := ent.NewContext(t.Context(), client)
+	ctx = privacy.DecisionContext(ctx, privacy.Allow)
+
+	_, err := client.VirtualModel.Create().
+		SetName("fast").
+		SetTargets([]objects.VirtualModelTarget{
+			{Model: "gpt-4o-mini", Priority: 0},
+			{Model: "claude-haiku", Priority: 0, Weight: 3},
+			{Model: "gpt-4o", Priority: 1, Conditions: &objects.VirtualModelConditions{Tools: lo.ToPtr(true)}},
+			{Model: "gemini-flash", Priority: 2, Conditions: &objects.VirtualModelConditions{Stream: lo.ToPtr(true)}},
+		}).
+		Save(ctx)
+	require.NoError(t, err)
+
+	_, err = client.VirtualModel.Create().
+		SetName("disabled").
+		SetStatus(virtualmodel.StatusDisabled).
+		SetTargets([]objects.VirtualModelTarget{{Model: "gpt-4o"}}).
+		Save(ctx)
+	require.NoError(t, err)
+
+	svc := &ChannelService{Ent: client}
+	require.NoError(t, svc.LoadVirtualModels(ctx))
+	require.Len(t, svc.VirtualModels, 1)
+
+	_, ok := svc.ResolveVirtualModel(&llm.Request{Model: "disabled"})
+	require.False(t, ok)
+
+	_, ok = svc.ResolveVirtualModel(&llm.Request{Model: "gpt-4o"})
+	require.False(t, ok)
+
+	targets, ok := svc.ResolveVirtualModel(&llm.Request{Model: "fast"})
+	require.True(t, ok)
+	require.ElementsMatch(t, []string{"gpt-4o-mini", "claude-haiku"}, lo.Map(targets, func(target objects.VirtualModelTarget, _ int) string {
+		return target.Model
+	}))
+
+	targets, ok = svc.ResolveVirtualModel(&llm.Request{
+		Model:  "fast",
+		Stream: lo.ToPtr(true),
+		Tools:  []llm.Tool{{Type: "function"}},
+	})
+	require.True(t, ok)
+	require.Len(t, targets, 4)
+	require.Equal(t, "gemini-flash", targets[0].Model)
+	require.Equal(t, "gpt-4o", targets[1].Model)
+
+	// The heavier target comes first more often.
+	var heavierFirst int
+
+	for range 1000 {
+		targets, _ := svc.ResolveVirtualModel(&llm.Request{Model: "fast"})
+		if targets[0].Model == "claude-haiku" {
+			heavierFirst++
+		}
+	}
+
+	require.Greater(t, heavierFirst, 600)
+}
+
+func TestChannelService_ListModels(t *testing.T) {
+	svc := &ChannelService{
+		Channels: []*Channel{
+			{Channel: &ent.Channel{
+				Type:            channel.TypeOpenai,
+				SupportedModels: []string{"gpt-4o", "gpt-4o-mini"},
+				Settings: &objects.ChannelSettings{
+					ModelMappings: []objects.ModelMapping{{From: "gpt-4", To: "gpt-4o"}, {From: "o1", To: "o3"}},
+				},
+			}},
+			{Channel: &ent.Channel{Type: channel.TypeOpenrouter, SupportedModels: []string{"gpt-4o", "claude-sonnet-4"}}},
+		},
+		VirtualModels: []*ent.VirtualModel{{Name: "fast"}},
+	}
+
+	models := svc.ListModels()
+	require.Equal(t, []string{"gpt-4o", "gpt-4o-mini", "gpt-4", "claude-sonnet-4", "fast"}, lo.Map(models, func(model Model, _ int) string {
+		return model.ID
+	}))
+	require.Equal(t, "openai", models[0].OwnedBy)
+	require.Equal(t, "openrouter", models[3].OwnedBy)
+	require.Equal(t, "axonhub", models[4].OwnedBy)
+}
+
+func TestChannelService_DeleteAndRestoreVirtualModel(t *testing.T) {
+	client := db.NewEntClient(db.Config{
+		Dialect: "sqlite3",
+		DSN:     "file:ent?mode=memory&_fk=1",
+	})
+	defer client.Close()
+
+	ctx := ent.NewContext(t.Context(), client)
+	ctx = privacy.DecisionContext(ctx, privacy.Allow)
+
+	svc := &ChannelService{Ent: client}
+
+	createVirtualModel := func() (*ent.VirtualModel, error) {
+		return client.VirtualModel.Create().
+			SetName("fast").
+			SetTargets([]objects.VirtualModelTarget{{Model: "gpt-4o-mini"}}).
+			Save(ctx)
+	}
+
+	deleted, err := createVirtualModel()
+	require.NoError(t, err)
+	require.NoError(t, svc.LoadVirtualModels(ctx))
+	require.Len(t, svc.VirtualModels, 1)
+
+	require.NoError(t, svc.DeleteVirtualModel(ctx, deleted.ID))
+	require.Empty(t, svc.VirtualModels)
+
+	_, err = client.VirtualModel.Get(ctx, deleted.ID)
+	require.True(t, ent.IsNotFound(err))
+
+	// The name of the deleted virtual model can be reused.
+	active, err := createVirtualModel()
+	require.NoError(t, err)
+
+	_, err = svc.RestoreVirtualModel(ctx, deleted.ID)
+	require.ErrorIs(t, err, ErrNameConflict)
+
+	require.NoError(t, svc.DeleteVirtualModel(ctx, active.ID))
+
+	restored, err := svc.RestoreVirtualModel(ctx, deleted.ID)
+	require.NoError(t, err)
+	require.Equal(t, deleted.ID, restored.ID)
+	require.Zero(t, restored.DeletedAt)
+	require.Len(t, svc.VirtualModels, 1)
+
+	// Only the deleted virtual models can be restored.
+	_, err = svc.RestoreVirtualModel(ctx, restored.ID)
+	require.True(t, ent.IsNotFound(err))
+}
@@ -0,0 +1,48 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/llm"
+)
+
+const (
+	StoreMemory   = "memory"
+	StoreDatabase = "database"
+)
+
+// Config holds the configuration of the response cache.
+type Config struct {
+	// Store is the storage of the cached responses, memory or database.
+	// The memory store is local to the instance, the database store is shared by all the instances.
+	Store string `conf:"store" yaml:"store" json:"store"`
+
+	// MaxEntries is the maximum number of the responses kept by the memory store, the least recently used are evicted.
+	MaxEntries int `conf:"max_entries" yaml:"max_entries" json:"max_entries"`
+
+	// TTL is the duration the cached responses are valid for.
+	TTL time.Duration `conf:"ttl" yaml:"ttl" json:"ttl"`
+}
+
+// Store stores the cached responses by the cache key.
+type Store interface {
+	// Get returns the cached response of the key, false if the key is not cached or expired.
+	Get(ctx context.Context, key string) (*llm.Response, bool, error)
+	// Set caches the response of the key, the response expires after the configured TTL.
+	Set(ctx context.Context, key string, response *llm.Response) error
+}
+
+// NewStore creates the response cache store of the configuration.
+func NewStore(config Config, client *ent.Client) (Store, error) {
+	switch config.Store {
+	case "", StoreMemory:
+		return NewMemoryStore(config.MaxEntries, config.TTL), nil
+	case StoreDatabase:
+		return NewDatabaseStore(client, config.TTL), nil
+	default:
+		return nil, fmt.Errorf("unknown response cache store: %s", config.Store)
+	}
+}
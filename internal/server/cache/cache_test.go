@@ -0,0 +1,117 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/looplj/axonhub/internal/llm"
+	"github.com/looplj/axonhub/internal/server/db"
+)
+
+func newResponse(content string) *llm.Response {
+	return &llm.Response{
+		ID:     "chatcmpl-123",
+		Object: "chat.completion",
+		Model:  "gpt-4o",
+		Choices: []llm.Choice{
+			{
+				Index: 0,
+				Message: &llm.Message{
+					Role:    "assistant",
+					Content: llm.MessageContent{Content: lo.ToPtr(content)},
+				},
+				FinishReason: lo.ToPtr("stop"),
+			},
+		},
+	}
+}
+
+func TestMemoryStore(t *testing.T) {
+	ctx := t.Context()
+	store := NewMemoryStore(2, time.Hour)
+
+	_, ok, err := store.Get(ctx, "a")
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	response := newResponse("hello")
+	require.NoError(t, store.Set(ctx, "a", response))
+
+	// The cached response is not affected by the changes of the caller.
+	response.Choices[0].Message.Content.Content = lo.ToPtr("changed")
+
+	cached, ok, err := store.Get(ctx, "a")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, "hello", *cached.Choices[0].Message.Content.Content)
+
+	// The least recently used response is evicted.
+	require.NoError(t, store.Set(ctx, "b", newResponse("b")))
+	require.NoError(t, store.Set(ctx, "c", newResponse("c")))
+
+	_, ok, err = store.Get(ctx, "a")
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func TestMemoryStore_Expired(t *testing.T) {
+	ctx := t.Context()
+	store := NewMemoryStore(10, 10*time.Millisecond)
+
+	require.NoError(t, store.Set(ctx, "a", newResponse("hello")))
+	time.Sleep(50 * time.Millisecond)
+
+	_, ok, err := store.Get(ctx, "a")
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func TestDatabaseStore(t *testing.T) {
+	client := db.NewEntClient(db.Config{
+		Dialect: "sqlite3",
+		DSN:     "file:ent?mode=memory&_fk=1",
+	})
+	defer client.Close()
+
+	ctx := t.Context()
+	store := NewDatabaseStore(client, time.Hour)
+
+	_, ok, err := store.Get(ctx, "a")
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	require.NoError(t, store.Set(ctx, "a", newResponse("hello")))
+
+	cached, ok, err := store.Get(ctx, "a")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, "hello", *cached.Choices[0].Message.Content.Content)
+
+	// The response of the same key is replaced.
+	require.NoError(t, store.Set(ctx, "a", newResponse("world")))
+
+	cached, ok, err = store.Get(ctx, "a")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, "world", *cached.Choices[0].Message.Content.Content)
+
+	// The expired response is ignored.
+	expired := NewDatabaseStore(client, -time.Minute)
+	require.NoError(t, expired.Set(ctx, "b", newResponse("expired")))
+
+	_, ok, err = store.Get(ctx, "b")
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func TestNewStore(t *testing.T) {
+	store, err := NewStore(Config{}, nil)
+	require.NoError(t, err)
+	require.IsType(t, &MemoryStore{}, store)
+
+	_, err = NewStore(Config{Store: "redis"}, nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,70 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/responsecache"
+	"github.com/looplj/axonhub/internal/llm"
+)
+
+// DatabaseStore keeps the cached responses in the database, the cache is shared by all the instances.
+// The expired responses are ignored on read and purged by the GC worker.
+type DatabaseStore struct {
+	client *ent.Client
+	ttl    time.Duration
+}
+
+// NewDatabaseStore creates a database store keeping the responses for the ttl.
+func NewDatabaseStore(client *ent.Client, ttl time.Duration) *DatabaseStore {
+	return &DatabaseStore{
+		client: client,
+		ttl:    ttl,
+	}
+}
+
+func (s *DatabaseStore) Get(ctx context.Context, key string) (*llm.Response, bool, error) {
+	entry, err := s.client.ResponseCache.Query().
+		Where(
+			responsecache.Key(key),
+			responsecache.ExpiresAtGT(time.Now()),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, false, nil
+		}
+
+		return nil, false, fmt.Errorf("failed to query cached response: %w", err)
+	}
+
+	var response llm.Response
+	if err := json.Unmarshal(entry.Response, &response); err != nil {
+		return nil, false, fmt.Errorf("failed to unmarshal cached response: %w", err)
+	}
+
+	return &response, true, nil
+}
+
+func (s *DatabaseStore) Set(ctx context.Context, key string, response *llm.Response) error {
+	data, err := json.Marshal(response)
+	if err != nil {
+		return fmt.Errorf("failed to marshal response: %w", err)
+	}
+
+	err = s.client.ResponseCache.Create().
+		SetKey(key).
+		SetResponse(data).
+		SetExpiresAt(time.Now().Add(s.ttl)).
+		OnConflictColumns(responsecache.FieldKey).
+		UpdateNewValues().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to save cached response: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/golang-lru/v2/expirable"
+
+	"github.com/looplj/axonhub/internal/llm"
+)
+
+const defaultMaxEntries = 1000
+
+// MemoryStore keeps the cached responses in an in-memory LRU.
+// The responses are stored serialized so the callers can not modify the cached ones.
+type MemoryStore struct {
+	lru *expirable.LRU[string, []byte]
+}
+
+// NewMemoryStore creates a memory store keeping at most maxEntries responses for the ttl.
+func NewMemoryStore(maxEntries int, ttl time.Duration) *MemoryStore {
+	if maxEntries <= 0 {
+		maxEntries = defaultMaxEntries
+	}
+
+	return &MemoryStore{
+		lru: expirable.NewLRU[string, []byte](maxEntries, nil, ttl),
+	}
+}
+
+func (s *MemoryStore) Get(ctx context.Context, key string) (*llm.Response, bool, error) {
+	data, ok := s.lru.Get(key)
+	if !ok {
+		return nil, false, nil
+	}
+
+	var response llm.Response
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, false, fmt.Errorf("failed to unmarshal cached response: %w", err)
+	}
+
+	return &response, true, nil
+}
+
+func (s *MemoryStore) Set(ctx context.Context, key string, response *llm.Response) error {
+	data, err := json.Marshal(response)
+	if err != nil {
+		return fmt.Errorf("failed to marshal response: %w", err)
+	}
+
+	s.lru.Add(key, data)
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/looplj/axonhub/internal/llm"
+	"github.com/looplj/axonhub/internal/log"
+	"github.com/looplj/axonhub/internal/server/affinity"
+)
+
+// applySessionAffinity moves the channel the conversation is pinned to in front of the selected channels,
+// the pin is ignored if the channel is no longer selected, e.g. it is disabled or restricted by the routing rules.
+// The pin of an unhealthy channel is deleted, so the conversation is pinned again to the channel serving the request.
+func (p *PersistentOutboundTransformer) applySessionAffinity(ctx context.Context, llmRequest *llm.Request) {
+	if p.state.SessionAffinity == nil {
+		return
+	}
+
+	var apiKeyID int
+	if p.state.APIKey != nil {
+		apiKeyID = p.state.APIKey.ID
+	}
+
+	p.state.SessionKey = p.state.SessionAffinity.Key(apiKeyID, p.state.SessionID, llmRequest)
+	if p.state.SessionKey == "" {
+		return
+	}
+
+	pin, ok := p.state.SessionAffinity.Get(p.state.SessionKey)
+	if !ok {
+		return
+	}
+
+	if p.state.ChannelStats.Unhealthy(pin.ChannelID) {
+		p.state.SessionAffinity.Delete(p.state.SessionKey)
+
+		log.Debug(ctx, "pinned channel of the session is unhealthy", log.Int("channel_id", pin.ChannelID))
+
+		return
+	}
+
+	for i, channel := range p.state.Channels {
+		if channel.ID != pin.ChannelID || p.state.Models[i] != pin.Model {
+			continue
+		}
+
+		moveToFront(p.state.Channels, i)
+		moveToFront(p.state.Models, i)
+		moveToFront(p.state.Candidates, i)
+
+		log.Debug(ctx, "routed to the pinned channel of the session", log.String("channel", channel.Name), log.String("model", pin.Model))
+
+		return
+	}
+
+	log.Debug(ctx, "pinned channel of the session is not available", log.Int("channel_id", pin.ChannelID))
+}
+
+// pinSession pins the conversation to the channel which served the request, the TTL of the pin is renewed.
+func (p *PersistentOutboundTransformer) pinSession() {
+	if p.state.SessionAffinity == nil || p.state.SessionKey == "" || p.state.CurrentChannel == nil {
+		return
+	}
+
+	p.state.SessionAffinity.Set(p.state.SessionKey, affinity.Pin{
+		ChannelID: p.state.CurrentChannel.ID,
+		Model:     p.state.Models[p.state.ChannelIndex],
+	})
+}
+
+// unpinSession unpins the conversation when all the channels failed, so the next request is routed afresh.
+func (p *PersistentOutboundTransformer) unpinSession() {
+	if p.state.SessionAffinity == nil || p.state.SessionKey == "" {
+		return
+	}
+
+	p.state.SessionAffinity.Delete(p.state.SessionKey)
+}
+
+func moveToFront[T any](items []T, i int) {
+	item := items[i]
+	copy(items[1:i+1], items[:i])
+	items[0] = item
+}
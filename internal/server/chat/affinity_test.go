@@ -0,0 +1,158 @@
+package chat
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/looplj/axonhub/internal/llm"
+	"github.com/looplj/axonhub/internal/llm/transformer/openai"
+	"github.com/looplj/axonhub/internal/pkg/httpclient"
+	"github.com/looplj/axonhub/internal/server/affinity"
+	"github.com/looplj/axonhub/internal/server/biz"
+)
+
+func TestChatCompletionProcessor_SessionAffinity(t *testing.T) {
+	env := newProcessorTestEnv(t)
+
+	var (
+		upstreams []string
+		failing   string
+	)
+
+	server := env.newUpstream(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.ReadAll(r.Body)
+
+		name := strings.Split(strings.TrimPrefix(r.URL.Path, "/"), "/")[0]
+		upstreams = append(upstreams, name)
+
+		w.Header().Set("Content-Type", "application/json")
+
+		if name == failing {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte(`{"error":{"message":"overloaded","type":"server_error"}}`))
+
+			return
+		}
+
+		_, _ = w.Write(chatCompletionBody("gpt-4o", "Hello"))
+	})
+
+	apiKey := env.createAPIKey("affinity")
+
+	processor := NewChatCompletionProcessor(
+		&biz.ChannelService{
+			Channels: []*biz.Channel{
+				env.createChannel("a", server.URL+"/a/v1", "gpt-4o"),
+				env.createChannel("b", server.URL+"/b/v1", "gpt-4o"),
+			},
+			Affinity: affinity.New(affinity.Config{Enabled: true, Header: "AH-Session-Id", TTL: time.Hour}),
+		},
+		env.requestService,
+		httpclient.NewHttpClient(),
+		openai.NewInboundTransformer(),
+		nil,
+		nil,
+	)
+
+	process := func(session string) {
+		t.Helper()
+
+		upstreams = nil
+
+		_, err := env.process(processor, apiKey, `{"model":"gpt-4o","messages":[{"role":"user","content":"Hi"}]}`,
+			http.Header{"Ah-Session-Id": []string{session}})
+		require.NoError(t, err)
+	}
+
+	// The channel a fails, the session is pinned to the channel b which served it.
+	failing = "a"
+	process("s1")
+	require.Equal(t, []string{"a", "b"}, upstreams)
+
+	// The channel a recovers, the session stays on the channel b.
+	failing = ""
+	process("s1")
+	require.Equal(t, []string{"b"}, upstreams)
+
+	// The other sessions are not pinned.
+	process("s2")
+	require.Equal(t, []string{"a"}, upstreams)
+
+	// The pinned channel becomes unhealthy, the session moves to the channel a.
+	failing = "b"
+	process("s1")
+	require.Equal(t, []string{"b", "a"}, upstreams)
+
+	failing = ""
+	process("s1")
+	require.Equal(t, []string{"a"}, upstreams)
+}
+
+func TestChatCompletionProcessor_SessionAffinity_UnhealthyPin(t *testing.T) {
+	env := newProcessorTestEnv(t)
+
+	var upstreams []string
+
+	server := env.newUpstream(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.ReadAll(r.Body)
+
+		upstreams = append(upstreams, strings.Split(strings.TrimPrefix(r.URL.Path, "/"), "/")[0])
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(chatCompletionBody("gpt-4o", "Hello"))
+	})
+
+	apiKey := env.createAPIKey("affinity")
+
+	channelA := env.createChannel("a", server.URL+"/a/v1", "gpt-4o")
+	channelB := env.createChannel("b", server.URL+"/b/v1", "gpt-4o")
+	store := affinity.New(affinity.Config{Enabled: true, Header: "AH-Session-Id", TTL: time.Hour})
+	stats := biz.NewChannelStats()
+
+	processor := NewChatCompletionProcessor(
+		&biz.ChannelService{
+			Channels: []*biz.Channel{channelA, channelB},
+			Affinity: store,
+			Stats:    stats,
+		},
+		env.requestService,
+		httpclient.NewHttpClient(),
+		openai.NewInboundTransformer(),
+		nil,
+		nil,
+	)
+
+	key := store.Key(apiKey.ID, "s1", &llm.Request{Model: "gpt-4o"})
+	store.Set(key, affinity.Pin{ChannelID: channelB.ID, Model: "gpt-4o"})
+
+	process := func() {
+		t.Helper()
+
+		upstreams = nil
+
+		_, err := env.process(processor, apiKey, `{"model":"gpt-4o","messages":[{"role":"user","content":"Hi"}]}`,
+			http.Header{"Ah-Session-Id": []string{"s1"}})
+		require.NoError(t, err)
+	}
+
+	process()
+	require.Equal(t, []string{"b"}, upstreams)
+
+	// The pinned channel is marked unhealthy by the failures of the other sessions,
+	// the pin is skipped and the session is pinned to the channel which served it.
+	for range 4 {
+		stats.RecordFailure(channelB.ID)
+	}
+
+	process()
+	require.Equal(t, []string{"a"}, upstreams)
+
+	pin, ok := store.Get(key)
+	require.True(t, ok)
+	require.Equal(t, affinity.Pin{ChannelID: channelA.ID, Model: "gpt-4o"}, pin)
+}
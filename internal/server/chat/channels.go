@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/looplj/axonhub/internal/contexts"
 	"github.com/looplj/axonhub/internal/llm"
 	"github.com/looplj/axonhub/internal/log"
 	"github.com/looplj/axonhub/internal/objects"
@@ -14,6 +15,12 @@ type ChannelSelector interface {
 	Select(ctx context.Context, req *llm.Request) ([]*biz.Channel, error)
 }
 
+// VirtualModelResolver is implemented by the channel selectors supporting the virtual models.
+type VirtualModelResolver interface {
+	// ResolveVirtualModel returns the ordered targets of the requested virtual model, false if the model is not a virtual model.
+	ResolveVirtualModel(ctx context.Context, req *llm.Request) ([]objects.VirtualModelTarget, bool)
+}
+
 // DefaultChannelSelector selects only enabled channels.
 type DefaultChannelSelector struct {
 	ChannelService *biz.ChannelService
@@ -40,6 +47,49 @@ func (s *DefaultChannelSelector) Select(ctx context.Context, req *llm.Request) (
 	return channels, nil
 }
 
+func (s *DefaultChannelSelector) ResolveVirtualModel(ctx context.Context, req *llm.Request) ([]objects.VirtualModelTarget, bool) {
+	return s.ChannelService.ResolveVirtualModel(req)
+}
+
+// RoutingChannelSelector selects the enabled channels, orders them by the routing strategy of the API key,
+// then applies the routing rules to them.
+type RoutingChannelSelector struct {
+	*DefaultChannelSelector
+
+	ModelMapper *ModelMapper
+}
+
+func NewRoutingChannelSelector(channelService *biz.ChannelService) *RoutingChannelSelector {
+	return &RoutingChannelSelector{
+		DefaultChannelSelector: NewDefaultChannelSelector(channelService),
+		ModelMapper:            NewModelMapper(),
+	}
+}
+
+func (s *RoutingChannelSelector) Select(ctx context.Context, req *llm.Request) ([]*biz.Channel, error) {
+	channels, err := s.DefaultChannelSelector.Select(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	apiKey, _ := contexts.GetAPIKey(ctx)
+	if profile := s.ModelMapper.GetActiveProfile(apiKey); profile != nil && profile.RoutingStrategy != "" {
+		channels = s.ChannelService.OrderChannels(req, channels, profile.RoutingStrategy)
+
+		log.Debug(ctx, "ordered channels by the routing strategy", log.String("strategy", profile.RoutingStrategy))
+	}
+
+	routed, rule := s.ChannelService.ApplyRoutingRules(req, channels)
+	if rule != nil {
+		log.Debug(ctx, "applied routing rule",
+			log.String("rule", rule.Name),
+			log.String("action", rule.Action.String()),
+			log.Int("channel_count", len(routed)))
+	}
+
+	return routed, nil
+}
+
 // SpecifiedChannelSelector allows selecting specific channels (including disabled ones) for testing.
 type SpecifiedChannelSelector struct {
 	ChannelService *biz.ChannelService
@@ -2,31 +2,47 @@ package chat
 
 import (
 	"context"
+	"slices"
 
 	"github.com/looplj/axonhub/internal/contexts"
 	"github.com/looplj/axonhub/internal/llm/decorator"
+	"github.com/looplj/axonhub/internal/llm/decorator/policy"
 	"github.com/looplj/axonhub/internal/llm/decorator/stream"
 	"github.com/looplj/axonhub/internal/llm/pipeline"
 	"github.com/looplj/axonhub/internal/llm/transformer"
 	"github.com/looplj/axonhub/internal/log"
+	"github.com/looplj/axonhub/internal/objects"
 	"github.com/looplj/axonhub/internal/pkg/httpclient"
 	"github.com/looplj/axonhub/internal/pkg/streams"
+	"github.com/looplj/axonhub/internal/server/affinity"
 	"github.com/looplj/axonhub/internal/server/biz"
+	"github.com/looplj/axonhub/internal/server/cache"
 )
 
+// defaultMaxRetries is the number of the retries with the other channels of the requested model.
+const defaultMaxRetries = 3
+
 // NewChatCompletionProcessor creates a new ChatCompletionProcessor.
 func NewChatCompletionProcessor(
 	channelService *biz.ChannelService,
 	requestService *biz.RequestService,
 	httpClient *httpclient.HttpClient,
 	inbound transformer.Inbound,
+	responseCache cache.Store,
+	policyWebhook *policy.Webhook,
 ) *ChatCompletionProcessor {
-	return NewChatCompletionProcessorWithSelector(
-		NewDefaultChannelSelector(channelService),
+	processor := NewChatCompletionProcessorWithSelector(
+		NewRoutingChannelSelector(channelService),
 		requestService,
 		httpClient,
 		inbound,
+		responseCache,
+		policyWebhook,
 	)
+	processor.SessionAffinity = channelService.Affinity
+	processor.ChannelStats = channelService.Stats
+
+	return processor
 }
 
 func NewChatCompletionProcessorWithSelector(
@@ -34,14 +50,16 @@ func NewChatCompletionProcessorWithSelector(
 	requestService *biz.RequestService,
 	httpClient *httpclient.HttpClient,
 	inbound transformer.Inbound,
+	responseCache cache.Store,
+	policyWebhook *policy.Webhook,
 ) *ChatCompletionProcessor {
 	return &ChatCompletionProcessor{
 		ChannelSelector: channelSelector,
 		Inbound:         inbound,
 		RequestService:  requestService,
-		Decorators: []decorator.Decorator{
-			stream.EnsureUsage(),
-		},
+		ResponseCache:   responseCache,
+		Decorators:      []decorator.Decorator{stream.EnsureUsage()},
+		PolicyWebhook:   policyWebhook,
 		ModelMapper:     NewModelMapper(),
 		PipelineFactory: pipeline.NewFactory(httpClient),
 	}
@@ -51,14 +69,24 @@ type ChatCompletionProcessor struct {
 	ChannelSelector ChannelSelector
 	Inbound         transformer.Inbound
 	RequestService  *biz.RequestService
+	ResponseCache   cache.Store
 	Decorators      []decorator.Decorator
 	PipelineFactory *pipeline.Factory
 	ModelMapper     *ModelMapper
+	// PolicyWebhook checks the requests and the responses with the external policy endpoint, nil if it is disabled.
+	PolicyWebhook *policy.Webhook
+	// SessionAffinity pins the conversations to the channels which served them, nil if the session affinity is disabled.
+	SessionAffinity *affinity.Store
+	// ChannelStats record the health and the latency of the channels for the routing strategies.
+	ChannelStats *biz.ChannelStats
 }
 
 type ChatCompletionResult struct {
 	ChatCompletion       *httpclient.Response
 	ChatCompletionStream streams.Stream[*httpclient.StreamEvent]
+
+	// Model is the model which served the request, it differs from the requested model when a fallback model is used.
+	Model string
 }
 
 func (processor *ChatCompletionProcessor) Process(ctx context.Context, request *httpclient.Request) (ChatCompletionResult, error) {
@@ -78,12 +106,59 @@ func (processor *ChatCompletionProcessor) Process(ctx context.Context, request *
 		processor.ChannelSelector,
 	)
 
-	pipe := processor.PipelineFactory.Pipeline(
-		inbound,
-		outbound,
-		pipeline.WithRetry(3, 0),
+	var sessionID string
+	if processor.SessionAffinity != nil {
+		sessionID = request.Headers.Get(processor.SessionAffinity.Header())
+	}
+
+	profile := processor.ModelMapper.GetActiveProfile(apiKey)
+
+	if err := processor.setupRouting(ctx, inbound.state, profile, sessionID); err != nil {
+		return ChatCompletionResult{}, err
+	}
+
+	opts := []pipeline.Option{
+		pipeline.WithRetry(defaultMaxRetries, 0),
+		pipeline.WithRetryBudget(outbound.retryBudget),
 		pipeline.WithDecorators(processor.Decorators...),
-	)
+	}
+
+	hedgingPolicy, err := processor.RequestService.SystemService.HedgingPolicy(ctx)
+	if err != nil {
+		return ChatCompletionResult{}, err
+	}
+
+	if delay := biz.HedgeDelay(hedgingPolicy); delay > 0 {
+		opts = append(opts, pipeline.WithHedging(delay))
+	}
+
+	// The overrides of the active profile are applied before the guardrail, so the prepended prompts are checked too.
+	if profile != nil {
+		if overrides := biz.RequestOverrides(profile.Overrides); overrides != nil {
+			opts = append(opts, pipeline.WithDecorators(overrides))
+		}
+	}
+
+	guard, err := newGuardrail(ctx, processor.RequestService, apiKey, inbound.state)
+	if err != nil {
+		return ChatCompletionResult{}, err
+	}
+
+	if guard != nil {
+		opts = append(opts, pipeline.WithDecorators(guard))
+	}
+
+	// The policy webhook is applied last, so it checks the request after the overrides and the guardrail masking,
+	// and the response after the guardrail masking too.
+	if processor.PolicyWebhook != nil {
+		opts = append(opts, pipeline.WithDecorators(processor.PolicyWebhook))
+	}
+
+	if responseCache := newResponseCache(processor.ResponseCache, apiKey, request, inbound.state); responseCache != nil {
+		opts = append(opts, pipeline.WithResponseCache(responseCache))
+	}
+
+	pipe := processor.PipelineFactory.Pipeline(inbound, outbound, opts...)
 
 	result, err := pipe.Process(ctx, request)
 	if err != nil {
@@ -91,6 +166,8 @@ func (processor *ChatCompletionProcessor) Process(ctx context.Context, request *
 
 		// Update request status to failed when all retries are exhausted
 		if outbound != nil {
+			outbound.unpinSession()
+
 			persistCtx := context.WithoutCancel(ctx)
 
 			// Update the last request execution status based on error if it exists
@@ -98,7 +175,7 @@ func (processor *ChatCompletionProcessor) Process(ctx context.Context, request *
 			if outbound.GetRequestExecution() != nil {
 				if execUpdateErr := processor.RequestService.UpdateRequestExecutionStatusFromError(
 					persistCtx,
-					outbound.GetRequestExecution().ID,
+					outbound.GetRequestExecution(),
 					err,
 				); execUpdateErr != nil {
 					log.Warn(persistCtx, "Failed to update request execution status from error", log.Cause(execUpdateErr))
@@ -120,16 +197,48 @@ func (processor *ChatCompletionProcessor) Process(ctx context.Context, request *
 		return ChatCompletionResult{}, err
 	}
 
+	outbound.pinSession()
+
 	// Return result based on stream type
 	if result.Stream {
 		return ChatCompletionResult{
 			ChatCompletion:       nil,
 			ChatCompletionStream: result.EventStream,
+			Model:                outbound.ServedModel(),
 		}, nil
 	}
 
 	return ChatCompletionResult{
 		ChatCompletion:       result.Response,
 		ChatCompletionStream: nil,
+		Model:                outbound.ServedModel(),
 	}, nil
 }
+
+// setupRouting sets the fallback chains, the channel stats and the session affinity used to select the channels.
+func (processor *ChatCompletionProcessor) setupRouting(
+	ctx context.Context,
+	state *PersistenceState,
+	profile *objects.APIKeyProfile,
+	sessionID string,
+) error {
+	globalFallbacks, err := processor.RequestService.SystemService.ModelFallbacks(ctx)
+	if err != nil {
+		return err
+	}
+
+	var profileFallbacks []objects.ModelFallback
+	if profile != nil {
+		profileFallbacks = profile.ModelFallbacks
+	}
+
+	state.ModelFallbacks = append(slices.Clone(profileFallbacks), globalFallbacks...)
+	state.ChannelStats = processor.ChannelStats
+
+	if processor.SessionAffinity != nil {
+		state.SessionAffinity = processor.SessionAffinity
+		state.SessionID = sessionID
+	}
+
+	return nil
+}
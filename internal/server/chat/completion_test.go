@@ -0,0 +1,194 @@
+package chat
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/looplj/axonhub/internal/contexts"
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/channel"
+	"github.com/looplj/axonhub/internal/ent/privacy"
+	"github.com/looplj/axonhub/internal/llm/transformer/openai"
+	"github.com/looplj/axonhub/internal/objects"
+	"github.com/looplj/axonhub/internal/pkg/httpclient"
+	"github.com/looplj/axonhub/internal/server/biz"
+	"github.com/looplj/axonhub/internal/server/db"
+	"github.com/looplj/axonhub/internal/server/persist"
+)
+
+// processorTestEnv holds the database, the user and the services shared by the processor tests.
+type processorTestEnv struct {
+	t              *testing.T
+	ctx            context.Context
+	client         *ent.Client
+	user           *ent.User
+	systemService  *biz.SystemService
+	requestService *biz.RequestService
+}
+
+func newProcessorTestEnv(t *testing.T) *processorTestEnv {
+	t.Helper()
+
+	client := db.NewEntClient(db.Config{
+		Dialect: "sqlite3",
+		DSN:     "file:ent?mode=memory&_fk=1",
+	})
+	t.Cleanup(func() { _ = client.Close() })
+
+	ctx := ent.NewContext(t.Context(), client)
+	ctx = privacy.DecisionContext(ctx, privacy.Allow)
+
+	user, err := client.User.Create().SetEmail("user@example.com").SetPassword("password").Save(ctx)
+	require.NoError(t, err)
+
+	writer := persist.NewWriter(persist.Config{}, client)
+	systemService := &biz.SystemService{}
+
+	return &processorTestEnv{
+		t:              t,
+		ctx:            ctx,
+		client:         client,
+		user:           user,
+		systemService:  systemService,
+		requestService: biz.NewRequestService(systemService, biz.NewUsageLogService(systemService, writer, nil), writer),
+	}
+}
+
+// newUpstream starts the upstream server, it is closed when the test ends.
+func (env *processorTestEnv) newUpstream(handler http.HandlerFunc) *httptest.Server {
+	server := httptest.NewServer(handler)
+	env.t.Cleanup(server.Close)
+
+	return server
+}
+
+// createAPIKey creates the API key of the user, the options are applied before the key is saved.
+func (env *processorTestEnv) createAPIKey(name string, options ...func(*ent.APIKeyCreate)) *ent.APIKey {
+	env.t.Helper()
+
+	create := env.client.APIKey.Create().SetName(name).SetKey(name).SetUserID(env.user.ID)
+	for _, option := range options {
+		option(create)
+	}
+
+	apiKey, err := create.Save(env.ctx)
+	require.NoError(env.t, err)
+
+	return apiKey
+}
+
+// createChannel creates the OpenAI channel serving the models from the base URL.
+func (env *processorTestEnv) createChannel(name string, baseURL string, models ...string) *biz.Channel {
+	env.t.Helper()
+
+	entChannel, err := env.client.Channel.Create().
+		SetType(channel.TypeOpenai).
+		SetName(name).
+		SetBaseURL(baseURL).
+		SetSupportedModels(models).
+		SetDefaultTestModel(models[0]).
+		Save(env.ctx)
+	require.NoError(env.t, err)
+
+	outbound, err := openai.NewOutboundTransformer(baseURL, "test-key")
+	require.NoError(env.t, err)
+
+	return &biz.Channel{Channel: entChannel, Outbound: outbound}
+}
+
+// process sends the chat completion request of the user with the API key, the headers are added to the JSON content type.
+func (env *processorTestEnv) process(
+	processor *ChatCompletionProcessor,
+	apiKey *ent.APIKey,
+	body string,
+	headers http.Header,
+) (ChatCompletionResult, error) {
+	requestHeaders := http.Header{"Content-Type": []string{"application/json"}}
+	for key, values := range headers {
+		requestHeaders[key] = values
+	}
+
+	return processor.Process(contexts.WithUser(contexts.WithAPIKey(env.ctx, apiKey), env.user), &httpclient.Request{
+		Method:  http.MethodPost,
+		URL:     "/v1/chat/completions",
+		Headers: requestHeaders,
+		Body:    []byte(body),
+	})
+}
+
+// chatCompletionBody returns the upstream chat completion response with the content.
+func chatCompletionBody(model string, content string) []byte {
+	return []byte(`{"id":"chatcmpl-1","object":"chat.completion","created":1700000000,"model":"` + model + `",` +
+		`"choices":[{"index":0,"message":{"role":"assistant","content":"` + content + `"},"finish_reason":"stop"}],` +
+		`"usage":{"prompt_tokens":10,"completion_tokens":1,"total_tokens":11}}`)
+}
+
+func TestChatCompletionProcessor_ChannelStats(t *testing.T) {
+	env := newProcessorTestEnv(t)
+
+	server := env.newUpstream(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case strings.Contains(string(body), "invalid"):
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":{"message":"invalid request","type":"invalid_request_error"}}`))
+		case strings.Contains(string(body), "busy"):
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`{"error":{"message":"rate limited","type":"rate_limit_error"}}`))
+		default:
+			_, _ = w.Write(chatCompletionBody("gpt-4o", "Hello"))
+		}
+	})
+
+	apiKey := env.createAPIKey("stats", func(create *ent.APIKeyCreate) {
+		create.SetGuardrailPolicy(&objects.GuardrailPolicy{
+			Enabled: true,
+			Rules: []objects.GuardrailRule{
+				{Name: "codename", Type: "keyword", Patterns: []string{"Project X"}, Action: "reject", Target: "request"},
+			},
+		})
+	})
+
+	channelService := &biz.ChannelService{
+		Channels: []*biz.Channel{env.createChannel("openai", server.URL, "gpt-4o")},
+		Stats:    biz.NewChannelStats(),
+	}
+	channelID := channelService.Channels[0].ID
+
+	processor := NewChatCompletionProcessor(
+		channelService,
+		env.requestService,
+		httpclient.NewHttpClient(),
+		openai.NewInboundTransformer(),
+		nil,
+		nil,
+	)
+
+	process := func(content string) error {
+		_, err := env.process(processor, apiKey, `{"model":"gpt-4o","messages":[{"role":"user","content":"`+content+`"}]}`, nil)
+		return err
+	}
+
+	// The client errors and the guardrail rejections do not affect the health of the channel.
+	require.Error(t, process("invalid"))
+	require.Error(t, process("What is project x?"))
+	require.Equal(t, 1.0, channelService.Stats.Health(channelID))
+	require.Zero(t, channelService.Stats.Latency(channelID))
+
+	require.NoError(t, process("Hi"))
+	require.Equal(t, 1.0, channelService.Stats.Health(channelID))
+	require.Positive(t, channelService.Stats.Latency(channelID))
+
+	// The rate limited request is a failure of the channel, recorded once for the attempt.
+	require.Error(t, process("busy"))
+	require.InDelta(t, 0.8, channelService.Stats.Health(channelID), 1e-9)
+}
@@ -0,0 +1,104 @@
+package chat
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/looplj/axonhub/internal/contexts"
+	"github.com/looplj/axonhub/internal/llm"
+	"github.com/looplj/axonhub/internal/llm/tokenizer"
+	"github.com/looplj/axonhub/internal/log"
+	"github.com/looplj/axonhub/internal/pkg/httpclient"
+	"github.com/looplj/axonhub/internal/server/biz"
+)
+
+// tokenCountingOutbound is implemented by the outbound transformers of the providers supporting the count tokens API.
+type tokenCountingOutbound interface {
+	TransformCountTokensRequest(ctx context.Context, chatReq *llm.Request) (*httpclient.Request, error)
+	TransformCountTokensResponse(ctx context.Context, resp *httpclient.Response) (int64, error)
+}
+
+// TokenCounter counts the input tokens of the requests.
+type TokenCounter struct {
+	ChannelService *biz.ChannelService
+	HttpClient     *httpclient.HttpClient
+	Tokenizers     *tokenizer.Registry
+	ModelMapper    *ModelMapper
+}
+
+func NewTokenCounter(channelService *biz.ChannelService, httpClient *httpclient.HttpClient, tokenizers *tokenizer.Registry) *TokenCounter {
+	return &TokenCounter{
+		ChannelService: channelService,
+		HttpClient:     httpClient,
+		Tokenizers:     tokenizers,
+		ModelMapper:    NewModelMapper(),
+	}
+}
+
+// CountTokens counts the input tokens of the request.
+// The request is proxied to the channel selected for the model if the provider supports the count tokens API,
+// otherwise, or if the proxied request fails, the tokens are counted locally.
+// The model is mapped and checked with the API key of the context, the same as the message requests.
+func (c *TokenCounter) CountTokens(ctx context.Context, req *llm.Request) (int64, error) {
+	apiKey, _ := contexts.GetAPIKey(ctx)
+
+	model, err := c.ModelMapper.ResolveModel(ctx, apiKey, req.Model)
+	if err != nil {
+		return 0, err
+	}
+
+	mappedReq := *req
+	mappedReq.Model = model
+	req = &mappedReq
+
+	channels, err := c.ChannelService.ChooseChannels(ctx, req)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(channels) > 0 {
+		count, err := c.countByChannel(ctx, channels[0], req)
+		if err == nil {
+			return count, nil
+		}
+
+		log.Debug(ctx, "failed to count tokens by channel, fallback to local tokenizer",
+			log.String("channel", channels[0].Name),
+			log.Cause(err),
+		)
+	}
+
+	return int64(tokenizer.CountRequest(c.Tokenizers.ForModel(req.Model), req)), nil
+}
+
+func (c *TokenCounter) countByChannel(ctx context.Context, channel *biz.Channel, req *llm.Request) (int64, error) {
+	outbound, ok := channel.Outbound.(tokenCountingOutbound)
+	if !ok {
+		return 0, fmt.Errorf("channel type %s does not support counting tokens", channel.Type)
+	}
+
+	model, err := channel.ChooseModel(req.Model)
+	if err != nil {
+		return 0, err
+	}
+
+	channelReq := *req
+	channelReq.Model = model
+
+	httpReq, err := outbound.TransformCountTokensRequest(ctx, &channelReq)
+	if err != nil {
+		return 0, err
+	}
+
+	client := c.HttpClient
+	if channel.Executor != nil {
+		client = channel.Executor
+	}
+
+	httpResp, err := client.Do(ctx, httpReq)
+	if err != nil {
+		return 0, err
+	}
+
+	return outbound.TransformCountTokensResponse(ctx, httpResp)
+}
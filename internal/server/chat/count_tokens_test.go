@@ -0,0 +1,130 @@
+package chat
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/looplj/axonhub/internal/contexts"
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/channel"
+	"github.com/looplj/axonhub/internal/llm"
+	"github.com/looplj/axonhub/internal/llm/tokenizer"
+	"github.com/looplj/axonhub/internal/llm/transformer/anthropic"
+	"github.com/looplj/axonhub/internal/llm/transformer/openai"
+	"github.com/looplj/axonhub/internal/objects"
+	"github.com/looplj/axonhub/internal/pkg/httpclient"
+	"github.com/looplj/axonhub/internal/server/biz"
+)
+
+func TestTokenCounter_CountTokens(t *testing.T) {
+	var received map[string]any
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/messages/count_tokens" || r.Header.Get("X-Api-Key") != "test-key" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(body, &received)
+
+		_, _ = w.Write([]byte(`{"input_tokens":42}`))
+	}))
+	defer server.Close()
+
+	anthropicOutbound, err := anthropic.NewOutboundTransformer(server.URL, "test-key")
+	require.NoError(t, err)
+
+	openaiOutbound, err := openai.NewOutboundTransformer(server.URL, "test-key")
+	require.NoError(t, err)
+
+	channelService := &biz.ChannelService{
+		Channels: []*biz.Channel{
+			{
+				Channel: &ent.Channel{
+					Name:            "anthropic",
+					Type:            channel.TypeAnthropic,
+					SupportedModels: []string{"claude-sonnet-4-20250514"},
+					Settings: &objects.ChannelSettings{
+						ModelMappings: []objects.ModelMapping{{From: "claude-sonnet", To: "claude-sonnet-4-20250514"}},
+					},
+				},
+				Outbound: anthropicOutbound,
+			},
+			{
+				Channel: &ent.Channel{
+					Name:            "openai",
+					Type:            channel.TypeOpenai,
+					SupportedModels: []string{"gpt-4o"},
+				},
+				Outbound: openaiOutbound,
+			},
+		},
+	}
+
+	counter := NewTokenCounter(channelService, httpclient.NewHttpClient(), nil)
+
+	newRequest := func(model string) *llm.Request {
+		return &llm.Request{
+			Model: model,
+			Messages: []llm.Message{
+				{Role: "user", Content: llm.MessageContent{Content: lo.ToPtr("Hello, Claude")}},
+			},
+		}
+	}
+
+	t.Run("proxy to anthropic channel", func(t *testing.T) {
+		count, err := counter.CountTokens(context.Background(), newRequest("claude-sonnet"))
+		require.NoError(t, err)
+		require.Equal(t, int64(42), count)
+		require.Equal(t, "claude-sonnet-4-20250514", received["model"])
+		require.NotContains(t, received, "max_tokens")
+	})
+
+	t.Run("count locally for other channels", func(t *testing.T) {
+		req := newRequest("gpt-4o")
+
+		count, err := counter.CountTokens(context.Background(), req)
+		require.NoError(t, err)
+		require.Equal(t, int64(tokenizer.CountRequest((*tokenizer.Registry)(nil).ForModel("gpt-4o"), req)), count)
+	})
+
+	t.Run("map and check the model with the api key", func(t *testing.T) {
+		apiKey := &ent.APIKey{
+			Name:          "restricted",
+			AllowedModels: []string{"claude-sonnet-4-20250514"},
+			Profiles: &objects.APIKeyProfiles{
+				ActiveProfile: "default",
+				Profiles: []objects.APIKeyProfile{{
+					Name:          "default",
+					ModelMappings: []objects.ModelMapping{{From: "sonnet", To: "claude-sonnet-4-20250514"}},
+				}},
+			},
+		}
+		ctx := contexts.WithAPIKey(context.Background(), apiKey)
+
+		count, err := counter.CountTokens(ctx, newRequest("sonnet"))
+		require.NoError(t, err)
+		require.Equal(t, int64(42), count)
+		require.Equal(t, "claude-sonnet-4-20250514", received["model"])
+
+		_, err = counter.CountTokens(ctx, newRequest("gpt-4o"))
+
+		var respErr *llm.ResponseError
+		require.ErrorAs(t, err, &respErr)
+		require.Equal(t, http.StatusForbidden, respErr.StatusCode)
+	})
+
+	t.Run("count locally for unknown models", func(t *testing.T) {
+		count, err := counter.CountTokens(context.Background(), newRequest("unknown"))
+		require.NoError(t, err)
+		require.Positive(t, count)
+	})
+}
@@ -0,0 +1,91 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/samber/lo"
+
+	"github.com/looplj/axonhub/internal/contexts"
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/pkg/httpclient"
+	"github.com/looplj/axonhub/internal/server/biz"
+)
+
+// RoutingExplanation describes the channels a request would be sent to.
+type RoutingExplanation struct {
+	// Model is the requested model after the model mapping of the API key.
+	Model string
+	// EstimatedPromptTokens is the prompt tokens of the request used to match the routing rules.
+	EstimatedPromptTokens int
+	// Candidates are the enabled channels supporting the first selected model before the routing strategy and rules are applied.
+	Candidates []*biz.Channel
+	// MatchedRule is the routing rule applied to the candidates, nil if no rule matches.
+	MatchedRule *ent.RoutingRule
+	// Channels are the channels the request would be sent to in order.
+	Channels []*biz.Channel
+	// Models are the models requested from the channels, one for each channel.
+	Models []string
+}
+
+// ExplainRouting selects the channels of the request the same way as Process without sending the request,
+// so the explanation covers the model mapping, the virtual models, the fallback chains, the routing strategy,
+// the routing rules and the session affinity.
+func (processor *ChatCompletionProcessor) ExplainRouting(
+	ctx context.Context,
+	request *httpclient.Request,
+	sessionID string,
+) (*RoutingExplanation, error) {
+	apiKey, _ := contexts.GetAPIKey(ctx)
+
+	llmRequest, err := processor.Inbound.TransformRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	llmRequest.Model, err = processor.ModelMapper.ResolveModel(ctx, apiKey, llmRequest.Model)
+	if err != nil {
+		return nil, err
+	}
+
+	_, outbound := NewPersistentTransformersWithSelector(
+		ctx,
+		processor.Inbound,
+		processor.RequestService,
+		apiKey,
+		nil,
+		request,
+		processor.ModelMapper,
+		processor.ChannelSelector,
+	)
+
+	if err := processor.setupRouting(ctx, outbound.state, processor.ModelMapper.GetActiveProfile(apiKey), sessionID); err != nil {
+		return nil, err
+	}
+
+	if err := outbound.selectChannels(ctx, llmRequest); err != nil {
+		return nil, err
+	}
+
+	explanation := &RoutingExplanation{
+		Model:    llmRequest.Model,
+		Channels: outbound.state.Channels,
+		Models:   outbound.state.Models,
+	}
+
+	// The prompt tokens, the candidates and the matched rule are only known to the routing channel selector.
+	if selector, ok := processor.ChannelSelector.(*RoutingChannelSelector); ok {
+		explanation.EstimatedPromptTokens = selector.ChannelService.EstimatePromptTokens(llmRequest)
+
+		req := *llmRequest
+		req.Model = lo.FirstOrEmpty(outbound.state.Models)
+
+		explanation.Candidates, err = selector.ChannelService.ChooseChannels(ctx, &req)
+		if err != nil {
+			return nil, err
+		}
+
+		_, explanation.MatchedRule = selector.ChannelService.ApplyRoutingRules(&req, explanation.Candidates)
+	}
+
+	return explanation, nil
+}
@@ -0,0 +1,103 @@
+package chat
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/request"
+	"github.com/looplj/axonhub/internal/llm/transformer/openai"
+	"github.com/looplj/axonhub/internal/objects"
+	"github.com/looplj/axonhub/internal/pkg/httpclient"
+	"github.com/looplj/axonhub/internal/server/biz"
+)
+
+func TestChatCompletionProcessor_ModelFallback(t *testing.T) {
+	env := newProcessorTestEnv(t)
+
+	var upstreamModels []string
+
+	server := env.newUpstream(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		model := gjson.GetBytes(body, "model").String()
+		upstreamModels = append(upstreamModels, model)
+
+		w.Header().Set("Content-Type", "application/json")
+
+		if model == "gpt-4o" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte(`{"error":{"message":"overloaded","type":"server_error"}}`))
+
+			return
+		}
+
+		_, _ = w.Write(chatCompletionBody(model, "Hello"))
+	})
+
+	apiKey := env.createAPIKey("fallback", func(create *ent.APIKeyCreate) {
+		create.SetProfiles(&objects.APIKeyProfiles{
+			ActiveProfile: "default",
+			Profiles: []objects.APIKeyProfile{{
+				Name:           "default",
+				ModelFallbacks: []objects.ModelFallback{{Model: "gpt-4o", Fallbacks: []string{"gpt-4o-mini"}}},
+			}},
+		})
+	})
+
+	var channels []*biz.Channel
+
+	// The fallback model is reached even if the requested model has more channels than the default retries.
+	for i, model := range []string{"gpt-4o", "gpt-4o", "gpt-4o", "gpt-4o", "gpt-4o-mini"} {
+		channels = append(channels, env.createChannel(fmt.Sprintf("%s-%d", model, i), server.URL, model))
+	}
+
+	channelService := &biz.ChannelService{Channels: channels, Ent: env.client}
+
+	processor := NewChatCompletionProcessor(
+		channelService,
+		env.requestService,
+		httpclient.NewHttpClient(),
+		openai.NewInboundTransformer(),
+		nil,
+		nil,
+	)
+
+	process := func(model string) ChatCompletionResult {
+		result, err := env.process(processor, apiKey, `{"model":"`+model+`","messages":[{"role":"user","content":"Hi"}]}`, nil)
+		require.NoError(t, err)
+
+		return result
+	}
+
+	result := process("gpt-4o")
+	require.Equal(t, "gpt-4o-mini", result.Model)
+	require.Equal(t, []string{"gpt-4o", "gpt-4o", "gpt-4o", "gpt-4o", "gpt-4o-mini"}, upstreamModels)
+
+	req, err := env.client.Request.Query().Order(ent.Desc(request.FieldID)).First(env.ctx)
+	require.NoError(t, err)
+	require.Equal(t, request.StatusCompleted, req.Status)
+	require.Equal(t, []objects.FallbackHop{{From: "gpt-4o", To: "gpt-4o-mini"}}, req.FallbackHops)
+
+	// Switching between the targets of a virtual model is not a fallback.
+	_, err = env.client.VirtualModel.Create().
+		SetName("team-default").
+		SetTargets([]objects.VirtualModelTarget{{Model: "gpt-4o", Priority: 1}, {Model: "gpt-4o-mini"}}).
+		Save(env.ctx)
+	require.NoError(t, err)
+	require.NoError(t, channelService.LoadVirtualModels(env.ctx))
+
+	upstreamModels = nil
+	result = process("team-default")
+	require.Equal(t, "gpt-4o-mini", result.Model)
+	require.Equal(t, []string{"gpt-4o", "gpt-4o", "gpt-4o", "gpt-4o", "gpt-4o-mini"}, upstreamModels)
+
+	req, err = env.client.Request.Query().Order(ent.Desc(request.FieldID)).First(env.ctx)
+	require.NoError(t, err)
+	require.Equal(t, request.StatusCompleted, req.Status)
+	require.Empty(t, req.FallbackHops)
+}
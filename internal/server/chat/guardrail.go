@@ -0,0 +1,63 @@
+package chat
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/llm/decorator"
+	"github.com/looplj/axonhub/internal/llm/decorator/guardrail"
+	"github.com/looplj/axonhub/internal/log"
+	"github.com/looplj/axonhub/internal/objects"
+	"github.com/looplj/axonhub/internal/server/biz"
+)
+
+// newGuardrail returns the guardrail decorator of the global and the API key guardrail policies,
+// nil if no rule is enabled. The violations are recorded on the request of the state.
+func newGuardrail(
+	ctx context.Context,
+	requestService *biz.RequestService,
+	apiKey *ent.APIKey,
+	state *PersistenceState,
+) (decorator.Decorator, error) {
+	policy, err := requestService.SystemService.GuardrailPolicy(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := biz.GuardrailRules(policy)
+	if apiKey != nil {
+		rules = append(rules, biz.GuardrailRules(apiKey.GuardrailPolicy)...)
+	}
+
+	if len(rules) == 0 {
+		return nil, nil
+	}
+
+	dec, err := guardrail.New(guardrail.Config{
+		Rules: rules,
+		OnViolation: func(ctx context.Context, violation guardrail.Violation) {
+			log.Debug(ctx, "guardrail rule violated", log.String("rule", violation.Rule), log.String("target", string(violation.Target)))
+
+			if state.Request == nil {
+				return
+			}
+
+			err := requestService.AppendRequestGuardrailViolations(ctx, state.Request.ID, objects.GuardrailViolation{
+				Rule:   violation.Rule,
+				Type:   string(violation.Type),
+				Action: string(violation.Action),
+				Target: string(violation.Target),
+				Count:  violation.Count,
+			})
+			if err != nil {
+				log.Warn(ctx, "Failed to record guardrail violations", log.Cause(err))
+			}
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create guardrail: %w", err)
+	}
+
+	return dec, nil
+}
@@ -0,0 +1,98 @@
+package chat
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/request"
+	"github.com/looplj/axonhub/internal/llm"
+	"github.com/looplj/axonhub/internal/llm/transformer/openai"
+	"github.com/looplj/axonhub/internal/objects"
+	"github.com/looplj/axonhub/internal/pkg/httpclient"
+	"github.com/looplj/axonhub/internal/server/biz"
+)
+
+func TestChatCompletionProcessor_Guardrail(t *testing.T) {
+	env := newProcessorTestEnv(t)
+
+	var upstreamBody string
+
+	server := env.newUpstream(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		upstreamBody = string(body)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(chatCompletionBody("gpt-4o", "Call 415-555-2671"))
+	})
+
+	apiKey := env.createAPIKey("guarded", func(create *ent.APIKeyCreate) {
+		create.SetGuardrailPolicy(&objects.GuardrailPolicy{
+			Enabled: true,
+			Rules: []objects.GuardrailRule{
+				{Name: "codename", Type: "keyword", Patterns: []string{"Project X"}, Action: "reject", Target: "request"},
+			},
+		})
+	})
+
+	require.Error(t, env.systemService.SetGuardrailPolicy(env.ctx, &objects.GuardrailPolicy{
+		Enabled: true,
+		Rules:   []objects.GuardrailRule{{Type: "regex", Patterns: []string{"("}}},
+	}))
+
+	require.NoError(t, env.systemService.SetGuardrailPolicy(env.ctx, &objects.GuardrailPolicy{
+		Enabled: true,
+		Rules: []objects.GuardrailRule{
+			{Type: "email", Target: "request"},
+			{Type: "phone", Target: "response"},
+		},
+	}))
+
+	processor := NewChatCompletionProcessor(
+		&biz.ChannelService{
+			Channels: []*biz.Channel{env.createChannel("openai", server.URL, "gpt-4o")},
+		},
+		env.requestService,
+		httpclient.NewHttpClient(),
+		openai.NewInboundTransformer(),
+		nil,
+		nil,
+	)
+
+	process := func(body string) (ChatCompletionResult, error) {
+		return env.process(processor, apiKey, body, nil)
+	}
+
+	result, err := process(`{"model":"gpt-4o","messages":[{"role":"user","content":"Email john@example.com"}]}`)
+	require.NoError(t, err)
+	require.Contains(t, upstreamBody, `Email [EMAIL]`)
+	require.NotContains(t, upstreamBody, "john@example.com")
+	require.Contains(t, string(result.ChatCompletion.Body), `"content":"Call [PHONE]"`)
+
+	req, err := env.client.Request.Query().Order(ent.Desc(request.FieldID)).First(env.ctx)
+	require.NoError(t, err)
+	require.Equal(t, []objects.GuardrailViolation{
+		{Rule: "email", Type: "email", Action: "mask", Target: "request", Count: 1},
+		{Rule: "phone", Type: "phone", Action: "mask", Target: "response", Count: 1},
+	}, req.GuardrailViolations)
+
+	// The request violating the reject rule of the API key is not sent to the channel.
+	upstreamBody = ""
+
+	_, err = process(`{"model":"gpt-4o","messages":[{"role":"user","content":"What is project x?"}]}`)
+
+	var respErr *llm.ResponseError
+	require.ErrorAs(t, err, &respErr)
+	require.Equal(t, "guardrail_violation", respErr.Detail.Code)
+	require.Empty(t, upstreamBody)
+
+	req, err = env.client.Request.Query().Order(ent.Desc(request.FieldID)).First(env.ctx)
+	require.NoError(t, err)
+	require.Equal(t, request.StatusFailed, req.Status)
+	require.Equal(t, []objects.GuardrailViolation{
+		{Rule: "codename", Type: "keyword", Action: "reject", Target: "request", Count: 1},
+	}, req.GuardrailViolations)
+}
@@ -0,0 +1,123 @@
+package chat
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/request"
+	"github.com/looplj/axonhub/internal/ent/requestexecution"
+	"github.com/looplj/axonhub/internal/llm/transformer/openai"
+	"github.com/looplj/axonhub/internal/objects"
+	"github.com/looplj/axonhub/internal/pkg/httpclient"
+	"github.com/looplj/axonhub/internal/server/biz"
+)
+
+func TestChatCompletionProcessor_Hedging(t *testing.T) {
+	// The slow channel does not respond, the stalled channel responds with the headers but not the first event.
+	for _, primary := range []string{"slow", "stalled"} {
+		t.Run(primary, func(t *testing.T) {
+			testHedging(t, primary)
+		})
+	}
+}
+
+func testHedging(t *testing.T, primary string) {
+	env := newProcessorTestEnv(t)
+
+	server := env.newUpstream(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.ReadAll(r.Body)
+
+		w.Header().Set("Content-Type", "text/event-stream")
+
+		// The primary channel does not send the first event until the hedged request cancels it.
+		if strings.HasPrefix(r.URL.Path, "/"+primary) {
+			if primary == "stalled" {
+				w.WriteHeader(http.StatusOK)
+				w.(http.Flusher).Flush()
+			}
+
+			select {
+			case <-r.Context().Done():
+			case <-time.After(5 * time.Second):
+			}
+
+			return
+		}
+
+		_, _ = w.Write([]byte(`data: {"id":"chatcmpl-1","object":"chat.completion.chunk","created":1700000000,"model":"gpt-4o",` +
+			`"choices":[{"index":0,"delta":{"role":"assistant","content":"Hello"},"finish_reason":null}]}` + "\n\n"))
+		_, _ = w.Write([]byte(`data: {"id":"chatcmpl-1","object":"chat.completion.chunk","created":1700000000,"model":"gpt-4o",` +
+			`"choices":[{"index":0,"delta":{},"finish_reason":"stop"}],` +
+			`"usage":{"prompt_tokens":10,"completion_tokens":1,"total_tokens":11}}` + "\n\n"))
+		_, _ = w.Write([]byte("data: [DONE]\n\n"))
+	})
+
+	apiKey := env.createAPIKey("hedging")
+
+	channels := []*biz.Channel{
+		env.createChannel(primary, server.URL+"/"+primary+"/v1", "gpt-4o"),
+		env.createChannel("fast", server.URL+"/fast/v1", "gpt-4o"),
+	}
+
+	require.NoError(t, env.systemService.SetHedgingPolicy(env.ctx, &objects.HedgingPolicy{Enabled: true, DelayMs: 50}))
+
+	// The chunks are stored, so the chunks of the attempts are checked to land on their own executions.
+	storagePolicy, err := env.systemService.StoragePolicy(env.ctx)
+	require.NoError(t, err)
+
+	storagePolicy.StoreChunks = true
+	require.NoError(t, env.systemService.SetStoragePolicy(env.ctx, storagePolicy))
+
+	channelService := &biz.ChannelService{Channels: channels, Stats: biz.NewChannelStats()}
+
+	processor := NewChatCompletionProcessor(
+		channelService,
+		env.requestService,
+		httpclient.NewHttpClient(),
+		openai.NewInboundTransformer(),
+		nil,
+		nil,
+	)
+
+	result, err := env.process(processor, apiKey, `{"model":"gpt-4o","stream":true,"messages":[{"role":"user","content":"Hi"}]}`, nil)
+	require.NoError(t, err)
+	require.NotNil(t, result.ChatCompletionStream)
+
+	var events int
+	for result.ChatCompletionStream.Next() {
+		events++
+	}
+
+	require.NoError(t, result.ChatCompletionStream.Err())
+	require.NoError(t, result.ChatCompletionStream.Close())
+	require.Positive(t, events)
+
+	req, err := env.client.Request.Query().Order(ent.Desc(request.FieldID)).First(env.ctx)
+	require.NoError(t, err)
+	require.Equal(t, channels[1].ID, req.ChannelID)
+
+	require.Eventually(t, func() bool {
+		executions, err := env.client.RequestExecution.Query().
+			Where(requestexecution.RequestID(req.ID)).
+			Order(ent.Asc(requestexecution.FieldID)).
+			All(env.ctx)
+		require.NoError(t, err)
+
+		return len(executions) == 2 &&
+			executions[0].ChannelID == channels[0].ID && executions[0].Status == requestexecution.StatusCanceled &&
+			len(executions[0].ResponseChunks) == 0 &&
+			executions[1].ChannelID == channels[1].ID && executions[1].Status == requestexecution.StatusCompleted &&
+			len(executions[1].ResponseChunks) > 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// The canceled attempt does not affect the stats of the channels.
+	require.Equal(t, 1.0, channelService.Stats.Health(channels[0].ID))
+	require.Zero(t, channelService.Stats.Latency(channels[0].ID))
+	require.Positive(t, channelService.Stats.Latency(channels[1].ID))
+}
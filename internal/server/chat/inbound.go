@@ -147,18 +147,11 @@ func (p *PersistentInboundTransformer) TransformRequest(ctx context.Context, req
 		return nil, err
 	}
 
-	// Apply model mapping from API key profiles if active profile exists
-	if p.state.APIKey != nil {
-		originalModel := llmRequest.Model
-		mappedModel := p.state.ModelMapper.MapModel(ctx, p.state.APIKey, originalModel)
-
-		if mappedModel != originalModel {
-			llmRequest.Model = mappedModel
-			log.Debug(ctx, "Applied model mapping from API key profile",
-				log.String("api_key_name", p.state.APIKey.Name),
-				log.String("original_model", originalModel),
-				log.String("mapped_model", mappedModel))
-		}
+	// Apply model mapping from API key profiles if active profile exists,
+	// and reject the model not allowed by the API key before selecting the channels.
+	llmRequest.Model, err = p.state.ModelMapper.ResolveModel(ctx, p.state.APIKey, llmRequest.Model)
+	if err != nil {
+		return nil, err
 	}
 
 	if p.state.Request == nil {
@@ -2,6 +2,8 @@ package chat
 
 import (
 	"context"
+	"fmt"
+	"net/http"
 	"regexp"
 	"strings"
 	"sync"
@@ -9,6 +11,7 @@ import (
 	"github.com/samber/lo"
 
 	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/llm"
 	"github.com/looplj/axonhub/internal/log"
 	"github.com/looplj/axonhub/internal/objects"
 )
@@ -78,6 +81,39 @@ func (m *ModelMapper) MapModel(ctx context.Context, apiKey *ent.APIKey, original
 	return mappedModel
 }
 
+// ResolveModel maps the model with the active profile of the API key,
+// and returns the permission error if the mapped model is not allowed for the API key.
+func (m *ModelMapper) ResolveModel(ctx context.Context, apiKey *ent.APIKey, model string) (string, error) {
+	if apiKey == nil {
+		return model, nil
+	}
+
+	mappedModel := m.MapModel(ctx, apiKey, model)
+	if !m.IsModelAllowed(apiKey, mappedModel) {
+		return "", &llm.ResponseError{
+			StatusCode: http.StatusForbidden,
+			Detail: llm.ErrorDetail{
+				Message: fmt.Sprintf("model %s is not allowed for the API key", mappedModel),
+				Type:    "permission_error",
+			},
+		}
+	}
+
+	return mappedModel, nil
+}
+
+// IsModelAllowed checks if the API key is allowed to request the model.
+// The allowed models support the same patterns as the model mappings, all models are allowed if not set.
+func (m *ModelMapper) IsModelAllowed(apiKey *ent.APIKey, model string) bool {
+	if apiKey == nil || len(apiKey.AllowedModels) == 0 {
+		return true
+	}
+
+	return lo.ContainsBy(apiKey.AllowedModels, func(pattern string) bool {
+		return m.matchesMapping(pattern, model)
+	})
+}
+
 // applyModelMapping applies model mappings from the given list
 // Returns the mapped model or the original if no mapping is found.
 func (m *ModelMapper) applyModelMapping(mappings []objects.ModelMapping, model string) string {
@@ -271,6 +271,54 @@ func TestModelMapper_Cache(t *testing.T) {
 	assert.Equal(t, 0, mapper.CacheSize())
 }
 
+func TestModelMapper_IsModelAllowed(t *testing.T) {
+	mapper := NewModelMapper()
+
+	tests := []struct {
+		name     string
+		apiKey   *ent.APIKey
+		model    string
+		expected bool
+	}{
+		{
+			name:     "nil api key",
+			apiKey:   nil,
+			model:    "gpt-4",
+			expected: true,
+		},
+		{
+			name:     "no allowed models",
+			apiKey:   &ent.APIKey{},
+			model:    "gpt-4",
+			expected: true,
+		},
+		{
+			name:     "exact match",
+			apiKey:   &ent.APIKey{AllowedModels: []string{"gpt-4o-mini"}},
+			model:    "gpt-4o-mini",
+			expected: true,
+		},
+		{
+			name:     "wildcard match",
+			apiKey:   &ent.APIKey{AllowedModels: []string{"gpt-4o-mini", "claude-3-5-haiku-*"}},
+			model:    "claude-3-5-haiku-20241022",
+			expected: true,
+		},
+		{
+			name:     "not allowed",
+			apiKey:   &ent.APIKey{AllowedModels: []string{"gpt-4o-mini", "claude-3-5-haiku-*"}},
+			model:    "gpt-4o",
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, mapper.IsModelAllowed(tt.apiKey, tt.model))
+		})
+	}
+}
+
 func TestModelMapper_GetActiveProfile(t *testing.T) {
 	mapper := NewModelMapper()
 
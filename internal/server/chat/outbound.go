@@ -3,14 +3,20 @@ package chat
 import (
 	"context"
 	"errors"
+	"net/http"
+	"time"
+
+	"github.com/samber/lo"
 
 	"github.com/looplj/axonhub/internal/ent"
 	"github.com/looplj/axonhub/internal/llm"
 	"github.com/looplj/axonhub/internal/llm/pipeline"
 	"github.com/looplj/axonhub/internal/llm/transformer"
 	"github.com/looplj/axonhub/internal/log"
+	"github.com/looplj/axonhub/internal/objects"
 	"github.com/looplj/axonhub/internal/pkg/httpclient"
 	"github.com/looplj/axonhub/internal/pkg/streams"
+	"github.com/looplj/axonhub/internal/pkg/xerrors"
 	"github.com/looplj/axonhub/internal/server/biz"
 )
 
@@ -28,9 +34,18 @@ type OutboundPersistentStream struct {
 	request     *ent.Request
 	requestExec *ent.RequestExecution
 
+	// llmRequest is the request sent to the channel, it is used to estimate the usage if the provider does not report it.
+	llmRequest *llm.Request
+
 	transformer    transformer.Outbound
 	responseChunks []*httpclient.StreamEvent
 	closed         bool
+
+	// channelStats record the time to the first event and the failure of the stream of the channel.
+	channelStats *biz.ChannelStats
+	channelID    int
+	startedAt    time.Time
+	started      bool
 }
 
 var _ streams.Stream[*httpclient.StreamEvent] = (*OutboundPersistentStream)(nil)
@@ -40,6 +55,7 @@ func NewOutboundPersistentStream(
 	stream streams.Stream[*httpclient.StreamEvent],
 	request *ent.Request,
 	requestExec *ent.RequestExecution,
+	llmRequest *llm.Request,
 	requestService *biz.RequestService,
 	usageLogService *biz.UsageLogService,
 	outboundTransformer transformer.Outbound,
@@ -49,6 +65,7 @@ func NewOutboundPersistentStream(
 		stream:          stream,
 		request:         request,
 		requestExec:     requestExec,
+		llmRequest:      llmRequest,
 		RequestService:  requestService,
 		UsageLogService: usageLogService,
 		transformer:     outboundTransformer,
@@ -58,7 +75,16 @@ func NewOutboundPersistentStream(
 }
 
 func (ts *OutboundPersistentStream) Next() bool {
-	return ts.stream.Next()
+	if !ts.stream.Next() {
+		return false
+	}
+
+	if !ts.started {
+		ts.started = true
+		ts.channelStats.RecordSuccess(ts.channelID, time.Since(ts.startedAt))
+	}
+
+	return true
 }
 
 func (ts *OutboundPersistentStream) Current() *httpclient.StreamEvent {
@@ -68,7 +94,7 @@ func (ts *OutboundPersistentStream) Current() *httpclient.StreamEvent {
 
 		err := ts.RequestService.AppendRequestExecutionChunk(
 			ts.ctx,
-			ts.requestExec.ID,
+			ts.requestExec,
 			event,
 		)
 		if err != nil {
@@ -95,12 +121,27 @@ func (ts *OutboundPersistentStream) Close() error {
 
 	streamErr := ts.stream.Err()
 	if streamErr != nil {
+		// The streams canceled by the clients or the hedged requests do not affect the health of the channel.
+		if !errors.Is(streamErr, context.Canceled) {
+			ts.channelStats.RecordFailure(ts.channelID)
+		}
+
 		// Use context without cancellation to ensure persistence even if client canceled
 		persistCtx := context.WithoutCancel(ctx)
 		if ts.requestExec != nil {
-			if err := ts.RequestService.UpdateRequestExecutionStatusFromError(persistCtx, ts.requestExec.ID, streamErr); err != nil {
+			if err := ts.RequestService.UpdateRequestExecutionStatusFromError(persistCtx, ts.requestExec, streamErr); err != nil {
 				log.Warn(persistCtx, "Failed to update request execution status from error", log.Cause(err))
 			}
+
+			// The tokens are consumed even if the stream is aborted.
+			if len(ts.responseChunks) > 0 {
+				responseBody, meta, err := ts.transformer.AggregateStreamChunks(persistCtx, ts.responseChunks)
+				if err != nil {
+					log.Warn(persistCtx, "Failed to aggregate chunks of aborted stream", log.Cause(err))
+				}
+
+				ts.createUsageLog(persistCtx, meta.Usage, responseBody)
+			}
 		}
 
 		return ts.stream.Close()
@@ -122,7 +163,7 @@ func (ts *OutboundPersistentStream) Close() error {
 
 		err = ts.RequestService.UpdateRequestExecutionCompleted(
 			persistCtx,
-			ts.requestExec.ID,
+			ts.requestExec,
 			meta.ID,
 			responseBody,
 		)
@@ -135,21 +176,53 @@ func (ts *OutboundPersistentStream) Close() error {
 		}
 
 		// Try to create usage log from aggregated response
-		if usage := meta.Usage; usage != nil {
-			_, err = ts.UsageLogService.CreateUsageLogFromRequest(persistCtx, ts.request, ts.requestExec, usage)
+		ts.createUsageLog(persistCtx, meta.Usage, responseBody)
+	}
+
+	return ts.stream.Close()
+}
+
+// createUsageLog creates the usage log with the usage reported by the provider,
+// or with the usage estimated from the request and the aggregated response if the provider did not report it.
+func (ts *OutboundPersistentStream) createUsageLog(ctx context.Context, usage *llm.Usage, responseBody []byte) {
+	if usage == nil {
+		var response *llm.Response
+
+		if len(responseBody) > 0 {
+			resp, err := ts.transformer.TransformResponse(ctx, &httpclient.Response{
+				StatusCode: http.StatusOK,
+				Body:       responseBody,
+			})
 			if err != nil {
-				log.Warn(persistCtx, "Failed to create usage log from request", log.Cause(err))
+				log.Warn(ctx, "Failed to transform aggregated response to estimate usage", log.Cause(err))
+			} else {
+				response = resp
 			}
 		}
+
+		usage = ts.UsageLogService.EstimateUsage(ts.llmRequest, response)
 	}
 
-	return ts.stream.Close()
+	if usage == nil {
+		return
+	}
+
+	err := ts.UsageLogService.CreateUsageLogFromRequest(ctx, ts.request, ts.requestExec, usage)
+	if err != nil {
+		log.Warn(ctx, "Failed to create usage log from request", log.Cause(err))
+	}
 }
 
 // PersistentOutboundTransformer wraps an outbound transformer with enhanced capabilities.
 type PersistentOutboundTransformer struct {
 	wrapped transformer.Outbound
 	state   *PersistenceState
+
+	// llmRequest is the request of the current attempt sent to the channel.
+	llmRequest *llm.Request
+
+	// startedAt is the time the current attempt is sent to the channel.
+	startedAt time.Time
 }
 
 // APIFormat returns the API format of the transformer.
@@ -164,21 +237,9 @@ func (p *PersistentOutboundTransformer) TransformError(ctx context.Context, rawE
 // Outbound transformer methods for enhanced version.
 func (p *PersistentOutboundTransformer) TransformRequest(ctx context.Context, llmRequest *llm.Request) (*httpclient.Request, error) {
 	if len(p.state.Channels) == 0 {
-		channels, err := p.state.ChannelSelector.Select(ctx, llmRequest)
-		if err != nil {
+		if err := p.selectChannels(ctx, llmRequest); err != nil {
 			return nil, err
 		}
-
-		log.Debug(ctx, "selected channels",
-			log.Any("channels", channels),
-			log.Any("model", llmRequest.Model),
-		)
-
-		if len(channels) == 0 {
-			return nil, biz.ErrInvalidModel
-		}
-
-		p.state.Channels = channels
 	}
 
 	// Select current channel for this attempt
@@ -196,7 +257,7 @@ func (p *PersistentOutboundTransformer) TransformRequest(ctx context.Context, ll
 		log.Any("model", llmRequest.Model),
 	)
 
-	model, err := p.state.CurrentChannel.ChooseModel(llmRequest.Model)
+	model, err := p.state.CurrentChannel.ChooseModel(p.state.Models[p.state.ChannelIndex])
 	if err != nil {
 		log.Error(ctx, "Failed to choose model", log.Cause(err))
 		return nil, err
@@ -204,11 +265,25 @@ func (p *PersistentOutboundTransformer) TransformRequest(ctx context.Context, ll
 
 	llmRequest.Model = model
 
+	for _, dec := range p.state.CurrentChannel.Decorators {
+		llmRequest, err = dec.DecorateRequest(ctx, llmRequest)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	channelRequest, err := p.wrapped.TransformRequest(ctx, llmRequest)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := p.state.CurrentChannel.PatchRequest(channelRequest, model); err != nil {
+		return nil, err
+	}
+
+	p.llmRequest = llmRequest
+	p.startedAt = time.Now()
+
 	if p.state.RequestExec == nil {
 		requestExec, err := p.state.RequestService.CreateRequestExecution(
 			ctx,
@@ -248,7 +323,7 @@ func (p *PersistentOutboundTransformer) TransformResponse(ctx context.Context, r
 			// Use context without cancellation to ensure persistence even if client canceled
 			persistCtx := context.WithoutCancel(ctx)
 
-			if innerErr := p.state.RequestService.UpdateRequestExecutionStatusFromError(persistCtx, p.state.RequestExec.ID, err); innerErr != nil {
+			if innerErr := p.state.RequestService.UpdateRequestExecutionStatusFromError(persistCtx, p.state.RequestExec, err); innerErr != nil {
 				log.Warn(persistCtx, "Failed to update request execution status from error", log.Cause(innerErr))
 			}
 		}
@@ -256,13 +331,15 @@ func (p *PersistentOutboundTransformer) TransformResponse(ctx context.Context, r
 		return nil, err
 	}
 
+	p.state.ChannelStats.RecordSuccess(p.state.CurrentChannel.ID, time.Since(p.startedAt))
+
 	if p.state.RequestExec != nil {
 		// Use context without cancellation to ensure persistence even if client canceled
 		persistCtx := context.WithoutCancel(ctx)
 
 		err = p.state.RequestService.UpdateRequestExecutionCompleted(
 			persistCtx,
-			p.state.RequestExec.ID,
+			p.state.RequestExec,
 			llmResp.ID,
 			response.Body,
 		)
@@ -275,9 +352,13 @@ func (p *PersistentOutboundTransformer) TransformResponse(ctx context.Context, r
 	// Use context without cancellation to ensure persistence even if client canceled
 	if p.state.Request != nil && llmResp != nil {
 		persistCtx := context.WithoutCancel(ctx)
+
 		usage := llmResp.Usage
+		if usage == nil {
+			usage = p.state.UsageLogService.EstimateUsage(p.llmRequest, llmResp)
+		}
 
-		_, err = p.state.UsageLogService.CreateUsageLogFromRequest(persistCtx, p.state.Request, p.state.RequestExec, usage)
+		err = p.state.UsageLogService.CreateUsageLogFromRequest(persistCtx, p.state.Request, p.state.RequestExec, usage)
 		if err != nil {
 			log.Warn(persistCtx, "Failed to create usage log from request", log.Cause(err))
 		}
@@ -292,10 +373,14 @@ func (p *PersistentOutboundTransformer) TransformStream(ctx context.Context, str
 		stream,
 		p.state.Request,
 		p.state.RequestExec,
+		p.llmRequest,
 		p.state.RequestService,
 		p.state.UsageLogService,
 		p.wrapped, // Pass the wrapped outbound transformer for chunk aggregation
 	)
+	persistentStream.channelStats = p.state.ChannelStats
+	persistentStream.channelID = p.state.CurrentChannel.ID
+	persistentStream.startedAt = p.startedAt
 
 	return p.wrapped.TransformStream(ctx, persistentStream)
 }
@@ -336,7 +421,7 @@ func (p *PersistentOutboundTransformer) NextChannel(ctx context.Context) error {
 
 		err := p.state.RequestService.UpdateRequestExecutionFailed(
 			persistCtx,
-			p.state.RequestExec.ID,
+			p.state.RequestExec,
 			"Channel request failed, switching to next channel",
 		)
 		if err != nil {
@@ -354,6 +439,11 @@ func (p *PersistentOutboundTransformer) NextChannel(ctx context.Context) error {
 	p.state.CurrentChannel = p.state.Channels[p.state.ChannelIndex]
 	p.wrapped = p.state.CurrentChannel.Outbound
 
+	// The targets of a virtual model are the same entry of the fallback chain, switching between them is not a fallback.
+	if from, to := p.state.Candidates[p.state.ChannelIndex-1], p.state.Candidates[p.state.ChannelIndex]; from != to {
+		p.recordFallbackHop(ctx, objects.FallbackHop{From: from, To: to})
+	}
+
 	log.Debug(ctx, "switching to next channel for retry",
 		log.Any("channel", p.state.CurrentChannel.Name),
 		log.Any("index", p.state.ChannelIndex))
@@ -361,21 +451,279 @@ func (p *PersistentOutboundTransformer) NextChannel(ctx context.Context) error {
 	return nil
 }
 
+// selectChannels selects the channels of the requested model followed by the channels of its fallback models,
+// the virtual models are resolved to the channels of their targets.
+func (p *PersistentOutboundTransformer) selectChannels(ctx context.Context, llmRequest *llm.Request) error {
+	type channelModel struct {
+		channelID int
+		model     string
+	}
+
+	var (
+		channels   []*biz.Channel
+		models     []string
+		candidates []string
+		seen       = make(map[channelModel]bool)
+		firstErr   error
+	)
+
+	chain := append([]string{llmRequest.Model}, biz.FallbackModels(llmRequest.Model, p.state.ModelFallbacks)...)
+
+	for i, candidate := range chain {
+		// The fallback models must be allowed by the API key too.
+		if i > 0 && p.state.APIKey != nil && !p.state.ModelMapper.IsModelAllowed(p.state.APIKey, candidate) {
+			log.Debug(ctx, "skip fallback model not allowed by the API key", log.String("model", candidate))
+			continue
+		}
+
+		for _, target := range p.resolveTargets(ctx, llmRequest, candidate) {
+			req := *llmRequest
+			req.Model = target.Model
+
+			selected, err := p.state.ChannelSelector.Select(ctx, &req)
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+				}
+
+				log.Warn(ctx, "failed to select channels", log.String("model", target.Model), log.Cause(err))
+
+				continue
+			}
+
+			log.Debug(ctx, "selected channels",
+				log.Any("channels", selected),
+				log.Any("model", target.Model),
+			)
+
+			for _, channel := range selected {
+				if target.ChannelID != nil && target.ChannelID.ID != channel.ID {
+					continue
+				}
+
+				// The targets of a virtual model may overlap, every channel is tried once for a model.
+				key := channelModel{channelID: channel.ID, model: target.Model}
+				if seen[key] {
+					continue
+				}
+
+				seen[key] = true
+				channels = append(channels, channel)
+				models = append(models, target.Model)
+				candidates = append(candidates, candidate)
+			}
+		}
+	}
+
+	if len(channels) == 0 {
+		if firstErr != nil {
+			return firstErr
+		}
+
+		return biz.ErrInvalidModel
+	}
+
+	p.state.Channels = channels
+	p.state.Models = models
+	p.state.Candidates = candidates
+
+	p.applySessionAffinity(ctx, llmRequest)
+
+	return nil
+}
+
+// resolveTargets returns the targets of the virtual model, or the model itself if it is not a virtual model.
+func (p *PersistentOutboundTransformer) resolveTargets(ctx context.Context, llmRequest *llm.Request, model string) []objects.VirtualModelTarget {
+	resolver, ok := p.state.ChannelSelector.(VirtualModelResolver)
+	if !ok {
+		return []objects.VirtualModelTarget{{Model: model}}
+	}
+
+	req := *llmRequest
+	req.Model = model
+
+	targets, ok := resolver.ResolveVirtualModel(ctx, &req)
+	if !ok {
+		return []objects.VirtualModelTarget{{Model: model}}
+	}
+
+	log.Debug(ctx, "resolved virtual model", log.String("model", model), log.Any("targets", targets))
+
+	return targets
+}
+
+func (p *PersistentOutboundTransformer) recordFallbackHop(ctx context.Context, hop objects.FallbackHop) {
+	log.Info(ctx, "falling back to the next model", log.String("from", hop.From), log.String("to", hop.To))
+
+	if p.state.Request == nil {
+		return
+	}
+
+	persistCtx := context.WithoutCancel(ctx)
+
+	if err := p.state.RequestService.AppendRequestFallbackHops(persistCtx, p.state.Request.ID, hop); err != nil {
+		log.Warn(persistCtx, "Failed to record fallback hops", log.Cause(err))
+	}
+}
+
+// ServedModel returns the requested model of the current channel, it is the fallback model
+// when the channels of the requested model failed.
+func (p *PersistentOutboundTransformer) ServedModel() string {
+	if p.state.CurrentChannel == nil {
+		return ""
+	}
+
+	return p.state.Models[p.state.ChannelIndex]
+}
+
+// retryBudget returns the number of the retries of the request, every selected channel gets one attempt
+// if the channels of multiple models are selected, so the fallback models and the targets of the virtual model
+// are not cut by the failed channels of the first model.
+func (p *PersistentOutboundTransformer) retryBudget() int {
+	if len(lo.Uniq(p.state.Models)) > 1 {
+		return max(defaultMaxRetries, len(p.state.Channels)-1)
+	}
+
+	return defaultMaxRetries
+}
+
 // HasMoreChannels returns true if there are more channels available for retry.
 func (p *PersistentOutboundTransformer) HasMoreChannels() bool {
 	return p.state.ChannelIndex+1 < len(p.state.Channels)
 }
 
+var _ pipeline.ChannelHedgeable = (*PersistentOutboundTransformer)(nil)
+
+// HedgeChannel returns the outbound transformer of the next channel for the hedged attempt,
+// it sends the request with its own request execution.
+func (p *PersistentOutboundTransformer) HedgeChannel(ctx context.Context) (transformer.Outbound, bool) {
+	if !p.HasMoreChannels() {
+		return nil, false
+	}
+
+	state := *p.state
+	state.ChannelIndex++
+	state.CurrentChannel = nil
+	state.RequestExec = nil
+
+	log.Debug(ctx, "hedging the request with the next channel", log.Any("channel", state.Channels[state.ChannelIndex].Name))
+
+	return &PersistentOutboundTransformer{state: &state}, true
+}
+
+// ResolveHedge continues with the channel of the winner attempt, and returns the function
+// to mark the request execution of the loser attempt.
+func (p *PersistentOutboundTransformer) ResolveHedge(ctx context.Context, winner, loser transformer.Outbound) func(ctx context.Context, cause error) {
+	var loserExec *ent.RequestExecution
+	if attempt, ok := loser.(*PersistentOutboundTransformer); ok {
+		loserExec = attempt.state.RequestExec
+	}
+
+	if hedge, ok := winner.(*PersistentOutboundTransformer); ok && hedge != p {
+		from := p.state.Candidates[p.state.ChannelIndex]
+
+		*p.state = *hedge.state
+		p.wrapped = hedge.wrapped
+		p.llmRequest = hedge.llmRequest
+		p.startedAt = hedge.startedAt
+
+		log.Debug(ctx, "hedged request won", log.Any("channel", p.state.CurrentChannel.Name))
+
+		if to := p.state.Candidates[p.state.ChannelIndex]; from != to {
+			p.recordFallbackHop(ctx, objects.FallbackHop{From: from, To: to})
+		}
+	}
+
+	// Both attempts updated the channel of the request, it is reset to the channel of the winner.
+	if p.state.Request != nil && p.state.CurrentChannel != nil {
+		persistCtx := context.WithoutCancel(ctx)
+
+		if err := p.state.RequestService.UpdateRequestChannelID(persistCtx, p.state.Request.ID, p.state.CurrentChannel.ID); err != nil {
+			log.Warn(persistCtx, "Failed to update request channel ID", log.Cause(err))
+		}
+	}
+
+	requestService := p.state.RequestService
+
+	return func(ctx context.Context, cause error) {
+		if loserExec == nil {
+			return
+		}
+
+		var err error
+		if cause != nil {
+			err = requestService.UpdateRequestExecutionStatusFromError(ctx, loserExec, cause)
+		} else {
+			err = requestService.UpdateRequestExecutionCanceled(ctx, loserExec, "Canceled, the hedged request was served by another channel")
+		}
+
+		if err != nil {
+			log.Warn(ctx, "Failed to update hedged request execution status", log.Cause(err))
+		}
+	}
+}
+
 // CustomizeExecutor customizes the executor for the current channel.
 // If the current channel has an executor, it will be used.
 // Otherwise, the default executor will be used.
 //
 // The customized executor will be used to execute the request.
-// e.g. the aws bedrock process need a custom executor to handle the request.
+// e.g. the aws bedrock process need a custom executor to handle the request,
+// and the channel with the transport settings uses its own HTTP client.
 func (p *PersistentOutboundTransformer) CustomizeExecutor(executor pipeline.Executor) pipeline.Executor {
+	if p.state.CurrentChannel.Executor != nil {
+		executor = p.state.CurrentChannel.Executor
+	}
+
 	if customExecutor, ok := p.state.CurrentChannel.Outbound.(pipeline.ChannelCustomizedExecutor); ok {
-		return customExecutor.CustomizeExecutor(executor)
+		executor = customExecutor.CustomizeExecutor(executor)
+	}
+
+	return &channelStatsExecutor{
+		Executor:  executor,
+		stats:     p.state.ChannelStats,
+		channelID: p.state.CurrentChannel.ID,
+	}
+}
+
+// channelStatsExecutor records the failures of the requests sent to the channel,
+// only the errors of the upstream count, the requests rejected before they are sent do not affect the health of the channel.
+type channelStatsExecutor struct {
+	pipeline.Executor
+
+	stats     *biz.ChannelStats
+	channelID int
+}
+
+func (e *channelStatsExecutor) Do(ctx context.Context, request *httpclient.Request) (*httpclient.Response, error) {
+	response, err := e.Executor.Do(ctx, request)
+	if err != nil && isChannelFailure(err) {
+		e.stats.RecordFailure(e.channelID)
+	}
+
+	return response, err
+}
+
+func (e *channelStatsExecutor) DoStream(ctx context.Context, request *httpclient.Request) (streams.Stream[*httpclient.StreamEvent], error) {
+	stream, err := e.Executor.DoStream(ctx, request)
+	if err != nil && isChannelFailure(err) {
+		e.stats.RecordFailure(e.channelID)
+	}
+
+	return stream, err
+}
+
+// isChannelFailure reports whether the error of the upstream request is a failure of the channel,
+// the 5xx and 429 responses and the transport errors. The other 4xx responses are caused by the requests,
+// and the requests canceled by the clients or the hedged requests are not failures.
+func isChannelFailure(err error) bool {
+	if errors.Is(err, context.Canceled) {
+		return false
+	}
+
+	if httpErr, ok := xerrors.As[*httpclient.Error](err); ok {
+		return httpErr.StatusCode >= http.StatusInternalServerError || httpErr.StatusCode == http.StatusTooManyRequests
 	}
 
-	return executor
+	return true
 }
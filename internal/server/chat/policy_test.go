@@ -0,0 +1,86 @@
+package chat
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/llm/decorator/policy"
+	"github.com/looplj/axonhub/internal/llm/transformer/openai"
+	"github.com/looplj/axonhub/internal/objects"
+	"github.com/looplj/axonhub/internal/pkg/httpclient"
+	"github.com/looplj/axonhub/internal/server/biz"
+)
+
+func TestChatCompletionProcessor_PolicyWebhookOrder(t *testing.T) {
+	env := newProcessorTestEnv(t)
+
+	server := env.newUpstream(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(chatCompletionBody("gpt-4o", "Call 415-555-2671"))
+	})
+
+	var payloads []policy.Payload
+
+	webhookServer := env.newUpstream(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		var payload policy.Payload
+		if json.Unmarshal(body, &payload) == nil {
+			payloads = append(payloads, payload)
+		}
+
+		_, _ = w.Write([]byte(`{"action":"allow"}`))
+	})
+
+	webhook, err := policy.New(policy.Config{URL: webhookServer.URL, CheckResponse: true})
+	require.NoError(t, err)
+
+	apiKey := env.createAPIKey("policy", func(create *ent.APIKeyCreate) {
+		create.SetProfiles(&objects.APIKeyProfiles{
+			ActiveProfile: "default",
+			Profiles: []objects.APIKeyProfile{{
+				Name:      "default",
+				Overrides: &objects.RequestOverrides{SystemPromptPrepend: "Contact support@example.com for help."},
+			}},
+		})
+	})
+
+	require.NoError(t, env.systemService.SetGuardrailPolicy(env.ctx, &objects.GuardrailPolicy{
+		Enabled: true,
+		Rules: []objects.GuardrailRule{
+			{Type: "email", Target: "request"},
+			{Type: "phone", Target: "response"},
+		},
+	}))
+
+	processor := NewChatCompletionProcessor(
+		&biz.ChannelService{
+			Channels: []*biz.Channel{env.createChannel("openai", server.URL, "gpt-4o")},
+		},
+		env.requestService,
+		httpclient.NewHttpClient(),
+		openai.NewInboundTransformer(),
+		nil,
+		webhook,
+	)
+
+	_, err = env.process(processor, apiKey, `{"model":"gpt-4o","messages":[{"role":"user","content":"Email john@example.com"}]}`, nil)
+	require.NoError(t, err)
+
+	// The webhook checks the request with the profile overrides applied and the guardrail masking done,
+	// and the response after the guardrail masking.
+	require.Len(t, payloads, 2)
+
+	require.Equal(t, policy.StageRequest, payloads[0].Stage)
+	require.Len(t, payloads[0].Request.Messages, 2)
+	require.Equal(t, "Contact [EMAIL] for help.", *payloads[0].Request.Messages[0].Content.Content)
+	require.Equal(t, "Email [EMAIL]", *payloads[0].Request.Messages[1].Content.Content)
+
+	require.Equal(t, policy.StageResponse, payloads[1].Stage)
+	require.Equal(t, "Call [PHONE]", *payloads[1].Response.Choices[0].Message.Content.Content)
+}
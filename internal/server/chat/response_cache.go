@@ -0,0 +1,119 @@
+package chat
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/apikey"
+	"github.com/looplj/axonhub/internal/llm"
+	"github.com/looplj/axonhub/internal/llm/pipeline"
+	"github.com/looplj/axonhub/internal/log"
+	"github.com/looplj/axonhub/internal/pkg/httpclient"
+	"github.com/looplj/axonhub/internal/server/cache"
+)
+
+// CacheHeader is the request header to enable or disable the response cache for the request.
+const CacheHeader = "AH-Cache"
+
+// responseCache caches the responses of the deterministic requests in the cache scope of the API key.
+// The cache hits are recorded as the requests with the cached flag, without the executions and the usage logs.
+type responseCache struct {
+	store cache.Store
+	scope string
+	state *PersistenceState
+}
+
+var _ pipeline.ResponseCache = (*responseCache)(nil)
+
+// newResponseCache returns the response cache for the request, nil if the cache is not enabled for it.
+func newResponseCache(store cache.Store, apiKey *ent.APIKey, request *httpclient.Request, state *PersistenceState) *responseCache {
+	if store == nil || apiKey == nil || !cacheEnabled(apiKey.CacheMode, request.Headers.Get(CacheHeader)) {
+		return nil
+	}
+
+	scope := fmt.Sprintf("api_key:%d", apiKey.ID)
+	if apiKey.CacheScope != "" {
+		scope = "scope:" + apiKey.CacheScope
+	}
+
+	return &responseCache{
+		store: store,
+		scope: scope,
+		state: state,
+	}
+}
+
+func cacheEnabled(mode apikey.CacheMode, header string) bool {
+	switch mode {
+	case apikey.CacheModeOptIn:
+		enabled, _ := strconv.ParseBool(header)
+		return enabled
+	case apikey.CacheModeAlways:
+		enabled, err := strconv.ParseBool(header)
+		return err != nil || enabled
+	default:
+		return false
+	}
+}
+
+// Key returns the hash of the cache scope and the normalized request.
+// Only the deterministic requests with the zero temperature are cached,
+// the fields not affecting the response, e.g. the stream options and the user, are ignored.
+func (c *responseCache) Key(ctx context.Context, request *llm.Request) (string, bool) {
+	if request.Temperature == nil || *request.Temperature != 0 {
+		return "", false
+	}
+
+	normalized := *request
+	normalized.Stream = nil
+	normalized.StreamOptions = nil
+	normalized.User = nil
+	normalized.SafetyIdentifier = nil
+	normalized.Metadata = nil
+	normalized.Store = nil
+
+	body, err := json.Marshal(normalized)
+	if err != nil {
+		log.Warn(ctx, "Failed to marshal request for response cache key", log.Cause(err))
+		return "", false
+	}
+
+	hash := sha256.New()
+	hash.Write([]byte(c.scope))
+	hash.Write([]byte{0})
+	hash.Write(body)
+
+	return hex.EncodeToString(hash.Sum(nil)), true
+}
+
+func (c *responseCache) Get(ctx context.Context, key string) (*llm.Response, bool) {
+	response, ok, err := c.store.Get(ctx, key)
+	if err != nil {
+		log.Warn(ctx, "Failed to get cached response", log.Cause(err))
+		return nil, false
+	}
+
+	if !ok {
+		return nil, false
+	}
+
+	if c.state.Request != nil {
+		if err := c.state.RequestService.UpdateRequestCached(ctx, c.state.Request.ID); err != nil {
+			log.Warn(ctx, "Failed to mark request as cached", log.Cause(err))
+		}
+	}
+
+	return response, true
+}
+
+func (c *responseCache) Set(ctx context.Context, key string, response *llm.Response) {
+	// Use context without cancellation to cache the response even if client canceled
+	if err := c.store.Set(context.WithoutCancel(ctx), key, response); err != nil {
+		log.Warn(ctx, "Failed to cache response", log.Cause(err))
+	}
+}
@@ -0,0 +1,147 @@
+package chat
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/apikey"
+	"github.com/looplj/axonhub/internal/ent/request"
+	"github.com/looplj/axonhub/internal/llm/transformer/openai"
+	"github.com/looplj/axonhub/internal/pkg/httpclient"
+	"github.com/looplj/axonhub/internal/server/biz"
+	"github.com/looplj/axonhub/internal/server/cache"
+)
+
+func TestCacheEnabled(t *testing.T) {
+	tests := []struct {
+		mode   apikey.CacheMode
+		header string
+		want   bool
+	}{
+		{mode: apikey.CacheModeDisabled, header: "true", want: false},
+		{mode: apikey.CacheModeOptIn, header: "", want: false},
+		{mode: apikey.CacheModeOptIn, header: "true", want: true},
+		{mode: apikey.CacheModeOptIn, header: "false", want: false},
+		{mode: apikey.CacheModeAlways, header: "", want: true},
+		{mode: apikey.CacheModeAlways, header: "false", want: false},
+	}
+
+	for _, tt := range tests {
+		require.Equal(t, tt.want, cacheEnabled(tt.mode, tt.header), "mode %s, header %q", tt.mode, tt.header)
+	}
+}
+
+func TestChatCompletionProcessor_ResponseCache(t *testing.T) {
+	env := newProcessorTestEnv(t)
+
+	calls := 0
+	server := env.newUpstream(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(chatCompletionBody("gpt-4o", "4"))
+	})
+
+	newAPIKey := func(name string, mode apikey.CacheMode, scope string) *ent.APIKey {
+		return env.createAPIKey(name, func(create *ent.APIKeyCreate) {
+			create.SetCacheMode(mode).SetCacheScope(scope)
+		})
+	}
+
+	processor := NewChatCompletionProcessor(
+		&biz.ChannelService{
+			Channels: []*biz.Channel{env.createChannel("openai", server.URL, "gpt-4o")},
+		},
+		env.requestService,
+		httpclient.NewHttpClient(),
+		openai.NewInboundTransformer(),
+		cache.NewMemoryStore(10, time.Hour),
+		nil,
+	)
+
+	process := func(apiKey *ent.APIKey, header string, body string) *ent.Request {
+		headers := http.Header{}
+		if header != "" {
+			headers.Set(CacheHeader, header)
+		}
+
+		result, err := env.process(processor, apiKey, body, headers)
+		require.NoError(t, err)
+
+		if result.ChatCompletionStream != nil {
+			for result.ChatCompletionStream.Next() {
+				result.ChatCompletionStream.Current()
+			}
+
+			require.NoError(t, result.ChatCompletionStream.Close())
+		} else {
+			require.Contains(t, string(result.ChatCompletion.Body), `"content":"4"`)
+		}
+
+		latest, err := env.client.Request.Query().Order(ent.Desc(request.FieldID)).First(env.ctx)
+		require.NoError(t, err)
+
+		return latest
+	}
+
+	deterministic := `{"model":"gpt-4o","temperature":0,"messages":[{"role":"user","content":"2+2?"}]}`
+
+	optIn := newAPIKey("opt-in", apikey.CacheModeOptIn, "")
+
+	// The request without the header is not cached for the opt-in key.
+	req := process(optIn, "", deterministic)
+	require.False(t, req.Cached)
+	require.Equal(t, 1, calls)
+
+	req = process(optIn, "true", deterministic)
+	require.False(t, req.Cached)
+	require.Equal(t, 2, calls)
+
+	// The identical request is served from the cache, without the executions and the usage logs.
+	req = process(optIn, "true", deterministic)
+	require.True(t, req.Cached)
+	require.Equal(t, request.StatusCompleted, req.Status)
+	require.Equal(t, 2, calls)
+	require.Equal(t, 2, env.client.RequestExecution.Query().CountX(env.ctx))
+	require.False(t, env.client.Request.QueryExecutions(req).ExistX(env.ctx))
+	require.False(t, env.client.Request.QueryUsageLogs(req).ExistX(env.ctx))
+
+	// The cached response is replayed as a stream.
+	req = process(optIn, "true", `{"model":"gpt-4o","temperature":0,"stream":true,"messages":[{"role":"user","content":"2+2?"}]}`)
+	require.True(t, req.Cached)
+	require.Equal(t, request.StatusCompleted, req.Status)
+	require.Equal(t, 2, calls)
+
+	// The non-deterministic request is not cached.
+	process(optIn, "true", `{"model":"gpt-4o","messages":[{"role":"user","content":"2+2?"}]}`)
+	req = process(optIn, "true", `{"model":"gpt-4o","messages":[{"role":"user","content":"2+2?"}]}`)
+	require.False(t, req.Cached)
+	require.Equal(t, 4, calls)
+
+	// The API keys in different scopes do not share the cached responses.
+	always := newAPIKey("always", apikey.CacheModeAlways, "")
+	req = process(always, "", deterministic)
+	require.False(t, req.Cached)
+	require.Equal(t, 5, calls)
+
+	req = process(always, "", deterministic)
+	require.True(t, req.Cached)
+	require.Equal(t, 5, calls)
+
+	req = process(always, "false", deterministic)
+	require.False(t, req.Cached)
+	require.Equal(t, 6, calls)
+
+	// The API keys in the same scope share the cached responses.
+	shared1 := newAPIKey("shared-1", apikey.CacheModeAlways, "evals")
+	shared2 := newAPIKey("shared-2", apikey.CacheModeAlways, "evals")
+
+	process(shared1, "", deterministic)
+	req = process(shared2, "", deterministic)
+	require.True(t, req.Cached)
+	require.Equal(t, 7, calls)
+}
@@ -5,7 +5,9 @@ import (
 
 	"github.com/looplj/axonhub/internal/ent"
 	"github.com/looplj/axonhub/internal/llm/transformer"
+	"github.com/looplj/axonhub/internal/objects"
 	"github.com/looplj/axonhub/internal/pkg/httpclient"
+	"github.com/looplj/axonhub/internal/server/affinity"
 	"github.com/looplj/axonhub/internal/server/biz"
 )
 
@@ -25,6 +27,26 @@ type PersistenceState struct {
 	Channels       []*biz.Channel
 	CurrentChannel *biz.Channel
 	ChannelIndex   int
+
+	// Models are the requested models of the channels, they are the fallback models for the fallback channels.
+	Models []string
+
+	// Candidates are the entries of the fallback chain the channels are selected for, they are the requested model
+	// or its fallback models before the virtual models are resolved.
+	Candidates []string
+
+	// ModelFallbacks are the fallback chains of the API key profile followed by the global ones.
+	ModelFallbacks []objects.ModelFallback
+
+	// SessionAffinity pins the conversation to the channel which served it, nil if the session affinity is disabled.
+	SessionAffinity *affinity.Store
+	// SessionID is the client-provided session id of the conversation.
+	SessionID string
+	// SessionKey is the affinity key of the conversation, empty if the conversation can not be identified.
+	SessionKey string
+
+	// ChannelStats record the health and the latency of the channels, nil if not tracked.
+	ChannelStats *biz.ChannelStats
 }
 
 var (
@@ -69,6 +91,7 @@ func NewPersistentTransformersWithSelector(
 ) (*PersistentInboundTransformer, *PersistentOutboundTransformer) {
 	state := &PersistenceState{
 		RequestService:  requestService,
+		UsageLogService: requestService.UsageLogService,
 		ChannelSelector: channelSelector,
 		APIKey:          apiKey,
 		User:            user,
@@ -0,0 +1,103 @@
+package chat
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+
+	"github.com/looplj/axonhub/internal/contexts"
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/llm/transformer/openai"
+	"github.com/looplj/axonhub/internal/objects"
+	"github.com/looplj/axonhub/internal/pkg/httpclient"
+	"github.com/looplj/axonhub/internal/server/biz"
+)
+
+func TestChatCompletionProcessor_VirtualModel(t *testing.T) {
+	env := newProcessorTestEnv(t)
+
+	var upstreams []string
+
+	newServer := func(name string) *httptest.Server {
+		return env.newUpstream(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			model := gjson.GetBytes(body, "model").String()
+			upstreams = append(upstreams, name+"/"+model)
+
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write(chatCompletionBody(model, "Hello"))
+		})
+	}
+
+	apiKey := env.createAPIKey("virtual")
+
+	var channels []*biz.Channel
+
+	for _, name := range []string{"primary", "secondary"} {
+		channels = append(channels, env.createChannel(name, newServer(name).URL, "gpt-4o", "gpt-4o-mini"))
+	}
+
+	_, err := env.client.VirtualModel.Create().
+		SetName("team-default").
+		SetTargets([]objects.VirtualModelTarget{
+			{Model: "gpt-4o-mini"},
+			{
+				Model:      "gpt-4o",
+				ChannelID:  &objects.GUID{Type: ent.TypeChannel, ID: channels[1].ID},
+				Priority:   1,
+				Conditions: &objects.VirtualModelConditions{Tools: lo.ToPtr(true)},
+			},
+		}).
+		Save(env.ctx)
+	require.NoError(t, err)
+
+	channelService := &biz.ChannelService{Channels: channels, Ent: env.client}
+	require.NoError(t, channelService.LoadVirtualModels(env.ctx))
+
+	processor := NewChatCompletionProcessor(
+		channelService,
+		env.requestService,
+		httpclient.NewHttpClient(),
+		openai.NewInboundTransformer(),
+		nil,
+		nil,
+	)
+
+	process := func(body string) ChatCompletionResult {
+		result, err := env.process(processor, apiKey, body, nil)
+		require.NoError(t, err)
+
+		return result
+	}
+
+	// The target restricted to the requests with tools is skipped.
+	result := process(`{"model":"team-default","messages":[{"role":"user","content":"Hi"}]}`)
+	require.Equal(t, "gpt-4o-mini", result.Model)
+	require.Equal(t, []string{"primary/gpt-4o-mini"}, upstreams)
+
+	// The target with the higher priority is restricted to the secondary channel.
+	upstreams = nil
+	result = process(`{"model":"team-default","messages":[{"role":"user","content":"Hi"}],` +
+		`"tools":[{"type":"function","function":{"name":"get_weather","parameters":{"type":"object"}}}]}`)
+	require.Equal(t, "gpt-4o", result.Model)
+	require.Equal(t, []string{"secondary/gpt-4o"}, upstreams)
+
+	// The explanation selects the targets of the virtual model without sending the request.
+	upstreams = nil
+	explanation, err := processor.ExplainRouting(contexts.WithAPIKey(env.ctx, apiKey), &httpclient.Request{
+		Method:  http.MethodPost,
+		Headers: http.Header{"Content-Type": []string{"application/json"}},
+		Body:    []byte(`{"model":"team-default","messages":[{"role":"user","content":"Hi"}]}`),
+	}, "")
+	require.NoError(t, err)
+	require.Equal(t, "team-default", explanation.Model)
+	require.Equal(t, []string{"primary", "secondary"}, lo.Map(explanation.Channels, func(c *biz.Channel, _ int) string { return c.Name }))
+	require.Equal(t, []string{"gpt-4o-mini", "gpt-4o-mini"}, explanation.Models)
+	require.Len(t, explanation.Candidates, 2)
+	require.Empty(t, upstreams)
+}
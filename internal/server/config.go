@@ -21,6 +21,13 @@ type Config struct {
 	// LLMRequestTimeout is the maximum duration for processing a request to LLM.
 	LLMRequestTimeout time.Duration `conf:"llm_request_timeout" yaml:"llm_request_timeout" json:"llm_request_timeout"`
 
+	// TrustedProxies are the IPs or CIDRs of the reverse proxies whose X-Forwarded-For headers are trusted
+	// to resolve the client IP, e.g. for the IP allowlists of the API keys. No proxy is trusted if empty.
+	TrustedProxies []string `conf:"trusted_proxies" yaml:"trusted_proxies" json:"trusted_proxies"`
+
+	// TrustedPlatform is the header set by the platform carrying the client IP, e.g. CF-Connecting-IP of Cloudflare.
+	TrustedPlatform string `conf:"trusted_platform" yaml:"trusted_platform" json:"trusted_platform"`
+
 	Trace tracing.Config `conf:"trace" yaml:"trace" json:"trace"`
 
 	Debug bool `conf:"debug" yaml:"debug" json:"debug"`
@@ -60,6 +60,11 @@ func NewEntClient(cfg Config) *ent.Client {
 	opts = append(opts, ent.Driver(drv))
 	client := ent.NewClient(opts...)
 
+	err = dropLegacyIndexes(context.Background(), sqlDB, dbDialect)
+	if err != nil {
+		panic(err)
+	}
+
 	err = client.Schema.Create(
 		context.Background(),
 		migrate.WithGlobalUniqueID(false),
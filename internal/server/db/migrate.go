@@ -0,0 +1,55 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"entgo.io/ent/dialect"
+)
+
+// legacyIndexes are the indexes replaced by the previous versions, they are dropped explicitly,
+// so the indexes added by the operators are kept by the schema migration.
+var legacyIndexes = []struct {
+	Table string
+	Name  string
+}{
+	// The unique name index is replaced by the name and deleted_at index, so the names of the deleted channels can be reused.
+	{Table: "channels", Name: "channels_by_name"},
+}
+
+func dropLegacyIndexes(ctx context.Context, db *sql.DB, dbDialect string) error {
+	for _, index := range legacyIndexes {
+		if err := dropIndex(ctx, db, dbDialect, index.Table, index.Name); err != nil {
+			return fmt.Errorf("failed to drop legacy index %s: %w", index.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func dropIndex(ctx context.Context, db *sql.DB, dbDialect, table, name string) error {
+	if dbDialect != dialect.MySQL {
+		_, err := db.ExecContext(ctx, fmt.Sprintf("DROP INDEX IF EXISTS %s", name))
+		return err
+	}
+
+	// MySQL does not support DROP INDEX IF EXISTS.
+	var count int
+
+	err := db.QueryRowContext(ctx,
+		"SELECT COUNT(*) FROM information_schema.statistics WHERE table_schema = DATABASE() AND table_name = ? AND index_name = ?",
+		table, name,
+	).Scan(&count)
+	if err != nil {
+		return err
+	}
+
+	if count == 0 {
+		return nil
+	}
+
+	_, err = db.ExecContext(ctx, fmt.Sprintf("DROP INDEX %s ON %s", name, table))
+
+	return err
+}
@@ -0,0 +1,50 @@
+package db
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEntClient_DropLegacyIndexes(t *testing.T) {
+	dsn := "file:migrate?mode=memory&cache=shared&_fk=1"
+
+	// The shared in-memory database lives as long as one connection is open.
+	conn, err := sql.Open("sqlite3", dsn)
+	require.NoError(t, err)
+	defer conn.Close()
+	require.NoError(t, conn.Ping())
+
+	require.NoError(t, NewEntClient(Config{Dialect: "sqlite3", DSN: dsn}).Close())
+
+	// The index of the previous versions, and the index added by the operator.
+	_, err = conn.Exec("CREATE UNIQUE INDEX channels_by_name ON channels (name)")
+	require.NoError(t, err)
+	_, err = conn.Exec("CREATE INDEX channels_operator_name ON channels (name)")
+	require.NoError(t, err)
+
+	client := NewEntClient(Config{Dialect: "sqlite3", DSN: dsn})
+	defer client.Close()
+
+	indexes := func() []string {
+		rows, err := conn.Query("SELECT name FROM sqlite_master WHERE type = 'index' AND tbl_name = 'channels' AND name NOT LIKE 'sqlite_%'")
+		require.NoError(t, err)
+		defer rows.Close()
+
+		var names []string
+
+		for rows.Next() {
+			var name string
+			require.NoError(t, rows.Scan(&name))
+			names = append(names, name)
+		}
+
+		require.NoError(t, rows.Err())
+
+		return names
+	}
+
+	// The legacy index is dropped, the index added by the operator is kept.
+	require.ElementsMatch(t, []string{"channels_by_name_deleted_at", "channels_operator_name"}, indexes())
+}
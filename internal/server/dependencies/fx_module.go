@@ -3,9 +3,14 @@ package dependencies
 import (
 	"go.uber.org/fx"
 
+	"github.com/looplj/axonhub/internal/llm/decorator/policy"
+	"github.com/looplj/axonhub/internal/llm/tokenizer"
 	"github.com/looplj/axonhub/internal/log"
 	"github.com/looplj/axonhub/internal/pkg/httpclient"
+	"github.com/looplj/axonhub/internal/server/affinity"
+	"github.com/looplj/axonhub/internal/server/cache"
 	"github.com/looplj/axonhub/internal/server/db"
+	"github.com/looplj/axonhub/internal/server/persist"
 )
 
 var Module = fx.Module("dependencies",
@@ -13,4 +18,9 @@ var Module = fx.Module("dependencies",
 	fx.Provide(db.NewEntClient),
 	fx.Provide(httpclient.NewHttpClient),
 	fx.Provide(NewExecutors),
+	fx.Provide(persist.NewWriter),
+	fx.Provide(tokenizer.New),
+	fx.Provide(cache.NewStore),
+	fx.Provide(affinity.New),
+	fx.Provide(policy.New),
 )
@@ -9,17 +9,26 @@ import (
 	"go.uber.org/fx"
 
 	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/apikey"
+	"github.com/looplj/axonhub/internal/ent/channel"
 	"github.com/looplj/axonhub/internal/ent/privacy"
 	"github.com/looplj/axonhub/internal/ent/request"
 	"github.com/looplj/axonhub/internal/ent/requestexecution"
+	"github.com/looplj/axonhub/internal/ent/responsecache"
+	"github.com/looplj/axonhub/internal/ent/role"
+	"github.com/looplj/axonhub/internal/ent/routingrule"
 	"github.com/looplj/axonhub/internal/ent/schema/schematype"
 	"github.com/looplj/axonhub/internal/ent/usagelog"
+	"github.com/looplj/axonhub/internal/ent/user"
+	"github.com/looplj/axonhub/internal/ent/virtualmodel"
 	"github.com/looplj/axonhub/internal/log"
 	"github.com/looplj/axonhub/internal/server/biz"
 )
 
 type Config struct {
 	CRON string `json:"cron" yaml:"cron" conf:"cron" validate:"required"`
+	// DeletedRetentionDays is the number of days to keep the soft deleted entities before purging them, 0 means never purge.
+	DeletedRetentionDays int `json:"deleted_retention_days" yaml:"deleted_retention_days" conf:"deleted_retention_days"`
 }
 
 // Worker handles garbage collection and cleanup operations.
@@ -128,6 +137,17 @@ func (w *Worker) runCleanup(ctx context.Context) {
 		}
 	}
 
+	if err := w.purgeDeleted(ctx, w.Config.DeletedRetentionDays); err != nil {
+		log.Error(ctx, "Failed to purge deleted entities", log.Cause(err))
+	} else {
+		log.Info(ctx, "Successfully purged deleted entities",
+			log.Int("retention_days", w.Config.DeletedRetentionDays))
+	}
+
+	if err := w.purgeExpiredResponseCaches(ctx); err != nil {
+		log.Error(ctx, "Failed to purge expired response caches", log.Cause(err))
+	}
+
 	log.Info(ctx, "Automatic cleanup process completed")
 }
 
@@ -192,6 +212,137 @@ func (w *Worker) cleanupUsageLogs(ctx context.Context, cleanupDays int) error {
 	return nil
 }
 
+// purgeExpiredResponseCaches deletes the expired responses of the database response cache store.
+func (w *Worker) purgeExpiredResponseCaches(ctx context.Context) error {
+	count, err := w.Ent.ResponseCache.Delete().
+		Where(responsecache.ExpiresAtLT(time.Now())).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to purge expired response caches: %w", err)
+	}
+
+	log.Debug(ctx, "Purged expired response caches", log.Int("count", count))
+
+	return nil
+}
+
+// purgeDeleted permanently deletes the entities soft deleted before the retention period.
+// The channels, API keys and users still referenced by the requests or usage logs are kept,
+// they are purged after the referencing records are cleaned up.
+func (w *Worker) purgeDeleted(ctx context.Context, retentionDays int) error {
+	if retentionDays <= 0 {
+		log.Debug(ctx, "No purge needed for deleted entities")
+		return nil
+	}
+
+	ctx = schematype.SkipSoftDelete(ctx)
+	ctx = privacy.DecisionContext(ctx, privacy.Allow)
+	cutoff := int(time.Now().AddDate(0, 0, -retentionDays).Unix())
+
+	channels, err := w.Ent.Channel.Delete().
+		Where(
+			channel.DeletedAtGT(0),
+			channel.DeletedAtLT(cutoff),
+			channel.Not(channel.HasRequests()),
+			channel.Not(channel.HasExecutions()),
+			channel.Not(channel.HasUsageLogs()),
+		).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to purge deleted channels: %w", err)
+	}
+
+	apiKeys, err := w.Ent.APIKey.Delete().
+		Where(
+			apikey.DeletedAtGT(0),
+			apikey.DeletedAtLT(cutoff),
+			apikey.Not(apikey.HasRequests()),
+		).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to purge deleted api keys: %w", err)
+	}
+
+	userIDs, err := w.Ent.User.Query().
+		Where(
+			user.DeletedAtGT(0),
+			user.DeletedAtLT(cutoff),
+			user.Not(user.HasRequests()),
+			user.Not(user.HasUsageLogs()),
+		).
+		IDs(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query deleted users: %w", err)
+	}
+
+	if len(userIDs) > 0 {
+		// The API keys of the deleted users are disabled but not deleted, they are purged with the users.
+		if _, err := w.Ent.APIKey.Delete().Where(apikey.UserIDIn(userIDs...)).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to purge api keys of deleted users: %w", err)
+		}
+
+		if err := w.Ent.User.Update().Where(user.IDIn(userIDs...)).ClearRoles().Exec(ctx); err != nil {
+			return fmt.Errorf("failed to clear roles of deleted users: %w", err)
+		}
+
+		if _, err := w.Ent.User.Delete().Where(user.IDIn(userIDs...)).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to purge deleted users: %w", err)
+		}
+	}
+
+	roleIDs, err := w.Ent.Role.Query().
+		Where(role.DeletedAtGT(0), role.DeletedAtLT(cutoff)).
+		IDs(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query deleted roles: %w", err)
+	}
+
+	if len(roleIDs) > 0 {
+		if err := w.Ent.Role.Update().Where(role.IDIn(roleIDs...)).ClearUsers().Exec(ctx); err != nil {
+			return fmt.Errorf("failed to clear users of deleted roles: %w", err)
+		}
+
+		if _, err := w.Ent.Role.Delete().Where(role.IDIn(roleIDs...)).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to purge deleted roles: %w", err)
+		}
+	}
+
+	routingRuleIDs, err := w.Ent.RoutingRule.Query().
+		Where(routingrule.DeletedAtGT(0), routingrule.DeletedAtLT(cutoff)).
+		IDs(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query deleted routing rules: %w", err)
+	}
+
+	if len(routingRuleIDs) > 0 {
+		if err := w.Ent.RoutingRule.Update().Where(routingrule.IDIn(routingRuleIDs...)).ClearChannels().Exec(ctx); err != nil {
+			return fmt.Errorf("failed to clear channels of deleted routing rules: %w", err)
+		}
+
+		if _, err := w.Ent.RoutingRule.Delete().Where(routingrule.IDIn(routingRuleIDs...)).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to purge deleted routing rules: %w", err)
+		}
+	}
+
+	virtualModels, err := w.Ent.VirtualModel.Delete().
+		Where(virtualmodel.DeletedAtGT(0), virtualmodel.DeletedAtLT(cutoff)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to purge deleted virtual models: %w", err)
+	}
+
+	log.Debug(ctx, "Purged deleted entities",
+		log.Int("channels", channels),
+		log.Int("api_keys", apiKeys),
+		log.Int("users", len(userIDs)),
+		log.Int("roles", len(roleIDs)),
+		log.Int("virtual_models", virtualModels),
+		log.Int("routing_rules", len(routingRuleIDs)),
+	)
+
+	return nil
+}
+
 // RunCleanupNow manually triggers the cleanup process.
 // This can be useful for testing or manual execution.
 func (w *Worker) RunCleanupNow(ctx context.Context) error {
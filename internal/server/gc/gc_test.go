@@ -0,0 +1,139 @@
+package gc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/channel"
+	"github.com/looplj/axonhub/internal/ent/privacy"
+	"github.com/looplj/axonhub/internal/ent/request"
+	"github.com/looplj/axonhub/internal/ent/schema/schematype"
+	"github.com/looplj/axonhub/internal/objects"
+	"github.com/looplj/axonhub/internal/server/db"
+)
+
+func TestWorker_PurgeDeleted(t *testing.T) {
+	client := db.NewEntClient(db.Config{
+		Dialect: "sqlite3",
+		DSN:     "file:ent?mode=memory&cache=shared&_fk=1",
+	})
+	defer client.Close()
+
+	ctx := ent.NewContext(t.Context(), client)
+	ctx = privacy.DecisionContext(ctx, privacy.Allow)
+
+	expired := int(time.Now().AddDate(0, 0, -31).Unix())
+	recent := int(time.Now().AddDate(0, 0, -1).Unix())
+
+	createChannel := func(name string, deletedAt int) *ent.Channel {
+		created, err := client.Channel.Create().
+			SetType(channel.TypeOpenai).
+			SetName(name).
+			SetSupportedModels([]string{"gpt-4o"}).
+			SetDefaultTestModel("gpt-4o").
+			SetDeletedAt(deletedAt).
+			Save(ctx)
+		require.NoError(t, err)
+
+		return created
+	}
+
+	purged := createChannel("purged", expired)
+	kept := createChannel("kept", recent)
+	referenced := createChannel("referenced", expired)
+	active := createChannel("active", 0)
+
+	deletedUser, err := client.User.Create().
+		SetEmail("deleted@example.com").
+		SetPassword("password").
+		SetDeletedAt(expired).
+		Save(ctx)
+	require.NoError(t, err)
+
+	apiKey, err := client.APIKey.Create().
+		SetName("deleted user key").
+		SetKey("hashed").
+		SetUserID(deletedUser.ID).
+		Save(ctx)
+	require.NoError(t, err)
+
+	owner, err := client.User.Create().
+		SetEmail("owner@example.com").
+		SetPassword("password").
+		Save(ctx)
+	require.NoError(t, err)
+
+	_, err = client.Request.Create().
+		SetUserID(owner.ID).
+		SetModelID("gpt-4o").
+		SetChannelID(referenced.ID).
+		SetRequestBody(objects.JSONRawMessage(`{}`)).
+		SetStatus(request.StatusCompleted).
+		Save(ctx)
+	require.NoError(t, err)
+
+	purgedVirtualModel, err := client.VirtualModel.Create().
+		SetName("fast").
+		SetTargets([]objects.VirtualModelTarget{{Model: "gpt-4o"}}).
+		SetDeletedAt(expired).
+		Save(ctx)
+	require.NoError(t, err)
+
+	keptVirtualModel, err := client.VirtualModel.Create().
+		SetName("fast").
+		SetTargets([]objects.VirtualModelTarget{{Model: "gpt-4o"}}).
+		SetDeletedAt(recent).
+		Save(ctx)
+	require.NoError(t, err)
+
+	purgedRoutingRule, err := client.RoutingRule.Create().
+		SetName("vision").
+		AddChannelIDs(active.ID).
+		SetDeletedAt(expired).
+		Save(ctx)
+	require.NoError(t, err)
+
+	keptRoutingRule, err := client.RoutingRule.Create().
+		SetName("vision").
+		AddChannelIDs(active.ID).
+		SetDeletedAt(recent).
+		Save(ctx)
+	require.NoError(t, err)
+
+	worker := &Worker{Ent: client}
+	require.NoError(t, worker.purgeDeleted(ctx, 30))
+
+	allCtx := schematype.SkipSoftDelete(ctx)
+
+	_, err = client.Channel.Get(allCtx, purged.ID)
+	require.True(t, ent.IsNotFound(err))
+
+	for _, id := range []int{kept.ID, referenced.ID, active.ID} {
+		_, err = client.Channel.Get(allCtx, id)
+		require.NoError(t, err)
+	}
+
+	_, err = client.User.Get(allCtx, deletedUser.ID)
+	require.True(t, ent.IsNotFound(err))
+
+	_, err = client.APIKey.Get(allCtx, apiKey.ID)
+	require.True(t, ent.IsNotFound(err))
+
+	_, err = client.User.Get(allCtx, owner.ID)
+	require.NoError(t, err)
+
+	_, err = client.VirtualModel.Get(allCtx, purgedVirtualModel.ID)
+	require.True(t, ent.IsNotFound(err))
+
+	_, err = client.VirtualModel.Get(allCtx, keptVirtualModel.ID)
+	require.NoError(t, err)
+
+	_, err = client.RoutingRule.Get(allCtx, purgedRoutingRule.ID)
+	require.True(t, ent.IsNotFound(err))
+
+	_, err = client.RoutingRule.Get(allCtx, keptRoutingRule.ID)
+	require.NoError(t, err)
+}
@@ -7,20 +7,48 @@ package gql
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/samber/lo"
 
 	"github.com/looplj/axonhub/internal/contexts"
 	"github.com/looplj/axonhub/internal/ent"
 	"github.com/looplj/axonhub/internal/ent/apikey"
 	"github.com/looplj/axonhub/internal/ent/channel"
 	"github.com/looplj/axonhub/internal/ent/request"
+	"github.com/looplj/axonhub/internal/ent/role"
+	"github.com/looplj/axonhub/internal/ent/schema/schematype"
 	"github.com/looplj/axonhub/internal/ent/user"
+	"github.com/looplj/axonhub/internal/llm/transformer/openai"
 	"github.com/looplj/axonhub/internal/objects"
+	"github.com/looplj/axonhub/internal/pkg/httpclient"
 	"github.com/looplj/axonhub/internal/server/biz"
 	"github.com/looplj/axonhub/internal/server/chat"
 )
 
+// Key is the resolver for the key field.
+func (r *aPIKeyResolver) Key(ctx context.Context, obj *ent.APIKey) (string, error) {
+	// The stored key is hashed, the plain key is only returned by the payloads of createAPIKey and rotateAPIKey.
+	return biz.MaskAPIKey(obj.KeyPrefix), nil
+}
+
+// Available is the resolver for the available field.
+func (r *channelResolver) Available(ctx context.Context, obj *ent.Channel) (bool, error) {
+	return (&biz.Channel{Channel: obj}).IsAvailableAt(time.Now()), nil
+}
+
+// ActiveMaintenanceWindow is the resolver for the activeMaintenanceWindow field.
+func (r *channelResolver) ActiveMaintenanceWindow(ctx context.Context, obj *ent.Channel) (*objects.MaintenanceWindow, error) {
+	return (&biz.Channel{Channel: obj}).ActiveMaintenanceWindow(time.Now()), nil
+}
+
 // CreateChannel is the resolver for the createChannel field.
 func (r *mutationResolver) CreateChannel(ctx context.Context, input ent.CreateChannelInput) (*ent.Channel, error) {
+	if err := biz.ValidateChannelSettings(input.Settings); err != nil {
+		return nil, err
+	}
+
 	channel, err := r.client.Channel.Create().
 		SetType(input.Type).
 		SetNillableBaseURL(input.BaseURL).
@@ -49,6 +77,10 @@ func (r *mutationResolver) UpdateChannel(ctx context.Context, id objects.GUID, i
 	}
 
 	if input.Settings != nil {
+		if err := biz.ValidateChannelSettings(input.Settings); err != nil {
+			return nil, err
+		}
+
 		mut.SetSettings(input.Settings)
 	}
 
@@ -198,41 +230,109 @@ func (r *mutationResolver) BulkUpdateChannelOrdering(ctx context.Context, input
 	}, nil
 }
 
+// DeleteChannel is the resolver for the deleteChannel field.
+func (r *mutationResolver) DeleteChannel(ctx context.Context, id objects.GUID) (bool, error) {
+	if err := r.channelService.DeleteChannel(ctx, id.ID); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// RestoreChannel is the resolver for the restoreChannel field.
+func (r *mutationResolver) RestoreChannel(ctx context.Context, id objects.GUID) (*ent.Channel, error) {
+	return r.channelService.RestoreChannel(ctx, id.ID)
+}
+
 // CreateAPIKey is the resolver for the createAPIKey field.
-func (r *mutationResolver) CreateAPIKey(ctx context.Context, input ent.CreateAPIKeyInput) (*ent.APIKey, error) {
+func (r *mutationResolver) CreateAPIKey(ctx context.Context, input ent.CreateAPIKeyInput) (*CreateAPIKeyPayload, error) {
 	// Get current user from context
 	user, ok := contexts.GetUser(ctx)
 	if !ok || user == nil {
 		return nil, fmt.Errorf("user not found in context")
 	}
 
+	if err := biz.ValidateCIDRs(input.AllowedCidrs); err != nil {
+		return nil, err
+	}
+
 	// Generate API key with ah- prefix (similar to OpenAI format)
 	generatedKey, err := r.authService.GenerateAPIKey()
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate API key: %w", err)
 	}
 
+	hashedKey, err := biz.HashAPIKey(generatedKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash API key: %w", err)
+	}
+
 	apiKey, err := r.client.APIKey.Create().
 		SetName(input.Name).
-		SetKey(generatedKey).
+		SetKey(hashedKey).
+		SetKeyPrefix(biz.APIKeyPrefix(generatedKey)).
 		SetUserID(user.ID).
+		SetNillableExpiresAt(input.ExpiresAt).
+		SetAllowedCidrs(input.AllowedCidrs).
+		SetAllowedModels(input.AllowedModels).
 		Save(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create API key: %w", err)
 	}
 
-	return apiKey, nil
+	// Return the plain key only once, it can not be retrieved later.
+	return &CreateAPIKeyPayload{APIKey: apiKey, PlainKey: generatedKey}, nil
 }
 
 // UpdateAPIKey is the resolver for the updateAPIKey field.
 func (r *mutationResolver) UpdateAPIKey(ctx context.Context, id objects.GUID, input ent.UpdateAPIKeyInput) (*ent.APIKey, error) {
-	apiKey, err := r.client.APIKey.UpdateOneID(id.ID).
+	if err := biz.ValidateCIDRs(input.AllowedCidrs); err != nil {
+		return nil, err
+	}
+
+	if err := biz.ValidateCIDRs(input.AppendAllowedCidrs); err != nil {
+		return nil, err
+	}
+
+	mut := r.client.APIKey.UpdateOneID(id.ID).
 		SetNillableName(input.Name).
-		Save(ctx)
+		SetNillableExpiresAt(input.ExpiresAt)
+
+	if input.ClearExpiresAt {
+		mut.ClearExpiresAt()
+	}
+
+	if input.AllowedCidrs != nil {
+		mut.SetAllowedCidrs(input.AllowedCidrs)
+	}
+
+	if input.AppendAllowedCidrs != nil {
+		mut.AppendAllowedCidrs(input.AppendAllowedCidrs)
+	}
+
+	if input.ClearAllowedCidrs {
+		mut.ClearAllowedCidrs()
+	}
+
+	if input.AllowedModels != nil {
+		mut.SetAllowedModels(input.AllowedModels)
+	}
+
+	if input.AppendAllowedModels != nil {
+		mut.AppendAllowedModels(input.AppendAllowedModels)
+	}
+
+	if input.ClearAllowedModels {
+		mut.ClearAllowedModels()
+	}
+
+	apiKey, err := mut.Save(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update API key: %w", err)
 	}
 
+	r.authService.InvalidateAPIKeyCache(apiKey.ID)
+
 	return apiKey, nil
 }
 
@@ -245,11 +345,17 @@ func (r *mutationResolver) UpdateAPIKeyStatus(ctx context.Context, id objects.GU
 		return nil, fmt.Errorf("failed to update API key status: %w", err)
 	}
 
+	r.authService.InvalidateAPIKeyCache(apiKey.ID)
+
 	return apiKey, nil
 }
 
 // UpdateAPIKeyProfiles is the resolver for the updateAPIKeyProfiles field.
 func (r *mutationResolver) UpdateAPIKeyProfiles(ctx context.Context, id objects.GUID, input objects.APIKeyProfiles) (*ent.APIKey, error) {
+	if err := biz.ValidateAPIKeyProfiles(&input); err != nil {
+		return nil, err
+	}
+
 	apiKey, err := r.client.APIKey.UpdateOneID(id.ID).
 		SetProfiles(&input).
 		Save(ctx)
@@ -257,9 +363,62 @@ func (r *mutationResolver) UpdateAPIKeyProfiles(ctx context.Context, id objects.
 		return nil, fmt.Errorf("failed to update API key profiles: %w", err)
 	}
 
+	r.authService.InvalidateAPIKeyCache(apiKey.ID)
+
+	return apiKey, nil
+}
+
+// UpdateAPIKeyGuardrailPolicy is the resolver for the updateAPIKeyGuardrailPolicy field.
+func (r *mutationResolver) UpdateAPIKeyGuardrailPolicy(ctx context.Context, id objects.GUID, input objects.GuardrailPolicy) (*ent.APIKey, error) {
+	if err := biz.ValidateGuardrailPolicy(&input); err != nil {
+		return nil, err
+	}
+
+	apiKey, err := r.client.APIKey.UpdateOneID(id.ID).
+		SetGuardrailPolicy(&input).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update API key guardrail policy: %w", err)
+	}
+
+	r.authService.InvalidateAPIKeyCache(apiKey.ID)
+
 	return apiKey, nil
 }
 
+// RotateAPIKey is the resolver for the rotateAPIKey field.
+func (r *mutationResolver) RotateAPIKey(ctx context.Context, id objects.GUID, gracePeriodSeconds *int) (*RotateAPIKeyPayload, error) {
+	gracePeriod := biz.DefaultAPIKeyRotationGracePeriod
+	if gracePeriodSeconds != nil {
+		if *gracePeriodSeconds < 0 {
+			return nil, fmt.Errorf("grace period must not be negative")
+		}
+
+		gracePeriod = time.Duration(*gracePeriodSeconds) * time.Second
+	}
+
+	apiKey, plainKey, err := r.authService.RotateAPIKey(ctx, id.ID, gracePeriod)
+	if err != nil {
+		return nil, fmt.Errorf("failed to rotate API key: %w", err)
+	}
+
+	return &RotateAPIKeyPayload{APIKey: apiKey, PlainKey: plainKey}, nil
+}
+
+// DeleteAPIKey is the resolver for the deleteAPIKey field.
+func (r *mutationResolver) DeleteAPIKey(ctx context.Context, id objects.GUID) (bool, error) {
+	if err := r.authService.DeleteAPIKey(ctx, id.ID); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// RestoreAPIKey is the resolver for the restoreAPIKey field.
+func (r *mutationResolver) RestoreAPIKey(ctx context.Context, id objects.GUID) (*ent.APIKey, error) {
+	return r.authService.RestoreAPIKey(ctx, id.ID)
+}
+
 // CreateUser is the resolver for the createUser field.
 func (r *mutationResolver) CreateUser(ctx context.Context, input ent.CreateUserInput) (*ent.User, error) {
 	// Hash the password using our auth service
@@ -333,6 +492,8 @@ func (r *mutationResolver) UpdateUser(ctx context.Context, id objects.GUID, inpu
 		return nil, fmt.Errorf("failed to update user: %w", err)
 	}
 
+	r.authService.InvalidateUserAPIKeyCache(user.ID)
+
 	return user, nil
 }
 
@@ -345,9 +506,25 @@ func (r *mutationResolver) UpdateUserStatus(ctx context.Context, id objects.GUID
 		return nil, fmt.Errorf("failed to update user status: %w", err)
 	}
 
+	r.authService.InvalidateUserAPIKeyCache(user.ID)
+
 	return user, nil
 }
 
+// DeleteUser is the resolver for the deleteUser field.
+func (r *mutationResolver) DeleteUser(ctx context.Context, id objects.GUID) (bool, error) {
+	if err := r.authService.DeleteUser(ctx, id.ID); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// RestoreUser is the resolver for the restoreUser field.
+func (r *mutationResolver) RestoreUser(ctx context.Context, id objects.GUID) (*ent.User, error) {
+	return r.authService.RestoreUser(ctx, id.ID)
+}
+
 // CreateRole is the resolver for the createRole field.
 func (r *mutationResolver) CreateRole(ctx context.Context, input ent.CreateRoleInput) (*ent.Role, error) {
 	role, err := r.client.Role.Create().
@@ -378,6 +555,224 @@ func (r *mutationResolver) UpdateRole(ctx context.Context, id objects.GUID, inpu
 	return role, nil
 }
 
+// DeleteRole is the resolver for the deleteRole field.
+func (r *mutationResolver) DeleteRole(ctx context.Context, id objects.GUID) (bool, error) {
+	if err := r.client.Role.DeleteOneID(id.ID).Exec(ctx); err != nil {
+		return false, fmt.Errorf("failed to delete role: %w", err)
+	}
+
+	return true, nil
+}
+
+// RestoreRole is the resolver for the restoreRole field.
+func (r *mutationResolver) RestoreRole(ctx context.Context, id objects.GUID) (*ent.Role, error) {
+	deleted, err := r.client.Role.Query().
+		Where(role.ID(id.ID), role.DeletedAtGT(0)).
+		Only(schematype.SkipSoftDelete(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deleted role: %w", err)
+	}
+
+	role, err := r.client.Role.UpdateOne(deleted).
+		SetDeletedAt(0).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore role: %w", err)
+	}
+
+	return role, nil
+}
+
+// CreateVirtualModel is the resolver for the createVirtualModel field.
+func (r *mutationResolver) CreateVirtualModel(ctx context.Context, input ent.CreateVirtualModelInput) (*ent.VirtualModel, error) {
+	if err := biz.ValidateVirtualModelTargets(input.Targets); err != nil {
+		return nil, err
+	}
+
+	vm, err := r.client.VirtualModel.Create().
+		SetName(input.Name).
+		SetNillableDescription(input.Description).
+		SetNillableStatus(input.Status).
+		SetTargets(input.Targets).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create virtual model: %w", err)
+	}
+
+	if err := r.channelService.LoadVirtualModels(ctx); err != nil {
+		return nil, err
+	}
+
+	return vm, nil
+}
+
+// UpdateVirtualModel is the resolver for the updateVirtualModel field.
+func (r *mutationResolver) UpdateVirtualModel(ctx context.Context, id objects.GUID, input ent.UpdateVirtualModelInput) (*ent.VirtualModel, error) {
+	mut := r.client.VirtualModel.UpdateOneID(id.ID).
+		SetNillableName(input.Name).
+		SetNillableStatus(input.Status)
+
+	if input.ClearDescription {
+		mut.ClearDescription()
+	} else {
+		mut.SetNillableDescription(input.Description)
+	}
+
+	if input.Targets != nil {
+		if err := biz.ValidateVirtualModelTargets(input.Targets); err != nil {
+			return nil, err
+		}
+
+		mut.SetTargets(input.Targets)
+	}
+
+	vm, err := mut.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update virtual model: %w", err)
+	}
+
+	if err := r.channelService.LoadVirtualModels(ctx); err != nil {
+		return nil, err
+	}
+
+	return vm, nil
+}
+
+// DeleteVirtualModel is the resolver for the deleteVirtualModel field.
+func (r *mutationResolver) DeleteVirtualModel(ctx context.Context, id objects.GUID) (bool, error) {
+	if err := r.channelService.DeleteVirtualModel(ctx, id.ID); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// RestoreVirtualModel is the resolver for the restoreVirtualModel field.
+func (r *mutationResolver) RestoreVirtualModel(ctx context.Context, id objects.GUID) (*ent.VirtualModel, error) {
+	return r.channelService.RestoreVirtualModel(ctx, id.ID)
+}
+
+// CreateRoutingRule is the resolver for the createRoutingRule field.
+func (r *mutationResolver) CreateRoutingRule(ctx context.Context, input ent.CreateRoutingRuleInput) (*ent.RoutingRule, error) {
+	if err := biz.ValidateRoutingConditions(input.Conditions); err != nil {
+		return nil, err
+	}
+
+	mut := r.client.RoutingRule.Create().
+		SetName(input.Name).
+		SetNillableDescription(input.Description).
+		SetNillableStatus(input.Status).
+		SetNillablePriority(input.Priority).
+		SetNillableAction(input.Action).
+		AddChannelIDs(input.ChannelIDs...)
+
+	if input.Conditions != nil {
+		mut.SetConditions(input.Conditions)
+	}
+
+	rule, err := mut.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create routing rule: %w", err)
+	}
+
+	if err := r.channelService.LoadRoutingRules(ctx); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+// UpdateRoutingRule is the resolver for the updateRoutingRule field.
+func (r *mutationResolver) UpdateRoutingRule(ctx context.Context, id objects.GUID, input ent.UpdateRoutingRuleInput) (*ent.RoutingRule, error) {
+	if err := biz.ValidateRoutingConditions(input.Conditions); err != nil {
+		return nil, err
+	}
+
+	mut := r.client.RoutingRule.UpdateOneID(id.ID).
+		SetNillableName(input.Name).
+		SetNillableStatus(input.Status).
+		SetNillablePriority(input.Priority).
+		SetNillableAction(input.Action)
+
+	if input.ClearDescription {
+		mut.ClearDescription()
+	} else {
+		mut.SetNillableDescription(input.Description)
+	}
+
+	if input.Conditions != nil {
+		mut.SetConditions(input.Conditions)
+	}
+
+	if input.ClearChannels {
+		mut.ClearChannels()
+	}
+
+	rule, err := mut.
+		AddChannelIDs(input.AddChannelIDs...).
+		RemoveChannelIDs(input.RemoveChannelIDs...).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update routing rule: %w", err)
+	}
+
+	if err := r.channelService.LoadRoutingRules(ctx); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+// DeleteRoutingRule is the resolver for the deleteRoutingRule field.
+func (r *mutationResolver) DeleteRoutingRule(ctx context.Context, id objects.GUID) (bool, error) {
+	if err := r.channelService.DeleteRoutingRule(ctx, id.ID); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// RestoreRoutingRule is the resolver for the restoreRoutingRule field.
+func (r *mutationResolver) RestoreRoutingRule(ctx context.Context, id objects.GUID) (*ent.RoutingRule, error) {
+	return r.channelService.RestoreRoutingRule(ctx, id.ID)
+}
+
+// ExplainRouting is the resolver for the explainRouting field.
+func (r *queryResolver) ExplainRouting(ctx context.Context, input ExplainRoutingInput) (*RoutingExplanation, error) {
+	if input.APIKeyID != nil {
+		apiKey, err := r.client.APIKey.Get(ctx, input.APIKeyID.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get api key: %w", err)
+		}
+
+		ctx = contexts.WithAPIKey(ctx, apiKey)
+	}
+
+	processor := chat.NewChatCompletionProcessor(r.channelService, r.requestService, r.httpClient, openai.NewInboundTransformer(), nil, nil)
+
+	explanation, err := processor.ExplainRouting(ctx, &httpclient.Request{
+		Method:  http.MethodPost,
+		Headers: http.Header{"Content-Type": []string{"application/json"}},
+		Body:    input.Request,
+	}, lo.FromPtr(input.SessionID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to explain routing: %w", err)
+	}
+
+	toEntChannels := func(channels []*biz.Channel) []*ent.Channel {
+		return lo.Map(channels, func(c *biz.Channel, _ int) *ent.Channel { return c.Channel })
+	}
+
+	return &RoutingExplanation{
+		Model:                 explanation.Model,
+		EstimatedPromptTokens: explanation.EstimatedPromptTokens,
+		Candidates:            toEntChannels(explanation.Candidates),
+		MatchedRule:           explanation.MatchedRule,
+		Channels:              toEntChannels(explanation.Channels),
+		Models:                explanation.Models,
+	}, nil
+}
+
 // Mutation returns MutationResolver implementation.
 func (r *Resolver) Mutation() MutationResolver { return &mutationResolver{r} }
 
@@ -84,6 +84,14 @@ func (r *queryResolver) Roles(ctx context.Context, after *entgql.Cursor[int], fi
 	)
 }
 
+// RoutingRules is the resolver for the routingRules field.
+func (r *queryResolver) RoutingRules(ctx context.Context, after *entgql.Cursor[int], first *int, before *entgql.Cursor[int], last *int, orderBy *ent.RoutingRuleOrder, where *ent.RoutingRuleWhereInput) (*ent.RoutingRuleConnection, error) {
+	return r.client.RoutingRule.Query().Paginate(ctx, after, first, before, last,
+		ent.WithRoutingRuleOrder(orderBy),
+		ent.WithRoutingRuleFilter(where.Filter),
+	)
+}
+
 // Systems is the resolver for the systems field.
 func (r *queryResolver) Systems(ctx context.Context, after *entgql.Cursor[int], first *int, before *entgql.Cursor[int], last *int, orderBy *ent.SystemOrder, where *ent.SystemWhereInput) (*ent.SystemConnection, error) {
 	return r.client.System.Query().Paginate(ctx, after, first, before, last,
@@ -108,6 +116,14 @@ func (r *queryResolver) Users(ctx context.Context, after *entgql.Cursor[int], fi
 	)
 }
 
+// VirtualModels is the resolver for the virtualModels field.
+func (r *queryResolver) VirtualModels(ctx context.Context, after *entgql.Cursor[int], first *int, before *entgql.Cursor[int], last *int, orderBy *ent.VirtualModelOrder, where *ent.VirtualModelWhereInput) (*ent.VirtualModelConnection, error) {
+	return r.client.VirtualModel.Query().Paginate(ctx, after, first, before, last,
+		ent.WithVirtualModelOrder(orderBy),
+		ent.WithVirtualModelFilter(where.Filter),
+	)
+}
+
 // ID is the resolver for the id field.
 func (r *requestResolver) ID(ctx context.Context, obj *ent.Request) (*objects.GUID, error) {
 	return &objects.GUID{
@@ -177,6 +193,14 @@ func (r *roleResolver) ID(ctx context.Context, obj *ent.Role) (*objects.GUID, er
 	}, nil
 }
 
+// ID is the resolver for the id field.
+func (r *routingRuleResolver) ID(ctx context.Context, obj *ent.RoutingRule) (*objects.GUID, error) {
+	return &objects.GUID{
+		Type: ent.TypeRoutingRule,
+		ID:   obj.ID,
+	}, nil
+}
+
 // ID is the resolver for the id field.
 func (r *systemResolver) ID(ctx context.Context, obj *ent.System) (*objects.GUID, error) {
 	return &objects.GUID{
@@ -225,6 +249,14 @@ func (r *userResolver) ID(ctx context.Context, obj *ent.User) (*objects.GUID, er
 	}, nil
 }
 
+// ID is the resolver for the id field.
+func (r *virtualModelResolver) ID(ctx context.Context, obj *ent.VirtualModel) (*objects.GUID, error) {
+	return &objects.GUID{
+		Type: ent.TypeVirtualModel,
+		ID:   obj.ID,
+	}, nil
+}
+
 // APIKey returns APIKeyResolver implementation.
 func (r *Resolver) APIKey() APIKeyResolver { return &aPIKeyResolver{r} }
 
@@ -243,6 +275,9 @@ func (r *Resolver) RequestExecution() RequestExecutionResolver { return &request
 // Role returns RoleResolver implementation.
 func (r *Resolver) Role() RoleResolver { return &roleResolver{r} }
 
+// RoutingRule returns RoutingRuleResolver implementation.
+func (r *Resolver) RoutingRule() RoutingRuleResolver { return &routingRuleResolver{r} }
+
 // System returns SystemResolver implementation.
 func (r *Resolver) System() SystemResolver { return &systemResolver{r} }
 
@@ -252,12 +287,17 @@ func (r *Resolver) UsageLog() UsageLogResolver { return &usageLogResolver{r} }
 // User returns UserResolver implementation.
 func (r *Resolver) User() UserResolver { return &userResolver{r} }
 
+// VirtualModel returns VirtualModelResolver implementation.
+func (r *Resolver) VirtualModel() VirtualModelResolver { return &virtualModelResolver{r} }
+
 type aPIKeyResolver struct{ *Resolver }
 type channelResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
 type requestResolver struct{ *Resolver }
 type requestExecutionResolver struct{ *Resolver }
 type roleResolver struct{ *Resolver }
+type routingRuleResolver struct{ *Resolver }
 type systemResolver struct{ *Resolver }
 type usageLogResolver struct{ *Resolver }
 type userResolver struct{ *Resolver }
+type virtualModelResolver struct{ *Resolver }
@@ -48,6 +48,12 @@ type ChannelOrderingItem struct {
 	OrderingWeight int          `json:"orderingWeight"`
 }
 
+type CreateAPIKeyPayload struct {
+	APIKey *ent.APIKey `json:"apiKey"`
+	// The plain API key, it can not be retrieved later.
+	PlainKey string `json:"plainKey"`
+}
+
 type DailyRequestStats struct {
 	Date  string `json:"date"`
 	Count int    `json:"count"`
@@ -63,6 +69,15 @@ type DashboardOverview struct {
 	AverageResponseTime *float64 `json:"averageResponseTime,omitempty"`
 }
 
+type ExplainRoutingInput struct {
+	// The sample request in the OpenAI chat completion format.
+	Request objects.JSONRawMessage `json:"request"`
+	// The API key sending the request, its profile applies the model mapping, the fallbacks and the routing strategy.
+	APIKeyID *objects.GUID `json:"apiKeyID,omitempty"`
+	// The session of the request, the channel which served the session is preferred if the session affinity is enabled.
+	SessionID *string `json:"sessionID,omitempty"`
+}
+
 type HourlyRequestStats struct {
 	Hour  int `json:"hour"`
 	Count int `json:"count"`
@@ -99,6 +114,25 @@ type RoleInfo struct {
 	Name string `json:"name"`
 }
 
+type RotateAPIKeyPayload struct {
+	APIKey *ent.APIKey `json:"apiKey"`
+	// The plain new key, it can not be retrieved later.
+	PlainKey string `json:"plainKey"`
+}
+
+type RoutingExplanation struct {
+	Model                 string `json:"model"`
+	EstimatedPromptTokens int    `json:"estimatedPromptTokens"`
+	// The enabled channels supporting the first selected model before the routing strategy and rules are applied.
+	Candidates []*ent.Channel `json:"candidates"`
+	// The first matched routing rule, null if no rule matches.
+	MatchedRule *ent.RoutingRule `json:"matchedRule,omitempty"`
+	// The channels the request would be sent to in order, including the targets of the virtual models and the fallback models.
+	Channels []*ent.Channel `json:"channels"`
+	// The models requested from the channels, one for each channel.
+	Models []string `json:"models"`
+}
+
 type ScopeInfo struct {
 	Scope       string `json:"scope"`
 	Description string `json:"description"`
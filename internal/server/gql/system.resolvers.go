@@ -9,7 +9,9 @@ import (
 	"fmt"
 
 	"entgo.io/ent/privacy"
+	"github.com/looplj/axonhub/internal/objects"
 	"github.com/looplj/axonhub/internal/server/biz"
+	"github.com/samber/lo"
 )
 
 // UpdateBrandSettings is the resolver for the updateBrandSettings field.
@@ -41,6 +43,36 @@ func (r *mutationResolver) UpdateStoragePolicy(ctx context.Context, input biz.St
 	return true, nil
 }
 
+// UpdateGuardrailPolicy is the resolver for the updateGuardrailPolicy field.
+func (r *mutationResolver) UpdateGuardrailPolicy(ctx context.Context, input objects.GuardrailPolicy) (bool, error) {
+	err := r.systemService.SetGuardrailPolicy(ctx, &input)
+	if err != nil {
+		return false, fmt.Errorf("failed to update guardrail policy: %w", err)
+	}
+
+	return true, nil
+}
+
+// UpdateModelFallbacks is the resolver for the updateModelFallbacks field.
+func (r *mutationResolver) UpdateModelFallbacks(ctx context.Context, input []*objects.ModelFallback) (bool, error) {
+	err := r.systemService.SetModelFallbacks(ctx, lo.FromSlicePtr(input))
+	if err != nil {
+		return false, fmt.Errorf("failed to update model fallbacks: %w", err)
+	}
+
+	return true, nil
+}
+
+// UpdateHedgingPolicy is the resolver for the updateHedgingPolicy field.
+func (r *mutationResolver) UpdateHedgingPolicy(ctx context.Context, input objects.HedgingPolicy) (bool, error) {
+	err := r.systemService.SetHedgingPolicy(ctx, &input)
+	if err != nil {
+		return false, fmt.Errorf("failed to update hedging policy: %w", err)
+	}
+
+	return true, nil
+}
+
 // SystemStatus is the resolver for the systemStatus field.
 func (r *queryResolver) SystemStatus(ctx context.Context) (*SystemStatus, error) {
 	isInitialized, err := r.systemService.IsInitialized(ctx)
@@ -77,3 +109,23 @@ func (r *queryResolver) BrandSettings(ctx context.Context) (*BrandSettings, erro
 func (r *queryResolver) StoragePolicy(ctx context.Context) (*biz.StoragePolicy, error) {
 	return r.systemService.StoragePolicy(ctx)
 }
+
+// GuardrailPolicy is the resolver for the guardrailPolicy field.
+func (r *queryResolver) GuardrailPolicy(ctx context.Context) (*objects.GuardrailPolicy, error) {
+	return r.systemService.GuardrailPolicy(ctx)
+}
+
+// ModelFallbacks is the resolver for the modelFallbacks field.
+func (r *queryResolver) ModelFallbacks(ctx context.Context) ([]*objects.ModelFallback, error) {
+	fallbacks, err := r.systemService.ModelFallbacks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.ToSlicePtr(fallbacks), nil
+}
+
+// HedgingPolicy is the resolver for the hedgingPolicy field.
+func (r *queryResolver) HedgingPolicy(ctx context.Context) (*objects.HedgingPolicy, error) {
+	return r.systemService.HedgingPolicy(ctx)
+}
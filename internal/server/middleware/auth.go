@@ -1,6 +1,8 @@
 package middleware
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"net/http"
 
@@ -9,42 +11,67 @@ import (
 	"github.com/looplj/axonhub/internal/contexts"
 	"github.com/looplj/axonhub/internal/ent"
 	"github.com/looplj/axonhub/internal/ent/request"
+	"github.com/looplj/axonhub/internal/llm"
+	"github.com/looplj/axonhub/internal/pkg/httpclient"
 	"github.com/looplj/axonhub/internal/server/biz"
 )
 
-// WithAPIKeyAuth 中间件用于验证 API key.
-func WithAPIKeyAuth(auth *biz.AuthService) gin.HandlerFunc {
-	return WithAPIKeyConfig(auth, nil)
+// ErrorTransformer 将错误转换为调用方 API 格式的 HTTP 错误，transformer.Inbound 实现了该接口.
+type ErrorTransformer interface {
+	TransformError(ctx context.Context, rawErr error) *httpclient.Error
+}
+
+// WithAPIKeyAuth 中间件用于验证 API key，错误以 errorTransformer 的 API 格式返回.
+func WithAPIKeyAuth(auth *biz.AuthService, errorTransformer ErrorTransformer) gin.HandlerFunc {
+	return WithAPIKeyConfig(auth, nil, errorTransformer)
 }
 
 // WithAPIKeyConfig 中间件用于验证 API key，支持自定义配置.
-func WithAPIKeyConfig(auth *biz.AuthService, config *APIKeyConfig) gin.HandlerFunc {
+func WithAPIKeyConfig(auth *biz.AuthService, config *APIKeyConfig, errorTransformer ErrorTransformer) gin.HandlerFunc {
+	abort := func(c *gin.Context, statusCode int, errType, message string) {
+		if errorTransformer == nil {
+			c.AbortWithStatusJSON(statusCode, gin.H{
+				"error": message,
+			})
+
+			return
+		}
+
+		httpErr := errorTransformer.TransformError(c.Request.Context(), &llm.ResponseError{
+			StatusCode: statusCode,
+			Detail: llm.ErrorDetail{
+				Message: message,
+				Type:    errType,
+			},
+		})
+		c.AbortWithStatusJSON(httpErr.StatusCode, json.RawMessage(httpErr.Body))
+	}
+
 	return func(c *gin.Context) {
 		key, err := ExtractAPIKeyFromRequest(c.Request, config)
 		if err != nil {
-			c.JSON(http.StatusUnauthorized, gin.H{
-				"error": err.Error(),
-			})
-			c.Abort()
-
+			abort(c, http.StatusUnauthorized, "authentication_error", err.Error())
 			return
 		}
 
 		// 查询数据库验证 API key 是否存在
 		apiKey, err := auth.AnthenticateAPIKey(c.Request.Context(), key)
 		if err != nil {
-			if ent.IsNotFound(err) || errors.Is(err, biz.ErrInvalidAPIKey) {
-				c.JSON(http.StatusUnauthorized, gin.H{
-					"error": "Invalid API key",
-				})
-			} else {
-				c.JSON(http.StatusInternalServerError, gin.H{
-					"error": "Failed to validate API key",
-				})
+			switch {
+			case errors.Is(err, biz.ErrAPIKeyExpired):
+				abort(c, http.StatusUnauthorized, "authentication_error", "API key expired")
+			case ent.IsNotFound(err) || errors.Is(err, biz.ErrInvalidAPIKey):
+				abort(c, http.StatusUnauthorized, "authentication_error", "Invalid API key")
+			default:
+				abort(c, http.StatusInternalServerError, "internal_server_error", "Failed to validate API key")
 			}
 
-			c.Abort()
+			return
+		}
 
+		// 校验 API key 的 IP 白名单
+		if err := biz.CheckAPIKeyClientIP(apiKey, c.ClientIP()); err != nil {
+			abort(c, http.StatusForbidden, "permission_error", "API key is not allowed from this IP")
 			return
 		}
 
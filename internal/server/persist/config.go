@@ -0,0 +1,26 @@
+package persist
+
+import (
+	"time"
+)
+
+// Config holds the configuration for the persistence writer.
+type Config struct {
+	// Async enables the write-behind queue.
+	// If false, all writes are applied synchronously in the caller goroutine.
+	Async bool `conf:"async" yaml:"async" json:"async"`
+
+	// QueueSize is the capacity of the queue of each worker.
+	QueueSize int `conf:"queue_size" yaml:"queue_size" json:"queue_size"`
+
+	// Workers is the number of workers applying the writes.
+	// Writes with the same key are always handled by the same worker, so they are applied in order.
+	Workers int `conf:"workers" yaml:"workers" json:"workers"`
+
+	// BatchSize is the maximum number of writes applied in one transaction.
+	BatchSize int `conf:"batch_size" yaml:"batch_size" json:"batch_size"`
+
+	// EnqueueTimeout is the maximum duration to wait for a free slot when the queue is full.
+	// The write is dropped if no slot is available after the timeout.
+	EnqueueTimeout time.Duration `conf:"enqueue_timeout" yaml:"enqueue_timeout" json:"enqueue_timeout"`
+}
@@ -0,0 +1,322 @@
+package persist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/log"
+	"github.com/looplj/axonhub/internal/metrics"
+)
+
+// ErrQueueFull is returned when a write is dropped because the queue is full.
+var ErrQueueFull = errors.New("persistence queue is full")
+
+// WriteFunc applies a write, the ent client should be retrieved from the context.
+type WriteFunc func(ctx context.Context) error
+
+// Writer applies the writes of the request lifecycle, e.g. the request status, the response chunks and the usage logs.
+//
+// In async mode, the writes are queued and applied by background workers in batches,
+// so a slow database does not add latency to the user requests.
+// The writes with the same key are handled by the same worker, so they are applied in the submission order,
+// the writes of a request lifecycle are keyed by the request ID.
+// In sync mode, or when the writer is not running, the writes are applied in the caller goroutine.
+type Writer struct {
+	config Config
+	client *ent.Client
+
+	mu      sync.RWMutex
+	running bool
+	queues  []chan *task
+	wg      sync.WaitGroup
+
+	// stopping is closed by stop when the writer is stopping, it wakes up the submitters waiting for the full queues,
+	// so they do not hold the read lock and block the shutdown.
+	stopping chan struct{}
+	stop     func()
+
+	depth   atomic.Int64
+	dropped atomic.Int64
+}
+
+//nolint:containedctx // Checked.
+type task struct {
+	ctx  context.Context
+	name string
+	fn   WriteFunc
+}
+
+// NewWriter creates a new persistence writer.
+func NewWriter(config Config, client *ent.Client) *Writer {
+	return &Writer{
+		config: config,
+		client: client,
+	}
+}
+
+// Start starts the background workers if the async mode is enabled.
+func (w *Writer) Start(ctx context.Context) error {
+	if !w.config.Async {
+		log.Info(ctx, "persistence writer runs in sync mode")
+		return nil
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.running {
+		return nil
+	}
+
+	workers := max(w.config.Workers, 1)
+	queueSize := max(w.config.QueueSize, 1)
+
+	w.queues = make([]chan *task, workers)
+	for i := range workers {
+		queue := make(chan *task, queueSize)
+		w.queues[i] = queue
+
+		w.wg.Add(1)
+
+		go w.run(queue)
+	}
+
+	stopping := make(chan struct{})
+	w.stopping = stopping
+	w.stop = sync.OnceFunc(func() { close(stopping) })
+	w.running = true
+
+	log.Info(ctx, "persistence writer started",
+		log.Int("workers", workers),
+		log.Int("queue_size", queueSize),
+		log.Int("batch_size", max(w.config.BatchSize, 1)),
+	)
+
+	return nil
+}
+
+// Stop stops accepting new writes and waits until all the queued writes are applied.
+func (w *Writer) Stop(ctx context.Context) error {
+	w.mu.RLock()
+	stop := w.stop
+	w.mu.RUnlock()
+
+	if stop != nil {
+		stop()
+	}
+
+	w.mu.Lock()
+
+	if !w.running {
+		w.mu.Unlock()
+		return nil
+	}
+
+	w.running = false
+	for _, queue := range w.queues {
+		close(queue)
+	}
+
+	w.mu.Unlock()
+
+	done := make(chan struct{})
+
+	go func() {
+		w.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		log.Info(ctx, "persistence writer stopped", log.Int64("dropped", w.dropped.Load()))
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("failed to flush persistence queue, %d writes pending: %w", w.depth.Load(), ctx.Err())
+	}
+}
+
+// Submit applies the write for the given key.
+// In async mode, it returns once the write is queued, and returns ErrQueueFull if the write is dropped.
+// If the writer stops while waiting for the full queue, the write is applied in the caller goroutine.
+// The write is applied with a context without cancellation, so it survives the client disconnection.
+func (w *Writer) Submit(ctx context.Context, key int, name string, fn WriteFunc) error {
+	return w.submit(ctx, key, name, fn, false)
+}
+
+// SubmitTerminal applies the terminal write of the request lifecycle for the given key, e.g. the final status.
+// It is never dropped, if the queue is still full after the enqueue timeout, the write is applied in the caller goroutine.
+func (w *Writer) SubmitTerminal(ctx context.Context, key int, name string, fn WriteFunc) error {
+	return w.submit(ctx, key, name, fn, true)
+}
+
+func (w *Writer) submit(ctx context.Context, key int, name string, fn WriteFunc, terminal bool) error {
+	if w == nil {
+		return fn(ctx)
+	}
+
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	if !w.running {
+		return fn(ctx)
+	}
+
+	t := &task{
+		ctx:  context.WithoutCancel(ctx),
+		name: name,
+		fn:   fn,
+	}
+
+	if key < 0 {
+		key = -key
+	}
+
+	queue := w.queues[key%len(w.queues)]
+
+	select {
+	case queue <- t:
+		w.enqueued(ctx)
+		return nil
+	default:
+	}
+
+	timer := time.NewTimer(w.config.EnqueueTimeout)
+	defer timer.Stop()
+
+	select {
+	case queue <- t:
+		w.enqueued(ctx)
+		return nil
+	case <-w.stopping:
+		return fn(ctx)
+	case <-timer.C:
+		if terminal {
+			log.Warn(ctx, "persistence queue is full, apply the terminal write synchronously", log.String("name", name), log.Int("key", key))
+
+			return t.fn(ent.NewContext(t.ctx, w.client))
+		}
+
+		w.dropped.Add(1)
+
+		if metrics.Metrics != nil {
+			metrics.Metrics.RecordPersistDrop(ctx, name)
+		}
+
+		log.Warn(ctx, "persistence queue is full, drop the write", log.String("name", name), log.Int("key", key))
+
+		return ErrQueueFull
+	}
+}
+
+// Depth returns the number of the writes queued but not yet applied.
+func (w *Writer) Depth() int64 {
+	return w.depth.Load()
+}
+
+// Dropped returns the number of the writes dropped because the queue was full.
+func (w *Writer) Dropped() int64 {
+	return w.dropped.Load()
+}
+
+func (w *Writer) enqueued(ctx context.Context) {
+	w.depth.Add(1)
+
+	if metrics.Metrics != nil {
+		metrics.Metrics.RecordPersistQueueDepth(ctx, 1)
+	}
+}
+
+func (w *Writer) applied(count int) {
+	w.depth.Add(-int64(count))
+
+	if metrics.Metrics != nil {
+		metrics.Metrics.RecordPersistQueueDepth(context.Background(), -int64(count))
+	}
+}
+
+func (w *Writer) run(queue chan *task) {
+	defer w.wg.Done()
+
+	batchSize := max(w.config.BatchSize, 1)
+	batch := make([]*task, 0, batchSize)
+
+	for t := range queue {
+		batch = append(batch[:0], t)
+
+	drain:
+		for len(batch) < batchSize {
+			select {
+			case next, ok := <-queue:
+				if !ok {
+					break drain
+				}
+
+				batch = append(batch, next)
+			default:
+				break drain
+			}
+		}
+
+		w.applyBatch(batch)
+	}
+}
+
+// applyBatch applies the writes in one transaction.
+// If the transaction fails, the writes are applied one by one, so one bad write does not drop the others.
+func (w *Writer) applyBatch(batch []*task) {
+	defer w.applied(len(batch))
+
+	if len(batch) == 1 {
+		w.applyOne(batch[0])
+		return
+	}
+
+	ctx := context.Background()
+
+	tx, err := w.client.Tx(ctx)
+	if err != nil {
+		log.Warn(ctx, "failed to start persistence transaction", log.Cause(err))
+		w.applyEach(batch)
+
+		return
+	}
+
+	for _, t := range batch {
+		err := t.fn(ent.NewContext(t.ctx, tx.Client()))
+		if err != nil {
+			_ = tx.Rollback()
+
+			log.Warn(t.ctx, "failed to apply persistence batch, retry the writes one by one",
+				log.String("name", t.name),
+				log.Int("batch_size", len(batch)),
+				log.Cause(err),
+			)
+			w.applyEach(batch)
+
+			return
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Warn(ctx, "failed to commit persistence batch, retry the writes one by one", log.Cause(err))
+		w.applyEach(batch)
+	}
+}
+
+func (w *Writer) applyEach(batch []*task) {
+	for _, t := range batch {
+		w.applyOne(t)
+	}
+}
+
+func (w *Writer) applyOne(t *task) {
+	err := t.fn(ent.NewContext(t.ctx, w.client))
+	if err != nil {
+		log.Error(t.ctx, "failed to apply persistence write", log.String("name", t.name), log.Cause(err))
+	}
+}
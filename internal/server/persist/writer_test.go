@@ -0,0 +1,265 @@
+package persist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/privacy"
+	"github.com/looplj/axonhub/internal/server/db"
+)
+
+func newTestClient(t *testing.T) *ent.Client {
+	t.Helper()
+
+	client := db.NewEntClient(db.Config{
+		Dialect: "sqlite3",
+		DSN:     fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()),
+	})
+	t.Cleanup(func() { _ = client.Close() })
+
+	return client
+}
+
+func createSystem(key string) WriteFunc {
+	return func(ctx context.Context) error {
+		ctx = privacy.DecisionContext(ctx, privacy.Allow)
+
+		return ent.FromContext(ctx).System.Create().
+			SetKey(key).
+			SetValue("value").
+			Exec(ctx)
+	}
+}
+
+func TestWriter_SyncMode(t *testing.T) {
+	client := newTestClient(t)
+	writer := NewWriter(Config{Async: false}, client)
+	require.NoError(t, writer.Start(t.Context()))
+
+	ctx := ent.NewContext(t.Context(), client)
+	require.NoError(t, writer.Submit(ctx, 1, "system", createSystem("sync")))
+
+	// The write is applied before Submit returns.
+	count, err := client.System.Query().Count(privacy.DecisionContext(t.Context(), privacy.Allow))
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+
+	expected := errors.New("write failed")
+	err = writer.Submit(ctx, 1, "failed", func(ctx context.Context) error { return expected })
+	require.ErrorIs(t, err, expected)
+
+	require.NoError(t, writer.Stop(t.Context()))
+}
+
+func TestWriter_NilWriter(t *testing.T) {
+	var writer *Writer
+
+	called := false
+	err := writer.Submit(t.Context(), 1, "nil", func(ctx context.Context) error {
+		called = true
+		return nil
+	})
+	require.NoError(t, err)
+	require.True(t, called)
+}
+
+func TestWriter_AsyncFlushOnStop(t *testing.T) {
+	client := newTestClient(t)
+	writer := NewWriter(Config{Async: true, QueueSize: 128, Workers: 2, BatchSize: 8, EnqueueTimeout: time.Second}, client)
+	require.NoError(t, writer.Start(t.Context()))
+
+	ctx := ent.NewContext(t.Context(), client)
+	for i := range 50 {
+		require.NoError(t, writer.Submit(ctx, i, "system", createSystem(fmt.Sprintf("key-%d", i))))
+	}
+
+	require.NoError(t, writer.Stop(t.Context()))
+	require.Zero(t, writer.Depth())
+
+	count, err := client.System.Query().Count(privacy.DecisionContext(t.Context(), privacy.Allow))
+	require.NoError(t, err)
+	require.Equal(t, 50, count)
+
+	// Writes after stop are applied synchronously.
+	require.NoError(t, writer.Submit(ctx, 1, "system", createSystem("after-stop")))
+
+	count, err = client.System.Query().Count(privacy.DecisionContext(t.Context(), privacy.Allow))
+	require.NoError(t, err)
+	require.Equal(t, 51, count)
+}
+
+func TestWriter_OrderingPerKey(t *testing.T) {
+	client := newTestClient(t)
+	writer := NewWriter(Config{Async: true, QueueSize: 1024, Workers: 4, BatchSize: 16, EnqueueTimeout: time.Second}, client)
+	require.NoError(t, writer.Start(t.Context()))
+
+	var (
+		mu     sync.Mutex
+		orders = map[int][]int{}
+	)
+
+	for i := range 100 {
+		for key := range 5 {
+			err := writer.Submit(t.Context(), key, "order", func(ctx context.Context) error {
+				mu.Lock()
+				defer mu.Unlock()
+
+				orders[key] = append(orders[key], i)
+
+				return nil
+			})
+			require.NoError(t, err)
+		}
+	}
+
+	require.NoError(t, writer.Stop(t.Context()))
+
+	for key := range 5 {
+		require.Len(t, orders[key], 100)
+
+		for i, v := range orders[key] {
+			require.Equal(t, i, v)
+		}
+	}
+}
+
+func TestWriter_BatchFailureRetriesIndividually(t *testing.T) {
+	client := newTestClient(t)
+	writer := NewWriter(Config{Async: true, QueueSize: 16, Workers: 1, BatchSize: 16, EnqueueTimeout: time.Second}, client)
+	require.NoError(t, writer.Start(t.Context()))
+
+	block := make(chan struct{})
+
+	ctx := ent.NewContext(t.Context(), client)
+	require.NoError(t, writer.Submit(ctx, 1, "block", func(ctx context.Context) error {
+		<-block
+		return nil
+	}))
+
+	// These writes are applied in one batch, the duplicated key fails the transaction.
+	require.NoError(t, writer.Submit(ctx, 1, "system", createSystem("a")))
+	require.NoError(t, writer.Submit(ctx, 1, "system", createSystem("a")))
+	require.NoError(t, writer.Submit(ctx, 1, "system", createSystem("b")))
+	close(block)
+
+	require.NoError(t, writer.Stop(t.Context()))
+
+	count, err := client.System.Query().Count(privacy.DecisionContext(t.Context(), privacy.Allow))
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+}
+
+func TestWriter_DropWhenFull(t *testing.T) {
+	client := newTestClient(t)
+	writer := NewWriter(Config{Async: true, QueueSize: 1, Workers: 1, BatchSize: 1, EnqueueTimeout: 10 * time.Millisecond}, client)
+	require.NoError(t, writer.Start(t.Context()))
+
+	started := make(chan struct{})
+	block := make(chan struct{})
+
+	require.NoError(t, writer.Submit(t.Context(), 1, "block", func(ctx context.Context) error {
+		close(started)
+		<-block
+
+		return nil
+	}))
+	<-started
+
+	noop := func(ctx context.Context) error { return nil }
+
+	// The first write fills the queue, the second one is dropped.
+	require.NoError(t, writer.Submit(t.Context(), 1, "noop", noop))
+	require.ErrorIs(t, writer.Submit(t.Context(), 1, "noop", noop), ErrQueueFull)
+	require.Equal(t, int64(1), writer.Dropped())
+
+	close(block)
+	require.NoError(t, writer.Stop(t.Context()))
+}
+
+func TestWriter_TerminalWriteWhenFull(t *testing.T) {
+	client := newTestClient(t)
+	writer := NewWriter(Config{Async: true, QueueSize: 1, Workers: 1, BatchSize: 1, EnqueueTimeout: 10 * time.Millisecond}, client)
+	require.NoError(t, writer.Start(t.Context()))
+
+	started := make(chan struct{})
+	block := make(chan struct{})
+
+	require.NoError(t, writer.Submit(t.Context(), 1, "block", func(ctx context.Context) error {
+		close(started)
+		<-block
+
+		return nil
+	}))
+	<-started
+
+	// The first write fills the queue, the terminal write is applied in the submitter goroutine instead of being dropped.
+	require.NoError(t, writer.Submit(t.Context(), 1, "noop", func(ctx context.Context) error { return nil }))
+	require.NoError(t, writer.SubmitTerminal(t.Context(), 1, "system", createSystem("terminal")))
+	require.Zero(t, writer.Dropped())
+
+	count, err := client.System.Query().Count(privacy.DecisionContext(t.Context(), privacy.Allow))
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+
+	close(block)
+	require.NoError(t, writer.Stop(t.Context()))
+}
+
+func TestWriter_StopDoesNotWaitForBlockedSubmit(t *testing.T) {
+	client := newTestClient(t)
+	writer := NewWriter(Config{Async: true, QueueSize: 1, Workers: 1, BatchSize: 1, EnqueueTimeout: time.Minute}, client)
+	require.NoError(t, writer.Start(t.Context()))
+
+	started := make(chan struct{})
+	block := make(chan struct{})
+
+	require.NoError(t, writer.Submit(t.Context(), 1, "block", func(ctx context.Context) error {
+		close(started)
+		<-block
+
+		return nil
+	}))
+	<-started
+
+	noop := func(ctx context.Context) error { return nil }
+
+	// The first write fills the queue, the second one waits for the queue until the writer stops.
+	require.NoError(t, writer.Submit(t.Context(), 1, "noop", noop))
+
+	submitted := make(chan error, 1)
+
+	go func() {
+		submitted <- writer.Submit(ent.NewContext(t.Context(), client), 1, "waiting", createSystem("waiting"))
+	}()
+
+	// Wait until the submitter is blocked on the full queue.
+	time.Sleep(50 * time.Millisecond)
+
+	stopped := make(chan error, 1)
+
+	go func() {
+		stopped <- writer.Stop(t.Context())
+	}()
+
+	select {
+	case err := <-submitted:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("submit is not woken up by stop")
+	}
+
+	close(block)
+	require.NoError(t, <-stopped)
+
+	// The waiting write is applied in the submitter goroutine.
+	count, err := client.System.Query().Count(privacy.DecisionContext(t.Context(), privacy.Allow))
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+}
@@ -6,6 +6,8 @@ import (
 
 	"github.com/looplj/axonhub/internal/ent"
 	"github.com/looplj/axonhub/internal/ent/request"
+	"github.com/looplj/axonhub/internal/llm/transformer/anthropic"
+	"github.com/looplj/axonhub/internal/llm/transformer/openai"
 	"github.com/looplj/axonhub/internal/server/api"
 	"github.com/looplj/axonhub/internal/server/biz"
 	"github.com/looplj/axonhub/internal/server/gql"
@@ -71,16 +73,18 @@ func SetupRoutes(server *Server, handlers Handlers, auth *biz.AuthService, clien
 	}
 
 	apiGroup := server.Group("/v1", middleware.WithTimeout(server.Config.LLMRequestTimeout))
-	apiGroup.Use(middleware.WithAPIKeyAuth(auth))
+	apiGroup.Use(middleware.WithAPIKeyAuth(auth, openai.NewInboundTransformer()))
 	apiGroup.Use(middleware.WithSource(request.SourceAPI))
 	{
 		apiGroup.POST("/chat/completions", handlers.OpenAI.ChatCompletion)
+		apiGroup.GET("/models", handlers.OpenAI.ListModels)
 	}
 
 	anthropicGroup := server.Group("/anthropic/v1", middleware.WithTimeout(server.Config.LLMRequestTimeout))
-	anthropicGroup.Use(middleware.WithAPIKeyAuth(auth))
+	anthropicGroup.Use(middleware.WithAPIKeyAuth(auth, anthropic.NewInboundTransformer()))
 	anthropicGroup.Use(middleware.WithSource(request.SourceAPI))
 	{
 		anthropicGroup.POST("/messages", handlers.Anthropic.CreateMessage)
+		anthropicGroup.POST("/messages/count_tokens", handlers.Anthropic.CountTokens)
 	}
 }
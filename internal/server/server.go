@@ -10,7 +10,9 @@ import (
 	"github.com/gin-gonic/gin"
 	"go.uber.org/fx"
 
+	"github.com/looplj/axonhub/internal/ent"
 	"github.com/looplj/axonhub/internal/log"
+	"github.com/looplj/axonhub/internal/pkg/xerrors"
 	"github.com/looplj/axonhub/internal/server/api"
 	"github.com/looplj/axonhub/internal/server/biz"
 	"github.com/looplj/axonhub/internal/server/dependencies"
@@ -26,6 +28,10 @@ func New(config Config) *Server {
 	engine := gin.New()
 	engine.Use(gin.Recovery())
 
+	// The forwarded headers are only trusted from the configured proxies, otherwise the clients can spoof their IPs.
+	xerrors.NoErr(engine.SetTrustedProxies(config.TrustedProxies))
+	engine.TrustedPlatform = config.TrustedPlatform
+
 	return &Server{
 		Config: config,
 		Engine: engine,
@@ -100,6 +106,14 @@ func Run(opts ...fx.Option) {
 					},
 				})
 			}),
+			fx.Invoke(func(lc fx.Lifecycle, client *ent.Client, authService *biz.AuthService) {
+				lc.Append(fx.Hook{
+					// Hash the plaintext API keys of the previous versions before serving the requests.
+					OnStart: func(ctx context.Context) error {
+						return authService.MigrateAPIKeys(ent.NewContext(ctx, client))
+					},
+				})
+			}),
 			fx.Invoke(SetupRoutes),
 		}, opts...)...,
 	)
@@ -0,0 +1,85 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/privacy"
+	"github.com/looplj/axonhub/internal/server/biz"
+	"github.com/looplj/axonhub/internal/server/db"
+	"github.com/looplj/axonhub/internal/server/middleware"
+)
+
+func TestServer_APIKeyClientIP(t *testing.T) {
+	client := db.NewEntClient(db.Config{
+		Dialect: "sqlite3",
+		DSN:     "file:ent?mode=memory&_fk=1",
+	})
+	defer client.Close()
+
+	ctx := ent.NewContext(t.Context(), client)
+	ctx = privacy.DecisionContext(ctx, privacy.Allow)
+
+	owner, err := client.User.Create().SetEmail("owner@example.com").SetPassword("password").Save(ctx)
+	require.NoError(t, err)
+
+	auth := biz.NewAuthService(biz.AuthServiceParams{})
+
+	key, err := auth.GenerateAPIKey()
+	require.NoError(t, err)
+
+	hashed, err := biz.HashAPIKey(key)
+	require.NoError(t, err)
+
+	_, err = client.APIKey.Create().
+		SetName("internal").
+		SetKey(hashed).
+		SetKeyPrefix(biz.APIKeyPrefix(key)).
+		SetUserID(owner.ID).
+		SetAllowedCidrs([]string{"10.0.0.0/8"}).
+		Save(ctx)
+	require.NoError(t, err)
+
+	newServer := func(config Config) *Server {
+		server := New(config)
+		server.Use(func(c *gin.Context) {
+			c.Request = c.Request.WithContext(ent.NewContext(c.Request.Context(), client))
+			c.Next()
+		})
+		server.GET("/v1/models", middleware.WithAPIKeyAuth(auth, nil), func(c *gin.Context) {
+			c.Status(http.StatusOK)
+		})
+
+		return server
+	}
+
+	serve := func(server *Server, remoteAddr string, forwardedFor string) int {
+		req := httptest.NewRequest(http.MethodGet, "/v1/models", nil)
+		req.RemoteAddr = remoteAddr
+		req.Header.Set("Authorization", "Bearer "+key)
+
+		if forwardedFor != "" {
+			req.Header.Set("X-Forwarded-For", forwardedFor)
+		}
+
+		rec := httptest.NewRecorder()
+		server.ServeHTTP(rec, req)
+
+		return rec.Code
+	}
+
+	// The client can not spoof the allowed IP with the X-Forwarded-For header.
+	server := newServer(Config{})
+	require.Equal(t, http.StatusForbidden, serve(server, "192.0.2.1:1234", "10.0.0.1"))
+	require.Equal(t, http.StatusOK, serve(server, "10.0.0.1:1234", ""))
+
+	// The X-Forwarded-For header is used when sent by the trusted proxies.
+	server = newServer(Config{TrustedProxies: []string{"192.0.2.0/24"}})
+	require.Equal(t, http.StatusOK, serve(server, "192.0.2.1:1234", "10.0.0.1"))
+	require.Equal(t, http.StatusForbidden, serve(server, "198.51.100.1:1234", "10.0.0.1"))
+}